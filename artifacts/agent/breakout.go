@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/netns"
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// handleBreakout replaces the interfaces relayed connections leave through, the whole table is refused if a rule
+// names an interface the target doesn't have
+func handleBreakout(request protocol.BreakoutRequestPacket) protocol.BreakoutResponsePacket {
+	ifaces, err := targetDialer.Interfaces()
+	if err != nil {
+		return protocol.BreakoutResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	known := make(map[string]bool)
+	for _, iface := range ifaces {
+		known[iface.Name] = true
+	}
+
+	var rules []netns.BreakoutRule
+	for _, rule := range request.Rules {
+		_, network, err := net.ParseCIDR(rule.Cidr)
+		if err != nil {
+			return protocol.BreakoutResponsePacket{Err: true, ErrString: err.Error()}
+		}
+
+		if rule.Interface != "" && !known[rule.Interface] {
+			return protocol.BreakoutResponsePacket{Err: true, ErrString: fmt.Sprintf("no interface named %s", rule.Interface)}
+		}
+
+		rules = append(rules, netns.BreakoutRule{Network: network, Interface: rule.Interface})
+	}
+
+	targetDialer.Breakout.Set(rules)
+
+	return protocol.BreakoutResponsePacket{}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"net"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"github.com/ttpreport/ligolo-mp-agent/internal/relay"
+	"github.com/ttpreport/ligolo-mp-agent/internal/transport"
+)
+
+// newRedirector opens the listener of a redirector, within the namespace targets are reached in. Bridges may listen
+// on a named pipe, for agents linking over SMB, which net.Listen knows nothing about
+func newRedirector(request protocol.RedirectorRequestPacket, dialer relay.Dialer) (relay.Redirector, error) {
+	var lis net.Listener
+	var err error
+	if request.Network == "pipe" {
+		lis, err = transport.ListenPipe(request.From)
+	} else {
+		lis, err = targetDialer.Listen(request.Network, request.From)
+	}
+	if err != nil {
+		return relay.Redirector{}, err
+	}
+
+	return relay.Redirector{
+		ID:       request.ID,
+		Network:  request.Network,
+		From:     request.From,
+		To:       request.To,
+		Listener: lis,
+		Dialer:   dialer,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// handleBurn removes every trace the agent knows it left, tells the server what went and what didn't, then exits.
+// Persistence goes first, it is named after the executable
+func handleBurn(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.BurnRequestPacket) {
+	var response protocol.BurnResponsePacket
+
+	response.Removed, response.Errors = removePersistence(request.Persisted)
+
+	if path, err := os.Executable(); err == nil {
+		for _, leftover := range leftovers(path) {
+			if err := os.Remove(leftover); err == nil {
+				response.Removed = append(response.Removed, leftover)
+			}
+		}
+	}
+
+	if removed, err := removeExecutable(); err != nil {
+		response.Errors = append(response.Errors, fmt.Sprintf("executable: %v", err))
+	} else {
+		response.Removed = append(response.Removed, removed)
+	}
+
+	encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageBurnResponse,
+		Payload: response,
+	})
+	conn.Close()
+
+	os.Exit(0)
+}
+
+// leftovers are the files updates leave next to the executable: the replaced one windows couldn't delete yet and
+// partial downloads
+func leftovers(path string) []string {
+	result, _ := filepath.Glob(filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+"-*"))
+	if _, err := os.Stat(path + ".old"); err == nil {
+		result = append(result, path+".old")
+	}
+
+	return result
+}
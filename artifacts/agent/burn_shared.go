@@ -0,0 +1,11 @@
+//go:build shared
+// +build shared
+
+package main
+
+import "errors"
+
+// removeExecutable can't do anything for a library, the executable is the process that loaded it
+func removeExecutable() (string, error) {
+	return "", errors.New("the agent is loaded by another process, its file is left in place")
+}
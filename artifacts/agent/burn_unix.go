@@ -0,0 +1,23 @@
+//go:build !shared && !windows
+// +build !shared,!windows
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// removeExecutable unlinks the running executable, the process keeps it open until it exits
+func removeExecutable() (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	if path, err = filepath.EvalSymlinks(path); err != nil {
+		return "", err
+	}
+
+	return path, os.Remove(path)
+}
@@ -0,0 +1,37 @@
+//go:build !shared && windows
+// +build !shared,windows
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+)
+
+// removeExecutable leaves deleting the executable to cmd.exe, windows doesn't let it go while the process runs.
+// cmd waits a few seconds for the agent to exit first
+func removeExecutable() (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	if path, err = filepath.EvalSymlinks(path); err != nil {
+		return "", err
+	}
+
+	cmd := exec.Command("cmd.exe")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CmdLine:    fmt.Sprintf(`/c ping -n 4 127.0.0.1 >nul & del /f /q "%s"`, path),
+		HideWindow: true,
+	}
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+	cmd.Process.Release()
+
+	return path + " (once the agent exits)", nil
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"github.com/ttpreport/ligolo-mp-agent/internal/sysproxy"
+	"github.com/ttpreport/ligolo-mp-agent/internal/transport"
+)
+
+// minMTU is the MTU below which tunnelled packets risk being fragmented or dropped on the way to the server
+const minMTU = 1400
+
+// selfTest is how the agent was set to reach the server, kept for diagnostics
+var selfTest struct {
+	sync.Mutex
+	servers        []string
+	proxyServer    string
+	ignoreEnvProxy bool
+	certs          [][]byte // PEM, the agent's certificate and the CA
+	dial           func(server string) (net.Conn, error)
+}
+
+func setSelfTest(servers []string, proxyServer string, ignoreEnvProxy bool, certs [][]byte, dial func(server string) (net.Conn, error)) {
+	selfTest.Lock()
+	defer selfTest.Unlock()
+
+	selfTest.servers = servers
+	selfTest.proxyServer = proxyServer
+	selfTest.ignoreEnvProxy = ignoreEnvProxy
+	selfTest.certs = certs
+	selfTest.dial = dial
+}
+
+// handleDiagnostics runs every check, one failing doesn't stop the others
+func handleDiagnostics() protocol.DiagnosticsResponsePacket {
+	selfTest.Lock()
+	servers, proxyServer, ignoreEnvProxy, certs, dial := selfTest.servers, selfTest.proxyServer, selfTest.ignoreEnvProxy, selfTest.certs, selfTest.dial
+	selfTest.Unlock()
+
+	var checks []protocol.DiagnosticCheck
+	checks = append(checks, checkClock(certs)...)
+	checks = append(checks, checkMTU())
+
+	for _, server := range servers {
+		serverTransport, address, err := transport.Parse(server)
+		if err != nil {
+			checks = append(checks, protocol.DiagnosticCheck{Name: "server", Target: server, Detail: err.Error()})
+			continue
+		}
+
+		hostPort, _ := transport.SplitPath(address)
+		if host, _, err := net.SplitHostPort(hostPort); err == nil && serverTransport.Name() != "smb" {
+			checks = append(checks, checkResolve(host))
+		}
+
+		checks = append(checks, checkProxy(hostPort, proxyServer, ignoreEnvProxy)...)
+		checks = append(checks, checkServer(server, dial))
+	}
+
+	return protocol.DiagnosticsResponsePacket{Checks: checks}
+}
+
+func checkServer(server string, dial func(string) (net.Conn, error)) protocol.DiagnosticCheck {
+	check := protocol.DiagnosticCheck{Name: "server", Target: server}
+	if dial == nil {
+		check.Detail = "not set up yet"
+		return check
+	}
+
+	start := time.Now()
+	err := reachServer(server, dial)
+	check.Duration = int64(time.Since(start))
+
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+
+	check.OK = true
+	check.Detail = fmt.Sprintf("handshake through %s", egressPath())
+	return check
+}
+
+// reachServer connects to the server the way the agent does, TLS handshake included. The server sees a session
+// that goes away right after
+func reachServer(server string, dial func(string) (net.Conn, error)) error {
+	conn, err := dial(server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// crypto/tls or utls when the agent mimics a browser
+	if tlsConn, ok := conn.(interface{ Handshake() error }); ok {
+		conn.SetDeadline(time.Now().Add(10 * time.Second))
+		return tlsConn.Handshake()
+	}
+
+	return nil
+}
+
+func checkResolve(host string) protocol.DiagnosticCheck {
+	check := protocol.DiagnosticCheck{Name: "dns", Target: host}
+	if net.ParseIP(host) != nil {
+		check.OK, check.Detail = true, "address, nothing to resolve"
+		return check
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	check.Duration = int64(time.Since(start))
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+
+	check.OK, check.Detail = true, strings.Join(addresses, ", ")
+	return check
+}
+
+// checkProxy connects to the proxy the agent was built with, or to the ones the system is set up with for the
+// address unless it was built to ignore them
+func checkProxy(address string, proxyServer string, ignoreEnvProxy bool) []protocol.DiagnosticCheck {
+	var proxies []*url.URL
+	switch {
+	case proxyServer != "":
+		u, err := url.Parse(proxyServer)
+		if err != nil {
+			return []protocol.DiagnosticCheck{{Name: "proxy", Target: proxyServer, Detail: err.Error()}}
+		}
+		proxies = append(proxies, u)
+	case !ignoreEnvProxy:
+		for _, candidate := range sysproxy.For(address) {
+			if candidate.URL != nil {
+				proxies = append(proxies, candidate.URL)
+			}
+		}
+	}
+
+	var checks []protocol.DiagnosticCheck
+	for _, proxy := range proxies {
+		check := protocol.DiagnosticCheck{Name: "proxy", Target: proxy.Redacted()}
+
+		start := time.Now()
+		conn, err := net.DialTimeout("tcp", proxy.Host, 10*time.Second)
+		check.Duration = int64(time.Since(start))
+		if err != nil {
+			check.Detail = err.Error()
+		} else {
+			conn.Close()
+			check.OK, check.Detail = true, fmt.Sprintf("reachable, for %s", address)
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+// checkClock tells whether the local clock falls within the validity of the agent's certificates. The agent
+// copes with an expired server certificate, the server doesn't with an agent certificate its own clock rejects
+func checkClock(certs [][]byte) []protocol.DiagnosticCheck {
+	now := time.Now()
+
+	var checks []protocol.DiagnosticCheck
+	for _, data := range certs {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			checks = append(checks, protocol.DiagnosticCheck{Name: "clock", Detail: err.Error()})
+			continue
+		}
+
+		check := protocol.DiagnosticCheck{Name: "clock", Target: cert.Subject.CommonName, OK: true}
+		switch {
+		case now.Before(cert.NotBefore):
+			check.OK, check.Detail = false, fmt.Sprintf("local clock %s is before the certificate is valid from %s", now.UTC().Format(time.RFC3339), cert.NotBefore.UTC().Format(time.RFC3339))
+		case now.After(cert.NotAfter):
+			check.OK, check.Detail = false, fmt.Sprintf("local clock %s is past the certificate expiry %s", now.UTC().Format(time.RFC3339), cert.NotAfter.UTC().Format(time.RFC3339))
+		default:
+			check.Detail = fmt.Sprintf("local clock %s, certificate valid until %s", now.UTC().Format(time.RFC3339), cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+// checkMTU finds the interface of the current connection to the server
+func checkMTU() protocol.DiagnosticCheck {
+	check := protocol.DiagnosticCheck{Name: "mtu"}
+
+	upstream.Lock()
+	multiplex := upstream.multiplex
+	upstream.Unlock()
+
+	if multiplex == nil {
+		check.Detail = "not connected"
+		return check
+	}
+
+	local, ok := multiplex.LocalAddr().(*net.TCPAddr)
+	if !ok {
+		check.OK, check.Detail = true, fmt.Sprintf("connected over %s, not IP", multiplex.LocalAddr().Network())
+		return check
+	}
+	check.Target = local.IP.String()
+
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+
+	for _, iface := range ifaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+
+		for _, addr := range addrs {
+			if network, ok := addr.(*net.IPNet); ok && network.IP.Equal(local.IP) {
+				check.Target = fmt.Sprintf("%s (%s)", iface.Name, local.IP)
+				check.OK = iface.MTU >= minMTU
+				check.Detail = fmt.Sprintf("%d", iface.MTU)
+				if !check.OK {
+					check.Detail += fmt.Sprintf(", below %d: large packets may be fragmented or dropped", minMTU)
+				}
+				return check
+			}
+		}
+	}
+
+	check.Detail = "no interface has the address, it may be translated"
+	return check
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/sysproxy"
+	"github.com/ttpreport/ligolo-mp-agent/internal/transport"
+	"golang.org/x/net/proxy"
+)
+
+// egress is how the agent reached the server of the current session, reported along with the agent info
+var egress struct {
+	sync.Mutex
+	transport string
+	path      string
+}
+
+func setEgress(transport string, path string) {
+	egress.Lock()
+	defer egress.Unlock()
+
+	egress.transport = transport
+	egress.path = path
+}
+
+func egressTransport() string {
+	egress.Lock()
+	defer egress.Unlock()
+
+	return egress.transport
+}
+
+func egressPath() string {
+	egress.Lock()
+	defer egress.Unlock()
+
+	return egress.path
+}
+
+// dialDetected connects to the server through the proxies the system is set up with, trying each in turn
+func dialDetected(serverTransport transport.Transport, dialer *net.Dialer, address string, config *tls.Config) (net.Conn, error) {
+	err := errors.New("no way to reach the server")
+	hostPort, _ := transport.SplitPath(address)
+	for _, candidate := range sysproxy.For(hostPort) {
+		var serverDialer transport.Dialer = dialer
+		if candidate.URL != nil {
+			if serverDialer, err = proxy.FromURL(candidate.URL, dialer); err != nil {
+				continue
+			}
+		}
+
+		var conn net.Conn
+		if conn, err = serverTransport.Dial(serverDialer, address, config); err == nil {
+			setEgress(serverTransport.Name(), candidate.String())
+			return conn, nil
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,95 @@
+//go:build exec
+// +build exec
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// execEnabled is reported to the server, this file is only compiled into agents built with command execution
+const execEnabled = true
+
+// execStream sends what a command writes to the server as it comes, stdout and stderr share the stream
+type execStream struct {
+	sync.Mutex
+	encoder *protocol.LigoloEncoder
+}
+
+func (stream *execStream) send(envelope protocol.Envelope) error {
+	stream.Lock()
+	defer stream.Unlock()
+
+	return stream.encoder.Encode(envelope)
+}
+
+type execWriter struct {
+	stream *execStream
+	stderr bool
+}
+
+func (w execWriter) Write(p []byte) (int, error) {
+	err := w.stream.send(protocol.Envelope{
+		Type:    protocol.MessageExecOutput,
+		Payload: protocol.ExecOutputPacket{Stderr: w.stderr, Data: p},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// handleExec runs a command until it exits, times out or the server closes the stream
+func handleExec(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.ExecRequestPacket) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if request.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(request.Timeout))
+		defer cancel()
+	}
+
+	go func() {
+		io.Copy(io.Discard, conn)
+		cancel()
+	}()
+
+	stream := &execStream{encoder: encoder}
+
+	cmd := shellCommand(ctx, request.Command)
+	cmd.Stdout = execWriter{stream: stream}
+	cmd.Stderr = execWriter{stream: stream, stderr: true}
+	cmd.WaitDelay = time.Second // children keeping the output open don't hold the command
+
+	exit := protocol.ExecExitPacket{ExitCode: -1}
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		exit.ExitCode = 0
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		exit.Err, exit.ErrString = true, fmt.Sprintf("killed after %s", time.Duration(request.Timeout))
+	case ctx.Err() != nil:
+		return // the server is gone
+	case errors.As(err, &exitErr) && exitErr.Exited():
+		exit.ExitCode = int32(exitErr.ExitCode())
+	default:
+		exit.Err, exit.ErrString = true, err.Error()
+	}
+
+	stream.send(protocol.Envelope{
+		Type:    protocol.MessageExecExit,
+		Payload: exit,
+	})
+}
@@ -0,0 +1,24 @@
+//go:build !exec
+// +build !exec
+
+package main
+
+import (
+	"net"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// execEnabled is reported to the server, command execution has to be asked for when the agent is built
+const execEnabled = false
+
+func handleExec(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.ExecRequestPacket) {
+	encoder.Encode(protocol.Envelope{
+		Type: protocol.MessageExecExit,
+		Payload: protocol.ExecExitPacket{
+			ExitCode:  -1,
+			Err:       true,
+			ErrString: "command execution was not built into this agent",
+		},
+	})
+}
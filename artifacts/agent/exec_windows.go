@@ -0,0 +1,21 @@
+//go:build exec && windows
+// +build exec,windows
+
+package main
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+)
+
+// shellCommand hands the command line to cmd.exe as is, Go's argument quoting would mangle its own
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "cmd.exe")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CmdLine:    "/c " + command,
+		HideWindow: true,
+	}
+
+	return cmd
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// listFiles lists a directory, or a single file along with the directory it's in, the path gets resolved
+// against the working directory
+func listFiles(request protocol.FileListRequestPacket) protocol.FileListResponsePacket {
+	path := request.Path
+	if path == "" {
+		path = "."
+	}
+
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return protocol.FileListResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return protocol.FileListResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	if !info.IsDir() {
+		return protocol.FileListResponsePacket{Path: filepath.Dir(path), Entries: []protocol.FileEntry{fileEntry(info)}}
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return protocol.FileListResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	response := protocol.FileListResponsePacket{Path: path}
+	for _, entry := range entries {
+		// entries can vanish or be unreadable, they are listed anyway so the operator knows they exist
+		info, err := entry.Info()
+		if err != nil {
+			response.Entries = append(response.Entries, protocol.FileEntry{Name: entry.Name(), IsDir: entry.IsDir()})
+			continue
+		}
+		response.Entries = append(response.Entries, fileEntry(info))
+	}
+
+	return response
+}
+
+func fileEntry(info os.FileInfo) protocol.FileEntry {
+	return protocol.FileEntry{
+		Name:    info.Name(),
+		Size:    info.Size(),
+		Mode:    uint32(info.Mode()),
+		ModTime: info.ModTime().Unix(),
+		IsDir:   info.IsDir(),
+	}
+}
+
+// fileHash is sent once a transfer is over, so the server can tell whether both ends have the same content
+func fileHash(path string) protocol.FileHashPacket {
+	f, err := os.Open(path)
+	if err != nil {
+		return protocol.FileHashPacket{Err: true, ErrString: err.Error()}
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return protocol.FileHashPacket{Err: true, ErrString: err.Error()}
+	}
+
+	return protocol.FileHashPacket{Size: size, Sha256: hex.EncodeToString(hash.Sum(nil))}
+}
+
+func openDownload(request protocol.FileDownloadRequestPacket) (*os.File, protocol.FileTransferResponsePacket) {
+	f, err := os.Open(request.Path)
+	if err != nil {
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	if info.IsDir() {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: fmt.Sprintf("%s is a directory", request.Path)}
+	}
+
+	if request.Offset < 0 || request.Offset > info.Size() {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: fmt.Sprintf("offset %d is outside of the file (%d bytes)", request.Offset, info.Size())}
+	}
+
+	if _, err := f.Seek(request.Offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	return f, protocol.FileTransferResponsePacket{Size: info.Size() - request.Offset, Total: info.Size()}
+}
+
+// handleFileDownload sends the file from the requested offset, the stream gets closed if it can't be read
+// entirely since the server expects exactly the announced amount of bytes
+func handleFileDownload(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.FileDownloadRequestPacket) {
+	f, response := openDownload(request)
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageFileTransferResponse,
+		Payload: response,
+	}); err != nil || response.Err {
+		if f != nil {
+			f.Close()
+		}
+		return
+	}
+
+	_, err := io.CopyN(conn, f, response.Size)
+	f.Close()
+	if err != nil {
+		return
+	}
+
+	encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageFileHash,
+		Payload: fileHash(request.Path),
+	})
+}
+
+func openUpload(request protocol.FileUploadRequestPacket) (*os.File, protocol.FileTransferResponsePacket) {
+	if request.Offset < 0 || request.Size < 0 {
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: "invalid transfer size"}
+	}
+
+	mode := os.FileMode(request.Mode).Perm()
+	if mode == 0 {
+		mode = 0644
+	}
+
+	f, err := os.OpenFile(request.Path, os.O_WRONLY|os.O_CREATE, mode)
+	if err != nil {
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	if request.Offset > info.Size() {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: fmt.Sprintf("offset %d is past the end of the file (%d bytes)", request.Offset, info.Size())}
+	}
+
+	if err := f.Truncate(request.Offset); err != nil {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	if _, err := f.Seek(request.Offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, protocol.FileTransferResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	return f, protocol.FileTransferResponsePacket{Size: request.Size, Total: request.Offset + request.Size}
+}
+
+// handleFileUpload writes what the server sends after the acknowledgment, an interrupted upload leaves
+// the received part on disk so it can be resumed
+func handleFileUpload(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.FileUploadRequestPacket) {
+	f, response := openUpload(request)
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageFileTransferResponse,
+		Payload: response,
+	}); err != nil || response.Err {
+		if f != nil {
+			f.Close()
+		}
+		return
+	}
+
+	_, err := io.CopyN(f, conn, request.Size)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+
+	encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageFileHash,
+		Payload: fileHash(request.Path),
+	})
+}
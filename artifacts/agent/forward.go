@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// upstream is the current connection to the server, forwarding redirectors open their streams on it
+var upstream struct {
+	sync.Mutex
+	multiplex *yamux.Session
+	encoding  uint8
+}
+
+func setUpstream(multiplex *yamux.Session) {
+	upstream.Lock()
+	defer upstream.Unlock()
+
+	upstream.multiplex = multiplex
+	upstream.encoding = protocol.EncodingGob
+}
+
+// dropUpstream closes the current connection to the server, the agent reconnects as if it was lost
+func dropUpstream() {
+	upstream.Lock()
+	defer upstream.Unlock()
+
+	if upstream.multiplex != nil {
+		upstream.multiplex.Close()
+	}
+}
+
+func setUpstreamEncoding(encoding uint8) {
+	upstream.Lock()
+	defer upstream.Unlock()
+
+	upstream.encoding = encoding
+}
+
+// forwardDialer hands the connections of a redirector to the server, which reaches the destination through
+// another session, so the agent itself never has to
+type forwardDialer struct {
+	redirectorID string
+}
+
+// openUpstream opens a stream to the server, for the agent to speak first
+func openUpstream() (net.Conn, protocol.LigoloEncoder, error) {
+	upstream.Lock()
+	multiplex, encoding := upstream.multiplex, upstream.encoding
+	upstream.Unlock()
+
+	if multiplex == nil || multiplex.IsClosed() {
+		return nil, protocol.LigoloEncoder{}, errors.New("not connected to the server")
+	}
+
+	stream, err := multiplex.Open()
+	if err != nil {
+		return nil, protocol.LigoloEncoder{}, err
+	}
+
+	encoder := protocol.NewEncoder(stream)
+	encoder.SetEncoding(encoding)
+
+	return stream, encoder, nil
+}
+
+func (d forwardDialer) Dial(network string, address string) (net.Conn, error) {
+	stream, encoder, err := openUpstream()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageForwardRequest,
+		Payload: protocol.ForwardRequestPacket{RedirectorID: d.redirectorID},
+	}); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	decoder := protocol.NewDecoder(stream)
+	if err := decoder.Decode(); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	response, ok := decoder.Envelope.Payload.(protocol.ForwardResponsePacket)
+	if !ok || !response.Established {
+		stream.Close()
+		return nil, errors.New("server could not reach the destination")
+	}
+
+	return stream, nil
+}
@@ -5,11 +5,26 @@ go 1.20
 require (
 	github.com/go-ping/ping v1.2.0
 	github.com/hashicorp/yamux v0.1.2
+	github.com/klauspost/compress v1.17.4
+	github.com/quic-go/quic-go v0.40.1
+	github.com/refraction-networking/utls v1.6.3
 	golang.org/x/net v0.35.0
+	golang.org/x/sys v0.30.0
+	google.golang.org/protobuf v1.33.0
 )
 
 require (
+	github.com/andybalholm/brotli v1.0.6 // indirect
+	github.com/cloudflare/circl v1.3.7 // indirect
+	github.com/go-task/slim-sprig v0.0.0-20230315185526-52ccab3ef572 // indirect
+	github.com/google/pprof v0.0.0-20210407192527-94a9f03dee38 // indirect
 	github.com/google/uuid v1.6.0 // indirect
+	github.com/onsi/ginkgo/v2 v2.9.5 // indirect
+	github.com/quic-go/qtls-go1-20 v0.4.1 // indirect
+	go.uber.org/mock v0.3.0 // indirect
+	golang.org/x/crypto v0.33.0 // indirect
+	golang.org/x/exp v0.0.0-20221205204356-47842c84f3db // indirect
+	golang.org/x/mod v0.11.0 // indirect
 	golang.org/x/sync v0.11.0 // indirect
-	golang.org/x/sys v0.30.0 // indirect
+	golang.org/x/tools v0.9.1 // indirect
 )
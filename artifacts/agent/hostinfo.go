@@ -0,0 +1,54 @@
+//go:build hostinfo
+// +build hostinfo
+
+package main
+
+import (
+	"fmt"
+	"os/user"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/ifaceinfo"
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// collectHostInfo describes the target, each part that fails is reported without failing the rest
+func collectHostInfo() *protocol.HostInfo {
+	info := &protocol.HostInfo{}
+
+	if current, err := user.Current(); err == nil {
+		info.Username = current.Username
+	} else {
+		info.Errors = append(info.Errors, fmt.Sprintf("user: %s", err))
+	}
+
+	hostDetails(info)
+
+	if err := targetDialer.Do(func() error {
+		routes, err := ifaceinfo.Routes()
+		if err != nil {
+			return err
+		}
+
+		for _, route := range routes {
+			if route.Gateway != "" && (route.Destination == "0.0.0.0/0" || route.Destination == "::/0") {
+				info.Gateways = appendUnique(info.Gateways, route.Gateway)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("gateways: %s", err))
+	}
+
+	return info
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, existing := range list {
+		if existing == value {
+			return list
+		}
+	}
+
+	return append(list, value)
+}
@@ -0,0 +1,13 @@
+//go:build !hostinfo
+// +build !hostinfo
+
+package main
+
+import (
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// collectHostInfo reports nothing, host information collection was left out of the agent for OPSEC
+func collectHostInfo() *protocol.HostInfo {
+	return nil
+}
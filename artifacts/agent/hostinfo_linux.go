@@ -0,0 +1,76 @@
+//go:build hostinfo && linux
+// +build hostinfo,linux
+
+package main
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// notableCapabilities are the capabilities worth knowing about when moving on from the host, by number
+var notableCapabilities = map[uint]string{
+	0:  "cap_chown",
+	1:  "cap_dac_override",
+	2:  "cap_dac_read_search",
+	3:  "cap_fowner",
+	6:  "cap_setgid",
+	7:  "cap_setuid",
+	12: "cap_net_admin",
+	13: "cap_net_raw",
+	16: "cap_sys_module",
+	19: "cap_sys_ptrace",
+	21: "cap_sys_admin",
+	39: "cap_bpf",
+}
+
+// distribution is the PRETTY_NAME of /etc/os-release, empty if there's none
+func distribution() string {
+	data, err := os.ReadFile("/etc/os-release")
+	if err != nil {
+		return ""
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if value, found := strings.CutPrefix(line, "PRETTY_NAME="); found {
+			return strings.Trim(value, `"'`)
+		}
+	}
+
+	return ""
+}
+
+// capabilities lists the notable capabilities in the effective set of the agent
+func capabilities() ([]string, error) {
+	file, err := os.Open("/proc/self/status")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		value, found := strings.CutPrefix(scanner.Text(), "CapEff:")
+		if !found {
+			continue
+		}
+
+		effective, err := strconv.ParseUint(strings.TrimSpace(value), 16, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for bit := uint(0); bit < 64; bit++ {
+			if name, ok := notableCapabilities[bit]; ok && effective&(1<<bit) != 0 {
+				names = append(names, name)
+			}
+		}
+
+		return names, nil
+	}
+
+	return nil, scanner.Err()
+}
@@ -0,0 +1,14 @@
+//go:build hostinfo && !linux && !windows
+// +build hostinfo,!linux,!windows
+
+package main
+
+// distribution is left empty, uname tells the system apart well enough on this platform
+func distribution() string {
+	return ""
+}
+
+// capabilities has nothing to report, privileges come down to being root on this platform
+func capabilities() ([]string, error) {
+	return nil, nil
+}
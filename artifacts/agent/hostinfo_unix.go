@@ -0,0 +1,93 @@
+//go:build hostinfo && !windows
+// +build hostinfo,!windows
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"golang.org/x/sys/unix"
+)
+
+func hostDetails(info *protocol.HostInfo) {
+	info.OS = distribution()
+	var uname unix.Utsname
+	if err := unix.Uname(&uname); err == nil {
+		info.OS = strings.TrimSpace(fmt.Sprintf("%s %s %s", info.OS, unix.ByteSliceToString(uname.Sysname[:]), unix.ByteSliceToString(uname.Release[:])))
+	} else {
+		info.Errors = append(info.Errors, fmt.Sprintf("os: %s", err))
+	}
+
+	info.Privileged = os.Geteuid() == 0
+
+	privileges, err := capabilities()
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("privileges: %s", err))
+	}
+	info.Privileges = privileges
+
+	servers, err := resolvConf()
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("dns servers: %s", err))
+	}
+	info.DNSServers = servers
+
+	realm, err := kerberosRealm()
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("domain: %s", err))
+	}
+	info.Domain = realm
+}
+
+// resolvConf lists the nameservers of /etc/resolv.conf, a missing file means no DNS server
+func resolvConf() ([]string, error) {
+	var servers []string
+	err := scanConfig("/etc/resolv.conf", func(fields []string) {
+		if len(fields) > 1 && fields[0] == "nameserver" {
+			servers = append(servers, fields[1])
+		}
+	})
+
+	return servers, err
+}
+
+// kerberosRealm is the default realm of /etc/krb5.conf, which hosts joined to a domain are configured with
+func kerberosRealm() (string, error) {
+	var realm string
+	err := scanConfig("/etc/krb5.conf", func(fields []string) {
+		if realm == "" && len(fields) > 2 && fields[0] == "default_realm" && fields[1] == "=" {
+			realm = fields[2]
+		}
+	})
+
+	return realm, err
+}
+
+// scanConfig calls f with the fields of each line of a configuration file, comments left out
+func scanConfig(path string, f func(fields []string)) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexAny(line, "#;"); i >= 0 {
+			line = line[:i]
+		}
+		if fields := strings.Fields(strings.Replace(line, "=", " = ", 1)); len(fields) > 0 {
+			f(fields)
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,99 @@
+//go:build hostinfo && windows
+// +build hostinfo,windows
+
+package main
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/ifaceinfo"
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"golang.org/x/sys/windows"
+)
+
+// notablePrivileges are the token privileges worth knowing about when moving on from the host
+var notablePrivileges = []string{
+	"SeDebugPrivilege",
+	"SeImpersonatePrivilege",
+	"SeAssignPrimaryTokenPrivilege",
+	"SeBackupPrivilege",
+	"SeRestorePrivilege",
+	"SeTakeOwnershipPrivilege",
+	"SeLoadDriverPrivilege",
+	"SeTcbPrivilege",
+	"SeCreateTokenPrivilege",
+	"SeManageVolumePrivilege",
+	"SeSecurityPrivilege",
+}
+
+func hostDetails(info *protocol.HostInfo) {
+	version := windows.RtlGetVersion()
+	edition := "Windows"
+	if version.ProductType != 1 { // VER_NT_WORKSTATION
+		edition = "Windows Server"
+	}
+	info.OS = fmt.Sprintf("%s %d.%d.%d", edition, version.MajorVersion, version.MinorVersion, version.BuildNumber)
+
+	token := windows.GetCurrentProcessToken()
+	info.Privileged = token.IsElevated()
+
+	privileges, err := tokenPrivileges(token)
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("privileges: %s", err))
+	}
+	info.Privileges = privileges
+
+	var name *uint16
+	var joinType uint32
+	if err := windows.NetGetJoinInformation(nil, &name, &joinType); err == nil {
+		if joinType == windows.NetSetupDomainName {
+			info.Domain = windows.UTF16PtrToString(name)
+		}
+		windows.NetApiBufferFree((*byte)(unsafe.Pointer(name)))
+	} else {
+		info.Errors = append(info.Errors, fmt.Sprintf("domain: %s", err))
+	}
+
+	details, err := ifaceinfo.Collect()
+	if err != nil {
+		info.Errors = append(info.Errors, fmt.Sprintf("dns servers: %s", err))
+	}
+	for _, iface := range details {
+		for _, server := range iface.DNSServers {
+			info.DNSServers = appendUnique(info.DNSServers, server)
+		}
+	}
+}
+
+// tokenPrivileges lists the notable privileges the token holds, enabled or not since the agent can enable them
+func tokenPrivileges(token windows.Token) ([]string, error) {
+	var size uint32
+	windows.GetTokenInformation(token, windows.TokenPrivileges, nil, 0, &size)
+	if size == 0 {
+		return nil, fmt.Errorf("token privileges have no size")
+	}
+
+	buf := make([]byte, size)
+	if err := windows.GetTokenInformation(token, windows.TokenPrivileges, &buf[0], size, &size); err != nil {
+		return nil, err
+	}
+	held := (*windows.Tokenprivileges)(unsafe.Pointer(&buf[0])).AllPrivileges()
+
+	var names []string
+	for _, name := range notablePrivileges {
+		var luid windows.LUID
+		if err := windows.LookupPrivilegeValue(nil, windows.StringToUTF16Ptr(name), &luid); err != nil {
+			continue
+		}
+
+		for _, privilege := range held {
+			if privilege.Luid == luid && privilege.Attributes&windows.SE_PRIVILEGE_REMOVED == 0 {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	return names, nil
+}
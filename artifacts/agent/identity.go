@@ -0,0 +1,101 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// identity is the certificate the agent connects with. It starts as the one it was built with and is only ever
+// replaced in memory, an agent started again from the same executable comes back with the original one
+var identity struct {
+	sync.Mutex
+	cert []byte
+	key  []byte
+	ca   []byte
+}
+
+func setIdentity(cert []byte, key []byte, ca []byte) {
+	identity.Lock()
+	defer identity.Unlock()
+
+	identity.cert = cert
+	identity.key = key
+	identity.ca = ca
+}
+
+func currentIdentity() (tls.Certificate, error) {
+	identity.Lock()
+	defer identity.Unlock()
+
+	return tls.X509KeyPair(identity.cert, identity.key)
+}
+
+// rotateIdentity checks that cert and key belong together and that cert was issued by the CA the agent trusts
+// before it connects with them
+func rotateIdentity(cert []byte, key []byte) error {
+	keyPair, err := tls.X509KeyPair(cert, key)
+	if err != nil {
+		return err
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return err
+	}
+
+	identity.Lock()
+	defer identity.Unlock()
+
+	ca := x509.NewCertPool()
+	if ok := ca.AppendCertsFromPEM(identity.ca); !ok {
+		return errors.New("invalid CA certificate")
+	}
+
+	intermediates := x509.NewCertPool() // certificates issued from an intermediate CA carry it along
+	for _, raw := range keyPair.Certificate[1:] {
+		if intermediate, err := x509.ParseCertificate(raw); err == nil {
+			intermediates.AddCert(intermediate)
+		}
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         ca,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return fmt.Errorf("certificate wasn't issued by our CA: %w", err)
+	}
+
+	identity.cert = cert
+	identity.key = key
+
+	return nil
+}
+
+// handleCertificate swaps the identity and drops the connection once the server knows, so that the agent connects
+// back with the new certificate
+func handleCertificate(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.CertificateRequestPacket) {
+	response := protocol.CertificateResponsePacket{}
+	if err := rotateIdentity(request.Certificate, request.Key); err != nil {
+		response.Err = true
+		response.ErrString = err.Error()
+	}
+
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageCertificateResponse,
+		Payload: response,
+	}); err != nil {
+		return
+	}
+
+	if !response.Err {
+		conn.Close()
+		dropUpstream()
+	}
+}
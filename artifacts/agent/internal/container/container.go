@@ -0,0 +1,13 @@
+package container
+
+// Info describes the container the agent is running in, if any
+type Info struct {
+	Runtime     string // e.g. "docker", "podman", "kubernetes"; empty when not containerized
+	HostNetwork bool   // container shares the network namespace of the host
+	HostNetns   string // path to the host network namespace, when it's reachable from the container
+}
+
+// IsContainer reports whether a container runtime was detected
+func (i Info) IsContainer() bool {
+	return i.Runtime != ""
+}
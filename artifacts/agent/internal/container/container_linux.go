@@ -0,0 +1,106 @@
+//go:build linux
+// +build linux
+
+package container
+
+import (
+	"net"
+	"os"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// hostIfacePrefixes are interface names that only show up in the host network namespace of a container runtime
+var hostIfacePrefixes = []string{"docker", "veth", "cni", "flannel", "cali", "podman", "lxcbr", "virbr"}
+
+// Detect inspects the environment of the current process and reports whether it runs in a container
+func Detect() Info {
+	var info Info
+
+	info.Runtime = runtimeName()
+	if info.Runtime == "" {
+		return info
+	}
+
+	info.HostNetwork = sharesHostNetwork()
+	if !info.HostNetwork {
+		info.HostNetns = hostNetns()
+	}
+
+	return info
+}
+
+func runtimeName() string {
+	if _, err := os.Stat("/.dockerenv"); err == nil {
+		return "docker"
+	}
+
+	if _, err := os.Stat("/run/.containerenv"); err == nil {
+		return "podman"
+	}
+
+	if os.Getenv("KUBERNETES_SERVICE_HOST") != "" {
+		return "kubernetes"
+	}
+
+	if val := os.Getenv("container"); val != "" {
+		return val
+	}
+
+	cgroup, err := os.ReadFile("/proc/1/cgroup")
+	if err != nil {
+		return ""
+	}
+
+	for _, name := range []string{"kubepods", "docker", "containerd", "libpod", "lxc"} {
+		if strings.Contains(string(cgroup), name) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func sharesHostNetwork() bool {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return false
+	}
+
+	for _, iface := range ifaces {
+		for _, prefix := range hostIfacePrefixes {
+			if strings.HasPrefix(iface.Name, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hostNetns returns the network namespace of the host init process, if it's visible (e.g. shared PID namespace) and differs from ours
+func hostNetns() string {
+	const path = "/proc/1/ns/net"
+
+	var self, host unix.Stat_t
+	if err := unix.Stat("/proc/self/ns/net", &self); err != nil {
+		return ""
+	}
+
+	if err := unix.Stat(path, &host); err != nil {
+		return ""
+	}
+
+	if self.Ino == host.Ino && self.Dev == host.Dev {
+		return ""
+	}
+
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return ""
+	}
+	unix.Close(fd)
+
+	return path
+}
@@ -0,0 +1,124 @@
+// Package guardrails keeps agents from running outside of the environment they were built for, every
+// guardrail that is set has to match or the agent exits before reaching out to its servers
+package guardrails
+
+import (
+	"os"
+	"os/user"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// dateLayout is what NotBefore and NotAfter are given in, both days are inclusive and in the target's local time
+const dateLayout = "2006-01-02"
+
+type Guardrails struct {
+	Domain    string // DNS or NetBIOS domain of the target, subdomains match too
+	Hostname  string // regular expression, case insensitive
+	Username  string // with or without the domain part
+	Locale    string // e.g. de-DE, or just de for any region
+	NotBefore string
+	NotAfter  string
+}
+
+// Check reports whether this machine matches every guardrail that is set, anything that can't be determined
+// counts as a mismatch
+func (g Guardrails) Check(now time.Time) bool {
+	return g.checkDomain() && g.checkHostname() && g.checkUsername() && g.checkLocale() && g.checkDate(now)
+}
+
+func (g Guardrails) checkDomain() bool {
+	if g.Domain == "" {
+		return true
+	}
+
+	want := strings.ToLower(strings.Trim(g.Domain, ". "))
+	for _, domain := range domains() {
+		domain = strings.ToLower(strings.Trim(domain, ". "))
+		if domain == want || strings.HasSuffix(domain, "."+want) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g Guardrails) checkHostname() bool {
+	if g.Hostname == "" {
+		return true
+	}
+
+	re, err := regexp.Compile("(?i)" + g.Hostname)
+	if err != nil {
+		return false
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(hostname)
+}
+
+func (g Guardrails) checkUsername() bool {
+	if g.Username == "" {
+		return true
+	}
+
+	current, err := user.Current()
+	if err != nil {
+		return false
+	}
+
+	// DOMAIN\user on windows
+	name := current.Username
+	if !strings.Contains(g.Username, `\`) {
+		if _, short, found := strings.Cut(name, `\`); found {
+			name = short
+		}
+	}
+
+	return strings.EqualFold(name, g.Username)
+}
+
+func (g Guardrails) checkLocale() bool {
+	if g.Locale == "" {
+		return true
+	}
+
+	want := normalizeLocale(g.Locale)
+	current := normalizeLocale(locale())
+	if current == "" {
+		return false
+	}
+
+	return current == want || strings.HasPrefix(current, want+"-")
+}
+
+func (g Guardrails) checkDate(now time.Time) bool {
+	if g.NotBefore != "" {
+		notBefore, err := time.ParseInLocation(dateLayout, g.NotBefore, now.Location())
+		if err != nil || now.Before(notBefore) {
+			return false
+		}
+	}
+
+	if g.NotAfter != "" {
+		notAfter, err := time.ParseInLocation(dateLayout, g.NotAfter, now.Location())
+		if err != nil || !now.Before(notAfter.AddDate(0, 0, 1)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizeLocale turns de_DE.UTF-8 and de-de into de-DE's lowercase form
+func normalizeLocale(locale string) string {
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(locale, "@")
+
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
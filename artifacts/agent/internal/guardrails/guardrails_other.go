@@ -0,0 +1,47 @@
+//go:build !windows
+// +build !windows
+
+package guardrails
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// domains returns the domain part of the hostname and the resolver's search domains
+func domains() []string {
+	var result []string
+
+	if hostname, err := os.Hostname(); err == nil {
+		if _, domain, found := strings.Cut(hostname, "."); found {
+			result = append(result, domain)
+		}
+	}
+
+	f, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return result
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 1 && (fields[0] == "domain" || fields[0] == "search") {
+			result = append(result, fields[1:]...)
+		}
+	}
+
+	return result
+}
+
+func locale() string {
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(env); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,55 @@
+package guardrails
+
+import (
+	"os"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestCheck(t *testing.T) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		t.Skip(err)
+	}
+
+	now := time.Date(2026, 3, 15, 23, 59, 0, 0, time.UTC)
+
+	if !(Guardrails{}).Check(now) {
+		t.Fatal("no guardrails should always match")
+	}
+
+	matching := Guardrails{
+		Hostname:  "^" + regexp.QuoteMeta(hostname) + "$",
+		NotBefore: "2026-03-15",
+		NotAfter:  "2026-03-15",
+	}
+	if !matching.Check(now) {
+		t.Fatalf("%+v should match", matching)
+	}
+
+	for name, g := range map[string]Guardrails{
+		"hostname":    {Hostname: "^" + regexp.QuoteMeta(hostname) + "x$"},
+		"not before":  {NotBefore: "2026-03-16"},
+		"not after":   {NotAfter: "2026-03-14"},
+		"bad date":    {NotAfter: "tomorrow"},
+		"bad pattern": {Hostname: "("},
+	} {
+		if g.Check(now) {
+			t.Errorf("%s guardrail should not match", name)
+		}
+	}
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	for locale, expected := range map[string]string{
+		"de_DE.UTF-8": "de-de",
+		"en-US":       "en-us",
+		"sr_RS@latin": "sr-rs",
+		"":            "",
+	} {
+		if normalized := normalizeLocale(locale); normalized != expected {
+			t.Errorf("%q: expected %q, got %q", locale, expected, normalized)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+//go:build windows
+// +build windows
+
+package guardrails
+
+import (
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var procGetUserDefaultLocaleName = windows.NewLazySystemDLL("kernel32.dll").NewProc("GetUserDefaultLocaleName")
+
+// domains returns the DNS domain the machine is joined to and the logon domains of the user
+func domains() []string {
+	var result []string
+
+	var size uint32 = 256
+	buf := make([]uint16, size)
+	if err := windows.GetComputerNameEx(windows.ComputerNameDnsDomain, &buf[0], &size); err == nil && size > 0 {
+		result = append(result, windows.UTF16ToString(buf[:size]))
+	}
+
+	for _, env := range []string{"USERDNSDOMAIN", "USERDOMAIN"} {
+		if domain := os.Getenv(env); domain != "" {
+			result = append(result, domain)
+		}
+	}
+
+	return result
+}
+
+func locale() string {
+	buf := make([]uint16, 85) // LOCALE_NAME_MAX_LENGTH
+	n, _, _ := procGetUserDefaultLocaleName.Call(uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	if n == 0 {
+		return ""
+	}
+
+	return windows.UTF16ToString(buf)
+}
@@ -0,0 +1,24 @@
+package ifaceinfo
+
+import "net"
+
+// Details holds the interface properties that aren't exposed by the net package
+type Details struct {
+	Gateways   []string
+	DNSServers []string
+}
+
+// interfaceNames maps interface indexes to names, tables only refer to indexes
+func interfaceNames() map[int]string {
+	names := make(map[int]string)
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return names
+	}
+
+	for _, iface := range ifaces {
+		names[iface.Index] = iface.Name
+	}
+
+	return names
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package ifaceinfo
+
+// Collect returns no extra details, as the net package already covers what's needed on this platform
+func Collect() (map[int]Details, error) {
+	return nil, nil
+}
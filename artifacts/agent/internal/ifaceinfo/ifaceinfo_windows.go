@@ -0,0 +1,46 @@
+//go:build windows
+// +build windows
+
+package ifaceinfo
+
+import (
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// Collect queries the IP Helper API for gateways and DNS servers, keyed by interface index
+func Collect() (map[int]Details, error) {
+	size := uint32(15 * 1024)
+	var buf []byte
+	for {
+		buf = make([]byte, size)
+		err := windows.GetAdaptersAddresses(windows.AF_UNSPEC, windows.GAA_FLAG_INCLUDE_GATEWAYS|windows.GAA_FLAG_INCLUDE_PREFIX, 0, (*windows.IpAdapterAddresses)(unsafe.Pointer(&buf[0])), &size)
+		if err == nil {
+			break
+		}
+		if err.(windows.Errno) != windows.ERROR_BUFFER_OVERFLOW {
+			return nil, os.NewSyscallError("getadaptersaddresses", err)
+		}
+	}
+
+	result := make(map[int]Details)
+	for aa := (*windows.IpAdapterAddresses)(unsafe.Pointer(&buf[0])); aa != nil; aa = aa.Next {
+		var details Details
+		for gw := aa.FirstGatewayAddress; gw != nil; gw = gw.Next {
+			details.Gateways = append(details.Gateways, gw.Address.IP().String())
+		}
+		for dns := aa.FirstDnsServerAddress; dns != nil; dns = dns.Next {
+			details.DNSServers = append(details.DNSServers, dns.Address.IP().String())
+		}
+
+		index := int(aa.IfIndex)
+		if index == 0 {
+			index = int(aa.Ipv6IfIndex)
+		}
+		result[index] = details
+	}
+
+	return result, nil
+}
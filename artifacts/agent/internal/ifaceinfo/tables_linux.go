@@ -0,0 +1,153 @@
+//go:build linux
+// +build linux
+
+package ifaceinfo
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"golang.org/x/sys/unix"
+)
+
+var neighborStates = map[uint16]string{
+	unix.NUD_INCOMPLETE: "incomplete",
+	unix.NUD_REACHABLE:  "reachable",
+	unix.NUD_STALE:      "stale",
+	unix.NUD_DELAY:      "delay",
+	unix.NUD_PROBE:      "probe",
+	unix.NUD_FAILED:     "failed",
+	unix.NUD_PERMANENT:  "permanent",
+}
+
+// Neighbors dumps the ARP and NDP tables over netlink, entries without a hardware address are left out
+func Neighbors() ([]protocol.Neighbor, error) {
+	msgs, err := dump(unix.RTM_GETNEIGH)
+	if err != nil {
+		return nil, err
+	}
+
+	names := interfaceNames()
+
+	var result []protocol.Neighbor
+	for _, msg := range msgs {
+		if msg.Header.Type != unix.RTM_NEWNEIGH || len(msg.Data) < unix.SizeofNdMsg {
+			continue
+		}
+
+		ndm := (*unix.NdMsg)(unsafe.Pointer(&msg.Data[0]))
+		if ndm.State&unix.NUD_NOARP != 0 {
+			continue
+		}
+
+		attrs := attributes(msg.Data[unix.SizeofNdMsg:])
+		lladdr := attrs[unix.NDA_LLADDR]
+		if len(attrs[unix.NDA_DST]) == 0 || len(lladdr) == 0 {
+			continue
+		}
+
+		result = append(result, protocol.Neighbor{
+			Address:      net.IP(attrs[unix.NDA_DST]).String(),
+			HardwareAddr: append(net.HardwareAddr(nil), lladdr...),
+			Interface:    names[int(ndm.Ifindex)],
+			State:        neighborStates[ndm.State],
+		})
+	}
+
+	return result, nil
+}
+
+// Routes dumps the main routing table over netlink
+func Routes() ([]protocol.SystemRoute, error) {
+	msgs, err := dump(unix.RTM_GETROUTE)
+	if err != nil {
+		return nil, err
+	}
+
+	names := interfaceNames()
+
+	var result []protocol.SystemRoute
+	for _, msg := range msgs {
+		if msg.Header.Type != unix.RTM_NEWROUTE || len(msg.Data) < unix.SizeofRtMsg {
+			continue
+		}
+
+		rtm := (*unix.RtMsg)(unsafe.Pointer(&msg.Data[0]))
+		attrs := attributes(msg.Data[unix.SizeofRtMsg:])
+
+		table := uint32(rtm.Table)
+		if raw := attrs[unix.RTA_TABLE]; len(raw) == 4 {
+			table = *(*uint32)(unsafe.Pointer(&raw[0]))
+		}
+		if table != unix.RT_TABLE_MAIN || rtm.Type != unix.RTN_UNICAST {
+			continue
+		}
+
+		bits := 32
+		dst := net.IP(net.IPv4zero)
+		if rtm.Family == unix.AF_INET6 {
+			bits = 128
+			dst = net.IPv6zero
+		}
+		if raw := attrs[unix.RTA_DST]; len(raw) > 0 {
+			dst = net.IP(raw)
+		}
+
+		route := protocol.SystemRoute{
+			Destination: (&net.IPNet{IP: dst, Mask: net.CIDRMask(int(rtm.Dst_len), bits)}).String(),
+		}
+		if raw := attrs[unix.RTA_GATEWAY]; len(raw) > 0 {
+			route.Gateway = net.IP(raw).String()
+		}
+		if raw := attrs[unix.RTA_OIF]; len(raw) == 4 {
+			route.Interface = names[int(*(*uint32)(unsafe.Pointer(&raw[0])))]
+		}
+		if raw := attrs[unix.RTA_PRIORITY]; len(raw) == 4 {
+			route.Metric = int(*(*uint32)(unsafe.Pointer(&raw[0])))
+		}
+
+		result = append(result, route)
+	}
+
+	return result, nil
+}
+
+func dump(request int) ([]syscall.NetlinkMessage, error) {
+	rib, err := syscall.NetlinkRIB(request, unix.AF_UNSPEC)
+	if err != nil {
+		return nil, os.NewSyscallError("netlinkrib", err)
+	}
+
+	msgs, err := syscall.ParseNetlinkMessage(rib)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse netlink messages: %w", err)
+	}
+
+	return msgs, nil
+}
+
+// attributes splits netlink attributes by type, values are in host byte order
+func attributes(data []byte) map[uint16][]byte {
+	attrs := make(map[uint16][]byte)
+	for len(data) >= unix.SizeofRtAttr {
+		attr := (*unix.RtAttr)(unsafe.Pointer(&data[0]))
+		length := int(attr.Len)
+		if length < unix.SizeofRtAttr || length > len(data) {
+			break
+		}
+
+		attrs[attr.Type] = data[unix.SizeofRtAttr:length]
+
+		aligned := (length + unix.NLA_ALIGNTO - 1) &^ (unix.NLA_ALIGNTO - 1)
+		if aligned > len(data) {
+			break
+		}
+		data = data[aligned:]
+	}
+
+	return attrs
+}
@@ -0,0 +1,20 @@
+//go:build !linux && !windows
+// +build !linux,!windows
+
+package ifaceinfo
+
+import (
+	"errors"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// Neighbors isn't implemented on this platform
+func Neighbors() ([]protocol.Neighbor, error) {
+	return nil, errors.New("not supported on this platform")
+}
+
+// Routes isn't implemented on this platform
+func Routes() ([]protocol.SystemRoute, error) {
+	return nil, errors.New("not supported on this platform")
+}
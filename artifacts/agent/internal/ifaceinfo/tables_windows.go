@@ -0,0 +1,149 @@
+//go:build windows
+// +build windows
+
+package ifaceinfo
+
+import (
+	"net"
+	"os"
+	"unsafe"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iphlpapi              = windows.NewLazySystemDLL("iphlpapi.dll")
+	procGetIpNetTable     = iphlpapi.NewProc("GetIpNetTable")
+	procGetIpForwardTable = iphlpapi.NewProc("GetIpForwardTable")
+)
+
+// MIB_IPNETROW, addresses are in network byte order
+type mibIPNetRow struct {
+	Index       uint32
+	PhysAddrLen uint32
+	PhysAddr    [8]byte
+	Addr        [4]byte
+	Type        uint32
+}
+
+// MIB_IPFORWARDROW, addresses are in network byte order
+type mibIPForwardRow struct {
+	Dest      [4]byte
+	Mask      [4]byte
+	Policy    uint32
+	NextHop   [4]byte
+	IfIndex   uint32
+	Type      uint32
+	Proto     uint32
+	Age       uint32
+	NextHopAS uint32
+	Metric1   uint32
+	Metric2   uint32
+	Metric3   uint32
+	Metric4   uint32
+	Metric5   uint32
+}
+
+const (
+	mibIPNetTypeInvalid  = 2
+	mibIPRouteTypeDirect = 3
+)
+
+var neighborTypes = map[uint32]string{
+	1: "other",
+	3: "dynamic",
+	4: "static",
+}
+
+// Neighbors reads the ARP table from the IP Helper API, IPv4 only
+func Neighbors() ([]protocol.Neighbor, error) {
+	buf, err := ipTable(procGetIpNetTable)
+	if err != nil {
+		return nil, err
+	}
+
+	names := interfaceNames()
+
+	var result []protocol.Neighbor
+	for _, row := range tableRows[mibIPNetRow](buf) {
+		if row.Type == mibIPNetTypeInvalid || row.PhysAddrLen == 0 || row.PhysAddrLen > uint32(len(row.PhysAddr)) {
+			continue
+		}
+
+		result = append(result, protocol.Neighbor{
+			Address:      net.IP(row.Addr[:]).String(),
+			HardwareAddr: append(net.HardwareAddr(nil), row.PhysAddr[:row.PhysAddrLen]...),
+			Interface:    names[int(row.Index)],
+			State:        neighborTypes[row.Type],
+		})
+	}
+
+	return result, nil
+}
+
+// Routes reads the routing table from the IP Helper API, IPv4 only
+func Routes() ([]protocol.SystemRoute, error) {
+	buf, err := ipTable(procGetIpForwardTable)
+	if err != nil {
+		return nil, err
+	}
+
+	names := interfaceNames()
+
+	var result []protocol.SystemRoute
+	for _, row := range tableRows[mibIPForwardRow](buf) {
+		route := protocol.SystemRoute{
+			Destination: (&net.IPNet{IP: net.IP(row.Dest[:]), Mask: net.IPMask(row.Mask[:])}).String(),
+			Interface:   names[int(row.IfIndex)],
+			Metric:      int(row.Metric1),
+		}
+		if row.Type != mibIPRouteTypeDirect {
+			route.Gateway = net.IP(row.NextHop[:]).String()
+		}
+
+		result = append(result, route)
+	}
+
+	return result, nil
+}
+
+// ipTable calls one of the Get*Table functions, growing the buffer until the table fits
+func ipTable(proc *windows.LazyProc) ([]byte, error) {
+	var size uint32
+	var buf []byte
+	for {
+		var ptr uintptr
+		if size > 0 {
+			buf = make([]byte, size)
+			ptr = uintptr(unsafe.Pointer(&buf[0]))
+		}
+
+		r, _, _ := proc.Call(ptr, uintptr(unsafe.Pointer(&size)), 1)
+		switch windows.Errno(r) {
+		case windows.ERROR_SUCCESS:
+			return buf, nil
+		case windows.ERROR_INSUFFICIENT_BUFFER:
+			continue
+		case windows.ERROR_NO_DATA:
+			return nil, nil
+		default:
+			return nil, os.NewSyscallError(proc.Name, windows.Errno(r))
+		}
+	}
+}
+
+// tableRows reads the rows of a MIB_*TABLE, a DWORD count followed by the rows
+func tableRows[T any](buf []byte) []T {
+	if len(buf) < 4 {
+		return nil
+	}
+
+	var row T
+	count := int(*(*uint32)(unsafe.Pointer(&buf[0])))
+	if count == 0 || 4+count*int(unsafe.Sizeof(row)) > len(buf) {
+		return nil
+	}
+
+	return unsafe.Slice((*T)(unsafe.Pointer(&buf[4])), count)
+}
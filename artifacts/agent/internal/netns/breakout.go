@@ -0,0 +1,91 @@
+package netns
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BreakoutRule sends the connections to a network out of an interface
+type BreakoutRule struct {
+	Network   *net.IPNet
+	Interface string // empty follows the routing table
+}
+
+// Breakout is the table of interfaces connections leave through, by destination. The most specific rule wins,
+// destinations no rule covers follow the routing table. Multi-homed targets often route a network the wrong way
+type Breakout struct {
+	mu    sync.RWMutex
+	rules []BreakoutRule
+}
+
+// Set replaces the table
+func (b *Breakout) Set(rules []BreakoutRule) {
+	sorted := append([]BreakoutRule(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, _ := sorted[i].Network.Mask.Size()
+		b, _ := sorted[j].Network.Mask.Size()
+		return a > b
+	})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.rules = sorted
+}
+
+// Lookup returns the interface a connection to the address leaves through, empty for the routing table, and the
+// destination IP. Host names aren't resolved and always follow the routing table
+func (b *Breakout) Lookup(address string) (string, net.IP) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return "", nil
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, rule := range b.rules {
+		if rule.Network.Contains(ip) {
+			return rule.Interface, ip
+		}
+	}
+
+	return "", ip
+}
+
+// localAddr is an address of the interface in the family of the destination, binding to it has the connection
+// leave through that interface on hosts sending from the interface that owns the source address
+func localAddr(network string, iface string, destination net.IP) (net.Addr, error) {
+	netIface, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := netIface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+
+	v4 := destination.To4() != nil
+	for _, addr := range addrs {
+		ipnet, ok := addr.(*net.IPNet)
+		if !ok || (ipnet.IP.To4() != nil) != v4 || ipnet.IP.IsLinkLocalUnicast() {
+			continue
+		}
+
+		if strings.HasPrefix(network, "udp") {
+			return &net.UDPAddr{IP: ipnet.IP}, nil
+		}
+		return &net.TCPAddr{IP: ipnet.IP}, nil
+	}
+
+	return nil, fmt.Errorf("%s has no address to reach %s from", iface, destination)
+}
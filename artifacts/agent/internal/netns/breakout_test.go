@@ -0,0 +1,32 @@
+package netns
+
+import (
+	"net"
+	"testing"
+)
+
+func TestBreakoutLookup(t *testing.T) {
+	rule := func(cidr string, iface string) BreakoutRule {
+		_, network, _ := net.ParseCIDR(cidr)
+		return BreakoutRule{Network: network, Interface: iface}
+	}
+
+	var breakout Breakout
+	breakout.Set([]BreakoutRule{
+		rule("10.0.0.0/8", "eth1"),
+		rule("10.20.0.0/16", ""),
+		rule("10.20.30.0/24", "eth2"),
+	})
+
+	for address, expected := range map[string]string{
+		"10.1.2.3:445":   "eth1",
+		"10.20.1.1:80":   "",
+		"10.20.30.40:22": "eth2",
+		"192.168.1.1:53": "",
+		"intranet:443":   "",
+	} {
+		if iface, _ := breakout.Lookup(address); iface != expected {
+			t.Errorf("%s: expected '%s', got '%s'", address, expected, iface)
+		}
+	}
+}
@@ -0,0 +1,365 @@
+//go:build linux
+// +build linux
+
+package netns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Dialer performs outbound connections from within a network namespace and/or a VRF
+type Dialer struct {
+	Namespace string
+	VRF       string
+	Breakout  Breakout
+}
+
+// Path resolves the namespace reference to a nsfs path. Accepts a named namespace (ip netns), a PID or a full path
+func (d *Dialer) Path() string {
+	if d.Namespace == "" {
+		return ""
+	}
+
+	if strings.ContainsRune(d.Namespace, filepath.Separator) {
+		return d.Namespace
+	}
+
+	if _, err := strconv.Atoi(d.Namespace); err == nil {
+		return fmt.Sprintf("/proc/%s/ns/net", d.Namespace)
+	}
+
+	return filepath.Join("/var/run/netns", d.Namespace)
+}
+
+// Do runs f with the calling thread switched to the configured network namespace
+func (d *Dialer) Do(f func() error) error {
+	if d.Namespace == "" {
+		return f()
+	}
+
+	runtime.LockOSThread()
+
+	origin, err := unix.Open(fmt.Sprintf("/proc/self/task/%d/ns/net", unix.Gettid()), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return err
+	}
+	defer unix.Close(origin)
+
+	target, err := unix.Open(d.Path(), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		runtime.UnlockOSThread()
+		return err
+	}
+	defer unix.Close(target)
+
+	if err := unix.Setns(target, unix.CLONE_NEWNET); err != nil {
+		runtime.UnlockOSThread()
+		return err
+	}
+
+	result := f()
+
+	// if we can't switch back, the thread stays locked and gets discarded when the goroutine exits
+	if err := unix.Setns(origin, unix.CLONE_NEWNET); err == nil {
+		runtime.UnlockOSThread()
+	}
+
+	return result
+}
+
+// DialContext connects to the address on the named network from within the namespace/VRF
+func (d *Dialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	return d.dial(ctx, network, "", address)
+}
+
+// DialFromContext connects to the address using a foreign source IP. Requires CAP_NET_ADMIN for IP_TRANSPARENT
+func (d *Dialer) DialFromContext(ctx context.Context, network string, source string, address string) (net.Conn, error) {
+	return d.dial(ctx, network, source, address)
+}
+
+func (d *Dialer) dial(ctx context.Context, network string, source string, address string) (net.Conn, error) {
+	dialer := net.Dialer{}
+
+	var sourceIP net.IP
+	if source != "" {
+		sourceIP = net.ParseIP(source)
+		if sourceIP == nil {
+			return nil, fmt.Errorf("invalid source address: %s", source)
+		}
+
+		if strings.HasPrefix(network, "tcp") {
+			dialer.LocalAddr = &net.TCPAddr{IP: sourceIP}
+		} else {
+			dialer.LocalAddr = &net.UDPAddr{IP: sourceIP}
+		}
+	}
+
+	// breakout interfaces take over from the VRF, binding to them needs CAP_NET_RAW like binding to a VRF does
+	device := d.VRF
+	if iface, _ := d.Breakout.Lookup(address); iface != "" {
+		device = iface
+	}
+
+	// the dialer resolves and races addresses on goroutines of its own, they'd run outside of the namespace
+	if d.Namespace != "" {
+		return d.dialNamespace(ctx, network, sourceIP, address)
+	}
+
+	if device != "" || sourceIP != nil {
+		dialer.Control = func(_, _ string, c syscall.RawConn) error {
+			var sockErr error
+			if err := c.Control(func(fd uintptr) {
+				sockErr = setsockopts(int(fd), device, sourceIP)
+			}); err != nil {
+				return err
+			}
+			return sockErr
+		}
+	}
+
+	return dialer.DialContext(ctx, network, address)
+}
+
+func setsockopts(fd int, device string, sourceIP net.IP) error {
+	if device != "" {
+		if err := unix.SetsockoptString(fd, unix.SOL_SOCKET, unix.SO_BINDTODEVICE, device); err != nil {
+			return err
+		}
+	}
+
+	if sourceIP != nil {
+		if sourceIP.To4() != nil {
+			return unix.SetsockoptInt(fd, unix.SOL_IP, unix.IP_TRANSPARENT, 1)
+		}
+		return unix.SetsockoptInt(fd, unix.SOL_IPV6, unix.IPV6_TRANSPARENT, 1)
+	}
+
+	return nil
+}
+
+// resolver looks names up with the DNS servers of the host, queries leave from within the namespace/VRF
+func (d *Dialer) resolver() *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network string, address string) (net.Conn, error) {
+			return d.dialNamespace(ctx, network, nil, address)
+		},
+	}
+}
+
+// dialNamespace tries the addresses the host resolves to in turn, each socket is created on the thread switched to
+// the namespace and only then handed to the runtime
+func (d *Dialer) dialNamespace(ctx context.Context, network string, sourceIP net.IP, address string) (net.Conn, error) {
+	host, portName, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := d.resolver().LookupPort(ctx, network, portName)
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		addrs, err := d.resolver().LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+
+	lastErr := fmt.Errorf("no suitable address found for %s", address)
+	for _, ip := range ips {
+		if (strings.HasSuffix(network, "4") && ip.To4() == nil) || (strings.HasSuffix(network, "6") && ip.To4() != nil) {
+			continue
+		}
+
+		conn, err := d.connect(ctx, network, sourceIP, ip, port)
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, lastErr
+}
+
+func (d *Dialer) connect(ctx context.Context, network string, sourceIP net.IP, ip net.IP, port int) (net.Conn, error) {
+	sotype := unix.SOCK_STREAM
+	if strings.HasPrefix(network, "udp") {
+		sotype = unix.SOCK_DGRAM
+	}
+
+	var sa unix.Sockaddr
+	family := unix.AF_INET6
+	if ip4 := ip.To4(); ip4 != nil {
+		family = unix.AF_INET
+		sa4 := &unix.SockaddrInet4{Port: port}
+		copy(sa4.Addr[:], ip4)
+		sa = sa4
+	} else {
+		sa6 := &unix.SockaddrInet6{Port: port}
+		copy(sa6.Addr[:], ip.To16())
+		sa = sa6
+	}
+
+	device := d.VRF
+	if iface, _ := d.Breakout.Lookup(ip.String()); iface != "" {
+		device = iface
+	}
+
+	fd := -1
+	err := d.Do(func() error {
+		var err error
+		if fd, err = unix.Socket(family, sotype|unix.SOCK_NONBLOCK|unix.SOCK_CLOEXEC, 0); err != nil {
+			return os.NewSyscallError("socket", err)
+		}
+
+		if err := setsockopts(fd, device, sourceIP); err != nil {
+			return err
+		}
+
+		if sourceIP != nil {
+			var local unix.Sockaddr
+			if family == unix.AF_INET {
+				local4 := &unix.SockaddrInet4{}
+				copy(local4.Addr[:], sourceIP.To4())
+				local = local4
+			} else {
+				local6 := &unix.SockaddrInet6{}
+				copy(local6.Addr[:], sourceIP.To16())
+				local = local6
+			}
+			if err := unix.Bind(fd, local); err != nil {
+				return os.NewSyscallError("bind", err)
+			}
+		}
+
+		// the connection completes on its own, the namespace of a socket is the one it was created in
+		if err := unix.Connect(fd, sa); err != nil && !errors.Is(err, unix.EINPROGRESS) {
+			return os.NewSyscallError("connect", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if fd >= 0 {
+			unix.Close(fd)
+		}
+		return nil, err
+	}
+
+	file := os.NewFile(uintptr(fd), "")
+	defer file.Close()
+
+	if err := waitConnected(ctx, file); err != nil {
+		return nil, err
+	}
+
+	conn, err := net.FileConn(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		tcp.SetKeepAlive(true)
+	}
+
+	return conn, nil
+}
+
+// waitConnected blocks until the non-blocking connect of file finished or ctx is done
+func waitConnected(ctx context.Context, file *os.File) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		file.SetWriteDeadline(deadline)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			file.SetWriteDeadline(time.Unix(1, 0))
+		case <-done:
+		}
+	}()
+
+	raw, err := file.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	// called again each time the socket turns writable, it's connected once it has a peer
+	var connectErr error
+	if err := raw.Write(func(fd uintptr) bool {
+		errno, err := unix.GetsockoptInt(int(fd), unix.SOL_SOCKET, unix.SO_ERROR)
+		if err != nil {
+			connectErr = os.NewSyscallError("getsockopt", err)
+			return true
+		}
+		if errno != 0 {
+			connectErr = os.NewSyscallError("connect", unix.Errno(errno))
+			return true
+		}
+
+		_, err = unix.Getpeername(int(fd))
+		return err == nil
+	}); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+
+	return connectErr
+}
+
+// Listen opens a listener from within the namespace, the socket is created on the thread switched to it
+func (d *Dialer) Listen(network string, address string) (net.Listener, error) {
+	var lis net.Listener
+	err := d.Do(func() error {
+		var err error
+		lis, err = net.Listen(network, address)
+		return err
+	})
+
+	return lis, err
+}
+
+// Dial connects to the address on the named network from within the namespace/VRF
+func (d *Dialer) Dial(network string, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// Interfaces returns the network interfaces visible from within the namespace
+func (d *Dialer) Interfaces() ([]net.Interface, error) {
+	var ifaces []net.Interface
+	err := d.Do(func() error {
+		var err error
+		ifaces, err = net.Interfaces()
+		return err
+	})
+
+	return ifaces, err
+}
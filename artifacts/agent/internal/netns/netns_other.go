@@ -0,0 +1,74 @@
+//go:build !linux
+// +build !linux
+
+package netns
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+var errUnsupported = errors.New("network namespaces and VRFs are only supported on linux")
+
+// Dialer performs outbound connections from within a network namespace and/or a VRF
+type Dialer struct {
+	Namespace string
+	VRF       string
+	Breakout  Breakout
+}
+
+// Do runs f, namespaces can't be switched on this platform
+func (d *Dialer) Do(f func() error) error {
+	if d.Namespace != "" {
+		return errUnsupported
+	}
+
+	return f()
+}
+
+// DialContext connects to the address on the named network
+func (d *Dialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	if d.Namespace != "" || d.VRF != "" {
+		return nil, errUnsupported
+	}
+
+	var dialer net.Dialer
+	if iface, ip := d.Breakout.Lookup(address); iface != "" {
+		local, err := localAddr(network, iface, ip)
+		if err != nil {
+			return nil, err
+		}
+		dialer.LocalAddr = local
+	}
+
+	return dialer.DialContext(ctx, network, address)
+}
+
+// DialFromContext connects to the address using a foreign source IP, which isn't supported on this platform
+func (d *Dialer) DialFromContext(ctx context.Context, network string, source string, address string) (net.Conn, error) {
+	return nil, errors.New("source address spoofing is only supported on linux")
+}
+
+// Dial connects to the address on the named network
+func (d *Dialer) Dial(network string, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+// Listen opens a listener on the host, namespaces can't be switched on this platform
+func (d *Dialer) Listen(network string, address string) (net.Listener, error) {
+	if d.Namespace != "" {
+		return nil, errUnsupported
+	}
+
+	return net.Listen(network, address)
+}
+
+// Interfaces returns the network interfaces of the host
+func (d *Dialer) Interfaces() ([]net.Interface, error) {
+	if d.Namespace != "" {
+		return nil, errUnsupported
+	}
+
+	return net.Interfaces()
+}
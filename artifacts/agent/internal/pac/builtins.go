@@ -0,0 +1,181 @@
+package pac
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type builtin func(env Env, args []interface{}) (interface{}, error)
+
+var builtins map[string]builtin
+
+func init() {
+	builtins = map[string]builtin{
+		"isPlainHostName": func(_ Env, args []interface{}) (interface{}, error) {
+			return !strings.Contains(argString(args, 0), "."), nil
+		},
+		"dnsDomainIs": func(_ Env, args []interface{}) (interface{}, error) {
+			return strings.HasSuffix(strings.ToLower(argString(args, 0)), strings.ToLower(argString(args, 1))), nil
+		},
+		"localHostOrDomainIs": func(_ Env, args []interface{}) (interface{}, error) {
+			host, hostdom := strings.ToLower(argString(args, 0)), strings.ToLower(argString(args, 1))
+			if strings.Contains(host, ".") {
+				return host == hostdom, nil
+			}
+			return host == hostdom || strings.HasPrefix(hostdom, host+"."), nil
+		},
+		"isResolvable": func(env Env, args []interface{}) (interface{}, error) {
+			return resolveHost(env, argString(args, 0)) != nil, nil
+		},
+		"dnsResolve": func(env Env, args []interface{}) (interface{}, error) {
+			if ip := resolveHost(env, argString(args, 0)); ip != nil {
+				return ip.String(), nil
+			}
+			return nil, nil
+		},
+		"myIpAddress": func(env Env, _ []interface{}) (interface{}, error) {
+			return env.LocalIP().String(), nil
+		},
+		"isInNet": isInNet,
+		"dnsDomainLevels": func(_ Env, args []interface{}) (interface{}, error) {
+			return float64(strings.Count(argString(args, 0), ".")), nil
+		},
+		"shExpMatch": func(_ Env, args []interface{}) (interface{}, error) {
+			return ShExpMatch(argString(args, 0), argString(args, 1)), nil
+		},
+		"weekdayRange": weekdayRange,
+		"timeRange":    timeRange,
+		"alert": func(_ Env, _ []interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	}
+
+	// Microsoft's extensions list every address, one is enough for what scripts do with them
+	builtins["isResolvableEx"] = builtins["isResolvable"]
+	builtins["dnsResolveEx"] = builtins["dnsResolve"]
+	builtins["myIpAddressEx"] = builtins["myIpAddress"]
+}
+
+func resolveHost(env Env, host string) net.IP {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip
+	}
+
+	ip, err := env.Resolve(host)
+	if err != nil {
+		return nil
+	}
+	return ip
+}
+
+func isInNet(env Env, args []interface{}) (interface{}, error) {
+	ip := resolveHost(env, argString(args, 0)).To4()
+	pattern := net.ParseIP(argString(args, 1)).To4()
+	mask := net.ParseIP(argString(args, 2)).To4()
+	if ip == nil || pattern == nil || mask == nil {
+		return false, nil
+	}
+
+	for i := range ip {
+		if ip[i]&mask[i] != pattern[i]&mask[i] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ShExpMatch matches shell expressions, where * is any run of characters and ? any single one
+func ShExpMatch(str, shexp string) bool {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for _, r := range shexp {
+		switch r {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return false
+	}
+	return re.MatchString(str)
+}
+
+var dayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// clock splits a trailing "GMT" argument off and returns the current time in the zone it asks for
+func clock(env Env, args []interface{}) (time.Time, []interface{}) {
+	now := env.Now()
+	if len(args) > 0 && strings.EqualFold(toString(args[len(args)-1]), "GMT") {
+		return now.UTC(), args[:len(args)-1]
+	}
+	return now, args
+}
+
+func weekdayRange(env Env, args []interface{}) (interface{}, error) {
+	now, args := clock(env, args)
+	if len(args) == 0 || len(args) > 2 {
+		return nil, fmt.Errorf("weekdayRange takes one or two days")
+	}
+
+	var days []int
+	for _, arg := range args {
+		day := -1
+		for i, name := range dayNames {
+			if strings.EqualFold(toString(arg), name) {
+				day = i
+			}
+		}
+		if day < 0 {
+			return false, nil
+		}
+		days = append(days, day)
+	}
+	if len(days) == 1 {
+		days = append(days, days[0])
+	}
+
+	return inRange(int(now.Weekday()), days[0], days[1]), nil
+}
+
+// timeRange supports the hour, hour and minute, and hour, minute and second forms, ranges include both ends
+func timeRange(env Env, args []interface{}) (interface{}, error) {
+	now, args := clock(env, args)
+	var values []int
+	for _, arg := range args {
+		values = append(values, int(toNumber(arg)))
+	}
+
+	hour, minute, second := now.Clock()
+	switch len(values) {
+	case 1:
+		return hour == values[0], nil
+	case 2:
+		return inRange(hour, values[0], values[1]), nil
+	case 4:
+		current := hour*60 + minute
+		return inRange(current, values[0]*60+values[1], values[2]*60+values[3]), nil
+	case 6:
+		current := hour*3600 + minute*60 + second
+		return inRange(current, values[0]*3600+values[1]*60+values[2], values[3]*3600+values[4]*60+values[5]), nil
+	}
+
+	return nil, fmt.Errorf("timeRange takes 1, 2, 4 or 6 numbers")
+}
+
+// inRange tells whether value is within [from, to], wrapping around when to comes before from
+func inRange(value, from, to int) bool {
+	if from <= to {
+		return value >= from && value <= to
+	}
+	return value >= from || value <= to
+}
@@ -0,0 +1,379 @@
+package pac
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxDepth bounds nested function calls, so a recursive script can't take the agent down
+const maxDepth = 64
+
+type scope struct {
+	vars   map[string]interface{}
+	parent *scope
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{vars: make(map[string]interface{}), parent: parent}
+}
+
+func (sc *scope) lookup(name string) (*scope, bool) {
+	for s := sc; s != nil; s = s.parent {
+		if _, ok := s.vars[name]; ok {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+type interp struct {
+	script *Script
+	global *scope
+	depth  int
+}
+
+// exec runs a statement, the bool tells whether it returned
+func (in *interp) exec(s stmt, sc *scope) (interface{}, bool, error) {
+	switch s := s.(type) {
+	case *block:
+		for _, child := range s.list {
+			value, returned, err := in.exec(child, sc)
+			if err != nil || returned {
+				return value, returned, err
+			}
+		}
+	case *varDecl:
+		for i, name := range s.names {
+			var value interface{}
+			if s.values[i] != nil {
+				var err error
+				if value, err = in.eval(s.values[i], sc); err != nil {
+					return nil, false, err
+				}
+			}
+			sc.vars[name] = value
+		}
+	case *ifStmt:
+		cond, err := in.eval(s.cond, sc)
+		if err != nil {
+			return nil, false, err
+		}
+		if truthy(cond) {
+			return in.exec(s.then, sc)
+		} else if s.els != nil {
+			return in.exec(s.els, sc)
+		}
+	case *returnStmt:
+		if s.value == nil {
+			return nil, true, nil
+		}
+		value, err := in.eval(s.value, sc)
+		return value, true, err
+	case *exprStmt:
+		_, err := in.eval(s.x, sc)
+		return nil, false, err
+	case *funcDecl:
+		return nil, false, fmt.Errorf("nested function %s is not supported", s.name)
+	}
+
+	return nil, false, nil
+}
+
+func (in *interp) eval(x expr, sc *scope) (interface{}, error) {
+	switch x := x.(type) {
+	case *literal:
+		return x.value, nil
+	case *ident:
+		if owner, ok := sc.lookup(x.name); ok {
+			return owner.vars[x.name], nil
+		}
+		return nil, fmt.Errorf("%s is not defined", x.name)
+	case *assign:
+		value, err := in.eval(x.value, sc)
+		if err != nil {
+			return nil, err
+		}
+		owner, ok := sc.lookup(x.name)
+		if !ok {
+			owner = in.global
+		}
+		owner.vars[x.name] = value
+		return value, nil
+	case *unary:
+		value, err := in.eval(x.x, sc)
+		if err != nil {
+			return nil, err
+		}
+		if x.op == "!" {
+			return !truthy(value), nil
+		}
+		return -toNumber(value), nil
+	case *binary:
+		return in.binary(x, sc)
+	case *conditional:
+		cond, err := in.eval(x.cond, sc)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(cond) {
+			return in.eval(x.then, sc)
+		}
+		return in.eval(x.els, sc)
+	case *member:
+		value, err := in.eval(x.x, sc)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := value.(string); ok && x.name == "length" {
+			return float64(len(s)), nil
+		}
+		return nil, fmt.Errorf("property %s is not supported", x.name)
+	case *call:
+		return in.call(x, sc)
+	}
+
+	return nil, fmt.Errorf("unexpected expression %T", x)
+}
+
+func (in *interp) binary(x *binary, sc *scope) (interface{}, error) {
+	left, err := in.eval(x.x, sc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x.op {
+	case "&&":
+		if !truthy(left) {
+			return left, nil
+		}
+		return in.eval(x.y, sc)
+	case "||":
+		if truthy(left) {
+			return left, nil
+		}
+		return in.eval(x.y, sc)
+	}
+
+	right, err := in.eval(x.y, sc)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x.op {
+	case "==":
+		return looseEqual(left, right), nil
+	case "!=":
+		return !looseEqual(left, right), nil
+	case "===":
+		return left == right, nil
+	case "!==":
+		return left != right, nil
+	case "+":
+		ls, lok := left.(string)
+		rs, rok := right.(string)
+		if lok || rok {
+			if !lok {
+				ls = toString(left)
+			}
+			if !rok {
+				rs = toString(right)
+			}
+			return ls + rs, nil
+		}
+		return toNumber(left) + toNumber(right), nil
+	case "-":
+		return toNumber(left) - toNumber(right), nil
+	}
+
+	var cmp int
+	ls, lok := left.(string)
+	rs, rok := right.(string)
+	if lok && rok {
+		cmp = strings.Compare(ls, rs)
+	} else {
+		l, r := toNumber(left), toNumber(right)
+		if math.IsNaN(l) || math.IsNaN(r) {
+			return false, nil
+		}
+		switch {
+		case l < r:
+			cmp = -1
+		case l > r:
+			cmp = 1
+		}
+	}
+
+	switch x.op {
+	case "<":
+		return cmp < 0, nil
+	case ">":
+		return cmp > 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+func (in *interp) call(x *call, sc *scope) (interface{}, error) {
+	args := make([]interface{}, len(x.args))
+	for i, arg := range x.args {
+		value, err := in.eval(arg, sc)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = value
+	}
+
+	switch fn := x.fn.(type) {
+	case *ident:
+		if decl, ok := in.script.funcs[fn.name]; ok {
+			return in.invoke(decl, args)
+		}
+		if builtin, ok := builtins[fn.name]; ok {
+			return builtin(in.script.env, args)
+		}
+		return nil, fmt.Errorf("%s is not a function", fn.name)
+	case *member:
+		value, err := in.eval(fn.x, sc)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("method %s is only supported on strings", fn.name)
+		}
+		return stringMethod(s, fn.name, args)
+	}
+
+	return nil, fmt.Errorf("expression is not a function")
+}
+
+func (in *interp) invoke(decl *funcDecl, args []interface{}) (interface{}, error) {
+	if in.depth >= maxDepth {
+		return nil, fmt.Errorf("%s: too many nested calls", decl.name)
+	}
+	in.depth++
+	defer func() { in.depth-- }()
+
+	sc := newScope(in.global)
+	for i, param := range decl.params {
+		var value interface{}
+		if i < len(args) {
+			value = args[i]
+		}
+		sc.vars[param] = value
+	}
+
+	value, _, err := in.exec(decl.body, sc)
+	return value, err
+}
+
+func stringMethod(s, name string, args []interface{}) (interface{}, error) {
+	switch name {
+	case "toLowerCase":
+		return strings.ToLower(s), nil
+	case "toUpperCase":
+		return strings.ToUpper(s), nil
+	case "indexOf":
+		return float64(strings.Index(s, argString(args, 0))), nil
+	case "substring":
+		start := clampIndex(argNumber(args, 0), len(s))
+		end := len(s)
+		if len(args) > 1 {
+			end = clampIndex(argNumber(args, 1), len(s))
+		}
+		if start > end {
+			start, end = end, start
+		}
+		return s[start:end], nil
+	}
+
+	return nil, fmt.Errorf("method %s is not supported", name)
+}
+
+func clampIndex(f float64, length int) int {
+	if math.IsNaN(f) || f < 0 {
+		return 0
+	}
+	if f > float64(length) {
+		return length
+	}
+	return int(f)
+}
+
+func truthy(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0 && !math.IsNaN(v)
+	case string:
+		return v != ""
+	}
+	return false
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return "null"
+}
+
+func toNumber(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	case string:
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return 0
+		}
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+		return math.NaN()
+	}
+	return 0
+}
+
+func looseEqual(left, right interface{}) bool {
+	if left == nil || right == nil {
+		return left == right
+	}
+
+	ls, lok := left.(string)
+	rs, rok := right.(string)
+	if lok && rok {
+		return ls == rs
+	}
+
+	return toNumber(left) == toNumber(right)
+}
+
+func argString(args []interface{}, i int) string {
+	if i >= len(args) {
+		return "undefined"
+	}
+	return toString(args[i])
+}
+
+func argNumber(args []interface{}, i int) float64 {
+	if i >= len(args) {
+		return math.NaN()
+	}
+	return toNumber(args[i])
+}
@@ -0,0 +1,135 @@
+package pac
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenPunct
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{} // decoded strings and numbers
+	line  int
+}
+
+func (t token) is(kind tokenKind, text string) bool {
+	return t.kind == kind && t.text == text
+}
+
+// punctuators, longest first so they're matched greedily
+var punctuators = []string{
+	"===", "!==",
+	"==", "!=", "&&", "||", "<=", ">=",
+	"(", ")", "{", "}", "[", "]", ";", ",", ".", "!", "=", "+", "-", "<", ">", "?", ":",
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	line := 1
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			value, n, err := readString(src[i:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: src[i : i+n], value: value, line: line})
+			i += n
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && (src[j] >= '0' && src[j] <= '9' || src[j] == '.') {
+				j++
+			}
+			value, err := strconv.ParseFloat(src[i:j], 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid number %s", line, src[i:j])
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: src[i:j], value: value, line: line})
+			i = j
+		case c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			j := i
+			for j < len(src) && (src[j] == '_' || src[j] == '$' || src[j] >= 'a' && src[j] <= 'z' || src[j] >= 'A' && src[j] <= 'Z' || src[j] >= '0' && src[j] <= '9') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: src[i:j], line: line})
+			i = j
+		default:
+			matched := false
+			for _, p := range punctuators {
+				if strings.HasPrefix(src[i:], p) {
+					tokens = append(tokens, token{kind: tokenPunct, text: p, line: line})
+					i += len(p)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, c)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line}), nil
+}
+
+// readString decodes a quoted string at the start of src and returns how many bytes it took
+func readString(src string) (string, int, error) {
+	quote := src[0]
+	var b strings.Builder
+	for i := 1; i < len(src); i++ {
+		switch c := src[i]; c {
+		case quote:
+			return b.String(), i + 1, nil
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated string")
+		case '\\':
+			i++
+			if i >= len(src) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			switch e := src[i]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			default:
+				b.WriteByte(e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string")
+}
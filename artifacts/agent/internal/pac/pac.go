@@ -0,0 +1,143 @@
+// Package pac evaluates proxy auto-config files. It is not a JavaScript engine, it covers the subset PAC files
+// are written in: functions, variables, if/else, the usual operators, a few string methods and the PAC builtins
+package pac
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Env is what the builtins look at, zero fields use the system
+type Env struct {
+	Resolve func(host string) (net.IP, error)
+	LocalIP func() net.IP
+	Now     func() time.Time
+}
+
+type Script struct {
+	funcs   map[string]*funcDecl
+	globals []stmt
+	env     Env
+}
+
+func Parse(src string) (*Script, error) {
+	return ParseWithEnv(src, Env{})
+}
+
+func ParseWithEnv(src string, env Env) (*Script, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	funcs, globals, err := p.program()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := funcs["FindProxyForURL"]; !ok {
+		return nil, fmt.Errorf("FindProxyForURL is not defined")
+	}
+
+	if env.Resolve == nil {
+		env.Resolve = resolve
+	}
+	if env.LocalIP == nil {
+		env.LocalIP = localIP
+	}
+	if env.Now == nil {
+		env.Now = time.Now
+	}
+
+	return &Script{funcs: funcs, globals: globals, env: env}, nil
+}
+
+// FindProxyForURL runs the script for a URL and returns what it came up with, e.g. "PROXY proxy:8080; DIRECT"
+func (s *Script) FindProxyForURL(rawURL, host string) (string, error) {
+	in := &interp{script: s, global: newScope(nil)}
+	for _, global := range s.globals {
+		if _, _, err := in.exec(global, in.global); err != nil {
+			return "", err
+		}
+	}
+
+	result, err := in.invoke(s.funcs["FindProxyForURL"], []interface{}{rawURL, host})
+	if err != nil {
+		return "", err
+	}
+
+	str, ok := result.(string)
+	if !ok {
+		return "", fmt.Errorf("FindProxyForURL returned %s instead of a string", toString(result))
+	}
+
+	return str, nil
+}
+
+// ParseResult turns what FindProxyForURL returned into proxy URLs in the order they should be tried, DIRECT is a
+// nil URL. Kinds of proxies that can't be used are left out
+func ParseResult(result string) []*url.URL {
+	var proxies []*url.URL
+	for _, entry := range strings.Split(result, ";") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if strings.EqualFold(fields[0], "DIRECT") {
+			proxies = append(proxies, nil)
+			continue
+		}
+		if len(fields) != 2 {
+			continue
+		}
+
+		var scheme string
+		switch strings.ToUpper(fields[0]) {
+		case "PROXY", "HTTP":
+			scheme = "http"
+		case "HTTPS":
+			scheme = "https"
+		case "SOCKS", "SOCKS5":
+			scheme = "socks5"
+		default:
+			continue
+		}
+
+		proxies = append(proxies, &url.URL{Scheme: scheme, Host: fields[1]})
+	}
+
+	return proxies
+}
+
+func resolve(host string) (net.IP, error) {
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ip := range ips {
+		if ip.To4() != nil {
+			return ip, nil
+		}
+	}
+	if len(ips) > 0 {
+		return ips[0], nil
+	}
+
+	return nil, fmt.Errorf("%s has no addresses", host)
+}
+
+// localIP is the address outbound traffic leaves from, connecting a UDP socket sends nothing
+func localIP() net.IP {
+	if conn, err := net.Dial("udp4", "198.51.100.1:53"); err == nil {
+		defer conn.Close()
+		return conn.LocalAddr().(*net.UDPAddr).IP
+	}
+
+	return net.IPv4(127, 0, 0, 1)
+}
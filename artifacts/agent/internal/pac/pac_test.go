@@ -0,0 +1,108 @@
+package pac
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+const testScript = `
+/* corporate proxies */
+var primary = "PROXY proxy.corp.local:8080";
+var fallback = "SOCKS5 10.0.0.2:1080";
+
+function isInternal(host) {
+	return isPlainHostName(host) || dnsDomainIs(host, ".corp.local") || isInNet(dnsResolve(host), "10.0.0.0", "255.0.0.0");
+}
+
+function FindProxyForURL(url, host) {
+	host = host.toLowerCase();
+	if (isInternal(host))
+		return "DIRECT";
+	else if (shExpMatch(url, "https://*.example.com:*/*") && weekdayRange("MON", "FRI"))
+		return primary + "; " + fallback + "; DIRECT";
+	if (url.substring(0, 5) == "http:" && host.indexOf("cdn") !== -1) {
+		return timeRange(8, 17) ? "HTTPS secure.corp.local:443" : 'DIRECT';
+	}
+	return primary;
+}
+`
+
+func TestFindProxyForURL(t *testing.T) {
+	script, err := ParseWithEnv(testScript, Env{
+		Resolve: func(host string) (net.IP, error) {
+			if host == "intranet.example.com" {
+				return net.ParseIP("10.1.2.3"), nil
+			}
+			return nil, fmt.Errorf("%s not found", host)
+		},
+		Now: func() time.Time {
+			return time.Date(2026, 3, 16, 9, 30, 0, 0, time.UTC) // monday
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct{ url, host, expected string }{
+		{"https://fileserver:443/", "fileserver", "DIRECT"},
+		{"https://DC01.corp.local:443/", "DC01.corp.local", "DIRECT"},
+		{"https://intranet.example.com:443/", "intranet.example.com", "DIRECT"},
+		{"https://c2.example.com:443/", "c2.example.com", "PROXY proxy.corp.local:8080; SOCKS5 10.0.0.2:1080; DIRECT"},
+		{"http://cdn.example.org/", "cdn.example.org", "HTTPS secure.corp.local:443"},
+		{"https://c2.example.org:443/", "c2.example.org", "PROXY proxy.corp.local:8080"},
+	} {
+		result, err := script.FindProxyForURL(c.url, c.host)
+		if err != nil {
+			t.Fatalf("%s: %s", c.url, err)
+		}
+		if result != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.url, c.expected, result)
+		}
+	}
+}
+
+func TestScriptErrors(t *testing.T) {
+	for name, src := range map[string]string{
+		"no entrypoint": `function foo() { return "DIRECT"; }`,
+		"unterminated":  `function FindProxyForURL(url, host) { return "DIRECT;`,
+		"loop":          `function FindProxyForURL(url, host) { while (true) {} }`,
+	} {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("%s: expected a parse error", name)
+		}
+	}
+
+	for name, src := range map[string]string{
+		"recursion": `function FindProxyForURL(url, host) { return FindProxyForURL(url, host); }`,
+		"undefined": `function FindProxyForURL(url, host) { return unknown(host); }`,
+		"no string": `function FindProxyForURL(url, host) { return 1; }`,
+	} {
+		script, err := Parse(src)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if _, err := script.FindProxyForURL("https://c2:443/", "c2"); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParseResult(t *testing.T) {
+	proxies := ParseResult("PROXY a:3128;  SOCKS b:1080; SOCKS4 c:1080; HTTPS d:443; DIRECT")
+	expected := []string{"http://a:3128", "socks5://b:1080", "https://d:443", "direct"}
+	if len(proxies) != len(expected) {
+		t.Fatalf("expected %d proxies, got %d", len(expected), len(proxies))
+	}
+
+	for i, proxy := range proxies {
+		got := "direct"
+		if proxy != nil {
+			got = proxy.String()
+		}
+		if got != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], got)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+package pac
+
+import "fmt"
+
+type expr interface{}
+
+type stmt interface{}
+
+type (
+	literal struct {
+		value interface{}
+	}
+	ident struct {
+		name string
+	}
+	unary struct {
+		op string
+		x  expr
+	}
+	binary struct {
+		op   string
+		x, y expr
+	}
+	conditional struct {
+		cond, then, els expr
+	}
+	assign struct {
+		name  string
+		value expr
+	}
+	call struct {
+		fn   expr
+		args []expr
+	}
+	member struct {
+		x    expr
+		name string
+	}
+)
+
+type (
+	block struct {
+		list []stmt
+	}
+	varDecl struct {
+		names  []string
+		values []expr // nil where there's no initializer
+	}
+	ifStmt struct {
+		cond      expr
+		then, els stmt
+	}
+	returnStmt struct {
+		value expr // nil returns undefined
+	}
+	exprStmt struct {
+		x expr
+	}
+	funcDecl struct {
+		name   string
+		params []string
+		body   *block
+	}
+)
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(kind tokenKind, text string) bool {
+	if p.peek().is(kind, text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if t := p.next(); !t.is(tokenPunct, text) {
+		return p.errorf(t, "expected %s", text)
+	}
+	return nil
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	found := t.text
+	if t.kind == tokenEOF {
+		found = "end of file"
+	}
+	return fmt.Errorf("line %d: %s, found %s", t.line, fmt.Sprintf(format, args...), found)
+}
+
+// program parses the whole file, functions are hoisted and everything else runs before FindProxyForURL
+func (p *parser) program() (map[string]*funcDecl, []stmt, error) {
+	funcs := make(map[string]*funcDecl)
+	var globals []stmt
+	for p.peek().kind != tokenEOF {
+		s, err := p.statement()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if fn, ok := s.(*funcDecl); ok {
+			funcs[fn.name] = fn
+		} else {
+			globals = append(globals, s)
+		}
+	}
+
+	return funcs, globals, nil
+}
+
+func (p *parser) statement() (stmt, error) {
+	t := p.peek()
+	switch {
+	case t.is(tokenIdent, "function"):
+		return p.function()
+	case t.is(tokenPunct, "{"):
+		return p.block()
+	case t.is(tokenPunct, ";"):
+		p.next()
+		return &block{}, nil
+	case t.is(tokenIdent, "var"), t.is(tokenIdent, "let"), t.is(tokenIdent, "const"):
+		p.next()
+		decl := &varDecl{}
+		for {
+			name := p.next()
+			if name.kind != tokenIdent {
+				return nil, p.errorf(name, "expected variable name")
+			}
+			decl.names = append(decl.names, name.text)
+
+			var value expr
+			if p.accept(tokenPunct, "=") {
+				var err error
+				if value, err = p.expression(); err != nil {
+					return nil, err
+				}
+			}
+			decl.values = append(decl.values, value)
+
+			if !p.accept(tokenPunct, ",") {
+				break
+			}
+		}
+		p.accept(tokenPunct, ";")
+		return decl, nil
+	case t.is(tokenIdent, "if"):
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		cond, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		then, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+
+		s := &ifStmt{cond: cond, then: then}
+		if p.accept(tokenIdent, "else") {
+			if s.els, err = p.statement(); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	case t.is(tokenIdent, "return"):
+		p.next()
+		s := &returnStmt{}
+		if !p.peek().is(tokenPunct, ";") && !p.peek().is(tokenPunct, "}") {
+			var err error
+			if s.value, err = p.expression(); err != nil {
+				return nil, err
+			}
+		}
+		p.accept(tokenPunct, ";")
+		return s, nil
+	case t.kind == tokenIdent && isUnsupported(t.text):
+		return nil, p.errorf(t, "unsupported statement")
+	default:
+		x, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		p.accept(tokenPunct, ";")
+		return &exprStmt{x: x}, nil
+	}
+}
+
+// isUnsupported tells statements this subset doesn't cover, so they fail with a clear error
+func isUnsupported(keyword string) bool {
+	switch keyword {
+	case "for", "while", "do", "switch", "try", "throw", "new", "break", "continue":
+		return true
+	}
+	return false
+}
+
+func (p *parser) function() (*funcDecl, error) {
+	p.next()
+	name := p.next()
+	if name.kind != tokenIdent {
+		return nil, p.errorf(name, "expected function name")
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	fn := &funcDecl{name: name.text}
+	for !p.accept(tokenPunct, ")") {
+		param := p.next()
+		if param.kind != tokenIdent {
+			return nil, p.errorf(param, "expected parameter name")
+		}
+		fn.params = append(fn.params, param.text)
+
+		if !p.peek().is(tokenPunct, ")") {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	body, err := p.block()
+	if err != nil {
+		return nil, err
+	}
+	fn.body = body
+
+	return fn, nil
+}
+
+func (p *parser) block() (*block, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	b := &block{}
+	for !p.accept(tokenPunct, "}") {
+		if p.peek().kind == tokenEOF {
+			return nil, p.errorf(p.peek(), "expected }")
+		}
+
+		s, err := p.statement()
+		if err != nil {
+			return nil, err
+		}
+		b.list = append(b.list, s)
+	}
+
+	return b, nil
+}
+
+func (p *parser) expression() (expr, error) {
+	if p.peek().kind == tokenIdent && p.tokens[p.pos+1].is(tokenPunct, "=") {
+		name := p.next().text
+		p.next()
+		value, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		return &assign{name: name, value: value}, nil
+	}
+
+	cond, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.accept(tokenPunct, "?") {
+		return cond, nil
+	}
+
+	then, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	els, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+
+	return &conditional{cond: cond, then: then, els: els}, nil
+}
+
+// precedence lists binary operators from the loosest binding
+var precedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "===", "!=="},
+	{"<", ">", "<=", ">="},
+	{"+", "-"},
+}
+
+func (p *parser) binary(level int) (expr, error) {
+	if level == len(precedence) {
+		return p.unary()
+	}
+
+	x, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		matched := false
+		for _, op := range precedence[level] {
+			if t.is(tokenPunct, op) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return x, nil
+		}
+
+		p.next()
+		y, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &binary{op: t.text, x: x, y: y}
+	}
+}
+
+func (p *parser) unary() (expr, error) {
+	if t := p.peek(); t.is(tokenPunct, "!") || t.is(tokenPunct, "-") {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unary{op: t.text, x: x}, nil
+	}
+
+	return p.postfix()
+}
+
+func (p *parser) postfix() (expr, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.accept(tokenPunct, "."):
+			name := p.next()
+			if name.kind != tokenIdent {
+				return nil, p.errorf(name, "expected property name")
+			}
+			x = &member{x: x, name: name.text}
+		case p.accept(tokenPunct, "("):
+			c := &call{fn: x}
+			for !p.accept(tokenPunct, ")") {
+				arg, err := p.expression()
+				if err != nil {
+					return nil, err
+				}
+				c.args = append(c.args, arg)
+
+				if !p.peek().is(tokenPunct, ")") {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+			}
+			x = c
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) primary() (expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString, tokenNumber:
+		return &literal{value: t.value}, nil
+	case tokenIdent:
+		switch t.text {
+		case "true":
+			return &literal{value: true}, nil
+		case "false":
+			return &literal{value: false}, nil
+		case "null", "undefined":
+			return &literal{value: nil}, nil
+		}
+		if isUnsupported(t.text) || t.text == "function" {
+			return nil, p.errorf(t, "unsupported expression")
+		}
+		return &ident{name: t.text}, nil
+	case tokenPunct:
+		if t.text == "(" {
+			x, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+
+	return nil, p.errorf(t, "expected expression")
+}
@@ -0,0 +1,85 @@
+package protocol
+
+import (
+	"net"
+
+	"github.com/klauspost/compress/s2"
+)
+
+const (
+	CompressionNone = uint8(iota)
+	CompressionSnappy
+)
+
+// snappyBlockSize is the largest block of the snappy framing format
+const snappyBlockSize = 64 << 10
+
+// SupportedCompressions is advertised by the server in InfoRequestPacket, most preferred first
+var SupportedCompressions = []uint8{CompressionSnappy}
+
+var compressionNames = map[uint8]string{
+	CompressionNone:   "none",
+	CompressionSnappy: "snappy",
+}
+
+// CompressionName names a compression for display, e.g. "snappy"
+func CompressionName(compression uint8) string {
+	if name, ok := compressionNames[compression]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// NegotiateCompression picks the first of our compressions the peer offered, none if there's none in common
+func NegotiateCompression(offered []uint8) uint8 {
+	for _, compression := range SupportedCompressions {
+		for _, candidate := range offered {
+			if compression == candidate {
+				return compression
+			}
+		}
+	}
+
+	return CompressionNone
+}
+
+// IsSupportedCompression reports whether relayed streams can be compressed that way on this side
+func IsSupportedCompression(compression uint8) bool {
+	for _, candidate := range SupportedCompressions {
+		if candidate == compression {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compress wraps a relayed stream once the connect exchange is done, both ends must wrap it the same way. Every
+// write is flushed as it comes so that interactive traffic isn't held back, incompressible data goes through
+// stored as is at the cost of a few bytes of framing
+func Compress(conn net.Conn, compression uint8) net.Conn {
+	if compression != CompressionSnappy {
+		return conn
+	}
+
+	return &compressedConn{
+		Conn:   conn,
+		reader: s2.NewReader(conn, s2.ReaderMaxBlockSize(snappyBlockSize)),
+		writer: s2.NewWriter(conn, s2.WriterSnappyCompat(), s2.WriterFlushOnWrite(), s2.WriterConcurrency(1)),
+	}
+}
+
+type compressedConn struct {
+	net.Conn
+	reader *s2.Reader
+	writer *s2.Writer
+}
+
+func (c *compressedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+func (c *compressedConn) Write(b []byte) (int, error) {
+	return c.writer.Write(b)
+}
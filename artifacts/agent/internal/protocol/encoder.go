@@ -9,7 +9,8 @@ import (
 
 // LigoloEncoder is the structure containing the writer used when encoding Envelopes
 type LigoloEncoder struct {
-	writer io.Writer
+	writer   io.Writer
+	encoding uint8
 }
 
 // NewEncoder encode Ligolo-ng packets
@@ -17,15 +18,31 @@ func NewEncoder(writer io.Writer) LigoloEncoder {
 	return LigoloEncoder{writer: writer}
 }
 
+// SetEncoding switches the payload encoding, gob by default
+func (e *LigoloEncoder) SetEncoding(encoding uint8) {
+	e.encoding = encoding
+}
+
 // Encode encode an Envelope packet and write the result into the writer
 func (e *LigoloEncoder) Encode(envelope Envelope) error {
 	var payload bytes.Buffer
-	encoder := gob.NewEncoder(&payload)
-	if err := encoder.Encode(envelope.Payload); err != nil {
-		return err
+	msgType := envelope.Type
+	switch e.encoding {
+	case EncodingProtobuf:
+		data, err := marshalProtobuf(envelope.Payload)
+		if err != nil {
+			return err
+		}
+		payload.Write(data)
+		msgType |= encodingFlag
+	default:
+		encoder := gob.NewEncoder(&payload)
+		if err := encoder.Encode(envelope.Payload); err != nil {
+			return err
+		}
 	}
 
-	if err := binary.Write(e.writer, binary.LittleEndian, envelope.Type); err != nil {
+	if err := binary.Write(e.writer, binary.LittleEndian, msgType); err != nil {
 		return err
 	}
 	if envelope.Size == 0 {
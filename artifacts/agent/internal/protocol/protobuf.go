@@ -0,0 +1,646 @@
+package protocol
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol/sessionpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Protobuf encoding of the packets goes through the messages generated from session.proto,
+// packets are only copied to and from them so the gob encoding keeps working
+
+// protoMessages are the generated messages each message type is decoded into
+var protoMessages = map[uint8]func() proto.Message{
+	MessageInfoRequest:             func() proto.Message { return &sessionpb.InfoRequest{} },
+	MessageInfoReply:               func() proto.Message { return &sessionpb.InfoReply{} },
+	MessageConnectRequest:          func() proto.Message { return &sessionpb.ConnectRequest{} },
+	MessageConnectResponse:         func() proto.Message { return &sessionpb.ConnectResponse{} },
+	MessageHostPingRequest:         func() proto.Message { return &sessionpb.HostPingRequest{} },
+	MessageHostPingResponse:        func() proto.Message { return &sessionpb.HostPingResponse{} },
+	MessageRedirectorRequest:       func() proto.Message { return &sessionpb.RedirectorRequest{} },
+	MessageRedirectorResponse:      func() proto.Message { return &sessionpb.RedirectorResponse{} },
+	MessageRedirectorCloseRequest:  func() proto.Message { return &sessionpb.RedirectorCloseRequest{} },
+	MessageRedirectorCloseResponse: func() proto.Message { return &sessionpb.RedirectorCloseResponse{} },
+	MessageDisconnectRequest:       func() proto.Message { return &sessionpb.DisconnectRequest{} },
+	MessageDisconnectResponse:      func() proto.Message { return &sessionpb.DisconnectResponse{} },
+	MessageThroughputRequest:       func() proto.Message { return &sessionpb.ThroughputRequest{} },
+	MessageThroughputResponse:      func() proto.Message { return &sessionpb.ThroughputResponse{} },
+	MessageBeaconRequest:           func() proto.Message { return &sessionpb.BeaconRequest{} },
+	MessageBeaconResponse:          func() proto.Message { return &sessionpb.BeaconResponse{} },
+	MessageForwardRequest:          func() proto.Message { return &sessionpb.ForwardRequest{} },
+	MessageForwardResponse:         func() proto.Message { return &sessionpb.ForwardResponse{} },
+	MessageSocksRequest:            func() proto.Message { return &sessionpb.SocksRequest{} },
+	MessageSocksResponse:           func() proto.Message { return &sessionpb.SocksResponse{} },
+	MessageFileListRequest:         func() proto.Message { return &sessionpb.FileListRequest{} },
+	MessageFileListResponse:        func() proto.Message { return &sessionpb.FileListResponse{} },
+	MessageFileDownloadRequest:     func() proto.Message { return &sessionpb.FileDownloadRequest{} },
+	MessageFileUploadRequest:       func() proto.Message { return &sessionpb.FileUploadRequest{} },
+	MessageFileTransferResponse:    func() proto.Message { return &sessionpb.FileTransferResponse{} },
+	MessageFileHash:                func() proto.Message { return &sessionpb.FileHash{} },
+	MessageExecRequest:             func() proto.Message { return &sessionpb.ExecRequest{} },
+	MessageExecOutput:              func() proto.Message { return &sessionpb.ExecOutput{} },
+	MessageExecExit:                func() proto.Message { return &sessionpb.ExecExit{} },
+	MessageUpdateRequest:           func() proto.Message { return &sessionpb.UpdateRequest{} },
+	MessageUpdateResponse:          func() proto.Message { return &sessionpb.UpdateResponse{} },
+	MessageScheduleRequest:         func() proto.Message { return &sessionpb.ScheduleRequest{} },
+	MessageScheduleResponse:        func() proto.Message { return &sessionpb.ScheduleResponse{} },
+	MessageNetworkRequest:          func() proto.Message { return &sessionpb.NetworkRequest{} },
+	MessageNetworkResponse:         func() proto.Message { return &sessionpb.NetworkResponse{} },
+	MessagePersistRequest:          func() proto.Message { return &sessionpb.PersistRequest{} },
+	MessagePersistResponse:         func() proto.Message { return &sessionpb.PersistResponse{} },
+	MessageBurnRequest:             func() proto.Message { return &sessionpb.BurnRequest{} },
+	MessageBurnResponse:            func() proto.Message { return &sessionpb.BurnResponse{} },
+	MessageBreakoutRequest:         func() proto.Message { return &sessionpb.BreakoutRequest{} },
+	MessageBreakoutResponse:        func() proto.Message { return &sessionpb.BreakoutResponse{} },
+	MessageHostInfoRequest:         func() proto.Message { return &sessionpb.HostInfoRequest{} },
+	MessageHostInfoResponse:        func() proto.Message { return &sessionpb.HostInfoResponse{} },
+	MessageCrashReport:             func() proto.Message { return &sessionpb.CrashReport{} },
+	MessageBandwidthRequest:        func() proto.Message { return &sessionpb.BandwidthRequest{} },
+	MessageBandwidthResponse:       func() proto.Message { return &sessionpb.BandwidthResponse{} },
+	MessageSweepRequest:            func() proto.Message { return &sessionpb.SweepRequest{} },
+	MessageSweepResult:             func() proto.Message { return &sessionpb.SweepResult{} },
+	MessageSweepDone:               func() proto.Message { return &sessionpb.SweepDone{} },
+	MessageDiagnosticsRequest:      func() proto.Message { return &sessionpb.DiagnosticsRequest{} },
+	MessageDiagnosticsResponse:     func() proto.Message { return &sessionpb.DiagnosticsResponse{} },
+	MessageCertificateRequest:      func() proto.Message { return &sessionpb.CertificateRequest{} },
+	MessageCertificateResponse:     func() proto.Message { return &sessionpb.CertificateResponse{} },
+}
+
+func marshalProtobuf(payload interface{}) ([]byte, error) {
+	var m proto.Message
+
+	switch p := payload.(type) {
+	case InfoRequestPacket:
+		m = &sessionpb.InfoRequest{
+			Encodings:    p.Encodings,
+			Version:      uint32(p.Version),
+			Compressions: p.Compressions,
+		}
+	case InfoReplyPacket:
+		m = &sessionpb.InfoReply{
+			Name:         p.Name,
+			Hostname:     p.Hostname,
+			Interfaces:   convertSlice(p.Interfaces, netInterfaceToProto),
+			Redirectors:  convertSlice(p.Redirectors, redirectorInterfaceToProto),
+			Container:    containerInfoToProto(p.Container),
+			Time:         p.Time,
+			Encoding:     uint32(p.Encoding),
+			Beacon:       p.Beacon,
+			Socks:        p.Socks,
+			Exec:         p.Exec,
+			Platform:     p.Platform,
+			Version:      p.Version,
+			Jitter:       uint32(p.Jitter),
+			WorkingHours: p.WorkingHours,
+			Zone:         p.Zone,
+			EgressPath:   p.EgressPath,
+			Persistence:  p.Persistence,
+			Host:         hostInfoToProto(p.Host),
+			Crashes:      convertSlice(p.Crashes, crashToProto),
+			Transport:    p.Transport,
+			Protocol:     uint32(p.Protocol),
+			MinProtocol:  uint32(p.MinProtocol),
+			Compression:  uint32(p.Compression),
+		}
+	case ConnectRequestPacket:
+		m = &sessionpb.ConnectRequest{
+			Net:           uint32(p.Net),
+			Transport:     uint32(p.Transport),
+			Address:       p.Address,
+			Port:          uint32(p.Port),
+			SourceAddress: p.SourceAddress,
+			Compression:   uint32(p.Compression),
+		}
+	case ConnectResponsePacket:
+		m = &sessionpb.ConnectResponse{Established: p.Established, Reset_: p.Reset, Spoofed: p.Spoofed}
+	case HostPingRequestPacket:
+		m = &sessionpb.HostPingRequest{Address: p.Address}
+	case HostPingResponsePacket:
+		m = &sessionpb.HostPingResponse{Alive: p.Alive}
+	case RedirectorRequestPacket:
+		m = &sessionpb.RedirectorRequest{ID: p.ID, Network: p.Network, From: p.From, To: p.To, Forward: p.Forward}
+	case RedirectorResponsePacket:
+		m = &sessionpb.RedirectorResponse{ID: p.ID, Err: p.Err, ErrString: p.ErrString}
+	case RedirectorCloseRequestPacket:
+		m = &sessionpb.RedirectorCloseRequest{ID: p.ID}
+	case RedirectorCloseResponsePacket:
+		m = &sessionpb.RedirectorCloseResponse{ErrString: p.ErrString, Err: p.Err}
+	case DisconnectRequestPacket:
+		m = &sessionpb.DisconnectRequest{}
+	case DisconnectResponsePacket:
+		m = &sessionpb.DisconnectResponse{}
+	case ThroughputRequestPacket:
+		m = &sessionpb.ThroughputRequest{Size: p.Size}
+	case ThroughputResponsePacket:
+		m = &sessionpb.ThroughputResponse{Size: p.Size}
+	case BeaconRequestPacket:
+		m = &sessionpb.BeaconRequest{
+			Interval:  p.Interval,
+			Jitter:    uint32(p.Jitter),
+			WakeHost:  p.WakeHost,
+			WakeCheck: p.WakeCheck,
+			Sleep:     p.Sleep,
+			WakeKey:   p.WakeKey,
+		}
+	case BeaconResponsePacket:
+		m = &sessionpb.BeaconResponse{Interval: p.Interval}
+	case ForwardRequestPacket:
+		m = &sessionpb.ForwardRequest{RedirectorID: p.RedirectorID}
+	case ForwardResponsePacket:
+		m = &sessionpb.ForwardResponse{Established: p.Established}
+	case SocksRequestPacket:
+		m = &sessionpb.SocksRequest{Address: p.Address, Username: p.Username, Password: p.Password}
+	case SocksResponsePacket:
+		m = &sessionpb.SocksResponse{Address: p.Address, Err: p.Err, ErrString: p.ErrString}
+	case FileListRequestPacket:
+		m = &sessionpb.FileListRequest{Path: p.Path}
+	case FileListResponsePacket:
+		m = &sessionpb.FileListResponse{
+			Path:      p.Path,
+			Entries:   convertSlice(p.Entries, fileEntryToProto),
+			Err:       p.Err,
+			ErrString: p.ErrString,
+		}
+	case FileDownloadRequestPacket:
+		m = &sessionpb.FileDownloadRequest{Path: p.Path, Offset: p.Offset}
+	case FileUploadRequestPacket:
+		m = &sessionpb.FileUploadRequest{Path: p.Path, Offset: p.Offset, Size: p.Size, Mode: p.Mode}
+	case FileTransferResponsePacket:
+		m = &sessionpb.FileTransferResponse{Size: p.Size, Total: p.Total, Err: p.Err, ErrString: p.ErrString}
+	case FileHashPacket:
+		m = &sessionpb.FileHash{Size: p.Size, Sha256: p.Sha256, Err: p.Err, ErrString: p.ErrString}
+	case ExecRequestPacket:
+		m = &sessionpb.ExecRequest{Command: p.Command, Timeout: p.Timeout}
+	case ExecOutputPacket:
+		m = &sessionpb.ExecOutput{Stderr: p.Stderr, Data: p.Data}
+	case ExecExitPacket:
+		m = &sessionpb.ExecExit{ExitCode: p.ExitCode, Err: p.Err, ErrString: p.ErrString}
+	case UpdateRequestPacket:
+		m = &sessionpb.UpdateRequest{Size: p.Size, Sha256: p.Sha256}
+	case UpdateResponsePacket:
+		m = &sessionpb.UpdateResponse{Err: p.Err, ErrString: p.ErrString}
+	case ScheduleRequestPacket:
+		m = &sessionpb.ScheduleRequest{WorkingHours: p.WorkingHours}
+	case ScheduleResponsePacket:
+		m = &sessionpb.ScheduleResponse{Err: p.Err, ErrString: p.ErrString}
+	case NetworkRequestPacket:
+		m = &sessionpb.NetworkRequest{}
+	case NetworkResponsePacket:
+		m = &sessionpb.NetworkResponse{
+			Interfaces: convertSlice(p.Interfaces, netInterfaceToProto),
+			Neighbors:  convertSlice(p.Neighbors, neighborToProto),
+			Routes:     convertSlice(p.Routes, systemRouteToProto),
+			Errors:     p.Errors,
+		}
+	case PersistRequestPacket:
+		m = &sessionpb.PersistRequest{Method: p.Method, Uninstall: p.Uninstall}
+	case PersistResponsePacket:
+		m = &sessionpb.PersistResponse{Location: p.Location, Err: p.Err, ErrString: p.ErrString}
+	case BurnRequestPacket:
+		m = &sessionpb.BurnRequest{Persisted: p.Persisted}
+	case BurnResponsePacket:
+		m = &sessionpb.BurnResponse{Removed: p.Removed, Errors: p.Errors}
+	case BreakoutRequestPacket:
+		m = &sessionpb.BreakoutRequest{Rules: convertSlice(p.Rules, breakoutRuleToProto)}
+	case BreakoutResponsePacket:
+		m = &sessionpb.BreakoutResponse{Err: p.Err, ErrString: p.ErrString}
+	case HostInfoRequestPacket:
+		m = &sessionpb.HostInfoRequest{}
+	case HostInfoResponsePacket:
+		m = &sessionpb.HostInfoResponse{Host: hostInfoToProto(p.Host)}
+	case CrashReportPacket:
+		m = &sessionpb.CrashReport{Crashes: convertSlice(p.Crashes, crashToProto)}
+	case BandwidthRequestPacket:
+		m = &sessionpb.BandwidthRequest{Limit: p.Limit}
+	case BandwidthResponsePacket:
+		m = &sessionpb.BandwidthResponse{Err: p.Err, ErrString: p.ErrString}
+	case SweepRequestPacket:
+		m = &sessionpb.SweepRequest{
+			Targets: p.Targets,
+			Ports:   convertSlice(p.Ports, func(port uint16) uint32 { return uint32(port) }),
+			Timeout: p.Timeout,
+			Workers: p.Workers,
+		}
+	case SweepResultPacket:
+		m = &sessionpb.SweepResult{
+			Address: p.Address,
+			Method:  p.Method,
+			RTT:     p.RTT,
+			Open:    convertSlice(p.Open, func(port uint16) uint32 { return uint32(port) }),
+		}
+	case SweepDonePacket:
+		m = &sessionpb.SweepDone{Probed: p.Probed, Alive: p.Alive, ICMP: p.ICMP, Err: p.Err, ErrString: p.ErrString}
+	case DiagnosticsRequestPacket:
+		m = &sessionpb.DiagnosticsRequest{}
+	case DiagnosticsResponsePacket:
+		m = &sessionpb.DiagnosticsResponse{Checks: convertSlice(p.Checks, diagnosticCheckToProto)}
+	case CertificateRequestPacket:
+		m = &sessionpb.CertificateRequest{Certificate: p.Certificate, Key: p.Key}
+	case CertificateResponsePacket:
+		m = &sessionpb.CertificateResponse{Err: p.Err, ErrString: p.ErrString}
+	default:
+		return nil, fmt.Errorf("%T can't be encoded as protobuf", payload)
+	}
+
+	return proto.Marshal(m)
+}
+
+func unmarshalProtobuf(msgType uint8, data []byte) (interface{}, error) {
+	newMessage, ok := protoMessages[msgType]
+	if !ok {
+		return nil, fmt.Errorf("invalid message type %d", msgType)
+	}
+
+	message := newMessage()
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, err
+	}
+
+	switch m := message.(type) {
+	case *sessionpb.InfoRequest:
+		return InfoRequestPacket{
+			Encodings:    m.Encodings,
+			Version:      uint16(m.Version),
+			Compressions: m.Compressions,
+		}, nil
+	case *sessionpb.InfoReply:
+		return InfoReplyPacket{
+			Name:         m.Name,
+			Hostname:     m.Hostname,
+			Interfaces:   convertSlice(m.Interfaces, netInterfaceFromProto),
+			Redirectors:  convertSlice(m.Redirectors, redirectorInterfaceFromProto),
+			Container:    containerInfoFromProto(m.Container),
+			Time:         m.Time,
+			Encoding:     uint8(m.Encoding),
+			Beacon:       m.Beacon,
+			Socks:        m.Socks,
+			Exec:         m.Exec,
+			Platform:     m.Platform,
+			Version:      m.Version,
+			Jitter:       uint8(m.Jitter),
+			WorkingHours: m.WorkingHours,
+			Zone:         m.Zone,
+			EgressPath:   m.EgressPath,
+			Persistence:  m.Persistence,
+			Host:         hostInfoFromProto(m.Host),
+			Crashes:      convertSlice(m.Crashes, crashFromProto),
+			Transport:    m.Transport,
+			Protocol:     uint16(m.Protocol),
+			MinProtocol:  uint16(m.MinProtocol),
+			Compression:  uint8(m.Compression),
+		}, nil
+	case *sessionpb.ConnectRequest:
+		return ConnectRequestPacket{
+			Net:           uint8(m.Net),
+			Transport:     uint8(m.Transport),
+			Address:       m.Address,
+			Port:          uint16(m.Port),
+			SourceAddress: m.SourceAddress,
+			Compression:   uint8(m.Compression),
+		}, nil
+	case *sessionpb.ConnectResponse:
+		return ConnectResponsePacket{Established: m.Established, Reset: m.Reset_, Spoofed: m.Spoofed}, nil
+	case *sessionpb.HostPingRequest:
+		return HostPingRequestPacket{Address: m.Address}, nil
+	case *sessionpb.HostPingResponse:
+		return HostPingResponsePacket{Alive: m.Alive}, nil
+	case *sessionpb.RedirectorRequest:
+		return RedirectorRequestPacket{ID: m.ID, Network: m.Network, From: m.From, To: m.To, Forward: m.Forward}, nil
+	case *sessionpb.RedirectorResponse:
+		return RedirectorResponsePacket{ID: m.ID, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.RedirectorCloseRequest:
+		return RedirectorCloseRequestPacket{ID: m.ID}, nil
+	case *sessionpb.RedirectorCloseResponse:
+		return RedirectorCloseResponsePacket{ErrString: m.ErrString, Err: m.Err}, nil
+	case *sessionpb.DisconnectRequest:
+		return DisconnectRequestPacket{}, nil
+	case *sessionpb.DisconnectResponse:
+		return DisconnectResponsePacket{}, nil
+	case *sessionpb.ThroughputRequest:
+		return ThroughputRequestPacket{Size: m.Size}, nil
+	case *sessionpb.ThroughputResponse:
+		return ThroughputResponsePacket{Size: m.Size}, nil
+	case *sessionpb.BeaconRequest:
+		return BeaconRequestPacket{
+			Interval:  m.Interval,
+			Jitter:    uint8(m.Jitter),
+			WakeHost:  m.WakeHost,
+			WakeKey:   m.WakeKey,
+			WakeCheck: m.WakeCheck,
+			Sleep:     m.Sleep,
+		}, nil
+	case *sessionpb.BeaconResponse:
+		return BeaconResponsePacket{Interval: m.Interval}, nil
+	case *sessionpb.ForwardRequest:
+		return ForwardRequestPacket{RedirectorID: m.RedirectorID}, nil
+	case *sessionpb.ForwardResponse:
+		return ForwardResponsePacket{Established: m.Established}, nil
+	case *sessionpb.SocksRequest:
+		return SocksRequestPacket{Address: m.Address, Username: m.Username, Password: m.Password}, nil
+	case *sessionpb.SocksResponse:
+		return SocksResponsePacket{Address: m.Address, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.FileListRequest:
+		return FileListRequestPacket{Path: m.Path}, nil
+	case *sessionpb.FileListResponse:
+		return FileListResponsePacket{
+			Path:      m.Path,
+			Entries:   convertSlice(m.Entries, fileEntryFromProto),
+			Err:       m.Err,
+			ErrString: m.ErrString,
+		}, nil
+	case *sessionpb.FileDownloadRequest:
+		return FileDownloadRequestPacket{Path: m.Path, Offset: m.Offset}, nil
+	case *sessionpb.FileUploadRequest:
+		return FileUploadRequestPacket{Path: m.Path, Offset: m.Offset, Size: m.Size, Mode: m.Mode}, nil
+	case *sessionpb.FileTransferResponse:
+		return FileTransferResponsePacket{Size: m.Size, Total: m.Total, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.FileHash:
+		return FileHashPacket{Size: m.Size, Sha256: m.Sha256, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.ExecRequest:
+		return ExecRequestPacket{Command: m.Command, Timeout: m.Timeout}, nil
+	case *sessionpb.ExecOutput:
+		return ExecOutputPacket{Stderr: m.Stderr, Data: m.Data}, nil
+	case *sessionpb.ExecExit:
+		return ExecExitPacket{ExitCode: m.ExitCode, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.UpdateRequest:
+		return UpdateRequestPacket{Size: m.Size, Sha256: m.Sha256}, nil
+	case *sessionpb.UpdateResponse:
+		return UpdateResponsePacket{Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.ScheduleRequest:
+		return ScheduleRequestPacket{WorkingHours: m.WorkingHours}, nil
+	case *sessionpb.ScheduleResponse:
+		return ScheduleResponsePacket{Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.NetworkRequest:
+		return NetworkRequestPacket{}, nil
+	case *sessionpb.NetworkResponse:
+		return NetworkResponsePacket{
+			Interfaces: convertSlice(m.Interfaces, netInterfaceFromProto),
+			Neighbors:  convertSlice(m.Neighbors, neighborFromProto),
+			Routes:     convertSlice(m.Routes, systemRouteFromProto),
+			Errors:     m.Errors,
+		}, nil
+	case *sessionpb.PersistRequest:
+		return PersistRequestPacket{Method: m.Method, Uninstall: m.Uninstall}, nil
+	case *sessionpb.PersistResponse:
+		return PersistResponsePacket{Location: m.Location, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.BurnRequest:
+		return BurnRequestPacket{Persisted: m.Persisted}, nil
+	case *sessionpb.BurnResponse:
+		return BurnResponsePacket{Removed: m.Removed, Errors: m.Errors}, nil
+	case *sessionpb.BreakoutRequest:
+		return BreakoutRequestPacket{Rules: convertSlice(m.Rules, breakoutRuleFromProto)}, nil
+	case *sessionpb.BreakoutResponse:
+		return BreakoutResponsePacket{Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.HostInfoRequest:
+		return HostInfoRequestPacket{}, nil
+	case *sessionpb.HostInfoResponse:
+		return HostInfoResponsePacket{Host: hostInfoFromProto(m.Host)}, nil
+	case *sessionpb.CrashReport:
+		return CrashReportPacket{Crashes: convertSlice(m.Crashes, crashFromProto)}, nil
+	case *sessionpb.BandwidthRequest:
+		return BandwidthRequestPacket{Limit: m.Limit}, nil
+	case *sessionpb.BandwidthResponse:
+		return BandwidthResponsePacket{Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.SweepRequest:
+		return SweepRequestPacket{
+			Targets: m.Targets,
+			Ports:   convertSlice(m.Ports, func(port uint32) uint16 { return uint16(port) }),
+			Timeout: m.Timeout,
+			Workers: m.Workers,
+		}, nil
+	case *sessionpb.SweepResult:
+		return SweepResultPacket{
+			Address: m.Address,
+			Method:  m.Method,
+			RTT:     m.RTT,
+			Open:    convertSlice(m.Open, func(port uint32) uint16 { return uint16(port) }),
+		}, nil
+	case *sessionpb.SweepDone:
+		return SweepDonePacket{Probed: m.Probed, Alive: m.Alive, ICMP: m.ICMP, Err: m.Err, ErrString: m.ErrString}, nil
+	case *sessionpb.DiagnosticsRequest:
+		return DiagnosticsRequestPacket{}, nil
+	case *sessionpb.DiagnosticsResponse:
+		return DiagnosticsResponsePacket{Checks: convertSlice(m.Checks, diagnosticCheckFromProto)}, nil
+	case *sessionpb.CertificateRequest:
+		return CertificateRequestPacket{Certificate: m.Certificate, Key: m.Key}, nil
+	case *sessionpb.CertificateResponse:
+		return CertificateResponsePacket{Err: m.Err, ErrString: m.ErrString}, nil
+	default:
+		return nil, fmt.Errorf("%T can't be decoded from protobuf", message)
+	}
+}
+
+// convertSlice converts repeated fields one way or the other, missing ones stay nil as they do with gob
+func convertSlice[From any, To any](items []From, convert func(From) To) []To {
+	if len(items) == 0 {
+		return nil
+	}
+
+	converted := make([]To, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, convert(item))
+	}
+
+	return converted
+}
+
+func netInterfaceToProto(iface NetInterface) *sessionpb.NetInterface {
+	return &sessionpb.NetInterface{
+		Index:        int64(iface.Index),
+		MTU:          int64(iface.MTU),
+		Name:         iface.Name,
+		HardwareAddr: iface.HardwareAddr,
+		Flags:        uint32(iface.Flags),
+		Addresses:    iface.Addresses,
+		Gateways:     iface.Gateways,
+		DNSServers:   iface.DNSServers,
+	}
+}
+
+func netInterfaceFromProto(m *sessionpb.NetInterface) NetInterface {
+	return NetInterface{
+		Index:        int(m.Index),
+		MTU:          int(m.MTU),
+		Name:         m.Name,
+		HardwareAddr: net.HardwareAddr(m.HardwareAddr),
+		Flags:        net.Flags(m.Flags),
+		Addresses:    m.Addresses,
+		Gateways:     m.Gateways,
+		DNSServers:   m.DNSServers,
+	}
+}
+
+func redirectorInterfaceToProto(redirector RedirectorInterface) *sessionpb.RedirectorInterface {
+	return &sessionpb.RedirectorInterface{ID: redirector.ID, Network: redirector.Network, From: redirector.From, To: redirector.To}
+}
+
+func redirectorInterfaceFromProto(m *sessionpb.RedirectorInterface) RedirectorInterface {
+	return RedirectorInterface{ID: m.ID, Network: m.Network, From: m.From, To: m.To}
+}
+
+func neighborToProto(neighbor Neighbor) *sessionpb.Neighbor {
+	return &sessionpb.Neighbor{
+		Address:      neighbor.Address,
+		HardwareAddr: neighbor.HardwareAddr,
+		Interface:    neighbor.Interface,
+		State:        neighbor.State,
+	}
+}
+
+func neighborFromProto(m *sessionpb.Neighbor) Neighbor {
+	return Neighbor{
+		Address:      m.Address,
+		HardwareAddr: net.HardwareAddr(m.HardwareAddr),
+		Interface:    m.Interface,
+		State:        m.State,
+	}
+}
+
+func systemRouteToProto(route SystemRoute) *sessionpb.SystemRoute {
+	return &sessionpb.SystemRoute{
+		Destination: route.Destination,
+		Gateway:     route.Gateway,
+		Interface:   route.Interface,
+		Metric:      int64(route.Metric),
+	}
+}
+
+func systemRouteFromProto(m *sessionpb.SystemRoute) SystemRoute {
+	return SystemRoute{
+		Destination: m.Destination,
+		Gateway:     m.Gateway,
+		Interface:   m.Interface,
+		Metric:      int(m.Metric),
+	}
+}
+
+// hostInfoToProto and hostInfoFromProto keep a missing HostInfo nil, agents built without collection don't send it
+func hostInfoToProto(host *HostInfo) *sessionpb.HostInfo {
+	if host == nil {
+		return nil
+	}
+
+	return &sessionpb.HostInfo{
+		OS:         host.OS,
+		Username:   host.Username,
+		Privileged: host.Privileged,
+		Privileges: host.Privileges,
+		Domain:     host.Domain,
+		DNSServers: host.DNSServers,
+		Gateways:   host.Gateways,
+		Errors:     host.Errors,
+	}
+}
+
+func hostInfoFromProto(m *sessionpb.HostInfo) *HostInfo {
+	if m == nil {
+		return nil
+	}
+
+	return &HostInfo{
+		OS:         m.OS,
+		Username:   m.Username,
+		Privileged: m.Privileged,
+		Privileges: m.Privileges,
+		Domain:     m.Domain,
+		DNSServers: m.DNSServers,
+		Gateways:   m.Gateways,
+		Errors:     m.Errors,
+	}
+}
+
+func crashToProto(crash Crash) *sessionpb.Crash {
+	return &sessionpb.Crash{
+		Where:     crash.Where,
+		Panic:     crash.Panic,
+		Frame:     crash.Frame,
+		StackHash: crash.StackHash,
+		Count:     int64(crash.Count),
+		First:     crash.First,
+		Last:      crash.Last,
+	}
+}
+
+func crashFromProto(m *sessionpb.Crash) Crash {
+	return Crash{
+		Where:     m.Where,
+		Panic:     m.Panic,
+		Frame:     m.Frame,
+		StackHash: m.StackHash,
+		Count:     int(m.Count),
+		First:     m.First,
+		Last:      m.Last,
+	}
+}
+
+func diagnosticCheckToProto(check DiagnosticCheck) *sessionpb.DiagnosticCheck {
+	return &sessionpb.DiagnosticCheck{
+		Name:     check.Name,
+		Target:   check.Target,
+		OK:       check.OK,
+		Detail:   check.Detail,
+		Duration: check.Duration,
+	}
+}
+
+func diagnosticCheckFromProto(m *sessionpb.DiagnosticCheck) DiagnosticCheck {
+	return DiagnosticCheck{
+		Name:     m.Name,
+		Target:   m.Target,
+		OK:       m.OK,
+		Detail:   m.Detail,
+		Duration: m.Duration,
+	}
+}
+
+func breakoutRuleToProto(rule BreakoutRule) *sessionpb.BreakoutRule {
+	return &sessionpb.BreakoutRule{Cidr: rule.Cidr, Interface: rule.Interface}
+}
+
+func breakoutRuleFromProto(m *sessionpb.BreakoutRule) BreakoutRule {
+	return BreakoutRule{Cidr: m.Cidr, Interface: m.Interface}
+}
+
+func containerInfoToProto(container ContainerInfo) *sessionpb.ContainerInfo {
+	return &sessionpb.ContainerInfo{
+		Runtime:     container.Runtime,
+		HostNetwork: container.HostNetwork,
+		HostNetns:   container.HostNetns,
+		ViaHost:     container.ViaHost,
+	}
+}
+
+func containerInfoFromProto(m *sessionpb.ContainerInfo) ContainerInfo {
+	if m == nil {
+		return ContainerInfo{}
+	}
+
+	return ContainerInfo{
+		Runtime:     m.Runtime,
+		HostNetwork: m.HostNetwork,
+		HostNetns:   m.HostNetns,
+		ViaHost:     m.ViaHost,
+	}
+}
+
+func fileEntryToProto(entry FileEntry) *sessionpb.FileEntry {
+	return &sessionpb.FileEntry{
+		Name:    entry.Name,
+		Size:    entry.Size,
+		Mode:    entry.Mode,
+		ModTime: entry.ModTime,
+		IsDir:   entry.IsDir,
+	}
+}
+
+func fileEntryFromProto(m *sessionpb.FileEntry) FileEntry {
+	return FileEntry{
+		Name:    m.Name,
+		Size:    m.Size,
+		Mode:    m.Mode,
+		ModTime: m.ModTime,
+		IsDir:   m.IsDir,
+	}
+}
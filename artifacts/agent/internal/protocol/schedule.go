@@ -0,0 +1,153 @@
+package protocol
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkingHours are the windows during which an agent stays dormant, in the agent's local time. They are written
+// as comma separated "[days] HH:MM-HH:MM", days being a weekday or a range of them and every day when left out,
+// e.g. "mon-fri 08:00-18:00, sat 09:00-12:00". A window ending before it starts runs past midnight
+type WorkingHours []workWindow
+
+type workWindow struct {
+	days  [7]bool       // indexed by time.Weekday, the day the window starts on
+	start time.Duration // since midnight
+	span  time.Duration
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func ParseWorkingHours(spec string) (WorkingHours, error) {
+	var hours WorkingHours
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+
+		var window workWindow
+		daySpec, timeSpec, found := strings.Cut(part, " ")
+		if !found {
+			daySpec, timeSpec = "", part
+		}
+
+		if daySpec == "" {
+			for day := range window.days {
+				window.days[day] = true
+			}
+		} else {
+			first, last, isRange := strings.Cut(daySpec, "-")
+			from, ok := weekdays[first]
+			if !ok {
+				return nil, fmt.Errorf("%s is invalid weekday, expected mon, tue...", first)
+			}
+			to := from
+			if isRange {
+				if to, ok = weekdays[last]; !ok {
+					return nil, fmt.Errorf("%s is invalid weekday, expected mon, tue...", last)
+				}
+			}
+			for day := from; ; day = (day + 1) % 7 {
+				window.days[day] = true
+				if day == to {
+					break
+				}
+			}
+		}
+
+		startSpec, endSpec, found := strings.Cut(strings.TrimSpace(timeSpec), "-")
+		if !found {
+			return nil, fmt.Errorf("%s is invalid window, expected HH:MM-HH:MM", part)
+		}
+		start, err := parseClock(startSpec)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(endSpec)
+		if err != nil {
+			return nil, err
+		}
+		if start == end {
+			return nil, fmt.Errorf("%s is an empty window", part)
+		}
+
+		window.start = start
+		window.span = end - start
+		if end < start {
+			window.span += 24 * time.Hour
+		}
+
+		hours = append(hours, window)
+	}
+
+	return hours, nil
+}
+
+func parseClock(spec string) (time.Duration, error) {
+	var hour, minute int
+	if _, err := fmt.Sscanf(strings.TrimSpace(spec), "%d:%d", &hour, &minute); err != nil || hour < 0 || minute < 0 || minute > 59 || hour > 24 || (hour == 24 && minute != 0) {
+		return 0, fmt.Errorf("%s is invalid time, expected HH:MM", spec)
+	}
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, nil
+}
+
+// Active tells whether at falls within a window and when that window ends, in at's location
+func (hours WorkingHours) Active(at time.Time) (bool, time.Time) {
+	var active bool
+	var until time.Time
+	for _, window := range hours {
+		for back := 0; back <= 1; back++ { // a window still running may have started the day before
+			day := at.AddDate(0, 0, -back)
+			if !window.days[day.Weekday()] {
+				continue
+			}
+
+			start := midnight(day).Add(window.start)
+			end := start.Add(window.span)
+			if !at.Before(start) && at.Before(end) && end.After(until) {
+				active, until = true, end
+			}
+		}
+	}
+
+	return active, until
+}
+
+// Next is when the next window starts after at, zero without windows
+func (hours WorkingHours) Next(at time.Time) time.Time {
+	var next time.Time
+	for _, window := range hours {
+		for ahead := 0; ahead <= 7; ahead++ {
+			day := at.AddDate(0, 0, ahead)
+			if !window.days[day.Weekday()] {
+				continue
+			}
+
+			start := midnight(day).Add(window.start)
+			if start.After(at) {
+				if next.IsZero() || start.Before(next) {
+					next = start
+				}
+				break
+			}
+		}
+	}
+
+	return next
+}
+
+func midnight(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
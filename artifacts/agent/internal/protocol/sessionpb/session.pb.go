@@ -0,0 +1,5270 @@
+// Session protocol payloads for agents using EncodingProtobuf.
+//
+// Every message travels in an envelope: type (uint8), size (int32 little endian), payload.
+// The high bit of the type is set for protobuf payloads, the remaining bits are the Message* constants.
+// The sessionpb package is generated from this file with `make protobuf`, agents written in other languages can do the same.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.21.12
+// source: internal/protocol/session.proto
+
+package sessionpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encodings    []byte `protobuf:"bytes,1,opt,name=Encodings,proto3" json:"Encodings,omitempty"`       // one byte per supported encoding, most preferred first
+	Version      uint32 `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`          // protocol spoken by the server
+	Compressions []byte `protobuf:"bytes,3,opt,name=Compressions,proto3" json:"Compressions,omitempty"` // one byte per relay stream compression, most preferred first
+}
+
+func (x *InfoRequest) Reset() {
+	*x = InfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfoRequest) ProtoMessage() {}
+
+func (x *InfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
+func (*InfoRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InfoRequest) GetEncodings() []byte {
+	if x != nil {
+		return x.Encodings
+	}
+	return nil
+}
+
+func (x *InfoRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *InfoRequest) GetCompressions() []byte {
+	if x != nil {
+		return x.Compressions
+	}
+	return nil
+}
+
+type InfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Hostname     string                 `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Interfaces   []*NetInterface        `protobuf:"bytes,3,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
+	Redirectors  []*RedirectorInterface `protobuf:"bytes,4,rep,name=Redirectors,proto3" json:"Redirectors,omitempty"`
+	Container    *ContainerInfo         `protobuf:"bytes,5,opt,name=Container,proto3" json:"Container,omitempty"`
+	Time         int64                  `protobuf:"varint,6,opt,name=Time,proto3" json:"Time,omitempty"`
+	Encoding     uint32                 `protobuf:"varint,7,opt,name=Encoding,proto3" json:"Encoding,omitempty"`         // encoding picked for the rest of the session
+	Beacon       int64                  `protobuf:"varint,8,opt,name=Beacon,proto3" json:"Beacon,omitempty"`             // build-time beacon interval in nanoseconds, 0 if always connected
+	Socks        string                 `protobuf:"bytes,9,opt,name=Socks,proto3" json:"Socks,omitempty"`                // address of the running SOCKS5 service, empty if it's off
+	Exec         bool                   `protobuf:"varint,10,opt,name=Exec,proto3" json:"Exec,omitempty"`                // built with command execution
+	Platform     string                 `protobuf:"bytes,11,opt,name=Platform,proto3" json:"Platform,omitempty"`         // GOOS/GOARCH
+	Version      string                 `protobuf:"bytes,12,opt,name=Version,proto3" json:"Version,omitempty"`           // SHA-256 of the agent executable, empty if it can't replace itself
+	Jitter       uint32                 `protobuf:"varint,13,opt,name=Jitter,proto3" json:"Jitter,omitempty"`            // percent reconnect waits are shortened by
+	WorkingHours string                 `protobuf:"bytes,14,opt,name=WorkingHours,proto3" json:"WorkingHours,omitempty"` // dormant windows in local time, e.g. "mon-fri 08:00-18:00"
+	Zone         int32                  `protobuf:"varint,15,opt,name=Zone,proto3" json:"Zone,omitempty"`                // seconds east of UTC
+	EgressPath   string                 `protobuf:"bytes,16,opt,name=EgressPath,proto3" json:"EgressPath,omitempty"`     // how the server was reached, e.g. "direct" or "http://proxy:8080 (pac)"
+	Persistence  []string               `protobuf:"bytes,17,rep,name=Persistence,proto3" json:"Persistence,omitempty"`   // persistence methods built in, e.g. "runkey" or "systemd"
+	Host         *HostInfo              `protobuf:"bytes,18,opt,name=Host,proto3" json:"Host,omitempty"`                 // missing if the agent was built without host information collection
+	Crashes      []*Crash               `protobuf:"bytes,19,rep,name=Crashes,proto3" json:"Crashes,omitempty"`           // recovered panics the server hasn't heard of yet
+	Transport    string                 `protobuf:"bytes,20,opt,name=Transport,proto3" json:"Transport,omitempty"`       // transport connected over, e.g. "tls" or "dns"
+	Protocol     uint32                 `protobuf:"varint,21,opt,name=Protocol,proto3" json:"Protocol,omitempty"`        // newest protocol spoken, 0 for agents predating versioning
+	MinProtocol  uint32                 `protobuf:"varint,22,opt,name=MinProtocol,proto3" json:"MinProtocol,omitempty"`  // oldest protocol still talked
+	Compression  uint32                 `protobuf:"varint,23,opt,name=Compression,proto3" json:"Compression,omitempty"`  // relay stream compression picked, 0 for none
+}
+
+func (x *InfoReply) Reset() {
+	*x = InfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfoReply) ProtoMessage() {}
+
+func (x *InfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfoReply.ProtoReflect.Descriptor instead.
+func (*InfoReply) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InfoReply) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InfoReply) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *InfoReply) GetInterfaces() []*NetInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *InfoReply) GetRedirectors() []*RedirectorInterface {
+	if x != nil {
+		return x.Redirectors
+	}
+	return nil
+}
+
+func (x *InfoReply) GetContainer() *ContainerInfo {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *InfoReply) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *InfoReply) GetEncoding() uint32 {
+	if x != nil {
+		return x.Encoding
+	}
+	return 0
+}
+
+func (x *InfoReply) GetBeacon() int64 {
+	if x != nil {
+		return x.Beacon
+	}
+	return 0
+}
+
+func (x *InfoReply) GetSocks() string {
+	if x != nil {
+		return x.Socks
+	}
+	return ""
+}
+
+func (x *InfoReply) GetExec() bool {
+	if x != nil {
+		return x.Exec
+	}
+	return false
+}
+
+func (x *InfoReply) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *InfoReply) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InfoReply) GetJitter() uint32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *InfoReply) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
+}
+
+func (x *InfoReply) GetZone() int32 {
+	if x != nil {
+		return x.Zone
+	}
+	return 0
+}
+
+func (x *InfoReply) GetEgressPath() string {
+	if x != nil {
+		return x.EgressPath
+	}
+	return ""
+}
+
+func (x *InfoReply) GetPersistence() []string {
+	if x != nil {
+		return x.Persistence
+	}
+	return nil
+}
+
+func (x *InfoReply) GetHost() *HostInfo {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *InfoReply) GetCrashes() []*Crash {
+	if x != nil {
+		return x.Crashes
+	}
+	return nil
+}
+
+func (x *InfoReply) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *InfoReply) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *InfoReply) GetMinProtocol() uint32 {
+	if x != nil {
+		return x.MinProtocol
+	}
+	return 0
+}
+
+func (x *InfoReply) GetCompression() uint32 {
+	if x != nil {
+		return x.Compression
+	}
+	return 0
+}
+
+// panics recovered from at the same place, stacks are reduced to function names and hashed
+type Crash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Where     string `protobuf:"bytes,1,opt,name=Where,proto3" json:"Where,omitempty"` // "tunnel", "stream" or "redirector"
+	Panic     string `protobuf:"bytes,2,opt,name=Panic,proto3" json:"Panic,omitempty"` // truncated
+	Frame     string `protobuf:"bytes,3,opt,name=Frame,proto3" json:"Frame,omitempty"` // function that panicked
+	StackHash string `protobuf:"bytes,4,opt,name=StackHash,proto3" json:"StackHash,omitempty"`
+	Count     int64  `protobuf:"varint,5,opt,name=Count,proto3" json:"Count,omitempty"`
+	First     int64  `protobuf:"varint,6,opt,name=First,proto3" json:"First,omitempty"` // agent's wall clock, unix nanoseconds
+	Last      int64  `protobuf:"varint,7,opt,name=Last,proto3" json:"Last,omitempty"`
+}
+
+func (x *Crash) Reset() {
+	*x = Crash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Crash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crash) ProtoMessage() {}
+
+func (x *Crash) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crash.ProtoReflect.Descriptor instead.
+func (*Crash) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Crash) GetWhere() string {
+	if x != nil {
+		return x.Where
+	}
+	return ""
+}
+
+func (x *Crash) GetPanic() string {
+	if x != nil {
+		return x.Panic
+	}
+	return ""
+}
+
+func (x *Crash) GetFrame() string {
+	if x != nil {
+		return x.Frame
+	}
+	return ""
+}
+
+func (x *Crash) GetStackHash() string {
+	if x != nil {
+		return x.StackHash
+	}
+	return ""
+}
+
+func (x *Crash) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Crash) GetFirst() int64 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *Crash) GetLast() int64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+type HostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OS         string   `protobuf:"bytes,1,opt,name=OS,proto3" json:"OS,omitempty"`
+	Username   string   `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`      // DOMAIN\user on Windows
+	Privileged bool     `protobuf:"varint,3,opt,name=Privileged,proto3" json:"Privileged,omitempty"` // root, or an elevated administrator
+	Privileges []string `protobuf:"bytes,4,rep,name=Privileges,proto3" json:"Privileges,omitempty"`  // token privileges on Windows, effective capabilities on Linux
+	Domain     string   `protobuf:"bytes,5,opt,name=Domain,proto3" json:"Domain,omitempty"`          // Active Directory domain or Kerberos realm, empty if not joined
+	DNSServers []string `protobuf:"bytes,6,rep,name=DNSServers,proto3" json:"DNSServers,omitempty"`
+	Gateways   []string `protobuf:"bytes,7,rep,name=Gateways,proto3" json:"Gateways,omitempty"` // default gateways
+	Errors     []string `protobuf:"bytes,8,rep,name=Errors,proto3" json:"Errors,omitempty"`     // what couldn't be collected
+}
+
+func (x *HostInfo) Reset() {
+	*x = HostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInfo) ProtoMessage() {}
+
+func (x *HostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInfo.ProtoReflect.Descriptor instead.
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HostInfo) GetOS() string {
+	if x != nil {
+		return x.OS
+	}
+	return ""
+}
+
+func (x *HostInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *HostInfo) GetPrivileged() bool {
+	if x != nil {
+		return x.Privileged
+	}
+	return false
+}
+
+func (x *HostInfo) GetPrivileges() []string {
+	if x != nil {
+		return x.Privileges
+	}
+	return nil
+}
+
+func (x *HostInfo) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *HostInfo) GetDNSServers() []string {
+	if x != nil {
+		return x.DNSServers
+	}
+	return nil
+}
+
+func (x *HostInfo) GetGateways() []string {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *HostInfo) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type NetInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        int64    `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	MTU          int64    `protobuf:"varint,2,opt,name=MTU,proto3" json:"MTU,omitempty"`
+	Name         string   `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	HardwareAddr []byte   `protobuf:"bytes,4,opt,name=HardwareAddr,proto3" json:"HardwareAddr,omitempty"`
+	Flags        uint32   `protobuf:"varint,5,opt,name=Flags,proto3" json:"Flags,omitempty"`
+	Addresses    []string `protobuf:"bytes,6,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	Gateways     []string `protobuf:"bytes,7,rep,name=Gateways,proto3" json:"Gateways,omitempty"`
+	DNSServers   []string `protobuf:"bytes,8,rep,name=DNSServers,proto3" json:"DNSServers,omitempty"`
+}
+
+func (x *NetInterface) Reset() {
+	*x = NetInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetInterface) ProtoMessage() {}
+
+func (x *NetInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetInterface.ProtoReflect.Descriptor instead.
+func (*NetInterface) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NetInterface) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NetInterface) GetMTU() int64 {
+	if x != nil {
+		return x.MTU
+	}
+	return 0
+}
+
+func (x *NetInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetInterface) GetHardwareAddr() []byte {
+	if x != nil {
+		return x.HardwareAddr
+	}
+	return nil
+}
+
+func (x *NetInterface) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *NetInterface) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *NetInterface) GetGateways() []string {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *NetInterface) GetDNSServers() []string {
+	if x != nil {
+		return x.DNSServers
+	}
+	return nil
+}
+
+type RedirectorInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Network string `protobuf:"bytes,2,opt,name=Network,proto3" json:"Network,omitempty"`
+	From    string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	To      string `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+}
+
+func (x *RedirectorInterface) Reset() {
+	*x = RedirectorInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectorInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectorInterface) ProtoMessage() {}
+
+func (x *RedirectorInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectorInterface.ProtoReflect.Descriptor instead.
+func (*RedirectorInterface) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RedirectorInterface) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *RedirectorInterface) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *RedirectorInterface) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RedirectorInterface) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type ContainerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runtime     string `protobuf:"bytes,1,opt,name=Runtime,proto3" json:"Runtime,omitempty"`
+	HostNetwork bool   `protobuf:"varint,2,opt,name=HostNetwork,proto3" json:"HostNetwork,omitempty"`
+	HostNetns   string `protobuf:"bytes,3,opt,name=HostNetns,proto3" json:"HostNetns,omitempty"`
+	ViaHost     bool   `protobuf:"varint,4,opt,name=ViaHost,proto3" json:"ViaHost,omitempty"`
+}
+
+func (x *ContainerInfo) Reset() {
+	*x = ContainerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerInfo) ProtoMessage() {}
+
+func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerInfo.ProtoReflect.Descriptor instead.
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ContainerInfo) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetHostNetwork() bool {
+	if x != nil {
+		return x.HostNetwork
+	}
+	return false
+}
+
+func (x *ContainerInfo) GetHostNetns() string {
+	if x != nil {
+		return x.HostNetns
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetViaHost() bool {
+	if x != nil {
+		return x.ViaHost
+	}
+	return false
+}
+
+type ConnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Net           uint32 `protobuf:"varint,1,opt,name=Net,proto3" json:"Net,omitempty"`
+	Transport     uint32 `protobuf:"varint,2,opt,name=Transport,proto3" json:"Transport,omitempty"`
+	Address       string `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Port          uint32 `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
+	SourceAddress string `protobuf:"bytes,5,opt,name=SourceAddress,proto3" json:"SourceAddress,omitempty"`
+	Compression   uint32 `protobuf:"varint,6,opt,name=Compression,proto3" json:"Compression,omitempty"` // 0 for none
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConnectRequest) GetNet() uint32 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetTransport() uint32 {
+	if x != nil {
+		return x.Transport
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetCompression() uint32 {
+	if x != nil {
+		return x.Compression
+	}
+	return 0
+}
+
+type ConnectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Established bool `protobuf:"varint,1,opt,name=Established,proto3" json:"Established,omitempty"`
+	Reset_      bool `protobuf:"varint,2,opt,name=Reset,proto3" json:"Reset,omitempty"`
+	Spoofed     bool `protobuf:"varint,3,opt,name=Spoofed,proto3" json:"Spoofed,omitempty"`
+}
+
+func (x *ConnectResponse) Reset() {
+	*x = ConnectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectResponse) ProtoMessage() {}
+
+func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConnectResponse) GetEstablished() bool {
+	if x != nil {
+		return x.Established
+	}
+	return false
+}
+
+func (x *ConnectResponse) GetReset_() bool {
+	if x != nil {
+		return x.Reset_
+	}
+	return false
+}
+
+func (x *ConnectResponse) GetSpoofed() bool {
+	if x != nil {
+		return x.Spoofed
+	}
+	return false
+}
+
+type HostPingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (x *HostPingRequest) Reset() {
+	*x = HostPingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostPingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostPingRequest) ProtoMessage() {}
+
+func (x *HostPingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostPingRequest.ProtoReflect.Descriptor instead.
+func (*HostPingRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HostPingRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HostPingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alive bool `protobuf:"varint,1,opt,name=Alive,proto3" json:"Alive,omitempty"`
+}
+
+func (x *HostPingResponse) Reset() {
+	*x = HostPingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostPingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostPingResponse) ProtoMessage() {}
+
+func (x *HostPingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostPingResponse.ProtoReflect.Descriptor instead.
+func (*HostPingResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HostPingResponse) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+type RedirectorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Network string `protobuf:"bytes,2,opt,name=Network,proto3" json:"Network,omitempty"`
+	From    string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	To      string `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	Forward bool   `protobuf:"varint,5,opt,name=Forward,proto3" json:"Forward,omitempty"` // accepted connections go back to the server in a ForwardRequest
+}
+
+func (x *RedirectorRequest) Reset() {
+	*x = RedirectorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectorRequest) ProtoMessage() {}
+
+func (x *RedirectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectorRequest.ProtoReflect.Descriptor instead.
+func (*RedirectorRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RedirectorRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *RedirectorRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *RedirectorRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RedirectorRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RedirectorRequest) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+type RedirectorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Err       bool   `protobuf:"varint,2,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,3,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *RedirectorResponse) Reset() {
+	*x = RedirectorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectorResponse) ProtoMessage() {}
+
+func (x *RedirectorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectorResponse.ProtoReflect.Descriptor instead.
+func (*RedirectorResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RedirectorResponse) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *RedirectorResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *RedirectorResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+type RedirectorCloseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *RedirectorCloseRequest) Reset() {
+	*x = RedirectorCloseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectorCloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectorCloseRequest) ProtoMessage() {}
+
+func (x *RedirectorCloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectorCloseRequest.ProtoReflect.Descriptor instead.
+func (*RedirectorCloseRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RedirectorCloseRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type RedirectorCloseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrString string `protobuf:"bytes,1,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+	Err       bool   `protobuf:"varint,2,opt,name=Err,proto3" json:"Err,omitempty"`
+}
+
+func (x *RedirectorCloseResponse) Reset() {
+	*x = RedirectorCloseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectorCloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectorCloseResponse) ProtoMessage() {}
+
+func (x *RedirectorCloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectorCloseResponse.ProtoReflect.Descriptor instead.
+func (*RedirectorCloseResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RedirectorCloseResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+func (x *RedirectorCloseResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+type DisconnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{15}
+}
+
+type DisconnectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisconnectResponse) Reset() {
+	*x = DisconnectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectResponse) ProtoMessage() {}
+
+func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{16}
+}
+
+type ThroughputRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size int64 `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+}
+
+func (x *ThroughputRequest) Reset() {
+	*x = ThroughputRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThroughputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputRequest) ProtoMessage() {}
+
+func (x *ThroughputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputRequest.ProtoReflect.Descriptor instead.
+func (*ThroughputRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ThroughputRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ThroughputResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size int64 `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+}
+
+func (x *ThroughputResponse) Reset() {
+	*x = ThroughputResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThroughputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputResponse) ProtoMessage() {}
+
+func (x *ThroughputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputResponse.ProtoReflect.Descriptor instead.
+func (*ThroughputResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ThroughputResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type BeaconRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interval  int64  `protobuf:"varint,1,opt,name=Interval,proto3" json:"Interval,omitempty"` // nanoseconds
+	Jitter    uint32 `protobuf:"varint,2,opt,name=Jitter,proto3" json:"Jitter,omitempty"`     // percent
+	WakeHost  string `protobuf:"bytes,3,opt,name=WakeHost,proto3" json:"WakeHost,omitempty"`
+	WakeCheck int64  `protobuf:"varint,4,opt,name=WakeCheck,proto3" json:"WakeCheck,omitempty"` // nanoseconds
+	Sleep     bool   `protobuf:"varint,5,opt,name=Sleep,proto3" json:"Sleep,omitempty"`         // the server closes the session after the response
+	WakeKey   []byte `protobuf:"bytes,6,opt,name=WakeKey,proto3" json:"WakeKey,omitempty"`      // HMAC key of the TXT record proving a wake up
+}
+
+func (x *BeaconRequest) Reset() {
+	*x = BeaconRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconRequest) ProtoMessage() {}
+
+func (x *BeaconRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconRequest.ProtoReflect.Descriptor instead.
+func (*BeaconRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BeaconRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *BeaconRequest) GetJitter() uint32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *BeaconRequest) GetWakeHost() string {
+	if x != nil {
+		return x.WakeHost
+	}
+	return ""
+}
+
+func (x *BeaconRequest) GetWakeCheck() int64 {
+	if x != nil {
+		return x.WakeCheck
+	}
+	return 0
+}
+
+func (x *BeaconRequest) GetSleep() bool {
+	if x != nil {
+		return x.Sleep
+	}
+	return false
+}
+
+func (x *BeaconRequest) GetWakeKey() []byte {
+	if x != nil {
+		return x.WakeKey
+	}
+	return nil
+}
+
+type BeaconResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interval int64 `protobuf:"varint,1,opt,name=Interval,proto3" json:"Interval,omitempty"`
+}
+
+func (x *BeaconResponse) Reset() {
+	*x = BeaconResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconResponse) ProtoMessage() {}
+
+func (x *BeaconResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconResponse.ProtoReflect.Descriptor instead.
+func (*BeaconResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BeaconResponse) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type ForwardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectorID string `protobuf:"bytes,1,opt,name=RedirectorID,proto3" json:"RedirectorID,omitempty"`
+}
+
+func (x *ForwardRequest) Reset() {
+	*x = ForwardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardRequest) ProtoMessage() {}
+
+func (x *ForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardRequest.ProtoReflect.Descriptor instead.
+func (*ForwardRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ForwardRequest) GetRedirectorID() string {
+	if x != nil {
+		return x.RedirectorID
+	}
+	return ""
+}
+
+type ForwardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Established bool `protobuf:"varint,1,opt,name=Established,proto3" json:"Established,omitempty"` // the stream carries the connection from then on
+}
+
+func (x *ForwardResponse) Reset() {
+	*x = ForwardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardResponse) ProtoMessage() {}
+
+func (x *ForwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardResponse.ProtoReflect.Descriptor instead.
+func (*ForwardResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ForwardResponse) GetEstablished() bool {
+	if x != nil {
+		return x.Established
+	}
+	return false
+}
+
+type SocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`   // empty stops the service, otherwise it replaces the running one
+	Username string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"` // both empty if clients don't have to authenticate
+	Password string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *SocksRequest) Reset() {
+	*x = SocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocksRequest) ProtoMessage() {}
+
+func (x *SocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocksRequest.ProtoReflect.Descriptor instead.
+func (*SocksRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SocksRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SocksRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SocksRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"` // where the service listens, empty once it's stopped
+	Err       bool   `protobuf:"varint,2,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,3,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *SocksResponse) Reset() {
+	*x = SocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocksResponse) ProtoMessage() {}
+
+func (x *SocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocksResponse.ProtoReflect.Descriptor instead.
+func (*SocksResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SocksResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SocksResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *SocksResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+type FileListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"` // empty is the agent's working directory
+}
+
+func (x *FileListRequest) Reset() {
+	*x = FileListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileListRequest) ProtoMessage() {}
+
+func (x *FileListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileListRequest.ProtoReflect.Descriptor instead.
+func (*FileListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FileListRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Size    int64  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	Mode    uint32 `protobuf:"varint,3,opt,name=Mode,proto3" json:"Mode,omitempty"`       // Go os.FileMode bits
+	ModTime int64  `protobuf:"varint,4,opt,name=ModTime,proto3" json:"ModTime,omitempty"` // unix seconds
+	IsDir   bool   `protobuf:"varint,5,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
+}
+
+func (x *FileEntry) Reset() {
+	*x = FileEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileEntry) ProtoMessage() {}
+
+func (x *FileEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileEntry.ProtoReflect.Descriptor instead.
+func (*FileEntry) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FileEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileEntry) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileEntry) GetModTime() int64 {
+	if x != nil {
+		return x.ModTime
+	}
+	return 0
+}
+
+func (x *FileEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+type FileListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string       `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"` // absolute path of the directory, a file is listed alone in its directory
+	Entries   []*FileEntry `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	Err       bool         `protobuf:"varint,3,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string       `protobuf:"bytes,4,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *FileListResponse) Reset() {
+	*x = FileListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileListResponse) ProtoMessage() {}
+
+func (x *FileListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileListResponse.ProtoReflect.Descriptor instead.
+func (*FileListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FileListResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileListResponse) GetEntries() []*FileEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *FileListResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *FileListResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+type FileDownloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *FileDownloadRequest) Reset() {
+	*x = FileDownloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDownloadRequest) ProtoMessage() {}
+
+func (x *FileDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDownloadRequest.ProtoReflect.Descriptor instead.
+func (*FileDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FileDownloadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileDownloadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type FileUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Offset int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"` // the file is truncated there first
+	Size   int64  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`     // raw bytes following the FileTransferResponse
+	Mode   uint32 `protobuf:"varint,4,opt,name=Mode,proto3" json:"Mode,omitempty"`     // permissions of created files
+}
+
+func (x *FileUploadRequest) Reset() {
+	*x = FileUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadRequest) ProtoMessage() {}
+
+func (x *FileUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadRequest.ProtoReflect.Descriptor instead.
+func (*FileUploadRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FileUploadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileUploadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileUploadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileUploadRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type FileTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size      int64  `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"` // raw bytes following this message for downloads
+	Total     int64  `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	Err       bool   `protobuf:"varint,3,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,4,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *FileTransferResponse) Reset() {
+	*x = FileTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTransferResponse) ProtoMessage() {}
+
+func (x *FileTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTransferResponse.ProtoReflect.Descriptor instead.
+func (*FileTransferResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FileTransferResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileTransferResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FileTransferResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *FileTransferResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+type FileHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size      int64  `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`    // of the whole file after the transfer
+	Sha256    string `protobuf:"bytes,2,opt,name=Sha256,proto3" json:"Sha256,omitempty"` // hex
+	Err       bool   `protobuf:"varint,3,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,4,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *FileHash) Reset() {
+	*x = FileHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileHash) ProtoMessage() {}
+
+func (x *FileHash) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileHash.ProtoReflect.Descriptor instead.
+func (*FileHash) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FileHash) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileHash) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *FileHash) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *FileHash) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+type ExecRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command string `protobuf:"bytes,1,opt,name=Command,proto3" json:"Command,omitempty"`  // run with /bin/sh -c or cmd.exe /c
+	Timeout int64  `protobuf:"varint,2,opt,name=Timeout,proto3" json:"Timeout,omitempty"` // nanoseconds, 0 waits for the command
+}
+
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRequest) ProtoMessage() {}
+
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExecRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ExecRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ExecOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stderr bool   `protobuf:"varint,1,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *ExecOutput) Reset() {
+	*x = ExecOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecOutput) ProtoMessage() {}
+
+func (x *ExecOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecOutput.ProtoReflect.Descriptor instead.
+func (*ExecOutput) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExecOutput) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+func (x *ExecOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExecExit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode  int32  `protobuf:"varint,1,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"` // -1 if the command didn't run to completion
+	Err       bool   `protobuf:"varint,2,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,3,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *ExecExit) Reset() {
+	*x = ExecExit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecExit) ProtoMessage() {}
+
+func (x *ExecExit) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecExit.ProtoReflect.Descriptor instead.
+func (*ExecExit) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExecExit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecExit) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *ExecExit) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// the new executable follows on the stream, Size bytes
+type UpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size   int64  `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	Sha256 string `protobuf:"bytes,2,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// sent once the executable is received, the agent restarts from it right after
+type UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err       bool   `protobuf:"varint,1,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,2,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *UpdateResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// replaces the working hours until the agent restarts, it drops the session after the response if a window is running
+type ScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingHours string `protobuf:"bytes,1,opt,name=WorkingHours,proto3" json:"WorkingHours,omitempty"`
+}
+
+func (x *ScheduleRequest) Reset() {
+	*x = ScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRequest) ProtoMessage() {}
+
+func (x *ScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ScheduleRequest) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
+}
+
+type ScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err       bool   `protobuf:"varint,1,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,2,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *ScheduleResponse) Reset() {
+	*x = ScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleResponse) ProtoMessage() {}
+
+func (x *ScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ScheduleResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *ScheduleResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// asks for interfaces, neighbors and routes as they are now
+type NetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NetworkRequest) Reset() {
+	*x = NetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkRequest) ProtoMessage() {}
+
+func (x *NetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkRequest.ProtoReflect.Descriptor instead.
+func (*NetworkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{39}
+}
+
+type NetworkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interfaces []*NetInterface `protobuf:"bytes,1,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
+	Neighbors  []*Neighbor     `protobuf:"bytes,2,rep,name=Neighbors,proto3" json:"Neighbors,omitempty"`
+	Routes     []*SystemRoute  `protobuf:"bytes,3,rep,name=Routes,proto3" json:"Routes,omitempty"`
+	Errors     []string        `protobuf:"bytes,4,rep,name=Errors,proto3" json:"Errors,omitempty"` // what couldn't be collected, the rest is still reported
+}
+
+func (x *NetworkResponse) Reset() {
+	*x = NetworkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkResponse) ProtoMessage() {}
+
+func (x *NetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkResponse.ProtoReflect.Descriptor instead.
+func (*NetworkResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NetworkResponse) GetInterfaces() []*NetInterface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *NetworkResponse) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+func (x *NetworkResponse) GetRoutes() []*SystemRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *NetworkResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// installs the agent with one of the persistence methods it reports, or removes it
+type PersistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method    string `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
+	Uninstall bool   `protobuf:"varint,2,opt,name=Uninstall,proto3" json:"Uninstall,omitempty"`
+}
+
+func (x *PersistRequest) Reset() {
+	*x = PersistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistRequest) ProtoMessage() {}
+
+func (x *PersistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistRequest.ProtoReflect.Descriptor instead.
+func (*PersistRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PersistRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PersistRequest) GetUninstall() bool {
+	if x != nil {
+		return x.Uninstall
+	}
+	return false
+}
+
+type PersistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location  string `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location,omitempty"` // what was created or removed
+	Err       bool   `protobuf:"varint,2,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,3,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *PersistResponse) Reset() {
+	*x = PersistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistResponse) ProtoMessage() {}
+
+func (x *PersistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistResponse.ProtoReflect.Descriptor instead.
+func (*PersistResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PersistResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PersistResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *PersistResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// has the agent remove its persistence, its executable and its leftovers, then exit
+type BurnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Persisted []string `protobuf:"bytes,1,rep,name=Persisted,proto3" json:"Persisted,omitempty"` // methods the server knows were installed
+}
+
+func (x *BurnRequest) Reset() {
+	*x = BurnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnRequest) ProtoMessage() {}
+
+func (x *BurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnRequest.ProtoReflect.Descriptor instead.
+func (*BurnRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BurnRequest) GetPersisted() []string {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
+}
+
+type BurnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed []string `protobuf:"bytes,1,rep,name=Removed,proto3" json:"Removed,omitempty"`
+	Errors  []string `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"` // what couldn't be removed, the agent exits regardless
+}
+
+func (x *BurnResponse) Reset() {
+	*x = BurnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnResponse) ProtoMessage() {}
+
+func (x *BurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnResponse.ProtoReflect.Descriptor instead.
+func (*BurnResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BurnResponse) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *BurnResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// replaces the table of interfaces relayed connections leave through
+type BreakoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*BreakoutRule `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+}
+
+func (x *BreakoutRequest) Reset() {
+	*x = BreakoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutRequest) ProtoMessage() {}
+
+func (x *BreakoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutRequest.ProtoReflect.Descriptor instead.
+func (*BreakoutRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BreakoutRequest) GetRules() []*BreakoutRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type BreakoutRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cidr      string `protobuf:"bytes,1,opt,name=Cidr,proto3" json:"Cidr,omitempty"`
+	Interface string `protobuf:"bytes,2,opt,name=Interface,proto3" json:"Interface,omitempty"` // empty follows the routing table of the target
+}
+
+func (x *BreakoutRule) Reset() {
+	*x = BreakoutRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakoutRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutRule) ProtoMessage() {}
+
+func (x *BreakoutRule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutRule.ProtoReflect.Descriptor instead.
+func (*BreakoutRule) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BreakoutRule) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *BreakoutRule) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+type BreakoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err       bool   `protobuf:"varint,1,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,2,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *BreakoutResponse) Reset() {
+	*x = BreakoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutResponse) ProtoMessage() {}
+
+func (x *BreakoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutResponse.ProtoReflect.Descriptor instead.
+func (*BreakoutResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BreakoutResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *BreakoutResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// has the agent collect host information again
+type HostInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HostInfoRequest) Reset() {
+	*x = HostInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInfoRequest) ProtoMessage() {}
+
+func (x *HostInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInfoRequest.ProtoReflect.Descriptor instead.
+func (*HostInfoRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{48}
+}
+
+type HostInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host *HostInfo `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"` // missing if the agent was built without host information collection
+}
+
+func (x *HostInfoResponse) Reset() {
+	*x = HostInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInfoResponse) ProtoMessage() {}
+
+func (x *HostInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInfoResponse.ProtoReflect.Descriptor instead.
+func (*HostInfoResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *HostInfoResponse) GetHost() *HostInfo {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+// sent by the agent on a stream of its own when it recovers from a panic while connected, not answered
+type CrashReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crashes []*Crash `protobuf:"bytes,1,rep,name=Crashes,proto3" json:"Crashes,omitempty"`
+}
+
+func (x *CrashReport) Reset() {
+	*x = CrashReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReport) ProtoMessage() {}
+
+func (x *CrashReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReport.ProtoReflect.Descriptor instead.
+func (*CrashReport) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CrashReport) GetCrashes() []*Crash {
+	if x != nil {
+		return x.Crashes
+	}
+	return nil
+}
+
+// caps the bandwidth of the tunnel connection, each way
+type BandwidthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"` // bytes per second, 0 lifts the cap
+}
+
+func (x *BandwidthRequest) Reset() {
+	*x = BandwidthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthRequest) ProtoMessage() {}
+
+func (x *BandwidthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthRequest.ProtoReflect.Descriptor instead.
+func (*BandwidthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BandwidthRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BandwidthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err       bool   `protobuf:"varint,1,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,2,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *BandwidthResponse) Reset() {
+	*x = BandwidthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthResponse) ProtoMessage() {}
+
+func (x *BandwidthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthResponse.ProtoReflect.Descriptor instead.
+func (*BandwidthResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BandwidthResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *BandwidthResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// has the agent look for live hosts, answered with a SweepResult per live host and a SweepDone
+type SweepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Targets []string `protobuf:"bytes,1,rep,name=Targets,proto3" json:"Targets,omitempty"`  // addresses and networks
+	Ports   []uint32 `protobuf:"varint,2,rep,name=Ports,proto3" json:"Ports,omitempty"`     // TCP ports probed on every host
+	Timeout int64    `protobuf:"varint,3,opt,name=Timeout,proto3" json:"Timeout,omitempty"` // nanoseconds a host gets to answer
+	Workers int32    `protobuf:"varint,4,opt,name=Workers,proto3" json:"Workers,omitempty"` // hosts probed over TCP at once
+}
+
+func (x *SweepRequest) Reset() {
+	*x = SweepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepRequest) ProtoMessage() {}
+
+func (x *SweepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepRequest.ProtoReflect.Descriptor instead.
+func (*SweepRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SweepRequest) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SweepRequest) GetPorts() []uint32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *SweepRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *SweepRequest) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+type SweepResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Method  string   `protobuf:"bytes,2,opt,name=Method,proto3" json:"Method,omitempty"` // icmp or tcp
+	RTT     int64    `protobuf:"varint,3,opt,name=RTT,proto3" json:"RTT,omitempty"`      // nanoseconds
+	Open    []uint32 `protobuf:"varint,4,rep,name=Open,proto3" json:"Open,omitempty"`    // ports that accepted a connection
+}
+
+func (x *SweepResult) Reset() {
+	*x = SweepResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepResult) ProtoMessage() {}
+
+func (x *SweepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepResult.ProtoReflect.Descriptor instead.
+func (*SweepResult) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SweepResult) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SweepResult) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SweepResult) GetRTT() int64 {
+	if x != nil {
+		return x.RTT
+	}
+	return 0
+}
+
+func (x *SweepResult) GetOpen() []uint32 {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+type SweepDone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Probed    int32  `protobuf:"varint,1,opt,name=Probed,proto3" json:"Probed,omitempty"`
+	Alive     int32  `protobuf:"varint,2,opt,name=Alive,proto3" json:"Alive,omitempty"`
+	ICMP      bool   `protobuf:"varint,3,opt,name=ICMP,proto3" json:"ICMP,omitempty"` // echo requests could be sent
+	Err       bool   `protobuf:"varint,4,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,5,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *SweepDone) Reset() {
+	*x = SweepDone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepDone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepDone) ProtoMessage() {}
+
+func (x *SweepDone) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepDone.ProtoReflect.Descriptor instead.
+func (*SweepDone) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SweepDone) GetProbed() int32 {
+	if x != nil {
+		return x.Probed
+	}
+	return 0
+}
+
+func (x *SweepDone) GetAlive() int32 {
+	if x != nil {
+		return x.Alive
+	}
+	return 0
+}
+
+func (x *SweepDone) GetICMP() bool {
+	if x != nil {
+		return x.ICMP
+	}
+	return false
+}
+
+func (x *SweepDone) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *SweepDone) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+// has the agent test how it reaches the server
+type DiagnosticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DiagnosticsRequest) Reset() {
+	*x = DiagnosticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsRequest) ProtoMessage() {}
+
+func (x *DiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*DiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{56}
+}
+
+type DiagnosticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks []*DiagnosticCheck `protobuf:"bytes,1,rep,name=Checks,proto3" json:"Checks,omitempty"`
+}
+
+func (x *DiagnosticsResponse) Reset() {
+	*x = DiagnosticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsResponse) ProtoMessage() {}
+
+func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DiagnosticsResponse) GetChecks() []*DiagnosticCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+// hands the agent a new certificate and key from the same CA, it reconnects with them once it answers
+type CertificateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate []byte `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"` // PEM
+	Key         []byte `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`                 // PEM
+}
+
+func (x *CertificateRequest) Reset() {
+	*x = CertificateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRequest) ProtoMessage() {}
+
+func (x *CertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRequest.ProtoReflect.Descriptor instead.
+func (*CertificateRequest) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CertificateRequest) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *CertificateRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type CertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err       bool   `protobuf:"varint,1,opt,name=Err,proto3" json:"Err,omitempty"`
+	ErrString string `protobuf:"bytes,2,opt,name=ErrString,proto3" json:"ErrString,omitempty"`
+}
+
+func (x *CertificateResponse) Reset() {
+	*x = CertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateResponse) ProtoMessage() {}
+
+func (x *CertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateResponse.ProtoReflect.Descriptor instead.
+func (*CertificateResponse) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CertificateResponse) GetErr() bool {
+	if x != nil {
+		return x.Err
+	}
+	return false
+}
+
+func (x *CertificateResponse) GetErrString() string {
+	if x != nil {
+		return x.ErrString
+	}
+	return ""
+}
+
+type DiagnosticCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"` // server, proxy, dns, clock or mtu
+	Target   string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	OK       bool   `protobuf:"varint,3,opt,name=OK,proto3" json:"OK,omitempty"`
+	Detail   string `protobuf:"bytes,4,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	Duration int64  `protobuf:"varint,5,opt,name=Duration,proto3" json:"Duration,omitempty"` // nanoseconds
+}
+
+func (x *DiagnosticCheck) Reset() {
+	*x = DiagnosticCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticCheck) ProtoMessage() {}
+
+func (x *DiagnosticCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticCheck.ProtoReflect.Descriptor instead.
+func (*DiagnosticCheck) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DiagnosticCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetOK() bool {
+	if x != nil {
+		return x.OK
+	}
+	return false
+}
+
+func (x *DiagnosticCheck) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// ARP or NDP table entry
+type Neighbor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	HardwareAddr []byte `protobuf:"bytes,2,opt,name=HardwareAddr,proto3" json:"HardwareAddr,omitempty"`
+	Interface    string `protobuf:"bytes,3,opt,name=Interface,proto3" json:"Interface,omitempty"`
+	State        string `protobuf:"bytes,4,opt,name=State,proto3" json:"State,omitempty"`
+}
+
+func (x *Neighbor) Reset() {
+	*x = Neighbor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Neighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Neighbor) ProtoMessage() {}
+
+func (x *Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Neighbor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Neighbor) GetHardwareAddr() []byte {
+	if x != nil {
+		return x.HardwareAddr
+	}
+	return nil
+}
+
+func (x *Neighbor) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Neighbor) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type SystemRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string `protobuf:"bytes,1,opt,name=Destination,proto3" json:"Destination,omitempty"` // CIDR
+	Gateway     string `protobuf:"bytes,2,opt,name=Gateway,proto3" json:"Gateway,omitempty"`         // empty if directly connected
+	Interface   string `protobuf:"bytes,3,opt,name=Interface,proto3" json:"Interface,omitempty"`
+	Metric      int64  `protobuf:"varint,4,opt,name=Metric,proto3" json:"Metric,omitempty"`
+}
+
+func (x *SystemRoute) Reset() {
+	*x = SystemRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_protocol_session_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemRoute) ProtoMessage() {}
+
+func (x *SystemRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_protocol_session_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemRoute.ProtoReflect.Descriptor instead.
+func (*SystemRoute) Descriptor() ([]byte, []int) {
+	return file_internal_protocol_session_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SystemRoute) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SystemRoute) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *SystemRoute) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *SystemRoute) GetMetric() int64 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+var File_internal_protocol_session_proto protoreflect.FileDescriptor
+
+var file_internal_protocol_session_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x69, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x94, 0x06, 0x0a,
+	0x09, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x3b, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78,
+	0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x43, 0x72, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x07, 0x43, 0x72,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x05, 0x43, 0x72, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x57, 0x68, 0x65, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x57, 0x68,
+	0x65, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x61, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x22, 0xe2, 0x01,
+	0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x76,
+	0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x76,
+	0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x54, 0x55,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x4d, 0x54, 0x55, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65,
+	0x74, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x4e,
+	0x65, 0x74, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x69, 0x61, 0x48, 0x6f, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x56, 0x69, 0x61, 0x48, 0x6f, 0x73, 0x74, 0x22, 0xb6,
+	0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x4e, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x70, 0x6f, 0x6f, 0x66, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x70, 0x6f, 0x6f, 0x66, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x0f,
+	0x48, 0x6f, 0x73, 0x74, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x0a, 0x10, 0x48, 0x6f, 0x73,
+	0x74, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x6c,
+	0x69, 0x76, 0x65, 0x22, 0x7b, 0x0a, 0x11, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x22, 0x54, 0x0a, 0x12, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
+	0x22, 0x49, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x45,
+	0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x14, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x28, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x57, 0x61, 0x6b, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x57, 0x61, 0x6b, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x57,
+	0x61, 0x6b, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x57, 0x61, 0x6b, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x6c, 0x65,
+	0x65, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x57, 0x61, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x57, 0x61, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x2c, 0x0a, 0x0e, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x34, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x33, 0x0a,
+	0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x45, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x22, 0x60, 0x0a, 0x0c, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x59, 0x0a, 0x0d, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22,
+	0x25, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x77, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x73, 0x44,
+	0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x69, 0x72, 0x22,
+	0x8b, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x45, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x41, 0x0a,
+	0x13, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x67, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x70, 0x0a, 0x14, 0x46, 0x69, 0x6c,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x45,
+	0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x66, 0x0a, 0x08, 0x46,
+	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x22, 0x41, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x56, 0x0a, 0x08, 0x45, 0x78, 0x65, 0x63, 0x45, 0x78, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72,
+	0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45,
+	0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3b, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x40, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72,
+	0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x35, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x42,
+	0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x22, 0x10, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x52, 0x09, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x33,
+	0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x0e, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x22, 0x5d, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x22, 0x2b, 0x0a, 0x0b, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0x40, 0x0a, 0x0c, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x22, 0x45, 0x0a, 0x0f, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x69, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x11,
+	0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x40, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x48,
+	0x6f, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x0b, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x43, 0x72, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x07, 0x43, 0x72, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x10, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x43, 0x0a,
+	0x11, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x22, 0x76, 0x0a, 0x0c, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x05,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10, 0x00, 0x52,
+	0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x0b, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x52,
+	0x54, 0x54, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x52, 0x54, 0x54, 0x12, 0x16, 0x0a,
+	0x04, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10, 0x00, 0x52,
+	0x04, 0x4f, 0x70, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x09, 0x53, 0x77, 0x65, 0x65, 0x70, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c,
+	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x76, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x49, 0x43, 0x4d, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x13, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x06, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x4b, 0x65, 0x79, 0x22, 0x45, 0x0a, 0x13, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45,
+	0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x45, 0x72, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x81, 0x01, 0x0a, 0x0f,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x4f,
+	0x4b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x4f, 0x4b, 0x12, 0x16, 0x0a, 0x06, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x7c, 0x0a, 0x08, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x48, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7f, 0x0a,
+	0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_protocol_session_proto_rawDescOnce sync.Once
+	file_internal_protocol_session_proto_rawDescData = file_internal_protocol_session_proto_rawDesc
+)
+
+func file_internal_protocol_session_proto_rawDescGZIP() []byte {
+	file_internal_protocol_session_proto_rawDescOnce.Do(func() {
+		file_internal_protocol_session_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_protocol_session_proto_rawDescData)
+	})
+	return file_internal_protocol_session_proto_rawDescData
+}
+
+var file_internal_protocol_session_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_internal_protocol_session_proto_goTypes = []interface{}{
+	(*InfoRequest)(nil),             // 0: ligolo.session.InfoRequest
+	(*InfoReply)(nil),               // 1: ligolo.session.InfoReply
+	(*Crash)(nil),                   // 2: ligolo.session.Crash
+	(*HostInfo)(nil),                // 3: ligolo.session.HostInfo
+	(*NetInterface)(nil),            // 4: ligolo.session.NetInterface
+	(*RedirectorInterface)(nil),     // 5: ligolo.session.RedirectorInterface
+	(*ContainerInfo)(nil),           // 6: ligolo.session.ContainerInfo
+	(*ConnectRequest)(nil),          // 7: ligolo.session.ConnectRequest
+	(*ConnectResponse)(nil),         // 8: ligolo.session.ConnectResponse
+	(*HostPingRequest)(nil),         // 9: ligolo.session.HostPingRequest
+	(*HostPingResponse)(nil),        // 10: ligolo.session.HostPingResponse
+	(*RedirectorRequest)(nil),       // 11: ligolo.session.RedirectorRequest
+	(*RedirectorResponse)(nil),      // 12: ligolo.session.RedirectorResponse
+	(*RedirectorCloseRequest)(nil),  // 13: ligolo.session.RedirectorCloseRequest
+	(*RedirectorCloseResponse)(nil), // 14: ligolo.session.RedirectorCloseResponse
+	(*DisconnectRequest)(nil),       // 15: ligolo.session.DisconnectRequest
+	(*DisconnectResponse)(nil),      // 16: ligolo.session.DisconnectResponse
+	(*ThroughputRequest)(nil),       // 17: ligolo.session.ThroughputRequest
+	(*ThroughputResponse)(nil),      // 18: ligolo.session.ThroughputResponse
+	(*BeaconRequest)(nil),           // 19: ligolo.session.BeaconRequest
+	(*BeaconResponse)(nil),          // 20: ligolo.session.BeaconResponse
+	(*ForwardRequest)(nil),          // 21: ligolo.session.ForwardRequest
+	(*ForwardResponse)(nil),         // 22: ligolo.session.ForwardResponse
+	(*SocksRequest)(nil),            // 23: ligolo.session.SocksRequest
+	(*SocksResponse)(nil),           // 24: ligolo.session.SocksResponse
+	(*FileListRequest)(nil),         // 25: ligolo.session.FileListRequest
+	(*FileEntry)(nil),               // 26: ligolo.session.FileEntry
+	(*FileListResponse)(nil),        // 27: ligolo.session.FileListResponse
+	(*FileDownloadRequest)(nil),     // 28: ligolo.session.FileDownloadRequest
+	(*FileUploadRequest)(nil),       // 29: ligolo.session.FileUploadRequest
+	(*FileTransferResponse)(nil),    // 30: ligolo.session.FileTransferResponse
+	(*FileHash)(nil),                // 31: ligolo.session.FileHash
+	(*ExecRequest)(nil),             // 32: ligolo.session.ExecRequest
+	(*ExecOutput)(nil),              // 33: ligolo.session.ExecOutput
+	(*ExecExit)(nil),                // 34: ligolo.session.ExecExit
+	(*UpdateRequest)(nil),           // 35: ligolo.session.UpdateRequest
+	(*UpdateResponse)(nil),          // 36: ligolo.session.UpdateResponse
+	(*ScheduleRequest)(nil),         // 37: ligolo.session.ScheduleRequest
+	(*ScheduleResponse)(nil),        // 38: ligolo.session.ScheduleResponse
+	(*NetworkRequest)(nil),          // 39: ligolo.session.NetworkRequest
+	(*NetworkResponse)(nil),         // 40: ligolo.session.NetworkResponse
+	(*PersistRequest)(nil),          // 41: ligolo.session.PersistRequest
+	(*PersistResponse)(nil),         // 42: ligolo.session.PersistResponse
+	(*BurnRequest)(nil),             // 43: ligolo.session.BurnRequest
+	(*BurnResponse)(nil),            // 44: ligolo.session.BurnResponse
+	(*BreakoutRequest)(nil),         // 45: ligolo.session.BreakoutRequest
+	(*BreakoutRule)(nil),            // 46: ligolo.session.BreakoutRule
+	(*BreakoutResponse)(nil),        // 47: ligolo.session.BreakoutResponse
+	(*HostInfoRequest)(nil),         // 48: ligolo.session.HostInfoRequest
+	(*HostInfoResponse)(nil),        // 49: ligolo.session.HostInfoResponse
+	(*CrashReport)(nil),             // 50: ligolo.session.CrashReport
+	(*BandwidthRequest)(nil),        // 51: ligolo.session.BandwidthRequest
+	(*BandwidthResponse)(nil),       // 52: ligolo.session.BandwidthResponse
+	(*SweepRequest)(nil),            // 53: ligolo.session.SweepRequest
+	(*SweepResult)(nil),             // 54: ligolo.session.SweepResult
+	(*SweepDone)(nil),               // 55: ligolo.session.SweepDone
+	(*DiagnosticsRequest)(nil),      // 56: ligolo.session.DiagnosticsRequest
+	(*DiagnosticsResponse)(nil),     // 57: ligolo.session.DiagnosticsResponse
+	(*CertificateRequest)(nil),      // 58: ligolo.session.CertificateRequest
+	(*CertificateResponse)(nil),     // 59: ligolo.session.CertificateResponse
+	(*DiagnosticCheck)(nil),         // 60: ligolo.session.DiagnosticCheck
+	(*Neighbor)(nil),                // 61: ligolo.session.Neighbor
+	(*SystemRoute)(nil),             // 62: ligolo.session.SystemRoute
+}
+var file_internal_protocol_session_proto_depIdxs = []int32{
+	4,  // 0: ligolo.session.InfoReply.Interfaces:type_name -> ligolo.session.NetInterface
+	5,  // 1: ligolo.session.InfoReply.Redirectors:type_name -> ligolo.session.RedirectorInterface
+	6,  // 2: ligolo.session.InfoReply.Container:type_name -> ligolo.session.ContainerInfo
+	3,  // 3: ligolo.session.InfoReply.Host:type_name -> ligolo.session.HostInfo
+	2,  // 4: ligolo.session.InfoReply.Crashes:type_name -> ligolo.session.Crash
+	26, // 5: ligolo.session.FileListResponse.Entries:type_name -> ligolo.session.FileEntry
+	4,  // 6: ligolo.session.NetworkResponse.Interfaces:type_name -> ligolo.session.NetInterface
+	61, // 7: ligolo.session.NetworkResponse.Neighbors:type_name -> ligolo.session.Neighbor
+	62, // 8: ligolo.session.NetworkResponse.Routes:type_name -> ligolo.session.SystemRoute
+	46, // 9: ligolo.session.BreakoutRequest.Rules:type_name -> ligolo.session.BreakoutRule
+	3,  // 10: ligolo.session.HostInfoResponse.Host:type_name -> ligolo.session.HostInfo
+	2,  // 11: ligolo.session.CrashReport.Crashes:type_name -> ligolo.session.Crash
+	60, // 12: ligolo.session.DiagnosticsResponse.Checks:type_name -> ligolo.session.DiagnosticCheck
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_internal_protocol_session_proto_init() }
+func file_internal_protocol_session_proto_init() {
+	if File_internal_protocol_session_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_protocol_session_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Crash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectorInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostPingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostPingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectorCloseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectorCloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThroughputRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThroughputResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDownloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecExit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakoutRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BandwidthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BandwidthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepDone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Neighbor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_protocol_session_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_protocol_session_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   63,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_internal_protocol_session_proto_goTypes,
+		DependencyIndexes: file_internal_protocol_session_proto_depIdxs,
+		MessageInfos:      file_internal_protocol_session_proto_msgTypes,
+	}.Build()
+	File_internal_protocol_session_proto = out.File
+	file_internal_protocol_session_proto_rawDesc = nil
+	file_internal_protocol_session_proto_goTypes = nil
+	file_internal_protocol_session_proto_depIdxs = nil
+}
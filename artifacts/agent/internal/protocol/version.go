@@ -0,0 +1,51 @@
+package protocol
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Version is the session protocol this side speaks. Bump it whenever a message changes in a way an older peer
+// would misread, and say what changed in Versions
+const Version = uint16(4)
+
+// MinVersion is the oldest protocol this side still talks. Raising it cuts agents of older builds off
+const MinVersion = uint16(1)
+
+// Versions is what each protocol version brought, agents of builds predating versioning report none and speak 1
+var Versions = map[uint16]string{
+	1: "every message up to diagnostics, gob or protobuf encoding",
+	2: "protocol version negotiation in the info exchange",
+	3: "relay stream compression negotiated in the info exchange",
+	4: "agent certificate rotation over the session",
+}
+
+// ErrIncompatible is returned when one side is too old for the other, the agent has to be rebuilt or the server
+// upgraded
+var ErrIncompatible = errors.New("incompatible protocol")
+
+// NegotiateVersion picks the protocol of a session, the newest both sides speak, given what the peer reported in
+// the info exchange
+func NegotiateVersion(peerVersion uint16, peerMinVersion uint16) (uint16, error) {
+	if peerVersion == 0 {
+		peerVersion = 1 // predates versioning
+	}
+	if peerMinVersion == 0 {
+		peerMinVersion = 1
+	}
+
+	version := Version
+	if peerVersion < version {
+		version = peerVersion
+	}
+
+	if version < MinVersion {
+		return 0, fmt.Errorf("%w: peer speaks v%d, v%d or later is needed, rebuild the agent", ErrIncompatible, peerVersion, MinVersion)
+	}
+
+	if version < peerMinVersion {
+		return 0, fmt.Errorf("%w: peer needs v%d or later, only v%d is spoken here, upgrade the server", ErrIncompatible, peerMinVersion, Version)
+	}
+
+	return version, nil
+}
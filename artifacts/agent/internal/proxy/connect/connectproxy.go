@@ -19,9 +19,11 @@ import (
 // New constructs an HttpConnectTunnel to be used a net.Dial command.
 // The first parameter is a proxy URL, for example https://foo.example.com:9090 will use foo.example.com as proxy on
 // port 9090 using TLS for connectivity.
-func New(proxyUrl *url.URL, dialer proxy.Dialer, timeout time.Duration, tlsConfig *tls.Config) (*HttpConnectTunnel, error) {
+// The User-Agent of CONNECT requests is userAgent, or Go's default if it's empty.
+func New(proxyUrl *url.URL, dialer proxy.Dialer, timeout time.Duration, tlsConfig *tls.Config, userAgent string) (*HttpConnectTunnel, error) {
 	t := &HttpConnectTunnel{
 		timeout:      timeout, // not sure this actually works as intended
+		userAgent:    userAgent,
 		parentDialer: dialer,
 		proxyScheme:  proxyUrl.Scheme,
 		proxyHost:    proxyUrl.Hostname(),
@@ -51,15 +53,15 @@ func New(proxyUrl *url.URL, dialer proxy.Dialer, timeout time.Duration, tlsConfi
 	return t, nil
 }
 
-func HttpHandler(timeout time.Duration) func(proxyUrl *url.URL, dialer proxy.Dialer) (proxy.Dialer, error) {
+func HttpHandler(timeout time.Duration, userAgent string) func(proxyUrl *url.URL, dialer proxy.Dialer) (proxy.Dialer, error) {
 	return func(proxyUrl *url.URL, dialer proxy.Dialer) (proxy.Dialer, error) {
-		return New(proxyUrl, dialer, timeout, nil)
+		return New(proxyUrl, dialer, timeout, nil, userAgent)
 	}
 }
 
-func HttpsHandler(timeout time.Duration, tlsConfig *tls.Config) func(proxyUrl *url.URL, dialer proxy.Dialer) (proxy.Dialer, error) {
+func HttpsHandler(timeout time.Duration, tlsConfig *tls.Config, userAgent string) func(proxyUrl *url.URL, dialer proxy.Dialer) (proxy.Dialer, error) {
 	return func(proxyUrl *url.URL, dialer proxy.Dialer) (proxy.Dialer, error) {
-		return New(proxyUrl, dialer, timeout, tlsConfig)
+		return New(proxyUrl, dialer, timeout, tlsConfig, userAgent)
 	}
 }
 
@@ -76,6 +78,7 @@ type HttpConnectTunnel struct {
 	proxyHost    string
 	proxyPort    string
 	proxyPath    string
+	userAgent    string
 	auth         ProxyAuthorization
 }
 
@@ -110,6 +113,9 @@ func (t HttpConnectTunnel) DialContext(ctx context.Context, network string, addr
 		Host:   address, // This is weird
 		Header: make(http.Header),
 	}
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
 	if t.auth != nil && t.auth.InitialResponse() != "" {
 		req.Header.Set(hdrProxyAuthResp, t.auth.Type()+" "+t.auth.InitialResponse())
 	}
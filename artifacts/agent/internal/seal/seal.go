@@ -0,0 +1,147 @@
+// Package seal encrypts the configuration embedded in agents, so C2 addresses and keys don't show up in the
+// binary. The key is never embedded: agents derive it at runtime from a passphrase they are given or from
+// properties of the machine they run on
+package seal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+const (
+	KeyPassphrase = "passphrase" // given to the agent with -key
+	KeyHostname   = "hostname"   // hostname of the target, without domain and case insensitive
+)
+
+const (
+	saltSize   = 16
+	iterations = 100000
+)
+
+var ErrInvalidKey = errors.New("invalid key")
+
+// Secret normalizes what the key gets derived from, the server and the agent have to agree on it
+func Secret(source string, value string) string {
+	switch source {
+	case KeyHostname:
+		value = strings.ToLower(strings.TrimSpace(value))
+		if host, _, found := strings.Cut(value, "."); found {
+			value = host
+		}
+	}
+
+	return value
+}
+
+// Seal encrypts fields with AES-GCM under a key derived from secret, the result is base64 so it can be templated
+func Seal(fields []string, secret string) (string, error) {
+	var plaintext []byte
+	for _, field := range fields {
+		plaintext = binary.BigEndian.AppendUint32(plaintext, uint32(len(field)))
+		plaintext = append(plaintext, field...)
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	aead, err := newAEAD(secret, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := append(salt, nonce...)
+	sealed = aead.Seal(sealed, nonce, plaintext, nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts what Seal produced, ErrInvalidKey means the secret is wrong
+func Open(sealed string, secret string) ([]string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < saltSize {
+		return nil, ErrInvalidKey
+	}
+
+	aead, err := newAEAD(secret, data[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	data = data[saltSize:]
+	if len(data) < aead.NonceSize() {
+		return nil, ErrInvalidKey
+	}
+
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+
+	var fields []string
+	for len(plaintext) > 0 {
+		if len(plaintext) < 4 {
+			return nil, ErrInvalidKey
+		}
+
+		size := binary.BigEndian.Uint32(plaintext)
+		plaintext = plaintext[4:]
+		if uint64(size) > uint64(len(plaintext)) {
+			return nil, ErrInvalidKey
+		}
+
+		fields = append(fields, string(plaintext[:size]))
+		plaintext = plaintext[size:]
+	}
+
+	return fields, nil
+}
+
+func newAEAD(secret string, salt []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(deriveKey([]byte(secret), salt))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// deriveKey is PBKDF2-HMAC-SHA256 with a single output block, the agent module predates crypto/pbkdf2
+func deriveKey(secret []byte, salt []byte) []byte {
+	prf := hmac.New(sha256.New, secret)
+	prf.Write(salt)
+	prf.Write([]byte{0, 0, 0, 1})
+	u := prf.Sum(nil)
+
+	key := make([]byte, len(u))
+	copy(key, u)
+
+	for i := 1; i < iterations; i++ {
+		prf.Reset()
+		prf.Write(u)
+		u = prf.Sum(u[:0])
+
+		for j := range key {
+			key[j] ^= u[j]
+		}
+	}
+
+	return key
+}
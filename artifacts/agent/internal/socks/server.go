@@ -0,0 +1,253 @@
+// Package socks is a minimal SOCKS5 server (RFC 1928) supporting CONNECT, with optional username/password
+// authentication (RFC 1929). It lets tooling on the target use the pivot without going through the server
+package socks
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/relay"
+)
+
+const (
+	version5 = 0x05
+
+	authNone         = 0x00
+	authPassword     = 0x02
+	authUnacceptable = 0xff
+
+	cmdConnect = 0x01
+
+	addrIPv4   = 0x01
+	addrDomain = 0x03
+	addrIPv6   = 0x04
+
+	replySucceeded          = 0x00
+	replyHostUnreachable    = 0x04
+	replyCommandUnsupported = 0x07
+	replyAddrUnsupported    = 0x08
+
+	handshakeTimeout = 30 * time.Second
+)
+
+// DialFunc reaches the destinations clients ask for
+type DialFunc func(ctx context.Context, network string, address string) (net.Conn, error)
+
+type Server struct {
+	Listener net.Listener
+	Username string
+	Password string
+	Dial     DialFunc
+}
+
+func Listen(address string, username string, password string, dial DialFunc) (*Server, error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{Listener: lis, Username: username, Password: password, Dial: dial}, nil
+}
+
+// Serve accepts clients until the listener gets closed
+func (s *Server) Serve() error {
+	for {
+		conn, err := s.Listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) Close() error {
+	return s.Listener.Close()
+}
+
+func (s *Server) Addr() string {
+	return s.Listener.Addr().String()
+}
+
+func (s *Server) handle(conn net.Conn) {
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+
+	if err := s.negotiate(conn); err != nil {
+		conn.Close()
+		return
+	}
+
+	target, err := s.request(conn)
+	if err != nil {
+		conn.Close()
+		return
+	}
+
+	conn.SetDeadline(time.Time{})
+	relay.StartRelay(conn, target)
+}
+
+// negotiate picks the authentication method and runs it
+func (s *Server) negotiate(conn net.Conn) error {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return err
+	}
+	if header[0] != version5 {
+		return errors.New("unsupported SOCKS version")
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return err
+	}
+
+	wanted := byte(authNone)
+	if s.Username != "" {
+		wanted = authPassword
+	}
+
+	for _, method := range methods {
+		if method == wanted {
+			if _, err := conn.Write([]byte{version5, wanted}); err != nil {
+				return err
+			}
+
+			if wanted == authPassword {
+				return s.authenticate(conn)
+			}
+			return nil
+		}
+	}
+
+	conn.Write([]byte{version5, authUnacceptable})
+	return errors.New("no acceptable authentication method")
+}
+
+func (s *Server) authenticate(conn net.Conn) error {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return err
+	}
+
+	username := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, username); err != nil {
+		return err
+	}
+
+	size := make([]byte, 1)
+	if _, err := io.ReadFull(conn, size); err != nil {
+		return err
+	}
+
+	password := make([]byte, size[0])
+	if _, err := io.ReadFull(conn, password); err != nil {
+		return err
+	}
+
+	userOK := subtle.ConstantTimeCompare(username, []byte(s.Username))
+	passOK := subtle.ConstantTimeCompare(password, []byte(s.Password))
+	if userOK&passOK != 1 {
+		conn.Write([]byte{0x01, 0x01})
+		return errors.New("invalid credentials")
+	}
+
+	_, err := conn.Write([]byte{0x01, 0x00})
+	return err
+}
+
+// request reads the client's command and connects to the destination
+func (s *Server) request(conn net.Conn) (net.Conn, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	if header[0] != version5 {
+		return nil, errors.New("unsupported SOCKS version")
+	}
+
+	var host string
+	switch header[3] {
+	case addrIPv4:
+		ip := make([]byte, net.IPv4len)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return nil, err
+		}
+		host = net.IP(ip).String()
+	case addrIPv6:
+		ip := make([]byte, net.IPv6len)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return nil, err
+		}
+		host = net.IP(ip).String()
+	case addrDomain:
+		size := make([]byte, 1)
+		if _, err := io.ReadFull(conn, size); err != nil {
+			return nil, err
+		}
+		domain := make([]byte, size[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return nil, err
+		}
+		host = string(domain)
+	default:
+		reply(conn, replyAddrUnsupported, nil)
+		return nil, errors.New("unsupported address type")
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return nil, err
+	}
+
+	if header[1] != cmdConnect {
+		reply(conn, replyCommandUnsupported, nil)
+		return nil, errors.New("unsupported command")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+
+	address := net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port))))
+	target, err := s.Dial(ctx, "tcp", address)
+	if err != nil {
+		reply(conn, replyHostUnreachable, nil)
+		return nil, err
+	}
+
+	if err := reply(conn, replySucceeded, target.LocalAddr()); err != nil {
+		target.Close()
+		return nil, err
+	}
+
+	return target, nil
+}
+
+func reply(conn net.Conn, code byte, bound net.Addr) error {
+	ip := net.IPv4zero.To4()
+	port := 0
+	if tcpAddr, ok := bound.(*net.TCPAddr); ok {
+		ip, port = tcpAddr.IP, tcpAddr.Port
+	}
+
+	atyp := byte(addrIPv4)
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	} else {
+		atyp = addrIPv6
+		ip = ip.To16()
+	}
+
+	msg := []byte{version5, code, 0x00, atyp}
+	msg = append(msg, ip...)
+	msg = binary.BigEndian.AppendUint16(msg, uint16(port))
+
+	_, err := conn.Write(msg)
+	return err
+}
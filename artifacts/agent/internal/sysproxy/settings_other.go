@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package sysproxy
+
+// readSettings has nothing to read outside of Windows, proxies there come from the environment
+func readSettings() settings {
+	return settings{}
+}
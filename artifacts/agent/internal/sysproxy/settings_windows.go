@@ -0,0 +1,83 @@
+//go:build windows
+// +build windows
+
+package sysproxy
+
+import (
+	"encoding/binary"
+	"os"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const internetSettings = `Software\Microsoft\Windows\CurrentVersion\Internet Settings`
+
+// autoDetectFlag is set in DefaultConnectionSettings when "Automatically detect settings" is ticked
+const autoDetectFlag = 0x08
+
+func readSettings() settings {
+	var cfg settings
+
+	if key, err := openKey(internetSettings); err == nil {
+		if enabled, ok := queryValue(key, "ProxyEnable"); ok && len(enabled) >= 4 && binary.LittleEndian.Uint32(enabled) != 0 {
+			if server, ok := queryValue(key, "ProxyServer"); ok {
+				cfg.proxies = parseProxyServer(utf16String(server))
+			}
+			if override, ok := queryValue(key, "ProxyOverride"); ok {
+				cfg.bypass = strings.Split(utf16String(override), ";")
+			}
+		}
+		if pacURL, ok := queryValue(key, "AutoConfigURL"); ok {
+			cfg.pacURL = utf16String(pacURL)
+		}
+		windows.RegCloseKey(key)
+	}
+
+	if key, err := openKey(internetSettings + `\Connections`); err == nil {
+		if conn, ok := queryValue(key, "DefaultConnectionSettings"); ok && len(conn) > 8 {
+			cfg.autoDetect = conn[8]&autoDetectFlag != 0
+		}
+		windows.RegCloseKey(key)
+	}
+
+	var size uint32 = 256
+	buf := make([]uint16, size)
+	if err := windows.GetComputerNameEx(windows.ComputerNameDnsDomain, &buf[0], &size); err == nil && size > 0 {
+		cfg.domain = windows.UTF16ToString(buf[:size])
+	} else {
+		cfg.domain = os.Getenv("USERDNSDOMAIN")
+	}
+
+	return cfg
+}
+
+func openKey(path string) (windows.Handle, error) {
+	var key windows.Handle
+	err := windows.RegOpenKeyEx(windows.HKEY_CURRENT_USER, windows.StringToUTF16Ptr(path), 0, windows.KEY_READ, &key)
+	return key, err
+}
+
+func queryValue(key windows.Handle, name string) ([]byte, bool) {
+	namePtr := windows.StringToUTF16Ptr(name)
+
+	var size uint32
+	if err := windows.RegQueryValueEx(key, namePtr, nil, nil, nil, &size); err != nil || size == 0 {
+		return nil, false
+	}
+
+	buf := make([]byte, size)
+	if err := windows.RegQueryValueEx(key, namePtr, nil, nil, &buf[0], &size); err != nil {
+		return nil, false
+	}
+
+	return buf[:size], true
+}
+
+func utf16String(value []byte) string {
+	if len(value) < 2 {
+		return ""
+	}
+	return windows.UTF16ToString(unsafe.Slice((*uint16)(unsafe.Pointer(&value[0])), len(value)/2))
+}
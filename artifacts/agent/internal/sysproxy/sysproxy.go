@@ -0,0 +1,292 @@
+// Package sysproxy works out how this machine reaches a server: proxies from the environment, the Windows
+// Internet Settings, and PAC files from their configured URL or found through WPAD
+package sysproxy
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/pac"
+)
+
+// cacheTTL is how long detected settings and PAC files are kept, so reconnects don't go through WPAD every time
+const cacheTTL = 15 * time.Minute
+
+// maxPACSize is the most that is read of a PAC file, real ones are a few kilobytes
+const maxPACSize = 1 << 20
+
+// Candidate is one way of reaching a server
+type Candidate struct {
+	URL    *url.URL // nil connects directly
+	Source string   // where the proxy was found: env, registry, pac or wpad
+}
+
+// String describes the candidate without credentials, e.g. "http://proxy:8080 (pac)"
+func (c Candidate) String() string {
+	if c.URL == nil {
+		return "direct"
+	}
+
+	u := *c.URL
+	u.User = nil
+	return fmt.Sprintf("%s (%s)", u.String(), c.Source)
+}
+
+// settings are what the system is configured with, as read by readSettings
+type settings struct {
+	proxies    map[string]*url.URL // manual proxies per scheme (http, https, socks), "" applies to all of them
+	bypass     []string            // shell expressions of hosts reached directly, "<local>" for plain host names
+	pacURL     string
+	autoDetect bool   // look for a PAC file through WPAD
+	domain     string // DNS domain WPAD starts from
+}
+
+type Detector struct {
+	mu       sync.Mutex
+	detected time.Time
+	settings settings
+	script   *pac.Script
+	source   string // pac or wpad
+}
+
+var defaultDetector Detector
+
+// For lists the ways to reach address, a host:port, in the order they should be tried, direct is always last
+func For(address string) []Candidate {
+	return defaultDetector.For(address)
+}
+
+func (d *Detector) For(address string) []Candidate {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	var candidates []Candidate
+	add := func(u *url.URL, source string) {
+		for _, c := range candidates {
+			if (c.URL == nil) == (u == nil) && (u == nil || c.URL.String() == u.String()) {
+				return
+			}
+		}
+		candidates = append(candidates, Candidate{URL: u, Source: source})
+	}
+
+	if u := fromEnvironment(host); u != nil {
+		add(u, "env")
+	}
+
+	cfg, script, source := d.detect()
+	if script != nil {
+		if result, err := script.FindProxyForURL("https://"+address+"/", host); err == nil {
+			for _, u := range pac.ParseResult(result) {
+				add(u, source)
+			}
+		}
+	}
+
+	if !bypassed(host, cfg.bypass) {
+		for _, scheme := range []string{"https", "socks", ""} {
+			if u, ok := cfg.proxies[scheme]; ok {
+				add(u, "registry")
+				break
+			}
+		}
+	}
+
+	add(nil, "")
+
+	return candidates
+}
+
+// detect reads the system settings and fetches the PAC file they point to, both are cached for cacheTTL
+func (d *Detector) detect() (settings, *pac.Script, string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.detected.IsZero() && time.Since(d.detected) < cacheTTL {
+		return d.settings, d.script, d.source
+	}
+
+	d.settings = readSettings()
+	d.script, d.source = nil, ""
+	if d.settings.pacURL != "" {
+		if script, err := fetchPAC(d.settings.pacURL); err == nil {
+			d.script, d.source = script, "pac"
+		}
+	}
+	if d.script == nil && d.settings.autoDetect {
+		for _, wpadURL := range wpadURLs(d.settings.domain) {
+			if script, err := fetchPAC(wpadURL); err == nil {
+				d.script, d.source = script, "wpad"
+				break
+			}
+		}
+	}
+	d.detected = time.Now()
+
+	return d.settings, d.script, d.source
+}
+
+// fromEnvironment is the proxy the usual variables set for an HTTPS connection to host, nil when there is none
+// or NO_PROXY covers host
+func fromEnvironment(host string) *url.URL {
+	value := getenv("HTTPS_PROXY")
+	if value == "" {
+		value = getenv("ALL_PROXY")
+	}
+	if value == "" || noProxy(host, getenv("NO_PROXY")) {
+		return nil
+	}
+
+	return parseProxy(value, "http")
+}
+
+func getenv(name string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return os.Getenv(strings.ToLower(name))
+}
+
+// parseProxy reads a proxy address, defaultScheme is used when it doesn't have one
+func parseProxy(value, defaultScheme string) *url.URL {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, "://") {
+		value = defaultScheme + "://" + value
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	if u.Scheme == "socks" {
+		u.Scheme = "socks5"
+	}
+
+	return u
+}
+
+// noProxy tells whether a NO_PROXY list covers host, entries are domains, addresses, CIDRs or *
+func noProxy(host, list string) bool {
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+
+		switch {
+		case entry == "":
+		case entry == "*":
+			return true
+		case strings.Contains(entry, "/"):
+			if _, network, err := net.ParseCIDR(entry); err == nil && ip != nil && network.Contains(ip) {
+				return true
+			}
+		default:
+			entry = strings.TrimPrefix(strings.TrimPrefix(entry, "*"), ".")
+			if host == entry || strings.HasSuffix(host, "."+entry) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// bypassed tells whether Windows would reach host directly, going by the ProxyOverride list
+func bypassed(host string, bypass []string) bool {
+	host = strings.ToLower(host)
+	for _, entry := range bypass {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "<local>" && !strings.Contains(host, ".") || entry != "" && pac.ShExpMatch(host, entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseProxyServer reads the ProxyServer value of Internet Settings, either "host:port" for every scheme or
+// "http=host:port;https=host:port;socks=host:port"
+func parseProxyServer(value string) map[string]*url.URL {
+	proxies := make(map[string]*url.URL)
+	for _, entry := range strings.Split(value, ";") {
+		scheme, address, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			scheme, address = "", scheme
+		}
+		if address == "" {
+			continue
+		}
+
+		defaultScheme := "http"
+		if scheme == "socks" {
+			defaultScheme = "socks5" // it's SOCKS4 for Windows, servers mostly speak both
+		}
+		if u := parseProxy(address, defaultScheme); u != nil {
+			proxies[strings.ToLower(scheme)] = u
+		}
+	}
+
+	return proxies
+}
+
+// wpadURLs are where WPAD looks for the PAC file, from the machine's domain up to the one below the TLD
+func wpadURLs(domain string) []string {
+	var urls []string
+	labels := strings.Split(strings.Trim(strings.ToLower(domain), "."), ".")
+	for i := 0; i < len(labels)-1; i++ {
+		urls = append(urls, "http://wpad."+strings.Join(labels[i:], ".")+"/wpad.dat")
+	}
+
+	return urls
+}
+
+func fetchPAC(pacURL string) (*pac.Script, error) {
+	u, err := url.Parse(pacURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var src []byte
+	if u.Scheme == "file" {
+		path := u.Path
+		if len(path) > 2 && path[0] == '/' && path[2] == ':' {
+			path = path[1:] // file:///C:/proxy.pac
+		} else if u.Host != "" {
+			path = "//" + u.Host + u.Path // UNC share
+		}
+		if src, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	} else {
+		client := &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: &http.Transport{Proxy: nil},
+		}
+		resp, err := client.Get(pacURL)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s returned %s", pacURL, resp.Status)
+		}
+		if src, err = io.ReadAll(io.LimitReader(resp.Body, maxPACSize)); err != nil {
+			return nil, err
+		}
+	}
+
+	return pac.Parse(string(src))
+}
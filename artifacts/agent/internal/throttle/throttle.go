@@ -0,0 +1,140 @@
+package throttle
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	burst   = 100 * time.Millisecond // worth of traffic a bucket holds, also the longest a wait lasts before the rate is read again
+	minSize = 1024                   // bytes granted at once, so slow rates don't end up in tiny reads and writes
+)
+
+// Bucket is a token bucket, the connections sharing it get its rate between them. The rate can change while they
+// are in use
+type Bucket struct {
+	mu     sync.Mutex
+	rate   int64 // bytes per second, 0 for no limit
+	tokens float64
+	last   time.Time
+}
+
+func (b *Bucket) SetRate(rate int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if rate < 0 {
+		rate = 0
+	}
+	b.rate = rate
+	b.tokens = 0
+	b.last = time.Now()
+}
+
+func (b *Bucket) Rate() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.rate
+}
+
+// take waits until some of n bytes can go through, and tells how many
+func (b *Bucket) take(n int) int {
+	for {
+		b.mu.Lock()
+		if b.rate == 0 {
+			b.mu.Unlock()
+			return n
+		}
+
+		size := b.size()
+		if n < size {
+			size = n
+		}
+
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * float64(b.rate)
+		b.last = now
+		if full := float64(b.size()); b.tokens > full {
+			b.tokens = full
+		}
+
+		if b.tokens >= float64(size) {
+			b.tokens -= float64(size)
+			b.mu.Unlock()
+			return size
+		}
+
+		wait := time.Duration((float64(size) - b.tokens) / float64(b.rate) * float64(time.Second))
+		b.mu.Unlock()
+
+		if wait > burst {
+			wait = burst
+		}
+		time.Sleep(wait)
+	}
+}
+
+// give returns bytes taken but not used
+func (b *Bucket) give(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate != 0 {
+		b.tokens += float64(n)
+	}
+}
+
+// size is the most a bucket holds, called with the lock held
+func (b *Bucket) size() int {
+	size := int(float64(b.rate) * burst.Seconds())
+	if size < minSize {
+		return minSize
+	}
+
+	return size
+}
+
+type conn struct {
+	net.Conn
+	read  *Bucket
+	write *Bucket
+}
+
+// Conn limits reads and writes of a connection to the rates of their buckets, either may be nil to leave that way
+// alone
+func Conn(c net.Conn, read *Bucket, write *Bucket) net.Conn {
+	return &conn{Conn: c, read: read, write: write}
+}
+
+func (c *conn) Read(p []byte) (int, error) {
+	if c.read == nil || len(p) == 0 {
+		return c.Conn.Read(p)
+	}
+
+	granted := c.read.take(len(p))
+	n, err := c.Conn.Read(p[:granted])
+	c.read.give(granted - n)
+
+	return n, err
+}
+
+func (c *conn) Write(p []byte) (int, error) {
+	if c.write == nil {
+		return c.Conn.Write(p)
+	}
+
+	written := 0
+	for len(p) > 0 {
+		granted := c.write.take(len(p))
+		n, err := c.Conn.Write(p[:granted])
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[granted:]
+	}
+
+	return written, nil
+}
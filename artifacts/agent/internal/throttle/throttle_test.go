@@ -0,0 +1,41 @@
+package throttle
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	var bucket Bucket
+	bucket.SetRate(100 * 1024)
+	limited := Conn(client, nil, &bucket)
+
+	go func() {
+		limited.Write(make([]byte, 50*1024))
+		limited.Close()
+	}()
+
+	start := time.Now()
+	received, err := io.Copy(io.Discard, server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elapsed := time.Since(start)
+
+	if received != 50*1024 {
+		t.Fatalf("expected %d bytes, got %d", 50*1024, received)
+	}
+	if elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Fatalf("50 KiB at 100 KiB/s took %s", elapsed)
+	}
+
+	bucket.SetRate(0)
+	if granted := bucket.take(1 << 20); granted != 1<<20 {
+		t.Fatalf("uncapped bucket granted %d bytes", granted)
+	}
+}
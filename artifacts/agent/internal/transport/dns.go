@@ -0,0 +1,785 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	dnsMinPoll      = 50 * time.Millisecond // agents poll at that pace while data moves
+	dnsMaxPoll      = time.Second           // and back off to that when the link is idle
+	dnsQueryTimeout = 5 * time.Second
+	dnsSessionIdle  = time.Minute // the server forgets sessions it got no query for in that long
+	dnsDownChunk    = 150         // bytes per answer, keeps answers within 512 bytes without EDNS
+	dnsMaxPending   = 64 * 1024   // writes block past that much data waiting to be sent
+	dnsQueryHeader  = 14          // session, upstream sequence, downstream acknowledgement and a nonce
+	dnsAnswerHeader = 8           // next upstream sequence expected and downstream sequence
+)
+
+// dnsEncoding is case insensitive once lowered, resolvers are free to mix the case of the names they forward
+var dnsEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// errDNSSessionGone is what an NXDOMAIN means to agents, the server doesn't know their session (anymore)
+var errDNSSessionGone = errors.New("dns session closed by the server")
+
+// DNSSettings configures the DNS transport
+type DNSSettings struct {
+	Domain    string // zone delegated to the server, required to listen
+	UserAgent string // sent by agents to DNS-over-HTTPS resolvers, empty for none
+	Profile   *Profile
+}
+
+// DNS tunnels the TLS session through TXT queries for a domain delegated to the server, for networks where DNS is
+// the only way out, e.g. dns://t.example.com:53. Agents query the system resolver, or a DNS-over-HTTPS resolver
+// given in the query, e.g. dns://t.example.com:53?doh=https://1.1.1.1/dns-query, the port is ignored. Each query
+// carries a chunk each way and waits for its answer, a few kilobytes per second at best: it's a fallback to get a
+// session at all
+type DNS struct {
+	settings DNSSettings
+}
+
+func NewDNS(settings DNSSettings) *DNS {
+	return &DNS{settings: settings}
+}
+
+func (t *DNS) Name() string {
+	return "dns"
+}
+
+// WithProfile has agents send the headers of the profile to DNS-over-HTTPS resolvers
+func (t *DNS) WithProfile(profile *Profile) Transport {
+	settings := t.settings
+	settings.Profile = profile
+	return NewDNS(settings)
+}
+
+// Listen answers queries on UDP, the server has to be the authoritative name server of the domain
+func (t *DNS) Listen(address string, config *tls.Config) (net.Listener, error) {
+	domain := strings.Trim(strings.ToLower(t.settings.Domain), ".")
+	if domain == "" {
+		return nil, errors.New("dns transport needs the domain delegated to the server")
+	}
+
+	conn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dnsListener{
+		conn:     conn,
+		domain:   domain,
+		config:   config,
+		sessions: make(map[uint32]*dnsServerConn),
+		accepted: make(chan net.Conn),
+		closed:   make(chan struct{}),
+	}
+	go result.serve()
+	go result.expire()
+
+	return result, nil
+}
+
+// Dial opens a session with a first query, so that a server that can't be reached fails here rather than later
+func (t *DNS) Dial(dialer Dialer, address string, config *tls.Config) (net.Conn, error) {
+	hostPort, rest := SplitPath(address)
+	domain, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, err
+	}
+	domain = strings.Trim(strings.ToLower(domain), ".")
+
+	chunk := dnsUpChunk(domain)
+	if chunk <= 0 {
+		return nil, fmt.Errorf("%s is too long a domain to tunnel through", domain)
+	}
+
+	exchange, resolver, err := t.resolver(dialer, rest)
+	if err != nil {
+		return nil, err
+	}
+
+	var session [4]byte
+	if _, err := rand.Read(session[:]); err != nil {
+		return nil, err
+	}
+
+	conn := &dnsClientConn{
+		dnsPipe:  newDNSPipe(dnsAddr("agent"), dnsAddr(resolver)),
+		exchange: exchange,
+		domain:   domain,
+		chunk:    chunk,
+		session:  binary.BigEndian.Uint32(session[:]),
+	}
+	if _, err := conn.round(); err != nil {
+		return nil, err
+	}
+	go conn.run()
+
+	return tls.Client(conn, config), nil
+}
+
+// resolver picks how queries are sent from the query of the address. Only DNS over HTTPS goes through proxies
+func (t *DNS) resolver(dialer Dialer, rest string) (func(context.Context, string) (string, error), string, error) {
+	_, rawQuery, _ := strings.Cut(rest, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, "", err
+	}
+
+	endpoint := query.Get("doh")
+	if endpoint == "" {
+		if _, ok := dialer.(*net.Dialer); !ok {
+			return nil, "", errors.New("dns can't go through a proxy, dns over https can")
+		}
+		return lookupTXT, "system resolver", nil
+	}
+
+	client := &http.Client{
+		Timeout: dnsQueryTimeout,
+		Transport: &http.Transport{
+			DialContext: func(_ context.Context, network string, address string) (net.Conn, error) {
+				return dialer.Dial(network, address)
+			},
+			ForceAttemptHTTP2: true,
+			IdleConnTimeout:   dnsSessionIdle,
+		},
+	}
+
+	return func(ctx context.Context, name string) (string, error) {
+		return t.exchangeDoH(ctx, client, endpoint, name)
+	}, endpoint, nil
+}
+
+// exchangeDoH sends a query as in RFC 8484, with the zero ID it recommends
+func (t *DNS) exchangeDoH(ctx context.Context, client *http.Client, endpoint string, name string) (string, error) {
+	qname, err := dnsmessage.NewName(name)
+	if err != nil {
+		return "", err
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{RecursionDesired: true})
+	builder.StartQuestions()
+	builder.Question(dnsmessage.Question{Name: qname, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET})
+	msg, err := builder.Finish()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(msg))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+	if t.settings.UserAgent != "" {
+		req.Header.Set("User-Agent", t.settings.UserAgent)
+	}
+	if t.settings.Profile != nil {
+		for name, value := range t.settings.Profile.Headers {
+			req.Header.Set(name, value)
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("dns over https resolver answered %s", resp.Status)
+	}
+
+	answer, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", err
+	}
+
+	return dnsAnswerTXT(answer)
+}
+
+// lookupTXT goes through the system resolver, the name is absolute so that no search domain gets appended
+func lookupTXT(ctx context.Context, name string) (string, error) {
+	records, err := net.DefaultResolver.LookupTXT(ctx, name)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return "", errDNSSessionGone
+		}
+		return "", err
+	}
+
+	if len(records) == 0 {
+		return "", errors.New("no TXT record in dns answer")
+	}
+
+	return records[0], nil
+}
+
+// dnsAnswerTXT returns the first TXT record of an answer, its strings joined
+func dnsAnswerTXT(msg []byte) (string, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(msg)
+	if err != nil {
+		return "", err
+	}
+
+	switch header.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return "", errDNSSessionGone
+	default:
+		return "", fmt.Errorf("dns query failed: %s", header.RCode)
+	}
+
+	if err := parser.SkipAllQuestions(); err != nil {
+		return "", err
+	}
+
+	for {
+		answer, err := parser.AnswerHeader()
+		if err != nil {
+			if errors.Is(err, dnsmessage.ErrSectionDone) {
+				return "", errors.New("no TXT record in dns answer")
+			}
+			return "", err
+		}
+
+		if answer.Type != dnsmessage.TypeTXT {
+			if err := parser.SkipAnswer(); err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		txt, err := parser.TXTResource()
+		if err != nil {
+			return "", err
+		}
+
+		return strings.Join(txt.TXT, ""), nil
+	}
+}
+
+// dnsUpChunk is how many bytes a query for the domain carries, within the 253 characters of a name and 63 of a label
+func dnsUpChunk(domain string) int {
+	chars := 253 - len(domain)
+	for chars > 0 && chars+(chars+62)/63 > 253-len(domain) {
+		chars--
+	}
+
+	return chars*5/8 - dnsQueryHeader
+}
+
+// dnsQueryName encodes a query payload into labels under the domain
+func dnsQueryName(domain string, payload []byte) string {
+	encoded := dnsEncoding.EncodeToString(payload)
+
+	var labels []string
+	for len(encoded) > 63 {
+		labels = append(labels, encoded[:63])
+		encoded = encoded[63:]
+	}
+	labels = append(labels, encoded, domain)
+
+	return strings.Join(labels, ".") + "."
+}
+
+// dnsAddr stands for the ends of the tunnel that aren't IP addresses, the domain and resolver
+type dnsAddr string
+
+func (a dnsAddr) Network() string {
+	return "dns"
+}
+
+func (a dnsAddr) String() string {
+	return string(a)
+}
+
+// dnsPipe buffers both directions of a tunneled connection, queries and answers move the data in between
+type dnsPipe struct {
+	mu            sync.Mutex
+	cond          *sync.Cond
+	in            bytes.Buffer
+	out           bytes.Buffer
+	closed        bool
+	wake          chan struct{} // signalled on writes for agents to poll right away
+	done          chan struct{}
+	readDeadline  time.Time
+	writeDeadline time.Time
+	readTimer     *time.Timer
+	writeTimer    *time.Timer
+	local         net.Addr
+	remote        net.Addr
+}
+
+func newDNSPipe(local net.Addr, remote net.Addr) *dnsPipe {
+	p := &dnsPipe{
+		wake:   make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		local:  local,
+		remote: remote,
+	}
+	p.cond = sync.NewCond(&p.mu)
+
+	return p
+}
+
+func (p *dnsPipe) Read(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for p.in.Len() == 0 {
+		if p.closed {
+			return 0, io.EOF
+		}
+		if expired(p.readDeadline) {
+			return 0, os.ErrDeadlineExceeded
+		}
+		p.cond.Wait()
+	}
+
+	return p.in.Read(b)
+}
+
+func (p *dnsPipe) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for {
+		if p.closed {
+			return 0, net.ErrClosed
+		}
+		if expired(p.writeDeadline) {
+			return 0, os.ErrDeadlineExceeded
+		}
+		if p.out.Len() < dnsMaxPending {
+			break
+		}
+		p.cond.Wait()
+	}
+
+	p.out.Write(b)
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+
+	return len(b), nil
+}
+
+func (p *dnsPipe) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil
+	}
+
+	p.closed = true
+	close(p.done)
+	for _, timer := range []*time.Timer{p.readTimer, p.writeTimer} {
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+	p.cond.Broadcast()
+
+	return nil
+}
+
+func (p *dnsPipe) LocalAddr() net.Addr {
+	return p.local
+}
+
+func (p *dnsPipe) RemoteAddr() net.Addr {
+	return p.remote
+}
+
+func (p *dnsPipe) SetDeadline(t time.Time) error {
+	p.SetReadDeadline(t)
+	return p.SetWriteDeadline(t)
+}
+
+func (p *dnsPipe) SetReadDeadline(t time.Time) error {
+	p.setDeadline(&p.readDeadline, &p.readTimer, t)
+	return nil
+}
+
+func (p *dnsPipe) SetWriteDeadline(t time.Time) error {
+	p.setDeadline(&p.writeDeadline, &p.writeTimer, t)
+	return nil
+}
+
+// setDeadline wakes up blocked reads or writes when the deadline passes for them to give up
+func (p *dnsPipe) setDeadline(deadline *time.Time, timer **time.Timer, t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	*deadline = t
+	if *timer != nil {
+		(*timer).Stop()
+	}
+	if !t.IsZero() {
+		*timer = time.AfterFunc(time.Until(t), func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.cond.Broadcast()
+		})
+	}
+	p.cond.Broadcast()
+}
+
+func expired(deadline time.Time) bool {
+	return !deadline.IsZero() && !time.Now().Before(deadline)
+}
+
+// take removes up to n bytes waiting to be sent, nil if there are none
+func (p *dnsPipe) take(n int) []byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.out.Len() == 0 {
+		return nil
+	}
+
+	chunk := bytes.Clone(p.out.Next(n))
+	p.cond.Broadcast()
+
+	return chunk
+}
+
+// deliver makes received data available to reads
+func (p *dnsPipe) deliver(data []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.in.Write(data)
+	p.cond.Broadcast()
+}
+
+func (p *dnsPipe) pending() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.out.Len() > 0
+}
+
+func (p *dnsPipe) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.closed
+}
+
+// dnsClientConn is the agent end: every round sends the next upstream chunk until the server acknowledges it, and
+// acknowledges the last downstream chunk it got. Lost queries or answers are simply sent again
+type dnsClientConn struct {
+	*dnsPipe
+	exchange func(ctx context.Context, name string) (string, error)
+	domain   string
+	chunk    int
+	session  uint32
+	up       uint32 // sequence of the chunk being sent
+	down     uint32 // sequence of the next chunk expected
+	sending  []byte
+}
+
+// round makes a single query, it tells whether data moved either way
+func (c *dnsClientConn) round() (bool, error) {
+	if len(c.sending) == 0 {
+		c.sending = c.take(c.chunk)
+	}
+
+	query := make([]byte, dnsQueryHeader, dnsQueryHeader+len(c.sending))
+	binary.BigEndian.PutUint32(query, c.session)
+	binary.BigEndian.PutUint32(query[4:], c.up)
+	binary.BigEndian.PutUint32(query[8:], c.down)
+	if _, err := rand.Read(query[12:dnsQueryHeader]); err != nil { // no two queries alike for caches to answer
+		return false, err
+	}
+	query = append(query, c.sending...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsQueryTimeout)
+	defer cancel()
+
+	txt, err := c.exchange(ctx, dnsQueryName(c.domain, query))
+	if err != nil {
+		return false, err
+	}
+
+	answer, err := base64.StdEncoding.DecodeString(txt)
+	if err != nil || len(answer) < dnsAnswerHeader {
+		return false, errors.New("malformed dns answer")
+	}
+
+	moved := false
+	if len(c.sending) > 0 && binary.BigEndian.Uint32(answer) == c.up+1 {
+		c.up++
+		c.sending = nil
+		moved = true
+	}
+	if data := answer[dnsAnswerHeader:]; len(data) > 0 && binary.BigEndian.Uint32(answer[4:]) == c.down {
+		c.deliver(data)
+		c.down++
+		moved = true
+	}
+
+	return moved, nil
+}
+
+// run polls until the connection is closed or the server stops answering. Polls back off while the link is idle
+func (c *dnsClientConn) run() {
+	defer c.Close()
+
+	poll := dnsMinPoll
+	var failing time.Time
+	for {
+		moved, err := c.round()
+		switch {
+		case errors.Is(err, errDNSSessionGone):
+			return
+		case err != nil:
+			if failing.IsZero() {
+				failing = time.Now()
+			} else if time.Since(failing) > dnsSessionIdle {
+				return
+			}
+		default:
+			failing = time.Time{}
+			if moved || len(c.sending) > 0 || c.pending() {
+				poll = dnsMinPoll
+				continue
+			}
+		}
+
+		timer := time.NewTimer(poll)
+		select {
+		case <-c.wake:
+			poll = dnsMinPoll
+		case <-timer.C:
+			if poll *= 2; poll > dnsMaxPoll {
+				poll = dnsMaxPoll
+			}
+		case <-c.done:
+			timer.Stop()
+			return
+		}
+		timer.Stop()
+	}
+}
+
+// dnsServerConn is the server end of a session, only touched by the listener while it answers
+type dnsServerConn struct {
+	*dnsPipe
+	up      uint32 // sequence of the next chunk expected
+	down    uint32 // sequence of the chunk being sent
+	sending []byte
+	seen    time.Time
+}
+
+// answer takes the upstream chunk if it's the expected one and moves on to the next downstream chunk once the agent
+// acknowledged the current one
+func (c *dnsServerConn) answer(up uint32, ack uint32, data []byte) []byte {
+	c.seen = time.Now()
+
+	if len(data) > 0 && up == c.up {
+		c.deliver(data)
+		c.up++
+	}
+	if c.sending != nil && ack == c.down+1 {
+		c.sending = nil
+		c.down++
+	}
+	if c.sending == nil {
+		c.sending = c.take(dnsDownChunk)
+	}
+
+	answer := make([]byte, dnsAnswerHeader, dnsAnswerHeader+len(c.sending))
+	binary.BigEndian.PutUint32(answer, c.up)
+	binary.BigEndian.PutUint32(answer[4:], c.down)
+
+	return append(answer, c.sending...)
+}
+
+type dnsListener struct {
+	conn      net.PacketConn
+	domain    string
+	config    *tls.Config
+	mu        sync.Mutex
+	sessions  map[uint32]*dnsServerConn
+	accepted  chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (l *dnsListener) serve() {
+	defer l.Close()
+
+	buf := make([]byte, 4096)
+	for {
+		n, from, err := l.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		if reply := l.reply(buf[:n], from); reply != nil {
+			l.conn.WriteTo(reply, from)
+		}
+	}
+}
+
+// reply builds the answer to a query, nil to drop it. Names in the domain that aren't queries of a session get an
+// empty answer rather than NXDOMAIN, resolvers minimizing names would take that as nothing existing beneath them
+func (l *dnsListener) reply(query []byte, from net.Addr) []byte {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil || header.Response {
+		return nil
+	}
+
+	question, err := parser.Question()
+	if err != nil {
+		return nil
+	}
+
+	rcode, txt := l.handle(question, from)
+
+	builder := dnsmessage.NewBuilder(make([]byte, 0, 512), dnsmessage.Header{
+		ID:               header.ID,
+		Response:         true,
+		Authoritative:    rcode != dnsmessage.RCodeRefused,
+		RecursionDesired: header.RecursionDesired,
+		RCode:            rcode,
+	})
+	builder.EnableCompression()
+	builder.StartQuestions()
+	builder.Question(question)
+	if txt != "" {
+		builder.StartAnswers()
+		builder.TXTResource(dnsmessage.ResourceHeader{
+			Name:  question.Name,
+			Type:  dnsmessage.TypeTXT,
+			Class: dnsmessage.ClassINET,
+			TTL:   0,
+		}, dnsmessage.TXTResource{TXT: []string{txt}})
+	}
+
+	reply, err := builder.Finish()
+	if err != nil {
+		return nil
+	}
+
+	return reply
+}
+
+func (l *dnsListener) handle(question dnsmessage.Question, from net.Addr) (dnsmessage.RCode, string) {
+	name := strings.TrimSuffix(strings.ToLower(question.Name.String()), ".")
+	if name != l.domain && !strings.HasSuffix(name, "."+l.domain) {
+		return dnsmessage.RCodeRefused, ""
+	}
+
+	encoded := strings.ReplaceAll(strings.TrimSuffix(strings.TrimSuffix(name, l.domain), "."), ".", "")
+	payload, err := dnsEncoding.DecodeString(encoded)
+	if question.Type != dnsmessage.TypeTXT || err != nil || len(payload) < dnsQueryHeader {
+		return dnsmessage.RCodeSuccess, ""
+	}
+
+	id := binary.BigEndian.Uint32(payload)
+	up := binary.BigEndian.Uint32(payload[4:])
+	ack := binary.BigEndian.Uint32(payload[8:])
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	conn, ok := l.sessions[id]
+	if !ok {
+		if up != 0 || ack != 0 {
+			return dnsmessage.RCodeNameError, ""
+		}
+
+		conn = &dnsServerConn{dnsPipe: newDNSPipe(l.conn.LocalAddr(), from)}
+		l.sessions[id] = conn
+		go func() {
+			select {
+			case l.accepted <- tls.Server(conn, l.config):
+			case <-l.closed:
+				conn.Close()
+			}
+		}()
+	}
+
+	if conn.isClosed() {
+		delete(l.sessions, id)
+		return dnsmessage.RCodeNameError, ""
+	}
+
+	return dnsmessage.RCodeSuccess, base64.StdEncoding.EncodeToString(conn.answer(up, ack, payload[dnsQueryHeader:]))
+}
+
+// expire closes the sessions agents stopped querying for
+func (l *dnsListener) expire() {
+	ticker := time.NewTicker(dnsSessionIdle / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-l.closed:
+			return
+		}
+
+		l.mu.Lock()
+		for id, conn := range l.sessions {
+			if time.Since(conn.seen) > dnsSessionIdle {
+				conn.Close()
+				delete(l.sessions, id)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+func (l *dnsListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.accepted:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *dnsListener) Close() error {
+	err := l.conn.Close()
+	l.closeOnce.Do(func() { close(l.closed) })
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for id, conn := range l.sessions {
+		conn.Close()
+		delete(l.sessions, id)
+	}
+
+	return err
+}
+
+func (l *dnsListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
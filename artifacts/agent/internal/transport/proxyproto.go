@@ -0,0 +1,213 @@
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	proxyHeaderTimeout = 10 * time.Second
+	proxyV1MaxLength   = 107
+)
+
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// ProxyListener reads the PROXY protocol header, v1 or v2, redirectors such as haproxy or nginx send ahead of the
+// connections they relay, so that connections come from the agent rather than from the redirector. Connections
+// without a header are refused, the listener must only be reachable through redirectors
+func ProxyListener(lis net.Listener) net.Listener {
+	return &proxyListener{Listener: lis}
+}
+
+type proxyListener struct {
+	net.Listener
+}
+
+// Accept doesn't wait for the header, it is read with the first Read or RemoteAddr of the connection
+func (l *proxyListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxyConn{Conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+type proxyConn struct {
+	net.Conn
+	reader *bufio.Reader
+	once   sync.Once
+	source net.Addr
+	err    error
+
+	deadlineMutex sync.Mutex
+	deadline      time.Time // read deadline set by the owner of the connection, kept over the header's
+}
+
+func (c *proxyConn) readHeader() {
+	c.once.Do(func() {
+		c.deadlineMutex.Lock()
+		deadline, own := time.Now().Add(proxyHeaderTimeout), c.deadline
+		if !own.IsZero() && own.Before(deadline) {
+			deadline = own
+		}
+		c.Conn.SetReadDeadline(deadline)
+		c.deadlineMutex.Unlock()
+
+		c.source, c.err = readProxyHeader(c.reader)
+
+		c.deadlineMutex.Lock()
+		c.Conn.SetReadDeadline(c.deadline)
+		c.deadlineMutex.Unlock()
+		if c.err != nil {
+			c.err = fmt.Errorf("PROXY protocol from %s: %v", c.Conn.RemoteAddr(), c.err)
+			c.Conn.Close()
+		}
+	})
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	c.readHeader()
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	return c.reader.Read(b)
+}
+
+func (c *proxyConn) SetDeadline(t time.Time) error {
+	c.deadlineMutex.Lock()
+	defer c.deadlineMutex.Unlock()
+
+	c.deadline = t
+	return c.Conn.SetDeadline(t)
+}
+
+func (c *proxyConn) SetReadDeadline(t time.Time) error {
+	c.deadlineMutex.Lock()
+	defer c.deadlineMutex.Unlock()
+
+	c.deadline = t
+	return c.Conn.SetReadDeadline(t)
+}
+
+// RemoteAddr is the agent the redirector relays, or the redirector itself when it sends no address, e.g. for its
+// health checks
+func (c *proxyConn) RemoteAddr() net.Addr {
+	c.readHeader()
+	if c.source != nil {
+		return c.source
+	}
+
+	return c.Conn.RemoteAddr()
+}
+
+// readProxyHeader returns the source address of the header, nil when the header carries none
+func readProxyHeader(r *bufio.Reader) (net.Addr, error) {
+	signature, err := r.Peek(len(proxyV2Signature))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(signature, proxyV2Signature) {
+		return readProxyV2(r)
+	}
+
+	if bytes.HasPrefix(signature, []byte("PROXY ")) {
+		return readProxyV1(r)
+	}
+
+	return nil, fmt.Errorf("no PROXY protocol header")
+}
+
+// readProxyV1 parses the text header, e.g. PROXY TCP4 192.0.2.1 198.51.100.1 51234 443\r\n
+func readProxyV1(r *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for len(line) < proxyV1MaxLength {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+	}
+
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, fmt.Errorf("v1 header is too long or not terminated by CRLF")
+	}
+
+	fields := strings.Fields(string(line))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, fmt.Errorf("malformed v1 header")
+	}
+
+	ip := net.ParseIP(fields[2])
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if ip == nil || err != nil {
+		return nil, fmt.Errorf("malformed v1 source address")
+	}
+
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readProxyV2 parses the binary header, TLVs following the addresses are skipped
+func readProxyV2(r *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, len(proxyV2Signature)+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	versionCommand, family := header[12], header[13]
+	length := int(binary.BigEndian.Uint16(header[14:]))
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	if versionCommand>>4 != 2 {
+		return nil, fmt.Errorf("unsupported v2 version %d", versionCommand>>4)
+	}
+
+	switch versionCommand & 0xf {
+	case 0: // LOCAL, the redirector's own connection
+		return nil, nil
+	case 1: // PROXY
+	default:
+		return nil, fmt.Errorf("unsupported v2 command %d", versionCommand&0xf)
+	}
+
+	var size int
+	switch family >> 4 {
+	case 1: // AF_INET
+		size = net.IPv4len
+	case 2: // AF_INET6
+		size = net.IPv6len
+	default:
+		return nil, nil // AF_UNSPEC or AF_UNIX, nothing to attribute the connection to
+	}
+
+	if len(payload) < 2*size+4 {
+		return nil, fmt.Errorf("v2 addresses are truncated")
+	}
+
+	ip := make(net.IP, size)
+	copy(ip, payload[:size])
+	port := binary.BigEndian.Uint16(payload[2*size:])
+
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
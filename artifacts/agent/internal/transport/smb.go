@@ -0,0 +1,55 @@
+package transport
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+)
+
+// SMB carries the TLS session over a named pipe, e.g. smb://fileserver:ligolo. It is how agents without egress link
+// to a bridge on another agent, SMB being open between most Windows hosts where nothing else is
+type SMB struct{}
+
+func NewSMB() *SMB {
+	return &SMB{}
+}
+
+func (t *SMB) Name() string {
+	return "smb"
+}
+
+// Listen takes the pipe name as the port of the address, the host must be local
+func (t *SMB) Listen(address string, config *tls.Config) (net.Listener, error) {
+	host, name, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if host != "" && host != "." {
+		return nil, fmt.Errorf("named pipes can only be opened locally, not on %s", host)
+	}
+
+	lis, err := ListenPipe(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return tls.NewListener(lis, config), nil
+}
+
+// Dial goes straight to the pipe, proxies don't carry SMB
+func (t *SMB) Dial(_ Dialer, address string, config *tls.Config) (net.Conn, error) {
+	host, name, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if host == "" {
+		host = "."
+	}
+
+	conn, err := DialPipe(fmt.Sprintf(`\\%s\pipe\%s`, host, name))
+	if err != nil {
+		return nil, err
+	}
+
+	return tls.Client(conn, config), nil
+}
@@ -0,0 +1,54 @@
+package transport
+
+import (
+	"crypto/tls"
+	"net"
+)
+
+// TLSSettings configures the TLS over stream transport
+type TLSSettings struct {
+	Network       string // tcp, tcp4 or tcp6
+	ProxyProtocol bool   // the server expects a PROXY protocol header ahead of every connection
+}
+
+// TLS is the default transport, a TLS session over a single stream connection
+type TLS struct {
+	settings TLSSettings
+}
+
+func NewTLS(settings TLSSettings) *TLS {
+	return &TLS{settings: settings}
+}
+
+func (t *TLS) Name() string {
+	return "tls"
+}
+
+// WithProxyProtocol has the server take agents through redirectors sending a PROXY protocol header
+func (t *TLS) WithProxyProtocol() Transport {
+	settings := t.settings
+	settings.ProxyProtocol = true
+	return NewTLS(settings)
+}
+
+func (t *TLS) Listen(address string, config *tls.Config) (net.Listener, error) {
+	if !t.settings.ProxyProtocol {
+		return tls.Listen(t.settings.Network, address, config)
+	}
+
+	lis, err := net.Listen(t.settings.Network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return tls.NewListener(ProxyListener(lis), config), nil
+}
+
+func (t *TLS) Dial(dialer Dialer, address string, config *tls.Config) (net.Conn, error) {
+	conn, err := dialer.Dial(t.settings.Network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return ClientTLS(conn, config), nil
+}
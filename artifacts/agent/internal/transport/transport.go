@@ -0,0 +1,134 @@
+package transport
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Default is used for server addresses without a transport prefix
+const Default = "tls"
+
+// Dialer opens the underlying connection, either directly or through a proxy
+type Dialer interface {
+	Dial(network, address string) (net.Conn, error)
+}
+
+// Transport carries the multiplexed session between agent and server.
+// Implementations are self-contained and keep their settings in their own struct
+type Transport interface {
+	// Name identifies the transport in server addresses, e.g. tls://1.3.3.7:11601
+	Name() string
+	// Listen accepts agent streams on the server
+	Listen(address string, config *tls.Config) (net.Listener, error)
+	// Dial opens a stream to the server from the agent
+	Dial(dialer Dialer, address string, config *tls.Config) (net.Conn, error)
+}
+
+// Profiled is implemented by transports a profile changes beyond what Profile.Shape does, e.g. the URIs of
+// WebSocket upgrades
+type Profiled interface {
+	WithProfile(profile *Profile) Transport
+}
+
+// Fronted is implemented by transports wrapping the session in an outer TLS agents don't check, the server can
+// present any certificate there, e.g. a public one of the fronting domain
+type Fronted interface {
+	WithCertificate(cert *tls.Certificate) Transport
+}
+
+// Proxied is implemented by transports over TCP, redirectors in front of the server can relay them with a PROXY
+// protocol header telling where agents connect from, see ProxyListener
+type Proxied interface {
+	WithProxyProtocol() Transport
+}
+
+// ClientTLS opens the TLS session of agents, replaced by agents mimicking the ClientHello of a browser
+var ClientTLS = func(conn net.Conn, config *tls.Config) net.Conn {
+	return tls.Client(conn, config)
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]Transport{}
+)
+
+// Register makes a transport available by its name, replacing any previous one
+func Register(t Transport) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	registry[t.Name()] = t
+}
+
+// Get returns a registered transport by name
+func Get(name string) (Transport, error) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	t, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not supported transport", name)
+	}
+
+	return t, nil
+}
+
+// Names returns all registered transports
+func Names() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	var result []string
+	for name := range registry {
+		result = append(result, name)
+	}
+
+	return result
+}
+
+// Parse splits a server address into its transport and host:port, e.g. tls://1.3.3.7:11601. The address keeps its
+// path for the transports taking one
+func Parse(server string) (Transport, string, error) {
+	name, address, found := strings.Cut(server, "://")
+	if !found {
+		name, address = Default, server
+	}
+
+	t, err := Get(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	hostPort, path := SplitPath(address)
+	if !takesPath(t) && path != "" {
+		return nil, "", fmt.Errorf("%s takes no path", name)
+	}
+
+	if _, _, err := net.SplitHostPort(hostPort); err != nil {
+		return nil, "", err
+	}
+
+	return t, address, nil
+}
+
+// takesPath tells whether the transport reads the path or query of addresses, e.g. for the DNS-over-HTTPS resolver
+func takesPath(t Transport) bool {
+	switch t.(type) {
+	case *WebSocket, *DNS:
+		return true
+	}
+
+	return false
+}
+
+func init() {
+	Register(NewTLS(TLSSettings{Network: "tcp"}))
+	Register(NewSMB())
+	Register(NewWebSocket(WebSocketSettings{}))
+	Register(NewQUIC(QUICSettings{KeepAlive: 15 * time.Second, IdleTimeout: time.Minute}))
+	Register(NewDNS(DNSSettings{}))
+}
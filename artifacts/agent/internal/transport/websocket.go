@@ -0,0 +1,271 @@
+package transport
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// WebSocketSettings configures the WebSocket transport
+type WebSocketSettings struct {
+	Path          string // the server only upgrades requests for it, any path if empty
+	UserAgent     string // sent by agents, empty for none
+	Profile       *Profile
+	Outer         *tls.Certificate // presented by the server on the outer TLS, the session's if nil
+	ProxyProtocol bool             // the server expects a PROXY protocol header ahead of every connection
+}
+
+// WebSocket carries the TLS session in a WebSocket over HTTPS, e.g.
+// wss://cdn.example.com:443/updates?host=c2.example.com. It goes through HTTP proxies that only allow CONNECT to
+// port 443, and through CDNs for domain fronting: the outer TLS goes to the address, the HTTP request to the host
+// given in the query. The outer TLS authenticates nobody, the session inside is the same mutual TLS as the tls
+// transport
+type WebSocket struct {
+	settings WebSocketSettings
+}
+
+func NewWebSocket(settings WebSocketSettings) *WebSocket {
+	return &WebSocket{settings: settings}
+}
+
+func (t *WebSocket) Name() string {
+	return "wss"
+}
+
+// WithProfile has agents send the headers of the profile and pick one of its URIs when the server address has no
+// path, the server then only upgrades requests for them
+func (t *WebSocket) WithProfile(profile *Profile) Transport {
+	settings := t.settings
+	settings.Profile = profile
+	return NewWebSocket(settings)
+}
+
+// WithCertificate has the server present the certificate on the outer TLS, the session inside keeps its own
+func (t *WebSocket) WithCertificate(cert *tls.Certificate) Transport {
+	settings := t.settings
+	settings.Outer = cert
+	return NewWebSocket(settings)
+}
+
+// WithProxyProtocol has the server take agents through redirectors sending a PROXY protocol header, the redirector
+// passes the outer TLS through rather than terminating it
+func (t *WebSocket) WithProxyProtocol() Transport {
+	settings := t.settings
+	settings.ProxyProtocol = true
+	return NewWebSocket(settings)
+}
+
+// accepts tells whether the server upgrades requests for the path
+func (t *WebSocket) accepts(path string) bool {
+	if profile := t.settings.Profile; profile != nil && len(profile.URIs) > 0 {
+		return contains(profile.URIs, path)
+	}
+
+	return t.settings.Path == "" || path == t.settings.Path
+}
+
+// SplitPath separates the host:port of an address from its path and query, e.g. for the wss transport
+func SplitPath(address string) (string, string) {
+	if i := strings.IndexAny(address, "/?"); i >= 0 {
+		return address[:i], address[i:]
+	}
+
+	return address, ""
+}
+
+// Listen serves HTTPS with the certificate of the session unless given another, CDNs in front of the server don't
+// check it
+func (t *WebSocket) Listen(address string, config *tls.Config) (net.Listener, error) {
+	outer := config.Clone()
+	outer.ClientAuth = tls.NoClientCert
+	outer.NextProtos = []string{"http/1.1"}
+	outer.VerifyPeerCertificate = nil
+	if t.settings.Outer != nil {
+		outer.Certificates = []tls.Certificate{*t.settings.Outer}
+	}
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	if t.settings.ProxyProtocol {
+		lis = ProxyListener(lis)
+	}
+	lis = tls.NewListener(lis, outer)
+
+	result := &webSocketListener{
+		listener: lis,
+		accepted: make(chan net.Conn),
+		closed:   make(chan struct{}),
+	}
+
+	upgrade := websocket.Server{
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil // agents aren't browsers, whatever Origin they send
+		},
+		Handler: func(ws *websocket.Conn) {
+			ws.PayloadType = websocket.BinaryFrame
+			conn := &webSocketConn{Conn: ws, done: make(chan struct{})}
+			if addr, err := net.ResolveTCPAddr("tcp", ws.Request().RemoteAddr); err == nil {
+				conn.remote = addr
+			}
+			if addr, ok := ws.Request().Context().Value(http.LocalAddrContextKey).(net.Addr); ok {
+				conn.local = addr
+			}
+
+			select {
+			case result.accepted <- tls.Server(conn, config):
+				<-conn.done // the connection is hijacked for as long as the handler runs
+			case <-result.closed:
+			}
+		},
+	}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !t.accepts(r.URL.Path) {
+				http.NotFound(w, r)
+				return
+			}
+			upgrade.ServeHTTP(w, r)
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		server.Serve(lis)
+		result.closeOnce.Do(func() { close(result.closed) })
+	}()
+
+	return result, nil
+}
+
+// Dial opens the outer TLS to the address, through the proxy if there's one
+func (t *WebSocket) Dial(dialer Dialer, address string, config *tls.Config) (net.Conn, error) {
+	hostPort, rest := SplitPath(address)
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := url.Parse("wss://" + hostPort + rest)
+	if err != nil {
+		return nil, err
+	}
+
+	query := location.Query()
+	if front := query.Get("host"); front != "" {
+		location.Host = front
+		query.Del("host")
+		location.RawQuery = query.Encode()
+	}
+	if location.Path == "" {
+		location.Path = t.settings.Profile.URI()
+	}
+	if location.Path == "" {
+		location.Path = "/"
+	}
+
+	conn, err := dialer.Dial("tcp", hostPort)
+	if err != nil {
+		return nil, err
+	}
+
+	// the session inside checks the server, the outer certificate may well be a CDN's
+	outer := ClientTLS(conn, &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"http/1.1"},
+	})
+
+	wsConfig, err := websocket.NewConfig(location.String(), "https://"+location.Host)
+	if err != nil {
+		outer.Close()
+		return nil, err
+	}
+	if t.settings.UserAgent != "" {
+		wsConfig.Header.Set("User-Agent", t.settings.UserAgent)
+	}
+	if t.settings.Profile != nil {
+		for name, value := range t.settings.Profile.Headers {
+			wsConfig.Header.Set(name, value)
+		}
+	}
+
+	outer.SetDeadline(time.Now().Add(10 * time.Second))
+	ws, err := websocket.NewClient(wsConfig, outer)
+	if err != nil {
+		outer.Close()
+		return nil, fmt.Errorf("websocket upgrade failed: %v", err)
+	}
+	outer.SetDeadline(time.Time{})
+	ws.PayloadType = websocket.BinaryFrame
+
+	return tls.Client(&webSocketConn{Conn: ws, local: conn.LocalAddr(), remote: conn.RemoteAddr(), done: make(chan struct{})}, config), nil
+}
+
+type webSocketListener struct {
+	listener  net.Listener
+	accepted  chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (l *webSocketListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.accepted:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *webSocketListener) Close() error {
+	err := l.listener.Close()
+	l.closeOnce.Do(func() { close(l.closed) })
+	return err
+}
+
+func (l *webSocketListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// webSocketConn tells the server handler when the connection is done with. websocket.Conn takes its addresses from
+// the URL and Origin header, the ones of the connection underneath are kept instead
+type webSocketConn struct {
+	*websocket.Conn
+	local     net.Addr
+	remote    net.Addr
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *webSocketConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(func() { close(c.done) })
+	return err
+}
+
+func (c *webSocketConn) LocalAddr() net.Addr {
+	if c.local == nil {
+		return c.Conn.LocalAddr()
+	}
+
+	return c.local
+}
+
+// RemoteAddr is the CDN when the server is fronted
+func (c *webSocketConn) RemoteAddr() net.Addr {
+	if c.remote == nil {
+		return c.Conn.RemoteAddr()
+	}
+
+	return c.remote
+}
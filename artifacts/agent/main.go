@@ -0,0 +1,10 @@
+//go:build !shared && !service
+// +build !shared,!service
+
+package main
+
+import "os"
+
+func main() {
+	run(os.Args[1:])
+}
@@ -0,0 +1,40 @@
+//go:build service
+// +build service
+
+package main
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows/svc"
+)
+
+type agentService struct{}
+
+func (s *agentService) Execute(args []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status) (bool, uint32) {
+	status <- svc.Status{State: svc.StartPending}
+	go run(append(os.Args[1:], args[1:]...))
+	status <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+
+	for req := range requests {
+		switch req.Cmd {
+		case svc.Interrogate:
+			status <- req.CurrentStatus
+		case svc.Stop, svc.Shutdown:
+			status <- svc.Status{State: svc.StopPending}
+			return false, 0
+		}
+	}
+
+	return false, 0
+}
+
+func main() {
+	isService, err := svc.IsWindowsService()
+	if err != nil || !isService {
+		run(os.Args[1:])
+		return
+	}
+
+	svc.Run("", &agentService{})
+}
@@ -0,0 +1,29 @@
+//go:build shared
+// +build shared
+
+package main
+
+import "C"
+
+import "sync"
+
+var startOnce sync.Once
+
+// Start runs the agent and never returns, so it is safe to use as a rundll32 entrypoint.
+// It is also invoked from DllMain/library constructor, only the first call starts the agent
+//
+//export Start
+func Start() {
+	startOnce.Do(func() {
+		go run(nil)
+	})
+
+	select {}
+}
+
+//export DllRegisterServer
+func DllRegisterServer() {
+	Start()
+}
+
+func main() {}
@@ -0,0 +1,18 @@
+//go:build shared
+// +build shared
+
+#include <pthread.h>
+#include "_cgo_export.h"
+
+static void *agentThread(void *param) {
+	Start();
+	return NULL;
+}
+
+__attribute__((constructor)) static void agentInit(void) {
+	pthread_t thread;
+
+	if (pthread_create(&thread, NULL, agentThread, NULL) == 0) {
+		pthread_detach(thread);
+	}
+}
@@ -0,0 +1,18 @@
+//go:build shared
+// +build shared
+
+#include <windows.h>
+#include "_cgo_export.h"
+
+static DWORD WINAPI agentThread(LPVOID param) {
+	Start();
+	return 0;
+}
+
+BOOL WINAPI DllMain(HINSTANCE instance, DWORD reason, LPVOID reserved) {
+	if (reason == DLL_PROCESS_ATTACH) {
+		CreateThread(NULL, 0, agentThread, NULL, 0, NULL);
+	}
+
+	return TRUE;
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/yamux"
+)
+
+// multiplexer is how the agent end of sessions was tuned at build time, zero values keep the yamux defaults
+var multiplexer struct {
+	keepAlive    time.Duration
+	writeTimeout time.Duration
+	maxStreams   int
+}
+
+func setMultiplexer(keepAlive string, writeTimeout string, maxStreams string) {
+	multiplexer.keepAlive, _ = time.ParseDuration(keepAlive)
+	multiplexer.writeTimeout, _ = time.ParseDuration(writeTimeout)
+	multiplexer.maxStreams, _ = strconv.Atoi(maxStreams)
+}
+
+// multiplexerConfig has the agent ping the server at the keepalive interval. A ping or a write taking longer than
+// the write timeout closes the session, and the agent reconnects
+func multiplexerConfig() *yamux.Config {
+	config := yamux.DefaultConfig()
+	config.LogOutput = io.Discard
+	if multiplexer.keepAlive > 0 {
+		config.KeepAliveInterval = multiplexer.keepAlive
+	}
+	if multiplexer.writeTimeout > 0 {
+		config.ConnectionWriteTimeout = multiplexer.writeTimeout
+	}
+
+	return config
+}
+
+// tooManyStreams tells whether the server opened more streams than the agent takes at once
+func tooManyStreams(session *yamux.Session) bool {
+	return multiplexer.maxStreams > 0 && session.NumStreams() > multiplexer.maxStreams
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/ifaceinfo"
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// netInterfaces lists the non-loopback interfaces of the network namespace connections are made from
+func netInterfaces() ([]protocol.NetInterface, error) {
+	netifaces, err := targetDialer.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	var nonloopbackIfaces []net.Interface
+	for _, iface := range netifaces {
+		if iface.Flags&net.FlagLoopback == 0 {
+			nonloopbackIfaces = append(nonloopbackIfaces, iface)
+		}
+	}
+
+	interfaces := protocol.NewNetInterfaces(nonloopbackIfaces)
+	if details, err := ifaceinfo.Collect(); err == nil {
+		for i := range interfaces {
+			if extra, ok := details[interfaces[i].Index]; ok {
+				interfaces[i].Gateways = extra.Gateways
+				interfaces[i].DNSServers = extra.DNSServers
+			}
+		}
+	}
+
+	return interfaces, nil
+}
+
+// collectNetwork gathers interfaces, neighbors and routes, each part that fails is reported without failing the rest
+func collectNetwork() protocol.NetworkResponsePacket {
+	var response protocol.NetworkResponsePacket
+
+	interfaces, err := netInterfaces()
+	if err != nil {
+		response.Errors = append(response.Errors, fmt.Sprintf("interfaces: %s", err))
+	}
+	response.Interfaces = interfaces
+
+	if err := targetDialer.Do(func() error {
+		neighbors, err := ifaceinfo.Neighbors()
+		if err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("neighbors: %s", err))
+		}
+		response.Neighbors = neighbors
+
+		routes, err := ifaceinfo.Routes()
+		if err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("routes: %s", err))
+		}
+		response.Routes = routes
+
+		return nil
+	}); err != nil {
+		response.Errors = append(response.Errors, fmt.Sprintf("neighbors and routes: %s", err))
+	}
+
+	return response
+}
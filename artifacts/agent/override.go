@@ -0,0 +1,39 @@
+//go:build override
+// +build override
+
+package main
+
+import (
+	"flag"
+	"os"
+	"strings"
+)
+
+// Servers and proxy given when the agent is launched, so a single build can be pointed at several labs. Flags win
+// over the environment, which is all there is to agents loaded as libraries
+var (
+	overrideServers string
+	overrideProxy   string
+)
+
+func addOverrideFlags(flags *flag.FlagSet) {
+	flags.StringVar(&overrideServers, "servers", os.Getenv("LIGOLO_SERVERS"), "")
+	flags.StringVar(&overrideProxy, "proxy", os.Getenv("LIGOLO_PROXY"), "")
+}
+
+// applyOverride replaces the embedded servers and proxy with the ones given at launch, "direct" drops the proxy
+func applyOverride(servers []string, proxyServer string) ([]string, string) {
+	if list := strings.FieldsFunc(overrideServers, func(r rune) bool { return r == ',' || r == ' ' }); len(list) > 0 {
+		servers = list
+	}
+
+	switch overrideProxy {
+	case "":
+	case "direct":
+		proxyServer = ""
+	default:
+		proxyServer = overrideProxy
+	}
+
+	return servers, proxyServer
+}
@@ -0,0 +1,15 @@
+//go:build !override
+// +build !override
+
+package main
+
+import (
+	"flag"
+)
+
+// addOverrideFlags adds nothing, the servers and proxy can't be overridden unless the agent was built for it
+func addOverrideFlags(flags *flag.FlagSet) {}
+
+func applyOverride(servers []string, proxyServer string) ([]string, string) {
+	return servers, proxyServer
+}
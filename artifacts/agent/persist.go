@@ -0,0 +1,99 @@
+//go:build persist
+// +build persist
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// persister installs the agent so it starts again after a reboot or a logon, and removes what it installed.
+// Both return what they created or removed, for the server to record
+type persister struct {
+	install   func(name string, command []string) (string, error)
+	uninstall func(name string) (string, error)
+}
+
+// persistMethods is reported to the server, nothing is installed until an operator asks for it
+func persistMethods() []string {
+	var methods []string
+	for method := range persisters {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+func handlePersist(request protocol.PersistRequestPacket) protocol.PersistResponsePacket {
+	var response protocol.PersistResponsePacket
+
+	location, err := persist(request.Method, request.Uninstall)
+	if err != nil {
+		response.Err = true
+		response.ErrString = err.Error()
+	}
+	response.Location = location
+
+	return response
+}
+
+func persist(method string, uninstall bool) (string, error) {
+	p, ok := persisters[method]
+	if !ok {
+		return "", fmt.Errorf("unknown persistence method %q", method)
+	}
+
+	path, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	// entries are named after the executable, so that removing them doesn't depend on anything but the file
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	if uninstall {
+		return p.uninstall(name)
+	}
+
+	return p.install(name, append([]string{path}, os.Args[1:]...))
+}
+
+// removePersistence removes whatever persistence it finds. Failures are only reported for the methods the server
+// knows were installed, the others most likely never were
+func removePersistence(installed []string) (removed []string, errs []string) {
+	for _, method := range persistMethods() {
+		location, err := persist(method, true)
+		if err == nil {
+			removed = append(removed, location)
+			continue
+		}
+
+		for _, m := range installed {
+			if m == method {
+				errs = append(errs, fmt.Sprintf("%s: %v", method, err))
+			}
+		}
+	}
+
+	return removed, errs
+}
+
+// persistOutput turns a failed helper command into an error carrying what it printed
+func persistOutput(output []byte, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if msg := strings.TrimSpace(string(output)); msg != "" {
+		return fmt.Errorf("%v: %s", err, msg)
+	}
+
+	return err
+}
@@ -0,0 +1,25 @@
+//go:build !persist
+// +build !persist
+
+package main
+
+import (
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// persistMethods is empty, persistence has to be asked for when the agent is built
+func persistMethods() []string {
+	return nil
+}
+
+// removePersistence has nothing to remove, the agent can't have installed any
+func removePersistence(installed []string) ([]string, []string) {
+	return nil, nil
+}
+
+func handlePersist(request protocol.PersistRequestPacket) protocol.PersistResponsePacket {
+	return protocol.PersistResponsePacket{
+		Err:       true,
+		ErrString: "persistence was not built into this agent",
+	}
+}
@@ -0,0 +1,173 @@
+//go:build persist && linux
+// +build persist,linux
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var persisters = map[string]persister{
+	"systemd": {installSystemd, uninstallSystemd},
+	"cron":    {installCron, uninstallCron},
+}
+
+// systemdUnit is where the unit goes: a system unit when running as root, a user unit otherwise
+func systemdUnit(name string) (path string, args []string, err error) {
+	if os.Geteuid() == 0 {
+		return filepath.Join("/etc/systemd/system", name+".service"), nil, nil
+	}
+
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return filepath.Join(config, "systemd", "user", name+".service"), []string{"--user"}, nil
+}
+
+func installSystemd(name string, command []string) (string, error) {
+	path, args, err := systemdUnit(name)
+	if err != nil {
+		return "", err
+	}
+
+	target := "multi-user.target"
+	if len(args) > 0 {
+		target = "default.target"
+	}
+
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		quoted[i] = systemdQuote(arg)
+	}
+
+	unit := fmt.Sprintf("[Unit]\nDescription=%s\nAfter=network-online.target\n\n[Service]\nExecStart=%s\nRestart=always\nRestartSec=30\n\n[Install]\nWantedBy=%s\n",
+		name, strings.Join(quoted, " "), target)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, []byte(unit), 0644); err != nil {
+		return "", err
+	}
+
+	if err := persistOutput(exec.Command("systemctl", append(args, "daemon-reload")...).CombinedOutput()); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	if err := persistOutput(exec.Command("systemctl", append(args, "enable", name+".service")...).CombinedOutput()); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+
+	return path, nil
+}
+
+func uninstallSystemd(name string) (string, error) {
+	path, args, err := systemdUnit(name)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("no unit at %s", path)
+	}
+
+	// the agent may be running from the unit itself, it is only disabled, not stopped
+	if err := persistOutput(exec.Command("systemctl", append(args, "disable", name+".service")...).CombinedOutput()); err != nil {
+		return "", err
+	}
+	if err := os.Remove(path); err != nil {
+		return "", err
+	}
+	exec.Command("systemctl", append(args, "daemon-reload")...).Run()
+
+	return path, nil
+}
+
+// systemdQuote quotes an ExecStart argument when it needs to be
+func systemdQuote(arg string) string {
+	arg = strings.ReplaceAll(arg, "%", "%%")
+	if arg != "" && !strings.ContainsAny(arg, " \t\"'\\;$") {
+		return arg
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", "$$").Replace(arg) + `"`
+}
+
+func cronEntry(command []string) string {
+	quoted := make([]string, len(command))
+	for i, arg := range command {
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+
+	return "@reboot " + strings.Join(quoted, " ")
+}
+
+// crontab reads the user's crontab, which doesn't exist until something was put in it
+func crontab() []string {
+	output, err := exec.Command("crontab", "-l").Output()
+	if err != nil {
+		return nil
+	}
+
+	return strings.Split(strings.TrimRight(string(output), "\n"), "\n")
+}
+
+func writeCrontab(lines []string) error {
+	cmd := exec.Command("crontab", "-")
+	cmd.Stdin = bytes.NewBufferString(strings.Join(lines, "\n") + "\n")
+
+	return persistOutput(cmd.CombinedOutput())
+}
+
+func installCron(name string, command []string) (string, error) {
+	entry := cronEntry(command)
+
+	lines := crontab()
+	for _, line := range lines {
+		if line == entry {
+			return "", fmt.Errorf("already in the crontab")
+		}
+	}
+
+	if err := writeCrontab(append(lines, entry)); err != nil {
+		return "", err
+	}
+
+	return "crontab: " + entry, nil
+}
+
+// uninstallCron removes the @reboot entries starting the executable, whatever arguments they were given
+func uninstallCron(name string) (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	prefix := cronEntry([]string{path})
+
+	var kept, removed []string
+	for _, line := range crontab() {
+		if line == prefix || strings.HasPrefix(line, prefix+" ") {
+			removed = append(removed, line)
+			continue
+		}
+		kept = append(kept, line)
+	}
+
+	if len(removed) == 0 {
+		return "", fmt.Errorf("not in the crontab")
+	}
+
+	if err := writeCrontab(kept); err != nil {
+		return "", err
+	}
+
+	return "crontab: " + strings.Join(removed, "; "), nil
+}
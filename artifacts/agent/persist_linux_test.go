@@ -0,0 +1,23 @@
+//go:build persist && linux
+// +build persist,linux
+
+package main
+
+import "testing"
+
+func TestPersistQuoting(t *testing.T) {
+	for arg, expected := range map[string]string{
+		"/opt/agent":    "/opt/agent",
+		"/opt/my agent": `"/opt/my agent"`,
+		`-key=a"b$c`:    `"-key=a\"b$$c"`,
+		"100%":          "100%%",
+	} {
+		if quoted := systemdQuote(arg); quoted != expected {
+			t.Errorf("%s: expected %s, got %s", arg, expected, quoted)
+		}
+	}
+
+	if entry := cronEntry([]string{"/opt/agent", "-key", "it's"}); entry != `@reboot '/opt/agent' '-key' 'it'\''s'` {
+		t.Fatalf("unexpected cron entry %s", entry)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build persist && !windows && !linux
+// +build persist,!windows,!linux
+
+package main
+
+var persisters = map[string]persister{}
@@ -0,0 +1,55 @@
+//go:build persist && windows && service
+// +build persist,windows,service
+
+package main
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows"
+)
+
+// only agents built as a service can answer the service control manager, the others can't be installed as one
+func init() {
+	persisters["service"] = persister{installService, uninstallService}
+}
+
+// installService registers an auto-start service running as LocalSystem, which needs an elevated agent
+func installService(name string, command []string) (string, error) {
+	manager, err := windows.OpenSCManager(nil, nil, windows.SC_MANAGER_CREATE_SERVICE)
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseServiceHandle(manager)
+
+	service, err := windows.CreateService(manager, windows.StringToUTF16Ptr(name), windows.StringToUTF16Ptr(name), windows.SERVICE_ALL_ACCESS,
+		windows.SERVICE_WIN32_OWN_PROCESS, windows.SERVICE_AUTO_START, windows.SERVICE_ERROR_IGNORE,
+		windows.StringToUTF16Ptr(windows.ComposeCommandLine(command)), nil, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	windows.CloseServiceHandle(service)
+
+	return fmt.Sprintf("service %s", name), nil
+}
+
+// uninstallService marks the service for deletion, the service control manager removes it once it's stopped
+func uninstallService(name string) (string, error) {
+	manager, err := windows.OpenSCManager(nil, nil, windows.SC_MANAGER_CONNECT)
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseServiceHandle(manager)
+
+	service, err := windows.OpenService(manager, windows.StringToUTF16Ptr(name), windows.DELETE)
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseServiceHandle(service)
+
+	if err := windows.DeleteService(service); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("service %s", name), nil
+}
@@ -0,0 +1,60 @@
+//go:build persist && windows
+// +build persist,windows
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+const runKey = `HKCU\Software\Microsoft\Windows\CurrentVersion\Run`
+
+var persisters = map[string]persister{
+	"runkey": {installRunKey, uninstallRunKey},
+	"task":   {installTask, uninstallTask},
+}
+
+// persistCommand runs one of the system tools persistence goes through, without a console window
+func persistCommand(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
+
+	return persistOutput(cmd.CombinedOutput())
+}
+
+func installRunKey(name string, command []string) (string, error) {
+	if err := persistCommand("reg.exe", "add", runKey, "/v", name, "/t", "REG_SZ", "/d", windows.ComposeCommandLine(command), "/f"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`%s\%s`, runKey, name), nil
+}
+
+func uninstallRunKey(name string) (string, error) {
+	if err := persistCommand("reg.exe", "delete", runKey, "/v", name, "/f"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`%s\%s`, runKey, name), nil
+}
+
+// installTask registers a task started at logon of the current user, it runs with their rights
+func installTask(name string, command []string) (string, error) {
+	if err := persistCommand("schtasks.exe", "/Create", "/SC", "ONLOGON", "/TN", name, "/TR", windows.ComposeCommandLine(command), "/F"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`scheduled task \%s`, name), nil
+}
+
+func uninstallTask(name string) (string, error) {
+	if err := persistCommand("schtasks.exe", "/Delete", "/TN", name, "/F"); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`scheduled task \%s`, name), nil
+}
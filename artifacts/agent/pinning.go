@@ -0,0 +1,94 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxClockSkew is how far outside of its validity period the server certificate is still accepted, agents on a
+// target whose clock drifted still connect and report their clock. Certificates that expired or were retired longer
+// ago than that are refused
+const maxClockSkew = 24 * time.Hour
+
+// verifyChain checks the server certificate against our CA. A chain that is only invalid because the local clock is
+// off by less than maxClockSkew is checked again as of the edge of the validity period the clock is past
+func verifyChain(cert *x509.Certificate, options x509.VerifyOptions) error {
+	_, err := cert.Verify(options)
+	var invalidErr x509.CertificateInvalidError
+	if err == nil || !errors.As(err, &invalidErr) || invalidErr.Reason != x509.Expired {
+		return err
+	}
+
+	now := time.Now()
+	edge := invalidErr.Cert.NotAfter
+	if now.Before(invalidErr.Cert.NotBefore) {
+		edge = invalidErr.Cert.NotBefore
+	}
+
+	if skew := now.Sub(edge).Abs(); skew > maxClockSkew {
+		return fmt.Errorf("%w, local clock is %s off", err, skew.Round(time.Second))
+	}
+
+	options.CurrentTime = edge
+	_, err = cert.Verify(options)
+	return err
+}
+
+// checkPins makes sure the server certificate carries one of the keys pinned at build time, so that a certificate
+// issued with a stolen CA key doesn't pass. Agents built without pins only check the CA
+func checkPins(cert *x509.Certificate, pins []string) error {
+	if len(pins) == 0 {
+		return nil
+	}
+
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	pin := base64.StdEncoding.EncodeToString(hash[:])
+	for _, pinned := range pins {
+		if pin == pinned {
+			return nil
+		}
+	}
+
+	return errors.New("server key is not pinned")
+}
+
+// checkRevoked refuses a server certificate the CRL embedded at build time lists, e.g. one the server replaced when
+// rotating its certificates. Agents built without a CRL skip the check
+func checkRevoked(cert *x509.Certificate, caPEM []byte, crlPEM []byte) error {
+	block, _ := pem.Decode(crlPEM)
+	if block == nil {
+		return nil
+	}
+
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	caBlock, _ := pem.Decode(caPEM)
+	if caBlock == nil {
+		return errors.New("invalid CA certificate")
+	}
+	ca, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		return err
+	}
+
+	// CAs issued before CRLs were generated lack the CRL signing usage, only the signature is checked
+	if err := ca.CheckSignature(crl.SignatureAlgorithm, crl.RawTBSRevocationList, crl.Signature); err != nil {
+		return err
+	}
+
+	for _, revoked := range crl.RevokedCertificates {
+		if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return errors.New("server certificate is revoked")
+		}
+	}
+
+	return nil
+}
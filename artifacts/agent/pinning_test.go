@@ -0,0 +1,86 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestVerifyChain(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-365 * 24 * time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	issue := func(notBefore time.Time, notAfter time.Time) *x509.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			Subject:      pkix.Name{CommonName: "server"},
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert
+	}
+
+	now := time.Now()
+	for _, tc := range []struct {
+		name      string
+		notBefore time.Time
+		notAfter  time.Time
+		valid     bool
+	}{
+		{"valid", now.Add(-time.Hour), now.Add(time.Hour), true},
+		{"not yet valid within skew", now.Add(time.Hour), now.Add(48 * time.Hour), true},
+		{"not yet valid past skew", now.Add(2 * maxClockSkew), now.Add(4 * maxClockSkew), false},
+		{"expired within skew", now.Add(-48 * time.Hour), now.Add(-time.Hour), true},
+		{"expired past skew", now.Add(-4 * maxClockSkew), now.Add(-2 * maxClockSkew), false},
+	} {
+		err := verifyChain(issue(tc.notBefore, tc.notAfter), x509.VerifyOptions{Roots: roots})
+		if tc.valid && err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s: accepted", tc.name)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"strings"
+
+	utls "github.com/refraction-networking/utls"
+	"github.com/ttpreport/ligolo-mp-agent/internal/transport"
+)
+
+var fingerprints = map[string]utls.ClientHelloID{
+	"chrome":     utls.HelloChrome_Auto,
+	"firefox":    utls.HelloFirefox_Auto,
+	"safari":     utls.HelloSafari_Auto,
+	"edge":       utls.HelloEdge_Auto,
+	"ios":        utls.HelloIOS_Auto,
+	"randomized": utls.HelloRandomized,
+}
+
+// applyProfile decodes the transport profile the agent was built with and hands it to the transports that take one.
+// TLS sessions then open with the ClientHello of the browser it names. Connections are shaped separately, see shape
+func applyProfile(encoded string) (*transport.Profile, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &transport.Profile{}
+	if err := json.Unmarshal(data, profile); err != nil {
+		return nil, err
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range transport.Names() {
+		t, err := transport.Get(name)
+		if err != nil {
+			continue
+		}
+		if profiled, ok := t.(transport.Profiled); ok {
+			transport.Register(profiled.WithProfile(profile))
+		}
+	}
+
+	if id, ok := fingerprints[profile.Fingerprint]; ok {
+		transport.ClientTLS = func(conn net.Conn, config *tls.Config) net.Conn {
+			return mimicTLS(conn, config, id)
+		}
+	}
+
+	return profile, nil
+}
+
+// shape applies the padding and jitter of the profile to a session with the server. Bridging agents relay sessions
+// to the server as they are, so sessions over smb are left alone
+func shape(profile *transport.Profile, server string, conn net.Conn) net.Conn {
+	if strings.HasPrefix(server, "smb://") {
+		return conn
+	}
+
+	return profile.Shape(conn)
+}
+
+// mimicTLS opens a TLS session with a browser's ClientHello. Browsers offer h2, which the server doesn't speak, so
+// the protocols asked for replace theirs
+func mimicTLS(conn net.Conn, config *tls.Config, id utls.ClientHelloID) net.Conn {
+	uconfig := &utls.Config{
+		ServerName:            config.ServerName,
+		RootCAs:               config.RootCAs,
+		InsecureSkipVerify:    config.InsecureSkipVerify,
+		VerifyPeerCertificate: config.VerifyPeerCertificate,
+		NextProtos:            config.NextProtos,
+	}
+	for _, cert := range config.Certificates {
+		uconfig.Certificates = append(uconfig.Certificates, utls.Certificate{
+			Certificate: cert.Certificate,
+			PrivateKey:  cert.PrivateKey,
+			Leaf:        cert.Leaf,
+		})
+	}
+
+	if len(config.NextProtos) == 0 || id == utls.HelloRandomized {
+		return utls.UClient(conn, uconfig, id)
+	}
+
+	spec, err := utls.UTLSIdToSpec(id)
+	if err != nil {
+		return utls.UClient(conn, uconfig, id)
+	}
+	for _, extension := range spec.Extensions {
+		if alpn, ok := extension.(*utls.ALPNExtension); ok {
+			alpn.AlpnProtocols = config.NextProtos
+		}
+	}
+
+	uconn := utls.UClient(conn, uconfig, utls.HelloCustom)
+	if err := uconn.ApplyPreset(&spec); err != nil {
+		return utls.UClient(conn, uconfig, id)
+	}
+
+	return uconn
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rotationFailover   = "failover"    // always start over from the first server
+	rotationRoundRobin = "round-robin" // start over from the server after the last one connected to
+	rotationRandom     = "random"      // shuffle the servers on every pass
+)
+
+const (
+	defaultBackoff = 5 * time.Second
+	maxBackoff     = 10 * time.Minute
+)
+
+// rotation decides in which order servers are tried and how hard. Retries and backoffs are newline separated,
+// one line per server, missing or invalid lines fall back to a single attempt and defaultBackoff
+type rotation struct {
+	strategy string
+	retries  []int
+	backoffs []time.Duration
+	next     int
+	first    int // server the next pass starts with whatever the strategy, -1 for none
+}
+
+func newRotation(strategy string, servers int, retries string, backoffs string) *rotation {
+	r := &rotation{
+		strategy: strategy,
+		retries:  make([]int, servers),
+		backoffs: make([]time.Duration, servers),
+		first:    -1,
+	}
+
+	retryLines := strings.Split(retries, "\n")
+	backoffLines := strings.Split(backoffs, "\n")
+	for i := 0; i < servers; i++ {
+		r.retries[i] = 1
+		if i < len(retryLines) {
+			if n, err := strconv.Atoi(strings.TrimSpace(retryLines[i])); err == nil && n > 0 {
+				r.retries[i] = n
+			}
+		}
+
+		r.backoffs[i] = defaultBackoff
+		if i < len(backoffLines) {
+			if d, err := time.ParseDuration(strings.TrimSpace(backoffLines[i])); err == nil && d > 0 {
+				r.backoffs[i] = d
+			}
+		}
+	}
+
+	return r
+}
+
+// Order returns the indexes of the servers to try during the next pass
+func (r *rotation) Order() []int {
+	order := make([]int, len(r.retries))
+	for i := range order {
+		order[i] = i
+	}
+
+	switch r.strategy {
+	case rotationRoundRobin:
+		for i := range order {
+			order[i] = (r.next + i) % len(order)
+		}
+	case rotationRandom:
+		rand.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+	}
+
+	if r.first >= 0 {
+		for i, server := range order {
+			if server == r.first {
+				copy(order[1:i+1], order[:i])
+				order[0] = server
+				break
+			}
+		}
+		r.first = -1
+	}
+
+	return order
+}
+
+// Connected records the server a session was established with
+func (r *rotation) Connected(server int) {
+	if len(r.retries) > 0 {
+		r.next = (server + 1) % len(r.retries)
+	}
+}
+
+// Prefer has the next pass start with the given server
+func (r *rotation) Prefer(server int) {
+	r.first = server
+}
+
+func (r *rotation) Retries(server int) int {
+	return r.retries[server]
+}
+
+// Backoff is how long to wait before the given attempt on a server, it doubles with every failed attempt
+func (r *rotation) Backoff(server int, attempt int) time.Duration {
+	backoff := r.backoffs[server]
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return backoff
+}
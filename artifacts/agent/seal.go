@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/seal"
+)
+
+// unseal decrypts the embedded configuration: proxy, servers, CA, certificate and key. Depending on keySource,
+// the key comes from -key or from the machine itself
+func unseal(sealed string, keySource string, passphrase string) ([]string, error) {
+	secret := passphrase
+	if keySource == seal.KeyHostname {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		secret = hostname
+	}
+
+	config, err := seal.Open(sealed, seal.Secret(keySource, secret))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config) != 6 {
+		return nil, seal.ErrInvalidKey
+	}
+
+	return config, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"github.com/ttpreport/ligolo-mp-agent/internal/socks"
+)
+
+// socksService is the SOCKS5 listener the server asked for, it dials through targetDialer so it sees the same
+// network as the tunnel does
+var socksService struct {
+	sync.Mutex
+	server *socks.Server
+}
+
+// socksAddress is what gets reported to the server, empty when the service is off
+func socksAddress() string {
+	socksService.Lock()
+	defer socksService.Unlock()
+
+	if socksService.server == nil {
+		return ""
+	}
+
+	return socksService.server.Addr()
+}
+
+// handleSocks stops the running service and, unless the address is empty, starts a new one in its place
+func handleSocks(request protocol.SocksRequestPacket) protocol.SocksResponsePacket {
+	socksService.Lock()
+	defer socksService.Unlock()
+
+	if socksService.server != nil {
+		socksService.server.Close()
+		socksService.server = nil
+	}
+
+	if request.Address == "" {
+		return protocol.SocksResponsePacket{}
+	}
+
+	server, err := socks.Listen(request.Address, request.Username, request.Password, targetDialer.DialContext)
+	if err != nil {
+		return protocol.SocksResponsePacket{Err: true, ErrString: err.Error()}
+	}
+	socksService.server = server
+
+	go server.Serve()
+
+	return protocol.SocksResponsePacket{Address: server.Addr()}
+}
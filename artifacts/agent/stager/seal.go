@@ -0,0 +1,50 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/seal"
+)
+
+// unseal is the agent's, the stage gets the same arguments so -key unlocks both
+func unseal(sealed string, keySource string, passphrase string) ([]string, error) {
+	secret := passphrase
+	if keySource == seal.KeyHostname {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		secret = hostname
+	}
+
+	config, err := seal.Open(sealed, seal.Secret(keySource, secret))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config) != 6 {
+		return nil, seal.ErrInvalidKey
+	}
+
+	return config, nil
+}
+
+// keyArg picks -key out of the arguments meant for the agent, without pulling in the flag package
+func keyArg(args []string) string {
+	for i, arg := range args {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || name != "key" {
+			continue
+		}
+
+		if hasValue {
+			return value
+		}
+		if i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/neterror"
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+)
+
+const (
+	maxSweepHosts   = 1 << 16 // a /16
+	sweepTimeout    = time.Second
+	sweepWorkers    = 64
+	maxSweepWorkers = 512
+	echoInterval    = 200 * time.Microsecond // between echo requests, a /16 goes out in about 15 seconds
+)
+
+// sweepPorts are knocked on when the agent can't send echo requests and the operator gave no ports. A host
+// answering any of them, even with a reset, is alive
+var sweepPorts = []uint16{22, 80, 135, 139, 443, 445, 3389, 5985}
+
+// echoPayload is what the ping command of Windows sends
+var echoPayload = []byte("abcdefghijklmnopqrstuvwabcdefghi")
+
+// handleSweep looks for live hosts until every target got its chance or the server closes the stream
+func handleSweep(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.SweepRequestPacket) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		io.Copy(io.Discard, conn)
+		cancel()
+	}()
+
+	var mu sync.Mutex
+	send := func(envelope protocol.Envelope) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return encoder.Encode(envelope)
+	}
+
+	done := sweep(ctx, request, func(result protocol.SweepResultPacket) {
+		if err := send(protocol.Envelope{Type: protocol.MessageSweepResult, Payload: result}); err != nil {
+			cancel()
+		}
+	})
+	if ctx.Err() != nil {
+		return // the server is gone
+	}
+
+	send(protocol.Envelope{Type: protocol.MessageSweepDone, Payload: done})
+}
+
+func sweep(ctx context.Context, request protocol.SweepRequestPacket, report func(protocol.SweepResultPacket)) protocol.SweepDonePacket {
+	hosts, err := sweepTargets(request.Targets)
+	if err != nil {
+		return protocol.SweepDonePacket{Err: true, ErrString: err.Error()}
+	}
+
+	timeout := time.Duration(request.Timeout)
+	if timeout <= 0 {
+		timeout = sweepTimeout
+	}
+
+	workers := int(request.Workers)
+	if workers <= 0 {
+		workers = sweepWorkers
+	}
+	if workers > maxSweepWorkers {
+		workers = maxSweepWorkers
+	}
+
+	done := protocol.SweepDonePacket{Probed: int32(len(hosts))}
+
+	echoed, err := echoSweep(ctx, hosts, timeout)
+	done.ICMP = err == nil
+
+	ports := request.Ports
+	if !done.ICMP && len(ports) == 0 {
+		ports = sweepPorts
+	}
+
+	var alive int32
+	var wg sync.WaitGroup
+	jobs := make(chan net.IP)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for host := range jobs {
+				rtt, echo := echoed[host.String()]
+
+				open, knocked, answered := knock(ctx, host, ports, timeout)
+				if !echo && !answered {
+					continue
+				}
+
+				result := protocol.SweepResultPacket{Address: host.String(), Method: "icmp", RTT: int64(rtt), Open: open}
+				if !echo {
+					result.Method, result.RTT = "tcp", int64(knocked)
+				}
+
+				atomic.AddInt32(&alive, 1)
+				report(result)
+			}
+		}()
+	}
+
+	for _, host := range hosts {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- host
+	}
+	close(jobs)
+	wg.Wait()
+
+	done.Alive = alive
+	return done
+}
+
+// knock connects to the ports of a host and returns the open ones. The host answered if any connection got through
+// or was reset, rtt is how long the first answer took
+func knock(ctx context.Context, host net.IP, ports []uint16, timeout time.Duration) (open []uint16, rtt time.Duration, answered bool) {
+	for _, port := range ports {
+		if ctx.Err() != nil {
+			return
+		}
+
+		dialCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		conn, err := targetDialer.DialContext(dialCtx, "tcp", net.JoinHostPort(host.String(), strconv.Itoa(int(port))))
+		elapsed := time.Since(start)
+		cancel()
+
+		var serr syscall.Errno
+		switch {
+		case err == nil:
+			conn.Close()
+			open = append(open, port)
+		case errors.As(err, &serr) && neterror.HostResponded(serr):
+		default:
+			continue
+		}
+
+		if !answered {
+			rtt, answered = elapsed, true
+		}
+	}
+
+	return
+}
+
+// echoSweep sends an echo request to every IPv4 host and returns the round trip of those that answered. It fails if
+// the agent may open neither a raw ICMP socket nor a ping socket
+func echoSweep(ctx context.Context, hosts []net.IP, timeout time.Duration) (map[string]time.Duration, error) {
+	var conn *icmp.PacketConn
+	privileged := true
+	err := targetDialer.Do(func() error {
+		var err error
+		if conn, err = icmp.ListenPacket("ip4:icmp", "0.0.0.0"); err != nil {
+			// Linux and macOS hand ping sockets to unprivileged users, on Linux if ping_group_range allows it
+			privileged = false
+			conn, err = icmp.ListenPacket("udp4", "0.0.0.0")
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	id := os.Getpid() & 0xffff
+
+	var mu sync.Mutex
+	sent := make(map[string]time.Time)
+	replies := make(map[string]time.Duration)
+
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+
+		buf := make([]byte, 1500)
+		for {
+			n, peer, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			msg, err := icmp.ParseMessage(1, buf[:n])
+			if err != nil || msg.Type != ipv4.ICMPTypeEchoReply {
+				continue
+			}
+
+			// ping sockets rewrite the identifier, and only get the replies to their own requests anyway
+			if echo, ok := msg.Body.(*icmp.Echo); !ok || (privileged && echo.ID != id) {
+				continue
+			}
+
+			var address string
+			switch peer := peer.(type) {
+			case *net.IPAddr:
+				address = peer.IP.String()
+			case *net.UDPAddr:
+				address = peer.IP.String()
+			}
+
+			mu.Lock()
+			if at, found := sent[address]; found {
+				if _, seen := replies[address]; !seen {
+					replies[address] = time.Since(at)
+				}
+			}
+			mu.Unlock()
+		}
+	}()
+
+	written := 0
+	var writeErr error
+	for i, host := range hosts {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if host.To4() == nil {
+			continue // knocked on instead
+		}
+
+		request := icmp.Message{
+			Type: ipv4.ICMPTypeEcho,
+			Body: &icmp.Echo{ID: id, Seq: i & 0xffff, Data: echoPayload},
+		}
+		data, err := request.Marshal(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var destination net.Addr = &net.IPAddr{IP: host}
+		if !privileged {
+			destination = &net.UDPAddr{IP: host}
+		}
+
+		mu.Lock()
+		sent[host.String()] = time.Now()
+		mu.Unlock()
+
+		if _, err := conn.WriteTo(data, destination); err != nil {
+			writeErr = err
+			continue
+		}
+		written++
+
+		time.Sleep(echoInterval)
+	}
+
+	if written == 0 && writeErr != nil {
+		conn.Close()
+		<-received
+		return nil, writeErr
+	}
+
+	conn.SetReadDeadline(time.Now().Add(timeout))
+	select {
+	case <-received:
+	case <-ctx.Done():
+		conn.Close()
+		<-received
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return replies, nil
+}
+
+// sweepTargets expands addresses and networks into hosts, leaving out network and broadcast addresses of IPv4
+// networks
+func sweepTargets(targets []string) ([]net.IP, error) {
+	var hosts []net.IP
+	for _, target := range targets {
+		if ip := net.ParseIP(target); ip != nil {
+			hosts = append(hosts, ip)
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(target)
+		if err != nil {
+			return nil, fmt.Errorf("%s is neither an address nor a network", target)
+		}
+
+		ones, bits := network.Mask.Size()
+		if bits-ones > 16 {
+			return nil, fmt.Errorf("%s is too large, networks go up to /%d", target, bits-16)
+		}
+
+		count := 1 << (bits - ones)
+		for i := 0; i < count; i++ {
+			if bits == 32 && count > 2 && (i == 0 || i == count-1) {
+				continue
+			}
+
+			hosts = append(hosts, nthIP(network.IP, i))
+		}
+	}
+
+	if len(hosts) > maxSweepHosts {
+		return nil, fmt.Errorf("%d hosts to sweep, at most %d at once", len(hosts), maxSweepHosts)
+	}
+
+	return hosts, nil
+}
+
+// nthIP is the address n after the given one
+func nthIP(ip net.IP, n int) net.IP {
+	result := make(net.IP, len(ip))
+	copy(result, ip)
+
+	for i := len(result) - 1; i >= 0 && n > 0; i-- {
+		sum := int(result[i]) + n
+		result[i] = byte(sum)
+		n = sum >> 8
+	}
+
+	return result
+}
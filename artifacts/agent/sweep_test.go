@@ -0,0 +1,31 @@
+package main
+
+import (
+	"net"
+	"testing"
+)
+
+func TestSweepTargets(t *testing.T) {
+	hosts, err := sweepTargets([]string{"10.0.0.0/24", "192.168.1.0/31", "fe80::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(hosts) != 254+2+1 {
+		t.Fatalf("expected %d hosts, got %d", 254+2+1, len(hosts))
+	}
+	if !hosts[0].Equal(net.ParseIP("10.0.0.1")) || !hosts[253].Equal(net.ParseIP("10.0.0.254")) {
+		t.Fatalf("network and broadcast addresses should be left out, got %s to %s", hosts[0], hosts[253])
+	}
+	if !hosts[255].Equal(net.ParseIP("192.168.1.1")) {
+		t.Fatalf("both addresses of a /31 should be swept, got %s", hosts[255])
+	}
+
+	if _, err := sweepTargets([]string{"10.0.0.0/15"}); err == nil {
+		t.Fatal("networks over a /16 should be refused")
+	}
+
+	if _, err := sweepTargets([]string{"10.0.0.0/16", "10.1.0.0/24"}); err == nil {
+		t.Fatal("sweeps over a /16 worth of hosts should be refused")
+	}
+}
@@ -0,0 +1,134 @@
+//go:build !shared && !service
+// +build !shared,!service
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+var (
+	versionOnce sync.Once
+	version     string
+)
+
+func init() {
+	// windows keeps the executable an update replaced until the previous process is gone
+	go func() {
+		path, err := executablePath()
+		if err != nil {
+			return
+		}
+
+		for i := 0; i < 10; i++ {
+			if err := os.Remove(path + ".old"); err == nil || os.IsNotExist(err) {
+				return
+			}
+			time.Sleep(time.Second)
+		}
+	}()
+}
+
+// agentVersion is the SHA-256 of the running executable, the server recognizes its builds by it
+func agentVersion() string {
+	versionOnce.Do(func() {
+		path, err := executablePath()
+		if err != nil {
+			return
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, f); err != nil {
+			return
+		}
+		version = hex.EncodeToString(hash.Sum(nil))
+	})
+
+	return version
+}
+
+func executablePath() (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(path)
+}
+
+// receiveUpdate writes the new executable next to the running one and puts it in its place once it checks out
+func receiveUpdate(conn net.Conn, request protocol.UpdateRequestPacket) (string, error) {
+	path, err := executablePath()
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return "", err
+	}
+	tmp := f.Name()
+
+	hash := sha256.New()
+	_, err = io.CopyN(io.MultiWriter(f, hash), conn, request.Size)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && hex.EncodeToString(hash.Sum(nil)) != request.Sha256 {
+		err = fmt.Errorf("update doesn't match its SHA-256")
+	}
+	if err == nil {
+		err = os.Chmod(tmp, info.Mode().Perm())
+	}
+	if err == nil {
+		err = installUpdate(path, tmp)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+
+	return path, nil
+}
+
+// handleUpdate replaces the agent with the executable the server sends and restarts from it, the session drops
+// and the new agent connects again straight away
+func handleUpdate(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.UpdateRequestPacket) {
+	var response protocol.UpdateResponsePacket
+	path, err := receiveUpdate(conn, request)
+	if err != nil {
+		response = protocol.UpdateResponsePacket{Err: true, ErrString: err.Error()}
+	}
+
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageUpdateResponse,
+		Payload: response,
+	}); err != nil || response.Err {
+		return
+	}
+	conn.Close()
+
+	// the new executable is in place either way, a failed restart only delays it to the next one
+	restart(path)
+}
@@ -0,0 +1,25 @@
+//go:build shared || service
+// +build shared service
+
+package main
+
+import (
+	"net"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+// agentVersion is empty for agents that are loaded by another process, they can't replace themselves
+func agentVersion() string {
+	return ""
+}
+
+func handleUpdate(conn net.Conn, encoder *protocol.LigoloEncoder, request protocol.UpdateRequestPacket) {
+	encoder.Encode(protocol.Envelope{
+		Type: protocol.MessageUpdateResponse,
+		Payload: protocol.UpdateResponsePacket{
+			Err:       true,
+			ErrString: "the agent doesn't run as a standalone executable and can't replace itself",
+		},
+	})
+}
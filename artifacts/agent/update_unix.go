@@ -0,0 +1,19 @@
+//go:build !shared && !service && !windows
+// +build !shared,!service,!windows
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// installUpdate replaces the executable, the running process keeps the one it started from
+func installUpdate(path string, update string) error {
+	return os.Rename(update, path)
+}
+
+// restart execs the new executable in place of the current process, keeping its PID and arguments
+func restart(path string) error {
+	return syscall.Exec(path, os.Args, os.Environ())
+}
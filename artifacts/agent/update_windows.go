@@ -0,0 +1,37 @@
+//go:build !shared && !service && windows
+// +build !shared,!service,windows
+
+package main
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// installUpdate moves the running executable aside, windows doesn't let it be replaced but lets it be renamed.
+// The new agent removes it once this process is gone
+func installUpdate(path string, update string) error {
+	if err := os.Rename(path, path+".old"); err != nil {
+		return err
+	}
+
+	if err := os.Rename(update, path); err != nil {
+		os.Rename(path+".old", path)
+		return err
+	}
+
+	return nil
+}
+
+// restart starts the new executable with the same arguments and exits
+func restart(path string) error {
+	cmd := exec.Command(path, os.Args[1:]...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	os.Exit(0)
+	return nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// upgradeInterval is how often the other servers are tried while the session goes over a fallback transport
+const upgradeInterval = 5 * time.Minute
+
+// isFallback tells whether the server is reached over a transport only worth keeping until another server answers
+func isFallback(server string) bool {
+	return strings.HasPrefix(server, "dns://")
+}
+
+// watchUpgrade keeps trying the other servers while the session goes over a fallback transport, and drops the session
+// as soon as one answers for the rotation to reconnect. The returned function stops watching and tells which server
+// answered, -1 if none did
+func watchUpgrade(servers []string, current int, dial func(string) (net.Conn, error)) func() int {
+	if !isFallback(servers[current]) {
+		return func() int { return -1 }
+	}
+
+	found := -1
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(upgradeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+
+			for i, server := range servers {
+				if i == current || isFallback(server) {
+					continue
+				}
+
+				if err := reachServer(server, dial); err == nil {
+					found = i
+					dropUpstream()
+					return
+				}
+			}
+		}
+	}()
+
+	return func() int {
+		close(stop)
+		<-done
+		return found
+	}
+}
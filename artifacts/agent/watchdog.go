@@ -0,0 +1,179 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp-agent/internal/protocol"
+)
+
+const (
+	maxCrashes      = 20 // distinct crashes kept until the server hears of them
+	maxPanicLength  = 200
+	restartBurst    = 5 // restarts allowed within restartWindow before backing off
+	restartWindow   = time.Minute
+	maxRestartDelay = 5 * time.Minute
+)
+
+// watchdog keeps the agent going through panics. It sums them up for the server and paces restarts, so a pivot
+// that keeps crashing flaps slowly instead of hammering the server
+var watchdog crashWatchdog
+
+type crashWatchdog struct {
+	sync.Mutex
+	pending  []protocol.Crash
+	restarts []time.Time
+}
+
+// supervise runs f, and runs it again after each panic if restart is set. Restarts are delayed once they come too
+// fast, up to maxRestartDelay
+func supervise(where string, restart bool, f func()) {
+	for watchdog.run(where, f) && restart {
+		time.Sleep(watchdog.restartDelay())
+	}
+}
+
+// run calls f and tells whether it panicked. The panic is recorded and reported to the server if it's connected
+func (w *crashWatchdog) run(where string, f func()) (panicked bool) {
+	defer func() {
+		if err := recover(); err != nil {
+			panicked = true
+			w.record(newCrash(where, err, debug.Stack(), time.Now()))
+			go w.report()
+		}
+	}()
+
+	f()
+	return false
+}
+
+func (w *crashWatchdog) restartDelay() time.Duration {
+	w.Lock()
+	defer w.Unlock()
+
+	now := time.Now()
+	recent := w.restarts[:0]
+	for _, restart := range w.restarts {
+		if now.Sub(restart) < restartWindow {
+			recent = append(recent, restart)
+		}
+	}
+	w.restarts = append(recent, now)
+
+	if len(recent) < restartBurst {
+		return 0
+	}
+
+	delay := time.Second << (len(recent) - restartBurst)
+	if delay <= 0 || delay > maxRestartDelay {
+		delay = maxRestartDelay
+	}
+	return delay
+}
+
+// record adds crashes to the ones waiting for the server, merging those that happened at the same place
+func (w *crashWatchdog) record(crashes ...protocol.Crash) {
+	w.Lock()
+	defer w.Unlock()
+
+next:
+	for _, crash := range crashes {
+		for i := range w.pending {
+			existing := &w.pending[i]
+			if existing.Where == crash.Where && existing.StackHash == crash.StackHash {
+				existing.Count += crash.Count
+				if crash.First < existing.First {
+					existing.First = crash.First
+				}
+				if crash.Last > existing.Last {
+					existing.Last = crash.Last
+					existing.Panic = crash.Panic
+				}
+				continue next
+			}
+		}
+
+		if len(w.pending) == maxCrashes {
+			w.pending = w.pending[1:]
+		}
+		w.pending = append(w.pending, crash)
+	}
+}
+
+// drain hands over the crashes waiting for the server, they have to be recorded again if it doesn't get them
+func (w *crashWatchdog) drain() []protocol.Crash {
+	w.Lock()
+	defer w.Unlock()
+
+	crashes := w.pending
+	w.pending = nil
+	return crashes
+}
+
+// report sends the waiting crashes to the server, they are kept for the next connection if that fails
+func (w *crashWatchdog) report() {
+	crashes := w.drain()
+	if len(crashes) == 0 {
+		return
+	}
+
+	stream, encoder, err := openUpstream()
+	if err == nil {
+		err = encoder.Encode(protocol.Envelope{
+			Type:    protocol.MessageCrashReport,
+			Payload: protocol.CrashReportPacket{Crashes: crashes},
+		})
+		stream.Close()
+	}
+
+	if err != nil {
+		w.record(crashes...)
+	}
+}
+
+// newCrash sums up a panic. The stack is reduced to the functions it goes through, without arguments, paths or
+// offsets, so its hash is the same from one build to the next and tells nothing about the target
+func newCrash(where string, err any, stack []byte, at time.Time) protocol.Crash {
+	message := fmt.Sprint(err)
+	if len(message) > maxPanicLength {
+		message = message[:maxPanicLength] + "..."
+	}
+
+	var functions []string
+	for _, line := range strings.Split(string(stack), "\n") {
+		if line == "" || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "goroutine ") {
+			continue
+		}
+		if i := strings.LastIndex(line, "("); i > 0 {
+			line = line[:i]
+		}
+		functions = append(functions, line)
+	}
+
+	// the panicking function comes right after the runtime's panic handling, the watchdog's own frames come first
+	var frame string
+	for i, function := range functions {
+		if function == "panic" && i+1 < len(functions) {
+			frame = functions[i+1]
+			functions = functions[i+1:]
+			break
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(functions, "\n")))
+
+	return protocol.Crash{
+		Where:     where,
+		Panic:     message,
+		Frame:     frame,
+		StackHash: hex.EncodeToString(sum[:8]),
+		Count:     1,
+		First:     at.UnixNano(),
+		Last:      at.UnixNano(),
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWatchdog(t *testing.T) {
+	var w crashWatchdog
+
+	for i := 0; i < 3; i++ {
+		if !w.run("stream", func() { panic("stream " + strings.Repeat("x", maxPanicLength)) }) {
+			t.Fatal("panic went unnoticed")
+		}
+	}
+	if w.run("stream", func() {}) {
+		t.Fatal("no panic to notice")
+	}
+
+	crashes := w.drain()
+	if len(crashes) != 1 || crashes[0].Count != 3 || crashes[0].StackHash == "" {
+		t.Fatalf("crashes at the same place should be merged: %+v", crashes)
+	}
+	if !strings.HasSuffix(crashes[0].Frame, "TestWatchdog.func1") {
+		t.Fatalf("unexpected frame %s", crashes[0].Frame)
+	}
+	if len(crashes[0].Panic) != maxPanicLength+3 {
+		t.Fatalf("panic message should be truncated: %s", crashes[0].Panic)
+	}
+
+	for i := 0; i < restartBurst; i++ {
+		if delay := w.restartDelay(); delay != 0 {
+			t.Fatalf("restart %d delayed by %s", i+1, delay)
+		}
+	}
+	if delay := w.restartDelay(); delay == 0 {
+		t.Fatal("restarts coming too fast should be delayed")
+	}
+}
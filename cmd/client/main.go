@@ -7,9 +7,11 @@ import (
 	"os"
 
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
 	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
+	_ "github.com/ttpreport/ligolo-mp/v2/internal/fips" // enforces FIPS-only TLS in boringcrypto builds
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
 	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
 	"github.com/ttpreport/ligolo-mp/v2/pkg/logger"
@@ -17,9 +19,23 @@ import (
 
 func main() {
 	var verbose = flag.Bool("v", false, "enable verbose mode")
+	var layout = flag.String("layout", utils.LayoutAuto, "layout preset: auto, regular or compact")
+	var compactBelow = flag.String("compact-below", "100x30", "terminal size under which the auto layout turns compact")
+	var enroll = flag.String("enroll", "", "enroll with a one-time code from an admin, the credentials are stored before the TUI starts")
 
 	flag.Parse()
 
+	if *layout != utils.LayoutAuto && *layout != utils.LayoutRegular && *layout != utils.LayoutCompact {
+		fmt.Fprintf(os.Stderr, "unknown layout %s\n", *layout)
+		os.Exit(1)
+	}
+
+	var compactWidth, compactHeight int
+	if _, err := fmt.Sscanf(*compactBelow, "%dx%d", &compactWidth, &compactHeight); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid terminal size %s, expected WIDTHxHEIGHT\n", *compactBelow)
+		os.Exit(1)
+	}
+
 	loggingOpts := &slog.HandlerOptions{}
 	if *verbose {
 		lvl := new(slog.LevelVar)
@@ -65,7 +81,24 @@ func main() {
 	certService := certificate.NewCertificateService(certRepo, crlService)
 	operService := operator.NewOperatorService(cfg, operRepo, certService)
 
+	if *enroll != "" {
+		config, err := operator.Enroll(*enroll)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "could not enroll: %s\n", err)
+			os.Exit(1)
+		}
+
+		oper, err := operService.ImportOperator(config)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "could not store credentials: %s\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("enrolled as %s on %s\n", oper.Name, oper.Server)
+	}
+
 	app := tui.NewApp(operService)
+	app.SetLayout(*layout, compactWidth, compactHeight)
 	logHandler = slog.New(logger.NewLogHandler(app.Logs, loggingOpts))
 	slog.SetDefault(logHandler)
 	app.Run()
@@ -0,0 +1,90 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	annotate_tags = FormVal[string]{
+		Hint: "Tags separated by commas, e.g. \"DC subnet, web DMZ\". Shared with every operator, the dashboard filter (Ctrl+L) matches them.",
+	}
+
+	annotate_note = FormVal[string]{
+		Hint: "Free-form context for the team: what it is, what runs through it, what to avoid. Leave empty to remove the note.",
+	}
+)
+
+// AnnotateForm sets the tags and note of a session or a route
+type AnnotateForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewAnnotateForm(title string, tags string, note string) *AnnotateForm {
+	page := &AnnotateForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle(title).SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	// the form edits what's already there, not what was last typed elsewhere
+	annotate_tags.Last = tags
+	annotate_note.Last = note
+
+	tagsField := tview.NewInputField()
+	tagsField.SetLabel("Tags")
+	tagsField.SetText(annotate_tags.Last)
+	tagsField.SetFocusFunc(func() {
+		hintBox.SetText(annotate_tags.Hint)
+	})
+	tagsField.SetChangedFunc(func(text string) {
+		annotate_tags.Last = text
+	})
+	page.form.AddFormItem(tagsField)
+
+	noteField := tview.NewTextArea()
+	noteField.SetLabel("Note")
+	noteField.SetText(annotate_note.Last, false)
+	noteField.SetFocusFunc(func() {
+		hintBox.SetText(annotate_note.Hint)
+	})
+	noteField.SetChangedFunc(func() {
+		annotate_note.Last = noteField.GetText()
+	})
+	page.form.AddFormItem(noteField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 9, 2)
+
+	return page
+}
+
+func (page *AnnotateForm) GetID() string {
+	return "annotate_page"
+}
+
+func (page *AnnotateForm) SetSubmitFunc(f func(tags string, note string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(annotate_tags.Last, annotate_note.Last)
+	})
+}
+
+func (page *AnnotateForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,150 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	attachment_file = FormVal[string]{
+		Hint: "Path to a file to attach: screenshot, loot sample, config dump. Leave empty to only leave a note. Size is limited by the server.\n\nExample:\n/home/kali/loot/web.config",
+	}
+
+	attachment_note = FormVal[string]{
+		Hint: "Note shown to the other operators alongside the attachment.",
+	}
+
+	attachment_saveTo = FormVal[string]{
+		Last: wd,
+		Hint: "Path to save the attachment. Specify only directory to keep the original filename, otherwise provide full path with filename.\n\nExample:\n/home/kali\n/home/kali/web.config",
+	}
+)
+
+type AttachmentForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewAttachmentForm() *AttachmentForm {
+	page := &AttachmentForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Add attachment").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	// attachments are one-offs, don't carry the previous one over
+	attachment_file.Last = ""
+	attachment_note.Last = ""
+
+	fileField := tview.NewInputField()
+	fileField.SetLabel("File")
+	fileField.SetFocusFunc(func() {
+		hintBox.SetText(attachment_file.Hint)
+	})
+	fileField.SetChangedFunc(func(text string) {
+		attachment_file.Last = text
+	})
+	page.form.AddFormItem(fileField)
+
+	noteField := tview.NewTextArea()
+	noteField.SetLabel("Note")
+	noteField.SetFocusFunc(func() {
+		hintBox.SetText(attachment_note.Hint)
+	})
+	noteField.SetChangedFunc(func() {
+		attachment_note.Last = noteField.GetText()
+	})
+	page.form.AddFormItem(noteField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 12, hintBox, 9, 2)
+
+	return page
+}
+
+func (page *AttachmentForm) GetID() string {
+	return "attachment_page"
+}
+
+func (page *AttachmentForm) SetSubmitFunc(f func(string, string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(attachment_file.Last, attachment_note.Last)
+	})
+}
+
+func (page *AttachmentForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
+
+type SaveAttachmentForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewSaveAttachmentForm() *SaveAttachmentForm {
+	page := &SaveAttachmentForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Download attachment").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	saveToField := tview.NewInputField()
+	saveToField.SetLabel("Save to")
+	saveToField.SetText(attachment_saveTo.Last)
+	saveToField.SetFocusFunc(func() {
+		hintBox.SetText(attachment_saveTo.Hint)
+	})
+	saveToField.SetChangedFunc(func(text string) {
+		attachment_saveTo.Last = text
+	})
+	page.form.AddFormItem(saveToField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 11, 1)
+
+	return page
+}
+
+func (page *SaveAttachmentForm) GetID() string {
+	return "save_attachment_page"
+}
+
+func (page *SaveAttachmentForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(attachment_saveTo.Last)
+	})
+}
+
+func (page *SaveAttachmentForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
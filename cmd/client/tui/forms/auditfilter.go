@@ -0,0 +1,120 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	auditfilter_operator = FormVal[string]{
+		Hint: "Only show actions of this operator. Leave empty for everyone.\n\nExample:\nalice",
+	}
+
+	auditfilter_method = FormVal[string]{
+		Hint: "Only show actions whose name contains this, regardless of case.\n\nExample:\nroute",
+	}
+
+	auditfilter_query = FormVal[string]{
+		Hint: "Only show actions whose parameters contain this, regardless of case, e.g. a network or a session ID.\n\nExample:\n10.10.0.0/16",
+	}
+
+	auditfilter_since = FormVal[string]{
+		Hint: "Only show actions from then on, as YYYY-MM-DD or YYYY-MM-DD HH:MM in local time.\n\nExample:\n2024-06-03 09:00",
+	}
+
+	auditfilter_until = FormVal[string]{
+		Hint: "Only show actions up to then, as YYYY-MM-DD or YYYY-MM-DD HH:MM in local time. A day alone includes all of it.\n\nExample:\n2024-06-14",
+	}
+
+	auditfilter_failed = FormVal[bool]{
+		Hint: "If checked, only actions that failed or were refused are shown",
+	}
+)
+
+// AuditFilterForm narrows the audit log down to matching actions
+type AuditFilterForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewAuditFilterForm() *AuditFilterForm {
+	page := &AuditFilterForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Filter audit log").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	fields := []struct {
+		label string
+		val   *FormVal[string]
+	}{
+		{"Operator", &auditfilter_operator},
+		{"Action", &auditfilter_method},
+		{"Parameters", &auditfilter_query},
+		{"Since", &auditfilter_since},
+		{"Until", &auditfilter_until},
+	}
+
+	for _, field := range fields {
+		val := field.val
+
+		input := tview.NewInputField()
+		input.SetLabel(field.label)
+		input.SetText(val.Last)
+		input.SetFocusFunc(func() {
+			hintBox.SetText(val.Hint)
+		})
+		input.SetChangedFunc(func(text string) {
+			val.Last = text
+		})
+		page.form.AddFormItem(input)
+	}
+
+	failedField := tview.NewCheckbox()
+	failedField.SetLabel("Failed only")
+	failedField.SetChecked(auditfilter_failed.Last)
+	failedField.SetFocusFunc(func() {
+		hintBox.SetText(auditfilter_failed.Hint)
+	})
+	failedField.SetChangedFunc(func(checked bool) {
+		auditfilter_failed.Last = checked
+	})
+	page.form.AddFormItem(failedField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 17, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *AuditFilterForm) GetID() string {
+	return "auditfilter_page"
+}
+
+func (page *AuditFilterForm) SetSubmitFunc(f func(operator string, method string, query string, since string, until string, failed bool)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(auditfilter_operator.Last), strings.TrimSpace(auditfilter_method.Last), strings.TrimSpace(auditfilter_query.Last),
+			strings.TrimSpace(auditfilter_since.Last), strings.TrimSpace(auditfilter_until.Last), auditfilter_failed.Last)
+	})
+}
+
+func (page *AuditFilterForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,75 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	bandwidth_limit = FormVal[string]{
+		Hint: "Most the agent's tunnel connection takes from the target network, each way, in bits per second. Keeps a busy pivot from saturating a production link. The agent gets it back whenever it reconnects. Leave empty to lift the cap.\n\nExample:\n512k\n10M",
+	}
+)
+
+// BandwidthForm edits the bandwidth cap of a session
+type BandwidthForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewBandwidthForm(limit string) *BandwidthForm {
+	page := &BandwidthForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	bandwidth_limit.Last = limit
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Bandwidth cap").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	limitField := tview.NewInputField()
+	limitField.SetLabel("Bandwidth")
+	limitField.SetText(bandwidth_limit.Last)
+	limitField.SetFocusFunc(func() {
+		hintBox.SetText(bandwidth_limit.Hint)
+	})
+	limitField.SetChangedFunc(func(text string) {
+		bandwidth_limit.Last = text
+	})
+	page.form.AddFormItem(limitField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 12, 1)
+
+	return page
+}
+
+func (page *BandwidthForm) GetID() string {
+	return "bandwidth_page"
+}
+
+func (page *BandwidthForm) SetSubmitFunc(f func(limit string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(bandwidth_limit.Last))
+	})
+}
+
+func (page *BandwidthForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
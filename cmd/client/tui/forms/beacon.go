@@ -0,0 +1,108 @@
+package forms
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	beacon_interval = FormVal[string]{
+		Hint: "How often the agent checks in while asleep. Unless it's woken up, the tunnel is dropped right after each check-in. Leave empty to keep the agent connected.\n\nExample:\n30m\n4h",
+	}
+
+	beacon_jitter = FormVal[string]{
+		Hint: "Percentage the interval is randomly shortened by, so check-ins don't form a regular pattern.\n\nExample:\n20",
+	}
+)
+
+type BeaconForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewBeaconForm(interval time.Duration, jitter uint8) *BeaconForm {
+	page := &BeaconForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	beacon_interval.Last = ""
+	if interval > 0 {
+		beacon_interval.Last = interval.String()
+	}
+	beacon_jitter.Last = strconv.Itoa(int(jitter))
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Beacon").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	intervalField := tview.NewInputField()
+	intervalField.SetLabel("Interval")
+	intervalField.SetText(beacon_interval.Last)
+	intervalField.SetFocusFunc(func() {
+		hintBox.SetText(beacon_interval.Hint)
+	})
+	intervalField.SetChangedFunc(func(text string) {
+		beacon_interval.Last = text
+	})
+	page.form.AddFormItem(intervalField)
+
+	jitterField := tview.NewInputField()
+	jitterField.SetLabel("Jitter (%)")
+	jitterField.SetText(beacon_jitter.Last)
+	jitterField.SetAcceptanceFunc(tview.InputFieldInteger)
+	jitterField.SetFocusFunc(func() {
+		hintBox.SetText(beacon_jitter.Hint)
+	})
+	jitterField.SetChangedFunc(func(text string) {
+		beacon_jitter.Last = text
+	})
+	page.form.AddFormItem(jitterField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 9, 1)
+
+	return page
+}
+
+func (page *BeaconForm) GetID() string {
+	return "beacon_page"
+}
+
+// SetSubmitFunc is called with the parsed interval and jitter, or with the error if they're invalid
+func (page *BeaconForm) SetSubmitFunc(f func(time.Duration, uint8, error)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		var interval time.Duration
+		var err error
+		if text := strings.TrimSpace(beacon_interval.Last); text != "" {
+			interval, err = time.ParseDuration(text)
+		}
+
+		jitter := 0
+		if err == nil && beacon_jitter.Last != "" {
+			jitter, err = strconv.Atoi(beacon_jitter.Last)
+		}
+
+		f(interval, uint8(min(max(jitter, 0), 100)), err)
+	})
+}
+
+func (page *BeaconForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,76 @@
+package forms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var breakout_rules = FormVal[string]{
+	Hint: "One rule per line, a network and the interface of the target its connections leave through. The most specific network wins, a network without interface follows the routing table of the target. Leave empty to follow it for everything.\n\nExample:\n10.20.0.0/16 eth1\n10.20.5.0/24",
+}
+
+// BreakoutForm edits the interfaces relayed connections of a session leave through
+type BreakoutForm struct {
+	tview.Flex
+	form  *tview.Form
+	rules *tview.TextArea
+}
+
+// NewBreakoutForm starts from the current table, the hint lists the interfaces the agent reported
+func NewBreakoutForm(current string, interfaces []string) *BreakoutForm {
+	page := &BreakoutForm{
+		Flex:  *tview.NewFlex(),
+		form:  tview.NewForm(),
+		rules: tview.NewTextArea(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Breakout").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	hint := breakout_rules.Hint
+	if len(interfaces) > 0 {
+		hint = fmt.Sprintf("%s\n\nInterfaces:\n%s", hint, strings.Join(interfaces, "\n"))
+	}
+
+	page.rules.SetLabel("Rules")
+	page.rules.SetText(current, false)
+	page.rules.SetFocusFunc(func() {
+		hintBox.SetText(hint)
+	})
+	page.form.AddFormItem(page.rules)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 13, hintBox, 16, 2)
+
+	return page
+}
+
+func (page *BreakoutForm) GetID() string {
+	return "breakout_page"
+}
+
+func (page *BreakoutForm) SetSubmitFunc(f func(rules string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(page.rules.GetText())
+	})
+}
+
+func (page *BreakoutForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,90 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	bridge_from = FormVal[string]{
+		Hint: "Where agents without egress link to, on this agent. An address for TCP, a pipe name for SMB.\n\nExample:\n0.0.0.0:11601\nligolo",
+	}
+
+	bridge_protocol = FormVal[FormSelectVal]{
+		Hint: "TCP takes agents built with tls://<this host>:<port> as server. Named pipe takes those built with smb://<this host>:<pipe name>, Windows only.",
+	}
+)
+
+// AddBridgeForm opens a listener on an agent for other agents to link to the server through it
+type AddBridgeForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewAddBridgeForm() *AddBridgeForm {
+	page := &AddBridgeForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Add bridge").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	fromField := tview.NewInputField()
+	fromField.SetLabel("Listen on")
+	fromField.SetText(bridge_from.Last)
+	fromField.SetFocusFunc(func() {
+		hintBox.SetText(bridge_from.Hint)
+	})
+	fromField.SetChangedFunc(func(text string) {
+		bridge_from.Last = text
+	})
+	page.form.AddFormItem(fromField)
+
+	protocolValues := []string{"tcp", "pipe"}
+	protocolField := tview.NewDropDown()
+	protocolField.SetLabel("Protocol")
+	protocolField.SetFocusFunc(func() {
+		hintBox.SetText(bridge_protocol.Hint)
+	})
+	protocolField.SetOptions([]string{"TCP", "Named pipe"}, func(option string, index int) {
+		bridge_protocol.Last.ID = index
+		bridge_protocol.Last.Value = protocolValues[index]
+	})
+	protocolField.SetCurrentOption(bridge_protocol.Last.ID)
+	page.form.AddFormItem(protocolField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 9, 1)
+
+	return page
+}
+
+func (page *AddBridgeForm) GetID() string {
+	return "addbridge_page"
+}
+
+func (page *AddBridgeForm) SetSubmitFunc(f func(from string, proto string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(bridge_from.Last), bridge_protocol.Last.Value)
+	})
+}
+
+func (page *AddBridgeForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
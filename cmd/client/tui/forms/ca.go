@@ -0,0 +1,106 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	ca_name = FormVal[string]{
+		Hint: "Name of the intermediate CA, builds, operators and enrollments pick it by this name.\n\nExample:\nop-nightfall",
+	}
+
+	ca_engagement = FormVal[string]{
+		Hint: "Engagement the CA issues for by default while it's current, leave empty to only use it when picked. An engagement has one CA at most until it's revoked.",
+	}
+
+	// issuing_ca is shared by the forms issuing a certificate
+	issuing_ca = FormVal[string]{
+		Hint: "Intermediate CA issuing the certificate. Leave empty for the current engagement's, or the server CA if it has none.\n\nRevoking the CA refuses every certificate it issued.",
+	}
+)
+
+// CAForm asks for the intermediate CA to add
+type CAForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewCAForm() *CAForm {
+	page := &CAForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("New intermediate CA").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	nameField := tview.NewInputField()
+	nameField.SetLabel("Name")
+	nameField.SetText(ca_name.Last)
+	nameField.SetFocusFunc(func() {
+		hintBox.SetText(ca_name.Hint)
+	})
+	nameField.SetChangedFunc(func(text string) {
+		ca_name.Last = text
+	})
+	page.form.AddFormItem(nameField)
+
+	engagementField := tview.NewInputField()
+	engagementField.SetLabel("Engagement")
+	engagementField.SetText(ca_engagement.Last)
+	engagementField.SetFocusFunc(func() {
+		hintBox.SetText(ca_engagement.Hint)
+	})
+	engagementField.SetChangedFunc(func(text string) {
+		ca_engagement.Last = text
+	})
+	page.form.AddFormItem(engagementField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 9, 2)
+
+	return page
+}
+
+func (page *CAForm) GetID() string {
+	return "ca_form"
+}
+
+func (page *CAForm) SetSubmitFunc(f func(name string, engagement string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(ca_name.Last, ca_engagement.Last)
+	})
+}
+
+func (page *CAForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
+
+// newIssuingCAField is the input of the intermediate CA a certificate is issued from
+func newIssuingCAField(hintBox *tview.TextView) *tview.InputField {
+	caField := tview.NewInputField()
+	caField.SetLabel("CA")
+	caField.SetText(issuing_ca.Last)
+	caField.SetFocusFunc(func() {
+		hintBox.SetText(issuing_ca.Hint)
+	})
+	caField.SetChangedFunc(func(text string) {
+		issuing_ca.Last = text
+	})
+
+	return caField
+}
@@ -0,0 +1,101 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	engagement_name = FormVal[string]{
+		Hint: "Name sessions, builds and audit records get filed under.\n\nExample:\nacme-internal-2024",
+	}
+
+	engagement_scope = FormVal[string]{
+		Hint: "Comma separated CIDRs routes have to stay in while the engagement is current. Leave empty to allow anything.\n\nExample:\n10.10.0.0/16, 192.168.56.0/24",
+	}
+
+	engagement_start = FormVal[string]{
+		Hint: "First day of the engagement as YYYY-MM-DD. No agents get built and no routes get added before it.\n\nExample:\n2024-06-03",
+	}
+
+	engagement_end = FormVal[string]{
+		Hint: "Last day of the engagement as YYYY-MM-DD, included. Leave empty if it's open ended.\n\nExample:\n2024-06-14",
+	}
+
+	engagement_operators = FormVal[string]{
+		Hint: "Comma separated operators allowed on the server while the engagement is current, admins always are. Leave empty to allow everyone.\n\nExample:\nalice, bob",
+	}
+)
+
+type EngagementForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewEngagementForm() *EngagementForm {
+	page := &EngagementForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("New engagement").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	fields := []struct {
+		label string
+		val   *FormVal[string]
+	}{
+		{"Name", &engagement_name},
+		{"Scope", &engagement_scope},
+		{"Start", &engagement_start},
+		{"End", &engagement_end},
+		{"Operators", &engagement_operators},
+	}
+
+	for _, field := range fields {
+		val := field.val
+
+		input := tview.NewInputField()
+		input.SetLabel(field.label)
+		input.SetText(val.Last)
+		input.SetFocusFunc(func() {
+			hintBox.SetText(val.Hint)
+		})
+		input.SetChangedFunc(func(text string) {
+			val.Last = text
+		})
+		page.form.AddFormItem(input)
+	}
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 15, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *EngagementForm) GetID() string {
+	return "engagement_page"
+}
+
+func (page *EngagementForm) SetSubmitFunc(f func(name string, scope []string, start string, end string, operators []string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(engagement_name.Last, splitList(engagement_scope.Last), engagement_start.Last, engagement_end.Last, splitList(engagement_operators.Last))
+	})
+}
+
+func (page *EngagementForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,71 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	enroll_code = FormVal[string]{
+		Hint: "One-time code an admin issued for you, the credentials are fetched from the server and stored here.\n\nExample:\nligolo-enroll://1.2.3.4:58009/...",
+	}
+)
+
+// EnrollForm asks for an enrollment code
+type EnrollForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewEnrollForm() *EnrollForm {
+	page := &EnrollForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Enroll").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	codeField := tview.NewInputField()
+	codeField.SetLabel("Code")
+	codeField.SetText(enroll_code.Last)
+	codeField.SetFocusFunc(func() {
+		hintBox.SetText(enroll_code.Hint)
+	})
+	codeField.SetChangedFunc(func(text string) {
+		enroll_code.Last = text
+	})
+	page.form.AddFormItem(codeField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 9, 1)
+
+	return page
+}
+
+func (page *EnrollForm) GetID() string {
+	return "enroll_form"
+}
+
+func (page *EnrollForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(enroll_code.Last)
+	})
+}
+
+func (page *EnrollForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,125 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+)
+
+var (
+	enrollment_name = FormVal[string]{
+		Hint: "Name of the operator to be",
+	}
+
+	enrollment_server = FormVal[string]{
+		Hint: "Address of this server with a port, as the new operator reaches it. The enrollment endpoint is expected on the same host.\n\nExample:\n1.2.3.4:58008",
+	}
+
+	enrollment_role = FormVal[FormSelectVal]{
+		Hint: "What the operator may do.\n\nadmin: everything, including operators, certificates and listeners\noperator: sessions, routes and builds\nread-only: watch sessions, routes and builds, without changing anything",
+		Last: FormSelectVal{ID: 1, Value: operator.RoleOperator},
+	}
+
+	enrollment_ttl = FormVal[string]{
+		Last: "24h",
+		Hint: "How long the code can be used, once at most.\n\nExample:\n1h\n72h",
+	}
+)
+
+// EnrollmentForm asks for the operator a one-time enrollment code is issued for
+type EnrollmentForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewEnrollmentForm() *EnrollmentForm {
+	page := &EnrollmentForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("New enrollment").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	nameField := tview.NewInputField()
+	nameField.SetLabel("Name")
+	nameField.SetText(enrollment_name.Last)
+	nameField.SetFocusFunc(func() {
+		hintBox.SetText(enrollment_name.Hint)
+	})
+	nameField.SetChangedFunc(func(text string) {
+		enrollment_name.Last = text
+	})
+	page.form.AddFormItem(nameField)
+
+	serverField := tview.NewInputField()
+	serverField.SetLabel("Server")
+	serverField.SetText(enrollment_server.Last)
+	serverField.SetFocusFunc(func() {
+		hintBox.SetText(enrollment_server.Hint)
+	})
+	serverField.SetChangedFunc(func(text string) {
+		enrollment_server.Last = text
+	})
+	page.form.AddFormItem(serverField)
+
+	roleField := tview.NewDropDown()
+	roleField.SetLabel("Role")
+	roleField.SetFocusFunc(func() {
+		hintBox.SetText(enrollment_role.Hint)
+	})
+	roleField.SetOptions(operator.Roles, func(option string, index int) {
+		enrollment_role.Last.ID = index
+		enrollment_role.Last.Value = option
+	})
+	roleField.SetCurrentOption(enrollment_role.Last.ID)
+	roleField.SetBlurFunc(func() {
+		hintBox.Clear()
+	})
+	page.form.AddFormItem(roleField)
+
+	ttlField := tview.NewInputField()
+	ttlField.SetLabel("Expires in")
+	ttlField.SetText(enrollment_ttl.Last)
+	ttlField.SetFocusFunc(func() {
+		hintBox.SetText(enrollment_ttl.Hint)
+	})
+	ttlField.SetChangedFunc(func(text string) {
+		enrollment_ttl.Last = text
+	})
+	page.form.AddFormItem(ttlField)
+
+	page.form.AddFormItem(newIssuingCAField(hintBox))
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 15, hintBox, 9, 1)
+
+	return page
+}
+
+func (page *EnrollmentForm) GetID() string {
+	return "enrollment_form"
+}
+
+func (page *EnrollmentForm) SetSubmitFunc(f func(string, string, string, string, string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(enrollment_name.Last, enrollment_role.Last.Value, enrollment_server.Last, enrollment_ttl.Last, issuing_ca.Last)
+	})
+}
+
+func (page *EnrollmentForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
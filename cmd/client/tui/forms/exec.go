@@ -0,0 +1,89 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	exec_command = FormVal[string]{
+		Hint: "Command to run on the target, through /bin/sh -c or cmd.exe /c depending on its OS. It runs as the user of the agent and is recorded in the event log.\n\nExample:\nid; uname -a\nipconfig /all",
+	}
+
+	exec_timeout = FormVal[string]{
+		Last: "1m",
+		Hint: "How long the command may run before the agent kills it. Leave empty to let it run until it exits or is cancelled.\n\nExample:\n30s\n5m",
+	}
+)
+
+// ExecForm asks for a command to run on an agent
+type ExecForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewExecForm() *ExecForm {
+	page := &ExecForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Run command").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	commandField := tview.NewInputField()
+	commandField.SetLabel("Command")
+	commandField.SetText(exec_command.Last)
+	commandField.SetFocusFunc(func() {
+		hintBox.SetText(exec_command.Hint)
+	})
+	commandField.SetChangedFunc(func(text string) {
+		exec_command.Last = text
+	})
+	page.form.AddFormItem(commandField)
+
+	timeoutField := tview.NewInputField()
+	timeoutField.SetLabel("Timeout")
+	timeoutField.SetText(exec_timeout.Last)
+	timeoutField.SetFocusFunc(func() {
+		hintBox.SetText(exec_timeout.Hint)
+	})
+	timeoutField.SetChangedFunc(func(text string) {
+		exec_timeout.Last = text
+	})
+	page.form.AddFormItem(timeoutField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 12, 1)
+
+	return page
+}
+
+func (page *ExecForm) GetID() string {
+	return "exec_page"
+}
+
+func (page *ExecForm) SetSubmitFunc(f func(command string, timeout string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(exec_command.Last), strings.TrimSpace(exec_timeout.Last))
+	})
+}
+
+func (page *ExecForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -2,6 +2,7 @@ package forms
 
 import (
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 )
 
 var (
@@ -53,17 +54,7 @@ func NewExportForm() *ExportForm {
 	export.form.AddButton("Submit", nil)
 	export.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(export.form, 7, 1, true).
-		AddItem(hintBox, 11, 1, false)
-
-	export.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&export.Flex, export.form, 7, hintBox, 11, 1)
 
 	return export
 }
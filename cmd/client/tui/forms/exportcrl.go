@@ -0,0 +1,72 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	exportcrl_saveTo = FormVal[string]{
+		Last: wd,
+		Hint: "Path to save the CRL of the server's CA, DER encoded as served over HTTP. Specify only directory for default filename, otherwise provide full path with filename.\n\nExample:\n/home/kali\n/home/kali/ca.crl",
+	}
+)
+
+// ExportCRLForm asks where to save the CRL
+type ExportCRLForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewExportCRLForm() *ExportCRLForm {
+	page := &ExportCRLForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Export CRL").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	saveToField := tview.NewInputField()
+	saveToField.SetLabel("Save to")
+	saveToField.SetText(exportcrl_saveTo.Last)
+	saveToField.SetFocusFunc(func() {
+		hintBox.SetText(exportcrl_saveTo.Hint)
+	})
+	saveToField.SetChangedFunc(func(text string) {
+		exportcrl_saveTo.Last = text
+	})
+	page.form.AddFormItem(saveToField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 11, 1)
+
+	return page
+}
+
+func (page *ExportCRLForm) GetID() string {
+	return "exportcrl_form"
+}
+
+func (page *ExportCRLForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(exportcrl_saveTo.Last)
+	})
+}
+
+func (page *ExportCRLForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
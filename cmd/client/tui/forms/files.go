@@ -0,0 +1,248 @@
+package forms
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	files_saveTo = FormVal[string]{
+		Last: wd,
+		Hint: "Where to save the file. Specify only directory to keep the name it has on the target, otherwise provide full path with filename.\n\nExample:\n/home/kali/loot\n/home/kali/loot/ntds.dit",
+	}
+
+	files_localPath = FormVal[string]{
+		Hint: "Local file to upload.\n\nExample:\n/home/kali/tools/chisel",
+	}
+
+	files_name = FormVal[string]{
+		Hint: "Name of the file in the directory being browsed. Leave empty to keep the local name.\n\nExample:\nupdate.exe",
+	}
+
+	files_resume = FormVal[bool]{
+		Hint: "Continue an interrupted transfer from where it stopped: what is already on the receiving end is kept and only the rest is sent. The whole file is checked against its SHA-256 afterwards either way.",
+	}
+
+	files_path = FormVal[string]{
+		Hint: "Directory to browse on the target, relative paths start from the agent's working directory.\n\nExample:\n/etc\nC:\\Users\\Public",
+	}
+)
+
+// DownloadFileForm asks where to save a file downloaded from an agent
+type DownloadFileForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewDownloadFileForm() *DownloadFileForm {
+	page := &DownloadFileForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Download file").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	saveToField := tview.NewInputField()
+	saveToField.SetLabel("Save to")
+	saveToField.SetText(files_saveTo.Last)
+	saveToField.SetFocusFunc(func() {
+		hintBox.SetText(files_saveTo.Hint)
+	})
+	saveToField.SetChangedFunc(func(text string) {
+		files_saveTo.Last = text
+	})
+	page.form.AddFormItem(saveToField)
+
+	page.form.AddFormItem(resumeCheckbox(hintBox))
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 10, 1)
+
+	return page
+}
+
+func (page *DownloadFileForm) GetID() string {
+	return "downloadfile_page"
+}
+
+func (page *DownloadFileForm) SetSubmitFunc(f func(path string, resume bool)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(files_saveTo.Last), files_resume.Last)
+	})
+}
+
+func (page *DownloadFileForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
+
+// UploadFileForm asks for a local file to upload to the directory being browsed
+type UploadFileForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewUploadFileForm() *UploadFileForm {
+	page := &UploadFileForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	files_name.Last = ""
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Upload file").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	localPathField := tview.NewInputField()
+	localPathField.SetLabel("Local file")
+	localPathField.SetText(files_localPath.Last)
+	localPathField.SetFocusFunc(func() {
+		hintBox.SetText(files_localPath.Hint)
+	})
+	localPathField.SetChangedFunc(func(text string) {
+		files_localPath.Last = text
+	})
+	page.form.AddFormItem(localPathField)
+
+	nameField := tview.NewInputField()
+	nameField.SetLabel("Name")
+	nameField.SetFocusFunc(func() {
+		hintBox.SetText(files_name.Hint)
+	})
+	nameField.SetChangedFunc(func(text string) {
+		files_name.Last = text
+	})
+	page.form.AddFormItem(nameField)
+
+	page.form.AddFormItem(resumeCheckbox(hintBox))
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 10, 1)
+
+	return page
+}
+
+func (page *UploadFileForm) GetID() string {
+	return "uploadfile_page"
+}
+
+// SetSubmitFunc gets the local file and the name it should have on the target
+func (page *UploadFileForm) SetSubmitFunc(f func(path string, name string, resume bool)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		path := strings.TrimSpace(files_localPath.Last)
+		name := strings.TrimSpace(files_name.Last)
+		if name == "" && path != "" {
+			name = filepath.Base(path)
+		}
+
+		f(path, name, files_resume.Last)
+	})
+}
+
+func (page *UploadFileForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
+
+// FilePathForm asks for a directory of the target to browse
+type FilePathForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewFilePathForm(path string) *FilePathForm {
+	page := &FilePathForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	files_path.Last = path
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Go to").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	pathField := tview.NewInputField()
+	pathField.SetLabel("Path")
+	pathField.SetText(files_path.Last)
+	pathField.SetFocusFunc(func() {
+		hintBox.SetText(files_path.Hint)
+	})
+	pathField.SetChangedFunc(func(text string) {
+		files_path.Last = text
+	})
+	page.form.AddFormItem(pathField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 10, 1)
+
+	return page
+}
+
+func (page *FilePathForm) GetID() string {
+	return "filepath_page"
+}
+
+func (page *FilePathForm) SetSubmitFunc(f func(path string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(files_path.Last))
+	})
+}
+
+func (page *FilePathForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
+
+func resumeCheckbox(hintBox *tview.TextView) *tview.Checkbox {
+	resumeField := tview.NewCheckbox()
+	resumeField.SetLabel("Resume")
+	resumeField.SetChecked(files_resume.Last)
+	resumeField.SetFocusFunc(func() {
+		hintBox.SetText(files_resume.Hint)
+	})
+	resumeField.SetChangedFunc(func(checked bool) {
+		files_resume.Last = checked
+	})
+
+	return resumeField
+}
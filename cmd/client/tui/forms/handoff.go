@@ -0,0 +1,106 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	handoff_operator = FormVal[string]{
+		Hint: "Operator taking the session over. The claim on the session moves to them once they accept the handoff from their session menu, until then you stay in charge and can withdraw it.",
+	}
+
+	handoff_note = FormVal[string]{
+		Hint: "What the next shift needs to know: what runs through the session, what to avoid, what's left to do. Kept as a note in the session attachments once the handoff is accepted.",
+	}
+
+	handoff_layout = FormVal[bool]{
+		Last: true,
+		Hint: "If checked, the recipient's client switches to your layout preset when they accept.",
+	}
+)
+
+// HandoffForm offers a session to another operator
+type HandoffForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewHandoffForm() *HandoffForm {
+	page := &HandoffForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Hand off session").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	// every handoff has its own note, don't carry the previous one over
+	handoff_note.Last = ""
+
+	operatorField := tview.NewInputField()
+	operatorField.SetLabel("Operator")
+	operatorField.SetText(handoff_operator.Last)
+	operatorField.SetFocusFunc(func() {
+		hintBox.SetText(handoff_operator.Hint)
+	})
+	operatorField.SetChangedFunc(func(text string) {
+		handoff_operator.Last = text
+	})
+	page.form.AddFormItem(operatorField)
+
+	noteField := tview.NewTextArea()
+	noteField.SetLabel("Note")
+	noteField.SetFocusFunc(func() {
+		hintBox.SetText(handoff_note.Hint)
+	})
+	noteField.SetChangedFunc(func() {
+		handoff_note.Last = noteField.GetText()
+	})
+	page.form.AddFormItem(noteField)
+
+	layoutField := tview.NewCheckbox()
+	layoutField.SetLabel("Share layout")
+	layoutField.SetChecked(handoff_layout.Last)
+	layoutField.SetFocusFunc(func() {
+		hintBox.SetText(handoff_layout.Hint)
+	})
+	layoutField.SetChangedFunc(func(checked bool) {
+		handoff_layout.Last = checked
+	})
+	page.form.AddFormItem(layoutField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 13, hintBox, 9, 2)
+
+	return page
+}
+
+func (page *HandoffForm) GetID() string {
+	return "handoff_page"
+}
+
+func (page *HandoffForm) SetSubmitFunc(f func(operator string, note string, shareLayout bool)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(handoff_operator.Last), handoff_note.Last, handoff_layout.Last)
+	})
+}
+
+func (page *HandoffForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,134 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	listener_name = FormVal[string]{
+		Hint: "Name sessions that connect on the listener are attributed to.\n\nExample:\nhttps-443\nstaging",
+	}
+
+	listener_transport = FormVal[string]{
+		Hint: "Transport agents connect over, as with -agent-transport: tls, quic, wss or dns, optionally followed by a transport profile after a +.\n\nExample:\ntls\nwss+office",
+	}
+
+	listener_address = FormVal[string]{
+		Hint: "Address and port to listen on.\n\nExample:\n0.0.0.0:443\n10.0.0.5:8443",
+	}
+
+	listener_certificate = FormVal[string]{
+		Hint: "Optional path to a PEM certificate to present instead of the server's. wss presents it on the outer TLS, e.g. a public certificate of the fronting domain. Other transports only take certificates issued by the server CA, agents reject any other.\n\nExample:\n/home/kali/fullchain.pem",
+	}
+
+	listener_key = FormVal[string]{
+		Hint: "Path to the PEM key of the certificate. It is stored on the server along with the listener.\n\nExample:\n/home/kali/privkey.pem",
+	}
+
+	listener_proxy_protocol = FormVal[bool]{
+		Hint: "Expect a PROXY protocol header, v1 or v2, ahead of every connection, so sessions get the address of the agent behind a redirector such as haproxy or nginx. Connections without one are refused. Only tls and wss take it, the redirector passes TLS through.",
+	}
+
+	listener_enabled = FormVal[bool]{
+		Hint: "Start listening right away. Disabled listeners are kept and can be enabled later.",
+		Last: true,
+	}
+)
+
+type ListenerForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewListenerForm() *ListenerForm {
+	page := &ListenerForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("New listener").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	fields := []struct {
+		label string
+		val   *FormVal[string]
+	}{
+		{"Name", &listener_name},
+		{"Transport", &listener_transport},
+		{"Address", &listener_address},
+		{"Certificate", &listener_certificate},
+		{"Key", &listener_key},
+	}
+
+	for _, field := range fields {
+		val := field.val
+
+		input := tview.NewInputField()
+		input.SetLabel(field.label)
+		input.SetText(val.Last)
+		input.SetFocusFunc(func() {
+			hintBox.SetText(val.Hint)
+		})
+		input.SetChangedFunc(func(text string) {
+			val.Last = text
+		})
+		page.form.AddFormItem(input)
+	}
+
+	proxyProtocolField := tview.NewCheckbox()
+	proxyProtocolField.SetLabel("PROXY protocol")
+	proxyProtocolField.SetChecked(listener_proxy_protocol.Last)
+	proxyProtocolField.SetFocusFunc(func() {
+		hintBox.SetText(listener_proxy_protocol.Hint)
+	})
+	proxyProtocolField.SetChangedFunc(func(checked bool) {
+		listener_proxy_protocol.Last = checked
+	})
+	page.form.AddFormItem(proxyProtocolField)
+
+	enabledField := tview.NewCheckbox()
+	enabledField.SetLabel("Enabled")
+	enabledField.SetChecked(listener_enabled.Last)
+	enabledField.SetFocusFunc(func() {
+		hintBox.SetText(listener_enabled.Hint)
+	})
+	enabledField.SetChangedFunc(func(checked bool) {
+		listener_enabled.Last = checked
+	})
+	page.form.AddFormItem(enabledField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 19, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *ListenerForm) GetID() string {
+	return "listener_page"
+}
+
+func (page *ListenerForm) SetSubmitFunc(f func(name string, transport string, address string, certificate string, key string, proxyProtocol bool, enabled bool)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(listener_name.Last), strings.TrimSpace(listener_transport.Last), strings.TrimSpace(listener_address.Last), strings.TrimSpace(listener_certificate.Last), strings.TrimSpace(listener_key.Last), listener_proxy_protocol.Last, listener_enabled.Last)
+	})
+}
+
+func (page *ListenerForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,74 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	lookupbuild_query = FormVal[string]{
+		Hint: "Build ID, SHA256, campaign tag or operator name. A path to a recovered agent looks it up by its watermark, or by its hashsum if the watermark was stripped.\n\nExample:\n/tmp/recovered.exe\nacme-internal-2024",
+	}
+)
+
+type LookupBuildForm struct {
+	tview.Flex
+	form      *tview.Form
+	submitBtn *tview.Button
+	cancelBtn *tview.Button
+}
+
+func NewLookupBuildForm() *LookupBuildForm {
+	page := &LookupBuildForm{
+		Flex:      *tview.NewFlex(),
+		form:      tview.NewForm(),
+		submitBtn: tview.NewButton("Submit"),
+		cancelBtn: tview.NewButton("Cancel"),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Look up agent build").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	queryField := tview.NewInputField()
+	queryField.SetLabel("Query")
+	queryField.SetText(lookupbuild_query.Last)
+	queryField.SetFocusFunc(func() {
+		hintBox.SetText(lookupbuild_query.Hint)
+	})
+	queryField.SetChangedFunc(func(text string) {
+		lookupbuild_query.Last = text
+	})
+	page.form.AddFormItem(queryField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 11, 1)
+
+	return page
+}
+
+func (page *LookupBuildForm) GetID() string {
+	return "lookupbuild_page"
+}
+
+func (page *LookupBuildForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(lookupbuild_query.Last)
+	})
+}
+
+func (page *LookupBuildForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,79 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	mirror_target = FormVal[string]{
+		Hint: "Where to copy session packets to, leave empty to stop mirroring. Pcap files and FIFOs are written on the server.\n\nExample:\npcap:/tmp/pivot.pcap\niface:dummy0",
+	}
+)
+
+type MirrorForm struct {
+	tview.Flex
+	form      *tview.Form
+	submitBtn *tview.Button
+	cancelBtn *tview.Button
+}
+
+func NewMirrorForm(current string) *MirrorForm {
+	mir := &MirrorForm{
+		Flex:      *tview.NewFlex(),
+		form:      tview.NewForm(),
+		submitBtn: tview.NewButton("Submit"),
+		cancelBtn: tview.NewButton("Cancel"),
+	}
+
+	mirror_target.Last = current
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	mir.form.SetTitle("Mirror traffic").SetTitleAlign(tview.AlignCenter)
+	mir.form.SetBorder(true)
+	mir.form.SetButtonsAlign(tview.AlignCenter)
+
+	targetField := tview.NewInputField()
+	targetField.SetLabel("Target")
+	targetField.SetText(mirror_target.Last)
+	targetField.SetFocusFunc(func() {
+		hintBox.SetText(mirror_target.Hint)
+	})
+	targetField.SetChangedFunc(func(text string) {
+		mirror_target.Last = text
+	})
+	targetField.SetBlurFunc(func() {
+		hintBox.Clear()
+	})
+	mir.form.AddFormItem(targetField)
+
+	mir.form.AddButton("Submit", nil)
+	mir.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&mir.Flex, mir.form, 7, hintBox, 9, 1)
+
+	return mir
+}
+
+func (page *MirrorForm) GetID() string {
+	return "mirror_page"
+}
+
+func (page *MirrorForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(mirror_target.Last)
+	})
+}
+
+func (page *MirrorForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -2,6 +2,8 @@ package forms
 
 import (
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
 )
 
 var (
@@ -13,8 +15,9 @@ var (
 		Hint: "Address of this server with a port.\n\nExample:\n1.2.3.4:58008",
 	}
 
-	operator_isAdmin = FormVal[bool]{
-		Hint: "If checked, operator will have access to administrative functions",
+	operator_role = FormVal[FormSelectVal]{
+		Hint: "What the operator may do.\n\nadmin: everything, including operators, certificates and listeners\noperator: sessions, routes and builds\nread-only: watch sessions, routes and builds, without changing anything",
+		Last: FormSelectVal{ID: 1, Value: operator.RoleOperator},
 	}
 )
 
@@ -66,33 +69,27 @@ func NewOperatorForm() *OperatorForm {
 	})
 	form.form.AddFormItem(serverField)
 
-	isAdminField := tview.NewCheckbox()
-	isAdminField.SetLabel("Admin")
-	isAdminField.SetChecked(operator_isAdmin.Last)
-	isAdminField.SetFocusFunc(func() {
-		hintBox.SetText(operator_isAdmin.Hint)
+	roleField := tview.NewDropDown()
+	roleField.SetLabel("Role")
+	roleField.SetFocusFunc(func() {
+		hintBox.SetText(operator_role.Hint)
 	})
-	isAdminField.SetChangedFunc(func(checked bool) {
-		operator_isAdmin.Last = checked
+	roleField.SetOptions(operator.Roles, func(option string, index int) {
+		operator_role.Last.ID = index
+		operator_role.Last.Value = option
 	})
-	isAdminField.SetBlurFunc(func() {
+	roleField.SetCurrentOption(operator_role.Last.ID)
+	roleField.SetBlurFunc(func() {
 		hintBox.Clear()
 	})
-	form.form.AddFormItem(isAdminField)
+	form.form.AddFormItem(roleField)
+
+	form.form.AddFormItem(newIssuingCAField(hintBox))
 
 	form.form.AddButton("Submit", nil)
 	form.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(form.form, 11, 1, true).
-		AddItem(hintBox, 8, 1, false)
-
-	form.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false), 0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&form.Flex, form.form, 13, hintBox, 8, 1)
 
 	return form
 }
@@ -101,11 +98,11 @@ func (page *OperatorForm) GetID() string {
 	return "operator_form"
 }
 
-func (page *OperatorForm) SetSubmitFunc(f func(string, bool, string)) {
+func (page *OperatorForm) SetSubmitFunc(f func(string, string, string, string)) {
 	btnId := page.form.GetButtonIndex("Submit")
 	submitBtn := page.form.GetButton(btnId)
 	submitBtn.SetSelectedFunc(func() {
-		f(operator_name.Last, operator_isAdmin.Last, operator_server.Last)
+		f(operator_name.Last, operator_role.Last.Value, operator_server.Last, issuing_ca.Last)
 	})
 }
 
@@ -0,0 +1,77 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	profile_name = FormVal[string]{
+		Hint: "Route profile name. Saving under an existing name replaces that profile.\n\nExample:\ncorp-lan",
+	}
+)
+
+type SaveProfileForm struct {
+	tview.Flex
+	form      *tview.Form
+	submitBtn *tview.Button
+	cancelBtn *tview.Button
+}
+
+func NewSaveProfileForm() *SaveProfileForm {
+	prof := &SaveProfileForm{
+		Flex:      *tview.NewFlex(),
+		form:      tview.NewForm(),
+		submitBtn: tview.NewButton("Submit"),
+		cancelBtn: tview.NewButton("Cancel"),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	prof.form.SetTitle("Save route profile").SetTitleAlign(tview.AlignCenter)
+	prof.form.SetBorder(true)
+	prof.form.SetButtonsAlign(tview.AlignCenter)
+
+	nameField := tview.NewInputField()
+	nameField.SetLabel("Name")
+	nameField.SetText(profile_name.Last)
+	nameField.SetFocusFunc(func() {
+		hintBox.SetText(profile_name.Hint)
+	})
+	nameField.SetChangedFunc(func(text string) {
+		profile_name.Last = text
+	})
+	nameField.SetBlurFunc(func() {
+		hintBox.Clear()
+	})
+	prof.form.AddFormItem(nameField)
+
+	prof.form.AddButton("Submit", nil)
+	prof.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&prof.Flex, prof.form, 7, hintBox, 8, 1)
+
+	return prof
+}
+
+func (page *SaveProfileForm) GetID() string {
+	return "save_profile_page"
+}
+
+func (page *SaveProfileForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(profile_name.Last)
+	})
+}
+
+func (page *SaveProfileForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
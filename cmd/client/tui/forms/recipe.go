@@ -0,0 +1,103 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	recipe_path = FormVal[string]{
+		Hint: "Path to a YAML (or JSON) recipe describing the agents to build.\n\nExample:\nname: acme\nservers: 203.0.113.7:443\nobfuscate: true\nformat: exe\noutput: \"{{.Name}}-{{.GOOS}}-{{.GOARCH}}{{.Ext}}\"\ntargets:\n  - windows/amd64\n  - linux/amd64\n  - linux/armv7",
+	}
+
+	recipe_saveTo = FormVal[string]{
+		Last: wd,
+		Hint: "Path to save the archive with all the agents. Specify only directory for <recipe name>.zip, otherwise provide full path with filename.\n\nExample:\n/home/kali\n/home/kali/acme.zip",
+	}
+
+	recipe_key = FormVal[string]{
+		Hint: "Only needed when the recipe sets key_source: passphrase, it is never kept on the server.",
+	}
+)
+
+// RecipeForm builds every agent of a recipe in one go
+type RecipeForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewRecipeForm() *RecipeForm {
+	page := &RecipeForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Build from recipe").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	pathField := tview.NewInputField()
+	pathField.SetLabel("Recipe")
+	pathField.SetText(recipe_path.Last)
+	pathField.SetFocusFunc(func() {
+		hintBox.SetText(recipe_path.Hint)
+	})
+	pathField.SetChangedFunc(func(text string) {
+		recipe_path.Last = text
+	})
+	page.form.AddFormItem(pathField)
+
+	saveToField := tview.NewInputField()
+	saveToField.SetLabel("Save to")
+	saveToField.SetText(recipe_saveTo.Last)
+	saveToField.SetFocusFunc(func() {
+		hintBox.SetText(recipe_saveTo.Hint)
+	})
+	saveToField.SetChangedFunc(func(text string) {
+		recipe_saveTo.Last = text
+	})
+	page.form.AddFormItem(saveToField)
+
+	recipe_key.Last = ""
+	keyField := tview.NewInputField()
+	keyField.SetLabel("Passphrase")
+	keyField.SetMaskCharacter('*')
+	keyField.SetFocusFunc(func() {
+		hintBox.SetText(recipe_key.Hint)
+	})
+	keyField.SetChangedFunc(func(text string) {
+		recipe_key.Last = text
+	})
+	page.form.AddFormItem(keyField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 17, 1)
+
+	return page
+}
+
+func (page *RecipeForm) GetID() string {
+	return "recipe_page"
+}
+
+func (page *RecipeForm) SetSubmitFunc(f func(recipePath string, path string, key string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(recipe_path.Last, recipe_saveTo.Last, recipe_key.Last)
+	})
+}
+
+func (page *RecipeForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -1,9 +1,12 @@
 package forms
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
 )
 
 var (
@@ -18,6 +21,10 @@ var (
 	redirector_protocol = FormVal[FormSelectVal]{
 		Hint: "Network protocol to use",
 	}
+
+	redirector_via = FormVal[FormSelectVal]{
+		Hint: "TCP only. Session to reach the destination through. Connections are sent back to the server, which reaches the destination through that session, so a listener on one pivot can target hosts only another pivot sees.",
+	}
 )
 
 type AddRedirectorForm struct {
@@ -27,7 +34,8 @@ type AddRedirectorForm struct {
 	cancelBtn *tview.Button
 }
 
-func NewAddRedirectorForm() *AddRedirectorForm {
+// NewAddRedirectorForm offers the sessions the destination can be reached through, besides the agent itself
+func NewAddRedirectorForm(sessions []*session.Session) *AddRedirectorForm {
 	page := &AddRedirectorForm{
 		Flex:      *tview.NewFlex(),
 		form:      tview.NewForm(),
@@ -76,26 +84,36 @@ func NewAddRedirectorForm() *AddRedirectorForm {
 		redirector_protocol.Last.ID = index
 		redirector_protocol.Last.Value = strings.ToLower(option)
 	})
-	protocolField.SetBlurFunc(func() {
-		hintBox.Clear()
-	})
 	protocolField.SetCurrentOption(redirector_protocol.Last.ID)
 	page.form.AddFormItem(protocolField)
 
+	viaOptions := []string{"Agent"}
+	viaIDs := []string{""}
+	for _, sess := range sessions {
+		viaOptions = append(viaOptions, sess.GetName())
+		viaIDs = append(viaIDs, sess.ID)
+	}
+
+	viaField := tview.NewDropDown()
+	viaField.SetLabel("Via")
+	viaField.SetFocusFunc(func() {
+		hintBox.SetText(redirector_via.Hint)
+	})
+	viaField.SetOptions(viaOptions, func(option string, index int) {
+		redirector_via.Last.ID = index
+		redirector_via.Last.Value = viaIDs[index]
+	})
+	viaField.SetBlurFunc(func() {
+		hintBox.Clear()
+	})
+	// sessions come and go, the previous pick is looked up by ID
+	viaField.SetCurrentOption(max(slices.Index(viaIDs, redirector_via.Last.Value), 0))
+	page.form.AddFormItem(viaField)
+
 	page.form.AddButton("Submit", nil)
 	page.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(page.form, 11, 1, true).
-		AddItem(hintBox, 8, 1, false)
-
-	page.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&page.Flex, page.form, 13, hintBox, 8, 1)
 
 	return page
 }
@@ -104,11 +122,11 @@ func (page *AddRedirectorForm) GetID() string {
 	return "addroute_page"
 }
 
-func (page *AddRedirectorForm) SetSubmitFunc(f func(string, string, string)) {
+func (page *AddRedirectorForm) SetSubmitFunc(f func(string, string, string, string)) {
 	btnId := page.form.GetButtonIndex("Submit")
 	submitBtn := page.form.GetButton(btnId)
 	submitBtn.SetSelectedFunc(func() {
-		f(redirector_from.Last, redirector_to.Last, redirector_protocol.Last.Value)
+		f(redirector_from.Last, redirector_to.Last, redirector_protocol.Last.Value, redirector_via.Last.Value)
 	})
 }
 
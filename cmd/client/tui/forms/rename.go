@@ -2,6 +2,7 @@ package forms
 
 import (
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 )
 
 var (
@@ -52,17 +53,7 @@ func NewRenameForm() *RenameForm {
 	ren.form.AddButton("Submit", nil)
 	ren.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(ren.form, 7, 1, true).
-		AddItem(hintBox, 5, 1, false)
-
-	ren.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&ren.Flex, ren.form, 7, hintBox, 5, 1)
 
 	return ren
 }
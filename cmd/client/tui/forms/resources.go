@@ -0,0 +1,147 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/winres"
+)
+
+var (
+	resources_icon = FormVal[string]{
+		Hint: "Path to an .ico file, shown by Explorer as the agent icon. Leave empty to keep the default.\n\nExample:\n/home/kali/onedrive.ico",
+	}
+
+	resources_manifest = FormVal[string]{
+		Hint: "Path to an application manifest XML, e.g. to request the asInvoker execution level or declare supported OS versions.\n\nExample:\n/home/kali/app.manifest",
+	}
+
+	resources_company = FormVal[string]{
+		Hint: "CompanyName shown in the file properties.\n\nExample:\nMicrosoft Corporation",
+	}
+
+	resources_product = FormVal[string]{
+		Hint: "ProductName shown in the file properties.\n\nExample:\nMicrosoft OneDrive",
+	}
+
+	resources_description = FormVal[string]{
+		Hint: "FileDescription, this is what Task Manager displays for the process.\n\nExample:\nMicrosoft OneDrive Update",
+	}
+
+	resources_fileVersion = FormVal[string]{
+		Hint: "Up to four dot separated numbers.\n\nExample:\n24.101.519.1",
+	}
+
+	resources_productVersion = FormVal[string]{
+		Hint: "Up to four dot separated numbers.\n\nExample:\n24.101.519.1",
+	}
+
+	resources_originalFilename = FormVal[string]{
+		Hint: "OriginalFilename shown in the file properties.\n\nExample:\nOneDriveUpdater.exe",
+	}
+
+	resources_copyright = FormVal[string]{
+		Hint: "LegalCopyright shown in the file properties.\n\nExample:\n© Microsoft Corporation. All rights reserved.",
+	}
+)
+
+// WindowsResources is what the resources form collects, files are read when the agent gets generated
+type WindowsResources struct {
+	IconPath     string
+	ManifestPath string
+	Version      winres.VersionInfo
+}
+
+func (res WindowsResources) Empty() bool {
+	return res.IconPath == "" && res.ManifestPath == "" && res.Version.Empty()
+}
+
+func currentWindowsResources() WindowsResources {
+	return WindowsResources{
+		IconPath:     resources_icon.Last,
+		ManifestPath: resources_manifest.Last,
+		Version: winres.VersionInfo{
+			CompanyName:      resources_company.Last,
+			ProductName:      resources_product.Last,
+			FileDescription:  resources_description.Last,
+			FileVersion:      resources_fileVersion.Last,
+			ProductVersion:   resources_productVersion.Last,
+			OriginalFilename: resources_originalFilename.Last,
+			LegalCopyright:   resources_copyright.Last,
+		},
+	}
+}
+
+type ResourcesForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewResourcesForm() *ResourcesForm {
+	page := &ResourcesForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Windows resources").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	fields := []struct {
+		label string
+		val   *FormVal[string]
+	}{
+		{"Icon", &resources_icon},
+		{"Manifest", &resources_manifest},
+		{"Company", &resources_company},
+		{"Product", &resources_product},
+		{"Description", &resources_description},
+		{"File version", &resources_fileVersion},
+		{"Product version", &resources_productVersion},
+		{"Original filename", &resources_originalFilename},
+		{"Copyright", &resources_copyright},
+	}
+
+	var inputs []*tview.InputField
+	for _, field := range fields {
+		val := field.val
+
+		input := tview.NewInputField()
+		input.SetLabel(field.label)
+		input.SetText(val.Last)
+		input.SetFocusFunc(func() {
+			hintBox.SetText(val.Hint)
+		})
+		input.SetChangedFunc(func(text string) {
+			val.Last = text
+		})
+		page.form.AddFormItem(input)
+		inputs = append(inputs, input)
+	}
+
+	page.form.AddButton("Done", nil)
+	page.form.AddButton("Clear", func() {
+		for _, input := range inputs {
+			input.SetText("") // resets the value through the changed func
+		}
+	})
+
+	utils.LayoutForm(&page.Flex, page.form, 23, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *ResourcesForm) GetID() string {
+	return "resources_page"
+}
+
+func (page *ResourcesForm) SetDoneFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Done")
+	doneBtn := page.form.GetButton(btnId)
+	doneBtn.SetSelectedFunc(f)
+}
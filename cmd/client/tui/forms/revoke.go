@@ -0,0 +1,86 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	revoke_certificate = FormVal[string]{
+		Hint: "Path to the PEM certificate to revoke, it has to be issued by the server's CA. Agents connected with it are dropped and can't connect again, operators using it are refused.\n\nExample:\n/home/kali/agent.crt",
+	}
+
+	revoke_reason = FormVal[string]{
+		Hint: "Why the certificate is revoked, it shows in the list of revoked certificates. Defaults to who revoked it.\n\nExample:\nagent binary recovered by the blue team",
+	}
+)
+
+// RevokeForm asks for a certificate file to revoke and why
+type RevokeForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewRevokeForm() *RevokeForm {
+	page := &RevokeForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Revoke certificate").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	certificateField := tview.NewInputField()
+	certificateField.SetLabel("Certificate")
+	certificateField.SetText(revoke_certificate.Last)
+	certificateField.SetFocusFunc(func() {
+		hintBox.SetText(revoke_certificate.Hint)
+	})
+	certificateField.SetChangedFunc(func(text string) {
+		revoke_certificate.Last = text
+	})
+	page.form.AddFormItem(certificateField)
+
+	reasonField := tview.NewInputField()
+	reasonField.SetLabel("Reason")
+	reasonField.SetText(revoke_reason.Last)
+	reasonField.SetFocusFunc(func() {
+		hintBox.SetText(revoke_reason.Hint)
+	})
+	reasonField.SetChangedFunc(func(text string) {
+		revoke_reason.Last = text
+	})
+	page.form.AddFormItem(reasonField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 11, 2)
+
+	return page
+}
+
+func (page *RevokeForm) GetID() string {
+	return "revoke_form"
+}
+
+func (page *RevokeForm) SetSubmitFunc(f func(path string, reason string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(revoke_certificate.Last, revoke_reason.Last)
+	})
+}
+
+func (page *RevokeForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/rivo/tview"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 )
 
 type AddRouteForm struct {
@@ -27,6 +28,14 @@ var (
 	add_route_loopback = forms.FormVal[bool]{
 		Hint: "If checked, specified CIDR will address the machine running the agent itself, i.e. localhost. Use this instead of port forwarding.",
 	}
+
+	add_route_ports = forms.FormVal[string]{
+		Hint: "Comma-separated ports and ranges allowed through this route, connections to other ports are reset. Leave empty to allow all.\n\nExample:\n22,80,443,8000-8100",
+	}
+
+	add_route_exclusion = forms.FormVal[bool]{
+		Hint: "If checked, specified CIDR is kept out of this session even if a broader route covers it, e.g. route 10.0.0.0/8 but exclude 10.13.0.0/16. Can't be combined with loopback or ports.",
+	}
 )
 
 func NewAddRouteForm() *AddRouteForm {
@@ -73,6 +82,17 @@ func NewAddRouteForm() *AddRouteForm {
 	})
 	form.form.AddFormItem(metricField)
 
+	portsField := tview.NewInputField()
+	portsField.SetLabel("Ports")
+	portsField.SetText(add_route_ports.Last)
+	portsField.SetFocusFunc(func() {
+		hintBox.SetText(add_route_ports.Hint)
+	})
+	portsField.SetChangedFunc(func(text string) {
+		add_route_ports.Last = text
+	})
+	form.form.AddFormItem(portsField)
+
 	loopbackField := tview.NewCheckbox()
 	loopbackField.SetLabel("Loopback")
 	loopbackField.SetChecked(add_route_loopback.Last)
@@ -82,25 +102,26 @@ func NewAddRouteForm() *AddRouteForm {
 	loopbackField.SetChangedFunc(func(checked bool) {
 		add_route_loopback.Last = checked
 	})
-	loopbackField.SetBlurFunc(func() {
+	form.form.AddFormItem(loopbackField)
+
+	exclusionField := tview.NewCheckbox()
+	exclusionField.SetLabel("Exclude")
+	exclusionField.SetChecked(add_route_exclusion.Last)
+	exclusionField.SetFocusFunc(func() {
+		hintBox.SetText(add_route_exclusion.Hint)
+	})
+	exclusionField.SetChangedFunc(func(checked bool) {
+		add_route_exclusion.Last = checked
+	})
+	exclusionField.SetBlurFunc(func() {
 		hintBox.Clear()
 	})
-	form.form.AddFormItem(loopbackField)
+	form.form.AddFormItem(exclusionField)
 
 	form.form.AddButton("Submit", nil)
 	form.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(form.form, 11, 1, true).
-		AddItem(hintBox, 8, 1, false)
-
-	form.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&form.Flex, form.form, 15, hintBox, 9, 1)
 
 	return form
 }
@@ -109,11 +130,11 @@ func (form *AddRouteForm) GetID() string {
 	return "addroute_form"
 }
 
-func (form *AddRouteForm) SetSubmitFunc(f func(string, int, bool)) {
+func (form *AddRouteForm) SetSubmitFunc(f func(string, int, bool, string, bool)) {
 	btnId := form.form.GetButtonIndex("Submit")
 	submitBtn := form.form.GetButton(btnId)
 	submitBtn.SetSelectedFunc(func() {
-		f(add_route_cidr.Last, add_route_metric.Last, add_route_loopback.Last)
+		f(add_route_cidr.Last, add_route_metric.Last, add_route_loopback.Last, add_route_ports.Last, add_route_exclusion.Last)
 	})
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/rivo/tview"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/internal/route"
 )
 
@@ -28,12 +29,22 @@ var (
 	edit_route_loopback = forms.FormVal[bool]{
 		Hint: "If checked, specified CIDR will address the machine running the agent itself, i.e. localhost. Use this instead of port forwarding.",
 	}
+
+	edit_route_ports = forms.FormVal[string]{
+		Hint: "Comma-separated ports and ranges allowed through this route, connections to other ports are reset. Leave empty to allow all.\n\nExample:\n22,80,443,8000-8100",
+	}
+
+	edit_route_exclusion = forms.FormVal[bool]{
+		Hint: "If checked, specified CIDR is kept out of this session even if a broader route covers it, e.g. route 10.0.0.0/8 but exclude 10.13.0.0/16. Can't be combined with loopback or ports.",
+	}
 )
 
 func NewEditRouteForm(route *route.Route) *EditRouteForm {
 	edit_route_cidr.Last = route.Cidr.String()
 	edit_route_metric.Last = route.Metric
 	edit_route_loopback.Last = route.IsLoopback
+	edit_route_ports.Last = route.Ports
+	edit_route_exclusion.Last = route.IsExclusion
 
 	form := &EditRouteForm{
 		Flex:      *tview.NewFlex(),
@@ -78,6 +89,17 @@ func NewEditRouteForm(route *route.Route) *EditRouteForm {
 	})
 	form.form.AddFormItem(metricField)
 
+	portsField := tview.NewInputField()
+	portsField.SetLabel("Ports")
+	portsField.SetText(edit_route_ports.Last)
+	portsField.SetFocusFunc(func() {
+		hintBox.SetText(edit_route_ports.Hint)
+	})
+	portsField.SetChangedFunc(func(text string) {
+		edit_route_ports.Last = text
+	})
+	form.form.AddFormItem(portsField)
+
 	loopbackField := tview.NewCheckbox()
 	loopbackField.SetLabel("Loopback")
 	loopbackField.SetChecked(edit_route_loopback.Last)
@@ -87,25 +109,26 @@ func NewEditRouteForm(route *route.Route) *EditRouteForm {
 	loopbackField.SetChangedFunc(func(checked bool) {
 		edit_route_loopback.Last = checked
 	})
-	loopbackField.SetBlurFunc(func() {
+	form.form.AddFormItem(loopbackField)
+
+	exclusionField := tview.NewCheckbox()
+	exclusionField.SetLabel("Exclude")
+	exclusionField.SetChecked(edit_route_exclusion.Last)
+	exclusionField.SetFocusFunc(func() {
+		hintBox.SetText(edit_route_exclusion.Hint)
+	})
+	exclusionField.SetChangedFunc(func(checked bool) {
+		edit_route_exclusion.Last = checked
+	})
+	exclusionField.SetBlurFunc(func() {
 		hintBox.Clear()
 	})
-	form.form.AddFormItem(loopbackField)
+	form.form.AddFormItem(exclusionField)
 
 	form.form.AddButton("Submit", nil)
 	form.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(form.form, 11, 1, true).
-		AddItem(hintBox, 8, 1, false)
-
-	form.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&form.Flex, form.form, 15, hintBox, 9, 1)
 
 	return form
 }
@@ -114,11 +137,11 @@ func (form *EditRouteForm) GetID() string {
 	return "editroute_form"
 }
 
-func (form *EditRouteForm) SetSubmitFunc(f func(string, int, bool)) {
+func (form *EditRouteForm) SetSubmitFunc(f func(string, int, bool, string, bool)) {
 	btnId := form.form.GetButtonIndex("Submit")
 	submitBtn := form.form.GetButton(btnId)
 	submitBtn.SetSelectedFunc(func() {
-		f(edit_route_cidr.Last, int(edit_route_metric.Last), edit_route_loopback.Last)
+		f(edit_route_cidr.Last, int(edit_route_metric.Last), edit_route_loopback.Last, edit_route_ports.Last, edit_route_exclusion.Last)
 	})
 }
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/rivo/tview"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
 )
 
@@ -60,17 +61,7 @@ func NewMoveRouteForm(sessions []*session.Session) *MoveRouteForm {
 	form.form.AddButton("Submit", nil)
 	form.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(form.form, 9, 1, true).
-		AddItem(hintBox, 8, 1, false)
-
-	form.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 2, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&form.Flex, form.form, 9, hintBox, 8, 2)
 
 	return form
 }
@@ -0,0 +1,92 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	savebuild_saveTo = FormVal[string]{
+		Last: wd,
+		Hint: "Path to save the agent. Specify only directory for default filename, otherwise provide full path with filename.\n\nExample:\n/home/kali\n/home/kali/agent.exe",
+	}
+
+	savebuild_key = FormVal[string]{
+		Hint: "The build's config is encrypted with a passphrase, which is never kept on the server. Give the same one to build an agent that works with the same -key.",
+	}
+)
+
+// SaveBuildForm asks where to save a stored build, and the passphrase when it's rebuilt from one keyed to it
+type SaveBuildForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewSaveBuildForm(title string, needsKey bool) *SaveBuildForm {
+	page := &SaveBuildForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle(title).SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	saveToField := tview.NewInputField()
+	saveToField.SetLabel("Save to")
+	saveToField.SetText(savebuild_saveTo.Last)
+	saveToField.SetFocusFunc(func() {
+		hintBox.SetText(savebuild_saveTo.Hint)
+	})
+	saveToField.SetChangedFunc(func(text string) {
+		savebuild_saveTo.Last = text
+	})
+	page.form.AddFormItem(saveToField)
+
+	height := 7
+	savebuild_key.Last = ""
+	if needsKey {
+		keyField := tview.NewInputField()
+		keyField.SetLabel("Passphrase")
+		keyField.SetMaskCharacter('*')
+		keyField.SetFocusFunc(func() {
+			hintBox.SetText(savebuild_key.Hint)
+		})
+		keyField.SetChangedFunc(func(text string) {
+			savebuild_key.Last = text
+		})
+		page.form.AddFormItem(keyField)
+		height += 2
+	}
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, height, hintBox, 11, 1)
+
+	return page
+}
+
+func (page *SaveBuildForm) GetID() string {
+	return "savebuild_page"
+}
+
+func (page *SaveBuildForm) SetSubmitFunc(f func(path string, key string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(savebuild_saveTo.Last, savebuild_key.Last)
+	})
+}
+
+func (page *SaveBuildForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
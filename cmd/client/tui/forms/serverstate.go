@@ -0,0 +1,106 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	serverstate_exportTo = FormVal[string]{
+		Last: wd,
+		Hint: "Path to save the server state archive to. Specify only directory for default filename, otherwise provide full path with filename.\n\nIt holds the CA and every key of the server, keep it as safe as the server itself.",
+	}
+
+	serverstate_importFrom = FormVal[string]{
+		Hint: "Path of a server state archive exported from a server, e.g. the one this server replaces.\n\nExample:\n/home/kali/ligolo-mp.state",
+	}
+
+	serverstate_passphrase = FormVal[string]{
+		Hint: "Passphrase the archive is encrypted with. Pick a long one on export, the archive opens with nothing else.",
+	}
+)
+
+// ServerStateForm asks where to export the server state to, or where to import it from, and the passphrase of the archive
+type ServerStateForm struct {
+	tview.Flex
+	form   *tview.Form
+	export bool
+}
+
+func NewServerStateForm(export bool) *ServerStateForm {
+	page := &ServerStateForm{
+		Flex:   *tview.NewFlex(),
+		form:   tview.NewForm(),
+		export: export,
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	path := &serverstate_importFrom
+	title, label := "Import server state", "Import from"
+	if export {
+		path = &serverstate_exportTo
+		title, label = "Export server state", "Save to"
+	}
+
+	page.form.SetTitle(title).SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	pathField := tview.NewInputField()
+	pathField.SetLabel(label)
+	pathField.SetText(path.Last)
+	pathField.SetFocusFunc(func() {
+		hintBox.SetText(path.Hint)
+	})
+	pathField.SetChangedFunc(func(text string) {
+		path.Last = text
+	})
+	page.form.AddFormItem(pathField)
+
+	// unlike the path the passphrase isn't remembered
+	serverstate_passphrase.Last = ""
+	passphraseField := tview.NewInputField()
+	passphraseField.SetLabel("Passphrase")
+	passphraseField.SetMaskCharacter('*')
+	passphraseField.SetFocusFunc(func() {
+		hintBox.SetText(serverstate_passphrase.Hint)
+	})
+	passphraseField.SetChangedFunc(func(text string) {
+		serverstate_passphrase.Last = text
+	})
+	page.form.AddFormItem(passphraseField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 11, 2)
+
+	return page
+}
+
+func (page *ServerStateForm) GetID() string {
+	return "serverstate_form"
+}
+
+func (page *ServerStateForm) SetSubmitFunc(f func(path string, passphrase string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		if page.export {
+			f(serverstate_exportTo.Last, serverstate_passphrase.Last)
+		} else {
+			f(serverstate_importFrom.Last, serverstate_passphrase.Last)
+		}
+	})
+}
+
+func (page *ServerStateForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
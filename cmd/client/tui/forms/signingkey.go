@@ -0,0 +1,119 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+var (
+	signingKey_name = FormVal[string]{
+		Hint: "Name to pick the key by when generating agents. Letters, digits, '-' and '_' only. Uploading an existing name replaces it.\n\nExample:\nacme-codesign",
+	}
+
+	signingKey_type = FormVal[FormSelectVal]{
+		Hint: "Authenticode: PFX/PKCS#12 bundle, signs Windows executables and DLLs (needs osslsigncode on the server).\nGPG: armored secret key, produces detached signatures for ELF agents (needs gpg on the server).",
+	}
+
+	signingKey_file = FormVal[string]{
+		Hint: "Path to the PFX or the exported GPG secret key.\n\nExample:\n/home/kali/codesign.pfx\n/home/kali/release.asc",
+	}
+
+	signingKey_password = FormVal[string]{
+		Hint: "PFX password or GPG passphrase, leave empty if the key isn't protected. It is stored on the server along with the key.",
+	}
+)
+
+type SigningKeyForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewSigningKeyForm() *SigningKeyForm {
+	page := &SigningKeyForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Upload signing key").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	nameField := tview.NewInputField()
+	nameField.SetLabel("Name")
+	nameField.SetText(signingKey_name.Last)
+	nameField.SetFocusFunc(func() {
+		hintBox.SetText(signingKey_name.Hint)
+	})
+	nameField.SetChangedFunc(func(text string) {
+		signingKey_name.Last = text
+	})
+	page.form.AddFormItem(nameField)
+
+	typeField := tview.NewDropDown()
+	typeField.SetLabel("Type")
+	typeField.SetFocusFunc(func() {
+		hintBox.SetText(signingKey_type.Hint)
+	})
+	typeField.SetOptions([]string{agentbuild.SigningAuthenticode, agentbuild.SigningGPG}, func(option string, index int) {
+		signingKey_type.Last.ID = index
+		signingKey_type.Last.Value = option
+	})
+	typeField.SetCurrentOption(signingKey_type.Last.ID)
+	page.form.AddFormItem(typeField)
+
+	fileField := tview.NewInputField()
+	fileField.SetLabel("File")
+	fileField.SetText(signingKey_file.Last)
+	fileField.SetFocusFunc(func() {
+		hintBox.SetText(signingKey_file.Hint)
+	})
+	fileField.SetChangedFunc(func(text string) {
+		signingKey_file.Last = text
+	})
+	page.form.AddFormItem(fileField)
+
+	// unlike the other fields the password isn't remembered between uploads
+	signingKey_password.Last = ""
+	passwordField := tview.NewInputField()
+	passwordField.SetLabel("Password")
+	passwordField.SetMaskCharacter('*')
+	passwordField.SetFocusFunc(func() {
+		hintBox.SetText(signingKey_password.Hint)
+	})
+	passwordField.SetChangedFunc(func(text string) {
+		signingKey_password.Last = text
+	})
+	page.form.AddFormItem(passwordField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 13, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *SigningKeyForm) GetID() string {
+	return "signingkey_page"
+}
+
+func (page *SigningKeyForm) SetSubmitFunc(f func(string, string, string, string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(signingKey_name.Last, signingKey_type.Last.Value, signingKey_file.Last, signingKey_password.Last)
+	})
+}
+
+func (page *SigningKeyForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,111 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	socks_address = FormVal[string]{
+		Hint: "Address the agent listens on. Bind to localhost for tooling on the target itself, or to an interface for its neighbors. Port 0 picks a free one.\n\nExample:\n127.0.0.1:1080\n10.0.0.5:1080",
+	}
+
+	socks_username = FormVal[string]{
+		Hint: "Clients have to authenticate with this username. Leave empty to accept anyone who can reach the listener.\n\nExample:\nops",
+	}
+
+	socks_password = FormVal[string]{
+		Hint: "Password that goes with the username.",
+	}
+)
+
+type SocksForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewSocksForm(address string, username string) *SocksForm {
+	page := &SocksForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	if address != "" {
+		socks_address.Last = address
+		socks_username.Last = username
+	}
+	if socks_address.Last == "" {
+		socks_address.Last = "127.0.0.1:1080"
+	}
+	socks_password.Last = ""
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("SOCKS5 service").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	addressField := tview.NewInputField()
+	addressField.SetLabel("Address")
+	addressField.SetText(socks_address.Last)
+	addressField.SetFocusFunc(func() {
+		hintBox.SetText(socks_address.Hint)
+	})
+	addressField.SetChangedFunc(func(text string) {
+		socks_address.Last = text
+	})
+	page.form.AddFormItem(addressField)
+
+	usernameField := tview.NewInputField()
+	usernameField.SetLabel("Username")
+	usernameField.SetText(socks_username.Last)
+	usernameField.SetFocusFunc(func() {
+		hintBox.SetText(socks_username.Hint)
+	})
+	usernameField.SetChangedFunc(func(text string) {
+		socks_username.Last = text
+	})
+	page.form.AddFormItem(usernameField)
+
+	passwordField := tview.NewInputField()
+	passwordField.SetLabel("Password")
+	passwordField.SetMaskCharacter('*')
+	passwordField.SetFocusFunc(func() {
+		hintBox.SetText(socks_password.Hint)
+	})
+	passwordField.SetChangedFunc(func(text string) {
+		socks_password.Last = text
+	})
+	page.form.AddFormItem(passwordField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 10, 1)
+
+	return page
+}
+
+func (page *SocksForm) GetID() string {
+	return "socks_page"
+}
+
+func (page *SocksForm) SetSubmitFunc(f func(string, string, string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(socks_address.Last), strings.TrimSpace(socks_username.Last), socks_password.Last)
+	})
+}
+
+func (page *SocksForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
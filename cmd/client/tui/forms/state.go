@@ -0,0 +1,71 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	state_saveTo = FormVal[string]{
+		Last: wd,
+		Hint: "Path to save the state export (hosts, routes, redirectors). Specify only directory for a timestamped filename. Compare two exports with ligolo-mp-diff.\n\nExample:\n/home/kali\n/home/kali/acme-retest.json",
+	}
+)
+
+type StateExportForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewStateExportForm() *StateExportForm {
+	export := &StateExportForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	export.form.SetTitle("Export state").SetTitleAlign(tview.AlignCenter)
+	export.form.SetBorder(true)
+	export.form.SetButtonsAlign(tview.AlignCenter)
+
+	saveToField := tview.NewInputField()
+	saveToField.SetLabel("Save to")
+	saveToField.SetText(state_saveTo.Last)
+	saveToField.SetFocusFunc(func() {
+		hintBox.SetText(state_saveTo.Hint)
+	})
+	saveToField.SetChangedFunc(func(text string) {
+		state_saveTo.Last = text
+	})
+	export.form.AddFormItem(saveToField)
+
+	export.form.AddButton("Submit", nil)
+	export.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&export.Flex, export.form, 7, hintBox, 11, 1)
+
+	return export
+}
+
+func (page *StateExportForm) GetID() string {
+	return "state_export_form"
+}
+
+func (page *StateExportForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(state_saveTo.Last)
+	})
+}
+
+func (page *StateExportForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,104 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	sweep_targets = FormVal[string]{
+		Hint: "Addresses and networks the agent sweeps itself, separated by commas. It sends ICMP echo requests if it may open raw or ping sockets, and connects to the ports below otherwise. Nothing goes through the tunnel but the results. Networks go up to a /16.\n\nExample:\n10.20.0.0/24, 10.20.5.17",
+	}
+
+	sweep_ports = FormVal[string]{
+		Hint: "TCP ports the agent knocks on for every host, open ones are listed. A host answering a connection, even with a reset, is alive. Leave empty to rely on ICMP, the agent then knocks on a few common ports only if it can't send echo requests.\n\nExample:\n22,80,443,445\n8000-8010",
+	}
+
+	sweep_timeout = FormVal[string]{
+		Last: "1s",
+		Hint: "How long a host gets to answer an echo request or a connection.\n\nExample:\n500ms\n2s",
+	}
+)
+
+// SweepForm asks for hosts an agent looks for live ones among
+type SweepForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewSweepForm() *SweepForm {
+	page := &SweepForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Sweep").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	targetsField := tview.NewInputField()
+	targetsField.SetLabel("Targets")
+	targetsField.SetText(sweep_targets.Last)
+	targetsField.SetFocusFunc(func() {
+		hintBox.SetText(sweep_targets.Hint)
+	})
+	targetsField.SetChangedFunc(func(text string) {
+		sweep_targets.Last = text
+	})
+	page.form.AddFormItem(targetsField)
+
+	portsField := tview.NewInputField()
+	portsField.SetLabel("Ports")
+	portsField.SetText(sweep_ports.Last)
+	portsField.SetFocusFunc(func() {
+		hintBox.SetText(sweep_ports.Hint)
+	})
+	portsField.SetChangedFunc(func(text string) {
+		sweep_ports.Last = text
+	})
+	page.form.AddFormItem(portsField)
+
+	timeoutField := tview.NewInputField()
+	timeoutField.SetLabel("Timeout")
+	timeoutField.SetText(sweep_timeout.Last)
+	timeoutField.SetFocusFunc(func() {
+		hintBox.SetText(sweep_timeout.Hint)
+	})
+	timeoutField.SetChangedFunc(func(text string) {
+		sweep_timeout.Last = text
+	})
+	page.form.AddFormItem(timeoutField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 12, 1)
+
+	return page
+}
+
+func (page *SweepForm) GetID() string {
+	return "sweep_page"
+}
+
+func (page *SweepForm) SetSubmitFunc(f func(targets string, ports string, timeout string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(sweep_targets.Last), strings.TrimSpace(sweep_ports.Last), strings.TrimSpace(sweep_timeout.Last))
+	})
+}
+
+func (page *SweepForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
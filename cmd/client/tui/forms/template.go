@@ -0,0 +1,85 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	template_name = FormVal[string]{
+		Hint: "Name to pick the template by when generating agents. Letters, digits, '-' and '_' only. Uploading an existing name replaces it.\n\nExample:\nslow-beacon",
+	}
+
+	template_file = FormVal[string]{
+		Hint: "Path to the agent.go replacement. It is rendered with the same variables as the built-in one ({{ .Servers }}, {{ .CACert }}, {{ .AgentCert }}, {{ .AgentKey }}, {{ .ProxyServer }}, {{ .IgnoreEnvProxy }}, {{ .UserAgent }}, {{ .Netns }}, {{ .VRF }}, {{ .Beacon }}, {{ .Sealed }}, {{ .KeySource }}, {{ .Rotation }}, {{ .Retries }}, {{ .Backoff }}, {{ .Guardrails }}, {{ .Schedule }}, {{ .Multiplexer }}, {{ .Pinning }}) and must define run(args []string). Templates without {{ .Sealed }}, {{ .Guardrails }}, {{ .Schedule }}, {{ .Multiplexer }} or {{ .Pinning }} can't be used with config encryption, guardrails, jitter, working hours, multiplexer settings or SPKI pinning.\n\nExample:\n/home/kali/agent.go",
+	}
+)
+
+type TemplateForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewTemplateForm() *TemplateForm {
+	page := &TemplateForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Upload agent template").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	nameField := tview.NewInputField()
+	nameField.SetLabel("Name")
+	nameField.SetText(template_name.Last)
+	nameField.SetFocusFunc(func() {
+		hintBox.SetText(template_name.Hint)
+	})
+	nameField.SetChangedFunc(func(text string) {
+		template_name.Last = text
+	})
+	page.form.AddFormItem(nameField)
+
+	fileField := tview.NewInputField()
+	fileField.SetLabel("File")
+	fileField.SetText(template_file.Last)
+	fileField.SetFocusFunc(func() {
+		hintBox.SetText(template_file.Hint)
+	})
+	fileField.SetChangedFunc(func(text string) {
+		template_file.Last = text
+	})
+	page.form.AddFormItem(fileField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 9, hintBox, 12, 2)
+
+	return page
+}
+
+func (page *TemplateForm) GetID() string {
+	return "template_page"
+}
+
+func (page *TemplateForm) SetSubmitFunc(f func(string, string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(template_name.Last, template_file.Last)
+	})
+}
+
+func (page *TemplateForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,83 @@
+package forms
+
+import (
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	toolchain_archive = FormVal[string]{
+		Hint: "Path to a Go release archive for the server's platform, as published on go.dev (tar.gz or zip with go/ at its root). It must be newer than the current toolchain, which is kept for a rollback.\n\nExample:\n/home/kali/go1.24.1.linux-amd64.tar.gz",
+	}
+
+	toolchain_version = FormVal[string]{
+		Hint: "Go release the server downloads from go.dev for its own platform, the archive is checked against the published checksum. It must be newer than the current toolchain, which is kept for a rollback.\n\nExample:\ngo1.24.1",
+	}
+)
+
+// ToolchainForm asks for the Go toolchain to install, either an archive to upload or a release to fetch
+type ToolchainForm struct {
+	tview.Flex
+	form  *tview.Form
+	value *FormVal[string]
+}
+
+func NewToolchainForm(fetch bool) *ToolchainForm {
+	page := &ToolchainForm{
+		Flex:  *tview.NewFlex(),
+		form:  tview.NewForm(),
+		value: &toolchain_archive,
+	}
+
+	title, label := "Upload Go toolchain", "Archive"
+	if fetch {
+		title, label = "Fetch Go toolchain", "Version"
+		page.value = &toolchain_version
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle(title).SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	field := tview.NewInputField()
+	field.SetLabel(label)
+	field.SetText(page.value.Last)
+	field.SetFocusFunc(func() {
+		hintBox.SetText(page.value.Hint)
+	})
+	field.SetChangedFunc(func(text string) {
+		page.value.Last = text
+	})
+	page.form.AddFormItem(field)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 10, 2)
+
+	return page
+}
+
+func (page *ToolchainForm) GetID() string {
+	return "toolchain_page"
+}
+
+func (page *ToolchainForm) SetSubmitFunc(f func(string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(page.value.Last)
+	})
+}
+
+func (page *ToolchainForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(f)
+}
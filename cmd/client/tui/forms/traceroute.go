@@ -2,6 +2,7 @@ package forms
 
 import (
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 )
 
 var (
@@ -49,17 +50,7 @@ func NewTracerouteForm() *TracerouteForm {
 	page.form.AddButton("Submit", nil)
 	page.form.AddButton("Cancel", nil)
 
-	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(page.form, 11, 1, true).
-		AddItem(hintBox, 8, 1, false)
-
-	page.Flex.AddItem(nil, 0, 1, false).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
-			AddItem(nil, 0, 1, false).
-			AddItem(formFlex, 0, 1, true).
-			AddItem(nil, 0, 1, false),
-			0, 1, true).
-		AddItem(nil, 0, 1, false)
+	utils.LayoutForm(&page.Flex, page.form, 11, hintBox, 8, 1)
 
 	return page
 }
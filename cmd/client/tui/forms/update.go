@@ -0,0 +1,86 @@
+package forms
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+var (
+	update_build = FormVal[FormSelectVal]{
+		Hint: "Build the agent replaces itself with, newest first. Only executables built for the platform of the agent can be picked. The agent restarts from it and connects back right away, redirectors and the SOCKS5 service are brought back up.",
+	}
+)
+
+// UpdateAgentForm asks which build an agent should update to
+type UpdateAgentForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewUpdateAgentForm(builds []*agentbuild.AgentBuild) *UpdateAgentForm {
+	page := &UpdateAgentForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Update agent").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	update_build.Last = FormSelectVal{}
+
+	buildField := tview.NewDropDown()
+	buildField.SetLabel("Build")
+	buildField.SetFocusFunc(func() {
+		hintBox.SetText(update_build.Hint)
+	})
+	var options []string
+	for _, build := range builds {
+		option := fmt.Sprintf("%s (%s by %s)", build.ID, build.Created.Format(time.DateTime), build.Operator)
+		if build.Campaign != "" {
+			option += " — " + build.Campaign
+		}
+		options = append(options, option)
+	}
+	buildField.SetOptions(options, func(_ string, index int) {
+		update_build.Last.ID = index
+		update_build.Last.Value = builds[index].ID
+	})
+	buildField.SetCurrentOption(0)
+	page.form.AddFormItem(buildField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 10, 1)
+
+	return page
+}
+
+func (page *UpdateAgentForm) GetID() string {
+	return "updateagent_page"
+}
+
+func (page *UpdateAgentForm) SetSubmitFunc(f func(buildID string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(update_build.Last.Value)
+	})
+}
+
+func (page *UpdateAgentForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
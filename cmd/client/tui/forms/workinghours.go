@@ -0,0 +1,75 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+)
+
+var (
+	workingHours_windows = FormVal[string]{
+		Hint: "Windows during which the agent stays dormant, in the target's local time. If one is running, the agent drops the tunnel right away and connects back once it's over. The change lasts until the agent restarts. Leave empty to keep the agent reachable around the clock.\n\nExample:\nmon-fri 08:00-18:00\nsat 09:00-12:00, sun 00:00-24:00",
+	}
+)
+
+// WorkingHoursForm edits the windows a connected agent stays dormant in
+type WorkingHoursForm struct {
+	tview.Flex
+	form *tview.Form
+}
+
+func NewWorkingHoursForm(workingHours string) *WorkingHoursForm {
+	page := &WorkingHoursForm{
+		Flex: *tview.NewFlex(),
+		form: tview.NewForm(),
+	}
+
+	workingHours_windows.Last = workingHours
+
+	hintBox := tview.NewTextView()
+	hintBox.SetTitle("HINT")
+	hintBox.SetTitleAlign(tview.AlignCenter)
+	hintBox.SetBorder(true)
+	hintBox.SetBorderPadding(1, 1, 1, 1)
+
+	page.form.SetTitle("Working hours").SetTitleAlign(tview.AlignCenter)
+	page.form.SetBorder(true)
+	page.form.SetButtonsAlign(tview.AlignCenter)
+
+	windowsField := tview.NewInputField()
+	windowsField.SetLabel("Dormant")
+	windowsField.SetText(workingHours_windows.Last)
+	windowsField.SetFocusFunc(func() {
+		hintBox.SetText(workingHours_windows.Hint)
+	})
+	windowsField.SetChangedFunc(func(text string) {
+		workingHours_windows.Last = text
+	})
+	page.form.AddFormItem(windowsField)
+
+	page.form.AddButton("Submit", nil)
+	page.form.AddButton("Cancel", nil)
+
+	utils.LayoutForm(&page.Flex, page.form, 7, hintBox, 12, 1)
+
+	return page
+}
+
+func (page *WorkingHoursForm) GetID() string {
+	return "workinghours_page"
+}
+
+func (page *WorkingHoursForm) SetSubmitFunc(f func(workingHours string)) {
+	btnId := page.form.GetButtonIndex("Submit")
+	submitBtn := page.form.GetButton(btnId)
+	submitBtn.SetSelectedFunc(func() {
+		f(strings.TrimSpace(workingHours_windows.Last))
+	})
+}
+
+func (page *WorkingHoursForm) SetCancelFunc(f func()) {
+	btnId := page.form.GetButtonIndex("Cancel")
+	cancelBtn := page.form.GetButton(btnId)
+	cancelBtn.SetSelectedFunc(f)
+}
@@ -0,0 +1,175 @@
+// Package help renders the F1 documentation of whatever has the focus. Pages and widgets describe themselves,
+// forms are documented from their fields and the hints already written for them, so help follows the features.
+package help
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+type Key struct {
+	Name        string
+	Description string
+}
+
+type Field struct {
+	Label   string
+	Hint    string
+	Focused bool
+}
+
+// Doc is the documentation of a page, widget or form
+type Doc struct {
+	Title   string
+	Summary string
+	Keys    []Key
+	Fields  []Field
+}
+
+// Provider is implemented by primitives that document themselves
+type Provider interface {
+	Help() Doc
+}
+
+// Render formats doc for a text view with dynamic colors
+func (doc Doc) Render() string {
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "[::b]%s[::-]\n", tview.Escape(doc.Title))
+	if doc.Summary != "" {
+		fmt.Fprintf(&out, "\n%s\n", tview.Escape(doc.Summary))
+	}
+
+	for _, field := range doc.Fields {
+		if field.Focused && field.Hint != "" {
+			fmt.Fprintf(&out, "\n[yellow::b]%s[-::-]\n%s\n", tview.Escape(field.Label), tview.Escape(field.Hint))
+		}
+	}
+
+	if len(doc.Keys) > 0 {
+		out.WriteString("\n[::b]Keys[::-]\n")
+		width := 0
+		for _, key := range doc.Keys {
+			width = max(width, len(key.Name))
+		}
+		for _, key := range doc.Keys {
+			fmt.Fprintf(&out, "[yellow]%-*s[-]  %s\n", width, tview.Escape(key.Name), tview.Escape(key.Description))
+		}
+	}
+
+	if len(doc.Fields) > 0 {
+		out.WriteString("\n[::b]Fields[::-]\n")
+		for _, field := range doc.Fields {
+			marker := " "
+			if field.Focused {
+				marker = ">"
+			}
+			fmt.Fprintf(&out, "%s %s\n", marker, tview.Escape(field.Label))
+		}
+	}
+
+	return out.String()
+}
+
+// Find documents the innermost primitive on the way from root to the focused one that can be documented
+func Find(root tview.Primitive) (Doc, bool) {
+	path := focusPath(root)
+
+	for i := len(path) - 1; i >= 0; i-- {
+		switch p := path[i].(type) {
+		case Provider:
+			return p.Help(), true
+		case *tview.Form:
+			var parent tview.Primitive
+			if i > 0 {
+				parent = path[i-1]
+			}
+			return formDoc(p, parent), true
+		}
+	}
+
+	return Doc{}, false
+}
+
+type container interface {
+	GetItemCount() int
+	GetItem(index int) tview.Primitive
+}
+
+type pages interface {
+	GetFrontPage() (string, tview.Primitive)
+}
+
+func focusPath(p tview.Primitive) []tview.Primitive {
+	if p == nil || !p.HasFocus() {
+		return nil
+	}
+
+	path := []tview.Primitive{p}
+	switch c := p.(type) {
+	case pages:
+		_, front := c.GetFrontPage()
+		path = append(path, focusPath(front)...)
+	case container:
+		for i := 0; i < c.GetItemCount(); i++ {
+			if sub := focusPath(c.GetItem(i)); sub != nil {
+				path = append(path, sub...)
+				break
+			}
+		}
+	}
+
+	return path
+}
+
+// formDoc lists the fields of a form, the focused one comes with what its hint box currently says
+func formDoc(form *tview.Form, parent tview.Primitive) Doc {
+	doc := Doc{Title: strings.TrimSpace(form.GetTitle())}
+	if doc.Title == "" {
+		doc.Title = "Form"
+	}
+
+	focusedItem, focusedButton := form.GetFocusedItemIndex()
+	hint := hintText(parent)
+
+	for i := 0; i < form.GetFormItemCount(); i++ {
+		field := Field{Label: form.GetFormItem(i).GetLabel()}
+		if i == focusedItem {
+			field.Focused = true
+			field.Hint = hint
+		}
+		doc.Fields = append(doc.Fields, field)
+	}
+
+	for i := 0; i < form.GetButtonCount(); i++ {
+		doc.Keys = append(doc.Keys, Key{Name: form.GetButton(i).GetLabel(), Description: "button"})
+		if i == focusedButton {
+			doc.Keys[len(doc.Keys)-1].Description = "button, focused"
+		}
+	}
+	doc.Keys = append(doc.Keys,
+		Key{Name: "Tab", Description: "next field"},
+		Key{Name: "Backtab", Description: "previous field"},
+		Key{Name: "Esc", Description: "close"},
+	)
+
+	return doc
+}
+
+// hintText reads the hint box laid out next to a form, see utils.LayoutForm
+func hintText(parent tview.Primitive) string {
+	c, ok := parent.(container)
+	if !ok {
+		return ""
+	}
+
+	for i := 0; i < c.GetItemCount(); i++ {
+		if box, ok := c.GetItem(i).(*tview.TextView); ok && box.GetTitle() == "HINT" {
+			return strings.TrimSpace(box.GetText(true))
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,66 @@
+package modals
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
+)
+
+type HelpModal struct {
+	tview.Flex
+	text     *tview.TextView
+	doneFunc func()
+}
+
+func NewHelpModal(body string) *HelpModal {
+	page := &HelpModal{
+		Flex: *tview.NewFlex(),
+		text: tview.NewTextView(),
+	}
+
+	page.text.SetDynamicColors(true)
+	page.text.SetScrollable(true)
+	page.text.SetWordWrap(true)
+	page.text.SetText(body)
+	page.text.SetBackgroundColor(style.ModalBgColor)
+	page.text.SetBorder(true)
+	page.text.SetBorderPadding(0, 0, 1, 1)
+	page.text.SetBorderColor(style.BorderColor)
+	page.text.SetTitleColor(style.FgColor)
+	page.text.SetTitle(fmt.Sprintf("[::b]%s", strings.ToUpper("help")))
+
+	page.Flex.AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(page.text, 0, 4, true).
+			AddItem(nil, 0, 1, false),
+			0, 3, true).
+		AddItem(nil, 0, 1, false)
+
+	return page
+}
+
+func (page *HelpModal) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		switch event.Key() {
+		case tcell.KeyEscape, tcell.KeyEnter, tcell.KeyF1:
+			if page.doneFunc != nil {
+				page.doneFunc()
+			}
+		default:
+			defaultHandler := page.Flex.InputHandler()
+			defaultHandler(event, setFocus)
+		}
+	}
+}
+
+func (page *HelpModal) GetID() string {
+	return "help"
+}
+
+func (page *HelpModal) SetDoneFunc(f func()) {
+	page.doneFunc = f
+}
@@ -2,14 +2,22 @@ package pages
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	forms "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	modals "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	widgets "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/listener"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
 )
 
@@ -17,6 +25,7 @@ type AdminPage struct {
 	tview.Pages
 
 	flex      *tview.Flex
+	rows      []*tview.Flex
 	server    *widgets.ServerWidget
 	operators *widgets.OperatorsWidget
 	certs     *widgets.CertificatesWidget
@@ -28,12 +37,44 @@ type AdminPage struct {
 	getCertificates func() ([]*certificate.Certificate, error)
 	switchback      func()
 
-	exportOperator  func(string, string) (string, error)
-	addOperator     func(string, bool, string) (*operator.Operator, error)
-	delOperator     func(string) error
-	promoteOperator func(string) error
-	demoteOperator  func(string) error
-	regenCert       func(string) error
+	exportOperator    func(string, string) (string, error)
+	addOperator       func(string, string, string, string) (*operator.Operator, error)
+	delOperator       func(string) error
+	setOperatorRole   func(string, string) error
+	regenCert         func(string) error
+	renewCert         func(string) (time.Time, error)
+	getEnrollments    func() ([]*operator.Enrollment, error)
+	addEnrollment     func(string, string, string, string, string) (string, error)
+	delEnrollment     func(string) error
+	getRevoked        func() ([]*crl.RevokedCertificate, error)
+	revokeCert        func(string, string) error
+	unrevokeCert      func(string) error
+	exportCRL         func(string) (string, error)
+	getCAs            func() ([]*certificate.Authority, error)
+	addCA             func(string, string) error
+	revokeCA          func(string, string) error
+	exportServerState func(string, string) (string, int64, error)
+	importServerState func(string, string) (int64, int64, error)
+	getTemplates      func() ([]*agentbuild.Template, error)
+	addTemplate       func(string, string) error
+	delTemplate       func(string) error
+	getSigningKeys    func() ([]*agentbuild.SigningKey, error)
+	addSigningKey     func(string, string, string, string) error
+	delSigningKey     func(string) error
+	getAssetUsage     func() ([]*agentbuild.AssetUsage, int64, error)
+	collectAssets     func(string) (int64, error)
+	getEngagements    func() ([]*engagement.Engagement, error)
+	addEngagement     func(string, []string, string, string, []string) error
+	delEngagement     func(string) error
+	activate          func(string) error
+	getListeners      func() ([]*listener.Listener, error)
+	addListener       func(string, string, string, string, string, bool, bool) error
+	delListener       func(string) error
+	enableListener    func(string, bool) error
+	getToolchain      func() (*agentbuild.Toolchain, error)
+	uploadToolchain   func(string) (*agentbuild.Toolchain, error)
+	fetchToolchain    func(string) (*agentbuild.Toolchain, error)
+	rollback          func() (*agentbuild.Toolchain, error)
 
 	operator *operator.Operator
 }
@@ -56,6 +97,8 @@ func NewAdminPage() *AdminPage {
 	firstRow.AddItem(admin.operators, 0, 50, true)
 	firstRow.AddItem(admin.certs, 0, 50, false)
 
+	admin.rows = []*tview.Flex{firstRow}
+
 	admin.flex.SetDirection(tview.FlexRow)
 	admin.flex.AddItem(admin.server, 3, 0, false)
 	admin.flex.AddItem(firstRow, 0, 100, true)
@@ -73,6 +116,11 @@ func (admin *AdminPage) Reset() {
 	admin.AddAndSwitchToPage("main", admin.flex, true)
 }
 
+// ApplyLayout puts operators above certificates in the compact preset
+func (admin *AdminPage) ApplyLayout() {
+	utils.LayoutPanes(admin.rows...)
+}
+
 func (admin *AdminPage) initOperatorsWidget() {
 	admin.operators.SetSelectedFunc(func(elem *widgets.OperatorsWidgetElem) {
 		menu := modals.NewMenuModal(fmt.Sprintf("Operator — %s", elem.Operator.Name))
@@ -103,28 +151,33 @@ func (admin *AdminPage) initOperatorsWidget() {
 			admin.AddPage(export.GetID(), export, true, true)
 		}))
 
-		if !elem.Operator.IsAdmin {
-			menu.AddItem(modals.NewMenuModalElem("Promote", func() {
-				admin.DoWithLoader("Promoting operator...", func() {
-					err := admin.promoteOperator(elem.Operator.Name)
-					if err != nil {
-						admin.ShowError(fmt.Sprintf("Could not promote operator: %s", err), nil)
-						return
-					}
+		menu.AddItem(modals.NewMenuModalElem("Renew certificate", func() {
+			admin.DoWithLoader("Renewing certificate...", func() {
+				expiry, err := admin.renewCert(elem.Operator.Name)
+				if err != nil {
+					admin.ShowError(fmt.Sprintf("Could not renew certificate: %s", err), nil)
+					return
+				}
 
-					admin.ShowInfo("Operator promoted", cleanup)
-				})
-			}))
-		} else {
-			menu.AddItem(modals.NewMenuModalElem("Demote", func() {
-				admin.DoWithLoader("Demoting operator...", func() {
-					err := admin.demoteOperator(elem.Operator.Name)
+				admin.ShowInfo(fmt.Sprintf("Certificate renewed until %s, export the operator again to hand it over", expiry.Local().Format(time.DateOnly)), cleanup)
+			})
+		}))
+
+		for _, role := range operator.Roles {
+			if role == elem.Operator.GetRole() {
+				continue
+			}
+
+			role := role
+			menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Make %s", role), func() {
+				admin.DoWithLoader("Changing role...", func() {
+					err := admin.setOperatorRole(elem.Operator.Name, role)
 					if err != nil {
-						admin.ShowError(fmt.Sprintf("Could not demote operator: %s", err), nil)
+						admin.ShowError(fmt.Sprintf("Could not change role: %s", err), nil)
 						return
 					}
 
-					admin.ShowInfo("Operator demoted", cleanup)
+					admin.ShowInfo(fmt.Sprintf("Operator is now %s", role), cleanup)
 				})
 			}))
 		}
@@ -178,14 +231,810 @@ func (admin *AdminPage) initCertsWidget() {
 	})
 }
 
+func (admin *AdminPage) showTemplates() {
+	admin.DoWithLoader("Loading agent templates...", func() {
+		templates, err := admin.getTemplates()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load agent templates: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Agent templates")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Upload template", func() {
+			form := forms.NewTemplateForm()
+			form.SetSubmitFunc(func(name string, path string) {
+				admin.DoWithLoader("Uploading agent template...", func() {
+					err := admin.addTemplate(name, path)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not upload agent template: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("Agent template %s saved", name), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, t := range templates {
+			name := t.Name
+			menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Remove %s (%s)", name, utils.HumanBytes(t.Size)), func() {
+				admin.DoWithConfirm(fmt.Sprintf("Remove agent template '%s'?", name), func() {
+					admin.DoWithLoader("Removing agent template...", func() {
+						err := admin.delTemplate(name)
+						if err != nil {
+							admin.ShowError(fmt.Sprintf("Could not remove agent template: %s", err), cleanup)
+							return
+						}
+
+						admin.ShowInfo("Agent template removed", cleanup)
+					})
+				})
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showSigningKeys() {
+	admin.DoWithLoader("Loading signing keys...", func() {
+		keys, err := admin.getSigningKeys()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load signing keys: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Signing keys")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Upload signing key", func() {
+			form := forms.NewSigningKeyForm()
+			form.SetSubmitFunc(func(name string, keyType string, path string, password string) {
+				admin.DoWithLoader("Uploading signing key...", func() {
+					err := admin.addSigningKey(name, keyType, path, password)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not upload signing key: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("Signing key %s saved", name), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, key := range keys {
+			name := key.Name
+			menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Remove %s (%s)", name, key.Type), func() {
+				admin.DoWithConfirm(fmt.Sprintf("Remove signing key '%s'?", name), func() {
+					admin.DoWithLoader("Removing signing key...", func() {
+						err := admin.delSigningKey(name)
+						if err != nil {
+							admin.ShowError(fmt.Sprintf("Could not remove signing key: %s", err), cleanup)
+							return
+						}
+
+						admin.ShowInfo("Signing key removed", cleanup)
+					})
+				})
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showEngagements() {
+	admin.DoWithLoader("Loading engagements...", func() {
+		engagements, err := admin.getEngagements()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load engagements: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Engagements")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("New engagement", func() {
+			form := forms.NewEngagementForm()
+			form.SetSubmitFunc(func(name string, scope []string, start string, end string, operators []string) {
+				admin.DoWithLoader("Adding engagement...", func() {
+					err := admin.addEngagement(name, scope, start, end, operators)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not add engagement: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("Engagement %s added, make it current to file new sessions and builds under it", name), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, e := range engagements {
+			e := e
+			title := e.Name
+			if e.Current {
+				title += " (current)"
+			}
+
+			menu.AddItem(modals.NewMenuModalElem(title, func() {
+				sub := modals.NewMenuModal(fmt.Sprintf("Engagement — %s", e.Name))
+				subCleanup := func() {
+					admin.RemovePage(sub.GetID())
+					cleanup()
+				}
+
+				sub.AddItem(modals.NewMenuModalElem("Details", func() {
+					admin.ShowText("Engagement", e.String(), nil)
+				}))
+
+				activation, name := "Make current", e.Name
+				if e.Current {
+					activation, name = "Stop being current", ""
+				}
+				sub.AddItem(modals.NewMenuModalElem(activation, func() {
+					admin.DoWithLoader("Switching engagement...", func() {
+						if err := admin.activate(name); err != nil {
+							admin.ShowError(fmt.Sprintf("Could not switch engagement: %s", err), subCleanup)
+							return
+						}
+
+						admin.RefreshData()
+						admin.ShowInfo("Engagement switched", subCleanup)
+					})
+				}))
+
+				sub.AddItem(modals.NewMenuModalElem("Remove", func() {
+					admin.DoWithConfirm(fmt.Sprintf("Remove engagement '%s'? What was filed under it keeps the name.", e.Name), func() {
+						admin.DoWithLoader("Removing engagement...", func() {
+							if err := admin.delEngagement(e.Name); err != nil {
+								admin.ShowError(fmt.Sprintf("Could not remove engagement: %s", err), subCleanup)
+								return
+							}
+
+							admin.RefreshData()
+							admin.ShowInfo("Engagement removed", subCleanup)
+						})
+					})
+				}))
+
+				sub.SetCancelFunc(func() {
+					admin.RemovePage(sub.GetID())
+				})
+				admin.AddPage(sub.GetID(), sub, true, true)
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showListeners() {
+	admin.DoWithLoader("Loading listeners...", func() {
+		listeners, err := admin.getListeners()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load listeners: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Listeners")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("New listener", func() {
+			form := forms.NewListenerForm()
+			form.SetSubmitFunc(func(name string, transport string, address string, certificate string, key string, proxyProtocol bool, enabled bool) {
+				admin.DoWithLoader("Adding listener...", func() {
+					err := admin.addListener(name, transport, address, certificate, key, proxyProtocol, enabled)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not add listener: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("Listener %s added", name), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, l := range listeners {
+			l := l
+			title := fmt.Sprintf("%s — %s (%s)", l.Name, l.Address, l.Status())
+			if l.Static {
+				title = fmt.Sprintf("%s (static)", l.Name)
+			}
+
+			menu.AddItem(modals.NewMenuModalElem(title, func() {
+				sub := modals.NewMenuModal(fmt.Sprintf("Listener — %s", l.Name))
+				subCleanup := func() {
+					admin.RemovePage(sub.GetID())
+					cleanup()
+				}
+
+				sub.AddItem(modals.NewMenuModalElem("Details", func() {
+					admin.ShowText("Listener", l.String(), nil)
+				}))
+
+				if !l.Static {
+					toggle, enable, done := "Disable", false, "Listener disabled"
+					if !l.Enabled || l.Error != "" {
+						toggle, enable, done = "Enable", true, "Listener enabled"
+					}
+					sub.AddItem(modals.NewMenuModalElem(toggle, func() {
+						admin.DoWithLoader("Switching listener...", func() {
+							if err := admin.enableListener(l.Name, enable); err != nil {
+								admin.ShowError(fmt.Sprintf("Could not switch listener: %s", err), subCleanup)
+								return
+							}
+
+							admin.ShowInfo(done, subCleanup)
+						})
+					}))
+
+					sub.AddItem(modals.NewMenuModalElem("Remove", func() {
+						admin.DoWithConfirm(fmt.Sprintf("Remove listener '%s'? Sessions that came in on it stay up.", l.Name), func() {
+							admin.DoWithLoader("Removing listener...", func() {
+								if err := admin.delListener(l.Name); err != nil {
+									admin.ShowError(fmt.Sprintf("Could not remove listener: %s", err), subCleanup)
+									return
+								}
+
+								admin.ShowInfo("Listener removed", subCleanup)
+							})
+						})
+					}))
+				}
+
+				sub.SetCancelFunc(func() {
+					admin.RemovePage(sub.GetID())
+				})
+				admin.AddPage(sub.GetID(), sub, true, true)
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showEnrollments() {
+	admin.DoWithLoader("Loading enrollments...", func() {
+		enrollments, err := admin.getEnrollments()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load enrollments: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Pending enrollments")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("New enrollment", func() {
+			form := forms.NewEnrollmentForm()
+			form.SetSubmitFunc(func(name string, role string, server string, ttl string, ca string) {
+				admin.DoWithLoader("Creating enrollment...", func() {
+					code, err := admin.addEnrollment(name, role, server, ttl, ca)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not create enrollment: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowText("Enrollment code", fmt.Sprintf("Hand this code over to %s, it works once and isn't shown again:\n\n%s", tview.Escape(name), code), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, enrollment := range enrollments {
+			enrollment := enrollment
+			title := fmt.Sprintf("%s (%s) — expires %s", enrollment.Name, enrollment.Role, enrollment.ExpiresAt.Local().Format(time.DateTime))
+
+			menu.AddItem(modals.NewMenuModalElem(title, func() {
+				admin.DoWithConfirm(fmt.Sprintf("Cancel the enrollment of %s? Their code stops working.", enrollment.Name), func() {
+					admin.DoWithLoader("Cancelling enrollment...", func() {
+						if err := admin.delEnrollment(enrollment.ID); err != nil {
+							admin.ShowError(fmt.Sprintf("Could not cancel enrollment: %s", err), cleanup)
+							return
+						}
+
+						admin.ShowInfo("Enrollment cancelled", cleanup)
+					})
+				})
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showRevocations() {
+	admin.DoWithLoader("Loading revoked certificates...", func() {
+		revoked, err := admin.getRevoked()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load revoked certificates: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Revoked certificates")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Revoke certificate", func() {
+			form := forms.NewRevokeForm()
+			form.SetSubmitFunc(func(path string, reason string) {
+				admin.DoWithLoader("Revoking certificate...", func() {
+					if err := admin.revokeCert(path, reason); err != nil {
+						admin.ShowError(fmt.Sprintf("Could not revoke certificate: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo("Certificate revoked", cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Export CRL", func() {
+			form := forms.NewExportCRLForm()
+			form.SetSubmitFunc(func(path string) {
+				admin.DoWithLoader("Exporting CRL...", func() {
+					saved, err := admin.exportCRL(path)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not export CRL: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("CRL saved to %s", saved), nil)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, cert := range revoked {
+			cert := cert
+			title := cert.Subject
+			if title == "" {
+				title = cert.Hash()
+			}
+			if !cert.RevokedAt.IsZero() {
+				title = fmt.Sprintf("%s — %s", title, cert.RevokedAt.Local().Format(time.DateTime))
+			}
+
+			menu.AddItem(modals.NewMenuModalElem(title, func() {
+				sub := modals.NewMenuModal(fmt.Sprintf("Revoked — %s", title))
+				subCleanup := func() {
+					admin.RemovePage(sub.GetID())
+					cleanup()
+				}
+
+				sub.AddItem(modals.NewMenuModalElem("Details", func() {
+					admin.ShowText("Revoked certificate", formatRevoked(cert), nil)
+				}))
+
+				sub.AddItem(modals.NewMenuModalElem("Unrevoke", func() {
+					admin.DoWithConfirm("Whatever holds this certificate will be able to connect again. Are you sure?", func() {
+						admin.DoWithLoader("Unrevoking certificate...", func() {
+							if err := admin.unrevokeCert(cert.Hash()); err != nil {
+								admin.ShowError(fmt.Sprintf("Could not unrevoke certificate: %s", err), subCleanup)
+								return
+							}
+
+							admin.ShowInfo("Certificate is no longer revoked", subCleanup)
+						})
+					})
+				}))
+
+				sub.SetCancelFunc(func() {
+					admin.RemovePage(sub.GetID())
+				})
+				admin.AddPage(sub.GetID(), sub, true, true)
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showCAs() {
+	admin.DoWithLoader("Loading CAs...", func() {
+		authorities, err := admin.getCAs()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load CAs: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal("Intermediate CAs")
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("New CA", func() {
+			form := forms.NewCAForm()
+			form.SetSubmitFunc(func(name string, engagement string) {
+				admin.DoWithLoader("Creating CA...", func() {
+					if err := admin.addCA(name, engagement); err != nil {
+						admin.ShowError(fmt.Sprintf("Could not create CA: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("CA '%s' created", name), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, authority := range authorities {
+			authority := authority
+			title := authority.Name
+			if authority.Engagement != "" {
+				title = fmt.Sprintf("%s (%s)", title, authority.Engagement)
+			}
+			if authority.Revoked {
+				title += " — revoked"
+			}
+
+			menu.AddItem(modals.NewMenuModalElem(title, func() {
+				sub := modals.NewMenuModal(fmt.Sprintf("CA — %s", authority.Name))
+				subCleanup := func() {
+					admin.RemovePage(sub.GetID())
+					cleanup()
+				}
+
+				sub.AddItem(modals.NewMenuModalElem("Details", func() {
+					admin.ShowText("Intermediate CA", formatAuthority(authority), nil)
+				}))
+
+				if !authority.Revoked {
+					sub.AddItem(modals.NewMenuModalElem("Revoke", func() {
+						admin.DoWithConfirm(fmt.Sprintf("Every agent and operator certificate '%s' issued will be refused, connected agents are dropped. It can't be undone from here. Are you sure?", authority.Name), func() {
+							admin.DoWithLoader("Revoking CA...", func() {
+								if err := admin.revokeCA(authority.Name, ""); err != nil {
+									admin.ShowError(fmt.Sprintf("Could not revoke CA: %s", err), subCleanup)
+									return
+								}
+
+								admin.ShowInfo(fmt.Sprintf("CA '%s' revoked", authority.Name), subCleanup)
+							})
+						})
+					}))
+				}
+
+				sub.SetCancelFunc(func() {
+					admin.RemovePage(sub.GetID())
+				})
+				admin.AddPage(sub.GetID(), sub, true, true)
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+// showState exports the server state to an archive, or replaces it with one, to move the server to another host
+func (admin *AdminPage) showState() {
+	menu := modals.NewMenuModal("Server state")
+	cleanup := func() {
+		admin.RemovePage(menu.GetID())
+	}
+
+	menu.AddItem(modals.NewMenuModalElem("Export", func() {
+		form := forms.NewServerStateForm(true)
+		form.SetSubmitFunc(func(path string, passphrase string) {
+			admin.DoWithLoader("Exporting server state...", func() {
+				saved, rows, err := admin.exportServerState(path, passphrase)
+				if err != nil {
+					admin.ShowError(fmt.Sprintf("Could not export server state: %s", err), nil)
+					return
+				}
+
+				admin.RemovePage(form.GetID())
+				admin.ShowInfo(fmt.Sprintf("Server state of %d rows saved to %s", rows, saved), cleanup)
+			})
+		})
+		form.SetCancelFunc(func() {
+			admin.RemovePage(form.GetID())
+		})
+		admin.AddPage(form.GetID(), form, true, true)
+	}))
+
+	menu.AddItem(modals.NewMenuModalElem("Import", func() {
+		form := forms.NewServerStateForm(false)
+		form.SetSubmitFunc(func(path string, passphrase string) {
+			admin.DoWithConfirm("Replace the whole server state with the archive? Everything on this server is lost, including its CA and operators.", func() {
+				admin.DoWithLoader("Importing server state...", func() {
+					tables, rows, err := admin.importServerState(path, passphrase)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not import server state: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("Imported %d rows in %d tables. Restart the server to load them, then connect with the credentials of the server they come from.", rows, tables), cleanup)
+				})
+			})
+		})
+		form.SetCancelFunc(func() {
+			admin.RemovePage(form.GetID())
+		})
+		admin.AddPage(form.GetID(), form, true, true)
+	}))
+
+	menu.SetCancelFunc(cleanup)
+	admin.AddPage(menu.GetID(), menu, true, true)
+}
+
+func formatAuthority(authority *certificate.Authority) string {
+	engagement := authority.Engagement
+	if engagement == "" {
+		engagement = "none"
+	}
+
+	lines := []string{
+		fmt.Sprintf("Name: %s", tview.Escape(authority.Name)),
+		fmt.Sprintf("Engagement: %s", tview.Escape(engagement)),
+		fmt.Sprintf("Serial number: %s", authority.SerialNumber()),
+		fmt.Sprintf("Created: %s by %s", authority.CreatedAt.Local().Format(time.DateTime), tview.Escape(authority.CreatedBy)),
+		fmt.Sprintf("Expires: %s", authority.Expires().Local().Format(time.DateTime)),
+		fmt.Sprintf("Revoked: %t", authority.Revoked),
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatRevoked(cert *crl.RevokedCertificate) string {
+	lines := []string{
+		fmt.Sprintf("Thumbprint: %s", cert.Hash()),
+		fmt.Sprintf("Reason: %s", tview.Escape(cert.Reason)),
+	}
+	if cert.Subject != "" {
+		lines = append(lines, fmt.Sprintf("Subject: %s", tview.Escape(cert.Subject)))
+	}
+	if cert.SerialNumber != "" {
+		lines = append(lines, fmt.Sprintf("Serial number: %s", cert.SerialNumber))
+	} else {
+		lines = append(lines, "Serial number: unknown, left out of the CRL")
+	}
+	if !cert.RevokedAt.IsZero() {
+		lines = append(lines, fmt.Sprintf("Revoked at: %s", cert.RevokedAt.Local().Format(time.DateTime)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// assetLabels are the names asset categories are shown with
+var assetLabels = map[string]string{
+	agentbuild.AssetToolchain:   "Go toolchain",
+	agentbuild.AssetBuildCache:  "Build cache",
+	agentbuild.AssetModuleCache: "Module cache",
+	agentbuild.AssetStages:      "Stages",
+	agentbuild.AssetScratch:     "Build leftovers",
+}
+
+func (admin *AdminPage) showAssetUsage() {
+	admin.DoWithLoader("Measuring assets...", func() {
+		usage, quota, err := admin.getAssetUsage()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not measure assets: %s", err), nil)
+			return
+		}
+
+		var total int64
+		for _, u := range usage {
+			total += u.Bytes
+		}
+
+		title := fmt.Sprintf("Disk usage — %s", utils.HumanBytes(total))
+		if quota > 0 {
+			title = fmt.Sprintf("Disk usage — %s of %s", utils.HumanBytes(total), utils.HumanBytes(quota))
+		}
+
+		menu := modals.NewMenuModal(title)
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+
+		collect := func(category string, question string) {
+			admin.DoWithConfirm(question, func() {
+				admin.DoWithLoader("Collecting...", func() {
+					freed, err := admin.collectAssets(category)
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not collect assets: %s", err), cleanup)
+						return
+					}
+
+					admin.ShowInfo(fmt.Sprintf("%s freed", utils.HumanBytes(freed)), cleanup)
+				})
+			})
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Collect garbage", func() {
+			collect("", "Remove build leftovers and evict caches over the quota? Builds wait until it's done.")
+		}))
+
+		for _, u := range usage {
+			category := u.Category
+			label := assetLabels[category]
+			if label == "" {
+				label = category
+			}
+
+			title := fmt.Sprintf("%s: %s in %d files", label, utils.HumanBytes(u.Bytes), u.Files)
+			if !u.Evictable {
+				menu.AddItem(modals.NewMenuModalElem(title, func() {}))
+				continue
+			}
+
+			menu.AddItem(modals.NewMenuModalElem(title, func() {
+				collect(category, fmt.Sprintf("Clear %s? The next builds will be slower.", strings.ToLower(label)))
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (admin *AdminPage) showToolchain() {
+	admin.DoWithLoader("Loading toolchain...", func() {
+		toolchain, err := admin.getToolchain()
+		if err != nil {
+			admin.ShowError(fmt.Sprintf("Could not load toolchain: %s", err), nil)
+			return
+		}
+
+		menu := modals.NewMenuModal(fmt.Sprintf("Go toolchain — %s (%s)", toolchain.Version, toolchain.Origin))
+		cleanup := func() {
+			admin.RemovePage(menu.GetID())
+		}
+		menu.SetCancelFunc(cleanup)
+
+		menu.AddItem(modals.NewMenuModalElem("Details", func() {
+			var text strings.Builder
+			fmt.Fprintf(&text, "Version: %s\n", toolchain.Version)
+			fmt.Fprintf(&text, "Origin: %s\n", toolchain.Origin)
+			if !toolchain.Installed.IsZero() {
+				fmt.Fprintf(&text, "Installed: %s\n", toolchain.Installed.Format(time.DateTime))
+			}
+			fmt.Fprintf(&text, "Rollback available: %s\n", utils.HumanBool(toolchain.CanRollback))
+
+			admin.ShowText("Go toolchain", text.String(), nil)
+		}))
+
+		if toolchain.Origin == agentbuild.ToolchainExternal {
+			admin.AddPage(menu.GetID(), menu, true, true)
+			return
+		}
+
+		install := func(fetch bool) {
+			form := forms.NewToolchainForm(fetch)
+			form.SetSubmitFunc(func(value string) {
+				admin.DoWithLoader("Installing toolchain, builds wait until it's done...", func() {
+					var installed *agentbuild.Toolchain
+					var err error
+					if fetch {
+						installed, err = admin.fetchToolchain(value)
+					} else {
+						installed, err = admin.uploadToolchain(value)
+					}
+					if err != nil {
+						admin.ShowError(fmt.Sprintf("Could not install toolchain: %s", err), nil)
+						return
+					}
+
+					admin.RemovePage(form.GetID())
+					admin.ShowInfo(fmt.Sprintf("Agents are now built with %s", installed.Version), cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				admin.RemovePage(form.GetID())
+			})
+			admin.AddPage(form.GetID(), form, true, true)
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Upload archive", func() {
+			install(false)
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Fetch from go.dev", func() {
+			install(true)
+		}))
+
+		if toolchain.CanRollback {
+			menu.AddItem(modals.NewMenuModalElem("Roll back", func() {
+				admin.DoWithConfirm("Go back to the previous toolchain? Builds wait until it's done.", func() {
+					admin.DoWithLoader("Rolling back toolchain...", func() {
+						previous, err := admin.rollback()
+						if err != nil {
+							admin.ShowError(fmt.Sprintf("Could not roll back toolchain: %s", err), nil)
+							return
+						}
+
+						admin.ShowInfo(fmt.Sprintf("Agents are now built with %s", previous.Version), cleanup)
+					})
+				})
+			}))
+		}
+
+		admin.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
 func (admin *AdminPage) GetID() string {
 	return "admin"
 }
 
+func (admin *AdminPage) Help() help.Doc {
+	return help.Doc{
+		Title:   "Administration",
+		Summary: "Operators, certificates, agent listeners and server assets, only admins get here.",
+	}
+}
+
 func (admin *AdminPage) GetNavBar() []widgets.NavBarElem {
 	return []widgets.NavBarElem{
 		widgets.NewNavBarElem(tcell.KeyCtrlA, "Back"),
 		widgets.NewNavBarElem(tcell.KeyCtrlN, "New operator"),
+		widgets.NewNavBarElem(tcell.KeyCtrlT, "Agent templates"),
+		widgets.NewNavBarElem(tcell.KeyCtrlK, "Signing keys"),
+		widgets.NewNavBarElem(tcell.KeyCtrlU, "Disk usage"),
+		widgets.NewNavBarElem(tcell.KeyCtrlG, "Engagements"),
+		widgets.NewNavBarElem(tcell.KeyCtrlL, "Listeners"),
+		widgets.NewNavBarElem(tcell.KeyCtrlO, "Toolchain"),
+		widgets.NewNavBarElem(tcell.KeyCtrlE, "Revocations"),
+		widgets.NewNavBarElem(tcell.KeyCtrlP, "Enrollments"),
+		widgets.NewNavBarElem(tcell.KeyCtrlY, "CAs"),
+		widgets.NewNavBarElem(tcell.KeyCtrlS, "Server state"),
 	}
 }
 
@@ -212,9 +1061,9 @@ func (admin *AdminPage) InputHandler() func(event *tcell.EventKey, setFocus func
 				admin.switchback()
 			case tcell.KeyCtrlN:
 				gen := forms.NewOperatorForm()
-				gen.SetSubmitFunc(func(name string, isAdmin bool, server string) {
+				gen.SetSubmitFunc(func(name string, role string, server string, ca string) {
 					admin.DoWithLoader("Creating operator...", func() {
-						oper, err := admin.addOperator(name, isAdmin, server)
+						oper, err := admin.addOperator(name, role, server, ca)
 						if err != nil {
 							admin.ShowError(fmt.Sprintf("Could not create operator: %s", err), nil)
 							return
@@ -229,6 +1078,26 @@ func (admin *AdminPage) InputHandler() func(event *tcell.EventKey, setFocus func
 					admin.RemovePage(gen.GetID())
 				})
 				admin.AddPage(gen.GetID(), gen, true, true)
+			case tcell.KeyCtrlT:
+				admin.showTemplates()
+			case tcell.KeyCtrlK:
+				admin.showSigningKeys()
+			case tcell.KeyCtrlU:
+				admin.showAssetUsage()
+			case tcell.KeyCtrlG:
+				admin.showEngagements()
+			case tcell.KeyCtrlL:
+				admin.showListeners()
+			case tcell.KeyCtrlO:
+				admin.showToolchain()
+			case tcell.KeyCtrlE:
+				admin.showRevocations()
+			case tcell.KeyCtrlP:
+				admin.showEnrollments()
+			case tcell.KeyCtrlY:
+				admin.showCAs()
+			case tcell.KeyCtrlS:
+				admin.showState()
 			default:
 				defaultHandler := admin.Pages.InputHandler()
 				defaultHandler(event, setFocus)
@@ -290,11 +1159,91 @@ func (admin *AdminPage) SetOperator(oper *operator.Operator) {
 	admin.operator = oper
 }
 
+func (admin *AdminPage) SetGetTemplatesFunc(f func() ([]*agentbuild.Template, error)) {
+	admin.getTemplates = f
+}
+
+func (admin *AdminPage) SetAddTemplateFunc(f func(string, string) error) {
+	admin.addTemplate = f
+}
+
+func (admin *AdminPage) SetDelTemplateFunc(f func(string) error) {
+	admin.delTemplate = f
+}
+
+func (admin *AdminPage) SetGetSigningKeysFunc(f func() ([]*agentbuild.SigningKey, error)) {
+	admin.getSigningKeys = f
+}
+
+func (admin *AdminPage) SetAddSigningKeyFunc(f func(string, string, string, string) error) {
+	admin.addSigningKey = f
+}
+
+func (admin *AdminPage) SetDelSigningKeyFunc(f func(string) error) {
+	admin.delSigningKey = f
+}
+
+func (admin *AdminPage) SetGetEngagementsFunc(f func() ([]*engagement.Engagement, error)) {
+	admin.getEngagements = f
+}
+
+func (admin *AdminPage) SetAddEngagementFunc(f func(string, []string, string, string, []string) error) {
+	admin.addEngagement = f
+}
+
+func (admin *AdminPage) SetDelEngagementFunc(f func(string) error) {
+	admin.delEngagement = f
+}
+
+func (admin *AdminPage) SetActivateEngagementFunc(f func(string) error) {
+	admin.activate = f
+}
+
+func (admin *AdminPage) SetGetListenersFunc(f func() ([]*listener.Listener, error)) {
+	admin.getListeners = f
+}
+
+func (admin *AdminPage) SetAddListenerFunc(f func(string, string, string, string, string, bool, bool) error) {
+	admin.addListener = f
+}
+
+func (admin *AdminPage) SetDelListenerFunc(f func(string) error) {
+	admin.delListener = f
+}
+
+func (admin *AdminPage) SetEnableListenerFunc(f func(string, bool) error) {
+	admin.enableListener = f
+}
+
+func (admin *AdminPage) SetGetToolchainFunc(f func() (*agentbuild.Toolchain, error)) {
+	admin.getToolchain = f
+}
+
+func (admin *AdminPage) SetUploadToolchainFunc(f func(string) (*agentbuild.Toolchain, error)) {
+	admin.uploadToolchain = f
+}
+
+func (admin *AdminPage) SetFetchToolchainFunc(f func(string) (*agentbuild.Toolchain, error)) {
+	admin.fetchToolchain = f
+}
+
+func (admin *AdminPage) SetRollbackToolchainFunc(f func() (*agentbuild.Toolchain, error)) {
+	admin.rollback = f
+}
+
+func (admin *AdminPage) SetGetAssetUsageFunc(f func() ([]*agentbuild.AssetUsage, int64, error)) {
+	admin.getAssetUsage = f
+}
+
+func (admin *AdminPage) SetCollectAssetsFunc(f func(string) (int64, error)) {
+	admin.collectAssets = f
+}
+
 func (admin *AdminPage) SetExportOperatorFunc(f func(string, string) (string, error)) {
 	admin.exportOperator = f
 }
 
-func (admin *AdminPage) SetAddOperatorFunc(f func(string, bool, string) (*operator.Operator, error)) {
+func (admin *AdminPage) SetAddOperatorFunc(f func(string, string, string, string) (*operator.Operator, error)) {
 	admin.addOperator = f
 }
 
@@ -302,18 +1251,66 @@ func (admin *AdminPage) SetDelOperatorFunc(f func(string) error) {
 	admin.delOperator = f
 }
 
-func (admin *AdminPage) SetPromoteOperatorFunc(f func(string) error) {
-	admin.promoteOperator = f
-}
-
-func (admin *AdminPage) SetDemoteOperatorFunc(f func(string) error) {
-	admin.demoteOperator = f
+func (admin *AdminPage) SetOperatorRoleFunc(f func(string, string) error) {
+	admin.setOperatorRole = f
 }
 
 func (admin *AdminPage) SetRegenCertFunc(f func(string) error) {
 	admin.regenCert = f
 }
 
+func (admin *AdminPage) SetRenewCertFunc(f func(string) (time.Time, error)) {
+	admin.renewCert = f
+}
+
+func (admin *AdminPage) SetGetEnrollmentsFunc(f func() ([]*operator.Enrollment, error)) {
+	admin.getEnrollments = f
+}
+
+func (admin *AdminPage) SetAddEnrollmentFunc(f func(string, string, string, string, string) (string, error)) {
+	admin.addEnrollment = f
+}
+
+func (admin *AdminPage) SetDelEnrollmentFunc(f func(string) error) {
+	admin.delEnrollment = f
+}
+
+func (admin *AdminPage) SetGetRevokedCertsFunc(f func() ([]*crl.RevokedCertificate, error)) {
+	admin.getRevoked = f
+}
+
+func (admin *AdminPage) SetRevokeCertFunc(f func(string, string) error) {
+	admin.revokeCert = f
+}
+
+func (admin *AdminPage) SetUnrevokeCertFunc(f func(string) error) {
+	admin.unrevokeCert = f
+}
+
+func (admin *AdminPage) SetExportCRLFunc(f func(string) (string, error)) {
+	admin.exportCRL = f
+}
+
+func (admin *AdminPage) SetGetCAsFunc(f func() ([]*certificate.Authority, error)) {
+	admin.getCAs = f
+}
+
+func (admin *AdminPage) SetAddCAFunc(f func(string, string) error) {
+	admin.addCA = f
+}
+
+func (admin *AdminPage) SetRevokeCAFunc(f func(string, string) error) {
+	admin.revokeCA = f
+}
+
+func (admin *AdminPage) SetExportServerStateFunc(f func(string, string) (string, int64, error)) {
+	admin.exportServerState = f
+}
+
+func (admin *AdminPage) SetImportServerStateFunc(f func(string, string) (int64, int64, error)) {
+	admin.importServerState = f
+}
+
 func (admin *AdminPage) SetSwitchbackFunc(f func()) {
 	admin.switchback = f
 }
@@ -339,6 +1336,18 @@ func (admin *AdminPage) ShowError(text string, done func()) {
 	admin.AddPage(modal.GetID(), modal, true, true)
 }
 
+func (admin *AdminPage) ShowText(title string, text string, done func()) {
+	modal := modals.NewTextModal(title, text)
+	modal.SetDoneFunc(func() {
+		admin.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	admin.AddPage(modal.GetID(), modal, true, true)
+}
+
 func (admin *AdminPage) ShowInfo(text string, done func()) {
 	modal := modals.NewInfoModal()
 	modal.SetText(text)
@@ -0,0 +1,285 @@
+package pages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	forms "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
+	modals "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
+	widgets "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
+	"github.com/ttpreport/ligolo-mp/v2/internal/audit"
+)
+
+// auditLimit is how many of the newest actions the page loads
+const auditLimit = 1000
+
+// maxAuditParams is how much of the parameters the table shows, details have all of them
+const maxAuditParams = 80
+
+// AuditPage lists the actions operators took on the server, newest first
+type AuditPage struct {
+	tview.Pages
+
+	table *tview.Table
+
+	data   []*audit.Action
+	filter audit.ActionFilter
+
+	getData    func(audit.ActionFilter) ([]*audit.Action, error)
+	switchback func()
+}
+
+func NewAuditPage() *AuditPage {
+	page := &AuditPage{
+		Pages:  *tview.NewPages(),
+		table:  tview.NewTable(),
+		filter: audit.ActionFilter{Limit: auditLimit},
+	}
+
+	page.table.SetSelectable(true, false)
+	page.table.SetBackgroundColor(style.BgColor)
+	page.table.SetTitle(fmt.Sprintf("[::b]%s", strings.ToUpper("audit log")))
+	page.table.SetBorderColor(style.BorderColor)
+	page.table.SetTitleColor(style.FgColor)
+	page.table.SetBorder(true)
+
+	page.table.SetSelectedFunc(func(id, _ int) {
+		if id < 1 || id > len(page.data) {
+			return
+		}
+
+		page.ShowText("Action", formatAction(page.data[id-1]), nil)
+	})
+
+	page.AddAndSwitchToPage("main", page.table, true)
+
+	return page
+}
+
+func (page *AuditPage) Reset() {
+	for _, name := range page.GetPageNames(false) {
+		page.RemovePage(name)
+	}
+
+	page.data = nil
+	page.filter = audit.ActionFilter{Limit: auditLimit}
+	page.table.Clear()
+	page.AddAndSwitchToPage("main", page.table, true)
+}
+
+func (page *AuditPage) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		key := event.Key()
+
+		if page.table.HasFocus() {
+			switch key {
+			case tcell.KeyCtrlU:
+				page.switchback()
+			case tcell.KeyCtrlR:
+				page.RefreshData()
+			case tcell.KeyCtrlL:
+				page.showFilter()
+			default:
+				defaultHandler := page.Pages.InputHandler()
+				defaultHandler(event, setFocus)
+			}
+		} else {
+			switch key {
+			case tcell.KeyEscape:
+				if page.GetPageCount() > 1 {
+					frontPage, _ := page.GetFrontPage()
+					page.RemovePage(frontPage)
+				}
+			default:
+				defaultHandler := page.Pages.InputHandler()
+				defaultHandler(event, setFocus)
+			}
+		}
+	}
+}
+
+func (page *AuditPage) GetID() string {
+	return "audit"
+}
+
+func (page *AuditPage) SetDataFunc(f func(audit.ActionFilter) ([]*audit.Action, error)) {
+	page.getData = f
+}
+
+func (page *AuditPage) SetSwitchbackFunc(f func()) {
+	page.switchback = f
+}
+
+func (page *AuditPage) showFilter() {
+	form := forms.NewAuditFilterForm()
+	form.SetSubmitFunc(func(operator string, method string, query string, since string, until string, failed bool) {
+		filter := audit.ActionFilter{
+			Operator: operator,
+			Method:   method,
+			Query:    query,
+			Failed:   failed,
+			Limit:    auditLimit,
+		}
+
+		var err error
+		if filter.Since, err = parseAuditTime(since, false); err != nil {
+			page.ShowError(fmt.Sprintf("Invalid since: %s", err), nil)
+			return
+		}
+		if filter.Until, err = parseAuditTime(until, true); err != nil {
+			page.ShowError(fmt.Sprintf("Invalid until: %s", err), nil)
+			return
+		}
+
+		page.RemovePage(form.GetID())
+		page.filter = filter
+		page.RefreshData()
+	})
+	form.SetCancelFunc(func() {
+		page.RemovePage(form.GetID())
+	})
+	page.AddPage(form.GetID(), form, true, true)
+}
+
+// parseAuditTime reads a day or a minute in local time, a day alone ends at its last moment when it's an upper bound
+func parseAuditTime(value string, end bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02 15:04", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("'%s' is neither YYYY-MM-DD nor YYYY-MM-DD HH:MM", value)
+	}
+
+	if end {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	return t, nil
+}
+
+func formatAction(action *audit.Action) string {
+	result := "OK"
+	if action.Error != "" {
+		result = action.Error
+	}
+
+	lines := []string{
+		fmt.Sprintf("Time: %s", action.Time.Local().Format(time.DateTime)),
+		fmt.Sprintf("Operator: %s (%s)", action.Operator, action.Role),
+		fmt.Sprintf("Action: %s", action.Method),
+		fmt.Sprintf("Result: %s", result),
+	}
+	if action.Engagement != "" {
+		lines = append(lines, fmt.Sprintf("Engagement: %s", action.Engagement))
+	}
+	lines = append(lines, "", "Parameters:", tview.Escape(action.Params))
+
+	return strings.Join(lines, "\n")
+}
+
+// RefreshData reloads the actions matching the filter from the server
+func (page *AuditPage) RefreshData() {
+	page.DoWithLoader("Loading audit log...", func() {
+		data, err := page.getData(page.filter)
+		if err != nil {
+			page.ShowError(fmt.Sprintf("Could not load audit log: %s", err), nil)
+			return
+		}
+		page.data = data
+
+		page.table.Clear()
+
+		headers := []string{"Time", "Operator", "Role", "Action", "Parameters", "Result"}
+		for i := 0; i < len(headers); i++ {
+			header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
+			page.table.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
+		}
+
+		for i, action := range page.data {
+			rowIdx := i + 1
+
+			params := action.Params
+			if len(params) > maxAuditParams {
+				params = params[:maxAuditParams] + "…"
+			}
+
+			result := tview.NewTableCell("OK")
+			if action.Error != "" {
+				result = tview.NewTableCell(tview.Escape(action.Error)).SetTextColor(tcell.ColorRed)
+			}
+
+			page.table.SetCell(rowIdx, 0, tview.NewTableCell(action.Time.Local().Format(time.DateTime)))
+			page.table.SetCell(rowIdx, 1, tview.NewTableCell(action.Operator))
+			page.table.SetCell(rowIdx, 2, tview.NewTableCell(action.Role))
+			page.table.SetCell(rowIdx, 3, tview.NewTableCell(action.Method))
+			page.table.SetCell(rowIdx, 4, tview.NewTableCell(tview.Escape(params)))
+			page.table.SetCell(rowIdx, 5, result)
+		}
+	})
+}
+
+func (page *AuditPage) Help() help.Doc {
+	return help.Doc{
+		Title:   "Audit log",
+		Summary: "Every action operators took on the server that changes something, newest first, with its parameters and whether it succeeded. Refused actions are listed too. Keys and passwords are redacted and file contents are reduced to their size. Only the newest 1000 matching actions are loaded, narrow the filter down to go further back.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "all details of the action"},
+			{Name: "Ctrl+L", Description: "filter by operator, action, parameters, time or failure"},
+		},
+	}
+}
+
+func (page *AuditPage) GetNavBar() []widgets.NavBarElem {
+	return []widgets.NavBarElem{
+		widgets.NewNavBarElem(tcell.KeyCtrlU, "Back"),
+		widgets.NewNavBarElem(tcell.KeyCtrlR, "Refresh"),
+		widgets.NewNavBarElem(tcell.KeyCtrlL, "Filter"),
+		widgets.NewNavBarElem(tcell.KeyEnter, "Select"),
+	}
+}
+
+func (page *AuditPage) DoWithLoader(text string, action func()) {
+	go func() {
+		modal := modals.NewLoaderModal()
+		modal.SetText(text)
+		page.AddPage(modal.GetID(), modal, true, true)
+		action()
+		page.RemovePage(modal.GetID())
+	}()
+}
+
+func (page *AuditPage) ShowError(text string, done func()) {
+	modal := modals.NewErrorModal()
+	modal.SetText(text)
+	modal.SetDoneFunc(func(_ int, _ string) {
+		page.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	page.AddPage(modal.GetID(), modal, true, true)
+}
+
+func (page *AuditPage) ShowText(title string, text string, done func()) {
+	modal := modals.NewTextModal(title, text)
+	modal.SetDoneFunc(func() {
+		page.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	page.AddPage(modal.GetID(), modal, true, true)
+}
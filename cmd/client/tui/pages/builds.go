@@ -0,0 +1,352 @@
+package pages
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	forms "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
+	modals "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	widgets "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+// BuildsPage lists the agents built on the server, the ones kept there can be downloaded again or rebuilt
+type BuildsPage struct {
+	tview.Pages
+
+	table *tview.Table
+
+	data []*agentbuild.AgentBuild
+
+	getData    func() ([]*agentbuild.AgentBuild, error)
+	download   func(id string, path string) (string, error)
+	regenerate func(ctx context.Context, progress func(string), id string, key string, path string) (string, *agentbuild.AgentBuild, error)
+	recipe     func(ctx context.Context, progress func(string), recipePath string, key string, path string) (string, int, error)
+	switchback func()
+}
+
+func NewBuildsPage() *BuildsPage {
+	page := &BuildsPage{
+		Pages: *tview.NewPages(),
+		table: tview.NewTable(),
+	}
+
+	page.table.SetSelectable(true, false)
+	page.table.SetBackgroundColor(style.BgColor)
+	page.table.SetTitle(fmt.Sprintf("[::b]%s", strings.ToUpper("agent builds")))
+	page.table.SetBorderColor(style.BorderColor)
+	page.table.SetTitleColor(style.FgColor)
+	page.table.SetBorder(true)
+
+	page.initBuilds()
+
+	page.AddAndSwitchToPage("main", page.table, true)
+
+	return page
+}
+
+func (page *BuildsPage) Reset() {
+	for _, name := range page.GetPageNames(false) {
+		page.RemovePage(name)
+	}
+
+	page.data = nil
+	page.table.Clear()
+	page.AddAndSwitchToPage("main", page.table, true)
+}
+
+func (page *BuildsPage) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		key := event.Key()
+
+		if page.table.HasFocus() {
+			switch key {
+			case tcell.KeyCtrlB:
+				page.switchback()
+			case tcell.KeyCtrlR:
+				page.RefreshData()
+			case tcell.KeyCtrlN:
+				page.buildRecipe()
+			default:
+				defaultHandler := page.Pages.InputHandler()
+				defaultHandler(event, setFocus)
+			}
+		} else {
+			switch key {
+			case tcell.KeyEscape:
+				if page.GetPageCount() > 1 {
+					frontPage, _ := page.GetFrontPage()
+					page.RemovePage(frontPage)
+				}
+			default:
+				defaultHandler := page.Pages.InputHandler()
+				defaultHandler(event, setFocus)
+			}
+		}
+	}
+}
+
+func (page *BuildsPage) GetID() string {
+	return "builds"
+}
+
+func (page *BuildsPage) GetElem(id int) *agentbuild.AgentBuild {
+	if id >= 0 && id < len(page.data) {
+		return page.data[id]
+	}
+
+	return nil
+}
+
+func (page *BuildsPage) SetDataFunc(f func() ([]*agentbuild.AgentBuild, error)) {
+	page.getData = f
+}
+
+func (page *BuildsPage) SetDownloadFunc(f func(id string, path string) (string, error)) {
+	page.download = f
+}
+
+func (page *BuildsPage) SetRegenerateFunc(f func(ctx context.Context, progress func(string), id string, key string, path string) (string, *agentbuild.AgentBuild, error)) {
+	page.regenerate = f
+}
+
+func (page *BuildsPage) SetRecipeFunc(f func(ctx context.Context, progress func(string), recipePath string, key string, path string) (string, int, error)) {
+	page.recipe = f
+}
+
+func (page *BuildsPage) SetSwitchbackFunc(f func()) {
+	page.switchback = f
+}
+
+func (page *BuildsPage) initBuilds() {
+	page.table.SetSelectedFunc(func(id, _ int) {
+		build := page.GetElem(id - 1)
+		if build == nil {
+			return
+		}
+
+		menu := modals.NewMenuModal(fmt.Sprintf("Build — %s", build.ID))
+		cleanup := func() {
+			page.RemovePage(menu.GetID())
+		}
+		menu.SetCancelFunc(cleanup)
+
+		menu.AddItem(modals.NewMenuModalElem("Details", func() {
+			page.ShowText("Agent build", build.String(), nil)
+		}))
+
+		if build.Stored {
+			menu.AddItem(modals.NewMenuModalElem("Download", func() {
+				save := forms.NewSaveBuildForm("Download agent", false)
+				save.SetSubmitFunc(func(path string, _ string) {
+					page.DoWithLoader("Downloading agent...", func() {
+						fullPath, err := page.download(build.ID, path)
+						if err != nil {
+							page.ShowError(fmt.Sprintf("Could not download agent: %s", err), nil)
+							return
+						}
+
+						page.RemovePage(save.GetID())
+						page.ShowInfo(fmt.Sprintf("Agent binary saved to %s", fullPath), cleanup)
+					})
+				})
+				save.SetCancelFunc(func() {
+					page.RemovePage(save.GetID())
+				})
+				page.AddPage(save.GetID(), save, true, true)
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Regenerate", func() {
+				save := forms.NewSaveBuildForm("Regenerate agent", build.KeySource == "passphrase")
+				save.SetSubmitFunc(func(path string, key string) {
+					go func() {
+						ctx, cancel := context.WithCancel(context.Background())
+						defer cancel()
+
+						// closing the stream cancels the build on the server
+						loader := modals.NewLoaderModal()
+						loader.SetText("Regenerating agent...")
+						loader.AddButtons([]string{"Cancel"})
+						loader.SetDoneFunc(func(_ int, _ string) {
+							cancel()
+						})
+						page.AddPage(loader.GetID(), loader, true, true)
+
+						fullPath, rebuilt, err := page.regenerate(ctx, func(progress string) {
+							loader.SetText(fmt.Sprintf("Regenerating agent...\n\n%s", progress))
+						}, build.ID, key, path)
+						page.RemovePage(loader.GetID())
+						if err != nil {
+							if ctx.Err() != nil {
+								return
+							}
+
+							page.ShowError(fmt.Sprintf("Could not regenerate agent: %s", err), nil)
+							return
+						}
+
+						page.RemovePage(save.GetID())
+						cleanup()
+						page.ShowInfo(fmt.Sprintf("Agent binary saved to %s\n\nBuild ID: %s", fullPath, rebuilt.ID), page.RefreshData)
+					}()
+				})
+				save.SetCancelFunc(func() {
+					page.RemovePage(save.GetID())
+				})
+				page.AddPage(save.GetID(), save, true, true)
+			}))
+		}
+
+		page.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+func (page *BuildsPage) buildRecipe() {
+	form := forms.NewRecipeForm()
+	form.SetSubmitFunc(func(recipePath string, path string, key string) {
+		go func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			loader := modals.NewLoaderModal()
+			loader.SetText("Building recipe...")
+			loader.AddButtons([]string{"Cancel"})
+			loader.SetDoneFunc(func(_ int, _ string) {
+				cancel()
+			})
+			page.AddPage(loader.GetID(), loader, true, true)
+
+			fullPath, count, err := page.recipe(ctx, func(progress string) {
+				loader.SetText(fmt.Sprintf("Building recipe...\n\n%s", progress))
+			}, recipePath, key, path)
+			page.RemovePage(loader.GetID())
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				page.ShowError(fmt.Sprintf("Could not build recipe: %s", err), nil)
+				return
+			}
+
+			page.RemovePage(form.GetID())
+			page.ShowInfo(fmt.Sprintf("%d agents saved to %s", count, fullPath), page.RefreshData)
+		}()
+	})
+	form.SetCancelFunc(func() {
+		page.RemovePage(form.GetID())
+	})
+	page.AddPage(form.GetID(), form, true, true)
+}
+
+// RefreshData reloads the builds from the server
+func (page *BuildsPage) RefreshData() {
+	page.DoWithLoader("Loading agent builds...", func() {
+		data, err := page.getData()
+		if err != nil {
+			page.ShowError(fmt.Sprintf("Could not load agent builds: %s", err), nil)
+			return
+		}
+		page.data = data
+
+		page.table.Clear()
+
+		headers := []string{"ID", "Built", "Operator", "Campaign", "Target", "Format", "Kept"}
+		for i := 0; i < len(headers); i++ {
+			header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
+			page.table.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
+		}
+
+		for i, build := range page.data {
+			rowIdx := i + 1
+
+			format := build.Format
+			if build.Staged {
+				format += " (staged)"
+			}
+
+			page.table.SetCell(rowIdx, 0, tview.NewTableCell(build.ID))
+			page.table.SetCell(rowIdx, 1, tview.NewTableCell(build.Created.Format(time.DateTime)))
+			page.table.SetCell(rowIdx, 2, tview.NewTableCell(build.Operator))
+			page.table.SetCell(rowIdx, 3, tview.NewTableCell(build.Campaign))
+			page.table.SetCell(rowIdx, 4, tview.NewTableCell(fmt.Sprintf("%s/%s", build.GOOS, build.GOARCH)))
+			page.table.SetCell(rowIdx, 5, tview.NewTableCell(format))
+			page.table.SetCell(rowIdx, 6, tview.NewTableCell(utils.HumanBool(build.Stored)))
+		}
+	})
+}
+
+func (page *BuildsPage) Help() help.Doc {
+	return help.Doc{
+		Title:   "Agent builds",
+		Summary: "Every agent built on the server, newest first. Builds that were kept can be downloaded again or regenerated with the same parameters, regenerated agents get a new build ID and certificate.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "build menu: details, download, regenerate"},
+			{Name: "Ctrl+N", Description: "build every agent of a YAML recipe into one archive"},
+		},
+	}
+}
+
+func (page *BuildsPage) GetNavBar() []widgets.NavBarElem {
+	return []widgets.NavBarElem{
+		widgets.NewNavBarElem(tcell.KeyCtrlB, "Back"),
+		widgets.NewNavBarElem(tcell.KeyCtrlR, "Refresh"),
+		widgets.NewNavBarElem(tcell.KeyCtrlN, "Recipe"),
+		widgets.NewNavBarElem(tcell.KeyEnter, "Select"),
+	}
+}
+
+func (page *BuildsPage) DoWithLoader(text string, action func()) {
+	go func() {
+		modal := modals.NewLoaderModal()
+		modal.SetText(text)
+		page.AddPage(modal.GetID(), modal, true, true)
+		action()
+		page.RemovePage(modal.GetID())
+	}()
+}
+
+func (page *BuildsPage) ShowError(text string, done func()) {
+	modal := modals.NewErrorModal()
+	modal.SetText(text)
+	modal.SetDoneFunc(func(_ int, _ string) {
+		page.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	page.AddPage(modal.GetID(), modal, true, true)
+}
+
+func (page *BuildsPage) ShowInfo(text string, done func()) {
+	modal := modals.NewInfoModal()
+	modal.SetText(text)
+	modal.SetDoneFunc(func(_ int, _ string) {
+		page.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	page.AddPage(modal.GetID(), modal, true, true)
+}
+
+func (page *BuildsPage) ShowText(title string, text string, done func()) {
+	modal := modals.NewTextModal(title, text)
+	modal.SetDoneFunc(func() {
+		page.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	page.AddPage(modal.GetID(), modal, true, true)
+}
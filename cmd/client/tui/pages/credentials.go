@@ -3,13 +3,14 @@ package pages
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	forms "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	modals "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
-	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	widgets "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
 )
@@ -25,6 +26,8 @@ type CredentialsPage struct {
 	newCred    func(path string) error
 	deleteCred func(*operator.Operator) error
 	connect    func(*operator.Operator) error
+	renew      func(*operator.Operator) error
+	enroll     func(code string) (*operator.Operator, error)
 }
 
 func NewCredentialsPage() *CredentialsPage {
@@ -80,6 +83,27 @@ func (creds *CredentialsPage) InputHandler() func(event *tcell.EventKey, setFocu
 					})
 					creds.AddPage(filepicker.GetID(), filepicker, true, true)
 				}
+			case tcell.KeyCtrlE:
+				if creds.enroll != nil {
+					form := forms.NewEnrollForm()
+					form.SetSubmitFunc(func(code string) {
+						creds.DoWithLoader("Enrolling...", func() {
+							oper, err := creds.enroll(code)
+							if err != nil {
+								creds.ShowError(fmt.Sprintf("Could not enroll: %s", err), nil)
+								return
+							}
+
+							creds.RemovePage(form.GetID())
+							creds.ShowInfo(fmt.Sprintf("Enrolled as %s on %s", oper.Name, oper.Server), nil)
+							creds.RefreshData()
+						})
+					})
+					form.SetCancelFunc(func() {
+						creds.RemovePage(form.GetID())
+					})
+					creds.AddPage(form.GetID(), form, true, true)
+				}
 			default:
 				defaultHandler := creds.Pages.InputHandler()
 				defaultHandler(event, setFocus)
@@ -127,6 +151,14 @@ func (creds *CredentialsPage) SetConnectFunc(f func(*operator.Operator) error) {
 	creds.connect = f
 }
 
+func (creds *CredentialsPage) SetRenewFunc(f func(*operator.Operator) error) {
+	creds.renew = f
+}
+
+func (creds *CredentialsPage) SetEnrollFunc(f func(code string) (*operator.Operator, error)) {
+	creds.enroll = f
+}
+
 func (creds *CredentialsPage) initCredentials() {
 	creds.table.SetSelectedFunc(func(id, _ int) {
 		oper := creds.GetElem(id - 1)
@@ -149,6 +181,18 @@ func (creds *CredentialsPage) initCredentials() {
 				})
 			}))
 
+			menu.AddItem(modals.NewMenuModalElem("Renew certificate", func() {
+				creds.DoWithLoader("Renewing certificate...", func() {
+					if err := creds.renew(oper); err != nil {
+						creds.ShowError(fmt.Sprintf("Could not renew certificate: %s", err), cleanup)
+						return
+					}
+
+					creds.ShowInfo(fmt.Sprintf("Certificate renewed until %s", oper.Cert.ExpiryDate().Local().Format(time.DateOnly)), cleanup)
+					creds.RefreshData()
+				})
+			}))
+
 			menu.AddItem(modals.NewMenuModalElem("Remove", func() {
 				creds.DoWithConfirm("Are you sure?", func() {
 					creds.DoWithLoader("Removing credentials...", func() {
@@ -171,7 +215,7 @@ func (creds *CredentialsPage) initCredentials() {
 func (creds *CredentialsPage) RefreshData() {
 	creds.table.Clear()
 
-	headers := []string{"Login", "Server", "Is Admin"}
+	headers := []string{"Login", "Server", "Role", "Cert expires"}
 	for i := 0; i < len(headers); i++ {
 		header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
 		creds.table.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
@@ -192,11 +236,38 @@ func (creds *CredentialsPage) RefreshData() {
 		rowIdx := i + 1
 		name := creds.data[i].Name
 		server := creds.data[i].Server
-		isAdmin := utils.HumanBool(creds.data[i].IsAdmin)
+		role := creds.data[i].GetRole()
 
 		creds.table.SetCell(rowIdx, 0, tview.NewTableCell(name))
 		creds.table.SetCell(rowIdx, 1, tview.NewTableCell(server))
-		creds.table.SetCell(rowIdx, 2, tview.NewTableCell(isAdmin))
+		creds.table.SetCell(rowIdx, 2, tview.NewTableCell(role))
+		creds.table.SetCell(rowIdx, 3, certExpiryCell(creds.data[i]))
+	}
+}
+
+// certExpiryCell shows when the certificate of the credentials expires, highlighted once it should be renewed
+func certExpiryCell(oper *operator.Operator) *tview.TableCell {
+	if oper.Cert == nil {
+		return tview.NewTableCell("")
+	}
+
+	cell := tview.NewTableCell(oper.Cert.ExpiryDate().Local().Format(time.DateOnly))
+	if oper.CertExpiresWithin(operator.CertRenewalWindow) {
+		cell.SetTextColor(tcell.ColorRed)
+	}
+
+	return cell
+}
+
+func (creds *CredentialsPage) Help() help.Doc {
+	return help.Doc{
+		Title:   "Credentials",
+		Summary: "Operator credentials stored on this machine, select one to connect to its server. Certificates expiring soon show in red, renew them from the menu before they expire, the server refuses them afterwards.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "credentials menu: connect, renew certificate, remove"},
+			{Name: "Ctrl+N", Description: "import an operator file"},
+			{Name: "Ctrl+E", Description: "enroll with a one-time code from an admin"},
+		},
 	}
 }
 
@@ -204,6 +275,7 @@ func (creds *CredentialsPage) GetNavBar() []widgets.NavBarElem {
 	return []widgets.NavBarElem{
 		widgets.NewNavBarElem(tcell.KeyEnter, "Select"),
 		widgets.NewNavBarElem(tcell.KeyCtrlN, "Add new"),
+		widgets.NewNavBarElem(tcell.KeyCtrlE, "Enroll"),
 	}
 }
 
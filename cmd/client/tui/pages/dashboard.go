@@ -1,18 +1,32 @@
 package pages
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
 	route_forms "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms/route"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	modals "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	widgets "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/attachment"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	"github.com/ttpreport/ligolo-mp/v2/internal/route"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
 )
 
 type DashboardPage struct {
@@ -20,29 +34,80 @@ type DashboardPage struct {
 
 	setFocus    func(tview.Primitive)
 	flex        *tview.Flex
+	rows        []*tview.Flex
 	server      *widgets.ServerWidget
 	sessions    *widgets.SessionsWidget
 	interfaces  *widgets.InterfacesWidget
+	hostInfo    *widgets.HostInfoWidget
 	routes      *widgets.RoutesWidget
 	redirectors *widgets.RedirectorsWidget
 
-	data []*session.Session
+	data         []*session.Session
+	filter       string // the panes only show matching sessions and routes, see session.Filter
+	serverConfig *config.Config
 
 	fetchData                   func() ([]*session.Session, error)
 	getMetadata                 func() (*config.Config, *operator.Operator, error)
 	adminFunc                   func()
-	generateFunc                func(path string, servers string, goos string, goarch string, obfuscate bool, proxy string, ignoreEnvProxy bool) (string, error)
+	replayFunc                  func()
+	buildsFunc                  func()
+	auditFunc                   func()
+	exportStateFunc             func(string) (string, error)
+	templatesFunc               func() ([]*agentbuild.Template, error)
+	signingKeysFunc             func() ([]*agentbuild.SigningKey, error)
+	buildHooksFunc              func() ([]*agentbuild.Hook, error)
+	transportProfilesFunc       func() ([]*transport.Profile, error)
+	renderFunc                  func(redact bool, path string, servers string, goos string, goarch string, format string, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, template string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string) (string, string, error)
+	generateFunc                func(ctx context.Context, progress func(string), path string, servers string, goos string, goarch string, format string, staged bool, exec bool, persist bool, hostInfo bool, loader bool, override bool, obfuscate bool, garble gogo.GarbleOptions, resources forms.WindowsResources, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, campaign string, template string, signingKey string, hooks []string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string) (string, *agentbuild.AgentBuild, error)
+	lookupBuildFunc             func(string) ([]*agentbuild.AgentBuild, error)
 	sessionStartFunc            func(*session.Session) error
 	sessionStopFunc             func(*session.Session) error
 	sessionRenameFunc           func(*session.Session, string) error
-	sessionAddRouteFunc         func(*session.Session, string, int, bool) error
-	sessionEditRouteFunc        func(*session.Session, string, string, int, bool) error
+	annotateFunc                func(*session.Session, string, []string, string) error
+	sessionAddRouteFunc         func(*session.Session, string, int, bool, string, bool) error
+	sessionEditRouteFunc        func(*session.Session, string, string, int, bool, string, bool) error
 	sessionMoveRouteFunc        func(*session.Session, string, string) error
 	sessionRemoveRouteFunc      func(*session.Session, string) error
-	sessionAddRedirectorFunc    func(*session.Session, string, string, string) error
+	sessionAddRedirectorFunc    func(*session.Session, string, string, string, string, bool) error
 	sessionRemoveRedirectorFunc func(*session.Session, string) error
 	sessionRemoveFunc           func(*session.Session) error
+	sessionKillAgentFunc        func(*session.Session) error
+	sessionBurnFunc             func(*session.Session) ([]string, []string, error)
+	sessionRevokeCertFunc       func(*session.Session) error
 	tracerouteFunc              func(string) ([]string, error)
+	sessionThroughputFunc       func(*session.Session) (int64, time.Duration, error)
+	sessionSpoofSourceFunc      func(*session.Session, bool) error
+	sessionCompressionFunc      func(*session.Session, string, string) error
+	sessionMirrorFunc           func(*session.Session, string) error
+	sessionBeaconFunc           func(*session.Session, time.Duration, uint8) error
+	sessionWorkingHoursFunc     func(*session.Session, string) error
+	sessionRefreshNetworkFunc   func(*session.Session) ([]string, error)
+	sessionRefreshHostInfoFunc  func(*session.Session) error
+	sessionHandoffFunc          func(*session.Session, string, string, bool) error
+	sessionAnswerHandoffFunc    func(*session.Session, bool) error
+	sessionClaimFunc            func(*session.Session, bool, bool) error
+	sessionWakeFunc             func(*session.Session, bool) error
+	sessionSocksFunc            func(*session.Session, string, string, string) error
+	listFilesFunc               func(*session.Session, string) (string, []session.FileEntry, error)
+	downloadFileFunc            func(context.Context, func(int64, int64), *session.Session, string, string, bool) (string, string, error)
+	uploadFileFunc              func(context.Context, func(int64, int64), *session.Session, string, string, bool) (string, error)
+	sessionExecFunc             func(context.Context, func(bool, []byte), *session.Session, string, time.Duration) (int, error)
+	sessionSweepFunc            func(context.Context, func(protocol.SweepResultPacket), *session.Session, []string, []uint16, time.Duration) (protocol.SweepDonePacket, error)
+	sessionPersistFunc          func(*session.Session, string, bool) (string, error)
+	sessionBreakoutFunc         func(*session.Session, []protocol.BreakoutRule) error
+	sessionBandwidthFunc        func(*session.Session, int64) error
+	sessionDiagnoseFunc         func(*session.Session) ([]protocol.DiagnosticCheck, error)
+	agentBuildsFunc             func() ([]*agentbuild.AgentBuild, error)
+	updateAgentFunc             func(*session.Session, string) error
+	rotateAgentCertFunc         func(*session.Session) (string, error)
+	sessionApplyProfileFunc     func(*session.Session, string) error
+	routeProfilesFunc           func() ([]*profile.Profile, error)
+	saveRouteProfileFunc        func(*profile.Profile) error
+	removeRouteProfileFunc      func(string) error
+	attachmentsFunc             func(*session.Session) ([]*attachment.Attachment, error)
+	addAttachmentFunc           func(*session.Session, string, string) error
+	downloadAttachmentFunc      func(*attachment.Attachment, string) (string, error)
+	removeAttachmentFunc        func(*attachment.Attachment) error
 
 	operator *operator.Operator
 }
@@ -54,6 +119,7 @@ func NewDashboardPage() *DashboardPage {
 		server:      widgets.NewServerWidget(),
 		sessions:    widgets.NewSessionsWidget(),
 		interfaces:  widgets.NewInterfacesWidget(),
+		hostInfo:    widgets.NewHostInfoWidget(),
 		routes:      widgets.NewRoutesWidget(),
 		redirectors: widgets.NewRedirectorsWidget(),
 	}
@@ -64,14 +130,17 @@ func NewDashboardPage() *DashboardPage {
 
 	firstRow := tview.NewFlex()
 	firstRow.SetDirection(tview.FlexColumn)
-	firstRow.AddItem(dash.sessions, 0, 65, true)
-	firstRow.AddItem(dash.interfaces, 0, 35, false)
+	firstRow.AddItem(dash.sessions, 0, 50, true)
+	firstRow.AddItem(dash.hostInfo, 0, 20, false)
+	firstRow.AddItem(dash.interfaces, 0, 30, false)
 
 	secondRow := tview.NewFlex()
 	secondRow.SetDirection(tview.FlexColumn)
 	secondRow.AddItem(dash.routes, 0, 50, false)
 	secondRow.AddItem(dash.redirectors, 0, 50, false)
 
+	dash.rows = []*tview.Flex{firstRow, secondRow}
+
 	dash.flex.SetDirection(tview.FlexRow)
 	dash.flex.AddItem(dash.server, 3, 0, false)
 	dash.flex.AddItem(firstRow, 0, 50, true)
@@ -90,9 +159,15 @@ func (dash *DashboardPage) Reset() {
 	dash.AddAndSwitchToPage("main", dash.flex, true)
 }
 
+// ApplyLayout stacks sessions, host info, interfaces, routes and redirectors in the compact preset
+func (dash *DashboardPage) ApplyLayout() {
+	utils.LayoutPanes(dash.rows...)
+}
+
 func (dash *DashboardPage) initSessionsWidget() {
 	dash.sessions.SetSelectionChangedFunc(func(sess *session.Session) {
 		dash.sessions.SetSelectedSession(sess)
+		dash.hostInfo.SetSelectedSession(sess)
 		dash.interfaces.SetSelectedSession(sess)
 		dash.routes.SetSelectedSession(sess)
 		dash.redirectors.SetSelectedSession(sess)
@@ -118,6 +193,10 @@ func (dash *DashboardPage) initSessionsWidget() {
 					dash.ShowInfo("Relay stopped", cleanup)
 				})
 			}))
+		} else if dash.serverConfig != nil && dash.serverConfig.TunError != "" {
+			menu.AddItem(modals.NewMenuModalElem("Start relay (unavailable)", func() {
+				dash.ShowError(fmt.Sprintf("The server can't create TUN interfaces (%s), use redirectors or the SOCKS5 service instead", dash.serverConfig.TunError), cleanup)
+			}))
 		} else {
 			menu.AddItem(modals.NewMenuModalElem("Start relay", func() {
 				dash.DoWithLoader("Starting relay...", func() {
@@ -132,6 +211,545 @@ func (dash *DashboardPage) initSessionsWidget() {
 			}))
 		}
 
+		if sess.IsConnected {
+			menu.AddItem(modals.NewMenuModalElem("Throughput test", func() {
+				dash.DoWithLoader("Measuring throughput...", func() {
+					received, elapsed, err := dash.sessionThroughputFunc(sess)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not measure throughput: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo(fmt.Sprintf("Received %s in %s (%s)", utils.HumanBytes(received), elapsed.Round(time.Millisecond), utils.HumanBitrate(received, elapsed)), cleanup)
+				})
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Link details", func() {
+				link := sess.Link
+				bandwidth := "not measured"
+				if link.Bandwidth > 0 {
+					bandwidth = utils.HumanBitrate(link.Bandwidth, time.Second)
+				}
+
+				version := fmt.Sprintf("v%d", sess.Protocol)
+				if sess.Outdated {
+					version += " (agent outdated, rebuild it to get every feature)"
+				}
+
+				compression := "unsupported by the agent"
+				if sess.Compression != protocol.CompressionNone {
+					compression = fmt.Sprintf("%s, off unless a route turns it on", protocol.CompressionName(sess.Compression))
+					if sess.Tun.Compress {
+						compression = fmt.Sprintf("%s, on unless a route turns it off", protocol.CompressionName(sess.Compression))
+					}
+				}
+
+				dash.ShowInfo(fmt.Sprintf("RTT: %s\nBandwidth at connect: %s\nStream window: %s\nKeepalive: %s\nProtocol: %s\nCompression: %s", link.RTT.Round(time.Microsecond), bandwidth, utils.HumanBytes(int64(link.WindowSize)), link.KeepAlive, version, compression), cleanup)
+			}))
+		}
+
+		if sess.Tun.SpoofSource {
+			menu.AddItem(modals.NewMenuModalElem("Disable source spoofing", func() {
+				dash.DoWithLoader("Disabling source spoofing...", func() {
+					err := dash.sessionSpoofSourceFunc(sess, false)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not disable source spoofing: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo("Source spoofing disabled", cleanup)
+				})
+			}))
+		} else {
+			menu.AddItem(modals.NewMenuModalElem("Enable source spoofing", func() {
+				dash.DoWithLoader("Enabling source spoofing...", func() {
+					err := dash.sessionSpoofSourceFunc(sess, true)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not enable source spoofing: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo("Source spoofing enabled. Agents without raw socket privileges will connect with their own address.", cleanup)
+				})
+			}))
+		}
+
+		if sess.Compression != protocol.CompressionNone {
+			if sess.Tun.Compress {
+				menu.AddItem(modals.NewMenuModalElem("Disable compression", func() {
+					dash.DoWithLoader("Disabling compression...", func() {
+						err := dash.sessionCompressionFunc(sess, "", route.CompressionOff)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not disable compression: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo("Compression disabled", cleanup)
+					})
+				}))
+			} else {
+				menu.AddItem(modals.NewMenuModalElem("Enable compression", func() {
+					dash.DoWithLoader("Enabling compression...", func() {
+						err := dash.sessionCompressionFunc(sess, "", route.CompressionOn)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not enable compression: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo("Compression enabled for new TCP connections. Routes to encrypted services are best turned off, their traffic doesn't compress.", cleanup)
+					})
+				}))
+			}
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Mirror traffic", func() {
+			mir := forms.NewMirrorForm(sess.Tun.Mirror)
+			mir.SetSubmitFunc(func(target string) {
+				dash.DoWithLoader("Configuring mirror...", func() {
+					err := dash.sessionMirrorFunc(sess, target)
+					if err != nil {
+						dash.RemovePage(mir.GetID())
+						dash.ShowError(fmt.Sprintf("Could not configure mirror: %s", err), cleanup)
+						return
+					}
+
+					dash.RemovePage(mir.GetID())
+					if target == "" {
+						dash.ShowInfo("Mirroring stopped", cleanup)
+					} else {
+						dash.ShowInfo(fmt.Sprintf("Mirroring traffic to %s", target), cleanup)
+					}
+				})
+			})
+			mir.SetCancelFunc(func() {
+				dash.RemovePage(mir.GetID())
+				cleanup()
+			})
+			dash.AddPage(mir.GetID(), mir, true, true)
+		}))
+
+		if sess.Beacon.Interval > 0 {
+			if sess.Beacon.Awake {
+				menu.AddItem(modals.NewMenuModalElem("Send to sleep", func() {
+					dash.DoWithLoader("Sending to sleep...", func() {
+						err := dash.sessionWakeFunc(sess, false)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not send to sleep: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo(fmt.Sprintf("Agent is asleep, next check-in within %s", sess.Beacon.Interval), cleanup)
+					})
+				}))
+			} else {
+				menu.AddItem(modals.NewMenuModalElem("Wake up", func() {
+					dash.DoWithLoader("Waking up...", func() {
+						err := dash.sessionWakeFunc(sess, true)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not wake up: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo("The tunnel stays up from the next check-in on", cleanup)
+					})
+				}))
+			}
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Beacon", func() {
+			bcn := forms.NewBeaconForm(sess.Beacon.Interval, sess.Beacon.Jitter)
+			bcn.SetSubmitFunc(func(interval time.Duration, jitter uint8, err error) {
+				if err != nil {
+					dash.RemovePage(bcn.GetID())
+					dash.ShowError(fmt.Sprintf("Invalid beacon settings: %s", err), cleanup)
+					return
+				}
+
+				dash.DoWithLoader("Configuring beacon...", func() {
+					err := dash.sessionBeaconFunc(sess, interval, jitter)
+					dash.RemovePage(bcn.GetID())
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not configure beacon: %s", err), cleanup)
+						return
+					}
+
+					if interval == 0 {
+						dash.ShowInfo("Agent stays connected", cleanup)
+					} else {
+						dash.ShowInfo(fmt.Sprintf("Agent checks in every %s", interval), cleanup)
+					}
+				})
+			})
+			bcn.SetCancelFunc(func() {
+				dash.RemovePage(bcn.GetID())
+				cleanup()
+			})
+			dash.AddPage(bcn.GetID(), bcn, true, true)
+		}))
+
+		if sess.IsConnected {
+			menu.AddItem(modals.NewMenuModalElem("Working hours", func() {
+				hours := forms.NewWorkingHoursForm(sess.Beacon.WorkingHours)
+				hours.SetSubmitFunc(func(workingHours string) {
+					dash.DoWithLoader("Setting working hours...", func() {
+						err := dash.sessionWorkingHoursFunc(sess, workingHours)
+						dash.RemovePage(hours.GetID())
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not set working hours: %s", err), cleanup)
+							return
+						}
+
+						if workingHours == "" {
+							dash.ShowInfo("Agent is reachable around the clock", cleanup)
+						} else {
+							dash.ShowInfo(fmt.Sprintf("Agent stays dormant %s, its local time", workingHours), cleanup)
+						}
+					})
+				})
+				hours.SetCancelFunc(func() {
+					dash.RemovePage(hours.GetID())
+					cleanup()
+				})
+				dash.AddPage(hours.GetID(), hours, true, true)
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Refresh network", func() {
+				dash.DoWithLoader("Collecting interfaces, neighbors and routes...", func() {
+					errs, err := dash.sessionRefreshNetworkFunc(sess)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not refresh network: %s", err), cleanup)
+						return
+					}
+
+					if len(errs) > 0 {
+						dash.ShowInfo(fmt.Sprintf("Network refreshed, but the agent couldn't collect everything:\n%s", strings.Join(errs, "\n")), cleanup)
+						return
+					}
+
+					cleanup()
+				})
+			}))
+
+			if sess.Host != nil {
+				menu.AddItem(modals.NewMenuModalElem("Refresh host info", func() {
+					dash.DoWithLoader("Collecting host information...", func() {
+						if err := dash.sessionRefreshHostInfoFunc(sess); err != nil {
+							dash.ShowError(fmt.Sprintf("Could not refresh host info: %s", err), cleanup)
+							return
+						}
+
+						cleanup()
+					})
+				}))
+			}
+		}
+
+		if sess.IsConnected {
+			menu.AddItem(modals.NewMenuModalElem("SOCKS5 service", func() {
+				sck := forms.NewSocksForm(sess.Socks.Address, sess.Socks.Username)
+				sck.SetSubmitFunc(func(address string, username string, password string) {
+					if address == "" {
+						dash.ShowError("Address is required, stop the service from the session menu instead", nil)
+						return
+					}
+
+					dash.DoWithLoader("Starting SOCKS5 service...", func() {
+						err := dash.sessionSocksFunc(sess, address, username, password)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not start SOCKS5 service: %s", err), nil)
+							return
+						}
+
+						dash.RemovePage(sck.GetID())
+						dash.ShowInfo("SOCKS5 service started", cleanup)
+					})
+				})
+				sck.SetCancelFunc(func() {
+					dash.RemovePage(sck.GetID())
+					cleanup()
+				})
+				dash.AddPage(sck.GetID(), sck, true, true)
+			}))
+
+			if sess.Socks.Listening != "" {
+				menu.AddItem(modals.NewMenuModalElem("Stop SOCKS5 service", func() {
+					dash.DoWithConfirm(fmt.Sprintf("Stop the SOCKS5 service on %s?", sess.Socks.Listening), func() {
+						dash.DoWithLoader("Stopping SOCKS5 service...", func() {
+							err := dash.sessionSocksFunc(sess, "", "", "")
+							if err != nil {
+								dash.ShowError(fmt.Sprintf("Could not stop SOCKS5 service: %s", err), cleanup)
+								return
+							}
+
+							dash.ShowInfo("SOCKS5 service stopped", cleanup)
+						})
+					})
+				}))
+			}
+
+			menu.AddItem(modals.NewMenuModalElem("Files", func() {
+				cleanup()
+				dash.showFiles(sess)
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Sweep", func() {
+				sweep := forms.NewSweepForm()
+				sweep.SetSubmitFunc(func(targetsText string, portsText string, timeoutText string) {
+					targets, err := session.ParseSweepTargets(targetsText)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Invalid targets: %s", err), nil)
+						return
+					}
+
+					ports, err := session.ParsePorts(portsText)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Invalid ports: %s", err), nil)
+						return
+					}
+
+					var timeout time.Duration
+					if timeoutText != "" {
+						if timeout, err = time.ParseDuration(timeoutText); err != nil {
+							dash.ShowError(fmt.Sprintf("Invalid timeout: %s", err), nil)
+							return
+						}
+					}
+
+					dash.RemovePage(sweep.GetID())
+					dash.runSweep(sess, targets, ports, timeout, cleanup)
+				})
+				sweep.SetCancelFunc(func() {
+					dash.RemovePage(sweep.GetID())
+					cleanup()
+				})
+				dash.AddPage(sweep.GetID(), sweep, true, true)
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Diagnostics", func() {
+				dash.DoWithLoader("Running diagnostics...", func() {
+					checks, err := dash.sessionDiagnoseFunc(sess)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not run diagnostics: %s", err), cleanup)
+						return
+					}
+
+					var lines []string
+					for _, check := range checks {
+						status := "[FAIL]"
+						if check.OK {
+							status = "[OK]"
+						}
+
+						line := fmt.Sprintf("%s %s", status, check.Name)
+						if check.Target != "" {
+							line += fmt.Sprintf(" %s", check.Target)
+						}
+						if check.Duration > 0 {
+							line += fmt.Sprintf(" %s", time.Duration(check.Duration).Round(time.Millisecond))
+						}
+						if check.Detail != "" {
+							line += fmt.Sprintf(": %s", check.Detail)
+						}
+						lines = append(lines, line)
+					}
+
+					dash.ShowText(fmt.Sprintf("Diagnostics of '%s'", sess.GetName()), strings.Join(lines, "\n"), cleanup)
+				})
+			}))
+
+			if sess.Exec {
+				menu.AddItem(modals.NewMenuModalElem("Run command", func() {
+					run := forms.NewExecForm()
+					run.SetSubmitFunc(func(command string, timeout string) {
+						var limit time.Duration
+						if timeout != "" {
+							var err error
+							limit, err = time.ParseDuration(timeout)
+							if err != nil {
+								dash.ShowError(fmt.Sprintf("Invalid timeout: %s", err), nil)
+								return
+							}
+						}
+
+						dash.RemovePage(run.GetID())
+						dash.runCommand(sess, command, limit, cleanup)
+					})
+					run.SetCancelFunc(func() {
+						dash.RemovePage(run.GetID())
+						cleanup()
+					})
+					dash.AddPage(run.GetID(), run, true, true)
+				}))
+			}
+
+			if len(sess.Persistence) > 0 {
+				menu.AddItem(modals.NewMenuModalElem("Persistence", func() {
+					picker := modals.NewMenuModal("Persistence")
+					pickerCleanup := func() {
+						dash.RemovePage(picker.GetID())
+						cleanup()
+					}
+
+					for _, method := range sess.Persistence {
+						method := method
+						if slices.Contains(sess.Persisted, method) {
+							picker.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Remove %s", method), func() {
+								dash.DoWithLoader(fmt.Sprintf("Removing %s persistence...", method), func() {
+									location, err := dash.sessionPersistFunc(sess, method, true)
+									if err != nil {
+										dash.ShowError(fmt.Sprintf("Could not remove persistence: %s", err), pickerCleanup)
+										return
+									}
+
+									dash.ShowInfo(fmt.Sprintf("Removed %s", location), pickerCleanup)
+								})
+							}))
+							continue
+						}
+
+						picker.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Install %s", method), func() {
+							dash.DoWithConfirm(fmt.Sprintf("The agent installs itself with %s on '%s' and starts again after a reboot until it's removed. Are you sure?", method, sess.GetName()), func() {
+								dash.DoWithLoader(fmt.Sprintf("Installing %s persistence...", method), func() {
+									location, err := dash.sessionPersistFunc(sess, method, false)
+									if err != nil {
+										dash.ShowError(fmt.Sprintf("Could not install persistence: %s", err), pickerCleanup)
+										return
+									}
+
+									dash.ShowInfo(fmt.Sprintf("Installed %s", location), pickerCleanup)
+								})
+							})
+						}))
+					}
+
+					picker.SetCancelFunc(pickerCleanup)
+					dash.AddPage(picker.GetID(), picker, true, true)
+				}))
+			}
+		}
+
+		if len(sess.Crashes) > 0 {
+			menu.AddItem(modals.NewMenuModalElem("Crash reports", func() {
+				var reports []string
+				for _, crash := range sess.Crashes {
+					reports = append(reports, crash.String())
+				}
+
+				dash.ShowInfo(fmt.Sprintf("The agent recovered from these crashes:\n%s", strings.Join(reports, "\n")), cleanup)
+			}))
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Attachments", func() {
+			dash.showAttachments(sess, cleanup)
+		}))
+
+		switch handoff := sess.Handoff; {
+		case handoff != nil && handoff.To == dash.operator.Name:
+			menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Accept handoff from %s", handoff.From), func() {
+				text := fmt.Sprintf("%s hands '%s' off to you.", handoff.From, sess.GetName())
+				if handoff.Note != "" {
+					text += "\n\n" + handoff.Note
+				}
+				dash.DoWithConfirm(text+"\n\nTake it over?", func() {
+					dash.DoWithLoader("Accepting handoff...", func() {
+						err := dash.sessionAnswerHandoffFunc(sess, true)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not accept handoff: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo(fmt.Sprintf("You now own '%s'", sess.GetName()), cleanup)
+					})
+				})
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Decline handoff", func() {
+				dash.DoWithLoader("Declining handoff...", func() {
+					err := dash.sessionAnswerHandoffFunc(sess, false)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not decline handoff: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo("Handoff declined", cleanup)
+				})
+			}))
+		case handoff != nil && handoff.From == dash.operator.Name:
+			menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Withdraw handoff to %s", handoff.To), func() {
+				dash.DoWithLoader("Withdrawing handoff...", func() {
+					err := dash.sessionAnswerHandoffFunc(sess, false)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not withdraw handoff: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo("Handoff withdrawn", cleanup)
+				})
+			}))
+		case sess.CanHandOff(dash.operator.Name, dash.operator.IsAdmin):
+			menu.AddItem(modals.NewMenuModalElem("Hand off", func() {
+				form := forms.NewHandoffForm()
+				form.SetSubmitFunc(func(operator string, note string, shareLayout bool) {
+					dash.DoWithLoader("Handing off session...", func() {
+						err := dash.sessionHandoffFunc(sess, operator, note, shareLayout)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not hand off session: %s", err), cleanup)
+							return
+						}
+
+						dash.RemovePage(form.GetID())
+						dash.ShowInfo(fmt.Sprintf("'%s' is waiting for %s to accept it", sess.GetName(), operator), cleanup)
+					})
+				})
+				form.SetCancelFunc(func() {
+					dash.RemovePage(form.GetID())
+					cleanup()
+				})
+				dash.AddPage(form.GetID(), form, true, true)
+			}))
+		}
+
+		switch {
+		case sess.Claim == "":
+			menu.AddItem(modals.NewMenuModalElem("Claim", func() {
+				dash.DoWithLoader("Claiming session...", func() {
+					err := dash.sessionClaimFunc(sess, false, false)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not claim session: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo(fmt.Sprintf("Others can't use '%s' until you release it or an admin overrides your claim", sess.GetName()), cleanup)
+				})
+			}))
+		case sess.Claim == dash.operator.Name:
+			menu.AddItem(modals.NewMenuModalElem("Release claim", func() {
+				dash.DoWithLoader("Releasing session...", func() {
+					err := dash.sessionClaimFunc(sess, true, false)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not release session: %s", err), cleanup)
+						return
+					}
+
+					dash.ShowInfo("Claim released", cleanup)
+				})
+			}))
+		case dash.operator.IsAdmin:
+			menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Override claim of %s", sess.Claim), func() {
+				dash.DoWithConfirm(fmt.Sprintf("%s claimed '%s' and is told when the claim is overridden. Take the claim over?", sess.Claim, sess.GetName()), func() {
+					dash.DoWithLoader("Overriding claim...", func() {
+						err := dash.sessionClaimFunc(sess, false, true)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not override claim: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo(fmt.Sprintf("You now hold the claim on '%s'", sess.GetName()), cleanup)
+					})
+				})
+			}))
+		}
+
 		menu.AddItem(modals.NewMenuModalElem("Rename", func() {
 			ren := forms.NewRenameForm()
 			ren.SetSubmitFunc(func(alias string) {
@@ -153,11 +771,33 @@ func (dash *DashboardPage) initSessionsWidget() {
 			dash.AddPage(ren.GetID(), ren, true, true)
 		}))
 
+		menu.AddItem(modals.NewMenuModalElem("Tags and notes", func() {
+			annotate := forms.NewAnnotateForm("Session tags and notes", session.FormatTags(sess.Tags), sess.Note)
+			annotate.SetSubmitFunc(func(tags string, note string) {
+				dash.DoWithLoader("Saving tags and notes...", func() {
+					err := dash.annotateFunc(sess, "", session.ParseTags(tags), note)
+					if err != nil {
+						dash.RemovePage(annotate.GetID())
+						dash.ShowError(fmt.Sprintf("Could not save tags and notes: %s", err), cleanup)
+						return
+					}
+
+					dash.RemovePage(annotate.GetID())
+					dash.ShowInfo("Tags and notes saved", cleanup)
+				})
+			})
+			annotate.SetCancelFunc(func() {
+				dash.RemovePage(annotate.GetID())
+				cleanup()
+			})
+			dash.AddPage(annotate.GetID(), annotate, true, true)
+		}))
+
 		menu.AddItem(modals.NewMenuModalElem("Add route", func() {
 			route := route_forms.NewAddRouteForm()
-			route.SetSubmitFunc(func(cidr string, metric int, loopback bool) {
+			route.SetSubmitFunc(func(cidr string, metric int, loopback bool, ports string, exclusion bool) {
 				dash.DoWithLoader("Adding route...", func() {
-					err := dash.sessionAddRouteFunc(sess, cidr, metric, loopback)
+					err := dash.sessionAddRouteFunc(sess, cidr, metric, loopback, ports, exclusion)
 					if err != nil {
 						dash.RemovePage(route.GetID())
 						dash.ShowError(fmt.Sprintf("Could not add route: %s", err), cleanup)
@@ -176,11 +816,90 @@ func (dash *DashboardPage) initSessionsWidget() {
 			dash.AddPage(route.GetID(), route, true, true)
 		}))
 
+		menu.AddItem(modals.NewMenuModalElem("Apply route profile", func() {
+			dash.DoWithLoader("Loading route profiles...", func() {
+				profiles, err := dash.routeProfilesFunc()
+				if err != nil {
+					dash.ShowError(fmt.Sprintf("Could not load route profiles: %s", err), cleanup)
+					return
+				}
+
+				if len(profiles) < 1 {
+					dash.ShowError("No route profiles defined", cleanup)
+					return
+				}
+
+				picker := modals.NewMenuModal("Apply route profile")
+				pickerCleanup := func() {
+					dash.RemovePage(picker.GetID())
+					cleanup()
+				}
+
+				for _, prof := range profiles {
+					name := prof.Name
+					picker.AddItem(modals.NewMenuModalElem(fmt.Sprintf("%s (%d routes)", name, len(prof.Routes)), func() {
+						dash.DoWithLoader("Applying route profile...", func() {
+							err := dash.sessionApplyProfileFunc(sess, name)
+							if err != nil {
+								dash.ShowError(fmt.Sprintf("Could not apply route profile: %s", err), pickerCleanup)
+								return
+							}
+
+							dash.ShowInfo("Route profile applied", pickerCleanup)
+						})
+					}))
+				}
+
+				picker.SetCancelFunc(pickerCleanup)
+				dash.AddPage(picker.GetID(), picker, true, true)
+			})
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Save routes as profile", func() {
+			save := forms.NewSaveProfileForm()
+			save.SetSubmitFunc(func(name string) {
+				dash.DoWithLoader("Saving route profile...", func() {
+					prof := &profile.Profile{Name: name}
+					for _, r := range sess.Tun.Routes.All() {
+						prof.Routes = append(prof.Routes, profile.Entry{
+							Cidr:        r.Cidr.String(),
+							Metric:      r.Metric,
+							IsLoopback:  r.IsLoopback,
+							Ports:       r.Ports,
+							IsExclusion: r.IsExclusion,
+						})
+					}
+
+					err := dash.saveRouteProfileFunc(prof)
+					if err != nil {
+						dash.RemovePage(save.GetID())
+						dash.ShowError(fmt.Sprintf("Could not save route profile: %s", err), cleanup)
+						return
+					}
+
+					dash.RemovePage(save.GetID())
+					dash.ShowInfo("Route profile saved", cleanup)
+				})
+			})
+			save.SetCancelFunc(func() {
+				dash.RemovePage(save.GetID())
+				cleanup()
+			})
+			dash.AddPage(save.GetID(), save, true, true)
+		}))
+
 		menu.AddItem(modals.NewMenuModalElem("Add redirector", func() {
-			redir := forms.NewAddRedirectorForm()
-			redir.SetSubmitFunc(func(from string, to string, proto string) {
+			var others []*session.Session
+			for _, other := range dash.data {
+				if other.ID != sess.ID {
+					others = append(others, other)
+				}
+			}
+
+			redir := forms.NewAddRedirectorForm(others)
+			redir.SetSubmitFunc(func(from string, to string, proto string, via string) {
 				dash.DoWithLoader("Adding redirector...", func() {
-					err := dash.sessionAddRedirectorFunc(sess, from, to, proto)
+					err := dash.sessionAddRedirectorFunc(sess, from, to, proto, via, false)
 					if err != nil {
 						dash.RemovePage(redir.GetID())
 						dash.ShowError(fmt.Sprintf("Could not add route: %s", err), cleanup)
@@ -199,6 +918,211 @@ func (dash *DashboardPage) initSessionsWidget() {
 			dash.AddPage(redir.GetID(), redir, true, true)
 		}))
 
+		menu.AddItem(modals.NewMenuModalElem("Add bridge", func() {
+			bridge := forms.NewAddBridgeForm()
+			bridge.SetSubmitFunc(func(from string, proto string) {
+				dash.DoWithLoader("Adding bridge...", func() {
+					err := dash.sessionAddRedirectorFunc(sess, from, "", proto, "", true)
+					if err != nil {
+						dash.RemovePage(bridge.GetID())
+						dash.ShowError(fmt.Sprintf("Could not add bridge: %s", err), cleanup)
+						return
+					}
+
+					dash.RemovePage(bridge.GetID())
+					dash.ShowInfo("Bridge added", cleanup)
+				})
+			})
+			bridge.SetCancelFunc(func() {
+				dash.RemovePage(bridge.GetID())
+				dash.setFocus(dash.sessions)
+				cleanup()
+			})
+			dash.AddPage(bridge.GetID(), bridge, true, true)
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Breakout", func() {
+			var interfaces []string
+			for _, iface := range sess.Interfaces.All() {
+				interfaces = append(interfaces, iface.Name)
+			}
+
+			brk := forms.NewBreakoutForm(session.FormatBreakout(sess.Breakout), interfaces)
+			brk.SetSubmitFunc(func(text string) {
+				rules, err := session.ParseBreakout(text)
+				if err != nil {
+					dash.ShowError(fmt.Sprintf("Invalid breakout table: %s", err), nil)
+					return
+				}
+
+				dash.DoWithLoader("Setting breakout table...", func() {
+					if err := dash.sessionBreakoutFunc(sess, rules); err != nil {
+						dash.ShowError(fmt.Sprintf("Could not set breakout table: %s", err), nil)
+						return
+					}
+
+					dash.RemovePage(brk.GetID())
+					dash.ShowInfo("Breakout table set", cleanup)
+				})
+			})
+			brk.SetCancelFunc(func() {
+				dash.RemovePage(brk.GetID())
+				cleanup()
+			})
+			dash.AddPage(brk.GetID(), brk, true, true)
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Bandwidth cap", func() {
+			bw := forms.NewBandwidthForm(session.FormatBandwidth(sess.BandwidthCap))
+			bw.SetSubmitFunc(func(text string) {
+				limit, err := session.ParseBandwidth(text)
+				if err != nil {
+					dash.ShowError(fmt.Sprintf("Invalid bandwidth: %s", err), nil)
+					return
+				}
+
+				dash.DoWithLoader("Setting bandwidth cap...", func() {
+					if err := dash.sessionBandwidthFunc(sess, limit); err != nil {
+						dash.ShowError(fmt.Sprintf("Could not set bandwidth cap: %s", err), nil)
+						return
+					}
+
+					dash.RemovePage(bw.GetID())
+					if limit == 0 {
+						dash.ShowInfo("Bandwidth cap lifted", cleanup)
+					} else {
+						dash.ShowInfo(fmt.Sprintf("Tunnel capped to %sbit/s each way", session.FormatBandwidth(limit)), cleanup)
+					}
+				})
+			})
+			bw.SetCancelFunc(func() {
+				dash.RemovePage(bw.GetID())
+				cleanup()
+			})
+			dash.AddPage(bw.GetID(), bw, true, true)
+		}))
+
+		if !sess.IsConnected && !sess.Disconnection.At.IsZero() {
+			menu.AddItem(modals.NewMenuModalElem("Last disconnect", func() {
+				details := fmt.Sprintf("Reason: %s\nAt: %s", sess.Disconnection.Reason, sess.Disconnection.At.Format(time.DateTime))
+				if sess.Disconnection.Note != "" {
+					details += fmt.Sprintf("\nDetails: %s", sess.Disconnection.Note)
+				}
+
+				dash.ShowInfo(details, cleanup)
+			}))
+		}
+
+		if sess.IsConnected && sess.Version != "" {
+			menu.AddItem(modals.NewMenuModalElem("Update agent", func() {
+				dash.DoWithLoader("Loading builds...", func() {
+					builds, err := dash.agentBuildsFunc()
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not load builds: %s", err), cleanup)
+						return
+					}
+
+					var compatible []*agentbuild.AgentBuild
+					for _, build := range builds {
+						if build.Stored && !build.Staged && (build.Format == "" || build.Format == "exe") &&
+							build.GOOS+"/"+build.GOARCH == sess.Platform && build.Sha256 != sess.Version {
+							compatible = append(compatible, build)
+						}
+					}
+					if len(compatible) == 0 {
+						dash.ShowError(fmt.Sprintf("No build can replace this agent, generate a %s executable first", sess.Platform), cleanup)
+						return
+					}
+
+					update := forms.NewUpdateAgentForm(compatible)
+					update.SetSubmitFunc(func(buildID string) {
+						dash.RemovePage(update.GetID())
+						dash.DoWithLoader("Updating agent, waiting for it to connect back...", func() {
+							err := dash.updateAgentFunc(sess, buildID)
+							if err != nil {
+								dash.ShowError(fmt.Sprintf("Could not update agent: %s", err), cleanup)
+								return
+							}
+
+							dash.ShowInfo(fmt.Sprintf("Agent is back running build %s", buildID), cleanup)
+						})
+					})
+					update.SetCancelFunc(func() {
+						dash.RemovePage(update.GetID())
+						cleanup()
+					})
+					dash.AddPage(update.GetID(), update, true, true)
+				})
+			}))
+		}
+
+		if sess.IsConnected {
+			menu.AddItem(modals.NewMenuModalElem("Rotate agent certificate", func() {
+				dash.DoWithConfirm("The agent reconnects with a new certificate and the one it has now gets revoked. It only keeps the new one in memory, if it starts again from its executable it can't connect until it's updated. Are you sure?", func() {
+					dash.DoWithLoader("Rotating certificate, waiting for the agent to connect back...", func() {
+						revoked, err := dash.rotateAgentCertFunc(sess)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not rotate certificate: %s", err), cleanup)
+							return
+						}
+
+						if revoked == "" {
+							dash.ShowInfo("Agent is back with a new certificate", cleanup)
+							return
+						}
+						dash.ShowInfo(fmt.Sprintf("Agent is back with a new certificate, serial %s is revoked", revoked), cleanup)
+					})
+				})
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Kill agent", func() {
+				dash.DoWithConfirm("The agent exits and won't come back on its own, the session is kept. Are you sure?", func() {
+					dash.DoWithLoader("Killing agent...", func() {
+						err := dash.sessionKillAgentFunc(sess)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not kill agent: %s", err), cleanup)
+							return
+						}
+
+						dash.ShowInfo("Agent killed", cleanup)
+					})
+				})
+			}))
+
+			menu.AddItem(modals.NewMenuModalElem("Burn agent", func() {
+				dash.DoWithConfirm(fmt.Sprintf("The agent removes its persistence, its executable and its leftovers from '%s', then exits. It can't be brought back. Are you sure?", sess.GetName()), func() {
+					dash.DoWithLoader("Burning agent...", func() {
+						removed, errs, err := dash.sessionBurnFunc(sess)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not burn agent: %s", err), cleanup)
+							return
+						}
+
+						text := fmt.Sprintf("Agent burned, it removed:\n%s", strings.Join(removed, "\n"))
+						if len(errs) > 0 {
+							text += fmt.Sprintf("\n\nLeft behind, to clean up by hand:\n%s", strings.Join(errs, "\n"))
+						}
+						dash.ShowInfo(text, cleanup)
+					})
+				})
+			}))
+
+			if dash.operator.Can(operator.RoleAdmin) {
+				menu.AddItem(modals.NewMenuModalElem("Revoke agent certificate", func() {
+					dash.DoWithConfirm(fmt.Sprintf("'%s' gets dropped and no agent built with the same certificate can connect again until it's unrevoked on the Admin page. Are you sure?", sess.GetName()), func() {
+						dash.DoWithLoader("Revoking certificate...", func() {
+							if err := dash.sessionRevokeCertFunc(sess); err != nil {
+								dash.ShowError(fmt.Sprintf("Could not revoke certificate: %s", err), cleanup)
+								return
+							}
+
+							dash.ShowInfo("Agent certificate revoked", cleanup)
+						})
+					})
+				}))
+			}
+		}
+
 		menu.AddItem(modals.NewMenuModalElem("Remove", func() {
 			dash.DoWithConfirm("Are you sure?", func() {
 				dash.DoWithLoader("Removing session...", func() {
@@ -222,6 +1146,7 @@ func (dash *DashboardPage) initSessionsWidget() {
 func (dash *DashboardPage) initRoutesWidget() {
 	dash.routes.SetSelectionChangedFunc(func(elem *widgets.RoutesWidgetElem) {
 		dash.sessions.SetSelectedSession(elem.Session)
+		dash.hostInfo.SetSelectedSession(elem.Session)
 		dash.interfaces.SetSelectedSession(elem.Session)
 		dash.routes.SetSelectedSession(elem.Session)
 		dash.redirectors.SetSelectedSession(elem.Session)
@@ -236,24 +1161,80 @@ func (dash *DashboardPage) initRoutesWidget() {
 
 		menu.AddItem(modals.NewMenuModalElem("Edit", func() {
 			routeEdit := route_forms.NewEditRouteForm(elem.Route)
-			routeEdit.SetSubmitFunc(func(cidr string, metric int, loopback bool) {
+			routeEdit.SetSubmitFunc(func(cidr string, metric int, loopback bool, ports string, exclusion bool) {
 				dash.DoWithLoader("Editing route...", func() {
-					err := dash.sessionEditRouteFunc(elem.Session, elem.Route.ID, cidr, metric, loopback)
+					err := dash.sessionEditRouteFunc(elem.Session, elem.Route.ID, cidr, metric, loopback, ports, exclusion)
 					if err != nil {
 						dash.RemovePage(routeEdit.GetID())
 						dash.ShowError(fmt.Sprintf("Could not edit route: %s", err), cleanup)
 						return
 					}
 
-					dash.RemovePage(routeEdit.GetID())
-					dash.ShowInfo("Route edited", cleanup)
-				})
-			})
-			routeEdit.SetCancelFunc(func() {
-				dash.RemovePage(routeEdit.GetID())
-				cleanup()
-			})
-			dash.AddPage(routeEdit.GetID(), routeEdit, true, true)
+					dash.RemovePage(routeEdit.GetID())
+					dash.ShowInfo("Route edited", cleanup)
+				})
+			})
+			routeEdit.SetCancelFunc(func() {
+				dash.RemovePage(routeEdit.GetID())
+				cleanup()
+			})
+			dash.AddPage(routeEdit.GetID(), routeEdit, true, true)
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Tags and notes", func() {
+			annotate := forms.NewAnnotateForm("Route tags and notes", session.FormatTags(elem.Route.Tags), elem.Route.Note)
+			annotate.SetSubmitFunc(func(tags string, note string) {
+				dash.DoWithLoader("Saving tags and notes...", func() {
+					err := dash.annotateFunc(elem.Session, elem.Route.ID, session.ParseTags(tags), note)
+					if err != nil {
+						dash.RemovePage(annotate.GetID())
+						dash.ShowError(fmt.Sprintf("Could not save tags and notes: %s", err), cleanup)
+						return
+					}
+
+					dash.RemovePage(annotate.GetID())
+					dash.ShowInfo("Tags and notes saved", cleanup)
+				})
+			})
+			annotate.SetCancelFunc(func() {
+				dash.RemovePage(annotate.GetID())
+				cleanup()
+			})
+			dash.AddPage(annotate.GetID(), annotate, true, true)
+		}))
+
+		menu.AddItem(modals.NewMenuModalElem("Compression", func() {
+			picker := modals.NewMenuModal("Route compression")
+			pickerCleanup := func() {
+				dash.RemovePage(picker.GetID())
+				cleanup()
+			}
+
+			for _, choice := range []struct{ label, compression string }{
+				{"Follow the session", route.CompressionDefault},
+				{"Always compress", route.CompressionOn},
+				{"Never compress", route.CompressionOff},
+			} {
+				compression := choice.compression
+				label := choice.label
+				if compression == elem.Route.Compression {
+					label += " (current)"
+				}
+				picker.AddItem(modals.NewMenuModalElem(label, func() {
+					dash.DoWithLoader("Setting route compression...", func() {
+						err := dash.sessionCompressionFunc(elem.Session, elem.Route.ID, compression)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not set route compression: %s", err), pickerCleanup)
+							return
+						}
+
+						dash.ShowInfo("Route compression set", pickerCleanup)
+					})
+				}))
+			}
+
+			picker.SetCancelFunc(pickerCleanup)
+			dash.AddPage(picker.GetID(), picker, true, true)
 		}))
 
 		menu.AddItem(modals.NewMenuModalElem("Move", func() {
@@ -302,6 +1283,7 @@ func (dash *DashboardPage) initRoutesWidget() {
 func (dash *DashboardPage) initRedirectorsWidget() {
 	dash.redirectors.SetSelectionChangedFunc(func(elem *widgets.RedirectorsWidgetElem) {
 		dash.sessions.SetSelectedSession(elem.Session)
+		dash.hostInfo.SetSelectedSession(elem.Session)
 		dash.interfaces.SetSelectedSession(elem.Session)
 		dash.routes.SetSelectedSession(elem.Session)
 		dash.redirectors.SetSelectedSession(elem.Session)
@@ -332,6 +1314,326 @@ func (dash *DashboardPage) initRedirectorsWidget() {
 	})
 }
 
+func (dash *DashboardPage) showAttachments(sess *session.Session, done func()) {
+	dash.DoWithLoader("Loading attachments...", func() {
+		attachments, err := dash.attachmentsFunc(sess)
+		if err != nil {
+			dash.ShowError(fmt.Sprintf("Could not load attachments: %s", err), done)
+			return
+		}
+
+		menu := modals.NewMenuModal(fmt.Sprintf("Attachments — %s", sess.GetName()))
+		cleanup := func() {
+			dash.RemovePage(menu.GetID())
+			done()
+		}
+
+		menu.AddItem(modals.NewMenuModalElem("Add attachment", func() {
+			form := forms.NewAttachmentForm()
+			form.SetSubmitFunc(func(path string, note string) {
+				dash.DoWithLoader("Uploading attachment...", func() {
+					err := dash.addAttachmentFunc(sess, path, note)
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not add attachment: %s", err), nil)
+						return
+					}
+
+					dash.RemovePage(form.GetID())
+					dash.ShowInfo("Attachment added", cleanup)
+				})
+			})
+			form.SetCancelFunc(func() {
+				dash.RemovePage(form.GetID())
+			})
+			dash.AddPage(form.GetID(), form, true, true)
+		}))
+
+		for _, a := range attachments {
+			a := a
+			label := fmt.Sprintf("Note by %s, %s", a.Operator, utils.HumanTime(a.Created))
+			if !a.IsNote() {
+				label = fmt.Sprintf("%s (%s) by %s", a.Name, utils.HumanBytes(a.Size), a.Operator)
+			}
+
+			menu.AddItem(modals.NewMenuModalElem(label, func() {
+				sub := modals.NewMenuModal(label)
+				subCleanup := func() {
+					dash.RemovePage(sub.GetID())
+				}
+
+				if a.Note != "" {
+					sub.AddItem(modals.NewMenuModalElem("Read note", func() {
+						dash.ShowText(label, a.Note, nil)
+					}))
+				}
+
+				if !a.IsNote() {
+					sub.AddItem(modals.NewMenuModalElem("Download", func() {
+						save := forms.NewSaveAttachmentForm()
+						save.SetSubmitFunc(func(path string) {
+							dash.DoWithLoader("Downloading attachment...", func() {
+								fullPath, err := dash.downloadAttachmentFunc(a, path)
+								if err != nil {
+									dash.ShowError(fmt.Sprintf("Could not download attachment: %s", err), nil)
+									return
+								}
+
+								dash.RemovePage(save.GetID())
+								dash.ShowInfo(fmt.Sprintf("Attachment saved to %s\n\nSHA256: %s", fullPath, a.Sha256), subCleanup)
+							})
+						})
+						save.SetCancelFunc(func() {
+							dash.RemovePage(save.GetID())
+						})
+						dash.AddPage(save.GetID(), save, true, true)
+					}))
+				}
+
+				sub.AddItem(modals.NewMenuModalElem("Remove", func() {
+					dash.DoWithConfirm("Remove this attachment?", func() {
+						dash.DoWithLoader("Removing attachment...", func() {
+							err := dash.removeAttachmentFunc(a)
+							if err != nil {
+								dash.ShowError(fmt.Sprintf("Could not remove attachment: %s", err), nil)
+								return
+							}
+
+							subCleanup()
+							dash.ShowInfo("Attachment removed", cleanup)
+						})
+					})
+				}))
+
+				sub.SetCancelFunc(subCleanup)
+				dash.AddPage(sub.GetID(), sub, true, true)
+			}))
+		}
+
+		menu.SetCancelFunc(cleanup)
+		dash.AddPage(menu.GetID(), menu, true, true)
+	})
+}
+
+// showFiles opens the file browser of a session at the working directory of the agent
+func (dash *DashboardPage) showFiles(sess *session.Session) {
+	browser := widgets.NewFilesWidget()
+
+	layout := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(browser, 0, 8, true).
+			AddItem(nil, 0, 1, false),
+			0, 8, true).
+		AddItem(nil, 0, 1, false)
+
+	open := func(path string) {
+		dash.DoWithLoader("Listing files...", func() {
+			listed, entries, err := dash.listFilesFunc(sess, path)
+			if err != nil {
+				dash.ShowError(fmt.Sprintf("Could not list %s: %s", path, err), nil)
+				return
+			}
+
+			browser.SetData(listed, entries)
+		})
+	}
+
+	browser.SetOpenFunc(open)
+
+	browser.SetGotoFunc(func(current string) {
+		form := forms.NewFilePathForm(current)
+		form.SetSubmitFunc(func(path string) {
+			dash.RemovePage(form.GetID())
+			open(path)
+		})
+		form.SetCancelFunc(func() {
+			dash.RemovePage(form.GetID())
+		})
+		dash.AddPage(form.GetID(), form, true, true)
+	})
+
+	browser.SetSelectedFunc(func(entry session.FileEntry, remotePath string) {
+		form := forms.NewDownloadFileForm()
+		form.SetSubmitFunc(func(localPath string, resume bool) {
+			dash.RemovePage(form.GetID())
+			dash.doTransfer(fmt.Sprintf("Downloading %s...", entry.Name), func(ctx context.Context, progress func(int64, int64)) (string, error) {
+				fullPath, sha256, err := dash.downloadFileFunc(ctx, progress, sess, remotePath, localPath, resume)
+				if err != nil {
+					return "", err
+				}
+
+				return fmt.Sprintf("%s saved to %s\n\nSHA256: %s", entry.Name, fullPath, sha256), nil
+			}, nil)
+		})
+		form.SetCancelFunc(func() {
+			dash.RemovePage(form.GetID())
+		})
+		dash.AddPage(form.GetID(), form, true, true)
+	})
+
+	browser.SetUploadFunc(func(dir string) {
+		form := forms.NewUploadFileForm()
+		form.SetSubmitFunc(func(localPath string, name string, resume bool) {
+			if localPath == "" {
+				dash.ShowError("Local file is required", nil)
+				return
+			}
+
+			dash.RemovePage(form.GetID())
+			remotePath := widgets.RemoteJoin(dir, name)
+			dash.doTransfer(fmt.Sprintf("Uploading %s...", name), func(ctx context.Context, progress func(int64, int64)) (string, error) {
+				sha256, err := dash.uploadFileFunc(ctx, progress, sess, localPath, remotePath, resume)
+				if err != nil {
+					return "", err
+				}
+
+				return fmt.Sprintf("%s uploaded to %s\n\nSHA256: %s", localPath, remotePath, sha256), nil
+			}, func() {
+				open(dir)
+			})
+		})
+		form.SetCancelFunc(func() {
+			dash.RemovePage(form.GetID())
+		})
+		dash.AddPage(form.GetID(), form, true, true)
+	})
+
+	dash.AddPage("files", layout, true, true)
+	open("")
+}
+
+// doTransfer runs a file transfer behind a loader showing its progress, cancelling the loader aborts it. The
+// transfer returns what to tell the operator once it's done, then done is called
+func (dash *DashboardPage) doTransfer(text string, transfer func(ctx context.Context, progress func(int64, int64)) (string, error), done func()) {
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		loader := modals.NewLoaderModal()
+		loader.SetText(text)
+		loader.AddButtons([]string{"Cancel"})
+		loader.SetDoneFunc(func(_ int, _ string) {
+			cancel()
+		})
+		dash.AddPage(loader.GetID(), loader, true, true)
+
+		start := time.Now()
+		result, err := transfer(ctx, func(transferred int64, total int64) {
+			percent := int64(100)
+			if total > 0 {
+				percent = transferred * 100 / total
+			}
+			loader.SetText(fmt.Sprintf("%s\n\n%s of %s (%d%%, %s)", text, utils.HumanBytes(transferred), utils.HumanBytes(total), percent, utils.HumanBitrate(transferred, time.Since(start))))
+		})
+		dash.RemovePage(loader.GetID())
+		if err != nil {
+			if ctx.Err() != nil {
+				dash.ShowError("Transfer cancelled, it can be resumed", done)
+				return
+			}
+
+			dash.ShowError(fmt.Sprintf("Transfer failed: %s", err), done)
+			return
+		}
+
+		dash.ShowInfo(result, done)
+	}()
+}
+
+// runCommand runs a command on the agent behind a loader showing the tail of its output, cancelling the loader
+// kills it. The whole output is shown once it exits
+func (dash *DashboardPage) runCommand(sess *session.Session, command string, timeout time.Duration, done func()) {
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		text := fmt.Sprintf("Running `%s`...", command)
+		loader := modals.NewLoaderModal()
+		loader.SetText(text)
+		loader.AddButtons([]string{"Cancel"})
+		loader.SetDoneFunc(func(_ int, _ string) {
+			cancel()
+		})
+		dash.AddPage(loader.GetID(), loader, true, true)
+
+		var output strings.Builder
+		exitCode, err := dash.sessionExecFunc(ctx, func(stderr bool, data []byte) {
+			output.Write(data)
+
+			lines := strings.Split(strings.TrimRight(output.String(), "\n"), "\n")
+			if len(lines) > 5 {
+				lines = lines[len(lines)-5:]
+			}
+			loader.SetText(fmt.Sprintf("%s\n\n%s", text, strings.Join(lines, "\n")))
+		}, sess, command, timeout)
+		dash.RemovePage(loader.GetID())
+
+		result := strings.TrimRight(output.String(), "\n")
+		switch {
+		case ctx.Err() != nil:
+			result += "\n\nCancelled, the command was killed"
+		case err != nil:
+			result += fmt.Sprintf("\n\nCommand failed: %s", err)
+		default:
+			result += fmt.Sprintf("\n\nExit code: %d", exitCode)
+		}
+
+		dash.ShowText(fmt.Sprintf("Output of `%s`", command), strings.TrimLeft(result, "\n"), done)
+	}()
+}
+
+// runSweep shows live hosts as the agent finds them, then lists them all
+func (dash *DashboardPage) runSweep(sess *session.Session, targets []string, ports []uint16, timeout time.Duration, done func()) {
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		text := fmt.Sprintf("Sweeping %s...", strings.Join(targets, ", "))
+		loader := modals.NewLoaderModal()
+		loader.SetText(text)
+		loader.AddButtons([]string{"Cancel"})
+		loader.SetDoneFunc(func(_ int, _ string) {
+			cancel()
+		})
+		dash.AddPage(loader.GetID(), loader, true, true)
+
+		var hosts []string
+		summary, err := dash.sessionSweepFunc(ctx, func(result protocol.SweepResultPacket) {
+			host := fmt.Sprintf("%s  %s  %s", result.Address, result.Method, time.Duration(result.RTT).Round(time.Microsecond))
+			if len(result.Open) > 0 {
+				var open []string
+				for _, port := range result.Open {
+					open = append(open, strconv.Itoa(int(port)))
+				}
+				host += fmt.Sprintf("  open %s", strings.Join(open, ","))
+			}
+			hosts = append(hosts, host)
+
+			lines := hosts
+			if len(lines) > 5 {
+				lines = lines[len(lines)-5:]
+			}
+			loader.SetText(fmt.Sprintf("%s\n\n%s", text, strings.Join(lines, "\n")))
+		}, sess, targets, ports, timeout)
+		dash.RemovePage(loader.GetID())
+
+		result := strings.Join(hosts, "\n")
+		switch {
+		case ctx.Err() != nil:
+			result += "\n\nCancelled"
+		case err != nil:
+			result += fmt.Sprintf("\n\nSweep failed: %s", err)
+		case summary.ICMP:
+			result += fmt.Sprintf("\n\n%d live hosts out of %d", summary.Alive, summary.Probed)
+		default:
+			result += fmt.Sprintf("\n\n%d live hosts out of %d, the agent couldn't send echo requests and only knocked on ports", summary.Alive, summary.Probed)
+		}
+
+		dash.ShowText(fmt.Sprintf("Sweep from '%s'", sess.GetName()), strings.TrimLeft(result, "\n"), done)
+	}()
+}
+
 func (dash *DashboardPage) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 	return func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 		key := event.Key()
@@ -342,9 +1644,34 @@ func (dash *DashboardPage) InputHandler() func(event *tcell.EventKey, setFocus f
 				if dash.operator.IsAdmin {
 					dash.adminFunc()
 				}
+			case tcell.KeyCtrlR:
+				dash.replayFunc()
+			case tcell.KeyCtrlB:
+				dash.buildsFunc()
+			case tcell.KeyCtrlU:
+				dash.auditFunc()
+			case tcell.KeyCtrlE:
+				export := forms.NewStateExportForm()
+				export.SetSubmitFunc(func(path string) {
+					dash.DoWithLoader("Exporting state...", func() {
+						fullPath, err := dash.exportStateFunc(path)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not export state: %s", err), nil)
+							return
+						}
+
+						dash.RemovePage(export.GetID())
+						dash.ShowInfo(fmt.Sprintf("Exported state to %s", fullPath), nil)
+					})
+				})
+				export.SetCancelFunc(func() {
+					dash.RemovePage(export.GetID())
+				})
+				dash.AddPage(export.GetID(), export, true, true)
 			case tcell.KeyTab:
 				focusOrder := []tview.Primitive{
 					dash.sessions,
+					dash.hostInfo,
 					dash.interfaces,
 					dash.routes,
 					dash.redirectors,
@@ -358,23 +1685,199 @@ func (dash *DashboardPage) InputHandler() func(event *tcell.EventKey, setFocus f
 					}
 				}
 			case tcell.KeyCtrlN:
-				gen := forms.NewGenerateForm()
-				gen.SetSubmitFunc(func(path string, servers string, goos string, goarch string, obfuscate bool, proxy string, ignoreEnvProxy bool) {
-					dash.DoWithLoader("Generating agent...", func() {
-						fullPath, err := dash.generateFunc(path, servers, goos, goarch, obfuscate, proxy, ignoreEnvProxy)
-						if err != nil {
-							dash.ShowError(fmt.Sprintf("Could not generate agent: %s", err), nil)
-							return
+				dash.DoWithLoader("Loading agent templates...", func() {
+					templates, err := dash.templatesFunc()
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not load agent templates: %s", err), nil)
+						return
+					}
+
+					var names []string
+					for _, t := range templates {
+						names = append(names, t.Name)
+					}
+
+					keys, err := dash.signingKeysFunc()
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not load signing keys: %s", err), nil)
+						return
+					}
+
+					var keyNames []string
+					for _, key := range keys {
+						keyNames = append(keyNames, key.Name)
+					}
+
+					hooks, err := dash.buildHooksFunc()
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not load build hooks: %s", err), nil)
+						return
+					}
+
+					profiles, err := dash.transportProfilesFunc()
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not load transport profiles: %s", err), nil)
+						return
+					}
+
+					var profileNames []string
+					for _, profile := range profiles {
+						profileNames = append(profileNames, profile.Name)
+					}
+
+					gen := forms.NewGenerateForm(names, keyNames, hooks, profileNames)
+					gen.SetSubmitFunc(func(path string, servers string, goos string, goarch string, format string, staged bool, exec bool, persist bool, hostInfo bool, memoryLoader bool, override bool, obfuscate bool, garble gogo.GarbleOptions, resources forms.WindowsResources, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, campaign string, template string, signingKey string, hooks []string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string) {
+						go func() {
+							ctx, cancel := context.WithCancel(context.Background())
+							defer cancel()
+
+							// closing the stream cancels the build on the server
+							loader := modals.NewLoaderModal()
+							loader.SetText("Generating agent...")
+							loader.AddButtons([]string{"Cancel"})
+							loader.SetDoneFunc(func(_ int, _ string) {
+								cancel()
+							})
+							dash.AddPage(loader.GetID(), loader, true, true)
+
+							fullPath, build, err := dash.generateFunc(ctx, func(progress string) {
+								loader.SetText(fmt.Sprintf("Generating agent...\n\n%s", progress))
+							}, path, servers, goos, goarch, format, staged, exec, persist, hostInfo, memoryLoader, override, obfuscate, garble, resources, proxy, ignoreEnvProxy, userAgent, profile, netns, vrf, beacon, campaign, template, signingKey, hooks, keySource, key, rotation, retries, backoff, guardrails, schedule, mux, pinning, ca)
+							dash.RemovePage(loader.GetID())
+							if err != nil {
+								if ctx.Err() != nil {
+									return
+								}
+
+								dash.ShowError(fmt.Sprintf("Could not generate agent: %s", err), nil)
+								return
+							}
+
+							dash.RemovePage(gen.GetID())
+							msg := fmt.Sprintf("Agent binary saved to %s\n\nBuild ID: %s", fullPath, build.ID)
+							if build.Staged {
+								msg = fmt.Sprintf("Stager saved to %s, the agent stays on the server\n\nBuild ID: %s", fullPath, build.ID)
+							}
+							if build.GarbleSeed != "" {
+								msg += fmt.Sprintf("\nGarble seed: %s", build.GarbleSeed)
+							}
+							if len(build.Hooks) > 0 {
+								msg += fmt.Sprintf("\nHooks: %s", strings.Join(build.Hooks, ", "))
+							}
+							if build.SigningKey != "" {
+								msg += fmt.Sprintf("\nSigned with: %s", build.SigningKey)
+							}
+							if !build.Guardrails.Empty() {
+								msg += fmt.Sprintf("\nGuardrails: %s", build.Guardrails)
+							}
+							if build.Loader {
+								msg += fmt.Sprintf("\n\nMemory loader saved to %s.sh, serve it and run:\ncurl -fsSL https://host/%s.sh | sh", fullPath, filepath.Base(fullPath))
+							}
+							dash.ShowInfo(msg, nil)
+						}()
+					})
+					gen.SetRenderFunc(func(path string, servers string, goos string, goarch string, format string, _ bool, _ bool, _ bool, _ bool, _ bool, _ bool, _ bool, _ gogo.GarbleOptions, _ forms.WindowsResources, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, _ string, template string, _ string, _ []string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string) {
+						menu := modals.NewMenuModal("Render agent.go")
+						cleanup := func() {
+							dash.RemovePage(menu.GetID())
+						}
+						menu.SetCancelFunc(cleanup)
+
+						render := func(redact bool) {
+							cleanup()
+							dash.DoWithLoader("Rendering agent...", func() {
+								fullPath, problems, err := dash.renderFunc(redact, path, servers, goos, goarch, format, proxy, ignoreEnvProxy, userAgent, profile, netns, vrf, beacon, template, keySource, key, rotation, retries, backoff, guardrails, schedule, mux, pinning, ca)
+								if err != nil {
+									dash.ShowError(fmt.Sprintf("Could not render agent: %s", err), nil)
+									return
+								}
+
+								if problems != "" {
+									dash.ShowError(fmt.Sprintf("agent.go saved to %s, it would not build:\n\n%s", fullPath, problems), nil)
+									return
+								}
+
+								dash.ShowInfo(fmt.Sprintf("agent.go saved to %s, no problems found", fullPath), nil)
+							})
 						}
 
+						menu.AddItem(modals.NewMenuModalElem("Redact secrets", func() {
+							render(true)
+						}))
+						menu.AddItem(modals.NewMenuModalElem("Include secrets (issues an agent certificate)", func() {
+							render(false)
+						}))
+
+						dash.AddPage(menu.GetID(), menu, true, true)
+					})
+					gen.SetResourcesFunc(func() {
+						res := forms.NewResourcesForm()
+						res.SetDoneFunc(func() {
+							dash.RemovePage(res.GetID())
+						})
+						dash.AddPage(res.GetID(), res, true, true)
+					})
+					gen.SetGuardrailsFunc(func() {
+						guards := forms.NewGuardrailsForm()
+						guards.SetDoneFunc(func() {
+							dash.RemovePage(guards.GetID())
+						})
+						dash.AddPage(guards.GetID(), guards, true, true)
+					})
+					gen.SetCancelFunc(func() {
 						dash.RemovePage(gen.GetID())
-						dash.ShowInfo(fmt.Sprintf("Agent binary saved to %s", fullPath), nil)
 					})
+					dash.AddPage(gen.GetID(), gen, true, true)
 				})
-				gen.SetCancelFunc(func() {
-					dash.RemovePage(gen.GetID())
+			case tcell.KeyCtrlP:
+				dash.DoWithLoader("Loading route profiles...", func() {
+					profiles, err := dash.routeProfilesFunc()
+					if err != nil {
+						dash.ShowError(fmt.Sprintf("Could not load route profiles: %s", err), nil)
+						return
+					}
+
+					if len(profiles) < 1 {
+						dash.ShowInfo("No route profiles defined. Use \"Save routes as profile\" on a session to create one.", nil)
+						return
+					}
+
+					menu := modals.NewMenuModal("Route profiles")
+					cleanup := func() {
+						dash.RemovePage(menu.GetID())
+					}
+
+					for _, prof := range profiles {
+						name := prof.Name
+						menu.AddItem(modals.NewMenuModalElem(fmt.Sprintf("Remove %s", name), func() {
+							dash.DoWithConfirm(fmt.Sprintf("Remove route profile '%s'?", name), func() {
+								dash.DoWithLoader("Removing route profile...", func() {
+									err := dash.removeRouteProfileFunc(name)
+									if err != nil {
+										dash.ShowError(fmt.Sprintf("Could not remove route profile: %s", err), cleanup)
+										return
+									}
+
+									dash.ShowInfo("Route profile removed", cleanup)
+								})
+							})
+						}))
+					}
+
+					menu.SetCancelFunc(cleanup)
+					dash.AddPage(menu.GetID(), menu, true, true)
+				})
+			case tcell.KeyCtrlL:
+				filter := forms.NewFilterForm()
+				filter.SetSubmitFunc(func(query string) {
+					dash.RemovePage(filter.GetID())
+					dash.filter = query
+					dash.RefreshData()
 				})
-				dash.AddPage(gen.GetID(), gen, true, true)
+				filter.SetCancelFunc(func() {
+					dash.RemovePage(filter.GetID())
+				})
+				dash.AddPage(filter.GetID(), filter, true, true)
 			case tcell.KeyCtrlT:
 				trace := forms.NewTracerouteForm()
 				trace.SetSubmitFunc(func(address string) {
@@ -393,6 +1896,34 @@ func (dash *DashboardPage) InputHandler() func(event *tcell.EventKey, setFocus f
 					dash.RemovePage(trace.GetID())
 				})
 				dash.AddPage(trace.GetID(), trace, true, true)
+			case tcell.KeyCtrlF:
+				lookup := forms.NewLookupBuildForm()
+				lookup.SetSubmitFunc(func(query string) {
+					dash.DoWithLoader("Looking up agent builds...", func() {
+						builds, err := dash.lookupBuildFunc(query)
+						if err != nil {
+							dash.ShowError(fmt.Sprintf("Could not look up agent builds: %s", err), nil)
+							return
+						}
+
+						if len(builds) < 1 {
+							dash.ShowInfo("No matching agent builds", nil)
+							return
+						}
+
+						var lines []string
+						for _, build := range builds {
+							lines = append(lines, build.String())
+						}
+
+						dash.RemovePage(lookup.GetID())
+						dash.ShowText("Agent builds", strings.Join(lines, "\n\n"), nil)
+					})
+				})
+				lookup.SetCancelFunc(func() {
+					dash.RemovePage(lookup.GetID())
+				})
+				dash.AddPage(lookup.GetID(), lookup, true, true)
 			default:
 				defaultHandler := dash.flex.InputHandler()
 				defaultHandler(event, setFocus)
@@ -429,6 +1960,22 @@ func (dash *DashboardPage) SetAdminFunc(f func()) {
 	dash.adminFunc = f
 }
 
+func (dash *DashboardPage) SetReplayFunc(f func()) {
+	dash.replayFunc = f
+}
+
+func (dash *DashboardPage) SetBuildsFunc(f func()) {
+	dash.buildsFunc = f
+}
+
+func (dash *DashboardPage) SetAuditFunc(f func()) {
+	dash.auditFunc = f
+}
+
+func (dash *DashboardPage) SetExportStateFunc(f func(string) (string, error)) {
+	dash.exportStateFunc = f
+}
+
 func (dash *DashboardPage) SetDataFunc(f func() ([]*session.Session, error)) {
 	dash.fetchData = f
 }
@@ -437,10 +1984,50 @@ func (dash *DashboardPage) SetMetadataFunc(f func() (*config.Config, *operator.O
 	dash.getMetadata = f
 }
 
-func (dash *DashboardPage) SetGenerateFunc(f func(string, string, string, string, bool, string, bool) (string, error)) {
+func (dash *DashboardPage) SetGenerateFunc(f func(context.Context, func(string), string, string, string, string, string, bool, bool, bool, bool, bool, bool, bool, gogo.GarbleOptions, forms.WindowsResources, string, bool, string, string, string, string, string, string, string, string, []string, string, string, string, string, string, agentbuild.Guardrails, agentbuild.Schedule, agentbuild.Multiplexer, string, string) (string, *agentbuild.AgentBuild, error)) {
 	dash.generateFunc = f
 }
 
+func (dash *DashboardPage) SetRenderFunc(f func(bool, string, string, string, string, string, string, bool, string, string, string, string, string, string, string, string, string, string, string, agentbuild.Guardrails, agentbuild.Schedule, agentbuild.Multiplexer, string, string) (string, string, error)) {
+	dash.renderFunc = f
+}
+
+func (dash *DashboardPage) SetSigningKeysFunc(f func() ([]*agentbuild.SigningKey, error)) {
+	dash.signingKeysFunc = f
+}
+
+func (dash *DashboardPage) SetBuildHooksFunc(f func() ([]*agentbuild.Hook, error)) {
+	dash.buildHooksFunc = f
+}
+
+func (dash *DashboardPage) SetTransportProfilesFunc(f func() ([]*transport.Profile, error)) {
+	dash.transportProfilesFunc = f
+}
+
+func (dash *DashboardPage) SetTemplatesFunc(f func() ([]*agentbuild.Template, error)) {
+	dash.templatesFunc = f
+}
+
+func (dash *DashboardPage) SetAttachmentsFunc(f func(*session.Session) ([]*attachment.Attachment, error)) {
+	dash.attachmentsFunc = f
+}
+
+func (dash *DashboardPage) SetAddAttachmentFunc(f func(*session.Session, string, string) error) {
+	dash.addAttachmentFunc = f
+}
+
+func (dash *DashboardPage) SetDownloadAttachmentFunc(f func(*attachment.Attachment, string) (string, error)) {
+	dash.downloadAttachmentFunc = f
+}
+
+func (dash *DashboardPage) SetRemoveAttachmentFunc(f func(*attachment.Attachment) error) {
+	dash.removeAttachmentFunc = f
+}
+
+func (dash *DashboardPage) SetLookupBuildFunc(f func(string) ([]*agentbuild.AgentBuild, error)) {
+	dash.lookupBuildFunc = f
+}
+
 func (dash *DashboardPage) SetSessionStartFunc(f func(*session.Session) error) {
 	dash.sessionStartFunc = f
 }
@@ -449,15 +2036,19 @@ func (dash *DashboardPage) SetSessionStopFunc(f func(*session.Session) error) {
 	dash.sessionStopFunc = f
 }
 
+func (dash *DashboardPage) SetAnnotateFunc(f func(*session.Session, string, []string, string) error) {
+	dash.annotateFunc = f
+}
+
 func (dash *DashboardPage) SetSessionRenameFunc(f func(*session.Session, string) error) {
 	dash.sessionRenameFunc = f
 }
 
-func (dash *DashboardPage) SetSessionAddRouteFunc(f func(*session.Session, string, int, bool) error) {
+func (dash *DashboardPage) SetSessionAddRouteFunc(f func(*session.Session, string, int, bool, string, bool) error) {
 	dash.sessionAddRouteFunc = f
 }
 
-func (dash *DashboardPage) SetSessionEditRouteFunc(f func(*session.Session, string, string, int, bool) error) {
+func (dash *DashboardPage) SetSessionEditRouteFunc(f func(*session.Session, string, string, int, bool, string, bool) error) {
 	dash.sessionEditRouteFunc = f
 }
 
@@ -469,7 +2060,7 @@ func (dash *DashboardPage) SetSessionRemoveRouteFunc(f func(*session.Session, st
 	dash.sessionRemoveRouteFunc = f
 }
 
-func (dash *DashboardPage) SetSessionAddRedirectorFunc(f func(*session.Session, string, string, string) error) {
+func (dash *DashboardPage) SetSessionAddRedirectorFunc(f func(*session.Session, string, string, string, string, bool) error) {
 	dash.sessionAddRedirectorFunc = f
 }
 
@@ -481,10 +2072,147 @@ func (dash *DashboardPage) SetSessionKillFunc(f func(*session.Session) error) {
 	dash.sessionRemoveFunc = f
 }
 
+func (dash *DashboardPage) SetSessionBurnFunc(f func(*session.Session) ([]string, []string, error)) {
+	dash.sessionBurnFunc = f
+}
+
+func (dash *DashboardPage) SetSessionRevokeCertFunc(f func(*session.Session) error) {
+	dash.sessionRevokeCertFunc = f
+}
+
+func (dash *DashboardPage) SetSessionKillAgentFunc(f func(*session.Session) error) {
+	dash.sessionKillAgentFunc = f
+}
+
 func (dash *DashboardPage) SetTracerouteFunc(f func(string) ([]string, error)) {
 	dash.tracerouteFunc = f
 }
 
+func (dash *DashboardPage) SetSessionThroughputFunc(f func(*session.Session) (int64, time.Duration, error)) {
+	dash.sessionThroughputFunc = f
+}
+
+func (dash *DashboardPage) SetSessionSpoofSourceFunc(f func(*session.Session, bool) error) {
+	dash.sessionSpoofSourceFunc = f
+}
+
+func (dash *DashboardPage) SetSessionCompressionFunc(f func(*session.Session, string, string) error) {
+	dash.sessionCompressionFunc = f
+}
+
+func (dash *DashboardPage) SetSessionMirrorFunc(f func(*session.Session, string) error) {
+	dash.sessionMirrorFunc = f
+}
+
+func (dash *DashboardPage) SetSessionBeaconFunc(f func(*session.Session, time.Duration, uint8) error) {
+	dash.sessionBeaconFunc = f
+}
+
+func (dash *DashboardPage) SetSessionWorkingHoursFunc(f func(*session.Session, string) error) {
+	dash.sessionWorkingHoursFunc = f
+}
+
+func (dash *DashboardPage) SetSessionPersistFunc(f func(*session.Session, string, bool) (string, error)) {
+	dash.sessionPersistFunc = f
+}
+
+func (dash *DashboardPage) SetSessionBreakoutFunc(f func(*session.Session, []protocol.BreakoutRule) error) {
+	dash.sessionBreakoutFunc = f
+}
+
+func (dash *DashboardPage) SetSessionBandwidthFunc(f func(*session.Session, int64) error) {
+	dash.sessionBandwidthFunc = f
+}
+
+func (dash *DashboardPage) SetSessionDiagnoseFunc(f func(*session.Session) ([]protocol.DiagnosticCheck, error)) {
+	dash.sessionDiagnoseFunc = f
+}
+
+func (dash *DashboardPage) SetSessionRefreshNetworkFunc(f func(*session.Session) ([]string, error)) {
+	dash.sessionRefreshNetworkFunc = f
+}
+
+func (dash *DashboardPage) SetSessionRefreshHostInfoFunc(f func(*session.Session) error) {
+	dash.sessionRefreshHostInfoFunc = f
+}
+
+func (dash *DashboardPage) SetSessionHandoffFunc(f func(*session.Session, string, string, bool) error) {
+	dash.sessionHandoffFunc = f
+}
+
+func (dash *DashboardPage) SetSessionAnswerHandoffFunc(f func(*session.Session, bool) error) {
+	dash.sessionAnswerHandoffFunc = f
+}
+
+func (dash *DashboardPage) SetSessionClaimFunc(f func(*session.Session, bool, bool) error) {
+	dash.sessionClaimFunc = f
+}
+
+func (dash *DashboardPage) SetSessionSocksFunc(f func(*session.Session, string, string, string) error) {
+	dash.sessionSocksFunc = f
+}
+
+func (dash *DashboardPage) SetListFilesFunc(f func(*session.Session, string) (string, []session.FileEntry, error)) {
+	dash.listFilesFunc = f
+}
+
+// SetDownloadFileFunc gets the remote and local paths and whether to resume, it returns where the file was
+// saved and its SHA-256
+func (dash *DashboardPage) SetDownloadFileFunc(f func(context.Context, func(int64, int64), *session.Session, string, string, bool) (string, string, error)) {
+	dash.downloadFileFunc = f
+}
+
+// SetUploadFileFunc gets the local and remote paths and whether to resume, it returns the SHA-256 of the file
+func (dash *DashboardPage) SetUploadFileFunc(f func(context.Context, func(int64, int64), *session.Session, string, string, bool) (string, error)) {
+	dash.uploadFileFunc = f
+}
+
+// SetSessionExecFunc gets the command and its timeout, output is called with each piece of it as the command
+// writes it. It returns the exit code
+func (dash *DashboardPage) SetSessionExecFunc(f func(context.Context, func(bool, []byte), *session.Session, string, time.Duration) (int, error)) {
+	dash.sessionExecFunc = f
+}
+
+// SetSessionSweepFunc gets the targets, ports and timeout of a sweep, result is called with each live host as the
+// agent finds it
+func (dash *DashboardPage) SetSessionSweepFunc(f func(context.Context, func(protocol.SweepResultPacket), *session.Session, []string, []uint16, time.Duration) (protocol.SweepDonePacket, error)) {
+	dash.sessionSweepFunc = f
+}
+
+func (dash *DashboardPage) SetAgentBuildsFunc(f func() ([]*agentbuild.AgentBuild, error)) {
+	dash.agentBuildsFunc = f
+}
+
+// SetUpdateAgentFunc gets the build to update to, it returns once the agent is connected again
+func (dash *DashboardPage) SetUpdateAgentFunc(f func(*session.Session, string) error) {
+	dash.updateAgentFunc = f
+}
+
+// SetRotateAgentCertFunc returns the serial of the revoked certificate once the agent is connected again
+func (dash *DashboardPage) SetRotateAgentCertFunc(f func(*session.Session) (string, error)) {
+	dash.rotateAgentCertFunc = f
+}
+
+func (dash *DashboardPage) SetSessionWakeFunc(f func(*session.Session, bool) error) {
+	dash.sessionWakeFunc = f
+}
+
+func (dash *DashboardPage) SetSessionApplyProfileFunc(f func(*session.Session, string) error) {
+	dash.sessionApplyProfileFunc = f
+}
+
+func (dash *DashboardPage) SetRouteProfilesFunc(f func() ([]*profile.Profile, error)) {
+	dash.routeProfilesFunc = f
+}
+
+func (dash *DashboardPage) SetSaveRouteProfileFunc(f func(*profile.Profile) error) {
+	dash.saveRouteProfileFunc = f
+}
+
+func (dash *DashboardPage) SetRemoveRouteProfileFunc(f func(string) error) {
+	dash.removeRouteProfileFunc = f
+}
+
 func (dash *DashboardPage) RefreshData() {
 	data, err := dash.fetchData()
 	if err != nil {
@@ -493,10 +2221,20 @@ func (dash *DashboardPage) RefreshData() {
 	}
 
 	dash.data = data
-	dash.sessions.SetData(data)
-	dash.interfaces.SetData(data)
-	dash.routes.SetData(data)
-	dash.redirectors.SetData(data)
+
+	shown := session.Filter(data, dash.filter)
+	title := "SESSIONS"
+	if dash.filter != "" {
+		title = fmt.Sprintf("SESSIONS (%d/%d matching '%s')", len(shown), len(data), tview.Escape(dash.filter))
+	}
+	dash.sessions.SetTitle(fmt.Sprintf("[::b]%s", title))
+	dash.routes.SetFilter(dash.filter)
+
+	dash.sessions.SetData(shown)
+	dash.hostInfo.SetData(shown)
+	dash.interfaces.SetData(shown)
+	dash.routes.SetData(shown)
+	dash.redirectors.SetData(shown)
 
 	config, operator, err := dash.getMetadata()
 	if err != nil {
@@ -504,6 +2242,7 @@ func (dash *DashboardPage) RefreshData() {
 		return
 	}
 
+	dash.serverConfig = config
 	dash.server.SetData(config, operator)
 }
 
@@ -511,10 +2250,24 @@ func (dash *DashboardPage) GetData() []*session.Session {
 	return dash.data
 }
 
+func (dash *DashboardPage) Help() help.Doc {
+	return help.Doc{
+		Title:   "Dashboard",
+		Summary: "Sessions, their interfaces, routes and redirectors. Select a session to see its details, press Enter on an item for what can be done with it.",
+	}
+}
+
 func (dash *DashboardPage) GetNavBar() []widgets.NavBarElem {
 	navbar := []widgets.NavBarElem{
 		widgets.NewNavBarElem(tcell.KeyCtrlN, "Generate"),
+		widgets.NewNavBarElem(tcell.KeyCtrlL, "Filter"),
 		widgets.NewNavBarElem(tcell.KeyCtrlT, "Traceroute"),
+		widgets.NewNavBarElem(tcell.KeyCtrlF, "Find build"),
+		widgets.NewNavBarElem(tcell.KeyCtrlB, "Builds"),
+		widgets.NewNavBarElem(tcell.KeyCtrlP, "Route profiles"),
+		widgets.NewNavBarElem(tcell.KeyCtrlR, "Replay"),
+		widgets.NewNavBarElem(tcell.KeyCtrlU, "Audit"),
+		widgets.NewNavBarElem(tcell.KeyCtrlE, "Export state"),
 		widgets.NewNavBarElem(tcell.KeyTab, "Switch pane"),
 	}
 
@@ -581,6 +2334,9 @@ func (dash *DashboardPage) ShowText(title string, text string, done func()) {
 	modal := modals.NewTextModal(title, text)
 	modal.SetDoneFunc(func() {
 		dash.RemovePage(modal.GetID())
+		if done != nil {
+			done()
+		}
 	})
 	dash.AddPage(modal.GetID(), modal, true, true)
 }
@@ -0,0 +1,343 @@
+package pages
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
+	modals "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	widgets "github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
+	"github.com/ttpreport/ligolo-mp/v2/internal/audit"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+const (
+	minReplaySpeed = 0.25
+	maxReplaySpeed = 1024
+)
+
+// ReplayPage plays the server's audit log back, it never talks to sessions so nothing here can change the engagement
+type ReplayPage struct {
+	tview.Pages
+
+	flex        *tview.Flex
+	rows        []*tview.Flex
+	status      *tview.TextView
+	events      *tview.TextView
+	sessions    *widgets.SessionsWidget
+	interfaces  *widgets.InterfacesWidget
+	routes      *widgets.RoutesWidget
+	redirectors *widgets.RedirectorsWidget
+
+	replayFunc func(context.Context, time.Time, float64, func(*audit.Record)) error
+	switchback func()
+
+	mu       sync.Mutex
+	cancel   context.CancelFunc
+	speed    float64
+	position time.Time
+	paused   bool
+	finished bool
+}
+
+func NewReplayPage() *ReplayPage {
+	page := &ReplayPage{
+		Pages:       *tview.NewPages(),
+		flex:        tview.NewFlex(),
+		status:      tview.NewTextView(),
+		events:      tview.NewTextView(),
+		sessions:    widgets.NewSessionsWidget(),
+		interfaces:  widgets.NewInterfacesWidget(),
+		routes:      widgets.NewRoutesWidget(),
+		redirectors: widgets.NewRedirectorsWidget(),
+		speed:       1,
+	}
+
+	page.status.SetTitle(fmt.Sprintf("[::b]%s", strings.ToUpper("replay")))
+	page.status.SetBorder(true)
+	page.status.SetTextAlign(tview.AlignCenter)
+
+	page.events.SetDynamicColors(true)
+	page.events.SetBorder(true)
+	page.events.SetBorderColor(style.BorderColor)
+	page.events.SetTitleColor(style.FgColor)
+	page.events.SetBackgroundColor(style.BgColor)
+	page.events.SetTitle(fmt.Sprintf("[::b]%s", strings.ToUpper("events")))
+
+	page.sessions.SetSelectionChangedFunc(func(sess *session.Session) {
+		page.sessions.SetSelectedSession(sess)
+		page.interfaces.SetSelectedSession(sess)
+		page.routes.SetSelectedSession(sess)
+		page.redirectors.SetSelectedSession(sess)
+	})
+
+	firstRow := tview.NewFlex()
+	firstRow.SetDirection(tview.FlexColumn)
+	firstRow.AddItem(page.sessions, 0, 65, true)
+	firstRow.AddItem(page.interfaces, 0, 35, false)
+
+	secondRow := tview.NewFlex()
+	secondRow.SetDirection(tview.FlexColumn)
+	secondRow.AddItem(page.routes, 0, 50, false)
+	secondRow.AddItem(page.redirectors, 0, 50, false)
+
+	page.rows = []*tview.Flex{firstRow, secondRow}
+
+	page.flex.SetDirection(tview.FlexRow)
+	page.flex.AddItem(page.status, 3, 0, false)
+	page.flex.AddItem(firstRow, 0, 35, true)
+	page.flex.AddItem(secondRow, 0, 35, false)
+	page.flex.AddItem(page.events, 0, 30, false)
+
+	page.AddAndSwitchToPage("main", page.flex, true)
+
+	return page
+}
+
+// ApplyLayout follows the layout preset the same way the dashboard does
+func (page *ReplayPage) ApplyLayout() {
+	utils.LayoutPanes(page.rows...)
+}
+
+// Start plays the log from the beginning at normal speed
+func (page *ReplayPage) Start() {
+	page.Stop()
+
+	page.mu.Lock()
+	page.speed = 1
+	page.position = time.Time{}
+	page.paused = false
+	page.finished = false
+	page.mu.Unlock()
+
+	page.events.Clear()
+	page.setData(nil)
+	page.play()
+}
+
+// Stop drops the stream, the position is kept so playback can resume from it
+func (page *ReplayPage) Stop() {
+	page.mu.Lock()
+	defer page.mu.Unlock()
+
+	if page.cancel != nil {
+		page.cancel()
+		page.cancel = nil
+	}
+}
+
+// play streams from the current position, speed changes restart the stream since the server does the pacing
+func (page *ReplayPage) play() {
+	page.mu.Lock()
+	defer page.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	page.cancel = cancel
+
+	since := page.position
+	if !since.IsZero() {
+		since = since.Add(time.Nanosecond)
+	}
+	speed := page.speed
+
+	go func() {
+		err := page.replayFunc(ctx, since, speed, page.apply)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			page.ShowError(fmt.Sprintf("Replay failed: %s", err), nil)
+		}
+
+		page.mu.Lock()
+		page.finished = true
+		page.mu.Unlock()
+		page.refreshStatus()
+	}()
+
+	page.refreshStatusLocked()
+}
+
+func (page *ReplayPage) apply(record *audit.Record) {
+	if record.Snapshot {
+		page.setData(record.Sessions)
+	}
+
+	// the state sent ahead of a resumed stream has no event and comes from before the position
+	if record.Data != "" {
+		page.mu.Lock()
+		page.position = record.Time
+		page.mu.Unlock()
+
+		line := fmt.Sprintf("%s [%s] %s\n", record.Time.Format(time.DateTime), record.Type, tview.Escape(record.Data))
+		page.events.SetText(line + page.events.GetText(false))
+	}
+
+	page.refreshStatus()
+}
+
+func (page *ReplayPage) setData(data []*session.Session) {
+	page.sessions.SetData(data)
+	page.interfaces.SetData(data)
+	page.routes.SetData(data)
+	page.redirectors.SetData(data)
+}
+
+func (page *ReplayPage) refreshStatus() {
+	page.mu.Lock()
+	defer page.mu.Unlock()
+
+	page.refreshStatusLocked()
+}
+
+func (page *ReplayPage) refreshStatusLocked() {
+	state := fmt.Sprintf("Playing at %gx", page.speed)
+	switch {
+	case page.finished:
+		state = "Finished"
+	case page.paused:
+		state = "Paused"
+	}
+
+	position := "start"
+	if !page.position.IsZero() {
+		position = page.position.Format(time.DateTime)
+	}
+
+	page.status.SetText(fmt.Sprintf("%s | Position: %s", state, position))
+}
+
+func (page *ReplayPage) togglePause() {
+	page.mu.Lock()
+	if page.finished {
+		page.mu.Unlock()
+		return
+	}
+	page.paused = !page.paused
+	paused := page.paused
+	page.mu.Unlock()
+
+	page.Stop()
+	if !paused {
+		page.play()
+	} else {
+		page.refreshStatus()
+	}
+}
+
+func (page *ReplayPage) changeSpeed(factor float64) {
+	page.mu.Lock()
+	page.speed = min(max(page.speed*factor, minReplaySpeed), maxReplaySpeed)
+	running := !page.paused && !page.finished
+	page.mu.Unlock()
+
+	if running {
+		page.Stop()
+		page.play()
+	} else {
+		page.refreshStatus()
+	}
+}
+
+func (page *ReplayPage) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		key := event.Key()
+
+		if page.flex.HasFocus() {
+			switch key {
+			case tcell.KeyTab:
+				focusOrder := []tview.Primitive{
+					page.sessions,
+					page.interfaces,
+					page.routes,
+					page.redirectors,
+					page.events,
+				}
+
+				for id, pane := range focusOrder {
+					if pane.HasFocus() {
+						nextId := (id + 1) % len(focusOrder)
+						setFocus(focusOrder[nextId])
+						break
+					}
+				}
+			case tcell.KeyCtrlR:
+				page.Stop()
+				page.switchback()
+			case tcell.KeyCtrlP:
+				page.togglePause()
+			case tcell.KeyCtrlF:
+				page.changeSpeed(2)
+			case tcell.KeyCtrlS:
+				page.changeSpeed(0.5)
+			case tcell.KeyCtrlB:
+				page.Start()
+			default:
+				defaultHandler := page.Pages.InputHandler()
+				defaultHandler(event, setFocus)
+			}
+		} else {
+			switch key {
+			case tcell.KeyEscape:
+				if page.GetPageCount() > 1 {
+					frontPage, _ := page.GetFrontPage()
+					page.RemovePage(frontPage)
+				}
+			default:
+				defaultHandler := page.Pages.InputHandler()
+				defaultHandler(event, setFocus)
+			}
+		}
+	}
+}
+
+func (page *ReplayPage) GetID() string {
+	return "replay"
+}
+
+func (page *ReplayPage) Help() help.Doc {
+	return help.Doc{
+		Title:   "Replay",
+		Summary: "Plays back the audit log, panes show the state of the server as it was at the replayed time.",
+	}
+}
+
+func (page *ReplayPage) GetNavBar() []widgets.NavBarElem {
+	return []widgets.NavBarElem{
+		widgets.NewNavBarElem(tcell.KeyCtrlR, "Back"),
+		widgets.NewNavBarElem(tcell.KeyCtrlP, "Pause/resume"),
+		widgets.NewNavBarElem(tcell.KeyCtrlF, "Faster"),
+		widgets.NewNavBarElem(tcell.KeyCtrlS, "Slower"),
+		widgets.NewNavBarElem(tcell.KeyCtrlB, "From start"),
+		widgets.NewNavBarElem(tcell.KeyTab, "Switch pane"),
+	}
+}
+
+func (page *ReplayPage) SetReplayFunc(f func(context.Context, time.Time, float64, func(*audit.Record)) error) {
+	page.replayFunc = f
+}
+
+func (page *ReplayPage) SetSwitchbackFunc(f func()) {
+	page.switchback = f
+}
+
+func (page *ReplayPage) ShowError(text string, done func()) {
+	modal := modals.NewErrorModal()
+	modal.SetText(text)
+	modal.SetDoneFunc(func(_ int, _ string) {
+		page.RemovePage(modal.GetID())
+
+		if done != nil {
+			done()
+		}
+	})
+	page.AddPage(modal.GetID(), modal, true, true)
+}
@@ -2,26 +2,51 @@ package tui
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/forms"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/modals"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/pages"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/widgets"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/attachment"
+	"github.com/ttpreport/ligolo-mp/v2/internal/audit"
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
 	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+	"github.com/ttpreport/ligolo-mp/v2/internal/listener"
+	"github.com/ttpreport/ligolo-mp/v2/internal/malleable"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"github.com/ttpreport/ligolo-mp/v2/internal/snapshot"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
 	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
 )
 
+const throughputTestSize = 32 << 20
+
+// toastDuration is how long notifications stay above the navbar
+const toastDuration = 5 * time.Second
+
 type App struct {
 	tview.Application
 	root         *tview.Flex
@@ -31,12 +56,21 @@ type App struct {
 	credentials  *pages.CredentialsPage
 	dashboard    *pages.DashboardPage
 	admin        *pages.AdminPage
+	replay       *pages.ReplayPage
+	builds       *pages.BuildsPage
+	audit        *pages.AuditPage
 	confirmModal *modals.ConfirmModal
 	loaderModal  *modals.LoaderModal
 	navbar       *widgets.NavBar
+	toast        *widgets.Toast
 	operService  *operator.OperatorService
 	operator     *operator.Operator
 	currentPage  string
+	help         *modals.HelpModal
+
+	layoutMode    string
+	compactWidth  int
+	compactHeight int
 }
 
 func NewApp(operService *operator.OperatorService) *App {
@@ -49,17 +83,26 @@ func NewApp(operService *operator.OperatorService) *App {
 		credentials: pages.NewCredentialsPage(),
 		dashboard:   pages.NewDashboardPage(),
 		admin:       pages.NewAdminPage(),
+		replay:      pages.NewReplayPage(),
+		builds:      pages.NewBuildsPage(),
+		audit:       pages.NewAuditPage(),
 		navbar:      widgets.NewNavBar(),
+		toast:       widgets.NewToast(),
 		operService: operService,
+		layoutMode:  utils.LayoutAuto,
 	}
 
 	app.root.SetDirection(tview.FlexRow).
 		AddItem(app.layout, 0, 99, true).
+		AddItem(app.toast, 0, 0, false).
 		AddItem(app.navbar, 0, 1, false)
 
 	app.initCredentials()
 	app.initDashboard()
 	app.initAdmin()
+	app.initReplay()
+	app.initBuilds()
+	app.initAudit()
 
 	app.layout.SetDirection(tview.FlexRow)
 	app.layout.AddItem(app.pages, 0, 80, false)
@@ -68,14 +111,49 @@ func NewApp(operService *operator.OperatorService) *App {
 	app.SwitchToPage(app.credentials)
 
 	go app.autoRedraw()
-	go app.autoRefresh()
 
 	return app
 }
 
+// SetLayout picks the layout preset, auto switches to compact whenever the terminal is smaller than width x height
+func (app *App) SetLayout(mode string, width int, height int) {
+	app.layoutMode = mode
+	app.compactWidth = width
+	app.compactHeight = height
+}
+
+// adaptLayout runs before every draw, so resizing the terminal switches presets right away
+func (app *App) adaptLayout(screen tcell.Screen) bool {
+	width, height := screen.Size()
+
+	compact := app.layoutMode == utils.LayoutCompact ||
+		(app.layoutMode == utils.LayoutAuto && (width < app.compactWidth || height < app.compactHeight))
+	if compact == utils.Compact() {
+		return false
+	}
+
+	utils.SetCompact(compact)
+	if compact {
+		app.layout.ResizeItem(app.Logs, 6, 0)
+		app.root.ResizeItem(app.navbar, 1, 0)
+	} else {
+		app.layout.ResizeItem(app.Logs, 0, 20)
+		app.root.ResizeItem(app.navbar, 0, 1)
+	}
+
+	app.dashboard.ApplyLayout()
+	app.admin.ApplyLayout()
+	app.replay.ApplyLayout()
+
+	return false
+}
+
 func (app *App) Reset() {
 	app.dashboard.Reset()
 	app.admin.Reset()
+	app.replay.Stop()
+	app.builds.Reset()
+	app.audit.Reset()
 
 	app.SwitchToPage(app.credentials)
 }
@@ -90,25 +168,307 @@ func (app *App) autoRedraw() {
 	}
 }
 
-func (app *App) autoRefresh() {
-	ticker := time.NewTicker(30 * time.Second)
+func (app *App) SwitchToPage(p pages.Page) {
+	app.pages.RemovePage(app.currentPage)
+
+	app.currentPage = p.GetID()
+	app.pages.AddAndSwitchToPage(app.currentPage, p, true)
+	app.navbar.SetData(p.GetNavBar())
+}
+
+// showHelp documents whatever has the focus along with the keys of the current page
+func (app *App) showHelp() {
+	doc, ok := help.Find(app.pages)
+	if !ok {
+		doc = help.Doc{Title: "Help"}
+	}
+
+	for _, page := range []pages.Page{app.credentials, app.dashboard, app.admin, app.replay, app.builds, app.audit} {
+		if page.GetID() == app.currentPage {
+			doc.Keys = append(doc.Keys, widgets.NavBarHelp(page.GetNavBar())...)
+		}
+	}
+	doc.Keys = append(doc.Keys,
+		help.Key{Name: utils.HelpScreenKey.KeyLabel, Description: "show or close this help"},
+		help.Key{Name: utils.AppExitKey.KeyLabel, Description: utils.AppExitKey.KeyDesc},
+	)
+
+	focused := app.GetFocus()
+	app.help = modals.NewHelpModal(doc.Render())
+	app.help.SetDoneFunc(func() {
+		app.pages.RemovePage(app.help.GetID())
+		app.help = nil
+		app.SetFocus(focused)
+	})
+
+	app.pages.AddPage(app.help.GetID(), app.help, true, true)
+	app.SetFocus(app.help)
+}
+
+// receiveAgent follows a build stream until the agent comes and saves it to path, see saveAgent
+func parseRetries(retries string) (uint32, error) {
+	if retries == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.ParseUint(retries, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s is invalid retries", retries)
+	}
+
+	return uint32(n), nil
+}
+
+func receiveAgent(stream interface {
+	Recv() (*pb.GenerateAgentResp, error)
+}, progress func(string), path string) (string, *agentbuild.AgentBuild, error) {
 	for {
-		select {
-		case <-ticker.C:
-			if app.IsConnected() {
-				app.dashboard.RefreshData()
-				app.admin.RefreshData()
+		r, err := stream.Recv()
+		if err != nil {
+			return "", nil, err
+		}
+
+		if r.Progress != "" {
+			progress(r.Progress)
+		}
+
+		if r.AgentBinary != nil {
+			build := agentbuild.ProtoToAgentBuild(r.Build)
+			fullPath, err := saveAgent(path, build, r.AgentBinary, r.Signature, r.Loader)
+			return fullPath, build, err
+		}
+	}
+}
+
+// saveAgent writes an agent to path, or to a default filename if path is a directory. A detached signature and a
+// memory loader are saved next to it
+func saveAgent(path string, build *agentbuild.AgentBuild, agentBinary []byte, signature []byte, loader []byte) (string, error) {
+	info, err := os.Stat(path)
+	if err == nil && info.IsDir() {
+		filename := "agent.bin"
+		switch {
+		case build.Staged:
+			filename = "stager.bin"
+		case build.Format == "dll":
+			filename = "agent.dll"
+		case build.Format == "so":
+			filename = "agent.so"
+		}
+		path = filepath.Join(path, filename)
+	}
+
+	if err = os.WriteFile(path, agentBinary, 0755); err != nil {
+		return "", err
+	}
+
+	if signature != nil {
+		if err = os.WriteFile(path+".asc", signature, 0644); err != nil {
+			return "", err
+		}
+	}
+
+	if loader != nil {
+		if err = os.WriteFile(path+".sh", loader, 0755); err != nil {
+			return "", err
+		}
+	}
+
+	return filepath.Abs(path)
+}
+
+// downloadFile saves a file of the agent to localPath, or under its remote name if localPath is a directory.
+// Resuming keeps what's already saved and only fetches the rest, the whole file is checked against the
+// SHA-256 the agent reports either way
+func (app *App) agentBuilds() ([]*agentbuild.AgentBuild, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	r, err := app.operator.Client().GetAgentBuilds(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var builds []*agentbuild.AgentBuild
+	for _, build := range r.Builds {
+		builds = append(builds, agentbuild.ProtoToAgentBuild(build))
+	}
+
+	return builds, nil
+}
+
+func (app *App) downloadFile(ctx context.Context, progress func(int64, int64), sess *session.Session, remotePath string, localPath string, resume bool) (string, string, error) {
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, remotePath[strings.LastIndexAny(remotePath, `/\`)+1:])
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if resume {
+		flags = os.O_WRONLY | os.O_CREATE
+	}
+
+	f, err := os.OpenFile(localPath, flags, 0600)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", "", err
+	}
+
+	stream, err := app.operator.Client().DownloadFile(ctx, &pb.DownloadFileReq{
+		SessionID: sess.ID,
+		Path:      remotePath,
+		Offset:    offset,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	received, total, remoteHash := offset, int64(0), ""
+	for remoteHash == "" {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return "", "", fmt.Errorf("download interrupted after %s", utils.HumanBytes(received))
+		}
+		if err != nil {
+			return "", "", err
+		}
+
+		switch {
+		case r.Sha256 != "":
+			remoteHash = r.Sha256
+		case len(r.Chunk) > 0:
+			if _, err := f.Write(r.Chunk); err != nil {
+				return "", "", err
 			}
+			received += int64(len(r.Chunk))
+			progress(received, total)
+		default:
+			total = r.Total
+			progress(received, total)
 		}
 	}
+
+	if err := f.Close(); err != nil {
+		return "", "", err
+	}
+
+	localHash, err := fileSha256(localPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	if localHash != remoteHash {
+		return "", "", fmt.Errorf("SHA256 of %s is %s but the agent has %s, download it again without resuming", localPath, localHash, remoteHash)
+	}
+
+	fullPath, err := filepath.Abs(localPath)
+	return fullPath, localHash, err
 }
 
-func (app *App) SwitchToPage(p pages.Page) {
-	app.pages.RemovePage(app.currentPage)
+// uploadFile sends a local file to remotePath on the agent. Resuming looks up how much of it the agent
+// already has and only sends the rest, the whole file is checked against the SHA-256 the agent reports
+// either way
+func (app *App) uploadFile(ctx context.Context, progress func(int64, int64), sess *session.Session, localPath string, remotePath string, resume bool) (string, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
 
-	app.currentPage = p.GetID()
-	app.pages.AddAndSwitchToPage(app.currentPage, p, true)
-	app.navbar.SetData(p.GetNavBar())
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	var offset int64
+	if resume {
+		r, err := app.operator.Client().ListFiles(ctx, &pb.ListFilesReq{
+			SessionID: sess.ID,
+			Path:      remotePath,
+		})
+		if err == nil && len(r.Entries) == 1 && !r.Entries[0].IsDir {
+			offset = r.Entries[0].Size
+		}
+
+		if offset > info.Size() {
+			return "", fmt.Errorf("%s is larger on the agent than locally, upload it again without resuming", remotePath)
+		}
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	stream, err := app.operator.Client().UploadFile(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := stream.Send(&pb.UploadFileReq{
+		SessionID: sess.ID,
+		Path:      remotePath,
+		Offset:    offset,
+		Size:      info.Size() - offset,
+		Mode:      uint32(info.Mode().Perm()),
+	}); err != nil {
+		return "", err
+	}
+
+	sent := offset
+	progress(sent, info.Size())
+
+	chunk := make([]byte, 1<<20)
+	for sent < info.Size() {
+		n, err := f.Read(chunk)
+		if n > 0 {
+			// the server's error comes with CloseAndRecv
+			if err := stream.Send(&pb.UploadFileReq{Chunk: chunk[:n]}); err != nil {
+				break
+			}
+			sent += int64(n)
+			progress(sent, info.Size())
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	r, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", err
+	}
+
+	localHash, err := fileSha256(localPath)
+	if err != nil {
+		return "", err
+	}
+
+	if localHash != r.Sha256 {
+		return "", fmt.Errorf("SHA256 of %s is %s on the agent but %s locally, upload it again without resuming", remotePath, r.Sha256, localHash)
+	}
+
+	return localHash, nil
+}
+
+func fileSha256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
 func (app *App) initCredentials() {
@@ -146,6 +506,17 @@ func (app *App) initCredentials() {
 		return nil
 	})
 
+	app.credentials.SetRenewFunc(app.renewCertificate)
+
+	app.credentials.SetEnrollFunc(func(code string) (*operator.Operator, error) {
+		config, err := operator.Enroll(code)
+		if err != nil {
+			return nil, err
+		}
+
+		return app.operService.ImportOperator(config)
+	})
+
 	app.credentials.SetNewFunc(func(path string) error {
 		_, err := app.operService.NewOperatorFromFile(path)
 		if err != nil {
@@ -163,6 +534,47 @@ func (app *App) initDashboard() {
 		app.SwitchToPage(app.admin)
 	})
 
+	app.dashboard.SetReplayFunc(func() {
+		app.SwitchToPage(app.replay)
+		app.replay.Start()
+	})
+
+	app.dashboard.SetBuildsFunc(func() {
+		app.SwitchToPage(app.builds)
+		app.builds.RefreshData()
+	})
+
+	app.dashboard.SetAuditFunc(func() {
+		app.SwitchToPage(app.audit)
+		app.audit.RefreshData()
+	})
+
+	app.dashboard.SetExportStateFunc(func(path string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetSessions(ctx, &pb.Empty{})
+		if err != nil {
+			return "", err
+		}
+
+		var sessions []*session.Session
+		for _, sess := range r.Sessions {
+			sessions = append(sessions, session.ProtoToSession(sess))
+		}
+
+		info, err := os.Stat(path)
+		if err == nil && info.IsDir() {
+			path = filepath.Join(path, fmt.Sprintf("ligolo-mp-state_%s.json", time.Now().Format("20060102-150405")))
+		}
+
+		if err := snapshot.New(sessions).Save(path); err != nil {
+			return "", err
+		}
+
+		return filepath.Abs(path)
+	})
+
 	app.dashboard.SetDataFunc(func() ([]*session.Session, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
@@ -180,308 +592,1628 @@ func (app *App) initDashboard() {
 		return sessions, nil
 	})
 
-	app.dashboard.SetGenerateFunc(func(path string, servers string, goos string, goarch string, obfuscate bool, proxy string, ignoreEnvProxy bool) (string, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*300)
+	app.dashboard.SetGenerateFunc(func(ctx context.Context, progress func(string), path string, servers string, goos string, goarch string, format string, staged bool, exec bool, persist bool, hostInfo bool, loader bool, override bool, obfuscate bool, garble gogo.GarbleOptions, resources forms.WindowsResources, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, campaign string, template string, signingKey string, hooks []string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string) (string, *agentbuild.AgentBuild, error) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*600) // includes waiting in the server's build queue
 		defer cancel()
 
-		r, err := app.operator.Client().GenerateAgent(ctx, &pb.GenerateAgentReq{
+		retriesNum, err := parseRetries(retries)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var pbGuardrails *pb.Guardrails
+		if !guardrails.Empty() {
+			pbGuardrails = guardrails.Proto()
+		}
+
+		var pbSchedule *pb.Schedule
+		if !schedule.Empty() {
+			pbSchedule = schedule.Proto()
+		}
+
+		var pbMultiplexer *pb.Multiplexer
+		if !mux.Empty() {
+			pbMultiplexer = mux.Proto()
+		}
+
+		var pbResources *pb.WindowsResources
+		if goos == "windows" && !resources.Empty() {
+			pbResources = &pb.WindowsResources{
+				CompanyName:      resources.Version.CompanyName,
+				ProductName:      resources.Version.ProductName,
+				FileDescription:  resources.Version.FileDescription,
+				FileVersion:      resources.Version.FileVersion,
+				ProductVersion:   resources.Version.ProductVersion,
+				OriginalFilename: resources.Version.OriginalFilename,
+				LegalCopyright:   resources.Version.LegalCopyright,
+			}
+
+			var err error
+			if resources.IconPath != "" {
+				if pbResources.Icon, err = os.ReadFile(resources.IconPath); err != nil {
+					return "", nil, err
+				}
+			}
+			if resources.ManifestPath != "" {
+				if pbResources.Manifest, err = os.ReadFile(resources.ManifestPath); err != nil {
+					return "", nil, err
+				}
+			}
+		}
+
+		stream, err := app.operator.Client().GenerateAgent(ctx, &pb.GenerateAgentReq{
 			Servers:        servers,
 			GOOS:           goos,
 			GOARCH:         goarch,
+			Format:         format,
+			Staged:         staged,
+			Exec:           exec,
+			Persist:        persist,
+			HostInfo:       hostInfo,
+			Loader:         loader,
+			Override:       override,
+			Resources:      pbResources,
 			Obfuscate:      obfuscate,
+			GarbleSeed:     garble.Seed,
+			GarbleTiny:     garble.Tiny,
+			GarbleLiterals: garble.Literals,
 			ProxyServer:    proxy,
 			IgnoreEnvProxy: ignoreEnvProxy,
+			UserAgent:      userAgent,
+			Profile:        profile,
+			Netns:          netns,
+			VRF:            vrf,
+			Beacon:         beacon,
+			Campaign:       campaign,
+			Template:       template,
+			SigningKey:     signingKey,
+			Hooks:          hooks,
+			KeySource:      keySource,
+			Key:            key,
+			Rotation:       rotation,
+			Retries:        retriesNum,
+			Backoff:        backoff,
+			Guardrails:     pbGuardrails,
+			Schedule:       pbSchedule,
+			Multiplexer:    pbMultiplexer,
+			Pinning:        &pb.Pinning{Mode: pinning},
+			CA:             ca,
 		})
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 
-		info, err := os.Stat(path)
-		if err == nil && info.IsDir() {
-			path = filepath.Join(path, "agent.bin")
+		return receiveAgent(stream, progress, path)
+	})
+
+	app.dashboard.SetRenderFunc(func(redact bool, path string, servers string, goos string, goarch string, format string, proxy string, ignoreEnvProxy bool, userAgent string, profile string, netns string, vrf string, beacon string, template string, keySource string, key string, rotation string, retries string, backoff string, guardrails agentbuild.Guardrails, schedule agentbuild.Schedule, mux agentbuild.Multiplexer, pinning string, ca string) (string, string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		retriesNum, err := parseRetries(retries)
+		if err != nil {
+			return "", "", err
 		}
 
-		if err = os.WriteFile(path, r.AgentBinary, 0755); err != nil {
-			return "", err
+		var pbGuardrails *pb.Guardrails
+		if !guardrails.Empty() {
+			pbGuardrails = guardrails.Proto()
 		}
 
-		return filepath.Abs(path)
-	})
+		var pbSchedule *pb.Schedule
+		if !schedule.Empty() {
+			pbSchedule = schedule.Proto()
+		}
 
-	app.dashboard.SetSessionStartFunc(func(sess *session.Session) error {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
-		defer cancel()
-		_, err := app.operator.Client().StartRelay(ctx, &pb.StartRelayReq{
-			SessionID: sess.ID,
+		var pbMultiplexer *pb.Multiplexer
+		if !mux.Empty() {
+			pbMultiplexer = mux.Proto()
+		}
+
+		r, err := app.operator.Client().RenderAgent(ctx, &pb.RenderAgentReq{
+			Request: &pb.GenerateAgentReq{
+				Servers:        servers,
+				GOOS:           goos,
+				GOARCH:         goarch,
+				Format:         format,
+				ProxyServer:    proxy,
+				IgnoreEnvProxy: ignoreEnvProxy,
+				UserAgent:      userAgent,
+				Profile:        profile,
+				Netns:          netns,
+				VRF:            vrf,
+				Beacon:         beacon,
+				Template:       template,
+				KeySource:      keySource,
+				Key:            key,
+				Rotation:       rotation,
+				Retries:        retriesNum,
+				Backoff:        backoff,
+				Guardrails:     pbGuardrails,
+				Schedule:       pbSchedule,
+				Multiplexer:    pbMultiplexer,
+				Pinning:        &pb.Pinning{Mode: pinning},
+				CA:             ca,
+			},
+			Redact: redact,
 		})
-		return err
+		if err != nil {
+			return "", "", err
+		}
+
+		// next to where the agent would go
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			path = filepath.Join(path, "agent.go")
+		} else {
+			path += ".go"
+		}
+
+		if err := os.WriteFile(path, r.Source, 0600); err != nil {
+			return "", "", err
+		}
+
+		fullPath, err := filepath.Abs(path)
+		return fullPath, r.Problems, err
 	})
 
-	app.dashboard.SetSessionStopFunc(func(sess *session.Session) error {
+	app.dashboard.SetTemplatesFunc(func() ([]*agentbuild.Template, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().StopRelay(ctx, &pb.StopRelayReq{
-			SessionID: sess.ID,
-		})
-		return err
+
+		r, err := app.operator.Client().GetAgentTemplates(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var templates []*agentbuild.Template
+		for _, t := range r.Templates {
+			templates = append(templates, agentbuild.ProtoToTemplate(t))
+		}
+
+		return templates, nil
 	})
 
-	app.dashboard.SetSessionRenameFunc(func(sess *session.Session, alias string) error {
+	app.dashboard.SetBuildHooksFunc(func() ([]*agentbuild.Hook, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().RenameSession(ctx, &pb.RenameSessionReq{
-			SessionID: sess.ID,
-			Alias:     alias,
-		})
-		return err
+
+		r, err := app.operator.Client().GetBuildHooks(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var hooks []*agentbuild.Hook
+		for _, hook := range r.Hooks {
+			hooks = append(hooks, agentbuild.ProtoToHook(hook))
+		}
+
+		return hooks, nil
 	})
 
-	app.dashboard.SetSessionAddRouteFunc(func(sess *session.Session, cidr string, metric int, loopback bool) error {
+	app.dashboard.SetTransportProfilesFunc(func() ([]*transport.Profile, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		_, err := app.operator.Client().AddRoute(ctx, &pb.AddRouteReq{
-			SessionID: sess.ID,
-			Route: &pb.Route{
-				Cidr:       cidr,
-				Metric:     int32(metric),
-				IsLoopback: loopback,
-			},
-		})
-		return err
+		r, err := app.operator.Client().GetTransportProfiles(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var profiles []*transport.Profile
+		for _, profile := range r.Profiles {
+			profiles = append(profiles, malleable.ProtoToProfile(profile))
+		}
+
+		return profiles, nil
 	})
 
-	app.dashboard.SetSessionEditRouteFunc(func(sess *session.Session, routeID string, cidr string, metric int, loopback bool) error {
+	app.dashboard.SetSigningKeysFunc(func() ([]*agentbuild.SigningKey, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().EditRoute(ctx, &pb.EditRouteReq{
+
+		r, err := app.operator.Client().GetSigningKeys(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var keys []*agentbuild.SigningKey
+		for _, key := range r.Keys {
+			keys = append(keys, agentbuild.ProtoToSigningKey(key))
+		}
+
+		return keys, nil
+	})
+
+	app.dashboard.SetAttachmentsFunc(func(sess *session.Session) ([]*attachment.Attachment, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetAttachments(ctx, &pb.GetAttachmentsReq{
 			SessionID: sess.ID,
-			RouteID:   routeID,
-			Route: &pb.Route{
-				Cidr:       cidr,
-				Metric:     int32(metric),
-				IsLoopback: loopback,
-			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var attachments []*attachment.Attachment
+		for _, a := range r.Attachments {
+			attachments = append(attachments, attachment.ProtoToAttachment(a))
+		}
+
+		return attachments, nil
+	})
+
+	app.dashboard.SetAddAttachmentFunc(func(sess *session.Session, path string, note string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		var name string
+		var content []byte
+		if path != "" {
+			var err error
+			content, err = os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			name = filepath.Base(path)
+		}
+
+		_, err := app.operator.Client().AddAttachment(ctx, &pb.AddAttachmentReq{
+			SessionID: sess.ID,
+			Name:      name,
+			Note:      note,
+			Content:   content,
 		})
 		return err
 	})
 
-	app.dashboard.SetSessionMoveRouteFunc(func(sess *session.Session, routeID string, targetSessionID string) error {
+	app.dashboard.SetDownloadAttachmentFunc(func(a *attachment.Attachment, path string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		r, err := app.operator.Client().DownloadAttachment(ctx, &pb.DownloadAttachmentReq{
+			ID: a.ID,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		info, err := os.Stat(path)
+		if err == nil && info.IsDir() {
+			path = filepath.Join(path, filepath.Base(r.Attachment.Name))
+		}
+
+		if err = os.WriteFile(path, r.Content, 0600); err != nil {
+			return "", err
+		}
+
+		return filepath.Abs(path)
+	})
+
+	app.dashboard.SetRemoveAttachmentFunc(func(a *attachment.Attachment) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().MoveRoute(ctx, &pb.MoveRouteReq{
-			OldSessionID: sess.ID,
-			RouteID:      routeID,
-			NewSessionID: targetSessionID,
+
+		_, err := app.operator.Client().DelAttachment(ctx, &pb.DelAttachmentReq{
+			ID: a.ID,
 		})
 		return err
 	})
 
-	app.dashboard.SetSessionRemoveRouteFunc(func(sess *session.Session, routeID string) error {
+	app.dashboard.SetLookupBuildFunc(func(query string) ([]*agentbuild.AgentBuild, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().DelRoute(ctx, &pb.DelRouteReq{
+
+		// recovered binaries are looked up by what they carry
+		if binary, err := os.ReadFile(query); err == nil {
+			query = agentbuild.Identify(binary)
+		}
+
+		r, err := app.operator.Client().LookupAgentBuild(ctx, &pb.LookupAgentBuildReq{
+			Query: query,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var builds []*agentbuild.AgentBuild
+		for _, build := range r.Builds {
+			builds = append(builds, agentbuild.ProtoToAgentBuild(build))
+		}
+
+		return builds, nil
+	})
+
+	app.dashboard.SetSessionStartFunc(func(sess *session.Session) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().StartRelay(ctx, &pb.StartRelayReq{
 			SessionID: sess.ID,
-			RouteID:   routeID,
 		})
 		return err
 	})
 
-	app.dashboard.SetSessionAddRedirectorFunc(func(sess *session.Session, from string, to string, proto string) error {
+	app.dashboard.SetSessionStopFunc(func(sess *session.Session) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().AddRedirector(ctx, &pb.AddRedirectorReq{
+		_, err := app.operator.Client().StopRelay(ctx, &pb.StopRelayReq{
 			SessionID: sess.ID,
-			From:      from,
-			To:        to,
-			Protocol:  proto,
 		})
 		return err
 	})
 
-	app.dashboard.SetSessionRemoveRedirectorFunc(func(sess *session.Session, redirectorID string) error {
+	app.dashboard.SetSessionSpoofSourceFunc(func(sess *session.Session, enabled bool) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().DelRedirector(ctx, &pb.DelRedirectorReq{
+		_, err := app.operator.Client().SetSpoofSource(ctx, &pb.SetSpoofSourceReq{
+			SessionID: sess.ID,
+			Enabled:   enabled,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionCompressionFunc(func(sess *session.Session, routeID string, compression string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetCompression(ctx, &pb.SetCompressionReq{
+			SessionID:   sess.ID,
+			RouteID:     routeID,
+			Compression: compression,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionMirrorFunc(func(sess *session.Session, target string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetMirror(ctx, &pb.SetMirrorReq{
+			SessionID: sess.ID,
+			Target:    target,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionBeaconFunc(func(sess *session.Session, interval time.Duration, jitter uint8) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetBeacon(ctx, &pb.SetBeaconReq{
+			SessionID:  sess.ID,
+			IntervalMs: interval.Milliseconds(),
+			Jitter:     uint32(jitter),
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionWorkingHoursFunc(func(sess *session.Session, workingHours string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetWorkingHours(ctx, &pb.SetWorkingHoursReq{
 			SessionID:    sess.ID,
-			RedirectorID: redirectorID,
+			WorkingHours: workingHours,
 		})
 		return err
 	})
 
-	app.dashboard.SetSessionKillFunc(func(sess *session.Session) error {
+	app.dashboard.SetSessionHandoffFunc(func(sess *session.Session, operator string, note string, shareLayout bool) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
-		_, err := app.operator.Client().KillSession(ctx, &pb.KillSessionReq{
+
+		var layout string
+		if shareLayout {
+			layout = app.layoutMode
+		}
+
+		_, err := app.operator.Client().HandoffSession(ctx, &pb.HandoffSessionReq{
 			SessionID: sess.ID,
+			Operator:  operator,
+			Note:      note,
+			Layout:    layout,
 		})
 		return err
 	})
 
-	app.dashboard.SetMetadataFunc(func() (*config.Config, *operator.Operator, error) {
+	app.dashboard.SetSessionClaimFunc(func(sess *session.Session, release bool, override bool) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
+		_, err := app.operator.Client().ClaimSession(ctx, &pb.ClaimSessionReq{
+			SessionID: sess.ID,
+			Release:   release,
+			Override:  override,
+		})
 
-		r, err := app.operator.Client().GetMetadata(ctx, &pb.Empty{})
+		return err
+	})
+
+	app.dashboard.SetSessionAnswerHandoffFunc(func(sess *session.Session, accept bool) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().AnswerHandoff(ctx, &pb.AnswerHandoffReq{
+			SessionID: sess.ID,
+			Accept:    accept,
+		})
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 
-		config := config.ProtoToConfig(r.Config)
-		operator := operator.ProtoToOperator(r.Operator)
+		if accept && sess.Handoff.Layout != "" {
+			app.QueueUpdate(func() {
+				app.SetLayout(sess.Handoff.Layout, app.compactWidth, app.compactHeight)
+			})
+		}
+		return nil
+	})
 
-		return config, operator, nil
+	app.dashboard.SetSessionRefreshNetworkFunc(func(sess *session.Session) ([]string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		r, err := app.operator.Client().RefreshNetwork(ctx, &pb.RefreshNetworkReq{
+			SessionID: sess.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return r.Errors, nil
 	})
 
-	app.dashboard.SetTracerouteFunc(func(address string) ([]string, error) {
+	app.dashboard.SetSessionRefreshHostInfoFunc(func(sess *session.Session) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().RefreshHostInfo(ctx, &pb.RefreshHostInfoReq{
+			SessionID: sess.ID,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionPersistFunc(func(sess *session.Session, method string, uninstall bool) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+		r, err := app.operator.Client().Persist(ctx, &pb.PersistReq{
+			SessionID: sess.ID,
+			Method:    method,
+			Uninstall: uninstall,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return r.Location, nil
+	})
+
+	app.dashboard.SetSessionBreakoutFunc(func(sess *session.Session, rules []protocol.BreakoutRule) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetBreakout(ctx, &pb.SetBreakoutReq{
+			SessionID: sess.ID,
+			Rules:     session.BreakoutProto(rules),
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionBandwidthFunc(func(sess *session.Session, limit int64) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetBandwidth(ctx, &pb.SetBandwidthReq{
+			SessionID: sess.ID,
+			Limit:     limit,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionDiagnoseFunc(func(sess *session.Session) ([]protocol.DiagnosticCheck, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		r, err := app.operator.Client().Diagnose(ctx, &pb.DiagnoseReq{
+			SessionID: sess.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return session.ProtoToDiagnostics(r.Checks), nil
+	})
+
+	app.dashboard.SetSessionWakeFunc(func(sess *session.Session, awake bool) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().WakeSession(ctx, &pb.WakeSessionReq{
+			SessionID: sess.ID,
+			Awake:     awake,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionSocksFunc(func(sess *session.Session, address string, username string, password string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().SetSocks(ctx, &pb.SetSocksReq{
+			SessionID: sess.ID,
+			Address:   address,
+			Username:  username,
+			Password:  password,
+		})
+		return err
+	})
+
+	app.dashboard.SetListFilesFunc(func(sess *session.Session, path string) (string, []session.FileEntry, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		r, err := app.operator.Client().Traceroute(ctx, &pb.TracerouteReq{
-			IP: address,
+		r, err := app.operator.Client().ListFiles(ctx, &pb.ListFilesReq{
+			SessionID: sess.ID,
+			Path:      path,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		var entries []session.FileEntry
+		for _, entry := range r.Entries {
+			entries = append(entries, session.ProtoToFileEntry(entry))
+		}
+
+		return r.Path, entries, nil
+	})
+
+	app.dashboard.SetDownloadFileFunc(app.downloadFile)
+	app.dashboard.SetUploadFileFunc(app.uploadFile)
+
+	app.dashboard.SetAgentBuildsFunc(app.agentBuilds)
+
+	app.dashboard.SetUpdateAgentFunc(func(sess *session.Session, buildID string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+		_, err := app.operator.Client().UpdateAgent(ctx, &pb.UpdateAgentReq{
+			SessionID: sess.ID,
+			BuildID:   buildID,
+		})
+		return err
+	})
+
+	app.dashboard.SetRotateAgentCertFunc(func(sess *session.Session) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+		resp, err := app.operator.Client().RotateAgentCert(ctx, &pb.RotateAgentCertReq{
+			SessionID: sess.ID,
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.Revoked, nil
+	})
+
+	app.dashboard.SetSessionExecFunc(func(ctx context.Context, output func(bool, []byte), sess *session.Session, command string, timeout time.Duration) (int, error) {
+		stream, err := app.operator.Client().Exec(ctx, &pb.ExecReq{
+			SessionID: sess.ID,
+			Command:   command,
+			TimeoutMs: timeout.Milliseconds(),
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				return 0, fmt.Errorf("command output ended before it exited")
+			}
+			if err != nil {
+				return 0, err
+			}
+
+			if r.Exited {
+				return int(r.ExitCode), nil
+			}
+			if len(r.Stdout) > 0 {
+				output(false, r.Stdout)
+			}
+			if len(r.Stderr) > 0 {
+				output(true, r.Stderr)
+			}
+		}
+	})
+
+	app.dashboard.SetSessionSweepFunc(func(ctx context.Context, result func(protocol.SweepResultPacket), sess *session.Session, targets []string, ports []uint16, timeout time.Duration) (protocol.SweepDonePacket, error) {
+		var requested []uint32
+		for _, port := range ports {
+			requested = append(requested, uint32(port))
+		}
+
+		stream, err := app.operator.Client().Sweep(ctx, &pb.SweepReq{
+			SessionID: sess.ID,
+			Targets:   targets,
+			Ports:     requested,
+			TimeoutMs: timeout.Milliseconds(),
+		})
+		if err != nil {
+			return protocol.SweepDonePacket{}, err
+		}
+
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				return protocol.SweepDonePacket{}, fmt.Errorf("sweep ended before it was done")
+			}
+			if err != nil {
+				return protocol.SweepDonePacket{}, err
+			}
+
+			if r.Done {
+				return protocol.SweepDonePacket{Probed: r.Probed, Alive: r.Alive, ICMP: r.ICMP}, nil
+			}
+
+			host := protocol.SweepResultPacket{
+				Address: r.Host.GetAddress(),
+				Method:  r.Host.GetMethod(),
+				RTT:     int64(time.Duration(r.Host.GetRttUs()) * time.Microsecond),
+			}
+			for _, port := range r.Host.GetOpen() {
+				host.Open = append(host.Open, uint16(port))
+			}
+			result(host)
+		}
+	})
+
+	app.dashboard.SetSessionApplyProfileFunc(func(sess *session.Session, name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().ApplyRouteProfile(ctx, &pb.ApplyRouteProfileReq{
+			SessionID: sess.ID,
+			Name:      name,
+		})
+		return err
+	})
+
+	app.dashboard.SetRouteProfilesFunc(func() ([]*profile.Profile, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		r, err := app.operator.Client().GetRouteProfiles(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*profile.Profile
+		for _, p := range r.Profiles {
+			result = append(result, profile.ProtoToProfile(p))
+		}
+
+		return result, nil
+	})
+
+	app.dashboard.SetSaveRouteProfileFunc(func(prof *profile.Profile) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().AddRouteProfile(ctx, &pb.AddRouteProfileReq{
+			Profile: prof.Proto(),
+		})
+		return err
+	})
+
+	app.dashboard.SetRemoveRouteProfileFunc(func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().DelRouteProfile(ctx, &pb.DelRouteProfileReq{
+			Name: name,
+		})
+		return err
+	})
+
+	app.dashboard.SetAnnotateFunc(func(sess *session.Session, routeID string, tags []string, note string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().Annotate(ctx, &pb.AnnotateReq{
+			SessionID: sess.ID,
+			RouteID:   routeID,
+			Tags:      tags,
+			Note:      note,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionRenameFunc(func(sess *session.Session, alias string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().RenameSession(ctx, &pb.RenameSessionReq{
+			SessionID: sess.ID,
+			Alias:     alias,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionAddRouteFunc(func(sess *session.Session, cidr string, metric int, loopback bool, ports string, exclusion bool) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().AddRoute(ctx, &pb.AddRouteReq{
+			SessionID: sess.ID,
+			Route: &pb.Route{
+				Cidr:        cidr,
+				Metric:      int32(metric),
+				IsLoopback:  loopback,
+				Ports:       ports,
+				IsExclusion: exclusion,
+			},
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionEditRouteFunc(func(sess *session.Session, routeID string, cidr string, metric int, loopback bool, ports string, exclusion bool) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().EditRoute(ctx, &pb.EditRouteReq{
+			SessionID: sess.ID,
+			RouteID:   routeID,
+			Route: &pb.Route{
+				Cidr:        cidr,
+				Metric:      int32(metric),
+				IsLoopback:  loopback,
+				Ports:       ports,
+				IsExclusion: exclusion,
+			},
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionMoveRouteFunc(func(sess *session.Session, routeID string, targetSessionID string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().MoveRoute(ctx, &pb.MoveRouteReq{
+			OldSessionID: sess.ID,
+			RouteID:      routeID,
+			NewSessionID: targetSessionID,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionRemoveRouteFunc(func(sess *session.Session, routeID string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().DelRoute(ctx, &pb.DelRouteReq{
+			SessionID: sess.ID,
+			RouteID:   routeID,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionAddRedirectorFunc(func(sess *session.Session, from string, to string, proto string, via string, bridge bool) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().AddRedirector(ctx, &pb.AddRedirectorReq{
+			SessionID: sess.ID,
+			From:      from,
+			To:        to,
+			Protocol:  proto,
+			Via:       via,
+			Bridge:    bridge,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionRemoveRedirectorFunc(func(sess *session.Session, redirectorID string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().DelRedirector(ctx, &pb.DelRedirectorReq{
+			SessionID:    sess.ID,
+			RedirectorID: redirectorID,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionKillFunc(func(sess *session.Session) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().KillSession(ctx, &pb.KillSessionReq{
+			SessionID: sess.ID,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionKillAgentFunc(func(sess *session.Session) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+		_, err := app.operator.Client().KillSession(ctx, &pb.KillSessionReq{
+			SessionID: sess.ID,
+			Keep:      true,
+		})
+		return err
+	})
+
+	app.dashboard.SetSessionBurnFunc(func(sess *session.Session) ([]string, []string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+		r, err := app.operator.Client().BurnAgent(ctx, &pb.BurnAgentReq{
+			SessionID: sess.ID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return r.Removed, r.Errors, nil
+	})
+
+	app.dashboard.SetSessionRevokeCertFunc(func(sess *session.Session) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().RevokeCert(ctx, &pb.RevokeCertReq{
+			SessionID: sess.ID,
+		})
+
+		return err
+	})
+
+	app.dashboard.SetMetadataFunc(func() (*config.Config, *operator.Operator, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetMetadata(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		config := config.ProtoToConfig(r.Config)
+		operator := operator.ProtoToOperator(r.Operator)
+
+		return config, operator, nil
+	})
+
+	app.dashboard.SetTracerouteFunc(func(address string) ([]string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().Traceroute(ctx, &pb.TracerouteReq{
+			IP: address,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var trace []string
+		var route string
+		for _, traceline := range r.Trace {
+			if traceline.IsInternal {
+				route = fmt.Sprintf("%s routed via session '%s'", address, traceline.Session)
+			} else {
+				route = fmt.Sprintf("%s routed externally via %s", address, traceline.Iface)
+
+				if traceline.Via != "" {
+					route += fmt.Sprintf(" (%s)", traceline.Via)
+				}
+			}
+
+			trace = append(trace, route)
+		}
+
+		return trace, nil
+	})
+
+	app.dashboard.SetSessionThroughputFunc(func(sess *session.Session) (int64, time.Duration, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*120)
+		defer cancel()
+
+		r, err := app.operator.Client().Throughput(ctx, &pb.ThroughputReq{
+			SessionID: sess.ID,
+			Size:      throughputTestSize,
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return r.Bytes, time.Duration(r.DurationMs) * time.Millisecond, nil
+	})
+}
+
+func (app *App) initAdmin() {
+	app.admin.SetSwitchbackFunc(func() {
+		app.SwitchToPage(app.dashboard)
+	})
+
+	app.admin.SetOperatorsFunc(func() ([]*operator.Operator, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetOperators(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var opers []*operator.Operator
+		for _, oper := range r.Operators {
+			opers = append(opers, operator.ProtoToOperator(oper))
+		}
+
+		return opers, nil
+	})
+
+	app.admin.SetCertificatesFunc(func() ([]*certificate.Certificate, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetCerts(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var certs []*certificate.Certificate
+		for _, cert := range r.Certs {
+			certs = append(certs, certificate.ProtoToCertificate(cert))
+		}
+
+		return certs, nil
+	})
+
+	app.admin.SetExportOperatorFunc(func(name string, path string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().ExportOperator(ctx, &pb.ExportOperatorReq{
+			Name: name,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		info, err := os.Stat(path)
+		if err == nil && info.IsDir() {
+			path = filepath.Join(path, fmt.Sprintf("%s_%s_ligolo-mp.json", r.Operator.Name, r.Operator.Server))
+		}
+
+		if err = os.WriteFile(path, r.Config, os.ModePerm); err != nil {
+			return "", err
+		}
+
+		return filepath.Abs(path)
+	})
+
+	app.admin.SetAddOperatorFunc(func(name string, role string, server string, ca string) (*operator.Operator, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().AddOperator(ctx, &pb.AddOperatorReq{
+			Operator: &pb.Operator{
+				Name:    name,
+				IsAdmin: role == operator.RoleAdmin,
+				Role:    role,
+				Server:  server,
+			},
+			CA: ca,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return operator.ProtoToOperator(r.Operator), nil
+	})
+
+	app.admin.SetDelOperatorFunc(func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().DelOperator(ctx, &pb.DelOperatorReq{
+			Name: name,
+		})
+
+		return err
+	})
+
+	app.admin.SetOperatorRoleFunc(func(name string, role string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().SetOperatorRole(ctx, &pb.SetOperatorRoleReq{
+			Name: name,
+			Role: role,
+		})
+
+		return err
+	})
+
+	app.admin.SetRegenCertFunc(func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().RegenCert(ctx, &pb.RegenCertReq{
+			Name: name,
+		})
+
+		return err
+	})
+
+	app.admin.SetRenewCertFunc(func(name string) (time.Time, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().RenewOperatorCert(ctx, &pb.RenewOperatorCertReq{
+			Name: name,
+		})
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		renewed := &certificate.Certificate{Certificate: r.Cert.Certificate}
+		if leaf, err := app.operator.Cert.Leaf(); err == nil && leaf.Subject.CommonName == name {
+			if err := app.storeRenewedCert(app.operator, r.Cert.Certificate); err != nil {
+				return time.Time{}, err
+			}
+		}
+
+		return renewed.ExpiryDate(), nil
+	})
+
+	app.admin.SetGetEnrollmentsFunc(func() ([]*operator.Enrollment, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetEnrollments(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*operator.Enrollment
+		for _, enrollment := range r.Enrollments {
+			result = append(result, operator.ProtoToEnrollment(enrollment))
+		}
+
+		return result, nil
+	})
+
+	app.admin.SetAddEnrollmentFunc(func(name string, role string, server string, ttl string, ca string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().AddEnrollment(ctx, &pb.AddEnrollmentReq{
+			Name:   name,
+			Role:   role,
+			Server: server,
+			TTL:    ttl,
+			CA:     ca,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return r.Code, nil
+	})
+
+	app.admin.SetDelEnrollmentFunc(func(id string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().DelEnrollment(ctx, &pb.DelEnrollmentReq{
+			ID: id,
+		})
+
+		return err
+	})
+
+	app.admin.SetGetCAsFunc(func() ([]*certificate.Authority, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetCAs(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*certificate.Authority
+		for _, ca := range r.CAs {
+			result = append(result, certificate.ProtoToAuthority(ca))
+		}
+
+		return result, nil
+	})
+
+	app.admin.SetAddCAFunc(func(name string, engagement string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().AddCA(ctx, &pb.AddCAReq{
+			Name:       name,
+			Engagement: engagement,
+		})
+		return err
+	})
+
+	app.admin.SetRevokeCAFunc(func(name string, reason string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().RevokeCA(ctx, &pb.RevokeCAReq{
+			Name:   name,
+			Reason: reason,
+		})
+		return err
+	})
+
+	app.admin.SetExportServerStateFunc(func(path string, passphrase string) (string, int64, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*10)
+		defer cancel()
+
+		stream, err := app.operator.Client().ExportServerState(ctx, &pb.ExportServerStateReq{
+			Passphrase: passphrase,
+		})
+		if err != nil {
+			return "", 0, err
+		}
+
+		var archive []byte
+		var size, rows int64
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", 0, err
+			}
+
+			if r.Size != 0 {
+				size, rows = r.Size, r.Rows
+			}
+			archive = append(archive, r.Chunk...)
+		}
+
+		if int64(len(archive)) != size {
+			return "", 0, fmt.Errorf("export interrupted after %s", utils.HumanBytes(int64(len(archive))))
+		}
+
+		info, err := os.Stat(path)
+		if err == nil && info.IsDir() {
+			path = filepath.Join(path, fmt.Sprintf("%s_%s.state", app.operator.Server, time.Now().Format("20060102-150405")))
+		}
+
+		if err = os.WriteFile(path, archive, 0600); err != nil {
+			return "", 0, err
+		}
+
+		saved, err := filepath.Abs(path)
+		return saved, rows, err
+	})
+
+	app.admin.SetImportServerStateFunc(func(path string, passphrase string) (int64, int64, error) {
+		archive, err := os.ReadFile(path)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*10)
+		defer cancel()
+
+		stream, err := app.operator.Client().ImportServerState(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if err := stream.Send(&pb.ImportServerStateReq{Passphrase: passphrase}); err != nil && err != io.EOF {
+			return 0, 0, err
+		}
+
+		for len(archive) > 0 {
+			chunk := archive[:min(len(archive), 32*1024)]
+			if err := stream.Send(&pb.ImportServerStateReq{Chunk: chunk}); err == io.EOF {
+				break // the server gave up, CloseAndRecv tells why
+			} else if err != nil {
+				return 0, 0, err
+			}
+			archive = archive[len(chunk):]
+		}
+
+		r, err := stream.CloseAndRecv()
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return r.Tables, r.Rows, nil
+	})
+
+	app.admin.SetGetRevokedCertsFunc(func() ([]*crl.RevokedCertificate, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetRevokedCerts(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*crl.RevokedCertificate
+		for _, cert := range r.Certs {
+			result = append(result, crl.ProtoToRevokedCertificate(cert))
+		}
+
+		return result, nil
+	})
+
+	app.admin.SetRevokeCertFunc(func(path string, reason string) error {
+		cert, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err = app.operator.Client().RevokeCert(ctx, &pb.RevokeCertReq{
+			Certificate: cert,
+			Reason:      reason,
+		})
+
+		return err
+	})
+
+	app.admin.SetUnrevokeCertFunc(func(hash string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().UnrevokeCert(ctx, &pb.UnrevokeCertReq{
+			Hash: hash,
+		})
+
+		return err
+	})
+
+	app.admin.SetExportCRLFunc(func(path string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetCRL(ctx, &pb.Empty{})
+		if err != nil {
+			return "", err
+		}
+
+		info, err := os.Stat(path)
+		if err == nil && info.IsDir() {
+			path = filepath.Join(path, "ca.crl")
+		}
+
+		if err = os.WriteFile(path, r.CRL, 0644); err != nil {
+			return "", err
+		}
+
+		return filepath.Abs(path)
+	})
+
+	app.admin.SetGetTemplatesFunc(func() ([]*agentbuild.Template, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetAgentTemplates(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var templates []*agentbuild.Template
+		for _, t := range r.Templates {
+			templates = append(templates, agentbuild.ProtoToTemplate(t))
+		}
+
+		return templates, nil
+	})
+
+	app.admin.SetAddTemplateFunc(func(name string, path string) error {
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err = app.operator.Client().AddAgentTemplate(ctx, &pb.AddAgentTemplateReq{
+			Name:   name,
+			Source: source,
+		})
+
+		return err
+	})
+
+	app.admin.SetDelTemplateFunc(func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().DelAgentTemplate(ctx, &pb.DelAgentTemplateReq{
+			Name: name,
+		})
+
+		return err
+	})
+
+	app.admin.SetGetSigningKeysFunc(func() ([]*agentbuild.SigningKey, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetSigningKeys(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		var keys []*agentbuild.SigningKey
+		for _, key := range r.Keys {
+			keys = append(keys, agentbuild.ProtoToSigningKey(key))
+		}
+
+		return keys, nil
+	})
+
+	app.admin.SetGetAssetUsageFunc(func() ([]*agentbuild.AssetUsage, int64, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetAssetUsage(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var usage []*agentbuild.AssetUsage
+		for _, u := range r.Usage {
+			usage = append(usage, agentbuild.ProtoToAssetUsage(u))
+		}
+
+		return usage, r.Quota, nil
+	})
+
+	app.admin.SetCollectAssetsFunc(func(category string) (int64, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*600) // waits for running builds
+		defer cancel()
+
+		r, err := app.operator.Client().CollectAssets(ctx, &pb.CollectAssetsReq{
+			Category: category,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return r.Freed, nil
+	})
+
+	app.admin.SetGetToolchainFunc(func() (*agentbuild.Toolchain, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetToolchain(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+
+		return agentbuild.ProtoToToolchain(r), nil
+	})
+
+	app.admin.SetUploadToolchainFunc(func(path string) (*agentbuild.Toolchain, error) {
+		archive, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*600) // waits for running builds
+		defer cancel()
+
+		stream, err := app.operator.Client().UploadToolchain(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for len(archive) > 0 {
+			chunk := archive[:min(len(archive), 1<<20)]
+			archive = archive[len(chunk):]
+
+			if err := stream.Send(&pb.UploadToolchainReq{Chunk: chunk}); err != nil {
+				return nil, err
+			}
+		}
+
+		r, err := stream.CloseAndRecv()
+		if err != nil {
+			return nil, err
+		}
+
+		return agentbuild.ProtoToToolchain(r), nil
+	})
+
+	app.admin.SetFetchToolchainFunc(func(version string) (*agentbuild.Toolchain, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*600) // download plus running builds
+		defer cancel()
+
+		r, err := app.operator.Client().FetchToolchain(ctx, &pb.FetchToolchainReq{
+			Version: version,
 		})
 		if err != nil {
 			return nil, err
 		}
 
-		var trace []string
-		var route string
-		for _, traceline := range r.Trace {
-			if traceline.IsInternal {
-				route = fmt.Sprintf("%s routed via session '%s'", address, traceline.Session)
-			} else {
-				route = fmt.Sprintf("%s routed externally via %s", address, traceline.Iface)
+		return agentbuild.ProtoToToolchain(r), nil
+	})
 
-				if traceline.Via != "" {
-					route += fmt.Sprintf(" (%s)", traceline.Via)
-				}
-			}
+	app.admin.SetRollbackToolchainFunc(func() (*agentbuild.Toolchain, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*600) // waits for running builds
+		defer cancel()
 
-			trace = append(trace, route)
+		r, err := app.operator.Client().RollbackToolchain(ctx, &pb.Empty{})
+		if err != nil {
+			return nil, err
 		}
 
-		return trace, nil
+		return agentbuild.ProtoToToolchain(r), nil
 	})
-}
 
-func (app *App) initAdmin() {
-	app.admin.SetSwitchbackFunc(func() {
-		app.SwitchToPage(app.dashboard)
-	})
+	app.admin.SetAddSigningKeyFunc(func(name string, keyType string, path string, password string) error {
+		key, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
 
-	app.admin.SetOperatorsFunc(func() ([]*operator.Operator, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		r, err := app.operator.Client().GetOperators(ctx, &pb.Empty{})
-		if err != nil {
-			return nil, err
-		}
+		_, err = app.operator.Client().AddSigningKey(ctx, &pb.AddSigningKeyReq{
+			Name:     name,
+			Type:     keyType,
+			Key:      key,
+			Password: password,
+		})
 
-		var opers []*operator.Operator
-		for _, oper := range r.Operators {
-			opers = append(opers, operator.ProtoToOperator(oper))
-		}
+		return err
+	})
 
-		return opers, nil
+	app.admin.SetDelSigningKeyFunc(func(name string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		_, err := app.operator.Client().DelSigningKey(ctx, &pb.DelSigningKeyReq{
+			Name: name,
+		})
+
+		return err
 	})
 
-	app.admin.SetCertificatesFunc(func() ([]*certificate.Certificate, error) {
+	app.admin.SetGetEngagementsFunc(func() ([]*engagement.Engagement, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		r, err := app.operator.Client().GetCerts(ctx, &pb.Empty{})
+		r, err := app.operator.Client().GetEngagements(ctx, &pb.Empty{})
 		if err != nil {
 			return nil, err
 		}
 
-		var certs []*certificate.Certificate
-		for _, cert := range r.Certs {
-			certs = append(certs, certificate.ProtoToCertificate(cert))
+		var engagements []*engagement.Engagement
+		for _, e := range r.Engagements {
+			engagements = append(engagements, engagement.ProtoToEngagement(e))
 		}
 
-		return certs, nil
+		return engagements, nil
 	})
 
-	app.admin.SetExportOperatorFunc(func(name string, path string) (string, error) {
+	app.admin.SetAddEngagementFunc(func(name string, scope []string, start string, end string, operators []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		r, err := app.operator.Client().ExportOperator(ctx, &pb.ExportOperatorReq{
-			Name: name,
-		})
-		if err != nil {
-			return "", err
+		e := &engagement.Engagement{
+			Name:      strings.TrimSpace(name),
+			Scope:     scope,
+			Operators: operators,
 		}
 
-		info, err := os.Stat(path)
-		if err == nil && info.IsDir() {
-			path = filepath.Join(path, fmt.Sprintf("%s_%s_ligolo-mp.json", r.Operator.Name, r.Operator.Server))
+		var err error
+		if start != "" {
+			if e.Start, err = time.Parse(engagement.DateLayout, start); err != nil {
+				return fmt.Errorf("%s is invalid start day", start)
+			}
+		}
+		if end != "" {
+			if e.End, err = time.Parse(engagement.DateLayout, end); err != nil {
+				return fmt.Errorf("%s is invalid end day", end)
+			}
 		}
 
-		if err = os.WriteFile(path, r.Config, os.ModePerm); err != nil {
-			return "", err
+		if err := e.Validate(); err != nil {
+			return err
 		}
 
-		return filepath.Abs(path)
+		_, err = app.operator.Client().AddEngagement(ctx, &pb.AddEngagementReq{
+			Engagement: e.Proto(),
+		})
+
+		return err
 	})
 
-	app.admin.SetAddOperatorFunc(func(name string, isAdmin bool, server string) (*operator.Operator, error) {
+	app.admin.SetDelEngagementFunc(func(name string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		r, err := app.operator.Client().AddOperator(ctx, &pb.AddOperatorReq{
-			Operator: &pb.Operator{
-				Name:    name,
-				IsAdmin: isAdmin,
-				Server:  server,
-			},
+		_, err := app.operator.Client().DelEngagement(ctx, &pb.DelEngagementReq{
+			Name: name,
 		})
+
+		return err
+	})
+
+	app.admin.SetGetListenersFunc(func() ([]*listener.Listener, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetListeners(ctx, &pb.Empty{})
 		if err != nil {
 			return nil, err
 		}
 
-		return operator.ProtoToOperator(r.Operator), nil
+		var listeners []*listener.Listener
+		for _, l := range r.Listeners {
+			listeners = append(listeners, listener.ProtoToListener(l))
+		}
+
+		return listeners, nil
 	})
 
-	app.admin.SetDelOperatorFunc(func(name string) error {
+	app.admin.SetAddListenerFunc(func(name string, transport string, address string, certPath string, keyPath string, proxyProtocol bool, enabled bool) error {
+		l := &listener.Listener{
+			Name:          name,
+			Transport:     transport,
+			Address:       address,
+			ProxyProtocol: proxyProtocol,
+			Enabled:       enabled,
+		}
+
+		if certPath != "" || keyPath != "" {
+			cert, err := os.ReadFile(certPath)
+			if err != nil {
+				return err
+			}
+			key, err := os.ReadFile(keyPath)
+			if err != nil {
+				return err
+			}
+			l.Certificate, l.Key = string(cert), string(key)
+		}
+
+		// the server checks it again, but mistakes show up before the round trip
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		_, err := app.operator.Client().DelOperator(ctx, &pb.DelOperatorReq{
-			Name: name,
+		_, err := app.operator.Client().AddListener(ctx, &pb.AddListenerReq{
+			Listener: &pb.Listener{
+				Name:          l.Name,
+				Transport:     l.Transport,
+				Address:       l.Address,
+				Certificate:   l.Certificate,
+				Key:           l.Key,
+				ProxyProtocol: l.ProxyProtocol,
+				Enabled:       l.Enabled,
+			},
 		})
 
 		return err
 	})
 
-	app.admin.SetPromoteOperatorFunc(func(name string) error {
+	app.admin.SetDelListenerFunc(func(name string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		_, err := app.operator.Client().PromoteOperator(ctx, &pb.PromoteOperatorReq{
+		_, err := app.operator.Client().DelListener(ctx, &pb.DelListenerReq{
 			Name: name,
 		})
 
 		return err
 	})
 
-	app.admin.SetDemoteOperatorFunc(func(name string) error {
+	app.admin.SetEnableListenerFunc(func(name string, enabled bool) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		_, err := app.operator.Client().DemoteOperator(ctx, &pb.DemoteOperatorReq{
-			Name: name,
+		_, err := app.operator.Client().SetListenerEnabled(ctx, &pb.SetListenerEnabledReq{
+			Name:    name,
+			Enabled: enabled,
 		})
 
 		return err
 	})
 
-	app.admin.SetRegenCertFunc(func(name string) error {
+	app.admin.SetActivateEngagementFunc(func(name string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
 		defer cancel()
 
-		_, err := app.operator.Client().RegenCert(ctx, &pb.RegenCertReq{
+		_, err := app.operator.Client().ActivateEngagement(ctx, &pb.ActivateEngagementReq{
 			Name: name,
 		})
 
@@ -506,29 +2238,280 @@ func (app *App) initAdmin() {
 	app.pages.AddPage(app.credentials.GetID(), app.credentials, true, false)
 }
 
-func (app *App) HandleOperatorEvents() {
+func (app *App) initBuilds() {
+	app.builds.SetSwitchbackFunc(func() {
+		app.SwitchToPage(app.dashboard)
+	})
+
+	app.builds.SetDataFunc(app.agentBuilds)
+
+	app.builds.SetDownloadFunc(func(id string, path string) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		r, err := app.operator.Client().DownloadAgentBuild(ctx, &pb.DownloadAgentBuildReq{ID: id})
+		if err != nil {
+			return "", err
+		}
+
+		return saveAgent(path, agentbuild.ProtoToAgentBuild(r.Build), r.AgentBinary, r.Signature, r.Loader)
+	})
+
+	app.builds.SetRegenerateFunc(func(ctx context.Context, progress func(string), id string, key string, path string) (string, *agentbuild.AgentBuild, error) {
+		ctx, cancel := context.WithTimeout(ctx, time.Second*600) // includes waiting in the server's build queue
+		defer cancel()
+
+		stream, err := app.operator.Client().RegenerateAgent(ctx, &pb.RegenerateAgentReq{ID: id, Key: key})
+		if err != nil {
+			return "", nil, err
+		}
+
+		return receiveAgent(stream, progress, path)
+	})
+
+	app.builds.SetRecipeFunc(func(ctx context.Context, progress func(string), recipePath string, key string, path string) (string, int, error) {
+		source, err := os.ReadFile(recipePath)
+		if err != nil {
+			return "", 0, err
+		}
+
+		recipe, err := agentbuild.ParseRecipe(source) // the server checks it again, but mistakes show up before queueing
+		if err != nil {
+			return "", 0, err
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, time.Second*600*time.Duration(len(recipe.Targets)))
+		defer cancel()
+
+		stream, err := app.operator.Client().BuildRecipe(ctx, &pb.BuildRecipeReq{Recipe: source, Key: key})
+		if err != nil {
+			return "", 0, err
+		}
+
+		var archive []byte
+		for {
+			r, err := stream.Recv()
+			if err != nil {
+				return "", 0, err
+			}
+
+			if r.Progress != "" {
+				progress(r.Progress)
+			}
+
+			archive = append(archive, r.Archive...)
+
+			if r.Builds != nil {
+				if info, err := os.Stat(path); err == nil && info.IsDir() {
+					path = filepath.Join(path, fmt.Sprintf("%s.zip", filepath.Base(recipe.Name)))
+				}
+
+				if err := os.WriteFile(path, archive, 0644); err != nil {
+					return "", 0, err
+				}
+
+				fullPath, err := filepath.Abs(path)
+				return fullPath, len(r.Builds), err
+			}
+		}
+	})
+}
+
+func (app *App) initAudit() {
+	app.audit.SetSwitchbackFunc(func() {
+		app.SwitchToPage(app.dashboard)
+	})
+
+	app.audit.SetDataFunc(func(filter audit.ActionFilter) ([]*audit.Action, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancel()
+
+		r, err := app.operator.Client().GetAuditLog(ctx, filter.Proto())
+		if err != nil {
+			return nil, err
+		}
+
+		var actions []*audit.Action
+		for _, action := range r.Actions {
+			actions = append(actions, audit.ProtoToAction(action))
+		}
+
+		return actions, nil
+	})
+}
+
+func (app *App) initReplay() {
+	app.replay.SetSwitchbackFunc(func() {
+		app.SwitchToPage(app.dashboard)
+	})
+
+	app.replay.SetReplayFunc(func(ctx context.Context, since time.Time, speed float64, handle func(*audit.Record)) error {
+		req := &pb.ReplayReq{
+			Speed: speed,
+		}
+		if !since.IsZero() {
+			req.Since = since.UnixNano()
+		}
+
+		stream, err := app.operator.Client().Replay(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			handle(audit.ProtoToRecord(r))
+		}
+	})
+}
+
+func (app *App) HandleOperatorEvents(oper *operator.Operator) {
 	defer func() {
+		if app.operator != oper { // already switched to another operator
+			return
+		}
+
 		app.Disconnect()
 		app.Reset()
 		app.ShowError("Disconnected from the server", nil)
 	}()
 
-	eventStream, err := app.operator.Client().Join(context.Background(), &pb.Empty{})
+	logEvents, err := oper.Subscribe("logs")
 	if err != nil {
 		slog.Error(fmt.Sprintf("Could not join event stream: %s", err))
 		return
 	}
 
-	for {
-		event, err := eventStream.Recv()
-		if err != nil {
-			return
+	go app.refreshOnEvents(oper, "dashboard", nil, app.dashboard.RefreshData)
+	go app.refreshOnEvents(oper, "admin", nil, app.admin.RefreshData)
+	go app.refreshOnEvents(oper, "builds", []string{events.TopicBuildFinished}, app.builds.RefreshData)
+	go app.notifyOnEvents(oper)
+
+	for event := range logEvents {
+		slog.Log(context.Background(), events.EventType(event.Type).Slog(), event.Data)
+	}
+}
+
+// refreshOnEvents refreshes a view on the events of topics, or on every event when there are none, the server
+// announces whatever changes so views needn't poll
+func (app *App) refreshOnEvents(oper *operator.Operator, view string, topics []string, refresh func()) {
+	viewEvents, err := oper.Subscribe(view)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Could not subscribe %s to events: %s", view, err))
+		return
+	}
+
+	for event := range viewEvents {
+		if len(topics) == 0 || slices.Contains(topics, event.Topic) {
+			refresh()
 		}
+	}
+}
 
-		app.dashboard.RefreshData()
-		app.admin.RefreshData()
+// notifyOnEvents pops the events of a topic up above the navbar, the others only go to the logs
+func (app *App) notifyOnEvents(oper *operator.Operator) {
+	toastEvents, err := oper.Subscribe("toast")
+	if err != nil {
+		slog.Error(fmt.Sprintf("Could not subscribe notifications to events: %s", err))
+		return
+	}
 
-		slog.Log(context.Background(), events.EventType(event.Type).Slog(), event.Data)
+	for event := range toastEvents {
+		if event.Topic == "" {
+			continue
+		}
+
+		app.notify(events.EventType(event.Type), event.Data)
+	}
+}
+
+// notify pops a notification up above the navbar for a while
+func (app *App) notify(eventType events.EventType, text string) {
+	app.QueueUpdateDraw(func() {
+		id := app.toast.Show(eventType, text)
+		app.root.ResizeItem(app.toast, 1, 0)
+
+		time.AfterFunc(toastDuration, func() {
+			app.QueueUpdateDraw(func() {
+				if app.toast.Clear(id) {
+					app.root.ResizeItem(app.toast, 0, 0)
+				}
+			})
+		})
+	})
+}
+
+// warnCertExpiry tells the operator to renew their certificate once it's about to expire
+func (app *App) warnCertExpiry(oper *operator.Operator) {
+	if !oper.CertExpiresWithin(operator.CertRenewalWindow) {
+		return
+	}
+
+	text := fmt.Sprintf("Certificate of %s expires on %s, renew it from the credentials page", oper.Name, oper.Cert.ExpiryDate().Local().Format(time.DateOnly))
+	slog.Warn(text)
+	app.notify(events.WARNING, text)
+}
+
+// renewCertificate has the server renew the certificate of stored credentials, connecting with them unless they're
+// the ones in use. The key stays the same, only the certificate is replaced
+func (app *App) renewCertificate(oper *operator.Operator) error {
+	if oper != app.operator || !app.IsConnected() {
+		if err := oper.Connect(); err != nil {
+			return err
+		}
+		defer oper.Disconnect()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	r, err := oper.Client().RenewOperatorCert(ctx, &pb.RenewOperatorCertReq{})
+	if err != nil {
+		return err
+	}
+
+	return app.storeRenewedCert(oper, r.Cert.Certificate)
+}
+
+func (app *App) storeRenewedCert(oper *operator.Operator, cert []byte) error {
+	renewed := &certificate.Certificate{
+		Name:        oper.Cert.Name,
+		Certificate: cert,
+		Key:         oper.Cert.Key,
+	}
+	if _, err := renewed.KeyPair(); err != nil {
+		return fmt.Errorf("renewed certificate doesn't match the key: %w", err)
+	}
+
+	oper.Cert = renewed
+	if err := app.operService.SaveOperator(oper); err != nil {
+		return err
+	}
+
+	slog.Info(fmt.Sprintf("Certificate of %s renewed until %s", oper.Name, renewed.ExpiryDate().Local().Format(time.DateOnly)))
+	return nil
+}
+
+// learnStandby remembers the other server of the pair the server belongs to, if any, so that credentials fail over
+// to it when the server stops answering
+func (app *App) learnStandby(oper *operator.Operator) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	r, err := oper.Client().GetMetadata(ctx, &pb.Empty{})
+	if err != nil {
+		return
+	}
+
+	if peer := r.Config.GetPeer(); peer != "" && peer != oper.Server {
+		oper.Standby = peer
 	}
 }
 
@@ -552,16 +2535,28 @@ func (app *App) SwitchOperator(oper *operator.Operator) error {
 
 	slog.Info(fmt.Sprintf("Connecting to %s as %s", oper.Server, oper.Name))
 
+	server, standby := oper.Server, oper.Standby
 	err := oper.Connect()
 	if err != nil {
 		slog.Error(fmt.Sprintf("Could not connect to %s: %s", oper.Server, err))
 		return err
 	}
 
+	if oper.Server != server {
+		slog.Warn(fmt.Sprintf("%s doesn't answer, the other server of the pair took over", server))
+	}
 	slog.Info(fmt.Sprintf("Connected to %s", oper.Server))
 
+	app.learnStandby(oper)
+	if oper.Server != server || oper.Standby != standby {
+		if err := app.operService.SaveOperator(oper); err != nil {
+			slog.Error(fmt.Sprintf("Could not save the servers of %s: %s", oper.Name, err))
+		}
+	}
+
 	app.operator = oper
-	go app.HandleOperatorEvents()
+	go app.HandleOperatorEvents(oper)
+	app.warnCertExpiry(oper)
 
 	return nil
 }
@@ -587,11 +2582,19 @@ func (app *App) Run() error {
 		case utils.AppExitKey.Key:
 			app.Stop()
 			return nil
+		case utils.HelpScreenKey.Key:
+			if app.help != nil {
+				return event // the help modal closes itself
+			}
+			app.showHelp()
+			return nil
 		}
 
 		return event
 	})
 
+	app.SetBeforeDrawFunc(app.adaptLayout)
+
 	app.credentials.RefreshData()
 
 	if err := app.SetRoot(app.root, true).SetFocus(app.pages).EnablePaste(true).Run(); err != nil {
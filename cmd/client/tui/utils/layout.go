@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"sync/atomic"
+
+	"github.com/rivo/tview"
+)
+
+// Layout presets, compact drops hint boxes and margins and stacks panes so the TUI stays usable in 80x24 terminals
+const (
+	LayoutAuto    = "auto" // compact below the configured terminal size, regular otherwise
+	LayoutRegular = "regular"
+	LayoutCompact = "compact"
+)
+
+var compact atomic.Bool
+
+// Compact reports whether the compact preset is active, forms check it when they are built
+func Compact() bool {
+	return compact.Load()
+}
+
+func SetCompact(enabled bool) {
+	compact.Store(enabled)
+}
+
+// LayoutForm centers a form above its hint box in page, width is the share of the screen the form gets.
+// In the compact preset the form takes the whole page and the hint box is hidden.
+func LayoutForm(page *tview.Flex, form tview.Primitive, formHeight int, hintBox tview.Primitive, hintHeight int, width int) {
+	if Compact() {
+		// the hint box stays without any room, F1 help reads it
+		page.AddItem(form, 0, 1, true).
+			AddItem(hintBox, 0, 0, false)
+		return
+	}
+
+	formFlex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(form, formHeight, 1, true).
+		AddItem(hintBox, hintHeight, 1, false)
+
+	page.AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(formFlex, 0, 1, true).
+			AddItem(nil, 0, 1, false),
+			0, width, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// LayoutPanes puts the panes of each row side by side, or on top of each other in the compact preset
+func LayoutPanes(rows ...*tview.Flex) {
+	direction := tview.FlexColumn
+	if Compact() {
+		direction = tview.FlexRow
+	}
+
+	for _, row := range rows {
+		row.SetDirection(direction)
+	}
+}
@@ -40,3 +40,26 @@ func HumanTimeSince(v time.Time) string {
 
 	return fmt.Sprintf("%d minutes ago", int(duration.Minutes()))
 }
+
+func HumanBytes(v int64) string {
+	const unit = 1024
+	if v < unit {
+		return fmt.Sprintf("%d B", v)
+	}
+
+	div, exp := int64(unit), 0
+	for n := v / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(v)/float64(div), "KMGTPE"[exp])
+}
+
+func HumanBitrate(bytes int64, duration time.Duration) string {
+	if duration <= 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%.2f Mbit/s", float64(bytes)*8/duration.Seconds()/1000/1000)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 )
@@ -106,3 +107,14 @@ func (elem *CertificatesWidgetElem) Name() *tview.TableCell {
 func (elem *CertificatesWidgetElem) ExpiryDate() *tview.TableCell {
 	return tview.NewTableCell(elem.Certificate.ExpiryDate().String())
 }
+
+func (widget *CertificatesWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Certificates",
+		Summary: "Certificates of the server CA, the operator and agent listeners.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "certificate menu: regenerate"},
+			{Name: "Up/Down", Description: "select a certificate"},
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package widgets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+// FilesWidget browses the file system of an agent one directory at a time, paths are the agent's own so
+// they can be Windows ones
+type FilesWidget struct {
+	tview.Table
+	path         string
+	data         []session.FileEntry
+	openFunc     func(string)
+	selectedFunc func(session.FileEntry, string)
+	uploadFunc   func(string)
+	gotoFunc     func(string)
+}
+
+func NewFilesWidget() *FilesWidget {
+	widget := &FilesWidget{
+		Table: *tview.NewTable(),
+	}
+
+	widget.Table.SetSelectable(true, false)
+	widget.Table.SetBackgroundColor(style.BgColor)
+	widget.Table.SetBorderColor(style.BorderColor)
+	widget.Table.SetTitleColor(style.FgColor)
+	widget.Table.SetBorder(true)
+
+	return widget
+}
+
+func (widget *FilesWidget) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+	return func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+		key := event.Key()
+		switch key {
+		case tcell.KeyEnter:
+			row, _ := widget.GetSelection()
+			if row == 1 {
+				widget.openFunc(RemoteJoin(widget.path, ".."))
+				return
+			}
+
+			entry, ok := widget.GetElem(row - 2)
+			if !ok {
+				return
+			}
+
+			if entry.IsDir {
+				widget.openFunc(RemoteJoin(widget.path, entry.Name))
+			} else {
+				widget.selectedFunc(entry, RemoteJoin(widget.path, entry.Name))
+			}
+		case tcell.KeyBackspace, tcell.KeyBackspace2:
+			widget.openFunc(RemoteJoin(widget.path, ".."))
+		case tcell.KeyCtrlU:
+			widget.uploadFunc(widget.path)
+		case tcell.KeyCtrlG:
+			widget.gotoFunc(widget.path)
+		default:
+			defaultHandler := widget.Table.InputHandler()
+			defaultHandler(event, setFocus)
+		}
+	}
+}
+
+// SetOpenFunc is called with the directory to list when the operator navigates
+func (widget *FilesWidget) SetOpenFunc(f func(string)) {
+	widget.openFunc = f
+}
+
+// SetSelectedFunc is called with a file and its full path
+func (widget *FilesWidget) SetSelectedFunc(f func(session.FileEntry, string)) {
+	widget.selectedFunc = f
+}
+
+// SetUploadFunc is called with the directory being browsed
+func (widget *FilesWidget) SetUploadFunc(f func(string)) {
+	widget.uploadFunc = f
+}
+
+// SetGotoFunc is called with the directory being browsed, to ask for another one
+func (widget *FilesWidget) SetGotoFunc(f func(string)) {
+	widget.gotoFunc = f
+}
+
+func (widget *FilesWidget) GetPath() string {
+	return widget.path
+}
+
+func (widget *FilesWidget) GetElem(id int) (session.FileEntry, bool) {
+	if id >= 0 && id < len(widget.data) {
+		return widget.data[id], true
+	}
+
+	return session.FileEntry{}, false
+}
+
+// SetData shows a listing, directories first
+func (widget *FilesWidget) SetData(path string, entries []session.FileEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].IsDir != entries[j].IsDir {
+			return entries[i].IsDir
+		}
+		return strings.ToLower(entries[i].Name) < strings.ToLower(entries[j].Name)
+	})
+
+	widget.path = path
+	widget.data = entries
+
+	widget.Refresh()
+	widget.Select(1, 0)
+	widget.ScrollToBeginning()
+}
+
+func (widget *FilesWidget) Refresh() {
+	widget.Clear()
+	widget.SetTitle(fmt.Sprintf("[::b]FILES — %s", tview.Escape(widget.path)))
+
+	headers := []string{"Name", "Size", "Mode", "Modified"}
+	for i := 0; i < len(headers); i++ {
+		header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
+		widget.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
+	}
+
+	widget.SetCell(1, 0, tview.NewTableCell("..").SetTextColor(tcell.ColorBlue))
+
+	for i, entry := range widget.data {
+		rowIdx := i + 2
+
+		name := tview.NewTableCell(tview.Escape(entry.Name))
+		size := utils.HumanBytes(entry.Size)
+		if entry.IsDir {
+			name.SetText(tview.Escape(entry.Name) + "/").SetTextColor(tcell.ColorBlue)
+			size = ""
+		}
+
+		modified := ""
+		if !entry.ModTime.IsZero() && entry.ModTime.Unix() != 0 {
+			modified = entry.ModTime.Format(time.DateTime)
+		}
+
+		widget.SetCell(rowIdx, 0, name)
+		widget.SetCell(rowIdx, 1, tview.NewTableCell(size))
+		widget.SetCell(rowIdx, 2, tview.NewTableCell(entry.Mode.String()))
+		widget.SetCell(rowIdx, 3, tview.NewTableCell(modified))
+	}
+}
+
+func (widget *FilesWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Files",
+		Summary: "File system of the agent, as the user it runs as sees it. Downloads and uploads are checked against the SHA-256 the agent computes once they are done and can be resumed from where an interrupted one stopped.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "open a directory, or download a file"},
+			{Name: "Backspace", Description: "parent directory"},
+			{Name: "Ctrl+U", Description: "upload a file to this directory"},
+			{Name: "Ctrl+G", Description: "go to a path"},
+			{Name: "Esc", Description: "close"},
+		},
+	}
+}
+
+// RemoteJoin joins paths of the agent, whose separator is guessed from the directory. The agent cleans
+// what it's given, so name can be ".."
+func RemoteJoin(dir string, name string) string {
+	separator := "/"
+	if strings.Contains(dir, `\`) && !strings.Contains(dir, "/") {
+		separator = `\`
+	}
+
+	if strings.HasSuffix(dir, separator) {
+		return dir + name
+	}
+
+	return dir + separator + name
+}
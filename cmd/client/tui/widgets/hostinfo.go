@@ -0,0 +1,116 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+type HostInfoWidget struct {
+	*tview.Table
+	selectedSession *session.Session
+}
+
+func NewHostInfoWidget() *HostInfoWidget {
+	widget := &HostInfoWidget{
+		Table: tview.NewTable(),
+	}
+
+	widget.SetSelectable(false, false)
+	widget.SetBackgroundColor(style.BgColor)
+	widget.SetTitle(fmt.Sprintf("[::b]%s", strings.ToUpper("host info")))
+	widget.SetBorderColor(style.BorderColor)
+	widget.SetTitleColor(style.FgColor)
+	widget.SetBorder(true)
+
+	widget.SetFocusFunc(func() {
+		widget.SetSelectable(true, false)
+		widget.ResetSelector()
+	})
+	widget.SetBlurFunc(func() {
+		widget.SetSelectable(false, false)
+	})
+
+	return widget
+}
+
+func (widget *HostInfoWidget) SetData(data []*session.Session) {
+	widget.Clear()
+
+	for _, session := range data {
+		if widget.selectedSession != nil && widget.selectedSession.ID == session.ID {
+			widget.selectedSession = session // pick up refreshed host information
+		}
+	}
+
+	widget.Refresh()
+}
+
+func (widget *HostInfoWidget) SetSelectedSession(sess *session.Session) {
+	widget.Clear()
+	widget.selectedSession = sess
+	widget.Refresh()
+}
+
+func (widget *HostInfoWidget) ResetSelector() {
+	if widget.GetRowCount() > 0 {
+		widget.Select(0, 0) // forcing selection for highlighting to work immediately
+	}
+}
+
+func (widget *HostInfoWidget) Refresh() {
+	title := strings.ToUpper("host info")
+	if widget.selectedSession != nil && !widget.selectedSession.HostRefreshed.IsZero() {
+		title += fmt.Sprintf(" (collected %s)", widget.selectedSession.HostRefreshed.Local().Format(time.DateTime))
+	}
+	widget.SetTitle(fmt.Sprintf("[::b]%s", title))
+
+	if widget.selectedSession == nil {
+		return
+	}
+
+	host := widget.selectedSession.Host
+	if host == nil {
+		widget.SetCell(0, 0, tview.NewTableCell("Not collected, the agent was built without it").SetExpansion(1))
+		return
+	}
+
+	privileged := "no"
+	if host.Privileged {
+		privileged = "yes"
+	}
+
+	rows := [][2]string{
+		{"Hostname", widget.selectedSession.Hostname},
+		{"OS", host.OS},
+		{"User", host.Username},
+		{"Privileged", privileged},
+		{"Privileges", strings.Join(host.Privileges, ", ")},
+		{"Domain", host.Domain},
+		{"DNS", strings.Join(host.DNSServers, ", ")},
+		{"Gateways", strings.Join(host.Gateways, ", ")},
+	}
+	for _, err := range host.Errors {
+		rows = append(rows, [2]string{"Error", err})
+	}
+
+	for rowId, row := range rows {
+		widget.SetCell(rowId, 0, tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(row[0]))))
+		widget.SetCell(rowId, 1, tview.NewTableCell(row[1]).SetExpansion(1))
+	}
+}
+
+func (widget *HostInfoWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Host info",
+		Summary: "What the agent of the selected session collected about its host: OS, user and privileges, domain membership, DNS servers and default gateways. It's collected when the agent connects, refresh host info from the session menu to collect it again. Agents have to be built with host info for it.",
+		Keys: []help.Key{
+			{Name: "Up/Down", Description: "scroll"},
+		},
+	}
+}
@@ -2,9 +2,12 @@ package widgets
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
@@ -47,6 +50,10 @@ func (widget *InterfacesWidget) SetData(data []*session.Session) {
 		for _, iface := range session.Interfaces.All() {
 			widget.data = append(widget.data, iface)
 		}
+
+		if widget.selectedSession != nil && widget.selectedSession.ID == session.ID {
+			widget.selectedSession = session // pick up a refreshed network view
+		}
 	}
 
 	widget.Refresh()
@@ -65,21 +72,73 @@ func (widget *InterfacesWidget) ResetSelector() {
 }
 
 func (widget *InterfacesWidget) Refresh() {
-	headers := []string{"Name", "IP"}
+	headers := []string{"Index", "Name", "IP", "Gateway", "DNS"}
 	for i := 0; i < len(headers); i++ {
 		header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
 		widget.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
 	}
 
+	title := strings.ToUpper("interfaces")
+	if widget.selectedSession != nil && !widget.selectedSession.NetworkRefreshed.IsZero() {
+		title += fmt.Sprintf(" (refreshed %s)", widget.selectedSession.NetworkRefreshed.Local().Format(time.DateTime))
+	}
+	widget.SetTitle(fmt.Sprintf("[::b]%s", title))
+
 	if widget.selectedSession != nil {
 		rowId := 1
 		for _, elem := range widget.selectedSession.Interfaces.All() {
-			for _, IP := range elem.Addresses {
-				widget.SetCell(rowId, 0, tview.NewTableCell(elem.Name))
-				widget.SetCell(rowId, 1, tview.NewTableCell(IP))
+			for i, IP := range elem.Addresses {
+				widget.SetCell(rowId, 0, tview.NewTableCell(strconv.Itoa(elem.Index)))
+				widget.SetCell(rowId, 1, tview.NewTableCell(elem.Name))
+				widget.SetCell(rowId, 2, tview.NewTableCell(IP))
+				if i == 0 {
+					widget.SetCell(rowId, 3, tview.NewTableCell(strings.Join(elem.Gateways, ", ")))
+					widget.SetCell(rowId, 4, tview.NewTableCell(strings.Join(elem.DNSServers, ", ")))
+				}
+
+				rowId++
+			}
+		}
 
+		if len(widget.selectedSession.Neighbors) > 0 {
+			rowId = widget.setSection(rowId+1, "Neighbor", "MAC", "Interface", "State")
+			for _, neighbor := range widget.selectedSession.Neighbors {
+				widget.SetCell(rowId, 0, tview.NewTableCell(neighbor.Address))
+				widget.SetCell(rowId, 1, tview.NewTableCell(neighbor.HardwareAddr.String()))
+				widget.SetCell(rowId, 2, tview.NewTableCell(neighbor.Interface))
+				widget.SetCell(rowId, 3, tview.NewTableCell(neighbor.State))
 				rowId++
 			}
 		}
+
+		if len(widget.selectedSession.SystemRoutes) > 0 {
+			rowId = widget.setSection(rowId+1, "Route", "Gateway", "Interface", "Metric")
+			for _, route := range widget.selectedSession.SystemRoutes {
+				widget.SetCell(rowId, 0, tview.NewTableCell(route.Destination))
+				widget.SetCell(rowId, 1, tview.NewTableCell(route.Gateway))
+				widget.SetCell(rowId, 2, tview.NewTableCell(route.Interface))
+				widget.SetCell(rowId, 3, tview.NewTableCell(strconv.Itoa(route.Metric)))
+				rowId++
+			}
+		}
+	}
+}
+
+// setSection writes the headers of a section below the interfaces and returns the row its entries start at
+func (widget *InterfacesWidget) setSection(rowId int, headers ...string) int {
+	for i, header := range headers {
+		widget.SetCell(rowId, i, tview.NewTableCell(fmt.Sprintf("[::b]%s", strings.ToUpper(header))).SetSelectable(false))
+	}
+
+	return rowId + 1
+}
+
+func (widget *InterfacesWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Interfaces",
+		Summary: "Network interfaces of the selected session and their addresses, use them to decide what to route. Refresh network from the session menu to update them without restarting the agent, along with its ARP/NDP neighbors and routing table.",
+		Keys: []help.Key{
+			{Name: "Up/Down", Description: "scroll"},
+		},
 	}
 }
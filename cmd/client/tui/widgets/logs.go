@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 )
 
@@ -37,3 +38,14 @@ func (widget *LogsWidget) Write(p []byte) (n int, err error) {
 func (widget *LogsWidget) Truncate() {
 	widget.SetText("")
 }
+
+func (widget *LogsWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Logs",
+		Summary: "Events published by the server and errors of this client, newest at the bottom.",
+		Keys: []help.Key{
+			{Name: "Up/Down", Description: "scroll"},
+			{Name: "PgUp/PgDn", Description: "scroll a page"},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 )
 
 type NavBar struct {
@@ -50,7 +51,18 @@ func (n *NavBar) SetData(buttons []NavBarElem) *NavBar {
 		n.AddButton(button.Hint, button.Key)
 	}
 
+	n.AddButton("Help", tcell.KeyF1)
 	n.AddButton("Quit", tcell.KeyCtrlQ)
 
 	return n
 }
+
+// NavBarHelp documents the shortcuts of a navbar, so help lists the same keys the bar shows
+func NavBarHelp(elems []NavBarElem) []help.Key {
+	var keys []help.Key
+	for _, elem := range elems {
+		keys = append(keys, help.Key{Name: tcell.KeyNames[elem.Key], Description: elem.Hint})
+	}
+
+	return keys
+}
@@ -3,8 +3,11 @@ package widgets
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
@@ -66,7 +69,7 @@ func (widget *OperatorsWidget) ResetSelector() {
 }
 
 func (widget *OperatorsWidget) Refresh() {
-	headers := []string{"Name", "Is Admin", "Online"}
+	headers := []string{"Name", "Role", "Online", "Cert expires"}
 	for i := 0; i < len(headers); i++ {
 		header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
 		widget.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
@@ -75,8 +78,9 @@ func (widget *OperatorsWidget) Refresh() {
 	rowId := 1
 	for _, elem := range widget.data {
 		widget.SetCell(rowId, 0, elem.Name())
-		widget.SetCell(rowId, 1, elem.IsAdmin())
+		widget.SetCell(rowId, 1, elem.Role())
 		widget.SetCell(rowId, 2, elem.IsOnline())
+		widget.SetCell(rowId, 3, elem.CertExpiry())
 
 		rowId++
 	}
@@ -105,12 +109,35 @@ func (elem *OperatorsWidgetElem) Name() *tview.TableCell {
 	return tview.NewTableCell(elem.Operator.Name)
 }
 
-func (elem *OperatorsWidgetElem) IsAdmin() *tview.TableCell {
-	val := utils.HumanBool(elem.Operator.IsAdmin)
-	return tview.NewTableCell(val)
+func (elem *OperatorsWidgetElem) Role() *tview.TableCell {
+	return tview.NewTableCell(elem.Operator.GetRole())
 }
 
 func (elem *OperatorsWidgetElem) IsOnline() *tview.TableCell {
 	val := utils.HumanBool(elem.Operator.IsOnline)
 	return tview.NewTableCell(val)
 }
+
+func (elem *OperatorsWidgetElem) CertExpiry() *tview.TableCell {
+	if elem.Operator.Cert == nil {
+		return tview.NewTableCell("")
+	}
+
+	cell := tview.NewTableCell(elem.Operator.Cert.ExpiryDate().Local().Format(time.DateOnly))
+	if elem.Operator.CertExpiresWithin(operator.CertRenewalWindow) {
+		cell.SetTextColor(tcell.ColorRed)
+	}
+
+	return cell
+}
+
+func (widget *OperatorsWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Operators",
+		Summary: "Operators allowed on this server and their roles. Admins manage operators, certificates and listeners, operators work sessions, routes and builds, read-only operators only watch. Certificates expiring soon show in red.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "operator menu: export, renew certificate, change role, remove"},
+			{Name: "Up/Down", Description: "select an operator"},
+		},
+	}
+}
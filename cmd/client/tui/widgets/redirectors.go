@@ -6,6 +6,7 @@ import (
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
 )
@@ -56,10 +57,22 @@ func (widget *RedirectorsWidget) InputHandler() func(event *tcell.EventKey, setF
 func (widget *RedirectorsWidget) SetData(data []*session.Session) {
 	widget.Clear()
 
+	names := make(map[string]string)
+	for _, session := range data {
+		names[session.ID] = session.GetName()
+	}
+
 	widget.data = nil
 	for _, session := range data {
 		for _, redirector := range session.Redirectors.All() {
-			widget.data = append(widget.data, NewRedirectorsWidgetElem(redirector, session))
+			elem := NewRedirectorsWidgetElem(redirector, session)
+			if redirector.Via != "" {
+				elem.via = redirector.Via
+				if name, ok := names[redirector.Via]; ok {
+					elem.via = name
+				}
+			}
+			widget.data = append(widget.data, elem)
 		}
 	}
 
@@ -151,6 +164,7 @@ func (widget *RedirectorsWidget) Refresh() {
 type RedirectorsWidgetElem struct {
 	Redirector session.Redirector
 	Session    *session.Session
+	via        string // name of the session the destination is reached through
 	bgcolor    tcell.Color
 }
 
@@ -194,6 +208,11 @@ func (elem *RedirectorsWidgetElem) From() *tview.TableCell {
 
 func (elem *RedirectorsWidgetElem) To() *tview.TableCell {
 	val := elem.Redirector.To
+	if elem.Redirector.Bridge {
+		val = "server (bridge)"
+	} else if elem.via != "" {
+		val = fmt.Sprintf("%s via %s", val, elem.via)
+	}
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
 }
 
@@ -214,3 +233,14 @@ func (elem *RedirectorsWidgetElem) Status() *tview.TableCell {
 
 	return tview.NewTableCell(val).SetTextColor(tcell.ColorGreen)
 }
+
+func (widget *RedirectorsWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Redirectors",
+		Summary: "Listeners opened on agents that forward connections to the server or to another session.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "redirector menu"},
+			{Name: "Up/Down", Description: "select a redirector"},
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/internal/route"
@@ -19,6 +20,7 @@ type RoutesWidget struct {
 	data            []*RoutesWidgetElem
 	selectedSession *session.Session
 	selectedFunc    func(string)
+	filter          string // see SetFilter
 }
 
 func NewRoutesWidget() *RoutesWidget {
@@ -87,14 +89,23 @@ func (widget *RoutesWidget) SetSelectedFunc(f func(*RoutesWidgetElem)) {
 	})
 }
 
+// SetFilter narrows the routes down to the ones matching the query, all the routes of a matching session are kept.
+// It applies from the next SetData
+func (widget *RoutesWidget) SetFilter(query string) {
+	widget.filter = query
+}
+
 func (widget *RoutesWidget) SetData(data []*session.Session) {
 	widget.Clear()
 
 	widget.data = nil
 
 	for _, session := range data {
+		matched := session.MatchesFilter(widget.filter)
 		for _, route := range session.Tun.Routes.All() {
-			widget.data = append(widget.data, NewRoutesWidgetElem(route, session))
+			if matched || route.MatchesFilter(widget.filter) {
+				widget.data = append(widget.data, NewRoutesWidgetElem(route, session))
+			}
 		}
 	}
 
@@ -116,7 +127,7 @@ func (widget *RoutesWidget) ResetSelector() {
 }
 
 func (widget *RoutesWidget) Refresh() {
-	headers := []string{"Session", "Route", "Loopback", "Priority", ""}
+	headers := []string{"Session", "Route", "Loopback", "Excluded", "Ports", "Priority", "Flows", "Traffic", "Last used", "Tags", "Note", ""}
 	for i := 0; i < len(headers); i++ {
 		header := fmt.Sprintf("[::b]%s", strings.ToUpper(headers[i]))
 		widget.SetCell(0, i, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
@@ -135,8 +146,15 @@ func (widget *RoutesWidget) Refresh() {
 		widget.SetCell(rowId, 0, elem.Name())
 		widget.SetCell(rowId, 1, elem.Cidr())
 		widget.SetCell(rowId, 2, elem.IsLoopback())
-		widget.SetCell(rowId, 3, elem.Priority())
-		widget.SetCell(rowId, 4, elem.Status().SetSelectable(false).SetAlign(tview.AlignCenter))
+		widget.SetCell(rowId, 3, elem.IsExclusion())
+		widget.SetCell(rowId, 4, elem.Ports())
+		widget.SetCell(rowId, 5, elem.Priority())
+		widget.SetCell(rowId, 6, elem.Flows())
+		widget.SetCell(rowId, 7, elem.Traffic())
+		widget.SetCell(rowId, 8, elem.LastUsed())
+		widget.SetCell(rowId, 9, elem.Tags())
+		widget.SetCell(rowId, 10, elem.Note())
+		widget.SetCell(rowId, 11, elem.Status().SetSelectable(false).SetAlign(tview.AlignCenter))
 
 		rowId++
 
@@ -187,6 +205,29 @@ func (elem *RoutesWidgetElem) IsLoopback() *tview.TableCell {
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
 }
 
+func (elem *RoutesWidgetElem) IsExclusion() *tview.TableCell {
+	val := utils.HumanBool(elem.Route.IsExclusion)
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *RoutesWidgetElem) Tags() *tview.TableCell {
+	val := session.FormatTags(elem.Route.Tags)
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *RoutesWidgetElem) Note() *tview.TableCell {
+	val := noteSummary(elem.Route.Note)
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *RoutesWidgetElem) Ports() *tview.TableCell {
+	val := elem.Route.Ports
+	if val == "" {
+		val = "all"
+	}
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
 func (elem *RoutesWidgetElem) Cidr() *tview.TableCell {
 	val := elem.Route.Cidr
 	return tview.NewTableCell(val.String()).SetBackgroundColor(elem.bgcolor)
@@ -210,6 +251,24 @@ func (elem *RoutesWidgetElem) Priority() *tview.TableCell {
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
 }
 
+func (elem *RoutesWidgetElem) Flows() *tview.TableCell {
+	val := fmt.Sprintf("%d", elem.Route.Usage.Flows())
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *RoutesWidgetElem) Traffic() *tview.TableCell {
+	val := utils.HumanBytes(elem.Route.Usage.Bytes())
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *RoutesWidgetElem) LastUsed() *tview.TableCell {
+	val := "never"
+	if lastUsed := elem.Route.Usage.LastUsed(); !lastUsed.IsZero() {
+		val = utils.HumanTimeSince(lastUsed)
+	}
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
 type ByRouteOrder []*RoutesWidgetElem
 
 func (sorter ByRouteOrder) Len() int      { return len(sorter) }
@@ -246,3 +305,14 @@ func (ByRouteOrder) compareIPs(a, b net.IP) int {
 	}
 	return 0
 }
+
+func (widget *RoutesWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Routes",
+		Summary: "Networks routed through the sessions, traffic to them goes through the TUN interface of the owning session. Flows, traffic and last use count what each route actually relayed, routes never used are candidates for pruning. Tags and Note are shared context set by operators.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "route menu: edit, tags and notes, move to another session, remove"},
+			{Name: "Up/Down", Description: "select a route"},
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/rivo/tview"
 
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
 )
@@ -38,12 +39,26 @@ func (widget *ServerWidget) SetData(config *config.Config, oper *operator.Operat
 
 func (widget *ServerWidget) Refresh() {
 	if widget.operator != nil {
-		access := "operator"
-		if widget.operator.IsAdmin {
-			access = "admin"
+		text := fmt.Sprintf("Operator: %s@%s (%s) | Agent server: %s", widget.operator.Name, widget.operator.Server, widget.operator.GetRole(), widget.serverConfig.ListenInterface)
+		if widget.serverConfig.CryptoMode != "" {
+			text += fmt.Sprintf(" | Crypto: %s", widget.serverConfig.CryptoMode)
+		}
+		if widget.serverConfig.HAPeer != "" {
+			text += fmt.Sprintf(" | Standby: %s", widget.serverConfig.HAPeer)
+		}
+		if widget.serverConfig.Engagement != "" {
+			text += fmt.Sprintf(" | Engagement: %s", widget.serverConfig.Engagement)
+		}
+		if widget.serverConfig.TunError != "" {
+			text += fmt.Sprintf(" | Relays: unavailable (%s)", widget.serverConfig.TunError)
 		}
-
-		text := fmt.Sprintf("Operator: %s@%s (%s) | Agent server: %s", widget.operator.Name, widget.operator.Server, access, widget.serverConfig.ListenInterface)
 		widget.SetText(text)
 	}
 }
+
+func (widget *ServerWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Server",
+		Summary: "The server this client is connected to, the operator it is connected as and the engagement new sessions and builds are filed under. Servers paired for high availability show the standby, the client connects to it when the active one stops answering. Relays are shown as unavailable when the server runs without TUN support, e.g. in a container without /dev/net/tun; redirectors, SOCKS5 services, files and commands still work.",
+	}
+}
@@ -3,9 +3,11 @@ package widgets
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/help"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/style"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
@@ -87,9 +89,21 @@ func (widget *SessionsWidget) SetSelectedFunc(f func(*session.Session)) {
 func (widget *SessionsWidget) SetData(data []*session.Session) {
 	widget.Clear()
 
+	names := make(map[string]string)
+	for _, session := range data {
+		names[session.ID] = session.GetName()
+	}
+
 	widget.data = nil
 	for _, session := range data {
-		widget.data = append(widget.data, NewSessionsWidgetElem(session))
+		elem := NewSessionsWidgetElem(session)
+		if session.Bridge != "" {
+			elem.bridge = session.Bridge
+			if name, ok := names[session.Bridge]; ok {
+				elem.bridge = name
+			}
+		}
+		widget.data = append(widget.data, elem)
 	}
 
 	widget.Refresh()
@@ -110,7 +124,7 @@ func (widget *SessionsWidget) ResetSelector() {
 }
 
 func (widget *SessionsWidget) Refresh() {
-	headers := []string{"Alias", "Hostname", "Connected", "Relaying", "First Seen", "Last Seen", ""}
+	headers := []string{"Alias", "Hostname", "Tags", "Note", "Owner", "Egress", "Listener", "Container", "Clock Skew", "Connected", "Relaying", "SOCKS5", "First Seen", "Last Seen", ""}
 	for colNo, header := range headers {
 		header := fmt.Sprintf("[::b]%s", strings.ToUpper(header))
 		widget.SetCell(0, colNo, tview.NewTableCell(header).SetExpansion(1).SetSelectable(false)).SetFixed(1, 0)
@@ -126,11 +140,19 @@ func (widget *SessionsWidget) Refresh() {
 
 		widget.SetCell(rowId, 0, elem.Alias())
 		widget.SetCell(rowId, 1, elem.Hostname())
-		widget.SetCell(rowId, 2, elem.IsConnected())
-		widget.SetCell(rowId, 3, elem.IsRelaying())
-		widget.SetCell(rowId, 4, elem.FirstSeen())
-		widget.SetCell(rowId, 5, elem.LastSeen())
-		widget.SetCell(rowId, 6, elem.Status().SetSelectable(false).SetAlign(tview.AlignCenter))
+		widget.SetCell(rowId, 2, elem.Tags())
+		widget.SetCell(rowId, 3, elem.Note())
+		widget.SetCell(rowId, 4, elem.Owner())
+		widget.SetCell(rowId, 5, elem.Egress())
+		widget.SetCell(rowId, 6, elem.Listener())
+		widget.SetCell(rowId, 7, elem.Container())
+		widget.SetCell(rowId, 8, elem.ClockSkew())
+		widget.SetCell(rowId, 9, elem.IsConnected())
+		widget.SetCell(rowId, 10, elem.IsRelaying())
+		widget.SetCell(rowId, 11, elem.Socks())
+		widget.SetCell(rowId, 12, elem.FirstSeen())
+		widget.SetCell(rowId, 13, elem.LastSeen())
+		widget.SetCell(rowId, 14, elem.Status().SetSelectable(false).SetAlign(tview.AlignCenter))
 
 		rowId++
 	}
@@ -138,6 +160,7 @@ func (widget *SessionsWidget) Refresh() {
 
 type SessionsWidgetElem struct {
 	Session *session.Session
+	bridge  string // name of the session the agent links through
 	bgcolor tcell.Color
 }
 
@@ -168,9 +191,15 @@ func (elem *SessionsWidgetElem) Highlight(h bool) {
 	}
 }
 
+// Alias is badged when the agent speaks an older protocol than the server
 func (elem *SessionsWidgetElem) Alias() *tview.TableCell {
 	val := elem.Session.Alias
-	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+	if !elem.Session.Outdated {
+		return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+	}
+
+	val = fmt.Sprintf("%s (agent outdated)", val)
+	return tview.NewTableCell(val).SetTextColor(tcell.ColorYellow).SetBackgroundColor(elem.bgcolor)
 }
 
 func (elem *SessionsWidgetElem) Hostname() *tview.TableCell {
@@ -178,8 +207,123 @@ func (elem *SessionsWidgetElem) Hostname() *tview.TableCell {
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
 }
 
+func (elem *SessionsWidgetElem) Tags() *tview.TableCell {
+	val := session.FormatTags(elem.Session.Tags)
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *SessionsWidgetElem) Note() *tview.TableCell {
+	val := noteSummary(elem.Session.Note)
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+// Owner shows who claimed the session, a pending handoff is highlighted along with its recipient
+func (elem *SessionsWidgetElem) Owner() *tview.TableCell {
+	sess := elem.Session
+	val := sess.Claim
+	if val == "" {
+		val = "-"
+	}
+
+	if sess.Handoff == nil {
+		return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+	}
+
+	val = fmt.Sprintf("%s → %s", val, sess.Handoff.To)
+	return tview.NewTableCell(val).SetTextColor(tcell.ColorYellow).SetBackgroundColor(elem.bgcolor)
+}
+
+// Egress shows where the agent connects from and through which proxy or bridge, a change in the last day is
+// highlighted along with the old address, and so is a slow fallback transport
+func (elem *SessionsWidgetElem) Egress() *tview.TableCell {
+	sess := elem.Session
+	if sess.Egress == "" {
+		return tview.NewTableCell("-").SetBackgroundColor(elem.bgcolor)
+	}
+
+	val := sess.Egress
+	if elem.bridge != "" {
+		val = fmt.Sprintf("bridged by %s", elem.bridge)
+	} else if sess.EgressPath != "" && sess.EgressPath != "direct" {
+		val = fmt.Sprintf("%s via %s", val, sess.EgressPath)
+	}
+
+	recent := !sess.EgressChanged.IsZero() && time.Since(sess.EgressChanged) <= 24*time.Hour
+	if recent {
+		val = fmt.Sprintf("%s (was %s)", val, sess.PreviousEgress)
+	}
+
+	if sess.SlowTransport() {
+		val = fmt.Sprintf("%s over %s (slow)", val, sess.Transport)
+	} else if !recent {
+		return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+	}
+
+	return tview.NewTableCell(val).SetTextColor(tcell.ColorYellow).SetBackgroundColor(elem.bgcolor)
+}
+
+// Listener is the one the agent connected on, agents linking through a bridge have none
+func (elem *SessionsWidgetElem) Listener() *tview.TableCell {
+	val := elem.Session.Listener
+	if val == "" {
+		val = "-"
+	}
+
+	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *SessionsWidgetElem) Container() *tview.TableCell {
+	info := elem.Session.Container
+	if info.Runtime == "" {
+		return tview.NewTableCell("-").SetBackgroundColor(elem.bgcolor)
+	}
+
+	val := info.Runtime
+	switch {
+	case info.HostNetwork:
+		val = fmt.Sprintf("%s (host network)", val)
+	case info.ViaHost:
+		val = fmt.Sprintf("%s (via host netns)", val)
+	case info.HostNetns != "":
+		val = fmt.Sprintf("%s (host netns reachable)", val)
+	}
+
+	return tview.NewTableCell(val).SetTextColor(tcell.ColorYellow).SetBackgroundColor(elem.bgcolor)
+}
+
+func (elem *SessionsWidgetElem) ClockSkew() *tview.TableCell {
+	skew := elem.Session.ClockSkew
+	if skew.Abs() < time.Second {
+		return tview.NewTableCell("-").SetBackgroundColor(elem.bgcolor)
+	}
+
+	val := skew.String()
+	if skew > 0 {
+		val = "+" + val
+	}
+
+	cell := tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
+	if skew.Abs() > time.Minute {
+		cell.SetTextColor(tcell.ColorYellow)
+	}
+
+	return cell
+}
+
 func (elem *SessionsWidgetElem) IsConnected() *tview.TableCell {
 	val := utils.HumanBool(elem.Session.IsConnected)
+	if !elem.Session.IsConnected && !elem.Session.Disconnection.At.IsZero() {
+		val = fmt.Sprintf("%s (%s)", val, elem.Session.Disconnection.Reason)
+	}
+	if dormant, until := elem.Session.Beacon.Dormant(time.Now().Add(elem.Session.ClockSkew)); !elem.Session.IsConnected && dormant {
+		val = fmt.Sprintf("Dormant (until %s)", until.Format("Mon 15:04"))
+	} else if !elem.Session.IsConnected && elem.Session.Beacon.Interval > 0 {
+		if elem.Session.Beacon.Awake {
+			val = "Waking up"
+		} else {
+			val = fmt.Sprintf("Asleep (%s)", elem.Session.Beacon.Interval)
+		}
+	}
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
 }
 
@@ -188,6 +332,20 @@ func (elem *SessionsWidgetElem) IsRelaying() *tview.TableCell {
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
 }
 
+// Socks shows where the agent's SOCKS5 service listens, or that it should but isn't running
+func (elem *SessionsWidgetElem) Socks() *tview.TableCell {
+	socks := elem.Session.Socks
+	if socks.Listening != "" {
+		return tview.NewTableCell(socks.Listening).SetBackgroundColor(elem.bgcolor)
+	}
+
+	if socks.Enabled() && elem.Session.IsConnected {
+		return tview.NewTableCell(fmt.Sprintf("%s (down)", socks.Address)).SetTextColor(tcell.ColorYellow).SetBackgroundColor(elem.bgcolor)
+	}
+
+	return tview.NewTableCell("").SetBackgroundColor(elem.bgcolor)
+}
+
 func (elem *SessionsWidgetElem) FirstSeen() *tview.TableCell {
 	val := utils.HumanTime(elem.Session.FirstSeen)
 	return tview.NewTableCell(val).SetBackgroundColor(elem.bgcolor)
@@ -201,6 +359,9 @@ func (elem *SessionsWidgetElem) LastSeen() *tview.TableCell {
 func (elem *SessionsWidgetElem) Status() *tview.TableCell {
 	val := "⚑"
 	if !elem.Session.IsConnected {
+		if dormant, _ := elem.Session.Beacon.Dormant(time.Now().Add(elem.Session.ClockSkew)); dormant || elem.Session.Beacon.Interval > 0 {
+			return tview.NewTableCell(val).SetTextColor(tcell.ColorYellow)
+		}
 		return tview.NewTableCell(val).SetTextColor(tcell.ColorRed)
 	}
 
@@ -210,3 +371,29 @@ func (elem *SessionsWidgetElem) Status() *tview.TableCell {
 
 	return tview.NewTableCell(val).SetTextColor(tcell.ColorGreen)
 }
+
+// noteSummary fits a note in a table cell, the whole of it is in the form editing it
+func noteSummary(note string) string {
+	const maxLength = 40
+
+	line, _, multiline := strings.Cut(note, "\n")
+	if runes := []rune(line); len(runes) > maxLength {
+		line, multiline = string(runes[:maxLength]), true
+	}
+	if multiline {
+		line += "…"
+	}
+
+	return line
+}
+
+func (widget *SessionsWidget) Help() help.Doc {
+	return help.Doc{
+		Title:   "Sessions",
+		Summary: "Agents that connected to the server, the selected one drives the interfaces, routes and redirectors panes. Agents speaking an older protocol than the server are badged agent outdated, they keep working but need a rebuild for the newest features. Tags and Note are shared context set by operators, the filter (Ctrl+L) matches them. Owner is the operator who claimed the session, followed by the recipient in yellow while a handoff waits for them to accept. Egress is the address the agent connects from, it turns yellow for a day when the agent came back from a different one. Listener is the agent listener it connected on. SOCKS5 is where the agent's own SOCKS5 service listens on the target. Disconnected sessions show why they dropped: agent exit, TLS error, connection lost, keepalive timeout, revoked certificate, operator kill or server shutdown.",
+		Keys: []help.Key{
+			{Name: "Enter", Description: "session menu: relay, throughput test, link details, spoofing, mirroring, sleep, beacon, working hours, SOCKS5 service, files, run command, attachments, handoff, rename, tags and notes, last disconnect, update agent, kill agent, routes and redirectors"},
+			{Name: "Up/Down", Description: "select a session"},
+		},
+	}
+}
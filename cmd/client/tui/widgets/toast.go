@@ -0,0 +1,52 @@
+package widgets
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+)
+
+// Toast is a one line notification above the navbar, it takes no room while there's nothing to show
+type Toast struct {
+	*tview.TextView
+	shown int // bumped on every Show, so that only the latest notification clears it
+}
+
+func NewToast() *Toast {
+	toast := &Toast{
+		TextView: tview.NewTextView(),
+	}
+
+	toast.SetDynamicColors(true)
+	toast.SetTextAlign(tview.AlignCenter)
+
+	return toast
+}
+
+// Show replaces whatever is shown, the returned ID is the one to pass to Clear
+func (toast *Toast) Show(eventType events.EventType, text string) int {
+	color := "green"
+	switch eventType {
+	case events.WARNING:
+		color = "yellow"
+	case events.ERROR:
+		color = "red"
+	}
+
+	toast.shown++
+	toast.SetText(fmt.Sprintf("[%s]%s", color, tview.Escape(text)))
+
+	return toast.shown
+}
+
+// Clear hides the notification of id, unless another one was shown since
+func (toast *Toast) Clear(id int) bool {
+	if id != toast.shown {
+		return false
+	}
+
+	toast.SetText("")
+
+	return true
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/snapshot"
+)
+
+func main() {
+	var quiet = flag.Bool("q", false, "only report whether the exports differ, through the exit code")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-q] <before.json> <after.json>\n\nCompares two state exports made from the client dashboard. Exits with 1 when they differ, like diff.\n\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	before, err := snapshot.Load(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	after, err := snapshot.Load(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	diff := snapshot.Compare(before, after)
+	if !*quiet {
+		fmt.Print(diff)
+	}
+
+	if !diff.Empty() {
+		os.Exit(1)
+	}
+}
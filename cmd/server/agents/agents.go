@@ -4,28 +4,56 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net"
+	"os"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp/v2/internal/asset"
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/conformance"
 	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/fips"
+	"github.com/ttpreport/ligolo-mp/v2/internal/listener"
+	"github.com/ttpreport/ligolo-mp/v2/internal/malleable"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
 )
 
 type AgentApiHandler struct {
-	config         *config.Config
-	certService    *certificate.CertificateService
-	sessionService *session.SessionService
+	config          *config.Config
+	certService     *certificate.CertificateService
+	sessionService  *session.SessionService
+	assetService    *asset.AssetService
+	listenerService *listener.ListenerService
+	decoy           []byte // page served to clients without an agent certificate, nil rejects them
+	tlsConfig       *tls.Config
 
-	connections chan net.Conn
+	runningMutex sync.Mutex
+	running      map[string]net.Listener // listeners added by admins, by name
+
+	refusedMutex sync.Mutex
+	refused      map[string]time.Time // when operators were last told about an incompatible agent, by address
+
+	connections chan incoming
 	quit        chan error
 }
 
-func Run(config *config.Config, certService *certificate.CertificateService, sessionService *session.SessionService) error {
+// incoming is an agent connection and the listener it came in on, empty for agents linking through a bridge
+type incoming struct {
+	conn     net.Conn
+	listener string
+}
+
+func Run(config *config.Config, certService *certificate.CertificateService, sessionService *session.SessionService, assetService *asset.AssetService, listenerService *listener.ListenerService) error {
 	CACert := certService.GetCA()
 	certpool, err := CACert.CertPool()
 	if err != nil {
@@ -39,16 +67,29 @@ func Run(config *config.Config, certService *certificate.CertificateService, ses
 	}
 
 	handler := &AgentApiHandler{
-		config:         config,
-		certService:    certService,
-		sessionService: sessionService,
-		connections:    make(chan net.Conn, 4096),
-		quit:           make(chan error, 1),
+		config:          config,
+		certService:     certService,
+		sessionService:  sessionService,
+		assetService:    assetService,
+		listenerService: listenerService,
+		running:         make(map[string]net.Listener),
+		refused:         make(map[string]time.Time),
+		connections:     make(chan incoming, 4096),
+		quit:            make(chan error, 1),
 	}
 
 	var clientAuth = tls.RequireAndVerifyClientCert
+	nextProtos := []string{stageProtocol}
 	if config.InsecureAgents {
 		clientAuth = tls.NoClientCert
+	} else if config.DecoyPage != "" {
+		if handler.decoy, err = os.ReadFile(config.DecoyPage); err != nil {
+			return err
+		}
+
+		// browsers and scanners get through the handshake and find a web server, dispatch keeps them away from sessions
+		clientAuth = tls.VerifyClientCertIfGiven
+		nextProtos = append(nextProtos, "http/1.1")
 	}
 
 	tlsConfig := &tls.Config{
@@ -56,52 +97,126 @@ func Run(config *config.Config, certService *certificate.CertificateService, ses
 		Certificates:       []tls.Certificate{tlsCert},
 		ClientCAs:          certpool,
 		RootCAs:            certpool,
-		MinVersion:         tls.VersionTLS13,
+		MinVersion:         fips.TLSMinVersion(),
 		MaxVersion:         tls.VersionTLS13,
+		NextProtos:         nextProtos,
 		InsecureSkipVerify: true,
 		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
-			cert, err := x509.ParseCertificate(rawCerts[0])
-			if err != nil {
-				return err
+			if len(rawCerts) == 0 {
+				return nil // only allowed with a decoy, which is what the client gets
 			}
 
-			options := x509.VerifyOptions{
-				Roots: certpool,
-			}
-			if options.Roots == nil {
-				return errors.New("no root certificate")
-			}
+			_, err := certService.VerifyPeer(rawCerts, certpool)
+			return err
+		},
+	}
 
-			if _, err := cert.Verify(options); err != nil {
-				return err
-			}
+	handler.tlsConfig = tlsConfig
 
-			return nil
-		},
+	endpoints, err := parseListeners(config.AgentTransport, config.ListenInterface, config.GetProfilesFile())
+	if err != nil {
+		return err
+	}
+	if config.AgentProxyProtocol {
+		for i := range endpoints {
+			endpoints[i].expectProxyProtocol()
+		}
 	}
 
-	go handler.serve("tcp", config.ListenInterface, tlsConfig)
+	sessionService.HandleBridged(handler.bridge(tlsConfig))
+
+	go handler.serve(endpoints, tlsConfig)
 
 	return <-handler.quit
 }
 
-func (aah *AgentApiHandler) serve(protocol string, listenIface string, tlsConfig *tls.Config) {
+// endpoint is a transport agents connect over and where it listens
+type endpoint struct {
+	name      string // what sessions that came in on it are attributed to
+	spec      string // transport and profile as given, e.g. wss+office
+	transport transport.Transport
+	address   string
+	profile   *transport.Profile // agents have to be built with the same, nil for none
+	proxied   bool               // connections come through redirectors sending a PROXY protocol header
+}
+
+// expectProxyProtocol has the endpoint read where agents connect from off the PROXY protocol header of redirectors,
+// transports not over TCP are left as they are
+func (e *endpoint) expectProxyProtocol() bool {
+	proxied, ok := e.transport.(transport.Proxied)
+	if ok {
+		e.transport = proxied.WithProxyProtocol()
+		e.proxied = true
+	}
+
+	return ok
+}
+
+// parseListeners reads transports separated by commas, each listening on the given address or on its own, e.g.
+// "tls,quic,wss@0.0.0.0:443". tls and quic can share an address being on TCP and UDP. A transport takes a profile
+// from the profiles file after a +, e.g. wss+office@0.0.0.0:443
+func parseListeners(transports string, address string, profilesFile string) ([]endpoint, error) {
+	var result []endpoint
+	for _, entry := range strings.Split(transports, ",") {
+		spec, own, found := strings.Cut(strings.TrimSpace(entry), "@")
+		name, profileName, profiled := strings.Cut(spec, "+")
+
+		agentTransport, err := transport.Get(name)
+		if err != nil {
+			return nil, err
+		}
+
+		l := endpoint{spec: spec, transport: agentTransport, address: address}
+		if found {
+			l.address = own
+		}
+		l.name = fmt.Sprintf("%s@%s", spec, l.address)
+		if profiled {
+			if l.profile, err = malleable.Get(profilesFile, profileName); err != nil {
+				return nil, err
+			}
+			if t, ok := agentTransport.(transport.Profiled); ok {
+				l.transport = t.WithProfile(l.profile)
+			}
+		}
+		result = append(result, l)
+	}
+
+	return result, nil
+}
+
+// serve listens with every transport given on the command line, the server stops if one of them can't listen or
+// fails. Listeners added by admins are started next, they don't stop the server
+func (aah *AgentApiHandler) serve(endpoints []endpoint, tlsConfig *tls.Config) {
 	defer func() { aah.quit <- nil }()
 
-	server, err := tls.Listen(protocol, listenIface, tlsConfig)
-	if err != nil {
-		slog.Error("Could not start agent server",
-			slog.Any("error", err),
+	var servers []net.Listener
+	defer func() {
+		for _, server := range servers {
+			server.Close()
+		}
+	}()
+
+	for _, l := range endpoints {
+		server, err := l.transport.Listen(l.address, listenerTLSConfig(tlsConfig, l.profile))
+		if err != nil {
+			slog.Error("Could not start agent server",
+				slog.Any("transport", l.transport.Name()),
+				slog.Any("error", err),
+			)
+			return
+		}
+		servers = append(servers, server)
+
+		slog.Info("Agent server started",
+			slog.Any("address", l.address),
+			slog.Any("transport", l.transport.Name()),
+			slog.Any("profile", l.profile.GetName()),
+			slog.Any("proxy_protocol", l.proxied),
 		)
-		return
 	}
-	defer server.Close()
 
-	slog.Info("Agent server started",
-		slog.Any("address", listenIface),
-	)
-
-	err = aah.sessionService.CleanUp()
+	err := aah.sessionService.CleanUp()
 	if err != nil {
 		slog.Error("Could not clean up sessions",
 			slog.Any("error", err),
@@ -110,70 +225,279 @@ func (aah *AgentApiHandler) serve(protocol string, listenIface string, tlsConfig
 
 	go aah.startHandler()
 
+	var static []*listener.Listener
+	for _, l := range endpoints {
+		static = append(static, &listener.Listener{Name: l.name, Transport: l.spec, Address: l.address, ProxyProtocol: l.proxied, Enabled: true, Static: true})
+	}
+	if err := aah.listenerService.Run(aah, static); err != nil {
+		slog.Error("Could not start agent listeners", slog.Any("error", err))
+	}
+
+	stopped := make(chan struct{}, len(servers))
+	for i, server := range servers {
+		go aah.accept(server, endpoints[i], stopped)
+	}
+	<-stopped
+}
+
+// listenerTLSConfig lets agents mimicking a browser through, browsers offer h2 and http/1.1 and nothing else
+func listenerTLSConfig(config *tls.Config, profile *transport.Profile) *tls.Config {
+	if profile == nil || profile.Fingerprint == "" || profile.Fingerprint == "go" || slices.Contains(config.NextProtos, "http/1.1") {
+		return config
+	}
+
+	config = config.Clone()
+	config.NextProtos = append(config.NextProtos, "http/1.1")
+	return config
+}
+
+// accept takes agents until the listener is closed, stopped is then signaled unless nil
+func (aah *AgentApiHandler) accept(server net.Listener, l endpoint, stopped chan<- struct{}) {
+	if stopped != nil {
+		defer func() { stopped <- struct{}{} }()
+	}
+
 	for {
 		conn, err := server.Accept()
 		if err != nil {
-			slog.Error("Agent server encountered an error",
-				slog.Any("error", err),
-			)
-
-			if err == net.ErrClosed {
+			if errors.Is(err, net.ErrClosed) {
 				return
 			}
 
+			slog.Error("Agent server encountered an error",
+				slog.Any("listener", l.name),
+				slog.Any("error", err),
+			)
 			continue
 		}
-		aah.connections <- conn
+		go aah.dispatch(conn, l.name, l.profile)
 	}
 }
 
 func (aah *AgentApiHandler) startHandler() {
 	for {
-		remoteConn := <-aah.connections
+		in := <-aah.connections
+		remoteConn := in.conn
 		slog.Debug("agent connection received")
 
 		config := yamux.DefaultConfig()
 		config.LogOutput = io.Discard
-		yamuxConn, err := yamux.Client(remoteConn, config)
+		config.EnableKeepAlive = false // the session monitor pings at an interval tuned to the link
+		if aah.config.MuxWriteTimeout > 0 {
+			config.ConnectionWriteTimeout = aah.config.MuxWriteTimeout // pings time out after it as well
+		}
+		watcher := session.NewConnWatcher(remoteConn)
+		yamuxConn, err := yamux.Client(watcher, config)
 		if err != nil {
 			slog.Error("could not open multiplexed connection with agent")
 			continue
 		}
 		slog.Debug("established multiplexed connection with agent")
 
-		newSession, err := aah.sessionService.NewSession(yamuxConn)
+		if aah.config.ConformanceMode {
+			go aah.runConformance(yamuxConn)
+			continue
+		}
+
+		link := session.ProbeLink(yamuxConn)
+		link.Apply(config)
+		if aah.config.MuxKeepAlive > 0 {
+			link.KeepAlive = aah.config.MuxKeepAlive
+		}
+		slog.Debug("agent link probed", slog.Duration("rtt", link.RTT), slog.Int64("bandwidth", link.Bandwidth), slog.Any("window", link.WindowSize))
+
+		connected := time.Now()
+		newSession, err := aah.sessionService.NewSession(yamuxConn, link)
 		if err != nil {
 			slog.Error("could not initialize new session", slog.Any("error", err))
+			if errors.Is(err, protocol.ErrIncompatible) {
+				aah.refuseIncompatible(yamuxConn.RemoteAddr(), err)
+			}
 			yamuxConn.Close()
 			continue
 		}
 		slog.Debug("new session created", slog.Any("session", newSession))
 
-		go aah.startSessionMonitor(newSession)
+		if via := bridgedVia(remoteConn); via != "" {
+			if err := aah.sessionService.SetBridge(newSession.ID, via); err != nil {
+				slog.Warn("could not record bridge", slog.String("session", newSession.GetName()), slog.Any("error", err))
+			}
+		}
+
+		if in.listener != "" {
+			if err := aah.sessionService.SetListener(newSession.ID, in.listener); err != nil {
+				slog.Warn("could not record listener", slog.String("session", newSession.GetName()), slog.Any("error", err))
+			}
+		}
+
+		if cert := peerCertificate(remoteConn); cert != nil {
+			if err := aah.sessionService.SetCertificate(newSession.ID, cert.Raw); err != nil {
+				slog.Warn("could not record agent certificate", slog.String("session", newSession.GetName()), slog.Any("error", err))
+			}
+		}
+
+		go aah.startSessionMonitor(newSession, watcher)
+		go aah.sessionService.ServeForwards(newSession)
 
 		slog.Debug("session initialized")
 
-		events.Publish(events.OK, "new session with '%s' established", newSession.GetName())
+		if aah.config.EgressAlert && newSession.EgressChanged.After(connected) {
+			events.Publish(events.WARNING, "'%s' now connects from %s instead of %s", newSession.GetName(), newSession.Egress, newSession.PreviousEgress)
+		}
+
+		if newSession.Beacon.Interval > 0 {
+			asleep := newSession.Beacon.Asleep()
+			if err := aah.sessionService.SyncBeacon(newSession.ID); err != nil {
+				slog.Warn("could not sync beacon settings", slog.String("session", newSession.GetName()), slog.Any("error", err))
+			} else if asleep {
+				slog.Info("agent checked in", slog.String("session", newSession.GetName()), slog.Duration("interval", newSession.Beacon.Interval))
+				continue
+			}
+		}
+
+		events.PublishTopic(events.TopicSessionConnected, newSession.ID, events.OK, "new session with '%s' established", newSession.GetName())
+
+		if newSession.SlowTransport() {
+			events.Publish(events.WARNING, "'%s' is connected over %s, expect a few kilobytes per second at best", newSession.GetName(), newSession.Transport)
+		}
+
+		skew := newSession.ClockSkew.Abs()
+		if aah.config.MaxClockSkew > 0 && skew > aah.config.MaxClockSkew {
+			slog.Warn("agent clock is skewed", slog.String("session", newSession.GetName()), slog.Duration("skew", newSession.ClockSkew))
+			events.Publish(events.ERROR, "clock of '%s' is off by %s", newSession.GetName(), newSession.ClockSkew)
+		}
 	}
 
 }
 
-func (aah *AgentApiHandler) startSessionMonitor(sess *session.Session) {
+func (aah *AgentApiHandler) runConformance(multiplex *yamux.Session) {
+	defer multiplex.Close()
+
+	remote := multiplex.RemoteAddr().String()
+	slog.Info("running conformance checks", slog.String("agent", remote))
+
+	var failed int
+	for _, result := range conformance.Run(multiplex) {
+		if result.Passed() {
+			slog.Info("conformance check passed", slog.String("agent", remote), slog.String("check", result.Name), slog.Duration("took", result.Duration))
+			continue
+		}
+
+		failed++
+		slog.Error("conformance check failed", slog.String("agent", remote), slog.String("check", result.Name), slog.Any("error", result.Err))
+		events.Publish(events.ERROR, "agent %s failed conformance check '%s': %s", remote, result.Name, result.Err)
+	}
+
+	if failed > 0 {
+		events.Publish(events.ERROR, "agent %s failed %d conformance checks", remote, failed)
+		return
+	}
+
+	events.Publish(events.OK, "agent %s passed all conformance checks", remote)
+}
+
+func (aah *AgentApiHandler) startSessionMonitor(sess *session.Session, watcher *session.ConnWatcher) {
+	interval := sess.Link.KeepAlive
+	window := aah.config.DeadSessionWindow
+	if window > 0 && window/3 < interval {
+		interval = window / 3 // live agents answer a couple of pings within the window, even if one gets lost
+	}
+
 	tick := time.NewTicker(1 * time.Second)
+	keepAlive := time.NewTicker(interval)
 	for {
 		select {
 		case <-tick.C:
 			aah.sessionService.UpdateLastSeen(sess.ID)
+			if window > 0 && watcher.Silence() > window {
+				slog.Debug("agent went silent, dropping session", slog.Any("session", sess), slog.Duration("window", window))
+				sess.Drop(session.DisconnectKeepAlive, fmt.Sprintf("nothing heard from the agent for %s", window))
+				sess.Multiplex.Close()
+			}
+		case <-keepAlive.C:
+			if aah.isRevoked(watcher.Conn) {
+				slog.Info("agent certificate revoked, dropping session", slog.Any("session", sess))
+				sess.Drop(session.DisconnectRevoked, "")
+				sess.Multiplex.Close()
+				continue
+			}
+
+			rtt, err := sess.Multiplex.Ping()
+			if err != nil {
+				slog.Debug("session keepalive failed", slog.Any("session", sess), slog.Any("error", err))
+				sess.Drop(session.DisconnectKeepAlive, err.Error())
+				sess.Multiplex.Close()
+				continue
+			}
+			aah.sessionService.UpdateRTT(sess.ID, rtt)
 		case <-sess.Multiplex.CloseChan():
 			slog.Debug("session multiplexer closed", slog.Any("session", sess))
-			aah.sessionService.DisconnectSession(sess.ID)
-			events.Publish(events.ERROR, "session with '%s' disconnected", sess.GetName())
+			if dormant, until := sess.Beacon.Dormant(time.Now().Add(sess.ClockSkew)); dormant {
+				sess.Drop(session.DisconnectDormant, fmt.Sprintf("until %s agent time", until.Format(time.DateTime)))
+			}
+			reason, note := watcher.Reason()
+			aah.sessionService.DisconnectSession(sess.ID, reason, note)
+			if sess.Beacon.Asleep() {
+				slog.Debug("session went to sleep", slog.Any("session", sess))
+				return
+			}
+			if sess.Disconnection.Reason == session.DisconnectDormant {
+				slog.Info("session went dormant", slog.String("session", sess.GetName()), slog.String("note", sess.Disconnection.Note))
+				return
+			}
+			slog.Info("session disconnected", slog.String("session", sess.GetName()), slog.String("reason", sess.Disconnection.Reason.String()), slog.String("note", sess.Disconnection.Note))
+			events.PublishTopic(events.TopicSessionDisconnected, sess.ID, events.ERROR, "session with '%s' disconnected: %s", sess.GetName(), sess.Disconnection.Reason)
 			return
 		}
 	}
 
 }
 
+// refuseIncompatible tells operators about an agent speaking a protocol the server doesn't, once a day per address
+// since such an agent keeps reconnecting
+func (aah *AgentApiHandler) refuseIncompatible(remote net.Addr, err error) {
+	host, _, splitErr := net.SplitHostPort(remote.String())
+	if splitErr != nil {
+		host = remote.String()
+	}
+
+	aah.refusedMutex.Lock()
+	defer aah.refusedMutex.Unlock()
+
+	if last, ok := aah.refused[host]; ok && time.Since(last) < 24*time.Hour {
+		return
+	}
+	aah.refused[host] = time.Now()
+
+	events.Publish(events.ERROR, "agent connecting from %s was refused: %s", host, err)
+}
+
+// isRevoked tells whether the certificate the agent connected with, or the intermediate CA it came with, got revoked
+// since
+func (aah *AgentApiHandler) isRevoked(conn net.Conn) bool {
+	tlsConn, ok := conn.(interface{ ConnectionState() tls.ConnectionState })
+	if !ok {
+		return false
+	}
+
+	return aah.certService.IsChainRevoked(tlsConn.ConnectionState().PeerCertificates)
+}
+
+// peerCertificate is the certificate the agent connected with, only TLS and QUIC transports carry one
+func peerCertificate(conn net.Conn) *x509.Certificate {
+	tlsConn, ok := conn.(interface{ ConnectionState() tls.ConnectionState })
+	if !ok {
+		return nil
+	}
+
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil
+	}
+
+	return certs[0]
+}
+
 func (aah *AgentApiHandler) Close() {
 	aah.quit <- nil
 }
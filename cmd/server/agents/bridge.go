@@ -0,0 +1,33 @@
+package agents
+
+import (
+	"crypto/tls"
+	"net"
+)
+
+// bridgedConn is an agent linking through a bridge, carried by a stream of the bridging session
+type bridgedConn struct {
+	net.Conn
+	via string // bridging session
+}
+
+// bridge takes agents linking through a bridge like those reaching the listener. They always speak TLS, whatever
+// transport the server listens on, since they dialed the bridging agent over tls or smb
+func (aah *AgentApiHandler) bridge(tlsConfig *tls.Config) func(conn net.Conn, via string) {
+	return func(conn net.Conn, via string) {
+		aah.dispatch(tls.Server(&bridgedConn{Conn: conn, via: via}, tlsConfig), "", nil)
+	}
+}
+
+// bridgedVia tells which session the agent links through, empty if it reached the listener itself
+func bridgedVia(conn net.Conn) string {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		conn = tlsConn.NetConn()
+	}
+
+	if bridged, ok := conn.(*bridgedConn); ok {
+		return bridged.via
+	}
+
+	return ""
+}
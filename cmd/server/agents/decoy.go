@@ -0,0 +1,65 @@
+package agents
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	decoyTimeout     = 15 * time.Second
+	decoyMaxRequests = 16
+	decoyMaxBody     = 64 * 1024
+)
+
+var decoyNotFound = []byte("<html>\r\n<head><title>404 Not Found</title></head>\r\n<body>\r\n<center><h1>404 Not Found</h1></center>\r\n</body>\r\n</html>\r\n")
+
+// serveDecoy answers clients that came without an agent certificate as a plain web server would, with the decoy
+// page at the root and 404 everywhere else
+func (aah *AgentApiHandler) serveDecoy(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	for i := 0; i < decoyMaxRequests; i++ {
+		conn.SetDeadline(time.Now().Add(decoyTimeout))
+
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			return
+		}
+		io.Copy(io.Discard, io.LimitReader(req.Body, decoyMaxBody))
+		req.Body.Close()
+
+		slog.Debug("decoy request", slog.Any("remote", conn.RemoteAddr()), slog.String("method", req.Method), slog.String("path", req.URL.Path), slog.String("user_agent", req.UserAgent()))
+
+		status, body := http.StatusNotFound, decoyNotFound
+		if req.URL.Path == "/" || req.URL.Path == "/index.html" {
+			status, body = http.StatusOK, aah.decoy
+		}
+
+		header := http.Header{}
+		header.Set("Content-Type", http.DetectContentType(body))
+		header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+		if aah.config.DecoyServer != "" {
+			header.Set("Server", aah.config.DecoyServer)
+		}
+
+		resp := &http.Response{
+			StatusCode:    status,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			ContentLength: int64(len(body)),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			Request:       req,
+			Close:         req.Close || i == decoyMaxRequests-1,
+		}
+		if err := resp.Write(conn); err != nil || resp.Close {
+			return
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package agents
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/listener"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
+)
+
+// Start listens for agents on a listener added by an admin, sessions that come in on it are attributed to it
+func (aah *AgentApiHandler) Start(l *listener.Listener) error {
+	endpoints, err := parseListeners(l.Transport, l.Address, aah.config.GetProfilesFile())
+	if err != nil {
+		return err
+	}
+	e := endpoints[0] // listeners take a single transport
+	e.name = l.Name
+	if l.ProxyProtocol && !e.expectProxyProtocol() {
+		return fmt.Errorf("%s doesn't take the PROXY protocol, only transports over TCP do", e.transport.Name())
+	}
+
+	config := listenerTLSConfig(aah.tlsConfig, e.profile)
+	cert, err := l.KeyPair()
+	if err != nil {
+		return err
+	}
+	if cert != nil {
+		if fronted, ok := e.transport.(transport.Fronted); ok {
+			e.transport = fronted.WithCertificate(cert)
+		} else {
+			if err := aah.checkIssued(cert); err != nil {
+				return fmt.Errorf("agents only accept certificates issued by the server CA over %s: %v", e.transport.Name(), err)
+			}
+			config = config.Clone()
+			config.Certificates = []tls.Certificate{*cert}
+		}
+	}
+
+	aah.runningMutex.Lock()
+	defer aah.runningMutex.Unlock()
+
+	if _, ok := aah.running[l.Name]; ok {
+		return fmt.Errorf("listener '%s' is already running", l.Name)
+	}
+
+	server, err := e.transport.Listen(e.address, config)
+	if err != nil {
+		return err
+	}
+	aah.running[l.Name] = server
+
+	slog.Info("Agent server started",
+		slog.Any("listener", l.Name),
+		slog.Any("address", e.address),
+		slog.Any("transport", e.transport.Name()),
+		slog.Any("profile", e.profile.GetName()),
+		slog.Any("proxy_protocol", e.proxied),
+	)
+
+	go aah.accept(server, e, nil)
+
+	return nil
+}
+
+// Stop closes a listener added by an admin, sessions that came in on it stay up
+func (aah *AgentApiHandler) Stop(name string) {
+	aah.runningMutex.Lock()
+	defer aah.runningMutex.Unlock()
+
+	server, ok := aah.running[name]
+	if !ok {
+		return
+	}
+	delete(aah.running, name)
+
+	server.Close()
+	slog.Info("Agent server stopped", slog.Any("listener", name))
+}
+
+func (aah *AgentApiHandler) checkIssued(cert *tls.Certificate) error {
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: aah.tlsConfig.RootCAs})
+	return err
+}
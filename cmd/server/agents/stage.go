@@ -0,0 +1,87 @@
+package agents
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
+)
+
+// stageProtocol is negotiated over ALPN by stagers, agents don't offer any protocol
+const stageProtocol = "ligolo-stage/1"
+
+const (
+	handshakeTimeout = 10 * time.Second
+	stageTimeout     = 5 * time.Minute
+)
+
+// dispatch tells stagers apart from agents, both come in over the same mTLS listener. Clients without a
+// certificate only make it here when a decoy is configured
+func (aah *AgentApiHandler) dispatch(conn net.Conn, listener string, profile *transport.Profile) {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		aah.connections <- incoming{conn: profile.Shape(conn), listener: listener}
+		return
+	}
+
+	tlsConn.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err := tlsConn.Handshake(); err != nil {
+		slog.Debug("agent handshake failed", slog.Any("remote", conn.RemoteAddr()), slog.Any("error", err))
+		conn.Close()
+		return
+	}
+	tlsConn.SetDeadline(time.Time{})
+
+	if aah.decoy != nil && len(tlsConn.ConnectionState().PeerCertificates) == 0 {
+		aah.serveDecoy(tlsConn)
+		return
+	}
+
+	if tlsConn.ConnectionState().NegotiatedProtocol == stageProtocol {
+		aah.serveStage(tlsConn)
+		return
+	}
+
+	aah.connections <- incoming{conn: profile.Shape(conn), listener: listener}
+}
+
+// serveStage answers with the size of the requested stage followed by its content, a zero size means there is no
+// such stage
+func (aah *AgentApiHandler) serveStage(conn net.Conn) {
+	defer conn.Close()
+
+	remote := conn.RemoteAddr().String()
+	conn.SetDeadline(time.Now().Add(stageTimeout))
+
+	id, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		slog.Debug("could not read stage request", slog.String("stager", remote), slog.Any("error", err))
+		return
+	}
+	id = strings.TrimSpace(id)
+
+	stage, err := aah.assetService.Stage(id)
+	if err != nil {
+		slog.Warn("stager requested an unknown stage", slog.String("stager", remote), slog.String("stage", id), slog.Any("error", err))
+		binary.Write(conn, binary.BigEndian, uint64(0))
+		return
+	}
+
+	if err := binary.Write(conn, binary.BigEndian, uint64(len(stage))); err != nil {
+		return
+	}
+
+	if _, err := conn.Write(stage); err != nil {
+		slog.Error("could not deliver stage", slog.String("stager", remote), slog.String("stage", id), slog.Any("error", err))
+		return
+	}
+
+	slog.Info("stage delivered", slog.String("stager", remote), slog.String("stage", id))
+	events.Publish(events.OK, "stage %s delivered to %s", id, remote)
+}
@@ -0,0 +1,350 @@
+package console
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
+	"github.com/ttpreport/ligolo-mp/v2/internal/declaration"
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"github.com/ttpreport/ligolo-mp/v2/internal/snapshot"
+)
+
+const prompt = "ligolo-mp> "
+
+// Console is a break-glass admin shell on a local unix socket, it does not depend on the operator
+// certificates or the gRPC server so it stays usable when those are broken
+type Console struct {
+	path        string
+	listener    net.Listener
+	certService *certificate.CertificateService
+	sessService *session.SessionService
+	operService *operator.OperatorService
+	reconciler  *declaration.Reconciler
+	shutdown    func()
+}
+
+type state struct {
+	Snapshot  *snapshot.Snapshot
+	Operators []operatorState
+}
+
+type operatorState struct {
+	Name    string
+	IsAdmin bool
+	Role    string
+}
+
+// New listens on path, only the user running the server may connect
+func New(path string, certService *certificate.CertificateService, sessService *session.SessionService, operService *operator.OperatorService, reconciler *declaration.Reconciler, shutdown func()) (*Console, error) {
+	if err := removeStale(path); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return &Console{
+		path:        path,
+		listener:    listener,
+		certService: certService,
+		sessService: sessService,
+		operService: operService,
+		reconciler:  reconciler,
+		shutdown:    shutdown,
+	}, nil
+}
+
+// removeStale deletes a socket left behind by a server that did not exit cleanly, but never one that is in use
+func removeStale(path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("admin console %s is already in use, is another server running?", path)
+	}
+
+	return os.Remove(path)
+}
+
+func (c *Console) Serve() {
+	slog.Info("Admin console started", slog.Any("socket", c.path))
+
+	for {
+		conn, err := c.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			slog.Error("Admin console encountered an error", slog.Any("error", err))
+			continue
+		}
+
+		go c.handle(conn)
+	}
+}
+
+func (c *Console) Close() error {
+	return c.listener.Close()
+}
+
+func (c *Console) handle(conn net.Conn) {
+	defer conn.Close()
+
+	slog.Warn("Admin console opened")
+	defer slog.Warn("Admin console closed")
+
+	fmt.Fprint(conn, prompt)
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		args := strings.Fields(scanner.Text())
+		if len(args) > 0 {
+			slog.Info("Admin console command", slog.Any("command", args[0]))
+
+			if !c.exec(conn, args[0], args[1:]) {
+				return
+			}
+		}
+
+		fmt.Fprint(conn, prompt)
+	}
+}
+
+// exec runs a single command, it returns false once the connection should be closed
+func (c *Console) exec(out io.Writer, command string, args []string) bool {
+	var err error
+
+	switch command {
+	case "help":
+		c.help(out)
+	case "sessions":
+		err = c.sessions(out)
+	case "dump":
+		err = c.dump(out)
+	case "rotate-certs":
+		err = c.rotateCerts(out)
+	case "enroll":
+		err = c.enroll(out, args)
+	case "plan":
+		err = c.apply(out, args, false)
+	case "apply":
+		err = c.apply(out, args, true)
+	case "shutdown":
+		err = c.shutdownServer(out)
+		if err == nil {
+			return false
+		}
+	case "exit", "quit":
+		return false
+	default:
+		fmt.Fprintf(out, "unknown command %q, try help\n", command)
+	}
+
+	if err != nil {
+		fmt.Fprintf(out, "error: %s\n", err)
+	}
+
+	return true
+}
+
+func (c *Console) help(out io.Writer) {
+	fmt.Fprintln(out, "sessions      list agent sessions")
+	fmt.Fprintln(out, "dump          print sessions, routes, redirectors and operators as JSON")
+	fmt.Fprintln(out, "rotate-certs  reissue the operator and agent server certificates from the CA")
+	fmt.Fprintln(out, "enroll NAME ROLE SERVER")
+	fmt.Fprintln(out, "              print a one-time code a new operator enrolls with, valid for a day")
+	fmt.Fprintln(out, "plan FILE     show what applying a declaration would change")
+	fmt.Fprintln(out, "apply FILE    reconcile engagements, route profiles, routes and redirectors to a declaration")
+	fmt.Fprintln(out, "shutdown      stop the server, agents are left running and reconnect on restart")
+	fmt.Fprintln(out, "exit          close the console")
+}
+
+func (c *Console) sessions(out io.Writer) error {
+	sessions, err := c.sessService.GetAll()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tHOSTNAME\tCONNECTED\tRELAYING\tROUTES\tREDIRECTORS")
+	for _, sess := range sessions {
+		var routes, redirectors int
+		if sess.Tun != nil && sess.Tun.Routes != nil {
+			routes = len(sess.Tun.Routes.All())
+		}
+		if sess.Redirectors != nil {
+			redirectors = len(sess.Redirectors.All())
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%v\t%v\t%d\t%d\n",
+			sess.ID,
+			sess.GetName(),
+			sess.Hostname,
+			sess.IsConnected,
+			sess.IsRelaying,
+			routes,
+			redirectors,
+		)
+	}
+
+	return w.Flush()
+}
+
+func (c *Console) dump(out io.Writer) error {
+	sessions, err := c.sessService.GetAll()
+	if err != nil {
+		return err
+	}
+
+	operators, err := c.operService.AllOperators()
+	if err != nil {
+		return err
+	}
+
+	dump := state{Snapshot: snapshot.New(sessions)}
+	for _, oper := range operators {
+		dump.Operators = append(dump.Operators, operatorState{Name: oper.Name, IsAdmin: oper.IsAdmin, Role: oper.GetRole()})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(dump)
+}
+
+func (c *Console) rotateCerts(out io.Writer) error {
+	if err := c.certService.RotateServerCerts(); err != nil {
+		return err
+	}
+
+	slog.Warn("Server certificates rotated from the admin console")
+	fmt.Fprintln(out, "server certificates rotated, restart the server to start using them")
+
+	return nil
+}
+
+// enroll is how to get an operator back in when no admin can connect anymore, e.g. once their certificates expired
+func (c *Console) enroll(out io.Writer, args []string) error {
+	if len(args) != 3 {
+		return errors.New("expected a name, a role and the address of this server with a port")
+	}
+
+	enrollment, token, err := c.operService.NewEnrollment(args[0], args[1], args[2], "", 24*time.Hour, "admin console")
+	if err != nil {
+		return err
+	}
+
+	code, err := c.operService.EnrollmentCode(enrollment, token)
+	if err != nil {
+		return err
+	}
+
+	slog.Warn("Enrollment created from the admin console", slog.Any("name", enrollment.Name), slog.Any("role", enrollment.Role))
+	fmt.Fprintf(out, "%s can enroll until %s with:\n%s\n", enrollment.Name, enrollment.ExpiresAt.Format(time.DateTime), code)
+
+	return nil
+}
+
+// apply reconciles the server to a declaration file, or only prints the changes. A change that fails doesn't stop the
+// others, running it again retries what's left
+func (c *Console) apply(out io.Writer, args []string, commit bool) error {
+	if len(args) != 1 {
+		return errors.New("expected the path of a declaration file")
+	}
+
+	decl, err := declaration.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	changes, err := c.reconciler.Plan(decl)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "nothing to change")
+		return nil
+	}
+
+	if !commit {
+		for _, change := range changes {
+			fmt.Fprintln(out, change)
+		}
+		fmt.Fprintf(out, "%d changes, run apply to make them\n", len(changes))
+		return nil
+	}
+
+	slog.Warn("Applying declaration from the admin console", slog.Any("file", args[0]))
+
+	var failed int
+	for _, change := range changes {
+		if err := c.reconciler.Apply(change); err != nil {
+			failed++
+			fmt.Fprintf(out, "%s: %s\n", change, err)
+			events.Publish(events.ERROR, "admin console: could not apply %s from %s: %s", change, args[0], err)
+			continue
+		}
+
+		fmt.Fprintln(out, change)
+		events.Publish(events.OK, "admin console: applied %s from %s", change, args[0])
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d changes failed", failed, len(changes))
+	}
+
+	fmt.Fprintf(out, "%d changes applied\n", len(changes))
+	return nil
+}
+
+func (c *Console) shutdownServer(out io.Writer) error {
+	if err := c.sessService.Release(); err != nil {
+		return err
+	}
+
+	slog.Warn("Server shut down from the admin console")
+	fmt.Fprintln(out, "sessions released, shutting down")
+
+	c.shutdown()
+
+	return nil
+}
+
+// Connect attaches the terminal to the admin console of a running server
+func Connect(path string) error {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return fmt.Errorf("could not reach the admin console: %w", err)
+	}
+	defer conn.Close()
+
+	go func() {
+		io.Copy(conn, os.Stdin)
+		conn.(*net.UnixConn).CloseWrite()
+	}()
+
+	_, err = io.Copy(os.Stdout, conn)
+	return err
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"context"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/ha"
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+// standBy copies the state of the active server of the pair until it's gone. Nothing else runs meanwhile, neither
+// listeners nor the operator server, the server goes on as the active one once it returns
+func standBy(cfg *config.Config, key storage.KeySource, peer *ha.Peer) error {
+	store, err := openStorage(cfg.GetStorageDir(), key)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	return ha.Standby(context.Background(), store, peer, cfg.HAInterval, cfg.HAFailoverAfter)
+}
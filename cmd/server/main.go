@@ -1,21 +1,38 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/server/agents"
+	"github.com/ttpreport/ligolo-mp/v2/cmd/server/console"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/server/rpc"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
 	"github.com/ttpreport/ligolo-mp/v2/internal/asset"
+	"github.com/ttpreport/ligolo-mp/v2/internal/attachment"
+	"github.com/ttpreport/ligolo-mp/v2/internal/audit"
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
 	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
+	"github.com/ttpreport/ligolo-mp/v2/internal/declaration"
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/fips"
+	"github.com/ttpreport/ligolo-mp/v2/internal/flowlog"
+	"github.com/ttpreport/ligolo-mp/v2/internal/ha"
+	"github.com/ttpreport/ligolo-mp/v2/internal/listener"
+	"github.com/ttpreport/ligolo-mp/v2/internal/netstack/tunlink"
 	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
 	"github.com/ttpreport/ligolo-mp/v2/internal/session"
-	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
+	"github.com/ttpreport/ligolo-mp/v2/internal/wake"
 	"github.com/ttpreport/ligolo-mp/v2/pkg/logger"
 )
 
@@ -23,13 +40,70 @@ func main() {
 	var daemon = flag.Bool("daemon", false, "enable daemon mode")
 	var verbose = flag.Bool("v", false, "enable verbose mode")
 	var listenInterface = flag.String("agent-addr", "0.0.0.0:11601", "listening address")
+	var agentTransport = flag.String("agent-transport", transport.Default, fmt.Sprintf("Transports agents connect over, comma separated, each on -agent-addr or its own address after @, e.g. tls,quic,wss@0.0.0.0:443 (%s)", strings.Join(transport.Names(), ", ")))
+	var agentWSPath = flag.String("agent-ws-path", "", "Path the wss transport takes agents on, others get a 404 (any path by default)")
+	var agentDNSDomain = flag.String("agent-dns-domain", "", "Domain delegated to the server the dns transport answers queries for, e.g. t.example.com (required for dns)")
+	var agentProxyProtocol = flag.Bool("agent-proxy-protocol", false, "Expect a PROXY protocol header (v1 or v2) from redirectors such as haproxy or nginx in front of the -agent-transport listeners over TCP, connections without one are refused")
+	var muxKeepAlive = flag.Duration("mux-keepalive", 0, "How often sessions are pinged, 0 spaces pings with the latency of each link (30s to 2m)")
+	var muxWriteTimeout = flag.Duration("mux-write-timeout", 10*time.Second, "Drop a session when a ping or a write to the agent takes longer than this")
+	var muxMaxStreams = flag.Int("mux-max-streams", 0, "Max streams a session may have open at once, further streams opened by the agent, e.g. for forwarding redirectors, are refused (0 for no limit)")
+	var deadSessionWindow = flag.Duration("dead-session-window", 90*time.Second, "Drop a session as soon as nothing was heard from its agent for this long, pings go out often enough for live agents to answer in time (0 disables)")
 	var maxInflight = flag.Int("max-inflight", 4096, "max inflight TCP connections")
 	var maxConnectionHandler = flag.Int("max-connection", 1024, "per tunnel connection pool size")
 	var operatorAddr = flag.String("operator-addr", "0.0.0.0:58008", "Address for operators connections")
+	var operatorCertValidity = flag.Duration("operator-cert-validity", 365*24*time.Hour, "How long operator certificates are issued and renewed for")
+	var enrollAddr = flag.String("enroll-addr", "", "Let new operators redeem one-time enrollment codes over TLS on this address, e.g. 0.0.0.0:58009 (disabled if empty)")
 	var insecureAgents = flag.Bool("insecure-agents", false, "Disable certificate verification for agents (insecure!)")
+	var tcpSACK = flag.Bool("tcp-sack", true, "Enable TCP selective acknowledgements on the tunnel netstack")
+	var tcpWindowScaling = flag.Bool("tcp-window-scaling", true, "Enable TCP window scaling on the tunnel netstack")
+	var tcpAutoTuning = flag.Bool("tcp-autotune", true, "Enable TCP receive buffer auto-tuning on the tunnel netstack")
+	var manageRoutes = flag.Bool("manage-routes", true, "Program session routes into the OS routing table automatically")
+	var operatorRateLimit = flag.Float64("operator-rate-limit", 50, "Max requests per second per operator across all of their connections, 0 to disable")
+	var maxClockSkew = flag.Duration("max-clock-skew", 5*time.Minute, "Alert when an agent's clock differs from the server's by more than this")
+	var maxBuilds = flag.Int("max-builds", 2, "Max agent builds running at once, others are queued")
+	var conformance = flag.Bool("conformance", false, "Run protocol conformance checks against connecting agents instead of opening sessions")
+	var maxAttachmentSize = flag.Int64("max-attachment-size", 2*1024*1024, "Max size in bytes of a file attached to a session")
+	var attachmentQuota = flag.Int64("attachment-quota", 32*1024*1024, "Max total size in bytes of the files attached to a single session")
+	var goRoot = flag.String("goroot", "", "Build agents with the Go toolchain at this GOROOT instead of the embedded one")
+	var buildContainer = flag.String("build-container", "", "Build agents with the Go toolchain in this container image (docker or podman), e.g. golang:1.23")
+	var adminSocket = flag.String("admin-socket", "", "Path of the local admin console socket (default admin.sock in the server directory)")
+	var hooksFile = flag.String("hooks", "", "JSON file declaring post-build hooks (packers, signer scripts...) operators can pick (default hooks.json in the server directory)")
+	var profilesFile = flag.String("profiles", "", "YAML or JSON file declaring malleable transport profiles builds and listeners can use (default profiles.yaml in the server directory)")
+	var attachConsole = flag.Bool("console", false, "Attach to the admin console of a running server instead of starting one")
+	var strictFIPS = flag.Bool("fips", false, "Refuse to start unless built with a FIPS validated crypto module (GOEXPERIMENT=boringcrypto), only FIPS agents can be built")
+	var wakeDomain = flag.String("wake-domain", "", "Domain delegated to this server, sleeping agents resolve a name under it to learn they were woken up (disabled if empty)")
+	var wakeAddr = flag.String("wake-addr", "0.0.0.0:53", "UDP address of the wake DNS responder")
+	var wakeCheck = flag.Duration("wake-check", time.Minute, "How often sleeping agents check whether they were woken up")
+	var assetsQuota = flag.Int64("assets-quota", 10<<30, "Max size in bytes of the assets directory, build caches are evicted least recently used first above it (0 disables eviction)")
+	var assetsGC = flag.Duration("assets-gc", time.Hour, "How often build leftovers are removed and the assets quota is enforced (0 disables)")
+	var flowLog = flag.Bool("flow-log", true, "Write one JSON line per connection relayed through a tunnel, for evidence and SIEM ingestion")
+	var flowLogFile = flag.String("flow-log-file", "", "Path of the flow log (default flows.jsonl in the server directory)")
+	var flowLogSize = flag.Int64("flow-log-size", 100<<20, "Size in bytes the flow log is rotated at")
+	var flowLogKeep = flag.Int("flow-log-keep", 10, "Rotated flow logs to keep")
+	var anomalyAlerts = flag.Bool("anomaly-alerts", true, "Alert operators when a session moves far more data than usual or contacts destination ports it never did")
+	var anomalyWindow = flag.Duration("anomaly-window", time.Minute, "Period relayed traffic is summed over to be compared with the session's baseline")
+	var anomalySpike = flag.Float64("anomaly-spike", 10, "Alert when a session moves this many times its usual traffic in a period")
+	var anomalyMinBytes = flag.Int64("anomaly-min-bytes", 50<<20, "Never alert about periods moving less than this many bytes")
+	var egressAlert = flag.Bool("egress-alert", true, "Alert operators when an agent reconnects from a different public address")
+	var decoyPage = flag.String("decoy", "", "HTML file served to anything reaching the agent listener without an agent certificate, so it looks like a web server (disabled if empty, ignored with -insecure-agents)")
+	var decoyServer = flag.String("decoy-server", "nginx", "Server header of the decoy responses")
+	var crlAddr = flag.String("crl-addr", "", "Serve the CRL of the CA over HTTP on this address, e.g. 0.0.0.0:8080 (disabled if empty)")
+	var crlPath = flag.String("crl-path", "/ca.crl", "URL path the CRL is served at")
+	var storageKeyFile = flag.String("storage-key", "", "Encrypt the server state with the key in this file, created if missing, a store kept in the clear is encrypted on first boot")
+	var storagePassphrase = flag.Bool("storage-passphrase", false, "Encrypt the server state with a key derived from a passphrase, read from LIGOLO_STORAGE_PASSPHRASE or prompted for")
+	var haPeer = flag.String("ha-peer", "", "Operator address of the other server of a high-availability pair, e.g. 10.0.0.2:58008. The server stands by while the other one answers (disabled if empty)")
+	var haCredentials = flag.String("ha-credentials", "", "Admin operator file the servers of the pair reach each other with, the same on both (required with -ha-peer)")
+	var haPeerAgents = flag.String("ha-peer-agents", "", "Agent servers of the other server of the pair, comma separated, added to every build so agents fail over to it, e.g. 10.0.0.2:11601")
+	var haInterval = flag.Duration("ha-interval", 30*time.Second, "How often the standby copies the state of the active server")
+	var haFailoverAfter = flag.Duration("ha-failover-after", 2*time.Minute, "The standby takes over once the active server didn't answer for this long")
+	var simulate = flag.Bool("simulate", false, "Relay over in-memory links instead of TUN interfaces, nothing from the host goes through them and no routes are programmed (for testing without privileges)")
+	var pprofAddr = flag.String("pprof-addr", "", "Serve pprof and relay contention metrics on this address, e.g. 127.0.0.1:6060 (disabled if empty)")
 
 	flag.Parse()
 
+	transport.Register(transport.NewWebSocket(transport.WebSocketSettings{Path: *agentWSPath}))
+	transport.Register(transport.NewDNS(transport.DNSSettings{Domain: *agentDNSDomain}))
+
 	loggingOpts := &slog.HandlerOptions{}
 	if *verbose {
 		lvl := new(slog.LevelVar)
@@ -47,17 +121,111 @@ func main() {
 	logHandler := slog.New(slog.NewTextHandler(os.Stdout, loggingOpts))
 	slog.SetDefault(logHandler)
 
+	if *pprofAddr != "" {
+		startProfiling(*pprofAddr)
+	}
+
 	cfg := &config.Config{
-		Environment:          "server",
-		Verbose:              *verbose,
-		ListenInterface:      *listenInterface,
-		MaxInFlight:          *maxInflight,
-		MaxConnectionHandler: *maxConnectionHandler,
-		OperatorAddr:         *operatorAddr,
-		InsecureAgents:       *insecureAgents,
+		Environment:            "server",
+		Verbose:                *verbose,
+		ListenInterface:        *listenInterface,
+		AgentTransport:         *agentTransport,
+		AgentProxyProtocol:     *agentProxyProtocol,
+		MuxKeepAlive:           *muxKeepAlive,
+		MuxWriteTimeout:        *muxWriteTimeout,
+		MuxMaxStreams:          *muxMaxStreams,
+		DeadSessionWindow:      *deadSessionWindow,
+		MaxInFlight:            *maxInflight,
+		MaxConnectionHandler:   *maxConnectionHandler,
+		OperatorAddr:           *operatorAddr,
+		OperatorCertValidity:   *operatorCertValidity,
+		EnrollAddr:             *enrollAddr,
+		InsecureAgents:         *insecureAgents,
+		ManageRoutes:           *manageRoutes,
+		TCPSACK:                *tcpSACK,
+		TCPWindowScaling:       *tcpWindowScaling,
+		TCPAutoTuning:          *tcpAutoTuning,
+		MaxClockSkew:           *maxClockSkew,
+		OperatorRateLimit:      *operatorRateLimit,
+		MaxConcurrentBuilds:    *maxBuilds,
+		ConformanceMode:        *conformance,
+		MaxAttachmentSize:      *maxAttachmentSize,
+		SessionAttachmentQuota: *attachmentQuota,
+		GoRoot:                 *goRoot,
+		BuildContainer:         *buildContainer,
+		AdminSocket:            *adminSocket,
+		HooksFile:              *hooksFile,
+		ProfilesFile:           *profilesFile,
+		FIPS:                   *strictFIPS,
+		WakeDomain:             *wakeDomain,
+		WakeAddr:               *wakeAddr,
+		WakeCheck:              *wakeCheck,
+		AssetsQuota:            *assetsQuota,
+		AssetsGCInterval:       *assetsGC,
+		FlowLog:                *flowLog,
+		FlowLogFile:            *flowLogFile,
+		FlowLogMaxSize:         *flowLogSize,
+		FlowLogKeep:            *flowLogKeep,
+		EgressAlert:            *egressAlert,
+		AnomalyAlerts:          *anomalyAlerts,
+		AnomalyWindow:          *anomalyWindow,
+		AnomalySpike:           *anomalySpike,
+		AnomalyMinBytes:        *anomalyMinBytes,
+		DecoyPage:              *decoyPage,
+		DecoyServer:            *decoyServer,
+		CRLAddr:                *crlAddr,
+		CRLPath:                *crlPath,
+		HAPeer:                 *haPeer,
+		HACredentials:          *haCredentials,
+		HAPeerAgents:           *haPeerAgents,
+		HAInterval:             *haInterval,
+		HAFailoverAfter:        *haFailoverAfter,
+		Simulate:               *simulate,
+	}
+
+	if *attachConsole {
+		if err := console.Connect(cfg.GetAdminSocket()); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if cfg.FIPS && !fips.Enabled() {
+		fmt.Fprintln(os.Stderr, "FIPS mode requires a server built with GOEXPERIMENT=boringcrypto, see make server-fips")
+		os.Exit(1)
+	}
+	slog.Info("crypto module", slog.String("mode", fips.Mode()))
+
+	if cfg.Simulate {
+		slog.Warn("simulate mode, relays run over in-memory links: nothing from this host is routed through them")
+	} else if err := tunlink.Available(); err != nil {
+		cfg.TunError = err.Error()
+		slog.Warn("TUN interfaces are unavailable, relays are disabled: only redirectors, SOCKS5 services, files and commands work", slog.Any("error", err))
 	}
 
-	db, err := storage.New(cfg.GetStorageDir())
+	key, err := storageKey(*storageKeyFile, *storagePassphrase)
+	if err != nil {
+		panic(fmt.Sprintf("could not connect to storage: %v", err))
+	}
+
+	var peer *ha.Peer
+	if cfg.HAPeer != "" {
+		if cfg.HACredentials == "" {
+			panic("-ha-peer needs the admin operator file of the pair in -ha-credentials")
+		}
+
+		peer, err = ha.NewPeer(cfg.HACredentials, cfg.HAPeer)
+		if err != nil {
+			panic(fmt.Sprintf("could not load the credentials of the pair: %v", err))
+		}
+
+		if err := standBy(cfg, key, peer); err != nil {
+			panic(fmt.Sprintf("could not stand by: %v", err))
+		}
+	}
+
+	db, err := openStorage(cfg.GetStorageDir(), key)
 	if err != nil {
 		panic(fmt.Sprintf("could not connect to storage: %v", err))
 	}
@@ -88,11 +256,61 @@ func main() {
 		panic(err)
 	}
 
+	buildRepo, err := agentbuild.NewAgentBuildRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	artifactRepo, err := agentbuild.NewArtifactRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	profileRepo, err := profile.NewProfileRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	attachmentRepo, err := attachment.NewAttachmentRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	auditRepo, err := audit.NewAuditRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	engagementRepo, err := engagement.NewEngagementRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	listenerRepo, err := listener.NewListenerRepository(db)
+	if err != nil {
+		panic(err)
+	}
+
+	var flows *flowlog.Writer
+	if cfg.FlowLog {
+		flows, err = flowlog.NewWriter(cfg.GetFlowLogFile(), cfg.FlowLogMaxSize, cfg.FlowLogKeep)
+		if err != nil {
+			panic(fmt.Sprintf("could not open flow log: %v", err))
+		}
+		defer flows.Close()
+		slog.Info("flow log enabled", slog.String("path", cfg.GetFlowLogFile()))
+	}
+
 	crlService := crl.NewCRLService(crlRepo)
 	certService := certificate.NewCertificateService(certRepo, crlService)
-	sessService := session.NewSessionService(cfg, sessRepo)
+	engagementService := engagement.NewEngagementService(engagementRepo)
+	sessService := session.NewSessionService(cfg, sessRepo, engagementService, flows)
 	operService := operator.NewOperatorService(cfg, operRepo, certService)
-	assetService := asset.NewAssetsService(cfg, assetRepo)
+	assetService := asset.NewAssetsService(cfg, assetRepo, buildRepo, artifactRepo)
+	profileService := profile.NewProfileService(profileRepo)
+	attachmentService := attachment.NewAttachmentService(cfg, attachmentRepo)
+	auditService := audit.NewAuditService(auditRepo)
+	listenerService := listener.NewListenerService(listenerRepo)
 
 	if err := assetService.Init(); err != nil {
 		panic(err)
@@ -119,12 +337,80 @@ func main() {
 
 	quit := make(chan error)
 	go func() {
-		quit <- agents.Run(cfg, certService, sessService)
+		quit <- agents.Run(cfg, certService, sessService, assetService, listenerService)
 	}()
 	go func() {
-		quit <- rpc.Run(cfg, certService, sessService, operService, assetService)
+		quit <- rpc.Run(cfg, certService, sessService, operService, assetService, profileService, attachmentService, auditService, engagementService, listenerService, db)
 	}()
 
+	if peer != nil {
+		go ha.Watch(context.Background(), peer, cfg.HAInterval, func() {
+			events.Publish(events.ERROR, "both servers of the pair are active, %s serves operators too: stop one of them and start it again to stand by", peer.Addr())
+		})
+	}
+
+	reconciler := declaration.NewReconciler(engagementService, profileService, sessService)
+	adminConsole, err := console.New(cfg.GetAdminSocket(), certService, sessService, operService, reconciler, func() {
+		if *daemon {
+			quit <- nil
+		} else {
+			app.Stop()
+		}
+	})
+	if err != nil {
+		slog.Error("Could not start admin console", slog.Any("error", err))
+	} else {
+		defer adminConsole.Close()
+		go adminConsole.Serve()
+	}
+
+	if cfg.AssetsGCInterval > 0 {
+		go assetService.RunGC(context.Background(), cfg.AssetsGCInterval)
+	}
+
+	if cfg.CRLAddr != "" {
+		distributionPoint, err := crl.ListenDistributionPoint(cfg.CRLAddr, cfg.CRLPath, certService.CRL)
+		if err != nil {
+			slog.Error("Could not start CRL distribution point", slog.Any("error", err))
+		} else {
+			defer distributionPoint.Close()
+			go distributionPoint.Serve()
+		}
+	}
+
+	if cfg.EnrollAddr != "" {
+		enrollCert, err := certService.GetOperatorServerCert().ChainedKeyPair(certService.GetCA())
+		if err != nil {
+			panic(err)
+		}
+
+		enrollmentPoint, err := operator.ListenEnrollmentPoint(cfg.EnrollAddr, enrollCert, func(token string) (*operator.Operator, error) {
+			oper, err := operService.Enroll(token)
+			if err != nil {
+				return nil, err
+			}
+
+			events.Publish(events.OK, "%s enrolled as %s", oper.Name, oper.GetRole())
+			return oper, nil
+		})
+		if err != nil {
+			slog.Error("Could not start enrollment endpoint", slog.Any("error", err))
+		} else {
+			defer enrollmentPoint.Close()
+			go enrollmentPoint.Serve()
+		}
+	}
+
+	if cfg.WakeDomain != "" {
+		wakeResponder, err := wake.Listen(cfg.WakeAddr, cfg.WakeDomain, sessService.WakePending)
+		if err != nil {
+			slog.Error("Could not start wake DNS responder", slog.Any("error", err))
+		} else {
+			defer wakeResponder.Close()
+			go wakeResponder.Serve()
+		}
+	}
+
 	if *daemon {
 		<-quit
 	} else {
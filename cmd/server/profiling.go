@@ -0,0 +1,28 @@
+package main
+
+import (
+	_ "expvar"
+	"log/slog"
+	"net/http"
+	_ "net/http/pprof"
+	"runtime"
+)
+
+// Sampling rates are kept low so profiling can stay on in production
+const (
+	mutexProfileFraction = 100   // report 1 in 100 contention events
+	blockProfileRate     = 10000 // sample 1 blocking event per 10µs spent blocked
+)
+
+// startProfiling serves pprof and expvar (including netstack pool contention) on addr
+func startProfiling(addr string) {
+	runtime.SetMutexProfileFraction(mutexProfileFraction)
+	runtime.SetBlockProfileRate(blockProfileRate)
+
+	slog.Info("profiling endpoint enabled", slog.Any("addr", addr))
+	go func() {
+		if err := http.ListenAndServe(addr, nil); err != nil {
+			slog.Error("profiling endpoint failed", slog.Any("error", err))
+		}
+	}()
+}
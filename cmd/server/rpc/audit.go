@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/audit"
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactedFields hold keys and passwords, the audit log only tells whether they were set
+var redactedFields = map[protoreflect.Name]bool{
+	"Key":        true,
+	"Password":   true,
+	"Passphrase": true,
+}
+
+// unauditedMethods are left out of the audit log even though they need more than the read-only role, the standby
+// of a pair replicates the state every few seconds
+var unauditedMethods = map[string]bool{
+	pb.Ligolo_ReplicateServerState_FullMethodName: true,
+}
+
+// logAction records a call in the audit log, unless it only reads. Refused calls are recorded too
+func (s *ligoloServer) logAction(oper *operator.Operator, method string, req any, err error) {
+	if role, ok := methodRoles[method]; ok && role == operator.RoleReadOnly {
+		return
+	}
+	if unauditedMethods[method] && err == nil {
+		return
+	}
+
+	action := audit.NewAction(oper.Name, oper.GetRole(), path.Base(method), auditParams(req), err, s.engService.CurrentName())
+	if err := s.auditService.LogAction(action); err != nil {
+		slog.Error("could not log action", slog.String("method", method), slog.Any("error", err))
+	}
+}
+
+// auditParams renders a request as JSON for the audit log, empty if there's none
+func auditParams(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return ""
+	}
+
+	var params strings.Builder
+	encoder := json.NewEncoder(&params)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(auditMessage(msg.ProtoReflect())); err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(params.String())
+}
+
+func auditMessage(msg protoreflect.Message) map[string]any {
+	result := make(map[string]any)
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			list := value.List()
+			items := make([]any, list.Len())
+			for i := range items {
+				items[i] = auditValue(field, list.Get(i))
+			}
+			result[string(field.Name())] = items
+		case field.IsMap():
+			entries := make(map[string]any)
+			value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				entries[key.String()] = auditValue(field.MapValue(), value)
+				return true
+			})
+			result[string(field.Name())] = entries
+		default:
+			result[string(field.Name())] = auditValue(field, value)
+		}
+		return true
+	})
+
+	return result
+}
+
+func auditValue(field protoreflect.FieldDescriptor, value protoreflect.Value) any {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return auditMessage(value.Message())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("<%d bytes>", len(value.Bytes()))
+	case protoreflect.StringKind:
+		if redactedFields[field.Name()] {
+			return "<redacted>"
+		}
+	}
+
+	return value.Interface()
+}
+
+// auditedStream keeps the first request of a stream for the audit log, the one carrying the parameters
+type auditedStream struct {
+	grpc.ServerStream
+	request any
+}
+
+func (stream *auditedStream) RecvMsg(m any) error {
+	err := stream.ServerStream.RecvMsg(m)
+	if err == nil && stream.request == nil {
+		stream.request = m
+	}
+
+	return err
+}
+
+func (s *ligoloServer) GetAuditLog(ctx context.Context, in *pb.GetAuditLogReq) (*pb.GetAuditLogResp, error) {
+	slog.Debug("Received request to get audit log", slog.Any("in", in))
+
+	actions, err := s.auditService.Actions(audit.ProtoToActionFilter(in))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.GetAuditLogResp{}
+	for _, action := range actions {
+		result.Actions = append(result.Actions, action.Proto())
+	}
+
+	return result, nil
+}
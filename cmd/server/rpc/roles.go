@@ -0,0 +1,159 @@
+package rpc
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodRoles is the least role each call needs, calls missing here are refused to everyone
+var methodRoles = map[string]string{
+	// observing
+	pb.Ligolo_Join_FullMethodName:                 operator.RoleReadOnly,
+	pb.Ligolo_Replay_FullMethodName:               operator.RoleReadOnly,
+	pb.Ligolo_Subscribe_FullMethodName:            operator.RoleReadOnly,
+	pb.Ligolo_GetAuditLog_FullMethodName:          operator.RoleReadOnly,
+	pb.Ligolo_GetMetadata_FullMethodName:          operator.RoleReadOnly,
+	pb.Ligolo_GetSessions_FullMethodName:          operator.RoleReadOnly,
+	pb.Ligolo_GetRouteProfiles_FullMethodName:     operator.RoleReadOnly,
+	pb.Ligolo_GetAttachments_FullMethodName:       operator.RoleReadOnly,
+	pb.Ligolo_DownloadAttachment_FullMethodName:   operator.RoleReadOnly,
+	pb.Ligolo_GetEngagements_FullMethodName:       operator.RoleReadOnly,
+	pb.Ligolo_LookupAgentBuild_FullMethodName:     operator.RoleReadOnly,
+	pb.Ligolo_GetAgentBuilds_FullMethodName:       operator.RoleReadOnly,
+	pb.Ligolo_GetToolchain_FullMethodName:         operator.RoleReadOnly,
+	pb.Ligolo_GetAgentTemplates_FullMethodName:    operator.RoleReadOnly,
+	pb.Ligolo_GetSigningKeys_FullMethodName:       operator.RoleReadOnly,
+	pb.Ligolo_GetBuildHooks_FullMethodName:        operator.RoleReadOnly,
+	pb.Ligolo_GetTransportProfiles_FullMethodName: operator.RoleReadOnly,
+
+	// working sessions, routes and builds
+	pb.Ligolo_RenameSession_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_Annotate_FullMethodName:           operator.RoleOperator,
+	pb.Ligolo_KillSession_FullMethodName:        operator.RoleOperator,
+	pb.Ligolo_BurnAgent_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_StartRelay_FullMethodName:         operator.RoleOperator,
+	pb.Ligolo_StopRelay_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_SetSpoofSource_FullMethodName:     operator.RoleOperator,
+	pb.Ligolo_SetCompression_FullMethodName:     operator.RoleOperator,
+	pb.Ligolo_SetMirror_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_SetBeacon_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_SetWorkingHours_FullMethodName:    operator.RoleOperator,
+	pb.Ligolo_WakeSession_FullMethodName:        operator.RoleOperator,
+	pb.Ligolo_SetSocks_FullMethodName:           operator.RoleOperator,
+	pb.Ligolo_ListFiles_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_DownloadFile_FullMethodName:       operator.RoleOperator,
+	pb.Ligolo_UploadFile_FullMethodName:         operator.RoleOperator,
+	pb.Ligolo_Exec_FullMethodName:               operator.RoleOperator,
+	pb.Ligolo_Sweep_FullMethodName:              operator.RoleOperator,
+	pb.Ligolo_UpdateAgent_FullMethodName:        operator.RoleOperator,
+	pb.Ligolo_RotateAgentCert_FullMethodName:    operator.RoleOperator,
+	pb.Ligolo_RefreshNetwork_FullMethodName:     operator.RoleOperator,
+	pb.Ligolo_HandoffSession_FullMethodName:     operator.RoleOperator,
+	pb.Ligolo_AnswerHandoff_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_ClaimSession_FullMethodName:       operator.RoleOperator,
+	pb.Ligolo_Persist_FullMethodName:            operator.RoleOperator,
+	pb.Ligolo_SetBreakout_FullMethodName:        operator.RoleOperator,
+	pb.Ligolo_SetBandwidth_FullMethodName:       operator.RoleOperator,
+	pb.Ligolo_RefreshHostInfo_FullMethodName:    operator.RoleOperator,
+	pb.Ligolo_Diagnose_FullMethodName:           operator.RoleOperator,
+	pb.Ligolo_AddRoute_FullMethodName:           operator.RoleOperator,
+	pb.Ligolo_EditRoute_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_MoveRoute_FullMethodName:          operator.RoleOperator,
+	pb.Ligolo_DelRoute_FullMethodName:           operator.RoleOperator,
+	pb.Ligolo_AddRouteProfile_FullMethodName:    operator.RoleOperator,
+	pb.Ligolo_DelRouteProfile_FullMethodName:    operator.RoleOperator,
+	pb.Ligolo_ApplyRouteProfile_FullMethodName:  operator.RoleOperator,
+	pb.Ligolo_AddRedirector_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_DelRedirector_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_AddAttachment_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_DelAttachment_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_GenerateAgent_FullMethodName:      operator.RoleOperator,
+	pb.Ligolo_RenderAgent_FullMethodName:        operator.RoleOperator,
+	pb.Ligolo_CancelAgentBuild_FullMethodName:   operator.RoleOperator,
+	pb.Ligolo_DownloadAgentBuild_FullMethodName: operator.RoleOperator,
+	pb.Ligolo_RegenerateAgent_FullMethodName:    operator.RoleOperator,
+	pb.Ligolo_BuildRecipe_FullMethodName:        operator.RoleOperator,
+	pb.Ligolo_Traceroute_FullMethodName:         operator.RoleOperator,
+	pb.Ligolo_Throughput_FullMethodName:         operator.RoleOperator,
+
+	// administration
+	pb.Ligolo_GetCerts_FullMethodName:             operator.RoleAdmin,
+	pb.Ligolo_RegenCert_FullMethodName:            operator.RoleAdmin,
+	pb.Ligolo_GetRevokedCerts_FullMethodName:      operator.RoleAdmin,
+	pb.Ligolo_RevokeCert_FullMethodName:           operator.RoleAdmin,
+	pb.Ligolo_UnrevokeCert_FullMethodName:         operator.RoleAdmin,
+	pb.Ligolo_GetCRL_FullMethodName:               operator.RoleReadOnly,
+	pb.Ligolo_GetCAs_FullMethodName:               operator.RoleOperator,
+	pb.Ligolo_AddCA_FullMethodName:                operator.RoleAdmin,
+	pb.Ligolo_RevokeCA_FullMethodName:             operator.RoleAdmin,
+	pb.Ligolo_ExportServerState_FullMethodName:    operator.RoleAdmin,
+	pb.Ligolo_ImportServerState_FullMethodName:    operator.RoleAdmin,
+	pb.Ligolo_ReplicateServerState_FullMethodName: operator.RoleAdmin,
+	pb.Ligolo_GetOperators_FullMethodName:         operator.RoleAdmin,
+	pb.Ligolo_ExportOperator_FullMethodName:       operator.RoleAdmin,
+	pb.Ligolo_AddOperator_FullMethodName:          operator.RoleAdmin,
+	pb.Ligolo_DelOperator_FullMethodName:          operator.RoleAdmin,
+	pb.Ligolo_PromoteOperator_FullMethodName:      operator.RoleAdmin,
+	pb.Ligolo_DemoteOperator_FullMethodName:       operator.RoleAdmin,
+	pb.Ligolo_SetOperatorRole_FullMethodName:      operator.RoleAdmin,
+	pb.Ligolo_RenewOperatorCert_FullMethodName:    operator.RoleReadOnly,
+	pb.Ligolo_GetEnrollments_FullMethodName:       operator.RoleAdmin,
+	pb.Ligolo_AddEnrollment_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_DelEnrollment_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_AddEngagement_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_DelEngagement_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_ActivateEngagement_FullMethodName:   operator.RoleAdmin,
+	pb.Ligolo_GetListeners_FullMethodName:         operator.RoleAdmin,
+	pb.Ligolo_AddListener_FullMethodName:          operator.RoleAdmin,
+	pb.Ligolo_DelListener_FullMethodName:          operator.RoleAdmin,
+	pb.Ligolo_SetListenerEnabled_FullMethodName:   operator.RoleAdmin,
+	pb.Ligolo_UploadToolchain_FullMethodName:      operator.RoleAdmin,
+	pb.Ligolo_FetchToolchain_FullMethodName:       operator.RoleAdmin,
+	pb.Ligolo_RollbackToolchain_FullMethodName:    operator.RoleAdmin,
+	pb.Ligolo_AddAgentTemplate_FullMethodName:     operator.RoleAdmin,
+	pb.Ligolo_DelAgentTemplate_FullMethodName:     operator.RoleAdmin,
+	pb.Ligolo_AddSigningKey_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_DelSigningKey_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_GetAssetUsage_FullMethodName:        operator.RoleAdmin,
+	pb.Ligolo_CollectAssets_FullMethodName:        operator.RoleAdmin,
+}
+
+// authorize checks the role of the operator against the call, roles are read from storage with every call so that
+// changes apply right away
+func authorize(oper *operator.Operator, method string) error {
+	role, ok := methodRoles[method]
+	if !ok || !oper.Can(role) {
+		return status.Errorf(codes.PermissionDenied, "%s can't do that with the %s role", oper.Name, oper.GetRole())
+	}
+
+	return nil
+}
+
+func (s *ligoloServer) streamAuthInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	oper, err := s.operatorFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	if !s.allowRequest(oper.Name) {
+		return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	if err := authorize(oper, info.FullMethod); err != nil {
+		s.logAction(oper, info.FullMethod, nil, err)
+		return err
+	}
+
+	if err := s.engService.Admit(oper.Name, oper.IsAdmin); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	audited := &auditedStream{ServerStream: stream}
+	err = handler(srv, audited)
+	s.logAction(oper, info.FullMethod, audited.request, err)
+
+	return err
+}
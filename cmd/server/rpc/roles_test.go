@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+func TestMethodRoles(t *testing.T) {
+	var methods []string
+	for _, method := range pb.Ligolo_ServiceDesc.Methods {
+		methods = append(methods, method.MethodName)
+	}
+	for _, stream := range pb.Ligolo_ServiceDesc.Streams {
+		methods = append(methods, stream.StreamName)
+	}
+
+	for _, method := range methods {
+		if _, ok := methodRoles["/"+pb.Ligolo_ServiceDesc.ServiceName+"/"+method]; !ok {
+			t.Errorf("%s has no role", method)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	readOnly := &operator.Operator{Name: "bob", Role: operator.RoleReadOnly}
+	if err := authorize(readOnly, pb.Ligolo_GetSessions_FullMethodName); err != nil {
+		t.Errorf("read-only can't observe: %v", err)
+	}
+	if err := authorize(readOnly, pb.Ligolo_AddRoute_FullMethodName); err == nil {
+		t.Error("read-only can add routes")
+	}
+
+	// stored before roles, IsAdmin is all there is
+	legacy := &operator.Operator{Name: "alice"}
+	if err := authorize(legacy, pb.Ligolo_AddRoute_FullMethodName); err != nil {
+		t.Errorf("operator can't add routes: %v", err)
+	}
+	if err := authorize(legacy, pb.Ligolo_AddListener_FullMethodName); err == nil {
+		t.Error("operator can add listeners")
+	}
+
+	legacy.IsAdmin = true
+	if err := authorize(legacy, pb.Ligolo_AddListener_FullMethodName); err != nil {
+		t.Errorf("admin can't add listeners: %v", err)
+	}
+
+	if err := authorize(legacy, "/ligolo.Ligolo/Unknown"); err == nil {
+		t.Error("unknown call is allowed")
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+	"golang.org/x/term"
+)
+
+// storagePassphraseEnv lets a server started without a terminal, e.g. as a service, unlock its storage
+const storagePassphraseEnv = "LIGOLO_STORAGE_PASSPHRASE"
+
+// storageKey is the key the server state is encrypted with, nil if it's kept in the clear
+func storageKey(keyFile string, usePassphrase bool) (storage.KeySource, error) {
+	switch {
+	case keyFile != "" && usePassphrase:
+		return nil, errors.New("-storage-key and -storage-passphrase can't be used together")
+	case keyFile != "":
+		return storage.FileKey(keyFile), nil
+	case usePassphrase:
+		passphrase, err := readPassphrase()
+		if err != nil {
+			return nil, err
+		}
+		return storage.PassphraseKey(passphrase), nil
+	default:
+		return nil, nil
+	}
+}
+
+// openStorage opens the server state, encrypted when there's a key
+func openStorage(dir string, key storage.KeySource) (*storage.Store, error) {
+	if key == nil {
+		return storage.New(dir)
+	}
+
+	return storage.NewEncrypted(dir, key)
+}
+
+func readPassphrase() (string, error) {
+	if passphrase, ok := os.LookupEnv(storagePassphraseEnv); ok {
+		return passphrase, nil
+	}
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("no terminal to ask for the storage passphrase, set %s", storagePassphraseEnv)
+	}
+
+	fmt.Fprint(os.Stderr, "Storage passphrase: ")
+	passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+
+	return string(passphrase), nil
+}
@@ -0,0 +1,44 @@
+package agentbuild
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+// Artifact is a built agent kept on the server, Request is the generate request it came from without the
+// passphrase, so the agent can be rebuilt the same way
+type Artifact struct {
+	ID        string
+	Binary    []byte
+	Signature []byte
+	Request   []byte
+}
+
+type ArtifactRepository struct {
+	storage *storage.StoreInstance[Artifact]
+}
+
+var artifactTable = "agent_artifacts"
+
+func NewArtifactRepository(store *storage.Store) (*ArtifactRepository, error) {
+	storeInstance, err := storage.GetInstance[Artifact](store, artifactTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtifactRepository{
+		storage: storeInstance,
+	}, nil
+}
+
+func (repo *ArtifactRepository) GetOne(id string) *Artifact {
+	result, err := repo.storage.Get(id)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func (repo *ArtifactRepository) Save(artifact *Artifact) error {
+	return repo.storage.Set(artifact.ID, artifact)
+}
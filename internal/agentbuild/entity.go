@@ -0,0 +1,194 @@
+package agentbuild
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AgentBuild records how an agent was built, enough to reproduce the obfuscation and attribute a recovered binary
+type AgentBuild struct {
+	ID             string
+	Operator       string
+	Campaign       string
+	Engagement     string
+	Template       string
+	SigningKey     string
+	Hooks          []string // post-build hooks the agent went through, in order
+	GOOS           string
+	GOARCH         string
+	Format         string
+	Staged         bool
+	StageSha256    string // the agent fetched by the stager, Sha256 is the stager's
+	Exec           bool   // built with command execution
+	Persist        bool   // built with persistence installers
+	HostInfo       bool   // built with host information collection
+	Loader         bool   // comes with a script running it from memory
+	Override       bool   // servers and proxy can be replaced when the agent is launched
+	Profile        string // transport profile the agent wraps its connections with
+	FIPS           bool   // built against BoringCrypto
+	KeySource      string // what the embedded config is encrypted with, the key itself is not kept
+	Guardrails     Guardrails
+	Schedule       Schedule
+	Multiplexer    Multiplexer
+	Pinning        Pinning
+	Obfuscate      bool
+	GarbleSeed     string
+	GarbleTiny     bool
+	GarbleLiterals bool
+	Sha256         string
+	Stored         bool // the binary is kept on the server and can be downloaded again
+	Created        time.Time
+}
+
+func (build *AgentBuild) String() string {
+	obfuscation := "none"
+	if build.Obfuscate {
+		obfuscation = fmt.Sprintf("seed=%s tiny=%t literals=%t", build.GarbleSeed, build.GarbleTiny, build.GarbleLiterals)
+	}
+
+	signing := "none"
+	if build.SigningKey != "" {
+		signing = build.SigningKey
+	}
+
+	result := fmt.Sprintf("ID: %s\nBuilt: %s by %s\nCampaign: %s\nTemplate: %s\nTarget: %s/%s (%s)\nObfuscation: %s\nSigned with: %s\nSHA256: %s",
+		build.ID, build.Created.Format(time.RFC3339), build.Operator, build.Campaign, build.Template, build.GOOS, build.GOARCH, build.Format, obfuscation, signing, build.Sha256)
+
+	if build.Engagement != "" {
+		result += fmt.Sprintf("\nEngagement: %s", build.Engagement)
+	}
+
+	if build.Staged {
+		result += fmt.Sprintf("\nStage SHA256: %s", build.StageSha256)
+	}
+
+	if build.FIPS {
+		result += "\nCrypto: FIPS (boringcrypto)"
+	}
+
+	if build.Exec {
+		result += "\nCommand execution: built in"
+	}
+
+	if build.Persist {
+		result += "\nPersistence: built in"
+	}
+
+	if build.HostInfo {
+		result += "\nHost information: collected"
+	}
+
+	if build.Loader {
+		result += "\nMemory loader: generated"
+	}
+
+	if build.Override {
+		result += "\nServer override: allowed"
+	}
+
+	if build.Profile != "" {
+		result += fmt.Sprintf("\nTransport profile: %s", build.Profile)
+	}
+
+	if len(build.Hooks) > 0 {
+		result += fmt.Sprintf("\nHooks: %s", strings.Join(build.Hooks, ", "))
+	}
+
+	if build.KeySource != "" {
+		result += fmt.Sprintf("\nConfig: encrypted, keyed to %s", build.KeySource)
+	}
+
+	if !build.Guardrails.Empty() {
+		result += fmt.Sprintf("\nGuardrails: %s", build.Guardrails)
+	}
+
+	if !build.Schedule.Empty() {
+		result += fmt.Sprintf("\nSchedule: %s", build.Schedule)
+	}
+
+	if !build.Multiplexer.Empty() {
+		result += fmt.Sprintf("\nMultiplexer: %s", build.Multiplexer)
+	}
+
+	if !build.Pinning.Empty() {
+		result += fmt.Sprintf("\nServer pinning: %s", build.Pinning)
+	}
+
+	return result
+}
+
+func (build *AgentBuild) Proto() *pb.AgentBuild {
+	return &pb.AgentBuild{
+		ID:             build.ID,
+		Operator:       build.Operator,
+		Campaign:       build.Campaign,
+		Engagement:     build.Engagement,
+		Template:       build.Template,
+		SigningKey:     build.SigningKey,
+		GOOS:           build.GOOS,
+		GOARCH:         build.GOARCH,
+		Format:         build.Format,
+		Staged:         build.Staged,
+		StageSha256:    build.StageSha256,
+		Exec:           build.Exec,
+		Persist:        build.Persist,
+		HostInfo:       build.HostInfo,
+		Loader:         build.Loader,
+		Override:       build.Override,
+		Profile:        build.Profile,
+		FIPS:           build.FIPS,
+		KeySource:      build.KeySource,
+		Guardrails:     build.Guardrails.Proto(),
+		Schedule:       build.Schedule.Proto(),
+		Multiplexer:    build.Multiplexer.Proto(),
+		Pinning:        build.Pinning.Proto(),
+		Obfuscate:      build.Obfuscate,
+		GarbleSeed:     build.GarbleSeed,
+		GarbleTiny:     build.GarbleTiny,
+		GarbleLiterals: build.GarbleLiterals,
+		Hooks:          build.Hooks,
+		Sha256:         build.Sha256,
+		Stored:         build.Stored,
+		Created:        timestamppb.New(build.Created),
+	}
+}
+
+func ProtoToAgentBuild(p *pb.AgentBuild) *AgentBuild {
+	return &AgentBuild{
+		ID:             p.ID,
+		Operator:       p.Operator,
+		Campaign:       p.Campaign,
+		Engagement:     p.Engagement,
+		Template:       p.Template,
+		SigningKey:     p.SigningKey,
+		GOOS:           p.GOOS,
+		GOARCH:         p.GOARCH,
+		Format:         p.Format,
+		Staged:         p.Staged,
+		StageSha256:    p.StageSha256,
+		Exec:           p.Exec,
+		Persist:        p.Persist,
+		HostInfo:       p.HostInfo,
+		Loader:         p.Loader,
+		Override:       p.Override,
+		Profile:        p.Profile,
+		FIPS:           p.FIPS,
+		KeySource:      p.KeySource,
+		Guardrails:     ProtoToGuardrails(p.Guardrails),
+		Schedule:       ProtoToSchedule(p.Schedule),
+		Multiplexer:    ProtoToMultiplexer(p.Multiplexer),
+		Pinning:        ProtoToPinning(p.Pinning),
+		Obfuscate:      p.Obfuscate,
+		GarbleSeed:     p.GarbleSeed,
+		GarbleTiny:     p.GarbleTiny,
+		GarbleLiterals: p.GarbleLiterals,
+		Hooks:          p.Hooks,
+		Sha256:         p.Sha256,
+		Stored:         p.Stored,
+		Created:        p.Created.AsTime(),
+	}
+}
@@ -0,0 +1,103 @@
+package agentbuild
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// GuardrailDateLayout is what NotBefore and NotAfter are given in, both days are inclusive
+const GuardrailDateLayout = "2006-01-02"
+
+// Guardrails key an agent to its target environment, it silently exits on machines that don't match every one that is set
+type Guardrails struct {
+	Domain    string // DNS or NetBIOS domain, subdomains match too
+	Hostname  string // regular expression, case insensitive
+	Username  string // with or without the domain part
+	Locale    string // e.g. de-DE, or de for any region
+	NotBefore string
+	NotAfter  string
+}
+
+func (g Guardrails) Empty() bool {
+	return g == Guardrails{}
+}
+
+func (g Guardrails) Validate() error {
+	for _, field := range []string{g.Domain, g.Hostname, g.Username, g.Locale, g.NotBefore, g.NotAfter} {
+		if strings.Contains(field, "`") {
+			return fmt.Errorf("guardrails can't contain backticks")
+		}
+	}
+
+	if g.Hostname != "" {
+		if _, err := regexp.Compile(g.Hostname); err != nil {
+			return fmt.Errorf("%s is invalid hostname regex: %s", g.Hostname, err)
+		}
+	}
+
+	var notBefore, notAfter time.Time
+	var err error
+	if g.NotBefore != "" {
+		if notBefore, err = time.Parse(GuardrailDateLayout, g.NotBefore); err != nil {
+			return fmt.Errorf("%s is invalid date, expected YYYY-MM-DD", g.NotBefore)
+		}
+	}
+	if g.NotAfter != "" {
+		if notAfter, err = time.Parse(GuardrailDateLayout, g.NotAfter); err != nil {
+			return fmt.Errorf("%s is invalid date, expected YYYY-MM-DD", g.NotAfter)
+		}
+	}
+	if !notBefore.IsZero() && !notAfter.IsZero() && notAfter.Before(notBefore) {
+		return fmt.Errorf("guardrail dates end before they start")
+	}
+
+	return nil
+}
+
+func (g Guardrails) String() string {
+	var result []string
+	for _, field := range [][2]string{
+		{"domain", g.Domain},
+		{"hostname", g.Hostname},
+		{"username", g.Username},
+		{"locale", g.Locale},
+		{"not before", g.NotBefore},
+		{"not after", g.NotAfter},
+	} {
+		if field[1] != "" {
+			result = append(result, fmt.Sprintf("%s=%s", field[0], field[1]))
+		}
+	}
+
+	return strings.Join(result, ", ")
+}
+
+func (g Guardrails) Proto() *pb.Guardrails {
+	return &pb.Guardrails{
+		Domain:    g.Domain,
+		Hostname:  g.Hostname,
+		Username:  g.Username,
+		Locale:    g.Locale,
+		NotBefore: g.NotBefore,
+		NotAfter:  g.NotAfter,
+	}
+}
+
+func ProtoToGuardrails(p *pb.Guardrails) Guardrails {
+	if p == nil {
+		return Guardrails{}
+	}
+
+	return Guardrails{
+		Domain:    p.Domain,
+		Hostname:  p.Hostname,
+		Username:  p.Username,
+		Locale:    p.Locale,
+		NotBefore: p.NotBefore,
+		NotAfter:  p.NotAfter,
+	}
+}
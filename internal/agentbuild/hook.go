@@ -0,0 +1,28 @@
+package agentbuild
+
+import (
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// Hook is a post-build tool configured on the server (packer, signer script...), agents go through it before delivery
+type Hook struct {
+	Name        string
+	Description string
+	Targets     []string
+}
+
+func (hook *Hook) Proto() *pb.BuildHook {
+	return &pb.BuildHook{
+		Name:        hook.Name,
+		Description: hook.Description,
+		Targets:     hook.Targets,
+	}
+}
+
+func ProtoToHook(p *pb.BuildHook) *Hook {
+	return &Hook{
+		Name:        p.Name,
+		Description: p.Description,
+		Targets:     p.Targets,
+	}
+}
@@ -0,0 +1,90 @@
+package agentbuild
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+const maxMultiplexerStreams = 65535
+
+// Multiplexer tunes the stream multiplexer of the agent end of sessions, empty values keep the yamux defaults
+type Multiplexer struct {
+	KeepAlive    string // how often the agent pings the server, it reconnects when pings go unanswered
+	WriteTimeout string // how long a write or a ping may take before the agent gives the connection up
+	MaxStreams   uint32 // streams the server may have open at once, the agent refuses others, 0 for no limit
+}
+
+func (m Multiplexer) Empty() bool {
+	return m == Multiplexer{}
+}
+
+func (m Multiplexer) Validate() error {
+	if err := validateMultiplexerDuration("keepalive", m.KeepAlive, time.Second, 10*time.Minute); err != nil {
+		return err
+	}
+
+	if err := validateMultiplexerDuration("write timeout", m.WriteTimeout, time.Second, 5*time.Minute); err != nil {
+		return err
+	}
+
+	if m.MaxStreams > maxMultiplexerStreams {
+		return fmt.Errorf("max streams can't be over %d", maxMultiplexerStreams)
+	}
+
+	return nil
+}
+
+func validateMultiplexerDuration(name string, value string, lowest time.Duration, highest time.Duration) error {
+	if value == "" {
+		return nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %s", name, err)
+	}
+
+	if d < lowest || d > highest {
+		return fmt.Errorf("%s must be between %s and %s", name, lowest, highest)
+	}
+
+	return nil
+}
+
+func (m Multiplexer) String() string {
+	var result []string
+	if m.KeepAlive != "" {
+		result = append(result, fmt.Sprintf("keepalive=%s", m.KeepAlive))
+	}
+	if m.WriteTimeout != "" {
+		result = append(result, fmt.Sprintf("write-timeout=%s", m.WriteTimeout))
+	}
+	if m.MaxStreams > 0 {
+		result = append(result, fmt.Sprintf("max-streams=%d", m.MaxStreams))
+	}
+
+	return strings.Join(result, ", ")
+}
+
+func (m Multiplexer) Proto() *pb.Multiplexer {
+	return &pb.Multiplexer{
+		KeepAlive:    m.KeepAlive,
+		WriteTimeout: m.WriteTimeout,
+		MaxStreams:   m.MaxStreams,
+	}
+}
+
+func ProtoToMultiplexer(p *pb.Multiplexer) Multiplexer {
+	if p == nil {
+		return Multiplexer{}
+	}
+
+	return Multiplexer{
+		KeepAlive:    p.KeepAlive,
+		WriteTimeout: p.WriteTimeout,
+		MaxStreams:   p.MaxStreams,
+	}
+}
@@ -0,0 +1,86 @@
+package agentbuild
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+const (
+	PinningCA   = "ca"   // the server certificate has to be issued by the embedded CA
+	PinningSPKI = "spki" // the server certificate also has to carry one of the pinned keys
+)
+
+// Pinning is how the agent checks the server it connects to. With SPKI pinning a stolen CA key isn't enough to
+// impersonate the server, the pins are filled in by the server from the keys its listeners present
+type Pinning struct {
+	Mode string   // PinningCA if empty
+	Pins []string // base64 SHA-256 of the SubjectPublicKeyInfo of each accepted server key
+	CRL  string   `json:"-"` // PEM CRL of the CA at build time, the agent refuses server certificates it lists
+}
+
+func (p Pinning) Empty() bool {
+	return p.Mode == "" && len(p.Pins) == 0
+}
+
+// ValidatePinningMode checks the mode operators ask for, pins are only known to the server
+func ValidatePinningMode(mode string) error {
+	switch mode {
+	case "", PinningCA, PinningSPKI:
+		return nil
+	default:
+		return fmt.Errorf("invalid pinning mode '%s', must be '%s' or '%s'", mode, PinningCA, PinningSPKI)
+	}
+}
+
+func (p Pinning) Validate() error {
+	if err := ValidatePinningMode(p.Mode); err != nil {
+		return err
+	}
+
+	if p.Mode == PinningSPKI && len(p.Pins) == 0 {
+		return fmt.Errorf("no server key to pin")
+	}
+
+	if p.Mode != PinningSPKI && len(p.Pins) > 0 {
+		return fmt.Errorf("keys are only pinned in %s mode", PinningSPKI)
+	}
+
+	for _, pin := range p.Pins {
+		hash, err := base64.StdEncoding.DecodeString(pin)
+		if err != nil || len(hash) != sha256.Size {
+			return fmt.Errorf("invalid pin '%s', expected a base64 SHA-256", pin)
+		}
+	}
+
+	return nil
+}
+
+func (p Pinning) String() string {
+	if p.Mode != PinningSPKI {
+		return PinningCA
+	}
+
+	return fmt.Sprintf("%s (%s)", PinningSPKI, strings.Join(p.Pins, ", "))
+}
+
+func (p Pinning) Proto() *pb.Pinning {
+	return &pb.Pinning{
+		Mode: p.Mode,
+		Pins: p.Pins,
+	}
+}
+
+func ProtoToPinning(p *pb.Pinning) Pinning {
+	if p == nil {
+		return Pinning{}
+	}
+
+	return Pinning{
+		Mode: p.Mode,
+		Pins: p.Pins,
+	}
+}
@@ -0,0 +1,221 @@
+package agentbuild
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRecipeOutput names the agents of a recipe that doesn't say how
+const DefaultRecipeOutput = "{{.Name}}_{{.GOOS}}_{{.GOARCH}}{{.Ext}}"
+
+// Recipe describes a batch of agents sharing their settings across an OS/arch matrix. It is written in YAML, JSON
+// works as well being a subset of it
+type Recipe struct {
+	Name           string   `yaml:"name"`
+	Targets        []string `yaml:"targets"` // goos/arch, e.g. windows/amd64 or linux/armv7
+	Servers        string   `yaml:"servers"`
+	Proxy          string   `yaml:"proxy"`
+	IgnoreEnvProxy bool     `yaml:"ignore_env_proxy"`
+	UserAgent      string   `yaml:"user_agent"`
+	Profile        string   `yaml:"profile"` // transport profile, see the server profiles file
+	Obfuscate      bool     `yaml:"obfuscate"`
+	Format         string   `yaml:"format"`
+	Staged         bool     `yaml:"staged"`
+	Exec           bool     `yaml:"exec"`
+	Persist        bool     `yaml:"persist"`
+	HostInfo       bool     `yaml:"host_info"`
+	Loader         bool     `yaml:"loader"` // a script running each linux agent from memory is added next to it
+	Override       bool     `yaml:"server_override"`
+	Campaign       string   `yaml:"campaign"`
+	Template       string   `yaml:"template"`
+	SigningKey     string   `yaml:"signing_key"`
+	Hooks          []string `yaml:"hooks"`
+	Beacon         string   `yaml:"beacon"`
+	Jitter         uint8    `yaml:"jitter"`
+	WorkingHours   string   `yaml:"working_hours"`
+	MuxKeepAlive   string   `yaml:"mux_keepalive"`
+	MuxTimeout     string   `yaml:"mux_write_timeout"`
+	MuxMaxStreams  uint32   `yaml:"mux_max_streams"`
+	Pinning        string   `yaml:"pinning"`
+	CA             string   `yaml:"ca"` // intermediate CA issuing the agent certificates, the current engagement's if empty
+	KeySource      string   `yaml:"key_source"`
+	Output         string   `yaml:"output"` // text/template with Name, GOOS, GOARCH, Format and Ext
+}
+
+// RecipeTarget is one agent of a recipe
+type RecipeTarget struct {
+	GOOS     string
+	GOARCH   string
+	Filename string
+	Request  *pb.GenerateAgentReq
+}
+
+func ParseRecipe(data []byte) (*Recipe, error) {
+	recipe := &Recipe{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(recipe); err != nil {
+		return nil, fmt.Errorf("invalid recipe: %v", err)
+	}
+
+	if err := recipe.Validate(); err != nil {
+		return nil, err
+	}
+
+	return recipe, nil
+}
+
+func (recipe *Recipe) Validate() error {
+	if strings.TrimSpace(recipe.Name) == "" {
+		return fmt.Errorf("recipe name is empty")
+	}
+
+	if len(recipe.Targets) == 0 {
+		return fmt.Errorf("recipe has no targets")
+	}
+
+	if recipe.Servers == "" {
+		return fmt.Errorf("recipe has no servers")
+	}
+
+	if _, err := recipe.outputTemplate(); err != nil {
+		return fmt.Errorf("invalid output name: %v", err)
+	}
+
+	if err := (Schedule{Jitter: recipe.Jitter, WorkingHours: recipe.WorkingHours}).Validate(); err != nil {
+		return err
+	}
+
+	if err := recipe.multiplexer().Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidatePinningMode(recipe.Pinning); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, target := range recipe.Targets {
+		goos, arch, found := strings.Cut(target, "/")
+		if !found {
+			return fmt.Errorf("%s is invalid target, expected goos/arch", target)
+		}
+
+		if _, err := gogo.ParseTarget(goos, arch); err != nil {
+			return err
+		}
+
+		if seen[target] {
+			return fmt.Errorf("%s is listed twice", target)
+		}
+		seen[target] = true
+	}
+
+	return nil
+}
+
+func (recipe *Recipe) multiplexer() Multiplexer {
+	return Multiplexer{KeepAlive: recipe.MuxKeepAlive, WriteTimeout: recipe.MuxTimeout, MaxStreams: recipe.MuxMaxStreams}
+}
+
+func (recipe *Recipe) outputTemplate() (*template.Template, error) {
+	output := recipe.Output
+	if output == "" {
+		output = DefaultRecipeOutput
+	}
+
+	return template.New("output").Option("missingkey=error").Parse(output)
+}
+
+// Expand turns the recipe into one build request per target, key is only used by passphrase keyed recipes
+func (recipe *Recipe) Expand(key string) ([]RecipeTarget, error) {
+	output, err := recipe.outputTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []RecipeTarget
+	names := make(map[string]bool)
+	for _, target := range recipe.Targets {
+		goos, arch, _ := strings.Cut(target, "/")
+
+		var filename bytes.Buffer
+		if err := output.Execute(&filename, map[string]string{
+			"Name":   recipe.Name,
+			"GOOS":   goos,
+			"GOARCH": arch,
+			"Format": recipe.Format,
+			"Ext":    recipeExt(goos, recipe.Format),
+		}); err != nil {
+			return nil, fmt.Errorf("invalid output name: %v", err)
+		}
+
+		name := path.Base(path.Clean("/" + filename.String())) // archive entries stay flat
+		if name == "/" {
+			return nil, fmt.Errorf("output name of %s is empty", target)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("output name %s is used by more than one target", name)
+		}
+		names[name] = true
+
+		result = append(result, RecipeTarget{
+			GOOS:     goos,
+			GOARCH:   arch,
+			Filename: name,
+			Request: &pb.GenerateAgentReq{
+				Servers:        recipe.Servers,
+				GOOS:           goos,
+				GOARCH:         arch,
+				Obfuscate:      recipe.Obfuscate,
+				ProxyServer:    recipe.Proxy,
+				IgnoreEnvProxy: recipe.IgnoreEnvProxy,
+				UserAgent:      recipe.UserAgent,
+				Profile:        recipe.Profile,
+				Format:         recipe.Format,
+				Campaign:       recipe.Campaign,
+				Template:       recipe.Template,
+				SigningKey:     recipe.SigningKey,
+				Staged:         recipe.Staged,
+				Exec:           recipe.Exec,
+				Persist:        recipe.Persist,
+				HostInfo:       recipe.HostInfo,
+				Loader:         recipe.Loader,
+				Override:       recipe.Override,
+				Beacon:         recipe.Beacon,
+				Schedule:       Schedule{Jitter: recipe.Jitter, WorkingHours: recipe.WorkingHours}.Proto(),
+				Multiplexer:    recipe.multiplexer().Proto(),
+				Pinning:        Pinning{Mode: recipe.Pinning}.Proto(),
+				CA:             recipe.CA,
+				Hooks:          recipe.Hooks,
+				KeySource:      recipe.KeySource,
+				Key:            key,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+func recipeExt(goos string, format string) string {
+	switch {
+	case format == "dll":
+		return ".dll"
+	case format == "so":
+		return ".so"
+	case format == "shellcode":
+		return ".bin"
+	case goos == "windows":
+		return ".exe"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,52 @@
+package agentbuild
+
+import (
+	"testing"
+)
+
+func TestRecipe(t *testing.T) {
+	recipe, err := ParseRecipe([]byte(`
+name: acme
+servers: 203.0.113.7:443
+obfuscate: true
+targets:
+  - windows/amd64
+  - linux/armv7
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	targets, err := recipe.Expand("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(targets) != 2 || targets[0].Filename != "acme_windows_amd64.exe" || targets[1].Filename != "acme_linux_armv7" {
+		t.Fatalf("unexpected targets %+v", targets)
+	}
+
+	if !targets[1].Request.Obfuscate || targets[1].Request.GOARCH != "armv7" {
+		t.Fatalf("settings are not carried to the requests: %v", targets[1].Request)
+	}
+
+	// JSON is valid YAML
+	if _, err := ParseRecipe([]byte(`{"name": "acme", "servers": "203.0.113.7:443", "targets": ["darwin/arm64"]}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, source := range map[string]string{
+		"unknown field": "name: acme\nservers: a:1\ntargets: [linux/amd64]\nobfuscation: true",
+		"bad target":    "name: acme\nservers: a:1\ntargets: [linux/sparc]",
+		"no targets":    "name: acme\nservers: a:1",
+	} {
+		if _, err := ParseRecipe([]byte(source)); err == nil {
+			t.Errorf("%s should be rejected", name)
+		}
+	}
+
+	recipe.Output = "agent"
+	if _, err := recipe.Expand(""); err == nil {
+		t.Error("targets sharing an output name should be rejected")
+	}
+}
@@ -0,0 +1,62 @@
+package agentbuild
+
+import (
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type AgentBuildRepository struct {
+	storage *storage.StoreInstance[AgentBuild]
+}
+
+var table = "agent_builds"
+
+func NewAgentBuildRepository(store *storage.Store) (*AgentBuildRepository, error) {
+	storeInstance, err := storage.GetInstance[AgentBuild](store, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AgentBuildRepository{
+		storage: storeInstance,
+	}, nil
+}
+
+func (repo *AgentBuildRepository) GetOne(id string) *AgentBuild {
+	result, err := repo.storage.Get(id)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func (repo *AgentBuildRepository) GetAll() ([]*AgentBuild, error) {
+	return repo.storage.GetAll()
+}
+
+func (repo *AgentBuildRepository) Save(build *AgentBuild) error {
+	return repo.storage.Set(build.ID, build)
+}
+
+// Find returns builds whose ID, hashsum (stage included), campaign or operator matches the query
+func (repo *AgentBuildRepository) Find(query string) ([]*AgentBuild, error) {
+	builds, err := repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*AgentBuild
+	for _, build := range builds {
+		if build.ID == query ||
+			strings.EqualFold(build.Sha256, query) ||
+			(build.StageSha256 != "" && strings.EqualFold(build.StageSha256, query)) ||
+			strings.EqualFold(build.Campaign, query) ||
+			build.Operator == query {
+			result = append(result, build)
+		}
+	}
+
+	return result, nil
+}
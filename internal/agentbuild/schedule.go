@@ -0,0 +1,66 @@
+package agentbuild
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// Schedule is when an agent reaches out, on top of its beacon interval. Both can be changed at runtime, the agent
+// goes back to these when it restarts
+type Schedule struct {
+	Jitter       uint8  // percentage reconnect waits are randomly shortened by
+	WorkingHours string // windows the agent stays dormant in, in its local time, see protocol.WorkingHours
+}
+
+func (s Schedule) Empty() bool {
+	return s == Schedule{}
+}
+
+func (s Schedule) Validate() error {
+	if s.Jitter > 100 {
+		return fmt.Errorf("jitter is a percentage, it can't be over 100")
+	}
+
+	if strings.Contains(s.WorkingHours, "`") {
+		return fmt.Errorf("working hours can't contain backticks")
+	}
+
+	if _, err := protocol.ParseWorkingHours(s.WorkingHours); err != nil {
+		return fmt.Errorf("invalid working hours: %s", err)
+	}
+
+	return nil
+}
+
+func (s Schedule) String() string {
+	var result []string
+	if s.Jitter > 0 {
+		result = append(result, fmt.Sprintf("jitter=%d%%", s.Jitter))
+	}
+	if s.WorkingHours != "" {
+		result = append(result, fmt.Sprintf("dormant=%s", s.WorkingHours))
+	}
+
+	return strings.Join(result, ", ")
+}
+
+func (s Schedule) Proto() *pb.Schedule {
+	return &pb.Schedule{
+		Jitter:       uint32(s.Jitter),
+		WorkingHours: s.WorkingHours,
+	}
+}
+
+func ProtoToSchedule(p *pb.Schedule) Schedule {
+	if p == nil {
+		return Schedule{}
+	}
+
+	return Schedule{
+		Jitter:       uint8(min(p.Jitter, 100)),
+		WorkingHours: p.WorkingHours,
+	}
+}
@@ -0,0 +1,33 @@
+package agentbuild
+
+import (
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+const (
+	SigningAuthenticode = "authenticode" // PFX, embedded into Windows PE agents
+	SigningGPG          = "gpg"          // armored secret key, detached signature for ELF agents
+)
+
+// SigningKey describes stored signing material, the key itself never leaves the server
+type SigningKey struct {
+	Name   string
+	Type   string
+	Sha256 string
+}
+
+func (key *SigningKey) Proto() *pb.SigningKey {
+	return &pb.SigningKey{
+		Name:   key.Name,
+		Type:   key.Type,
+		Sha256: key.Sha256,
+	}
+}
+
+func ProtoToSigningKey(p *pb.SigningKey) *SigningKey {
+	return &SigningKey{
+		Name:   p.Name,
+		Type:   p.Type,
+		Sha256: p.Sha256,
+	}
+}
@@ -0,0 +1,31 @@
+package agentbuild
+
+import (
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// DefaultTemplate is the agent.go shipped with the server
+const DefaultTemplate = "default"
+
+// Template is an operator supplied replacement for the agent's agent.go
+type Template struct {
+	Name   string
+	Sha256 string
+	Size   int64
+}
+
+func (t *Template) Proto() *pb.AgentTemplate {
+	return &pb.AgentTemplate{
+		Name:   t.Name,
+		Sha256: t.Sha256,
+		Size:   t.Size,
+	}
+}
+
+func ProtoToTemplate(p *pb.AgentTemplate) *Template {
+	return &Template{
+		Name:   p.Name,
+		Sha256: p.Sha256,
+		Size:   p.Size,
+	}
+}
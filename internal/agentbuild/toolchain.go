@@ -0,0 +1,52 @@
+package agentbuild
+
+import (
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Where the Go toolchain agents are built with came from
+const (
+	ToolchainEmbedded = "embedded" // shipped with the server
+	ToolchainUploaded = "uploaded" // archive uploaded by an admin
+	ToolchainFetched  = "go.dev"   // release downloaded from go.dev
+	ToolchainExternal = "external" // -goroot or -build-container, can't be replaced at runtime
+)
+
+// Toolchain is the Go toolchain agents are built with
+type Toolchain struct {
+	Version     string
+	Origin      string
+	Installed   time.Time // zero for the embedded and external toolchains
+	CanRollback bool      // the previous toolchain is still around
+}
+
+func (t *Toolchain) Proto() *pb.Toolchain {
+	result := &pb.Toolchain{
+		Version:     t.Version,
+		Origin:      t.Origin,
+		CanRollback: t.CanRollback,
+	}
+
+	if !t.Installed.IsZero() {
+		result.Installed = timestamppb.New(t.Installed)
+	}
+
+	return result
+}
+
+func ProtoToToolchain(p *pb.Toolchain) *Toolchain {
+	result := &Toolchain{
+		Version:     p.Version,
+		Origin:      p.Origin,
+		CanRollback: p.CanRollback,
+	}
+
+	if p.Installed != nil {
+		result.Installed = p.Installed.AsTime()
+	}
+
+	return result
+}
@@ -0,0 +1,40 @@
+package agentbuild
+
+import (
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// Parts of the server's assets directory, accounted for separately
+const (
+	AssetToolchain   = "toolchain"    // the embedded Go toolchain
+	AssetBuildCache  = "build-cache"  // GOCACHE
+	AssetModuleCache = "module-cache" // GOMODCACHE
+	AssetStages      = "stages"       // agents waiting for their stager, never evicted
+	AssetScratch     = "scratch"      // build, hook and signing directories left behind
+)
+
+// AssetUsage is the disk space taken by one category of assets
+type AssetUsage struct {
+	Category  string
+	Bytes     int64
+	Files     int
+	Evictable bool // can be cleared, it only costs slower builds
+}
+
+func (usage *AssetUsage) Proto() *pb.AssetUsage {
+	return &pb.AssetUsage{
+		Category:  usage.Category,
+		Bytes:     usage.Bytes,
+		Files:     int64(usage.Files),
+		Evictable: usage.Evictable,
+	}
+}
+
+func ProtoToAssetUsage(p *pb.AssetUsage) *AssetUsage {
+	return &AssetUsage{
+		Category:  p.Category,
+		Bytes:     p.Bytes,
+		Files:     int(p.Files),
+		Evictable: p.Evictable,
+	}
+}
@@ -0,0 +1,57 @@
+package agentbuild
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// watermarkMagic precedes the build ID appended to every agent. Trailing data is ignored by loaders and
+// survives obfuscation, unlike anything embedded in the code
+var watermarkMagic = []byte("\x00LGMP-BUILD:")
+
+const maxWatermarkLen = 64
+
+// Watermark appends the build ID to the agent. The ID is NUL terminated, signing appends its own data after it
+func Watermark(binary []byte, id string) []byte {
+	marked := make([]byte, 0, len(binary)+len(watermarkMagic)+len(id)+1)
+	marked = append(marked, binary...)
+	marked = append(marked, watermarkMagic...)
+	marked = append(marked, id...)
+	return append(marked, 0)
+}
+
+// ReadWatermark returns the build ID of a recovered agent, if it still carries one
+func ReadWatermark(binary []byte) (string, bool) {
+	idx := bytes.LastIndex(binary, watermarkMagic)
+	if idx < 0 {
+		return "", false
+	}
+
+	// older watermarks end with the file
+	id := binary[idx+len(watermarkMagic):]
+	if end := bytes.IndexByte(id, 0); end >= 0 {
+		id = id[:end]
+	}
+	if len(id) == 0 || len(id) > maxWatermarkLen {
+		return "", false
+	}
+
+	for _, c := range id {
+		if c < '0' || c > 'z' {
+			return "", false
+		}
+	}
+
+	return string(id), true
+}
+
+// Identify returns what a recovered binary can be looked up by: its build ID, or its hashsum if the watermark is gone
+func Identify(binary []byte) string {
+	if id, ok := ReadWatermark(binary); ok {
+		return id
+	}
+
+	hashsum := sha256.Sum256(binary)
+	return hex.EncodeToString(hashsum[:])
+}
@@ -0,0 +1,42 @@
+package agentbuild
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWatermark(t *testing.T) {
+	binary := []byte("MZ\x90\x00 not really an agent")
+	marked := Watermark(binary, "cs1fgb2v0r9c73bn1q6g")
+
+	if !bytes.HasPrefix(marked, binary) {
+		t.Fatal("watermark must not alter the binary")
+	}
+
+	id, ok := ReadWatermark(marked)
+	if !ok || id != "cs1fgb2v0r9c73bn1q6g" {
+		t.Fatalf("expected the build ID back, got %q (%v)", id, ok)
+	}
+
+	if Identify(marked) != id {
+		t.Fatal("watermarked binaries should be identified by their build ID")
+	}
+
+	signed := append(marked, "\x48\x2a\x00\x00\x00\x02\x02\x00 signature"...)
+	if id, ok := ReadWatermark(signed); !ok || id != "cs1fgb2v0r9c73bn1q6g" {
+		t.Fatalf("data appended after the watermark should be ignored, got %q (%v)", id, ok)
+	}
+
+	legacy := bytes.Join([][]byte{binary, watermarkMagic, []byte("cs1fgb2v0r9c73bn1q6g")}, nil)
+	if id, ok := ReadWatermark(legacy); !ok || id != "cs1fgb2v0r9c73bn1q6g" {
+		t.Fatalf("unterminated watermarks should still be read, got %q (%v)", id, ok)
+	}
+
+	if _, ok := ReadWatermark(binary); ok {
+		t.Fatal("unmarked binary should have no watermark")
+	}
+
+	if len(Identify(binary)) != 64 {
+		t.Fatal("unmarked binaries should be identified by their hashsum")
+	}
+}
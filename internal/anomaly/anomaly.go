@@ -0,0 +1,169 @@
+// Package anomaly watches the flows relayed for a session and tells when they stop looking like what the session
+// usually does: far more data than its baseline, or destination ports it never contacted. It only keeps a few
+// counters per session, the flows themselves are not retained
+package anomaly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// learningWindows is how many windows with traffic make up the baseline before anything gets reported
+	learningWindows = 5
+	// portAlerts is how many new ports are reported one by one in a window, the others are summed up
+	portAlerts = 3
+	// baselineWeight is the weight of a window in the baseline once it's learned
+	baselineWeight = 0.2
+)
+
+type port struct {
+	protocol string
+	number   uint16
+}
+
+func (p port) String() string {
+	return fmt.Sprintf("%s/%d", p.protocol, p.number)
+}
+
+type Options struct {
+	Window   time.Duration // traffic is summed over it
+	Spike    float64       // a window moving this many times the baseline is reported
+	MinBytes int64         // windows moving less are never reported, however quiet the session usually is
+}
+
+// Detector keeps the baseline of a single session, flows are fed to it as they end
+type Detector struct {
+	mu   sync.Mutex
+	opts Options
+
+	windowStart time.Time
+	windowBytes int64
+	spiked      bool   // the current window was already reported
+	newPorts    []port // reported in the current window
+
+	baseline float64 // bytes per window with traffic
+	learned  int     // windows the baseline is made of
+	ports    map[port]bool
+}
+
+func NewDetector(opts Options) *Detector {
+	return &Detector{
+		opts:  opts,
+		ports: make(map[port]bool),
+	}
+}
+
+// Observe accounts for a flow that ended at the given time and returns what is unusual about it, if anything
+func (d *Detector) Observe(at time.Time, protocol string, dstPort uint16, bytes int64) []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.roll(at)
+	d.windowBytes += bytes
+
+	var alerts []string
+
+	key := port{protocol: protocol, number: dstPort}
+	if !d.ports[key] {
+		d.ports[key] = true
+
+		if d.learned >= learningWindows {
+			d.newPorts = append(d.newPorts, key)
+			switch {
+			case len(d.newPorts) <= portAlerts:
+				alerts = append(alerts, fmt.Sprintf("contacted %s for the first time", key))
+			case len(d.newPorts) == portAlerts+1:
+				alerts = append(alerts, fmt.Sprintf("is contacting many new ports (%s so far), others are not reported until %s", portRanges(d.newPorts), d.windowStart.Add(d.opts.Window).Format(time.TimeOnly)))
+			}
+		}
+	}
+
+	if !d.spiked && d.learned >= learningWindows && d.windowBytes >= d.opts.MinBytes && float64(d.windowBytes) >= d.baseline*d.opts.Spike {
+		d.spiked = true
+		alerts = append(alerts, fmt.Sprintf("moved %s in less than %s, about %.0f times its usual %s", humanBytes(d.windowBytes), d.opts.Window, float64(d.windowBytes)/d.baseline, humanBytes(int64(d.baseline))))
+	}
+
+	return alerts
+}
+
+// roll starts a new window once the current one is over, windows without traffic are left out of the baseline
+// so a session coming back from idle isn't taken for a spike
+func (d *Detector) roll(at time.Time) {
+	if !d.windowStart.IsZero() && at.Sub(d.windowStart) < d.opts.Window {
+		return
+	}
+
+	if d.windowBytes > 0 {
+		bytes := float64(d.windowBytes)
+		if d.learned >= learningWindows {
+			// a runaway window only moves the baseline so much, otherwise the next one wouldn't stand out
+			bytes = min(bytes, d.baseline*d.opts.Spike)
+			d.baseline += (bytes - d.baseline) * baselineWeight
+		} else {
+			d.baseline += (bytes - d.baseline) / float64(d.learned+1)
+		}
+		d.learned++
+	}
+
+	d.windowStart = at
+	d.windowBytes = 0
+	d.spiked = false
+	d.newPorts = nil
+}
+
+// portRanges sums up ports as ranges per protocol, e.g. tcp/1-1024,3389
+func portRanges(ports []port) string {
+	byProto := make(map[string][]int)
+	for _, p := range ports {
+		byProto[p.protocol] = append(byProto[p.protocol], int(p.number))
+	}
+
+	var protos []string
+	for proto := range byProto {
+		protos = append(protos, proto)
+	}
+	sort.Strings(protos)
+
+	var result []string
+	for _, proto := range protos {
+		ports := byProto[proto]
+		sort.Ints(ports)
+
+		var ranges []string
+		for i := 0; i < len(ports); {
+			j := i
+			for j+1 < len(ports) && ports[j+1] == ports[j]+1 {
+				j++
+			}
+			if i == j {
+				ranges = append(ranges, fmt.Sprint(ports[i]))
+			} else {
+				ranges = append(ranges, fmt.Sprintf("%d-%d", ports[i], ports[j]))
+			}
+			i = j + 1
+		}
+
+		result = append(result, fmt.Sprintf("%s/%s", proto, strings.Join(ranges, ",")))
+	}
+
+	return strings.Join(result, " ")
+}
+
+func humanBytes(v int64) string {
+	const unit = 1024
+	if v < unit {
+		return fmt.Sprintf("%d B", v)
+	}
+
+	div, exp := int64(unit), 0
+	for n := v / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(v)/float64(div), "KMGTPE"[exp])
+}
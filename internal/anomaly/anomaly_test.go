@@ -0,0 +1,71 @@
+package anomaly
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func learn(t *testing.T, d *Detector, start time.Time) time.Time {
+	at := start
+	for i := 0; i < learningWindows; i++ {
+		if alerts := d.Observe(at, "tcp", 445, 1<<20); alerts != nil {
+			t.Fatalf("alert while learning: %v", alerts)
+		}
+		at = at.Add(time.Minute)
+	}
+
+	return at
+}
+
+func TestSpike(t *testing.T) {
+	d := NewDetector(Options{Window: time.Minute, Spike: 10, MinBytes: 1 << 20})
+	at := learn(t, d, time.Unix(0, 0))
+
+	// hours of idle are not part of the baseline
+	at = at.Add(3 * time.Hour)
+	if alerts := d.Observe(at, "tcp", 445, 5<<20); alerts != nil {
+		t.Fatalf("usual traffic reported: %v", alerts)
+	}
+
+	alerts := d.Observe(at.Add(time.Second), "tcp", 445, 10<<20)
+	if len(alerts) != 1 || !strings.Contains(alerts[0], "15.0 MiB") {
+		t.Fatalf("spike not reported: %v", alerts)
+	}
+
+	if alerts := d.Observe(at.Add(2*time.Second), "tcp", 445, 10<<20); alerts != nil {
+		t.Fatalf("spike reported twice in a window: %v", alerts)
+	}
+}
+
+func TestMinBytes(t *testing.T) {
+	d := NewDetector(Options{Window: time.Minute, Spike: 10, MinBytes: 1 << 30})
+	at := learn(t, d, time.Unix(0, 0))
+
+	if alerts := d.Observe(at, "tcp", 445, 100<<20); alerts != nil {
+		t.Fatalf("window under the minimum reported: %v", alerts)
+	}
+}
+
+func TestNewPorts(t *testing.T) {
+	d := NewDetector(Options{Window: time.Minute, Spike: 10, MinBytes: 1 << 30})
+	at := learn(t, d, time.Unix(0, 0))
+
+	var alerts []string
+	for p := uint16(20); p < 30; p++ {
+		alerts = append(alerts, d.Observe(at, "tcp", p, 0)...)
+	}
+	if len(alerts) != portAlerts+1 {
+		t.Fatalf("expected %d alerts, got %v", portAlerts+1, alerts)
+	}
+	if !strings.Contains(alerts[0], "tcp/20") || !strings.Contains(alerts[portAlerts], "tcp/20-23") {
+		t.Fatalf("unexpected alerts: %v", alerts)
+	}
+
+	if alerts := d.Observe(at.Add(time.Hour), "tcp", 25, 0); alerts != nil {
+		t.Fatalf("known port reported: %v", alerts)
+	}
+	if alerts := d.Observe(at.Add(time.Hour), "udp", 25, 0); len(alerts) != 1 {
+		t.Fatalf("new protocol not reported: %v", alerts)
+	}
+}
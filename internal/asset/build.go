@@ -0,0 +1,148 @@
+package asset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rs/xid"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+var ErrBuildCancelled = errors.New("build cancelled")
+
+// BuildJob is an agent compilation waiting for, or holding, a build slot
+type BuildJob struct {
+	ID        string
+	Build     *agentbuild.AgentBuild // set once the build succeeded
+	Signature []byte                 // detached signature, for GPG signed builds
+	Loader    []byte                 // script running the agent from memory, for builds asking for it
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	progress chan string
+	result   []byte
+	err      error
+}
+
+// Progress streams status updates, it is closed once the job is over
+func (job *BuildJob) Progress() <-chan string {
+	return job.progress
+}
+
+// Result is only valid after Progress is closed
+func (job *BuildJob) Result() ([]byte, error) {
+	return job.result, job.err
+}
+
+func (job *BuildJob) Cancel() {
+	job.cancel()
+}
+
+// report never blocks, a slow client must not stall the build
+func (job *BuildJob) report(format string, args ...any) {
+	select {
+	case job.progress <- fmt.Sprintf(format, args...):
+	default:
+	}
+}
+
+// BuildQueue runs agent builds with a limited number running at once
+type BuildQueue struct {
+	slots chan struct{}
+	mutex sync.Mutex
+	jobs  map[string]*BuildJob
+}
+
+func NewBuildQueue(concurrency int) *BuildQueue {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &BuildQueue{
+		slots: make(chan struct{}, concurrency),
+		jobs:  make(map[string]*BuildJob),
+	}
+}
+
+// Submit queues a build, it starts as soon as a slot frees up
+func (q *BuildQueue) Submit(build func(job *BuildJob) ([]byte, error)) *BuildJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &BuildJob{
+		ID:       xid.New().String(),
+		ctx:      ctx,
+		cancel:   cancel,
+		progress: make(chan string, 16),
+	}
+
+	q.mutex.Lock()
+	q.jobs[job.ID] = job
+	q.mutex.Unlock()
+
+	go q.run(job, build)
+
+	return job
+}
+
+func (q *BuildQueue) run(job *BuildJob, build func(job *BuildJob) ([]byte, error)) {
+	defer func() {
+		q.mutex.Lock()
+		delete(q.jobs, job.ID)
+		q.mutex.Unlock()
+
+		job.cancel()
+		close(job.progress)
+	}()
+
+	job.report("queued as %s", job.ID)
+
+	select {
+	case q.slots <- struct{}{}:
+		defer func() { <-q.slots }()
+	case <-job.ctx.Done():
+		job.err = ErrBuildCancelled
+		return
+	}
+
+	job.result, job.err = build(job)
+	if job.ctx.Err() != nil {
+		job.result, job.err = nil, ErrBuildCancelled
+	}
+}
+
+// Cancel stops a queued or running build
+func (q *BuildQueue) Cancel(id string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	job, ok := q.jobs[id]
+	if !ok {
+		return fmt.Errorf("build %s not found", id)
+	}
+
+	job.Cancel()
+
+	return nil
+}
+
+// Exclusive runs f once every build slot is taken, queued builds wait until it returns
+func (q *BuildQueue) Exclusive(ctx context.Context, f func() error) error {
+	taken := 0
+	defer func() {
+		for ; taken > 0; taken-- {
+			<-q.slots
+		}
+	}()
+
+	for taken < cap(q.slots) {
+		select {
+		case q.slots <- struct{}{}:
+			taken++
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return f()
+}
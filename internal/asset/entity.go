@@ -3,12 +3,19 @@ package asset
 import (
 	"crypto/sha256"
 	"fmt"
+	"time"
 )
 
 type Asset struct {
 	Name    string
 	content []byte `json:"-"`
 	Hashsum [sha256.Size]byte
+	Source  []byte `json:",omitempty"` // persisted content, only set for small assets like agent templates
+
+	// toolchains installed over the embedded one
+	Version   string    `json:",omitempty"`
+	Origin    string    `json:",omitempty"`
+	Installed time.Time `json:",omitempty"`
 }
 
 func NewAsset(name string) *Asset {
@@ -26,6 +33,12 @@ func (a *Asset) SetContent(content []byte) {
 	a.Hashsum = sha256.Sum256(a.content)
 }
 
+// SetSource sets content that is kept in storage along with the asset
+func (a *Asset) SetSource(source []byte) {
+	a.Source = source
+	a.SetContent(source)
+}
+
 func (a *Asset) String() string {
 	return fmt.Sprintf("Name=%s", a.Name)
 }
@@ -0,0 +1,24 @@
+package asset
+
+import "fmt"
+
+// fipsExperiment builds agents against BoringCrypto, it needs cgo and is only available on these targets
+const fipsExperiment = "boringcrypto"
+
+var fipsTargets = map[string]bool{
+	"linux/amd64": true,
+	"linux/arm64": true,
+}
+
+func checkFIPS(goos string, goarch string, obfuscate bool) error {
+	if !fipsTargets[fmt.Sprintf("%s/%s", goos, goarch)] {
+		return fmt.Errorf("FIPS agents can only be built for linux/amd64 and linux/arm64")
+	}
+
+	// garble rewrites the crypto module, the result would no longer be the validated one
+	if obfuscate {
+		return fmt.Errorf("FIPS agents can't be obfuscated")
+	}
+
+	return nil
+}
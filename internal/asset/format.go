@@ -0,0 +1,135 @@
+package asset
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	FormatExecutable = "exe"
+	FormatService    = "service"
+	FormatDLL        = "dll"
+	FormatShared     = "so"
+	FormatShellcode  = "shellcode"
+)
+
+// C cross-compilers used for shared library builds, keyed by GOOS/GOARCH
+var sharedCompilers = map[string]string{
+	"windows/amd64": "x86_64-w64-mingw32-gcc",
+	"windows/386":   "i686-w64-mingw32-gcc",
+	"linux/amd64":   "x86_64-linux-gnu-gcc",
+	"linux/386":     "i686-linux-gnu-gcc",
+	"linux/arm64":   "aarch64-linux-gnu-gcc",
+	"linux/arm":     "arm-linux-gnueabihf-gcc",
+}
+
+// donut architecture values, keyed by GOARCH
+var donutArchs = map[string]string{
+	"386":   "1",
+	"amd64": "2",
+}
+
+type agentFormat struct {
+	goos      string // empty if any OS is fine
+	buildMode string
+	tags      []string
+	cgo       bool
+	filename  string
+	shellcode bool // convert the built executable to position-independent shellcode
+}
+
+var agentFormats = map[string]agentFormat{
+	FormatExecutable: {},
+	FormatService: {
+		goos:     "windows",
+		tags:     []string{"service"},
+		filename: "agent.exe",
+	},
+	FormatDLL: {
+		goos:      "windows",
+		buildMode: "c-shared",
+		tags:      []string{"shared"},
+		cgo:       true,
+		filename:  "agent.dll",
+	},
+	FormatShared: {
+		goos:      "linux",
+		buildMode: "c-shared",
+		tags:      []string{"shared"},
+		cgo:       true,
+		filename:  "agent.so",
+	},
+	FormatShellcode: {
+		goos:      "windows",
+		filename:  "agent.exe",
+		shellcode: true,
+	},
+}
+
+func getAgentFormat(format string, goos string) (agentFormat, error) {
+	if format == "" {
+		format = FormatExecutable
+	}
+
+	result, ok := agentFormats[format]
+	if !ok {
+		return agentFormat{}, fmt.Errorf("%s is not supported agent format", format)
+	}
+
+	if result.goos != "" && result.goos != goos {
+		return agentFormat{}, fmt.Errorf("%s format is only supported on %s agents", format, result.goos)
+	}
+
+	if result.filename == "" {
+		result.filename = "agent"
+		if goos == "windows" {
+			result.filename = "agent.exe"
+		}
+	}
+
+	return result, nil
+}
+
+// findCompiler looks up a C compiler able to target goos/goarch
+func findCompiler(goos string, goarch string) (string, error) {
+	candidates := []string{sharedCompilers[fmt.Sprintf("%s/%s", goos, goarch)]}
+	if goos == runtime.GOOS && goarch == runtime.GOARCH {
+		candidates = append(candidates, "gcc", "cc")
+	}
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+
+		if path, err := exec.LookPath(candidate); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("shared library builds for %s/%s need a C cross-compiler (%s) on the server", goos, goarch, candidates[0])
+}
+
+// convertToShellcode turns the PE at path into position-independent shellcode using donut
+func convertToShellcode(path string, goarch string) (string, error) {
+	arch, ok := donutArchs[goarch]
+	if !ok {
+		return "", fmt.Errorf("shellcode is not supported for %s agents", goarch)
+	}
+
+	donut, err := exec.LookPath("donut")
+	if err != nil {
+		return "", fmt.Errorf("shellcode builds need donut on the server: %s", err)
+	}
+
+	destination := filepath.Join(filepath.Dir(path), "agent.bin")
+	cmd := exec.Command(donut, "-i", path, "-o", destination, "-a", arch, "-f", "1")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("could not convert agent to shellcode: %s: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return destination, nil
+}
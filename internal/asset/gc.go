@@ -0,0 +1,337 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+)
+
+// cacheEntry is the unit caches are evicted in, a build cache file or a downloaded module version
+type cacheEntry struct {
+	paths   []string
+	bytes   int64
+	used    time.Time
+	modules bool
+}
+
+func dirUsage(path string) (int64, int, error) {
+	var bytes int64
+	var files int
+
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			bytes += info.Size()
+			files++
+		}
+
+		return nil
+	})
+
+	return bytes, files, err
+}
+
+// scratchDirs are directories builds create and remove when done, anything found while no build runs is a leftover
+func (assets *AssetService) scratchDirs() []string {
+	dir := assets.config.GetAssetsDir()
+
+	paths := []string{filepath.Join(dir, "agents")}
+	for _, pattern := range []string{"hook-*", "signing-*"} {
+		matches, _ := filepath.Glob(filepath.Join(dir, pattern))
+		paths = append(paths, matches...)
+	}
+
+	return paths
+}
+
+// DiskUsage reports the space taken by each category of assets
+func (assets *AssetService) DiskUsage() ([]*agentbuild.AssetUsage, error) {
+	dir := assets.config.GetAssetsDir()
+	goRoot := gogo.GetGoRootDir(dir)
+	buildCache := filepath.Join(goRoot, "cache")
+	moduleCache := filepath.Join(goRoot, "modcache")
+
+	var result []*agentbuild.AssetUsage
+	add := func(category string, evictable bool, paths ...string) error {
+		usage := &agentbuild.AssetUsage{Category: category, Evictable: evictable}
+		for _, path := range paths {
+			bytes, files, err := dirUsage(path)
+			if err != nil {
+				return err
+			}
+			usage.Bytes += bytes
+			usage.Files += files
+		}
+		result = append(result, usage)
+		return nil
+	}
+
+	if err := add(agentbuild.AssetBuildCache, true, buildCache); err != nil {
+		return nil, err
+	}
+	if err := add(agentbuild.AssetModuleCache, true, moduleCache); err != nil {
+		return nil, err
+	}
+	if err := add(agentbuild.AssetScratch, true, assets.scratchDirs()...); err != nil {
+		return nil, err
+	}
+	if err := add(agentbuild.AssetStages, false, filepath.Join(dir, "stages")); err != nil {
+		return nil, err
+	}
+
+	// the caches live inside GOROOT
+	if err := add(agentbuild.AssetToolchain, false, goRoot); err != nil {
+		return nil, err
+	}
+	toolchain := result[len(result)-1]
+	for _, cache := range result[:2] {
+		toolchain.Bytes -= cache.Bytes
+		toolchain.Files -= cache.Files
+	}
+
+	return result, nil
+}
+
+// CollectGarbage removes build leftovers and evicts the least recently used cache entries until the assets fit
+// in the quota. Given a category, everything evictable in it goes instead. Builds wait while it runs.
+func (assets *AssetService) CollectGarbage(ctx context.Context, category string) (int64, error) {
+	var freed int64
+
+	err := assets.builds.Exclusive(ctx, func() error {
+		var err error
+		switch category {
+		case "":
+			freed, err = assets.collect()
+		case agentbuild.AssetScratch:
+			freed, err = assets.removeScratch()
+		case agentbuild.AssetBuildCache, agentbuild.AssetModuleCache:
+			freed, err = assets.evict(category, 0)
+		default:
+			err = fmt.Errorf("%s can't be collected", category)
+		}
+		return err
+	})
+
+	return freed, err
+}
+
+func (assets *AssetService) collect() (int64, error) {
+	freed, err := assets.removeScratch()
+	if err != nil || assets.config.AssetsQuota <= 0 {
+		return freed, err
+	}
+
+	usage, err := assets.DiskUsage()
+	if err != nil {
+		return freed, err
+	}
+
+	var total int64
+	for _, u := range usage {
+		total += u.Bytes
+	}
+
+	if total <= assets.config.AssetsQuota {
+		return freed, nil
+	}
+
+	evicted, err := assets.evict("", total-assets.config.AssetsQuota)
+	return freed + evicted, err
+}
+
+func (assets *AssetService) removeScratch() (int64, error) {
+	var freed int64
+	for _, path := range assets.scratchDirs() {
+		bytes, _, err := dirUsage(path)
+		if err != nil {
+			return freed, err
+		}
+
+		if err := os.RemoveAll(path); err != nil {
+			return freed, err
+		}
+		freed += bytes
+	}
+
+	return freed, nil
+}
+
+// evict removes cache entries, least recently used first, until need bytes are freed. Build cache entries go
+// before modules as they are cheaper to get back. No need and no category clears every cache.
+func (assets *AssetService) evict(category string, need int64) (int64, error) {
+	entries, err := assets.cacheEntries(category)
+	if err != nil {
+		return 0, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].modules != entries[j].modules {
+			return !entries[i].modules
+		}
+		return entries[i].used.Before(entries[j].used)
+	})
+
+	var freed int64
+	for _, entry := range entries {
+		if need > 0 && freed >= need {
+			break
+		}
+
+		for _, path := range entry.paths {
+			if err := removeReadOnly(path); err != nil {
+				return freed, err
+			}
+		}
+		freed += entry.bytes
+	}
+
+	if freed > 0 {
+		slog.Info("evicted build caches", slog.Int64("bytes", freed))
+	}
+
+	return freed, nil
+}
+
+func (assets *AssetService) cacheEntries(category string) ([]*cacheEntry, error) {
+	goRoot := gogo.GetGoRootDir(assets.config.GetAssetsDir())
+	var entries []*cacheEntry
+
+	if category == "" || category == agentbuild.AssetBuildCache {
+		// GOCACHE keeps entries in 256 subdirectories and refreshes their mtime when they get used
+		buildCache := filepath.Join(goRoot, "cache")
+		subdirs, _ := filepath.Glob(filepath.Join(buildCache, "[0-9a-f][0-9a-f]"))
+		for _, subdir := range subdirs {
+			files, err := os.ReadDir(subdir)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, file := range files {
+				info, err := file.Info()
+				if err != nil || !info.Mode().IsRegular() {
+					continue
+				}
+
+				entries = append(entries, &cacheEntry{
+					paths: []string{filepath.Join(subdir, file.Name())},
+					bytes: info.Size(),
+					used:  info.ModTime(),
+				})
+			}
+		}
+	}
+
+	if category == "" || category == agentbuild.AssetModuleCache {
+		moduleCache := filepath.Join(goRoot, "modcache")
+		download := filepath.Join(moduleCache, "cache", "download")
+
+		// extracted modules are read-only <module>@<version> directories, the download cache keeps
+		// <module>/@v/<version>.{zip,mod,info,...} next to them
+		err := filepath.WalkDir(moduleCache, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			if !d.IsDir() || path == moduleCache {
+				return nil
+			}
+
+			if path == filepath.Join(moduleCache, "cache") {
+				return fs.SkipDir
+			}
+
+			module, version, found := strings.Cut(d.Name(), "@")
+			if !found {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			rel, err := filepath.Rel(moduleCache, filepath.Join(filepath.Dir(path), module))
+			if err != nil {
+				return err
+			}
+
+			downloads, _ := filepath.Glob(filepath.Join(download, rel, "@v", version+".*"))
+
+			entry := &cacheEntry{
+				paths:   append([]string{path}, downloads...),
+				used:    info.ModTime(),
+				modules: true,
+			}
+			for _, p := range entry.paths {
+				bytes, _, err := dirUsage(p)
+				if err != nil {
+					return err
+				}
+				entry.bytes += bytes
+			}
+			entries = append(entries, entry)
+
+			return fs.SkipDir
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// removeReadOnly deletes a path the Go module cache made read-only
+func removeReadOnly(path string) error {
+	filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() {
+			os.Chmod(p, 0700)
+		}
+		return nil
+	})
+
+	return os.RemoveAll(path)
+}
+
+// RunGC collects garbage right away and then on every interval, until ctx is done
+func (assets *AssetService) RunGC(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		freed, err := assets.CollectGarbage(ctx, "")
+		if err != nil && ctx.Err() == nil {
+			slog.Error("could not collect assets garbage", slog.Any("error", err))
+		} else if freed > 0 {
+			slog.Debug("collected assets garbage", slog.Int64("bytes", freed))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
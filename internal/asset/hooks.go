@@ -0,0 +1,209 @@
+package asset
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+const defaultHookTimeout = 2 * time.Minute
+
+// hookConfig is an entry of the hooks file, e.g.
+//
+//	[{"name": "upx", "description": "UPX, best compression", "command": ["upx", "--best", "-o", "{output}", "{input}"], "timeout": "1m", "targets": ["linux", "windows/amd64"]}]
+//
+// {input} is the agent and {output} where the transformed one goes. Tools working in place can leave {output} out
+type hookConfig struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Command     []string `json:"command"`
+	Timeout     string   `json:"timeout"`
+	Targets     []string `json:"targets"` // GOOS or GOOS/GOARCH, empty if any target is fine
+	timeout     time.Duration
+}
+
+func (hook *hookConfig) supports(goos string, goarch string) bool {
+	if len(hook.Targets) == 0 {
+		return true
+	}
+
+	return slices.Contains(hook.Targets, goos) || slices.Contains(hook.Targets, fmt.Sprintf("%s/%s", goos, goarch))
+}
+
+// loadHooks reads the hooks file on every call, so admins can edit it without restarting the server
+func (assets *AssetService) loadHooks() (map[string]*hookConfig, error) {
+	content, err := os.ReadFile(assets.config.GetHooksFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var hooks []*hookConfig
+	if err := json.Unmarshal(content, &hooks); err != nil {
+		return nil, fmt.Errorf("invalid hooks file: %w", err)
+	}
+
+	result := make(map[string]*hookConfig)
+	for _, hook := range hooks {
+		if !assetNameRe.MatchString(hook.Name) {
+			return nil, fmt.Errorf("invalid hooks file: hook name '%s' may only contain letters, digits, '-' and '_'", hook.Name)
+		}
+		if len(hook.Command) == 0 {
+			return nil, fmt.Errorf("invalid hooks file: hook '%s' has no command", hook.Name)
+		}
+
+		hook.timeout = defaultHookTimeout
+		if hook.Timeout != "" {
+			if hook.timeout, err = time.ParseDuration(hook.Timeout); err != nil || hook.timeout <= 0 {
+				return nil, fmt.Errorf("invalid hooks file: hook '%s' has invalid timeout", hook.Name)
+			}
+		}
+
+		result[hook.Name] = hook
+	}
+
+	return result, nil
+}
+
+// Hooks lists the post-build hooks operators can pick from
+func (assets *AssetService) Hooks() ([]*agentbuild.Hook, error) {
+	hooks, err := assets.loadHooks()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*agentbuild.Hook
+	for _, hook := range hooks {
+		result = append(result, &agentbuild.Hook{
+			Name:        hook.Name,
+			Description: hook.Description,
+			Targets:     hook.Targets,
+		})
+	}
+	slices.SortFunc(result, func(a, b *agentbuild.Hook) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result, nil
+}
+
+// checkHooks makes sure the chosen hooks exist and apply to the target, before anything gets compiled
+func (assets *AssetService) checkHooks(names []string, goos string, goarch string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	hooks, err := assets.loadHooks()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		hook, ok := hooks[name]
+		if !ok {
+			return fmt.Errorf("hook '%s' does not exist", name)
+		}
+
+		if !hook.supports(goos, goarch) {
+			return fmt.Errorf("hook '%s' does not support %s/%s agents", name, goos, goarch)
+		}
+	}
+
+	return nil
+}
+
+// runHooks passes the binary through the hooks in order, each one gets a fresh directory it can't escape through
+// its environment and is killed when it runs out of time
+func (assets *AssetService) runHooks(ctx context.Context, report func(format string, args ...any), names []string, binary []byte, filename string, env []string) ([]byte, error) {
+	hooks, err := assets.loadHooks()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		hook, ok := hooks[name]
+		if !ok {
+			return nil, fmt.Errorf("hook '%s' does not exist", name)
+		}
+
+		report("running hook %s", name)
+		if binary, err = assets.runHook(ctx, hook, binary, filename, env); err != nil {
+			return nil, fmt.Errorf("hook '%s' failed: %w", name, err)
+		}
+	}
+
+	return binary, nil
+}
+
+func (assets *AssetService) runHook(ctx context.Context, hook *hookConfig, binary []byte, filename string, env []string) ([]byte, error) {
+	workDir, err := os.MkdirTemp(assets.config.GetAssetsDir(), "hook-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	input := filepath.Join(workDir, filename)
+	output := filepath.Join(workDir, "out", filename)
+	if err := os.Mkdir(filepath.Dir(output), 0700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(input, binary, 0700); err != nil {
+		return nil, err
+	}
+
+	var args []string
+	for _, arg := range hook.Command {
+		arg = strings.ReplaceAll(arg, "{input}", input)
+		arg = strings.ReplaceAll(arg, "{output}", output)
+		args = append(args, arg)
+	}
+
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, hook.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path, args[1:]...)
+	cmd.Dir = workDir
+	cmd.Env = append([]string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + workDir,
+		"TMPDIR=" + workDir,
+	}, env...)
+	isolateHook(cmd)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timed out after %s", hook.timeout)
+		}
+		return nil, fmt.Errorf("%s: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	result, err := os.ReadFile(output)
+	if errors.Is(err, os.ErrNotExist) {
+		result, err = os.ReadFile(input)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("produced an empty binary")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+// +build !windows
+
+package asset
+
+import (
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// isolateHook runs the hook in its own process group, so whatever it spawns dies with it
+func isolateHook(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	cmd.WaitDelay = 5 * time.Second
+}
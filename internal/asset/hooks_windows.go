@@ -0,0 +1,13 @@
+//go:build windows
+// +build windows
+
+package asset
+
+import (
+	"os/exec"
+	"time"
+)
+
+func isolateHook(cmd *exec.Cmd) {
+	cmd.WaitDelay = 5 * time.Second
+}
@@ -0,0 +1,86 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"text/template"
+)
+
+// memfd_create syscall numbers for the perl fallback of the loader, keyed by GOARCH
+var memfdSyscalls = map[string]int{
+	"amd64":    319,
+	"386":      356,
+	"arm64":    279,
+	"arm":      385,
+	"riscv64":  279,
+	"loong64":  279,
+	"ppc64":    360,
+	"ppc64le":  360,
+	"s390x":    350,
+	"mips":     4354,
+	"mipsle":   4354,
+	"mips64":   5314,
+	"mips64le": 5314,
+}
+
+// memory loader script, the agent follows it base64 encoded and is piped into an anonymous memory file that gets
+// executed. python3 is preferred, perl calls memfd_create by number where it is known
+var loaderTemplate = template.Must(template.New("loader").Parse(`#!/bin/sh
+P='import os,sys
+f=os.memfd_create("",os.MFD_CLOEXEC)
+d=sys.stdin.buffer.read()
+while d:d=d[os.write(f,d):]
+os.execv("/proc/self/fd/%d"%f,[sys.argv[1]]+sys.argv[2:])'
+{{- if .Syscall}}
+L='$f=syscall({{.Syscall}},$n="",1);$f>=0 or die "memfd_create: $!";open(my $h,">&=",$f) or die "open: $!";binmode $h;select((select($h),$|=1)[0]);binmode STDIN;local $/;print $h <STDIN> or die "write: $!";exec {"/proc/self/fd/$f"} @ARGV or die "exec: $!"'
+{{- end}}
+run() {
+	if command -v python3 >/dev/null 2>&1 && python3 -c 'import os;os.memfd_create' 2>/dev/null; then
+		python3 -c "$P" "$@"
+{{- if .Syscall}}
+	elif command -v perl >/dev/null 2>&1; then
+		perl -e "$L" -- "$@"
+{{- end}}
+	else
+		echo "no way to run from memory: python 3.8+{{if .Syscall}} or perl{{end}} is needed" >&2
+		exit 1
+	fi
+}
+base64 -d <<'AGENT' | run "$0" "$@"
+{{.Agent}}
+AGENT
+`))
+
+func checkLoader(goos string, format string) error {
+	if goos != "linux" || (format != "" && format != FormatExecutable) {
+		return fmt.Errorf("memory loaders are only generated for linux executables")
+	}
+
+	return nil
+}
+
+// MemfdLoader wraps a linux agent in a shell script that runs it from memory, so it can be piped straight into sh
+// without the agent ever touching disk. Its arguments are passed on to the agent
+func MemfdLoader(goarch string, agent []byte) ([]byte, error) {
+	encoded := base64.StdEncoding.EncodeToString(agent)
+
+	var lines bytes.Buffer
+	for len(encoded) > 76 {
+		lines.WriteString(encoded[:76])
+		lines.WriteByte('\n')
+		encoded = encoded[76:]
+	}
+	lines.WriteString(encoded)
+
+	var script bytes.Buffer
+	err := loaderTemplate.Execute(&script, struct {
+		Syscall int
+		Agent   string
+	}{
+		Syscall: memfdSyscalls[goarch],
+		Agent:   lines.String(),
+	})
+
+	return script.Bytes(), err
+}
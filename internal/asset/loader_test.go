@@ -0,0 +1,48 @@
+package asset
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestMemfdLoader(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("memory loaders only run on linux")
+	}
+
+	echo, err := exec.LookPath("echo")
+	if err != nil {
+		t.Skip("no echo binary to load")
+	}
+
+	binary, err := os.ReadFile(echo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	script, err := MemfdLoader(runtime.GOARCH, binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("sh", "-s", "--", "from", "memory")
+	cmd.Stdin = strings.NewReader(string(script))
+	output, err := cmd.CombinedOutput()
+	if strings.Contains(string(output), "no way to run from memory") {
+		t.Skip("neither python 3.8+ nor perl is available")
+	}
+	if err != nil {
+		t.Fatalf("%v: %s", err, output)
+	}
+
+	if strings.TrimSpace(string(output)) != "from memory" {
+		t.Fatalf("unexpected output: %s", output)
+	}
+
+	if err := checkLoader("windows", FormatExecutable); err == nil {
+		t.Fatal("loaders for windows agents should be refused")
+	}
+}
@@ -0,0 +1,28 @@
+package asset
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/malleable"
+)
+
+// encodeProfile looks the transport profile up in the profiles file and encodes it for the agent template. The
+// profile is read again on every build, so agents get whatever it says at the time
+func (assets *AssetService) encodeProfile(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+
+	profile, err := malleable.Get(assets.config.GetProfilesFile(), name)
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(profile)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(encoded), nil
+}
@@ -0,0 +1,81 @@
+package asset
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+)
+
+// RenderAgent templates agent.go exactly as a build would and returns it without compiling, so templates and
+// parameters can be checked before waiting on garble. Settings are validated like for BuildAgent, problems is
+// what the lint found in the rendered source, which is returned either way
+func (assets *AssetService) RenderAgent(opts BuildOptions) ([]byte, string, error) {
+	if opts.Template == "" {
+		opts.Template = agentbuild.DefaultTemplate
+	}
+
+	if _, err := gogo.ParseTarget(opts.GOOS, opts.GOARCH); err != nil {
+		return nil, "", err
+	}
+
+	if _, err := getAgentFormat(opts.Format, opts.GOOS); err != nil {
+		return nil, "", err
+	}
+
+	if err := checkSealing(opts.KeySource, opts.Key, opts.Format); err != nil {
+		return nil, "", err
+	}
+
+	if _, err := assets.encodeProfile(opts.Profile); err != nil {
+		return nil, "", err
+	}
+
+	if err := checkRotation(opts.Rotation); err != nil {
+		return nil, "", err
+	}
+
+	plan, err := parseServers(withPeers(opts.Servers, assets.config.HAPeerAgents), opts.Retries, opts.Backoff)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := opts.Guardrails.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	if err := opts.Schedule.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	if err := opts.Multiplexer.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	if err := opts.Pinning.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	if err := assets.checkAgent(opts.GOOS, opts.ProxyServer, opts.UserAgent, plan.Servers, opts.Netns, opts.VRF, opts.Beacon); err != nil {
+		return nil, "", err
+	}
+
+	agentDir, err := assets.renderAgent(opts, plan)
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.RemoveAll(agentDir)
+
+	source, err := os.ReadFile(filepath.Join(agentDir, "src", "agent.go"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	var problems string
+	if err := lintAgentSource(source); err != nil {
+		problems = err.Error()
+	}
+
+	return source, problems, nil
+}
@@ -0,0 +1,117 @@
+package asset
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
+)
+
+// agents try servers in this order, see rotation.go in the agent
+var rotationStrategies = []string{"failover", "round-robin", "random"}
+
+// serverPlan is the server list split into what agents are templated with, one line per server in each
+type serverPlan struct {
+	Servers string
+	Retries string
+	Backoff string
+}
+
+// withPeers appends the agent servers of the other server of a pair, comma separated, to the servers of a build
+// that don't list them yet. They come last so that agents only fail over to them
+func withPeers(servers string, peers string) string {
+	listed := make(map[string]bool)
+	for _, line := range strings.Split(servers, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			listed[fields[0]] = true
+		}
+	}
+
+	for _, peer := range strings.Split(peers, ",") {
+		peer = strings.TrimSpace(peer)
+		if peer == "" || listed[peer] {
+			continue
+		}
+
+		servers = strings.TrimRight(servers, "\n") + "\n" + peer
+		listed[peer] = true
+	}
+
+	return servers
+}
+
+// parseServers reads one server per line. The build retries and backoff apply to every server unless the line
+// overrides them, e.g.
+//
+//	tls://1.3.3.7:11601 retries=3 backoff=30s
+func parseServers(servers string, retries int, backoff string) (serverPlan, error) {
+	if retries < 0 {
+		return serverPlan{}, fmt.Errorf("retries can't be negative")
+	}
+	if retries == 0 {
+		retries = 1
+	}
+
+	if backoff != "" {
+		if d, err := time.ParseDuration(backoff); err != nil || d <= 0 {
+			return serverPlan{}, fmt.Errorf("%s is invalid backoff", backoff)
+		}
+	}
+
+	var addresses, retryLines, backoffLines []string
+	for _, line := range strings.Split(servers, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		server := fields[0]
+		if _, _, err := transport.Parse(server); err != nil {
+			return serverPlan{}, fmt.Errorf("%s is invalid server: %s", server, err)
+		}
+
+		serverRetries, serverBackoff := strconv.Itoa(retries), backoff
+		for _, option := range fields[1:] {
+			name, value, _ := strings.Cut(option, "=")
+			switch name {
+			case "retries":
+				if n, err := strconv.Atoi(value); err != nil || n <= 0 {
+					return serverPlan{}, fmt.Errorf("%s: %s is invalid retries", server, value)
+				}
+				serverRetries = value
+			case "backoff":
+				if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+					return serverPlan{}, fmt.Errorf("%s: %s is invalid backoff", server, value)
+				}
+				serverBackoff = value
+			default:
+				return serverPlan{}, fmt.Errorf("%s: unknown option %s", server, option)
+			}
+		}
+
+		addresses = append(addresses, server)
+		retryLines = append(retryLines, serverRetries)
+		backoffLines = append(backoffLines, serverBackoff)
+	}
+
+	if len(addresses) == 0 {
+		return serverPlan{}, fmt.Errorf("no servers")
+	}
+
+	return serverPlan{
+		Servers: strings.Join(addresses, "\n"),
+		Retries: strings.Join(retryLines, "\n"),
+		Backoff: strings.Join(backoffLines, "\n"),
+	}, nil
+}
+
+func checkRotation(rotation string) error {
+	if rotation != "" && !slices.Contains(rotationStrategies, rotation) {
+		return fmt.Errorf("%s is not a supported rotation strategy", rotation)
+	}
+
+	return nil
+}
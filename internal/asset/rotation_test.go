@@ -0,0 +1,36 @@
+package asset
+
+import "testing"
+
+func TestParseServers(t *testing.T) {
+	plan, err := parseServers("1.3.3.7:11601\n\n7.3.3.1:1234 retries=3 backoff=30s\n", 2, "5s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plan.Servers != "1.3.3.7:11601\n7.3.3.1:1234" || plan.Retries != "2\n3" || plan.Backoff != "5s\n30s" {
+		t.Fatalf("unexpected plan %+v", plan)
+	}
+
+	for _, servers := range []string{
+		"1.3.3.7:11601 retries=0",
+		"1.3.3.7:11601 backoff=soon",
+		"1.3.3.7:11601 jitter=5s",
+		"",
+	} {
+		if _, err := parseServers(servers, 1, ""); err == nil {
+			t.Errorf("%q should be rejected", servers)
+		}
+	}
+}
+
+func TestWithPeers(t *testing.T) {
+	servers := withPeers("1.3.3.7:11601\n7.3.3.1:1234 retries=3\n", "7.3.3.1:1234, 10.0.0.2:11601,")
+	if servers != "1.3.3.7:11601\n7.3.3.1:1234 retries=3\n10.0.0.2:11601" {
+		t.Fatalf("unexpected servers %q", servers)
+	}
+
+	if servers := withPeers("1.3.3.7:11601", ""); servers != "1.3.3.7:11601" {
+		t.Fatalf("servers changed without peers: %q", servers)
+	}
+}
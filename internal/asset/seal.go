@@ -0,0 +1,39 @@
+package asset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/seal"
+)
+
+// checkSealing validates the config encryption settings. Passphrases are given with -key, only formats taking
+// arguments can use them
+func checkSealing(keySource string, key string, format string) error {
+	switch keySource {
+	case "":
+		return nil
+	case seal.KeyPassphrase:
+		if format != "" && format != FormatExecutable && format != FormatService {
+			return fmt.Errorf("passphrase keyed agents must be executables or services, use another key source")
+		}
+	case seal.KeyHostname:
+	default:
+		return fmt.Errorf("%s is not a supported key source", keySource)
+	}
+
+	if seal.Secret(keySource, key) == "" {
+		return fmt.Errorf("config encryption needs a key")
+	}
+
+	return nil
+}
+
+// sealConfig encrypts the settings that give the C2 away, in the order agents unseal them
+func sealConfig(keySource string, key string, proxyServer string, servers string, CACert string, AgentCert string, AgentKey string, pins []string) (string, error) {
+	if keySource == "" {
+		return "", nil
+	}
+
+	return seal.Seal([]string{proxyServer, servers, CACert, AgentCert, AgentKey, strings.Join(pins, "\n")}, seal.Secret(keySource, key))
+}
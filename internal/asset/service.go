@@ -3,33 +3,45 @@ package asset
 import (
 	"archive/zip"
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log/slog"
-	"net"
 	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/rs/xid"
 	"github.com/ttpreport/ligolo-mp/v2/artifacts"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
 	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
+	"github.com/ttpreport/ligolo-mp/v2/internal/winres"
 )
 
 type AssetService struct {
 	repo                  *AssetRepository
+	buildRepo             *agentbuild.AgentBuildRepository
+	artifactRepo          *agentbuild.ArtifactRepository
 	config                *config.Config
 	supportedProxySchemes []string
+	builds                *BuildQueue
 }
 
-func NewAssetsService(cfg *config.Config, repo *AssetRepository) *AssetService {
+func NewAssetsService(cfg *config.Config, repo *AssetRepository, buildRepo *agentbuild.AgentBuildRepository, artifactRepo *agentbuild.ArtifactRepository) *AssetService {
 	return &AssetService{
-		config: cfg,
-		repo:   repo,
+		config:       cfg,
+		repo:         repo,
+		buildRepo:    buildRepo,
+		artifactRepo: artifactRepo,
+		builds:       NewBuildQueue(cfg.MaxConcurrentBuilds),
 		supportedProxySchemes: []string{
 			"socks5",
 			"socks5h",
@@ -40,6 +52,24 @@ func NewAssetsService(cfg *config.Config, repo *AssetRepository) *AssetService {
 }
 
 func (assets *AssetService) Init() error {
+	if assets.config.GoRoot != "" || assets.config.BuildContainer != "" {
+		return assets.checkExternalGo()
+	}
+
+	// a toolchain installed at runtime wins over the embedded one until it gets rolled back
+	if updated := assets.repo.GetOne(toolchainAsset); updated != nil {
+		if _, err := os.Stat(filepath.Join(gogo.GetGoRootDir(assets.config.GetAssetsDir()), "bin", "go")); err == nil {
+			slog.Info("using updated go toolchain", slog.String("version", updated.Version), slog.String("origin", updated.Origin))
+			return nil
+		}
+
+		slog.Warn("updated go toolchain is gone, going back to the embedded one", slog.String("version", updated.Version))
+		assets.repo.Remove(toolchainAsset)
+		assets.repo.Remove(previousToolchainAsset)
+		assets.repo.Remove("go") // forces the embedded one to be unpacked again
+		os.RemoveAll(assets.previousGoRoot())
+	}
+
 	currentGo := assets.repo.GetOne("go")
 	distGo := assets.GetDistGo()
 
@@ -58,6 +88,37 @@ func (assets *AssetService) Init() error {
 	return nil
 }
 
+// checkExternalGo validates an operator provided toolchain, the embedded one is left alone so switching back is instant
+func (assets *AssetService) checkExternalGo() error {
+	if assets.config.GoRoot != "" && assets.config.BuildContainer != "" {
+		return fmt.Errorf("a GOROOT and a build container are mutually exclusive")
+	}
+
+	version, err := gogo.CheckVersion(assets.toolchain())
+	if err != nil {
+		return err
+	}
+
+	slog.Info("using external go toolchain", slog.String("version", version), slog.String("goroot", assets.config.GoRoot), slog.String("container", assets.config.BuildContainer))
+
+	return nil
+}
+
+// toolchain is the part of the build config that depends on which Go is used
+func (assets *AssetService) toolchain() gogo.GoConfig {
+	goRoot := assets.config.GoRoot
+	if goRoot == "" {
+		goRoot = gogo.GetGoRootDir(assets.config.GetAssetsDir())
+	}
+
+	return gogo.GoConfig{
+		GOROOT:     goRoot,
+		Container:  assets.config.BuildContainer,
+		GOCACHE:    gogo.GetGoCache(assets.config.GetAssetsDir()),
+		GOMODCACHE: gogo.GetGoModCache(assets.config.GetAssetsDir()),
+	}
+}
+
 func (assets *AssetService) GetDistGo() *Asset {
 	distGo, err := artifacts.GetGoArchive()
 	if err != nil {
@@ -89,44 +150,113 @@ func (assets *AssetService) UnpackDistGo() error {
 	return nil
 }
 
-func (assets *AssetService) renderAgent(proxyServer string, servers string, CACert string, AgentCert string, AgentKey string, IgnoreEnvProxy bool) (string, error) {
+// unpackAgent extracts the agent sources into a fresh build directory
+func (assets *AssetService) unpackAgent() (string, error) {
 	agentDir, err := assets.setupAgentDir()
 	if err != nil {
 		return "", err
 	}
 
-	srcDir := filepath.Join(agentDir, "src")
-
 	a, err := artifacts.GetAgentArchive()
 	if err != nil {
 		return "", err
 	}
 
-	_, err = unzipBuf(a, srcDir)
+	if _, err = unzipBuf(a, filepath.Join(agentDir, "src")); err != nil {
+		return "", err
+	}
+
+	return agentDir, nil
+}
+
+func (assets *AssetService) renderAgent(opts BuildOptions, plan serverPlan) (string, error) {
+	agentDir, err := assets.unpackAgent()
 	if err != nil {
 		return "", err
 	}
 
-	t := template.New("agent.go")
+	srcDir := filepath.Join(agentDir, "src")
 
 	agentFile := filepath.Join(srcDir, "agent.go")
-	t.ParseFiles(agentFile)
+	source, err := assets.agentTemplateSource(opts.Template)
+	if err != nil {
+		return "", err
+	}
+	if source == nil {
+		if source, err = os.ReadFile(agentFile); err != nil {
+			return "", err
+		}
+	}
+
+	sealed, err := sealConfig(opts.KeySource, opts.Key, opts.ProxyServer, plan.Servers, opts.CACert, opts.AgentCert, opts.AgentKey, opts.Pinning.Pins)
+	if err != nil {
+		return "", err
+	}
+
+	// an older template would get empty settings and an agent that never connects
+	if sealed != "" && !bytes.Contains(source, []byte(".Sealed")) {
+		return "", fmt.Errorf("template %s does not support config encryption", opts.Template)
+	}
+
+	if !opts.Guardrails.Empty() && !bytes.Contains(source, []byte(".Guardrails")) {
+		return "", fmt.Errorf("template %s does not support guardrails", opts.Template)
+	}
+
+	if !opts.Schedule.Empty() && !bytes.Contains(source, []byte(".Schedule")) {
+		return "", fmt.Errorf("template %s does not support jitter or working hours", opts.Template)
+	}
+
+	if !opts.Multiplexer.Empty() && !bytes.Contains(source, []byte(".Multiplexer")) {
+		return "", fmt.Errorf("template %s does not support multiplexer settings", opts.Template)
+	}
+
+	if len(opts.Pinning.Pins) > 0 && !bytes.Contains(source, []byte(".Pinning")) {
+		return "", fmt.Errorf("template %s does not support server key pinning", opts.Template)
+	}
+
+	if opts.UserAgent != "" && !bytes.Contains(source, []byte(".UserAgent")) {
+		return "", fmt.Errorf("template %s does not support a custom user agent", opts.Template)
+	}
+
+	encodedProfile, err := assets.encodeProfile(opts.Profile)
+	if err != nil {
+		return "", err
+	}
+	if encodedProfile != "" && !bytes.Contains(source, []byte(".Profile")) {
+		return "", fmt.Errorf("template %s does not support transport profiles", opts.Template)
+	}
+
+	t, err := template.New("agent.go").Parse(string(source))
+	if err != nil {
+		return "", err
+	}
 
 	var tpl bytes.Buffer
-	data := struct {
-		ProxyServer    string
-		Servers        string
-		CACert         string
-		AgentCert      string
-		AgentKey       string
-		IgnoreEnvProxy bool
-	}{
-		ProxyServer:    proxyServer,
-		Servers:        servers,
-		CACert:         CACert,
-		AgentCert:      AgentCert,
-		AgentKey:       AgentKey,
-		IgnoreEnvProxy: IgnoreEnvProxy,
+	data := agentTemplateData{
+		ProxyServer:    opts.ProxyServer,
+		Servers:        plan.Servers,
+		CACert:         opts.CACert,
+		AgentCert:      opts.AgentCert,
+		AgentKey:       opts.AgentKey,
+		IgnoreEnvProxy: opts.IgnoreEnvProxy,
+		UserAgent:      opts.UserAgent,
+		Profile:        encodedProfile,
+		Netns:          opts.Netns,
+		VRF:            opts.VRF,
+		Beacon:         opts.Beacon,
+		Sealed:         sealed,
+		KeySource:      opts.KeySource,
+		Rotation:       opts.Rotation,
+		Retries:        plan.Retries,
+		Backoff:        plan.Backoff,
+		Guardrails:     opts.Guardrails,
+		Schedule:       opts.Schedule,
+		Multiplexer:    opts.Multiplexer,
+		Pinning:        opts.Pinning,
+	}
+	if sealed != "" {
+		data.ProxyServer, data.Servers, data.CACert, data.AgentCert, data.AgentKey = "", "", "", "", ""
+		data.Pinning.Pins = nil
 	}
 	if err := t.Execute(&tpl, data); err != nil {
 		return "", err
@@ -166,63 +296,459 @@ func (assets *AssetService) setupAgentDir() (string, error) {
 	return "", nil
 }
 
-func (assets *AssetService) CompileAgent(goos string, goarch string, obfuscate bool, proxyServer string, servers string, CACert string, AgentCert string, AgentKey string, IgnoreEnvProxy bool) ([]byte, error) {
-	for _, server := range strings.Split(servers, "\n") {
-		if _, _, err := net.SplitHostPort(server); err != nil {
-			return nil, fmt.Errorf("%s is invalid server: %s", server, err)
+// BuildOptions is everything an agent is built from, it's handed from the build request down to the template
+type BuildOptions struct {
+	Operator   string
+	Campaign   string
+	Template   string
+	SigningKey string
+	Hooks      []string
+
+	GOOS      string
+	GOARCH    string
+	Format    string
+	Staged    bool
+	Exec      bool
+	Persist   bool
+	HostInfo  bool
+	Loader    bool
+	Override  bool
+	Obfuscate bool
+	Garble    gogo.GarbleOptions
+	Resources *winres.Resources
+
+	ProxyServer    string
+	Servers        string
+	CACert         string
+	AgentCert      string
+	AgentKey       string
+	IgnoreEnvProxy bool
+	UserAgent      string
+	Profile        string
+	Netns          string
+	VRF            string
+	Beacon         string
+	KeySource      string
+	Key            string
+	Rotation       string
+	Retries        int
+	Backoff        string
+	Guardrails     agentbuild.Guardrails
+	Schedule       agentbuild.Schedule
+	Multiplexer    agentbuild.Multiplexer
+	Pinning        agentbuild.Pinning
+}
+
+// BuildAgent queues an agent compilation, see CompileAgent. Successful builds are watermarked with the build ID,
+// signed if a signing key was picked and recorded along with who built them and their garble seed. Staged builds
+// keep the agent on the server as a stage and return a stager that fetches it instead
+func (assets *AssetService) BuildAgent(opts BuildOptions) (*BuildJob, error) {
+	if opts.Template == "" {
+		opts.Template = agentbuild.DefaultTemplate
+	}
+
+	if err := assets.checkSigning(opts.SigningKey, opts.GOOS, opts.Format); err != nil {
+		return nil, err
+	}
+
+	if err := assets.checkHooks(opts.Hooks, opts.GOOS, opts.GOARCH); err != nil {
+		return nil, err
+	}
+
+	if _, err := assets.encodeProfile(opts.Profile); err != nil {
+		return nil, err
+	}
+
+	if err := checkSealing(opts.KeySource, opts.Key, opts.Format); err != nil {
+		return nil, err
+	}
+
+	if err := checkRotation(opts.Rotation); err != nil {
+		return nil, err
+	}
+
+	plan, err := parseServers(withPeers(opts.Servers, assets.config.HAPeerAgents), opts.Retries, opts.Backoff)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := opts.Guardrails.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := opts.Schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := opts.Multiplexer.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := opts.Pinning.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.Staged {
+		if err := checkStaged(opts.Format, opts.ProxyServer); err != nil {
+			return nil, err
 		}
 	}
 
-	if proxyServer != "" {
-		u, err := url.Parse(proxyServer)
+	if opts.Persist && (opts.Staged || (opts.Format != "" && opts.Format != FormatExecutable && opts.Format != FormatService)) {
+		return nil, fmt.Errorf("persistence is only built into unstaged executables and services")
+	}
+
+	if opts.Loader {
+		if err := checkLoader(opts.GOOS, opts.Format); err != nil {
+			return nil, err
+		}
+	}
+
+	if assets.config.FIPS {
+		if err := checkFIPS(opts.GOOS, opts.GOARCH, opts.Obfuscate); err != nil {
+			return nil, err
+		}
+	}
+
+	if !opts.Resources.Empty() {
+		if opts.GOOS != "windows" || opts.Format == FormatShellcode {
+			return nil, fmt.Errorf("resources can only be embedded in windows executables, services and DLLs")
+		}
+
+		if err := opts.Resources.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Obfuscate {
+		if opts.Garble.Seed == "" {
+			seed, err := gogo.NewGarbleSeed()
+			if err != nil {
+				return nil, err
+			}
+			opts.Garble.Seed = seed
+		} else if err := gogo.ValidateGarbleSeed(opts.Garble.Seed); err != nil {
+			return nil, err
+		}
+	} else {
+		opts.Garble = gogo.GarbleOptions{}
+	}
+
+	return assets.builds.Submit(func(job *BuildJob) ([]byte, error) {
+		result, err := assets.CompileAgent(job.ctx, job.report, opts, plan)
 		if err != nil {
-			return nil, fmt.Errorf("%s is invalid proxy: %s", proxyServer, err)
+			return nil, err
 		}
 
-		if !slices.Contains(assets.supportedProxySchemes, u.Scheme) {
-			return nil, fmt.Errorf("%s is not supported proxy scheme", u.Scheme)
+		result = agentbuild.Watermark(result, job.ID)
+
+		var stageSha256 string
+		if opts.Staged {
+			// the stage gets signed as well, outside of linux it runs from disk
+			if opts.SigningKey != "" {
+				job.report("signing stage with %s", opts.SigningKey)
+				if result, _, err = assets.signAgent(job.ctx, opts.SigningKey, result); err != nil {
+					return nil, err
+				}
+			}
+
+			stageSum := sha256.Sum256(result)
+			stageSha256 = hex.EncodeToString(stageSum[:])
+
+			job.report("storing stage")
+			if err := assets.saveStage(job.ID, result); err != nil {
+				return nil, err
+			}
+
+			result, err = assets.CompileStager(job.ctx, job.report, job.ID, opts.GOOS, opts.GOARCH, opts.Obfuscate, opts.Garble, opts.Resources, opts.ProxyServer, plan.Servers, opts.CACert, opts.AgentCert, opts.AgentKey, opts.IgnoreEnvProxy, opts.KeySource, opts.Key, opts.Guardrails, opts.Pinning)
+			if err != nil {
+				return nil, err
+			}
+
+			result = agentbuild.Watermark(result, job.ID)
+		}
+
+		if len(opts.Hooks) > 0 {
+			filename := "agent"
+			if agentFormat, err := getAgentFormat(opts.Format, opts.GOOS); err == nil {
+				filename = agentFormat.filename
+				if agentFormat.shellcode {
+					filename = "agent.bin"
+				}
+			}
+
+			result, err = assets.runHooks(job.ctx, job.report, opts.Hooks, result, filename, []string{
+				"LIGOLO_BUILD_ID=" + job.ID,
+				"LIGOLO_GOOS=" + opts.GOOS,
+				"LIGOLO_GOARCH=" + opts.GOARCH,
+				"LIGOLO_FORMAT=" + opts.Format,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// packers usually drop trailing data
+			if _, ok := agentbuild.ReadWatermark(result); !ok {
+				result = agentbuild.Watermark(result, job.ID)
+			}
+		}
+
+		if opts.SigningKey != "" {
+			job.report("signing agent with %s", opts.SigningKey)
+			if result, job.Signature, err = assets.signAgent(job.ctx, opts.SigningKey, result); err != nil {
+				return nil, err
+			}
 		}
+
+		if opts.Loader {
+			job.report("generating memory loader")
+			if job.Loader, err = MemfdLoader(opts.GOARCH, result); err != nil {
+				return nil, err
+			}
+		}
+
+		hashsum := sha256.Sum256(result)
+		job.Build = &agentbuild.AgentBuild{
+			ID:             job.ID,
+			Operator:       opts.Operator,
+			Campaign:       strings.TrimSpace(opts.Campaign),
+			Template:       opts.Template,
+			SigningKey:     opts.SigningKey,
+			Hooks:          opts.Hooks,
+			GOOS:           opts.GOOS,
+			GOARCH:         opts.GOARCH,
+			Format:         opts.Format,
+			Staged:         opts.Staged,
+			StageSha256:    stageSha256,
+			Exec:           opts.Exec,
+			Persist:        opts.Persist,
+			HostInfo:       opts.HostInfo,
+			Loader:         opts.Loader,
+			Override:       opts.Override,
+			Profile:        opts.Profile,
+			FIPS:           assets.config.FIPS,
+			KeySource:      opts.KeySource,
+			Guardrails:     opts.Guardrails,
+			Schedule:       opts.Schedule,
+			Multiplexer:    opts.Multiplexer,
+			Pinning:        opts.Pinning,
+			Obfuscate:      opts.Obfuscate,
+			GarbleSeed:     opts.Garble.Seed,
+			GarbleTiny:     opts.Garble.Tiny,
+			GarbleLiterals: opts.Garble.Literals,
+			Sha256:         hex.EncodeToString(hashsum[:]),
+			Created:        time.Now(),
+		}
+		if err := assets.buildRepo.Save(job.Build); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}), nil
+}
+
+func (assets *AssetService) CancelBuild(id string) error {
+	return assets.builds.Cancel(id)
+}
+
+// LookupBuilds finds recorded builds by ID, hashsum, campaign or operator
+func (assets *AssetService) LookupBuilds(query string) ([]*agentbuild.AgentBuild, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty query")
 	}
 
-	agentDir, err := assets.renderAgent(proxyServer, servers, CACert, AgentCert, AgentKey, IgnoreEnvProxy)
+	return assets.buildRepo.Find(query)
+}
+
+// Builds lists every recorded build, newest first
+func (assets *AssetService) Builds() ([]*agentbuild.AgentBuild, error) {
+	builds, err := assets.buildRepo.GetAll()
 	if err != nil {
 		return nil, err
 	}
 
-	goConfig := &gogo.GoConfig{
-		CGO:        "0",
-		GOOS:       goos,
-		GOARCH:     goarch,
-		GOROOT:     gogo.GetGoRootDir(assets.config.GetAssetsDir()),
-		GOCACHE:    gogo.GetGoCache(assets.config.GetAssetsDir()),
-		GOMODCACHE: gogo.GetGoModCache(assets.config.GetAssetsDir()),
-		ProjectDir: agentDir,
-		Obfuscate:  obfuscate,
-		GOGARBLE:   "*",
+	slices.SortFunc(builds, func(a, b *agentbuild.AgentBuild) int {
+		return b.Created.Compare(a.Created)
+	})
+
+	return builds, nil
+}
+
+// StoreBuild keeps a built agent on the server along with the request it was built from, so any operator can
+// download it again or rebuild it
+func (assets *AssetService) StoreBuild(build *agentbuild.AgentBuild, binary []byte, signature []byte, request []byte) error {
+	err := assets.artifactRepo.Save(&agentbuild.Artifact{
+		ID:        build.ID,
+		Binary:    binary,
+		Signature: signature,
+		Request:   request,
+	})
+	if err != nil {
+		return err
 	}
 
-	var destination string
-	if goos == "windows" {
-		destination = filepath.Join(agentDir, "bin", "agent.exe")
-	} else {
-		destination = filepath.Join(agentDir, "bin", "agent")
+	build.Stored = true
+	return assets.buildRepo.Save(build)
+}
+
+// UpdateFor returns a stored build an agent running on platform (GOOS/GOARCH) can replace itself with
+func (assets *AssetService) UpdateFor(id string, platform string) (*agentbuild.AgentBuild, []byte, error) {
+	build, artifact, err := assets.StoredBuild(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if build.GOOS+"/"+build.GOARCH != platform {
+		return nil, nil, fmt.Errorf("build %s is for %s/%s, the agent runs on %s", id, build.GOOS, build.GOARCH, platform)
+	}
+
+	if (build.Format != "" && build.Format != FormatExecutable) || build.Staged {
+		return nil, nil, fmt.Errorf("build %s is not an unstaged executable", id)
 	}
 
-	_, err = gogo.GoBuild(*goConfig, filepath.Join(agentDir, "src"), destination)
+	return build, artifact.Binary, nil
+}
+
+// StoredBuild returns a build kept with StoreBuild
+func (assets *AssetService) StoredBuild(id string) (*agentbuild.AgentBuild, *agentbuild.Artifact, error) {
+	build := assets.buildRepo.GetOne(id)
+	if build == nil {
+		return nil, nil, fmt.Errorf("build %s not found", id)
+	}
+
+	artifact := assets.artifactRepo.GetOne(id)
+	if artifact == nil {
+		return nil, nil, fmt.Errorf("build %s was not kept on the server", id)
+	}
+
+	return build, artifact, nil
+}
+
+func (assets *AssetService) CompileAgent(ctx context.Context, report func(format string, args ...any), opts BuildOptions, plan serverPlan) ([]byte, error) {
+	target, err := gogo.ParseTarget(opts.GOOS, opts.GOARCH)
 	if err != nil {
 		return nil, err
 	}
 
-	agentBytes, err := os.ReadFile(destination)
+	agentFormat, err := getAgentFormat(opts.Format, opts.GOOS)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assets.checkAgent(opts.GOOS, opts.ProxyServer, opts.UserAgent, plan.Servers, opts.Netns, opts.VRF, opts.Beacon); err != nil {
+		return nil, err
+	}
+
+	report("rendering agent")
+	agentDir, err := assets.renderAgent(opts, plan)
 	if err != nil {
 		return nil, err
 	}
+	defer os.RemoveAll(agentDir)
+
+	cgo := "0"
+	var cc string
+	if agentFormat.cgo || assets.config.FIPS {
+		cgo = "1"
+		if cc, err = assets.cCompiler(opts.GOOS, target.GOARCH); err != nil {
+			return nil, err
+		}
+	}
+
+	goConfig := assets.toolchain()
+	goConfig.CGO = cgo
+	goConfig.CC = cc
+	goConfig.GOOS = target.GOOS
+	goConfig.GOARCH = target.GOARCH
+	goConfig.GOARM = target.GOARM
+	goConfig.GOMIPS = target.GOMIPS
+	goConfig.ProjectDir = agentDir
+	goConfig.Obfuscate = opts.Obfuscate
+	goConfig.GOGARBLE = "*"
+	goConfig.Garble = opts.Garble
+	goConfig.BuildMode = agentFormat.buildMode
+	goConfig.Tags = agentFormat.tags
+	if opts.Exec {
+		goConfig.Tags = append(slices.Clone(goConfig.Tags), "exec")
+	}
+	if opts.Persist {
+		goConfig.Tags = append(slices.Clone(goConfig.Tags), "persist")
+	}
+	if opts.HostInfo {
+		goConfig.Tags = append(slices.Clone(goConfig.Tags), "hostinfo")
+	}
+	if opts.Override {
+		goConfig.Tags = append(slices.Clone(goConfig.Tags), "override")
+	}
+	goConfig.Resources = opts.Resources
+	if assets.config.FIPS {
+		goConfig.EXPERIMENT = fipsExperiment
+	}
 
-	if err = os.RemoveAll(agentDir); err != nil {
+	destination := filepath.Join(agentDir, "bin", agentFormat.filename)
+
+	report("compiling %s/%s agent", opts.GOOS, opts.GOARCH)
+	_, err = gogo.GoBuild(ctx, goConfig, filepath.Join(agentDir, "src"), destination)
+	if err != nil {
 		return nil, err
 	}
 
-	return agentBytes, nil
+	if agentFormat.shellcode {
+		report("converting agent to shellcode")
+		destination, err = convertToShellcode(destination, target.GOARCH)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return os.ReadFile(destination)
+}
+
+// checkAgent validates the settings that end up in agent.go
+func (assets *AssetService) checkAgent(goos string, proxyServer string, userAgent string, servers string, netns string, vrf string, beacon string) error {
+	for _, server := range strings.Split(servers, "\n") {
+		if _, _, err := transport.Parse(server); err != nil {
+			return fmt.Errorf("%s is invalid server: %s", server, err)
+		}
+	}
+
+	if proxyServer != "" {
+		u, err := url.Parse(proxyServer)
+		if err != nil {
+			return fmt.Errorf("%s is invalid proxy: %s", proxyServer, err)
+		}
+
+		if !slices.Contains(assets.supportedProxySchemes, u.Scheme) {
+			return fmt.Errorf("%s is not supported proxy scheme", u.Scheme)
+		}
+	}
+
+	if strings.ContainsAny(userAgent, "`\r\n") {
+		return fmt.Errorf("user agent can't contain backticks or line breaks")
+	}
+
+	if (netns != "" || vrf != "") && goos != "linux" {
+		return fmt.Errorf("network namespaces and VRFs are only supported on linux agents")
+	}
+
+	if beacon != "" {
+		if interval, err := time.ParseDuration(beacon); err != nil || interval <= 0 {
+			return fmt.Errorf("%s is invalid beacon interval", beacon)
+		}
+	}
+
+	return nil
+}
+
+// cCompiler picks the C toolchain for cgo builds
+func (assets *AssetService) cCompiler(goos string, goarch string) (string, error) {
+	if assets.config.BuildContainer != "" {
+		// the image brings its own C toolchain, nothing to look up on the host
+		return sharedCompilers[fmt.Sprintf("%s/%s", goos, goarch)], nil
+	}
+
+	return findCompiler(goos, goarch)
 }
 
 func unzipBuf(src []byte, dest string) ([]string, error) {
@@ -0,0 +1,222 @@
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+const (
+	signingAssetPrefix = "signing-key/"
+	maxSigningKeySize  = 64 * 1024
+)
+
+// signingMaterial is kept as the asset source, passwords included since builds run unattended
+type signingMaterial struct {
+	Type     string
+	Key      []byte
+	Password string
+}
+
+func newSigningKey(a *Asset, material *signingMaterial) *agentbuild.SigningKey {
+	return &agentbuild.SigningKey{
+		Name:   strings.TrimPrefix(a.Name, signingAssetPrefix),
+		Type:   material.Type,
+		Sha256: hex.EncodeToString(a.Hashsum[:]),
+	}
+}
+
+func (assets *AssetService) SaveSigningKey(name string, keyType string, key []byte, password string) (*agentbuild.SigningKey, error) {
+	if !assetNameRe.MatchString(name) {
+		return nil, fmt.Errorf("signing key name may only contain letters, digits, '-' and '_'")
+	}
+
+	switch keyType {
+	case agentbuild.SigningAuthenticode, agentbuild.SigningGPG:
+	default:
+		return nil, fmt.Errorf("unknown signing key type '%s'", keyType)
+	}
+
+	if len(key) == 0 || len(key) > maxSigningKeySize {
+		return nil, fmt.Errorf("signing key must be between 1 and %d bytes", maxSigningKeySize)
+	}
+
+	material := &signingMaterial{
+		Type:     keyType,
+		Key:      key,
+		Password: password,
+	}
+
+	source, err := json.Marshal(material)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := NewAsset(signingAssetPrefix + name)
+	asset.SetSource(source)
+	if err := assets.repo.Save(asset); err != nil {
+		return nil, err
+	}
+
+	return newSigningKey(asset, material), nil
+}
+
+func (assets *AssetService) SigningKeys() ([]*agentbuild.SigningKey, error) {
+	all, err := assets.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*agentbuild.SigningKey
+	for _, a := range all {
+		if !strings.HasPrefix(a.Name, signingAssetPrefix) {
+			continue
+		}
+
+		var material signingMaterial
+		if err := json.Unmarshal(a.Source, &material); err != nil {
+			return nil, err
+		}
+		keys = append(keys, newSigningKey(a, &material))
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+
+	return keys, nil
+}
+
+func (assets *AssetService) RemoveSigningKey(name string) error {
+	if assets.repo.GetOne(signingAssetPrefix+name) == nil {
+		return fmt.Errorf("signing key '%s' not found", name)
+	}
+
+	return assets.repo.Remove(signingAssetPrefix + name)
+}
+
+func (assets *AssetService) signingMaterial(name string) (*signingMaterial, error) {
+	asset := assets.repo.GetOne(signingAssetPrefix + name)
+	if asset == nil {
+		return nil, fmt.Errorf("signing key '%s' not found", name)
+	}
+
+	hashsum := sha256.Sum256(asset.Source)
+	if hashsum != asset.Hashsum {
+		return nil, fmt.Errorf("signing key '%s' is corrupted", name)
+	}
+
+	var material signingMaterial
+	if err := json.Unmarshal(asset.Source, &material); err != nil {
+		return nil, err
+	}
+
+	return &material, nil
+}
+
+// checkSigning makes sure the key can sign what is about to be built, so a mismatch fails before compiling
+func (assets *AssetService) checkSigning(name string, goos string, format string) error {
+	if name == "" {
+		return nil
+	}
+
+	material, err := assets.signingMaterial(name)
+	if err != nil {
+		return err
+	}
+
+	switch material.Type {
+	case agentbuild.SigningAuthenticode:
+		if goos != "windows" || format == FormatShellcode {
+			return fmt.Errorf("authenticode only signs windows executables and DLLs")
+		}
+	case agentbuild.SigningGPG:
+		if goos != "linux" && goos != "freebsd" {
+			return fmt.Errorf("gpg signing is only available for ELF agents")
+		}
+	}
+
+	return nil
+}
+
+// signAgent signs the binary with the named key. Authenticode signatures are embedded and the signed binary is
+// returned, GPG ones come back separately as an armored detached signature
+func (assets *AssetService) signAgent(ctx context.Context, name string, binary []byte) ([]byte, []byte, error) {
+	material, err := assets.signingMaterial(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workDir, err := os.MkdirTemp(assets.config.GetAssetsDir(), "signing-")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer os.RemoveAll(workDir)
+
+	unsigned := filepath.Join(workDir, "agent")
+	passFile := filepath.Join(workDir, "password")
+	keyFile := filepath.Join(workDir, "key")
+	for path, content := range map[string][]byte{
+		unsigned: binary,
+		passFile: []byte(material.Password),
+		keyFile:  material.Key,
+	} {
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	switch material.Type {
+	case agentbuild.SigningAuthenticode:
+		signed := filepath.Join(workDir, "agent.signed")
+		if err := runSigner(ctx, "osslsigncode", "sign", "-pkcs12", keyFile, "-readpass", passFile, "-h", "sha256", "-in", unsigned, "-out", signed); err != nil {
+			return nil, nil, err
+		}
+
+		result, err := os.ReadFile(signed)
+		return result, nil, err
+	case agentbuild.SigningGPG:
+		home := filepath.Join(workDir, "gnupg")
+		if err := os.Mkdir(home, 0700); err != nil {
+			return nil, nil, err
+		}
+		defer exec.Command("gpgconf", "--homedir", home, "--kill", "gpg-agent").Run()
+
+		if err := runSigner(ctx, "gpg", "--batch", "--homedir", home, "--pinentry-mode", "loopback", "--passphrase-file", passFile, "--import", keyFile); err != nil {
+			return nil, nil, err
+		}
+
+		signature := filepath.Join(workDir, "agent.asc")
+		if err := runSigner(ctx, "gpg", "--batch", "--homedir", home, "--pinentry-mode", "loopback", "--passphrase-file", passFile, "--armor", "--detach-sign", "--output", signature, unsigned); err != nil {
+			return nil, nil, err
+		}
+
+		result, err := os.ReadFile(signature)
+		return binary, result, err
+	}
+
+	return nil, nil, fmt.Errorf("unknown signing key type '%s'", material.Type)
+}
+
+func runSigner(ctx context.Context, tool string, args ...string) error {
+	path, err := exec.LookPath(tool)
+	if err != nil {
+		return fmt.Errorf("signing needs %s on the server: %s", tool, err)
+	}
+
+	cmd := exec.CommandContext(ctx, path, args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %s: %s", tool, err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package asset
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/rs/xid"
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+	"github.com/ttpreport/ligolo-mp/v2/internal/winres"
+)
+
+// stagerTemplateData is what the stager gets rendered with, it carries the agent's transport settings plus
+// the build ID of the stage to fetch
+type stagerTemplateData struct {
+	StageID        string
+	ProxyServer    string
+	Servers        string
+	CACert         string
+	AgentCert      string
+	AgentKey       string
+	IgnoreEnvProxy bool
+	Sealed         string
+	KeySource      string
+	Guardrails     agentbuild.Guardrails
+	Pinning        agentbuild.Pinning
+}
+
+// checkStaged validates a staged build request before anything gets compiled
+func checkStaged(format string, proxyServer string) error {
+	if format != "" && format != FormatExecutable {
+		return fmt.Errorf("staged builds only support the %s format", FormatExecutable)
+	}
+
+	if proxyServer != "" {
+		u, err := url.Parse(proxyServer)
+		if err != nil {
+			return fmt.Errorf("%s is invalid proxy: %s", proxyServer, err)
+		}
+
+		if u.Scheme != "socks5" && u.Scheme != "socks5h" {
+			return fmt.Errorf("stagers only support socks5 proxies")
+		}
+	}
+
+	return nil
+}
+
+func (assets *AssetService) stagePath(id string) (string, error) {
+	if _, err := xid.FromString(id); err != nil {
+		return "", fmt.Errorf("invalid stage ID")
+	}
+
+	return filepath.Join(assets.config.GetAssetsDir(), "stages", id), nil
+}
+
+func (assets *AssetService) saveStage(id string, agent []byte) error {
+	path, err := assets.stagePath(id)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, agent, 0600)
+}
+
+// Stage returns the full agent a stager built with this ID downloads
+func (assets *AssetService) Stage(id string) ([]byte, error) {
+	path, err := assets.stagePath(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(path)
+}
+
+// CompileStager builds the minimal binary that fetches stage stageID from the server and runs it
+func (assets *AssetService) CompileStager(ctx context.Context, report func(format string, args ...any), stageID string, goos string, goarch string, obfuscate bool, garble gogo.GarbleOptions, resources *winres.Resources, proxyServer string, servers string, CACert string, AgentCert string, AgentKey string, IgnoreEnvProxy bool, keySource string, key string, guardrails agentbuild.Guardrails, pinning agentbuild.Pinning) ([]byte, error) {
+	target, err := gogo.ParseTarget(goos, goarch)
+	if err != nil {
+		return nil, err
+	}
+
+	report("rendering stager")
+	agentDir, err := assets.unpackAgent()
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(agentDir)
+
+	stagerDir := filepath.Join(agentDir, "src", "stager")
+	stagerFile := filepath.Join(stagerDir, "main.go")
+
+	source, err := os.ReadFile(stagerFile)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := template.New("main.go").Parse(string(source))
+	if err != nil {
+		return nil, err
+	}
+
+	data := stagerTemplateData{
+		StageID:        stageID,
+		ProxyServer:    proxyServer,
+		Servers:        servers,
+		CACert:         CACert,
+		AgentCert:      AgentCert,
+		AgentKey:       AgentKey,
+		IgnoreEnvProxy: IgnoreEnvProxy,
+		KeySource:      keySource,
+		Guardrails:     guardrails,
+		Pinning:        pinning,
+	}
+	if data.Sealed, err = sealConfig(keySource, key, proxyServer, servers, CACert, AgentCert, AgentKey, pinning.Pins); err != nil {
+		return nil, err
+	}
+	if data.Sealed != "" {
+		data.ProxyServer, data.Servers, data.CACert, data.AgentCert, data.AgentKey = "", "", "", "", ""
+		data.Pinning.Pins = nil
+	}
+
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, data); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(stagerFile, rendered.Bytes(), 0600); err != nil {
+		return nil, err
+	}
+
+	goConfig := assets.toolchain()
+	goConfig.CGO = "0"
+	if assets.config.FIPS {
+		goConfig.CGO = "1"
+		goConfig.EXPERIMENT = fipsExperiment
+		if goConfig.CC, err = assets.cCompiler(goos, target.GOARCH); err != nil {
+			return nil, err
+		}
+	}
+	goConfig.GOOS = target.GOOS
+	goConfig.GOARCH = target.GOARCH
+	goConfig.GOARM = target.GOARM
+	goConfig.GOMIPS = target.GOMIPS
+	goConfig.ProjectDir = agentDir
+	goConfig.Obfuscate = obfuscate
+	goConfig.GOGARBLE = "*"
+	goConfig.Garble = garble
+	goConfig.Resources = resources
+
+	filename := "stager"
+	if goos == "windows" {
+		filename = "stager.exe"
+	}
+	destination := filepath.Join(agentDir, "bin", filename)
+
+	report("compiling %s/%s stager", goos, goarch)
+	if _, err := gogo.GoBuild(ctx, goConfig, stagerDir, destination); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(destination)
+}
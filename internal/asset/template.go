@@ -0,0 +1,167 @@
+package asset
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+)
+
+const (
+	templateAssetPrefix = "agent-template/"
+	maxTemplateSize     = 1024 * 1024
+)
+
+var assetNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// agentTemplateData is what agent.go templates get rendered with
+type agentTemplateData struct {
+	ProxyServer    string
+	Servers        string
+	CACert         string
+	AgentCert      string
+	AgentKey       string
+	IgnoreEnvProxy bool
+	UserAgent      string // sent in CONNECT requests to HTTP proxies, empty keeps Go's default
+	Profile        string // transport profile as base64 encoded JSON, empty for none
+	Netns          string
+	VRF            string
+	Beacon         string // low-and-slow check-in interval, e.g. 30m, empty keeps the agent connected
+	Sealed         string // encrypted proxy, servers, CA, certificate, key and pins, which are then left empty
+	KeySource      string
+	Rotation       string // failover, round-robin or random, empty is failover
+	Retries        string // attempts per server, one line per server
+	Backoff        string // wait before retrying a server, doubled on every attempt, one line per server
+	Guardrails     agentbuild.Guardrails
+	Schedule       agentbuild.Schedule
+	Multiplexer    agentbuild.Multiplexer
+	Pinning        agentbuild.Pinning
+}
+
+func newAgentTemplate(a *Asset) *agentbuild.Template {
+	return &agentbuild.Template{
+		Name:   strings.TrimPrefix(a.Name, templateAssetPrefix),
+		Sha256: hex.EncodeToString(a.Hashsum[:]),
+		Size:   int64(len(a.Source)),
+	}
+}
+
+// validateAgentTemplate renders the template with placeholder values and makes sure the result is Go
+// that can stand in for agent.go, the rest of the agent calls run()
+func validateAgentTemplate(source []byte) error {
+	if len(source) > maxTemplateSize {
+		return fmt.Errorf("template is %d bytes, the limit is %d", len(source), maxTemplateSize)
+	}
+
+	t, err := template.New("agent.go").Parse(string(source))
+	if err != nil {
+		return err
+	}
+
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, agentTemplateData{
+		Servers:   "127.0.0.1:11601",
+		CACert:    "CA",
+		AgentCert: "CERT",
+		AgentKey:  "KEY",
+	}); err != nil {
+		return err
+	}
+
+	return lintAgentSource(rendered.Bytes())
+}
+
+// lintAgentSource checks that a rendered agent.go can stand in for the built-in one
+func lintAgentSource(source []byte) error {
+	file, err := parser.ParseFile(token.NewFileSet(), "agent.go", source, 0)
+	if err != nil {
+		return fmt.Errorf("rendered template is not valid Go: %w", err)
+	}
+
+	if file.Name.Name != "main" {
+		return fmt.Errorf("template must be in package main, got %s", file.Name.Name)
+	}
+
+	if file.Scope.Lookup("run") == nil {
+		return fmt.Errorf("template must define run(args []string), the agent entrypoints call it")
+	}
+
+	return nil
+}
+
+func (assets *AssetService) SaveAgentTemplate(name string, source []byte) (*agentbuild.Template, error) {
+	if !assetNameRe.MatchString(name) {
+		return nil, fmt.Errorf("template name may only contain letters, digits, '-' and '_'")
+	}
+
+	if name == agentbuild.DefaultTemplate {
+		return nil, fmt.Errorf("'%s' is the built-in template", agentbuild.DefaultTemplate)
+	}
+
+	if err := validateAgentTemplate(source); err != nil {
+		return nil, err
+	}
+
+	asset := NewAsset(templateAssetPrefix + name)
+	asset.SetSource(source)
+	if err := assets.repo.Save(asset); err != nil {
+		return nil, err
+	}
+
+	return newAgentTemplate(asset), nil
+}
+
+func (assets *AssetService) AgentTemplates() ([]*agentbuild.Template, error) {
+	all, err := assets.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []*agentbuild.Template
+	for _, a := range all {
+		if strings.HasPrefix(a.Name, templateAssetPrefix) {
+			templates = append(templates, newAgentTemplate(a))
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, nil
+}
+
+func (assets *AssetService) RemoveAgentTemplate(name string) error {
+	if assets.repo.GetOne(templateAssetPrefix+name) == nil {
+		return fmt.Errorf("agent template '%s' not found", name)
+	}
+
+	return assets.repo.Remove(templateAssetPrefix + name)
+}
+
+// agentTemplateSource returns the stored template, nil for the built-in one
+func (assets *AssetService) agentTemplateSource(name string) ([]byte, error) {
+	if name == "" || name == agentbuild.DefaultTemplate {
+		return nil, nil
+	}
+
+	asset := assets.repo.GetOne(templateAssetPrefix + name)
+	if asset == nil {
+		return nil, fmt.Errorf("agent template '%s' not found", name)
+	}
+
+	hashsum := sha256.Sum256(asset.Source)
+	if hashsum != asset.Hashsum {
+		return nil, fmt.Errorf("agent template '%s' is corrupted", name)
+	}
+
+	return asset.Source, nil
+}
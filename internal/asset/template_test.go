@@ -0,0 +1,30 @@
+package asset
+
+import (
+	"os"
+	"testing"
+)
+
+func TestValidateAgentTemplate(t *testing.T) {
+	builtin, err := os.ReadFile("../../artifacts/agent/agent.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validateAgentTemplate(builtin); err != nil {
+		t.Fatalf("built-in agent.go should be a valid template: %v", err)
+	}
+
+	invalid := map[string]string{
+		"unknown variable": "package main\n\nvar x = `{{ .Sleep }}`\n\nfunc run(args []string) {}\n",
+		"missing run":      "package main\n\nvar servers = `{{ .Servers }}`\n",
+		"not go":           "package main\n\nfunc run(args []string) {\n",
+		"wrong package":    "package agent\n\nfunc run(args []string) {}\n",
+	}
+
+	for name, source := range invalid {
+		if err := validateAgentTemplate([]byte(source)); err == nil {
+			t.Errorf("%s: template should be rejected", name)
+		}
+	}
+}
@@ -0,0 +1,449 @@
+package asset
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/version"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/agentbuild"
+	"github.com/ttpreport/ligolo-mp/v2/internal/gogo"
+)
+
+const (
+	toolchainAsset         = "toolchain"          // installed over the embedded go, survives restarts
+	previousToolchainAsset = "toolchain.previous" // what a rollback goes back to, missing means the embedded one
+
+	// MaxToolchainSize caps uploaded and downloaded toolchain archives
+	MaxToolchainSize = 512 * 1024 * 1024
+
+	goReleases = "https://go.dev/dl/"
+)
+
+// toolchainCaches live inside GOROOT, they are carried over whenever the toolchain gets swapped
+var toolchainCaches = []string{"cache", "modcache"}
+
+func (assets *AssetService) previousGoRoot() string {
+	return filepath.Join(assets.config.GetAssetsDir(), "go.previous")
+}
+
+// Toolchain describes the Go toolchain agents are currently built with
+func (assets *AssetService) Toolchain() (*agentbuild.Toolchain, error) {
+	goVersion, err := gogo.CheckVersion(assets.toolchain())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &agentbuild.Toolchain{
+		Version: goVersion,
+		Origin:  agentbuild.ToolchainEmbedded,
+	}
+
+	if assets.config.GoRoot != "" || assets.config.BuildContainer != "" {
+		result.Origin = agentbuild.ToolchainExternal
+		return result, nil
+	}
+
+	if current := assets.repo.GetOne(toolchainAsset); current != nil {
+		result.Origin = current.Origin
+		result.Installed = current.Installed
+	}
+
+	if _, err := os.Stat(assets.previousGoRoot()); err == nil {
+		result.CanRollback = true
+	}
+
+	return result, nil
+}
+
+// InstallToolchain replaces the toolchain with a Go release archive (zip or tar.gz, with go/ at its root). It has
+// to be newer than the current one, which is kept for a rollback. Builds wait while the toolchain gets swapped
+func (assets *AssetService) InstallToolchain(ctx context.Context, archive []byte, origin string) (*agentbuild.Toolchain, error) {
+	if assets.config.GoRoot != "" || assets.config.BuildContainer != "" {
+		return nil, fmt.Errorf("the server uses an external toolchain, it can't be replaced at runtime")
+	}
+
+	staging, err := os.MkdirTemp(assets.config.GetAssetsDir(), "toolchain-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging)
+
+	if err := extractToolchain(archive, staging); err != nil {
+		return nil, fmt.Errorf("invalid toolchain archive: %v", err)
+	}
+
+	goRoot := gogo.GetGoRootDir(assets.config.GetAssetsDir())
+	newGoRoot := filepath.Join(staging, "go")
+
+	err = assets.builds.Exclusive(ctx, func() error {
+		config := assets.toolchain()
+		current, err := gogo.CheckVersion(config)
+		if err != nil {
+			return err
+		}
+
+		config.GOROOT = newGoRoot
+		installed, err := gogo.CheckVersion(config)
+		if err != nil {
+			return err
+		}
+
+		if version.Compare(installed, current) <= 0 {
+			return fmt.Errorf("%s is not newer than the current toolchain %s, roll back instead", installed, current)
+		}
+
+		// official releases don't ship garble, the current one is kept
+		garble := filepath.Join(goRoot, "bin", "garble")
+		if _, err := os.Stat(filepath.Join(newGoRoot, "bin", "garble")); os.IsNotExist(err) {
+			if err := copyFile(garble, filepath.Join(newGoRoot, "bin", "garble")); err != nil {
+				slog.Warn("toolchain has no garble, obfuscated builds need one in PATH", slog.Any("error", err))
+			}
+		}
+
+		if err := removeReadOnly(assets.previousGoRoot()); err != nil {
+			return err
+		}
+
+		if err := assets.swapToolchain(newGoRoot, assets.previousGoRoot()); err != nil {
+			return err
+		}
+
+		if previous := assets.repo.GetOne(toolchainAsset); previous != nil {
+			previous.Name = previousToolchainAsset
+			assets.repo.Save(previous)
+		} else {
+			assets.repo.Remove(previousToolchainAsset)
+		}
+
+		record := NewAsset(toolchainAsset)
+		record.SetContent(archive)
+		record.Version = installed
+		record.Origin = origin
+		record.Installed = time.Now()
+
+		slog.Info("go toolchain updated", slog.String("from", current), slog.String("to", installed), slog.String("origin", origin))
+
+		return assets.repo.Save(record)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assets.Toolchain()
+}
+
+// RollbackToolchain goes back to the toolchain in use before the last update. The replaced one is kept in turn,
+// so a rollback can be undone the same way
+func (assets *AssetService) RollbackToolchain(ctx context.Context) (*agentbuild.Toolchain, error) {
+	if _, err := os.Stat(assets.previousGoRoot()); err != nil {
+		return nil, fmt.Errorf("there is no previous toolchain to roll back to")
+	}
+
+	err := assets.builds.Exclusive(ctx, func() error {
+		swap := assets.previousGoRoot() + ".swap"
+		if err := removeReadOnly(swap); err != nil {
+			return err
+		}
+
+		if err := os.Rename(assets.previousGoRoot(), swap); err != nil {
+			return err
+		}
+
+		if err := assets.swapToolchain(swap, assets.previousGoRoot()); err != nil {
+			return err
+		}
+
+		current := assets.repo.GetOne(toolchainAsset)
+		previous := assets.repo.GetOne(previousToolchainAsset)
+
+		assets.repo.Remove(toolchainAsset)
+		assets.repo.Remove(previousToolchainAsset)
+		if previous != nil {
+			previous.Name = toolchainAsset
+			assets.repo.Save(previous)
+		}
+		if current != nil {
+			current.Name = previousToolchainAsset
+			assets.repo.Save(current)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := assets.Toolchain()
+	if err == nil {
+		slog.Info("go toolchain rolled back", slog.String("version", result.Version))
+	}
+
+	return result, err
+}
+
+// swapToolchain makes newGoRoot the toolchain, the current one is moved to oldGoRoot without its caches
+func (assets *AssetService) swapToolchain(newGoRoot string, oldGoRoot string) error {
+	goRoot := gogo.GetGoRootDir(assets.config.GetAssetsDir())
+
+	for _, cache := range toolchainCaches {
+		if err := removeReadOnly(filepath.Join(newGoRoot, cache)); err != nil {
+			return err
+		}
+
+		if err := os.Rename(filepath.Join(goRoot, cache), filepath.Join(newGoRoot, cache)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := os.Rename(goRoot, oldGoRoot); err != nil {
+		return err
+	}
+
+	if err := os.Rename(newGoRoot, goRoot); err != nil {
+		os.Rename(oldGoRoot, goRoot) // builds would have nothing to run otherwise
+		return err
+	}
+
+	gogo.ForgetToolchain(goRoot)
+
+	return nil
+}
+
+// goRelease is an entry of the go.dev download list
+type goRelease struct {
+	Version string `json:"version"`
+	Files   []struct {
+		Filename string `json:"filename"`
+		OS       string `json:"os"`
+		Arch     string `json:"arch"`
+		Sha256   string `json:"sha256"`
+		Kind     string `json:"kind"`
+	} `json:"files"`
+}
+
+// FetchToolchain downloads a Go release from go.dev for the server's platform, checks it against the published
+// checksum and installs it
+func (assets *AssetService) FetchToolchain(ctx context.Context, goVersion string) (*agentbuild.Toolchain, error) {
+	if !strings.HasPrefix(goVersion, "go") {
+		goVersion = "go" + goVersion
+	}
+
+	if !version.IsValid(goVersion) {
+		return nil, fmt.Errorf("%s is invalid go version", goVersion)
+	}
+
+	index, err := download(ctx, goReleases+"?mode=json&include=all")
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []goRelease
+	if err := json.Unmarshal(index, &releases); err != nil {
+		return nil, fmt.Errorf("invalid go release list: %v", err)
+	}
+
+	for _, release := range releases {
+		if release.Version != goVersion {
+			continue
+		}
+
+		for _, file := range release.Files {
+			if file.OS != runtime.GOOS || file.Arch != runtime.GOARCH || file.Kind != "archive" {
+				continue
+			}
+
+			archive, err := download(ctx, goReleases+file.Filename)
+			if err != nil {
+				return nil, err
+			}
+
+			sum := sha256.Sum256(archive)
+			if hex.EncodeToString(sum[:]) != file.Sha256 {
+				return nil, fmt.Errorf("checksum of %s does not match the published one", file.Filename)
+			}
+
+			return assets.InstallToolchain(ctx, archive, agentbuild.ToolchainFetched)
+		}
+
+		return nil, fmt.Errorf("%s has no release for %s/%s", goVersion, runtime.GOOS, runtime.GOARCH)
+	}
+
+	return nil, fmt.Errorf("%s is not a known go release", goVersion)
+}
+
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not download %s: %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxToolchainSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > MaxToolchainSize {
+		return nil, fmt.Errorf("%s is too large", url)
+	}
+
+	return data, nil
+}
+
+// extractToolchain unpacks a zip or tar.gz Go release into dest, it must hold a go/ directory with the go binary
+func extractToolchain(archive []byte, dest string) error {
+	var err error
+	switch {
+	case bytes.HasPrefix(archive, []byte("PK\x03\x04")):
+		err = extractZip(archive, dest)
+	case bytes.HasPrefix(archive, []byte{0x1f, 0x8b}):
+		err = extractTarGz(archive, dest)
+	default:
+		err = fmt.Errorf("expected a zip or tar.gz archive")
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "go", "bin", "go")); err != nil {
+		return fmt.Errorf("go/bin/go is missing")
+	}
+
+	return nil
+}
+
+// archivePath resolves an archive entry under dest, entries escaping it are refused
+func archivePath(dest string, name string) (string, error) {
+	path := filepath.Join(dest, name)
+	if !strings.HasPrefix(path, filepath.Clean(dest)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("%s is outside of the archive", name)
+	}
+
+	return path, nil
+}
+
+func extractZip(archive []byte, dest string) error {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range reader.File {
+		path, err := archivePath(dest, file.Name)
+		if err != nil {
+			return err
+		}
+
+		if file.FileInfo().IsDir() {
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return err
+		}
+
+		err = writeArchiveFile(path, file.Mode(), rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func extractTarGz(archive []byte, dest string) error {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		path, err := archivePath(dest, header.Name)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeArchiveFile(path, header.FileInfo().Mode(), reader); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeArchiveFile(path string, mode os.FileMode, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm()|0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func copyFile(src string, dest string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeArchiveFile(dest, info.Mode(), file)
+}
@@ -0,0 +1,46 @@
+package asset
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+)
+
+func TestExtractToolchain(t *testing.T) {
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	w, _ := zw.Create("go/bin/go")
+	w.Write([]byte("#!/bin/sh\n"))
+	zw.Close()
+
+	if err := extractToolchain(zipped.Bytes(), t.TempDir()); err != nil {
+		t.Fatalf("zip release should be accepted: %v", err)
+	}
+
+	tarball := func(name string) []byte {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		tw.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: 10, Typeflag: tar.TypeReg})
+		tw.Write([]byte("#!/bin/sh\n"))
+		tw.Close()
+		gz.Close()
+		return buf.Bytes()
+	}
+
+	if err := extractToolchain(tarball("go/bin/go"), t.TempDir()); err != nil {
+		t.Fatalf("tar.gz release should be accepted: %v", err)
+	}
+
+	for name, archive := range map[string][]byte{
+		"escaping entry": tarball("../go/bin/go"),
+		"no go binary":   tarball("go/bin/gofmt"),
+		"not an archive": []byte("go1.24.0"),
+	} {
+		if err := extractToolchain(archive, t.TempDir()); err == nil {
+			t.Errorf("%s should be rejected", name)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package attachment
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Attachment is a file or a note an operator left on a session for the others
+type Attachment struct {
+	ID        string
+	SessionID string
+	Name      string
+	Note      string
+	Operator  string
+	Size      int64
+	Sha256    string
+	Created   time.Time
+	Content   []byte `json:"-"` // kept apart so listing attachments doesn't load every file
+}
+
+func NewAttachment(sessionID string, operatorName string, name string, note string, content []byte) *Attachment {
+	hashsum := sha256.Sum256(content)
+
+	// only keep the file name, operators upload from their own filesystem layout
+	if name = strings.TrimSpace(name); name != "" {
+		name = filepath.Base(name)
+	}
+
+	return &Attachment{
+		ID:        xid.New().String(),
+		SessionID: sessionID,
+		Name:      name,
+		Note:      strings.TrimSpace(note),
+		Operator:  operatorName,
+		Size:      int64(len(content)),
+		Sha256:    hex.EncodeToString(hashsum[:]),
+		Created:   time.Now(),
+		Content:   content,
+	}
+}
+
+func (a *Attachment) Validate() error {
+	if a.SessionID == "" {
+		return fmt.Errorf("attachment has no session")
+	}
+
+	if a.Size == 0 && a.Note == "" {
+		return fmt.Errorf("attachment needs a file or a note")
+	}
+
+	if a.Size > 0 && (a.Name == "" || a.Name == "." || a.Name == ".." || a.Name == string(filepath.Separator)) {
+		return fmt.Errorf("attached file has no name")
+	}
+
+	return nil
+}
+
+// IsNote is true for attachments that carry no file
+func (a *Attachment) IsNote() bool {
+	return a.Size == 0
+}
+
+func (a *Attachment) String() string {
+	return fmt.Sprintf("ID=%s Session=%s Name=%s Size=%d", a.ID, a.SessionID, a.Name, a.Size)
+}
+
+// Proto leaves the content out, it is only sent when an operator downloads the attachment
+func (a *Attachment) Proto() *pb.Attachment {
+	return &pb.Attachment{
+		ID:        a.ID,
+		SessionID: a.SessionID,
+		Name:      a.Name,
+		Note:      a.Note,
+		Operator:  a.Operator,
+		Size:      a.Size,
+		Sha256:    a.Sha256,
+		Created:   timestamppb.New(a.Created),
+	}
+}
+
+func ProtoToAttachment(p *pb.Attachment) *Attachment {
+	return &Attachment{
+		ID:        p.ID,
+		SessionID: p.SessionID,
+		Name:      p.Name,
+		Note:      p.Note,
+		Operator:  p.Operator,
+		Size:      p.Size,
+		Sha256:    p.Sha256,
+		Created:   p.Created.AsTime(),
+	}
+}
@@ -0,0 +1,27 @@
+package attachment
+
+import "testing"
+
+func TestNewAttachment(t *testing.T) {
+	a := NewAttachment("session", "alice", "/home/alice/loot/web.config", "", []byte("<configuration/>"))
+	if err := a.Validate(); err != nil {
+		t.Fatalf("valid attachment rejected: %v", err)
+	}
+
+	if a.Name != "web.config" {
+		t.Fatalf("only the file name should be kept, got %q", a.Name)
+	}
+
+	note := NewAttachment("session", "alice", "", "domain admin creds in the vault", nil)
+	if err := note.Validate(); err != nil || !note.IsNote() {
+		t.Fatalf("notes without files should be accepted: %v", err)
+	}
+
+	if err := NewAttachment("session", "alice", "", "", nil).Validate(); err == nil {
+		t.Fatal("attachment without file and note should be rejected")
+	}
+
+	if err := NewAttachment("session", "alice", "..", "", []byte("x")).Validate(); err == nil {
+		t.Fatal("file names escaping the download directory should be rejected")
+	}
+}
@@ -0,0 +1,84 @@
+package attachment
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type AttachmentRepository struct {
+	storage  *storage.StoreInstance[Attachment]
+	contents *storage.StoreInstance[[]byte]
+}
+
+var (
+	table         = "attachments"
+	contentsTable = "attachment_contents"
+)
+
+func NewAttachmentRepository(store *storage.Store) (*AttachmentRepository, error) {
+	storeInstance, err := storage.GetInstance[Attachment](store, table)
+	if err != nil {
+		return nil, err
+	}
+
+	contentsInstance, err := storage.GetInstance[[]byte](store, contentsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AttachmentRepository{
+		storage:  storeInstance,
+		contents: contentsInstance,
+	}, nil
+}
+
+func (repo *AttachmentRepository) GetOne(id string) (*Attachment, error) {
+	return repo.storage.Get(id)
+}
+
+// GetContent loads the attached file, nil for notes
+func (repo *AttachmentRepository) GetContent(a *Attachment) ([]byte, error) {
+	content, err := repo.contents.Get(a.ID)
+	if err != nil || content == nil {
+		return nil, err
+	}
+
+	return *content, nil
+}
+
+func (repo *AttachmentRepository) GetAll() ([]*Attachment, error) {
+	return repo.storage.GetAll()
+}
+
+func (repo *AttachmentRepository) GetBySession(sessionID string) ([]*Attachment, error) {
+	attachments, err := repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Attachment
+	for _, a := range attachments {
+		if a.SessionID == sessionID {
+			result = append(result, a)
+		}
+	}
+
+	return result, nil
+}
+
+func (repo *AttachmentRepository) Save(a *Attachment) error {
+	if len(a.Content) > 0 {
+		if err := repo.contents.Set(a.ID, &a.Content); err != nil {
+			return err
+		}
+	}
+
+	return repo.storage.Set(a.ID, a)
+}
+
+func (repo *AttachmentRepository) Remove(a *Attachment) error {
+	if err := repo.contents.Del(a.ID); err != nil {
+		return err
+	}
+
+	return repo.storage.Del(a.ID)
+}
@@ -0,0 +1,102 @@
+package attachment
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+)
+
+type AttachmentService struct {
+	config *config.Config
+	repo   *AttachmentRepository
+}
+
+func NewAttachmentService(cfg *config.Config, repo *AttachmentRepository) *AttachmentService {
+	return &AttachmentService{
+		config: cfg,
+		repo:   repo,
+	}
+}
+
+// AddAttachment stores a file or a note on a session, within the per-file and per-session quotas
+func (service *AttachmentService) AddAttachment(sessionID string, operatorName string, name string, note string, content []byte) (*Attachment, error) {
+	a := NewAttachment(sessionID, operatorName, name, note, content)
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	if a.Size > service.config.MaxAttachmentSize {
+		return nil, fmt.Errorf("attachment is %d bytes, the limit is %d", a.Size, service.config.MaxAttachmentSize)
+	}
+
+	existing, err := service.repo.GetBySession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	used := a.Size
+	for _, other := range existing {
+		used += other.Size
+	}
+	if used > service.config.SessionAttachmentQuota {
+		return nil, fmt.Errorf("session attachments would take %d bytes, the quota is %d", used, service.config.SessionAttachmentQuota)
+	}
+
+	return a, service.repo.Save(a)
+}
+
+// SessionAttachments lists attachments oldest first, without their content
+func (service *AttachmentService) SessionAttachments(sessionID string) ([]*Attachment, error) {
+	attachments, err := service.repo.GetBySession(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].Created.Before(attachments[j].Created)
+	})
+
+	return attachments, nil
+}
+
+func (service *AttachmentService) AttachmentByID(id string) (*Attachment, error) {
+	a, err := service.repo.GetOne(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if a == nil {
+		return nil, fmt.Errorf("attachment '%s' not found", id)
+	}
+
+	return a, nil
+}
+
+// Download returns the attachment along with its content
+func (service *AttachmentService) Download(id string) (*Attachment, error) {
+	a, err := service.AttachmentByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Content, err = service.repo.GetContent(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (service *AttachmentService) RemoveAttachment(id string) (*Attachment, error) {
+	a, err := service.AttachmentByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.repo.Remove(a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
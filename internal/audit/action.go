@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rs/xid"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Action is a call of an operator that changes something, actions are only ever added to the log
+type Action struct {
+	ID         string
+	Time       time.Time
+	Operator   string
+	Role       string
+	Method     string // name of the call, e.g. AddRoute
+	Params     string // request as JSON, with bytes reduced to their size and secrets redacted
+	Error      string // empty if the call succeeded
+	Engagement string `json:",omitempty"`
+}
+
+func NewAction(operator string, role string, method string, params string, err error, engagement string) *Action {
+	action := &Action{
+		ID:         xid.New().String(),
+		Time:       time.Now(),
+		Operator:   operator,
+		Role:       role,
+		Method:     method,
+		Params:     params,
+		Engagement: engagement,
+	}
+	if err != nil {
+		action.Error = err.Error()
+	}
+
+	return action
+}
+
+func (action *Action) Proto() *pb.AuditAction {
+	return &pb.AuditAction{
+		ID:         action.ID,
+		Time:       timestamppb.New(action.Time),
+		Operator:   action.Operator,
+		Role:       action.Role,
+		Method:     action.Method,
+		Params:     action.Params,
+		Error:      action.Error,
+		Engagement: action.Engagement,
+	}
+}
+
+func ProtoToAction(p *pb.AuditAction) *Action {
+	return &Action{
+		ID:         p.ID,
+		Time:       p.Time.AsTime(),
+		Operator:   p.Operator,
+		Role:       p.Role,
+		Method:     p.Method,
+		Params:     p.Params,
+		Error:      p.Error,
+		Engagement: p.Engagement,
+	}
+}
+
+// ActionFilter selects actions of the log, empty fields match everything
+type ActionFilter struct {
+	Operator string
+	Method   string // matched case-insensitively as part of the name of the call
+	Query    string // matched case-insensitively in the parameters
+	Since    time.Time
+	Until    time.Time
+	Failed   bool // only actions that failed
+	Limit    int  // newest actions kept, 0 for all
+}
+
+func (filter ActionFilter) Matches(action *Action) bool {
+	if filter.Operator != "" && action.Operator != filter.Operator {
+		return false
+	}
+
+	if filter.Method != "" && !strings.Contains(strings.ToLower(action.Method), strings.ToLower(filter.Method)) {
+		return false
+	}
+
+	if filter.Query != "" && !strings.Contains(strings.ToLower(action.Params), strings.ToLower(filter.Query)) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && action.Time.Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && action.Time.After(filter.Until) {
+		return false
+	}
+
+	return !filter.Failed || action.Error != ""
+}
+
+func (filter ActionFilter) Proto() *pb.GetAuditLogReq {
+	result := &pb.GetAuditLogReq{
+		Operator: filter.Operator,
+		Method:   filter.Method,
+		Query:    filter.Query,
+		Failed:   filter.Failed,
+		Limit:    uint32(filter.Limit),
+	}
+	if !filter.Since.IsZero() {
+		result.Since = timestamppb.New(filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		result.Until = timestamppb.New(filter.Until)
+	}
+
+	return result
+}
+
+func ProtoToActionFilter(p *pb.GetAuditLogReq) ActionFilter {
+	filter := ActionFilter{
+		Operator: p.Operator,
+		Method:   p.Method,
+		Query:    p.Query,
+		Failed:   p.Failed,
+		Limit:    int(p.Limit),
+	}
+	if p.Since != nil {
+		filter.Since = p.Since.AsTime()
+	}
+	if p.Until != nil {
+		filter.Until = p.Until.AsTime()
+	}
+
+	return filter
+}
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// Record is an event as it was published, along with the sessions right after it when they changed
+type Record struct {
+	ID         string
+	Time       time.Time
+	Type       events.EventType
+	Data       string
+	Engagement string `json:",omitempty"`
+	Snapshot   bool
+	Sessions   []*session.Session `json:",omitempty"`
+}
+
+func NewRecord(event *events.Event, engagement string) *Record {
+	return &Record{
+		ID:         xid.New().String(),
+		Time:       time.Now(),
+		Type:       event.Type,
+		Data:       event.Data,
+		Engagement: engagement,
+	}
+}
+
+func (record *Record) Proto() *pb.ReplayEvent {
+	result := &pb.ReplayEvent{
+		Time:     record.Time.UnixNano(),
+		Snapshot: record.Snapshot,
+	}
+
+	if record.Data != "" {
+		result.Event = &pb.Event{
+			Type: int32(record.Type),
+			Data: record.Data,
+		}
+	}
+
+	for _, sess := range record.Sessions {
+		result.Sessions = append(result.Sessions, sess.Proto())
+	}
+
+	return result
+}
+
+func ProtoToRecord(p *pb.ReplayEvent) *Record {
+	record := &Record{
+		Time:     time.Unix(0, p.Time),
+		Snapshot: p.Snapshot,
+	}
+
+	if p.Event != nil {
+		record.Type = events.EventType(p.Event.Type)
+		record.Data = p.Event.Data
+	}
+
+	for _, sess := range p.Sessions {
+		record.Sessions = append(record.Sessions, session.ProtoToSession(sess))
+	}
+
+	return record
+}
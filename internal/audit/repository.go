@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type AuditRepository struct {
+	storage *storage.StoreInstance[Record]
+	actions *storage.StoreInstance[Action]
+}
+
+var (
+	table        = "audit_log"
+	actionsTable = "audit_actions"
+)
+
+func NewAuditRepository(store *storage.Store) (*AuditRepository, error) {
+	storeInstance, err := storage.GetInstance[Record](store, table)
+	if err != nil {
+		return nil, err
+	}
+
+	actionsInstance, err := storage.GetInstance[Action](store, actionsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditRepository{
+		storage: storeInstance,
+		actions: actionsInstance,
+	}, nil
+}
+
+func (repo *AuditRepository) GetAll() ([]*Record, error) {
+	return repo.storage.GetAll()
+}
+
+func (repo *AuditRepository) Save(record *Record) error {
+	return repo.storage.Set(record.ID, record)
+}
+
+func (repo *AuditRepository) GetActions() ([]*Action, error) {
+	return repo.actions.GetAll()
+}
+
+func (repo *AuditRepository) SaveAction(action *Action) error {
+	return repo.actions.Set(action.ID, action)
+}
@@ -0,0 +1,146 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+// idle stretches of the engagement are squashed to this much wall time, whatever the speed
+const maxReplayGap = 2 * time.Second
+
+type AuditService struct {
+	repo *AuditRepository
+
+	mu           sync.Mutex
+	lastSnapshot []byte
+}
+
+func NewAuditService(repo *AuditRepository) *AuditService {
+	return &AuditService{
+		repo: repo,
+	}
+}
+
+// Record persists an event under the current engagement, sessions are only stored when they differ from the
+// previous snapshot
+func (service *AuditService) Record(event *events.Event, sessions []*session.Session, engagement string) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	record := NewRecord(event, engagement)
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ID < sessions[j].ID
+	})
+
+	snapshot, err := json.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(snapshot, service.lastSnapshot) {
+		record.Snapshot = true
+		record.Sessions = sessions
+		service.lastSnapshot = snapshot
+	}
+
+	return service.repo.Save(record)
+}
+
+func (service *AuditService) Records() ([]*Record, error) {
+	records, err := service.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+
+	return records, nil
+}
+
+// LogAction adds an action of an operator to the log
+func (service *AuditService) LogAction(action *Action) error {
+	return service.repo.SaveAction(action)
+}
+
+// Actions are the actions of the log matching the filter, newest first
+func (service *AuditService) Actions(filter ActionFilter) ([]*Action, error) {
+	actions, err := service.repo.GetActions()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*Action
+	for _, action := range actions {
+		if filter.Matches(action) {
+			result = append(result, action)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Time.After(result[j].Time)
+	})
+
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+// Replay sends the records after since, spaced like they happened divided by speed. When starting midway, the
+// latest earlier snapshot goes first so the receiver can rebuild the state at that point
+func (service *AuditService) Replay(ctx context.Context, since time.Time, speed float64, send func(*Record) error) error {
+	if speed <= 0 {
+		speed = 1
+	}
+
+	records, err := service.Records()
+	if err != nil {
+		return err
+	}
+
+	var state *Record
+	var previous time.Time
+	for _, record := range records {
+		if record.Time.Before(since) {
+			if record.Snapshot {
+				state = record
+			}
+			continue
+		}
+
+		if state != nil {
+			if err := send(&Record{Time: state.Time, Snapshot: true, Sessions: state.Sessions}); err != nil {
+				return err
+			}
+			state = nil
+		}
+
+		if !previous.IsZero() {
+			gap := min(time.Duration(float64(record.Time.Sub(previous))/speed), maxReplayGap)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(gap):
+			}
+		}
+		previous = record.Time
+
+		if err := send(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+func newTestService(t *testing.T) *AuditService {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	repo, err := NewAuditRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewAuditService(repo)
+}
+
+func TestReplay(t *testing.T) {
+	service := newTestService(t)
+
+	first := []*session.Session{{ID: "a"}}
+	second := []*session.Session{{ID: "a"}, {ID: "b"}}
+
+	steps := []struct {
+		data     string
+		sessions []*session.Session
+	}{
+		{"session a joined", first},
+		{"operator joined", first},
+		{"session b joined", second},
+		{"route added", second},
+	}
+
+	var times []time.Time
+	for _, step := range steps {
+		if err := service.Record(&events.Event{Type: events.OK, Data: step.data}, step.sessions, ""); err != nil {
+			t.Fatal(err)
+		}
+
+		records, _ := service.Records()
+		times = append(times, records[len(records)-1].Time)
+		time.Sleep(time.Millisecond)
+	}
+
+	var replayed []*Record
+	collect := func(record *Record) error {
+		replayed = append(replayed, record)
+		return nil
+	}
+
+	if err := service.Replay(context.Background(), time.Time{}, 1000, collect); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(replayed) != len(steps) {
+		t.Fatalf("got %d records, want %d", len(replayed), len(steps))
+	}
+
+	for i, snapshot := range []bool{true, false, true, false} {
+		if replayed[i].Data != steps[i].data {
+			t.Errorf("record %d is %q, want %q", i, replayed[i].Data, steps[i].data)
+		}
+		if replayed[i].Snapshot != snapshot {
+			t.Errorf("record %d snapshot = %v, want %v", i, replayed[i].Snapshot, snapshot)
+		}
+	}
+
+	// starting midway restores the sessions as of then first
+	replayed = nil
+	if err := service.Replay(context.Background(), times[1], 1000, collect); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(replayed) != 4 || !replayed[0].Snapshot || replayed[0].Data != "" || len(replayed[0].Sessions) != 1 {
+		t.Fatalf("unexpected replay from midway: %+v", replayed)
+	}
+	if replayed[1].Data != "operator joined" {
+		t.Errorf("replay resumed at %q", replayed[1].Data)
+	}
+}
+
+func TestActions(t *testing.T) {
+	service := newTestService(t)
+
+	start := time.Now()
+	for _, action := range []*Action{
+		NewAction("alice", "operator", "AddRoute", `{"SessionID":"a","Route":{"Cidr":"10.0.0.0/24"}}`, nil, ""),
+		NewAction("bob", "operator", "KillSession", `{"SessionID":"a"}`, nil, ""),
+		NewAction("bob", "read-only", "DelRoute", `{"SessionID":"b"}`, errors.New("access denied"), ""),
+	} {
+		if err := service.LogAction(action); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	tests := []struct {
+		filter  ActionFilter
+		methods []string
+	}{
+		{ActionFilter{}, []string{"DelRoute", "KillSession", "AddRoute"}},
+		{ActionFilter{Operator: "bob"}, []string{"DelRoute", "KillSession"}},
+		{ActionFilter{Method: "route"}, []string{"DelRoute", "AddRoute"}},
+		{ActionFilter{Query: "10.0.0.0"}, []string{"AddRoute"}},
+		{ActionFilter{Failed: true}, []string{"DelRoute"}},
+		{ActionFilter{Limit: 1}, []string{"DelRoute"}},
+		{ActionFilter{Since: time.Now()}, nil},
+		{ActionFilter{Until: start}, nil},
+	}
+
+	for _, test := range tests {
+		actions, err := service.Actions(test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var methods []string
+		for _, action := range actions {
+			methods = append(methods, action.Method)
+		}
+
+		if strings.Join(methods, ",") != strings.Join(test.methods, ",") {
+			t.Errorf("%+v: got %v, want %v", test.filter, methods, test.methods)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package certificate
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// Authority is an intermediate CA the server CA issued, typically one per engagement. Agent and operator
+// certificates issued from it carry it along, so revoking it refuses all of them at once
+type Authority struct {
+	Name       string
+	Engagement string // the engagement it issues for by default, empty if none
+	CreatedBy  string
+	CreatedAt  time.Time
+	Cert       *Certificate
+	Revoked    bool `json:"-"` // looked up whenever it's read
+}
+
+// GetName is empty for no authority, i.e. the server CA
+func (a *Authority) GetName() string {
+	if a == nil {
+		return ""
+	}
+
+	return a.Name
+}
+
+func (a *Authority) String() string {
+	return fmt.Sprintf("Name=%s Engagement=%s CreatedBy=%s", a.Name, a.Engagement, a.CreatedBy)
+}
+
+// Expires is when the intermediate CA expires, certificates it issued stop being valid along with it
+func (a *Authority) Expires() time.Time {
+	leaf, err := a.Cert.Leaf()
+	if err != nil {
+		return time.Time{}
+	}
+
+	return leaf.NotAfter
+}
+
+func (a *Authority) SerialNumber() string {
+	leaf, err := a.Cert.Leaf()
+	if err != nil {
+		return ""
+	}
+
+	return leaf.SerialNumber.String()
+}
+
+// Proto leaves the key out, it never leaves the server
+func (a *Authority) Proto() *pb.CA {
+	return &pb.CA{
+		Name:        a.Name,
+		Engagement:  a.Engagement,
+		CreatedBy:   a.CreatedBy,
+		CreatedAt:   a.CreatedAt.UnixNano(),
+		Certificate: a.Cert.Certificate,
+		Revoked:     a.Revoked,
+	}
+}
+
+func ProtoToAuthority(p *pb.CA) *Authority {
+	return &Authority{
+		Name:       p.Name,
+		Engagement: p.Engagement,
+		CreatedBy:  p.CreatedBy,
+		CreatedAt:  time.Unix(0, p.CreatedAt),
+		Cert:       &Certificate{Name: p.Name, Certificate: p.Certificate},
+		Revoked:    p.Revoked,
+	}
+}
@@ -2,8 +2,11 @@ package certificate
 
 import (
 	"crypto/sha1"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"time"
@@ -23,6 +26,23 @@ func (cert *Certificate) KeyPair() (tls.Certificate, error) {
 	return tls.X509KeyPair(cert.Certificate, cert.Key)
 }
 
+// ChainedKeyPair is KeyPair presenting the issuer along with the certificate, for peers that only know a pin of the
+// issuer's key
+func (cert *Certificate) ChainedKeyPair(issuer *Certificate) (tls.Certificate, error) {
+	keypair, err := cert.KeyPair()
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	block, _ := pem.Decode(issuer.Certificate)
+	if block == nil {
+		return tls.Certificate{}, errors.New("error parsing issuer certificate")
+	}
+	keypair.Certificate = append(keypair.Certificate, block.Bytes)
+
+	return keypair, nil
+}
+
 func (cert *Certificate) CertPool() (*x509.CertPool, error) {
 	certpool := x509.NewCertPool()
 	if ok := certpool.AppendCertsFromPEM(cert.Certificate); !ok {
@@ -32,6 +52,66 @@ func (cert *Certificate) CertPool() (*x509.CertPool, error) {
 	return certpool, nil
 }
 
+// Leaf parses the certificate, without the chain
+func (cert *Certificate) Leaf() (*x509.Certificate, error) {
+	block, _ := pem.Decode(cert.Certificate)
+	if block == nil {
+		return nil, errors.New("error parsing certificate")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// SPKI is the pin of the public key of the certificate, the base64 SHA-256 of its SubjectPublicKeyInfo as with HPKP.
+// It survives reissuing the certificate with the same key
+func (cert *Certificate) SPKI() (string, error) {
+	leaf, err := cert.Leaf()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:]), nil
+}
+
+// IssuedBy tells whether the certificate chains to one of the pool, through the intermediate CA it carries if any
+func (cert *Certificate) IssuedBy(pool *x509.CertPool) bool {
+	leaf, err := cert.Leaf()
+	if err != nil {
+		return false
+	}
+
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         pool,
+		Intermediates: cert.intermediates(),
+		CurrentTime:   leaf.NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err == nil
+}
+
+// intermediates are the certificates following the leaf in the PEM
+func (cert *Certificate) intermediates() *x509.CertPool {
+	pool := x509.NewCertPool()
+
+	rest := cert.Certificate
+	for first := true; ; first = false {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return pool
+		}
+
+		if first {
+			continue
+		}
+
+		if intermediate, err := x509.ParseCertificate(block.Bytes); err == nil {
+			pool.AddCert(intermediate)
+		}
+	}
+}
+
 func (cert *Certificate) ExpiryDate() time.Time {
 	keypair, err := cert.KeyPair()
 	if err != nil {
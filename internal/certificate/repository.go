@@ -5,10 +5,12 @@ import (
 )
 
 type CertificateRepository struct {
-	storage *storage.StoreInstance[Certificate]
+	storage     *storage.StoreInstance[Certificate]
+	authorities *storage.StoreInstance[Authority]
 }
 
 var table = "certificates"
+var authoritiesTable = "authorities"
 
 func NewCertificateRepository(store *storage.Store) (*CertificateRepository, error) {
 	storeInstance, err := storage.GetInstance[Certificate](store, table)
@@ -16,8 +18,14 @@ func NewCertificateRepository(store *storage.Store) (*CertificateRepository, err
 		return nil, err
 	}
 
+	authorities, err := storage.GetInstance[Authority](store, authoritiesTable)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CertificateRepository{
-		storage: storeInstance,
+		storage:     storeInstance,
+		authorities: authorities,
 	}, nil
 }
 
@@ -41,3 +49,20 @@ func (repo *CertificateRepository) Save(cert *Certificate) error {
 func (repo *CertificateRepository) Remove(key string) error {
 	return repo.storage.Del(key)
 }
+
+func (repo *CertificateRepository) GetAuthority(name string) *Authority {
+	result, err := repo.authorities.Get(name)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
+
+func (repo *CertificateRepository) GetAuthorities() ([]*Authority, error) {
+	return repo.authorities.GetAll()
+}
+
+func (repo *CertificateRepository) SaveAuthority(authority *Authority) error {
+	return repo.authorities.Set(authority.Name, authority)
+}
@@ -12,11 +12,19 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
 )
 
+// crlValidity is how long a CRL is good for, a new one is generated whenever it's asked for
+const crlValidity = 7 * 24 * time.Hour
+
+// defaultValidity is how long server certificates, and certificates issued without a validity, are good for
+const defaultValidity = 10 * 365 * 24 * time.Hour
+
 type CertificateService struct {
 	caName           string
 	operatorCertName string
@@ -83,6 +91,116 @@ func (cs *CertificateService) RegenerateCert(name string) (*Certificate, error)
 	return cert, nil
 }
 
+// RotateServerCerts reissues the operator and agent server certificates from the existing CA, so neither
+// deployed agents nor operator configs need to change. The agent server certificate keeps its key, agents built with
+// SPKI pinning check it. The old certificates are revoked, agents built from then on refuse them
+func (cs *CertificateService) RotateServerCerts() error {
+	previous := []*Certificate{cs.GetOperatorServerCert(), cs.GetAgentServerCert()}
+
+	if _, err := cs.RegenerateCert(cs.operatorCertName); err != nil {
+		return fmt.Errorf("could not rotate %s: %w", cs.operatorCertName, err)
+	}
+
+	if err := cs.reissueCert(cs.agentCertName); err != nil {
+		return fmt.Errorf("could not rotate %s: %w", cs.agentCertName, err)
+	}
+
+	for _, cert := range previous {
+		if cert == nil {
+			continue
+		}
+
+		leaf, err := cert.Leaf()
+		if err != nil {
+			return err
+		}
+
+		if err := cs.crl.RevokeCertificate(leaf, "rotated"); err != nil {
+			return fmt.Errorf("could not revoke previous %s: %w", cert.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// reissueCert renews a certificate from the CA with its current key
+func (cs *CertificateService) reissueCert(name string) error {
+	current := cs.repo.GetOne(name)
+	if current == nil {
+		return fmt.Errorf("certificate not found")
+	}
+
+	block, _ := pem.Decode(current.Key)
+	if block == nil {
+		return fmt.Errorf("error parsing key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	CACert := cs.repo.GetOne(cs.caName)
+	if CACert == nil {
+		return fmt.Errorf("CA certificate not found")
+	}
+
+	cert, err := cs.issueCert(name, CACert, key, defaultValidity)
+	if err != nil {
+		return err
+	}
+
+	return cs.Save(name, cert)
+}
+
+// Reissue renews a certificate issued by the CA, or one of its intermediate CAs, for that long from now, with the
+// same name, key and issuer so whoever holds the key only needs the new certificate. The certificate being renewed
+// stays valid until it expires or is revoked
+func (cs *CertificateService) Reissue(cert *Certificate, validity time.Duration) (*Certificate, error) {
+	block, _ := pem.Decode(cert.Key)
+	if block == nil {
+		return nil, fmt.Errorf("error parsing key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	CACert := cs.repo.GetOne(cs.caName)
+	if CACert == nil {
+		return nil, fmt.Errorf("CA certificate not found")
+	}
+
+	pool, err := CACert.CertPool()
+	if err != nil {
+		return nil, err
+	}
+	if !cert.IssuedBy(pool) {
+		return nil, fmt.Errorf("certificate was not issued by this CA")
+	}
+
+	leaf, err := cert.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	authority := cs.AuthorityOf(leaf)
+	if authority == nil {
+		return cs.issueCert(cert.Name, CACert, key, validity)
+	}
+
+	if authority.Revoked {
+		return nil, fmt.Errorf("CA '%s' that issued the certificate is revoked", authority.Name)
+	}
+
+	renewed, err := cs.issueCert(cert.Name, authority.Cert, key, validity)
+	if err != nil {
+		return nil, err
+	}
+	renewed.Certificate = append(renewed.Certificate, authority.Cert.Certificate...)
+
+	return renewed, nil
+}
+
 func (cs *CertificateService) Init() error {
 	var err error
 	var CAcert *Certificate
@@ -143,7 +261,7 @@ func (cs *CertificateService) GenerateCA(name string) (*Certificate, error) {
 		NotAfter:              time.Now().AddDate(10, 0, 0),
 		IsCA:                  true,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 	}
 
@@ -184,6 +302,20 @@ func (cs *CertificateService) GenerateCA(name string) (*Certificate, error) {
 }
 
 func (cs *CertificateService) GenerateCert(name string, CAcert *Certificate) (*Certificate, error) {
+	return cs.GenerateCertFor(name, CAcert, defaultValidity)
+}
+
+// GenerateCertFor is GenerateCert with a certificate expiring after validity
+func (cs *CertificateService) GenerateCertFor(name string, CAcert *Certificate, validity time.Duration) (*Certificate, error) {
+	certPrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return cs.issueCert(name, CAcert, certPrivKey, validity)
+}
+
+func (cs *CertificateService) issueCert(name string, CAcert *Certificate, certPrivKey *ecdsa.PrivateKey, validity time.Duration) (*Certificate, error) {
 	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
@@ -197,17 +329,12 @@ func (cs *CertificateService) GenerateCert(name string, CAcert *Certificate) (*C
 		},
 		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
 		NotBefore:    time.Now(),
-		NotAfter:     time.Now().AddDate(10, 0, 0),
+		NotAfter:     time.Now().Add(validity),
 		SubjectKeyId: []byte{1, 2, 3, 4, 6},
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
 		KeyUsage:     x509.KeyUsageDigitalSignature,
 	}
 
-	certPrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		return nil, err
-	}
-
 	caBytes, _ := pem.Decode(CAcert.Certificate)
 	ca, err := x509.ParseCertificate(caBytes.Bytes)
 	if err != nil {
@@ -255,9 +382,358 @@ func (cs *CertificateService) Thumbprint(rawCert []byte) [sha1.Size]byte {
 }
 
 func (cs *CertificateService) Revoke(cert *Certificate, reason string) error {
-	return cs.crl.Revoke(cert.Thumbprint, reason)
+	leaf, err := cert.Leaf()
+	if err != nil {
+		return cs.crl.Revoke(cert.Thumbprint, reason)
+	}
+
+	return cs.RevokeX509(leaf, reason)
+}
+
+// RevokeX509 revokes a certificate issued by the CA or one of its intermediate CAs, e.g. the one an agent connected with. The CA and the server
+// certificates can't be, they are rotated instead
+func (cs *CertificateService) RevokeX509(cert *x509.Certificate, reason string) error {
+	for _, own := range []*Certificate{cs.GetCA(), cs.GetOperatorServerCert(), cs.GetAgentServerCert()} {
+		if own != nil && own.Thumbprint == cs.Thumbprint(cert.Raw) {
+			return fmt.Errorf("%s can't be revoked, rotate it instead", own.Name)
+		}
+	}
+
+	CACert, err := cs.GetCA().Leaf()
+	if err != nil {
+		return err
+	}
+
+	if err := cert.CheckSignatureFrom(CACert); err != nil && cs.AuthorityOf(cert) == nil {
+		return fmt.Errorf("certificate wasn't issued by the CA or one of its intermediate CAs: %w", err)
+	}
+
+	return cs.crl.RevokeCertificate(cert, reason)
+}
+
+// Unrevoke lets a revoked certificate in again, hash being the hex SHA-1 thumbprint it's listed with
+func (cs *CertificateService) Unrevoke(hash string) (*crl.RevokedCertificate, error) {
+	return cs.crl.Unrevoke(hash)
+}
+
+func (cs *CertificateService) Revoked() ([]*crl.RevokedCertificate, error) {
+	return cs.crl.GetAll()
+}
+
+// CRL is the DER X.509 CRL of the revoked certificates, signed by the CA
+func (cs *CertificateService) CRL() ([]byte, error) {
+	CACert := cs.GetCA()
+	if CACert == nil {
+		return nil, fmt.Errorf("CA certificate not found")
+	}
+
+	ca, err := CACert.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(CACert.Key)
+	if block == nil {
+		return nil, fmt.Errorf("error parsing CA key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := *ca
+	issuer.KeyUsage |= x509.KeyUsageCRLSign // CAs issued before CRLs were generated lack it
+
+	return cs.crl.Generate(&issuer, key, crlValidity)
 }
 
 func (cs *CertificateService) IsRevoked(cert *x509.Certificate) bool {
 	return cs.crl.IsRevoked(cs.Thumbprint(cert.Raw))
 }
+
+// NewAuthority issues an intermediate CA from the server CA. Only one that isn't revoked can be tied to an
+// engagement, it's the one issuing for that engagement by default
+func (cs *CertificateService) NewAuthority(name string, engagement string, createdBy string) (*Authority, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("CA name is empty")
+	}
+
+	if cs.repo.GetAuthority(name) != nil {
+		return nil, fmt.Errorf("CA '%s' already exists", name)
+	}
+
+	if engagement != "" {
+		if current := cs.AuthorityFor(engagement); current != nil {
+			return nil, fmt.Errorf("engagement '%s' already has CA '%s', revoke it first", engagement, current.Name)
+		}
+	}
+
+	CACert := cs.GetCA()
+	if CACert == nil {
+		return nil, fmt.Errorf("CA certificate not found")
+	}
+
+	cert, err := cs.generateIntermediate(name, CACert)
+	if err != nil {
+		return nil, err
+	}
+
+	authority := &Authority{
+		Name:       name,
+		Engagement: engagement,
+		CreatedBy:  createdBy,
+		CreatedAt:  time.Now(),
+		Cert:       cert,
+	}
+
+	return authority, cs.repo.SaveAuthority(authority)
+}
+
+func (cs *CertificateService) GetAuthority(name string) *Authority {
+	authority := cs.repo.GetAuthority(name)
+	if authority != nil {
+		authority.Revoked = cs.isAuthorityRevoked(authority)
+	}
+
+	return authority
+}
+
+// Authorities returns the intermediate CAs, oldest first, revoked ones included
+func (cs *CertificateService) Authorities() ([]*Authority, error) {
+	result, err := cs.repo.GetAuthorities()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, authority := range result {
+		authority.Revoked = cs.isAuthorityRevoked(authority)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
+
+// AuthorityFor is the intermediate CA issuing for an engagement, nil if it has none that isn't revoked
+func (cs *CertificateService) AuthorityFor(engagement string) *Authority {
+	authorities, err := cs.Authorities()
+	if err != nil {
+		return nil
+	}
+
+	for _, authority := range authorities {
+		if authority.Engagement == engagement && !authority.Revoked {
+			return authority
+		}
+	}
+
+	return nil
+}
+
+// RevokeAuthority revokes an intermediate CA, and with it every certificate it issued. It stays listed, a new one
+// can be tied to its engagement
+func (cs *CertificateService) RevokeAuthority(name string, reason string) (*Authority, error) {
+	authority := cs.GetAuthority(name)
+	if authority == nil {
+		return nil, fmt.Errorf("CA '%s' not found", name)
+	}
+
+	if authority.Revoked {
+		return nil, fmt.Errorf("CA '%s' is already revoked", name)
+	}
+
+	leaf, err := authority.Cert.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cs.crl.RevokeCertificate(leaf, reason); err != nil {
+		return nil, err
+	}
+	authority.Revoked = true
+
+	return authority, nil
+}
+
+func (cs *CertificateService) isAuthorityRevoked(authority *Authority) bool {
+	leaf, err := authority.Cert.Leaf()
+	if err != nil {
+		return false
+	}
+
+	return cs.IsRevoked(leaf)
+}
+
+// AuthorityOf is the intermediate CA that issued a certificate, nil if the server CA did or it's unknown
+func (cs *CertificateService) AuthorityOf(cert *x509.Certificate) *Authority {
+	authorities, err := cs.Authorities()
+	if err != nil {
+		return nil
+	}
+
+	for _, authority := range authorities {
+		issuer, err := authority.Cert.Leaf()
+		if err != nil {
+			continue
+		}
+
+		if cert.CheckSignatureFrom(issuer) == nil {
+			return authority
+		}
+	}
+
+	return nil
+}
+
+// Issue generates a certificate from an intermediate CA, or from the server CA if authority is nil, for the default
+// validity if none is given. The intermediate comes along in the PEM, so that whoever presents the certificate
+// presents the chain
+func (cs *CertificateService) Issue(name string, authority *Authority, validity time.Duration) (*Certificate, error) {
+	if validity <= 0 {
+		validity = defaultValidity
+	}
+
+	if authority == nil {
+		CACert := cs.GetCA()
+		if CACert == nil {
+			return nil, fmt.Errorf("CA certificate not found")
+		}
+
+		return cs.GenerateCertFor(name, CACert, validity)
+	}
+
+	if authority.Revoked || cs.isAuthorityRevoked(authority) {
+		return nil, fmt.Errorf("CA '%s' is revoked", authority.Name)
+	}
+
+	cert, err := cs.GenerateCertFor(name, authority.Cert, validity)
+	if err != nil {
+		return nil, err
+	}
+	cert.Certificate = append(cert.Certificate, authority.Cert.Certificate...)
+
+	return cert, nil
+}
+
+// VerifyPeer checks the chain a peer presented in a TLS handshake: it has to lead to the server CA, through an
+// intermediate CA if the peer sent one, and nothing along it may be revoked
+func (cs *CertificateService) VerifyPeer(rawCerts [][]byte, roots *x509.CertPool) (*x509.Certificate, error) {
+	if roots == nil {
+		return nil, fmt.Errorf("no root certificate")
+	}
+
+	if len(rawCerts) == 0 {
+		return nil, fmt.Errorf("no certificate")
+	}
+
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, raw := range rawCerts[1:] {
+		intermediate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, err
+		}
+		intermediates.AddCert(intermediate)
+	}
+
+	chains, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cs.IsChainRevoked(chains[0]) {
+		return nil, fmt.Errorf("certificate has been revoked")
+	}
+
+	return cert, nil
+}
+
+// IsChainRevoked tells whether a certificate or any intermediate CA it chains through is revoked
+func (cs *CertificateService) IsChainRevoked(chain []*x509.Certificate) bool {
+	for _, cert := range chain {
+		if cs.IsRevoked(cert) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cs *CertificateService) generateIntermediate(name string, CAcert *Certificate) (*Certificate, error) {
+	parent, err := CAcert.Leaf()
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(CAcert.Key)
+	if block == nil {
+		return nil, fmt.Errorf("error parsing CA key")
+	}
+	parentKey, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	ca := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: name,
+		},
+		NotBefore:             time.Now(),
+		NotAfter:              parent.NotAfter,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+
+	caPrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	caBytes, err := x509.CreateCertificate(rand.Reader, ca, parent, &caPrivKey.PublicKey, parentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	caPEM := new(bytes.Buffer)
+	pem.Encode(caPEM, &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: caBytes,
+	})
+
+	caPrivKeyPEM := new(bytes.Buffer)
+	caPrivKeyx509, err := x509.MarshalECPrivateKey(caPrivKey)
+	if err != nil {
+		return nil, err
+	}
+	pem.Encode(caPrivKeyPEM, &pem.Block{
+		Type:  "ECDSA PRIVATE KEY",
+		Bytes: caPrivKeyx509,
+	})
+
+	return &Certificate{
+		Name:        name,
+		Certificate: caPEM.Bytes(),
+		Key:         caPrivKeyPEM.Bytes(),
+		Thumbprint:  cs.Thumbprint(caBytes),
+	}, nil
+}
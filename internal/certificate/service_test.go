@@ -0,0 +1,192 @@
+package certificate
+
+import (
+	"bytes"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+func newTestService(t *testing.T) *CertificateService {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	repo, err := NewCertificateRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlRepo, err := crl.NewCRLRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewCertificateService(repo, crl.NewCRLService(crlRepo))
+	if err := service.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	return service
+}
+
+func TestCRL(t *testing.T) {
+	service := newTestService(t)
+
+	cert, err := service.GenerateCert("agent", service.GetCA())
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := cert.Leaf()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.RevokeX509(leaf, "lost"); err != nil {
+		t.Fatal(err)
+	}
+	if !service.IsRevoked(leaf) {
+		t.Fatal("certificate should be revoked")
+	}
+
+	der, err := service.CRL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := x509.ParseRevocationList(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ca, err := service.GetCA().Leaf()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := list.CheckSignatureFrom(ca); err != nil {
+		t.Fatalf("CRL isn't signed by the CA: %s", err)
+	}
+	if len(list.RevokedCertificateEntries) != 1 || list.RevokedCertificateEntries[0].SerialNumber.Cmp(leaf.SerialNumber) != 0 {
+		t.Fatalf("CRL should list the revoked certificate, got %v", list.RevokedCertificateEntries)
+	}
+
+	revoked, err := service.Revoked()
+	if err != nil || len(revoked) != 1 {
+		t.Fatalf("expected one revoked certificate, got %v (%v)", revoked, err)
+	}
+	if _, err := service.Unrevoke(revoked[0].Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if service.IsRevoked(leaf) {
+		t.Fatal("certificate should no longer be revoked")
+	}
+}
+
+func TestRevokeOwn(t *testing.T) {
+	service := newTestService(t)
+
+	for _, own := range []*Certificate{service.GetCA(), service.GetOperatorServerCert(), service.GetAgentServerCert()} {
+		leaf, err := own.Leaf()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := service.RevokeX509(leaf, "test"); err == nil {
+			t.Errorf("%s shouldn't be revocable", own.Name)
+		}
+	}
+}
+
+func TestReissue(t *testing.T) {
+	service := newTestService(t)
+
+	cert, err := service.GenerateCertFor("operator", service.GetCA(), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renewed, err := service.Reissue(cert, 48*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(renewed.Key, cert.Key) {
+		t.Fatal("renewed certificate should keep the key")
+	}
+	if !renewed.ExpiryDate().After(cert.ExpiryDate().Add(24 * time.Hour)) {
+		t.Fatalf("renewed certificate expires %s, should be later than %s", renewed.ExpiryDate(), cert.ExpiryDate())
+	}
+
+	if _, err := renewed.KeyPair(); err != nil {
+		t.Fatalf("renewed certificate doesn't match its key: %s", err)
+	}
+
+	other := newTestService(t)
+	if _, err := other.Reissue(cert, time.Hour); err == nil {
+		t.Fatal("certificates of another CA shouldn't be reissued")
+	}
+}
+
+func TestAuthority(t *testing.T) {
+	service := newTestService(t)
+
+	authority, err := service.NewAuthority("op-red", "red", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if service.AuthorityFor("red") == nil {
+		t.Fatal("engagement should have its CA")
+	}
+	if _, err := service.NewAuthority("op-red-2", "red", "admin"); err == nil {
+		t.Fatal("engagement shouldn't get a second CA while its first isn't revoked")
+	}
+
+	cert, err := service.Issue("agent", authority, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keypair, err := cert.KeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keypair.Certificate) != 2 {
+		t.Fatalf("certificate should carry the intermediate CA, got %d certificates", len(keypair.Certificate))
+	}
+
+	roots, err := service.GetCA().CertPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.VerifyPeer(keypair.Certificate, roots); err != nil {
+		t.Fatalf("chain should verify: %s", err)
+	}
+	if _, err := service.VerifyPeer(keypair.Certificate[:1], roots); err == nil {
+		t.Fatal("certificate shouldn't verify without its intermediate CA")
+	}
+
+	renewed, err := service.Reissue(cert, 48*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !renewed.IssuedBy(roots) {
+		t.Fatal("renewed certificate should still chain to the CA")
+	}
+
+	if _, err := service.RevokeAuthority("op-red", "op over"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.VerifyPeer(keypair.Certificate, roots); err == nil {
+		t.Fatal("certificates of a revoked CA shouldn't verify")
+	}
+	if _, err := service.Issue("agent", service.GetAuthority("op-red"), time.Hour); err == nil {
+		t.Fatal("a revoked CA shouldn't issue")
+	}
+	if service.AuthorityFor("red") != nil {
+		t.Fatal("a revoked CA shouldn't issue for its engagement")
+	}
+}
@@ -5,18 +5,71 @@ import (
 	"os/user"
 	"path"
 	"path/filepath"
+	"time"
 
+	"github.com/ttpreport/ligolo-mp/v2/internal/fips"
 	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
 )
 
 type Config struct {
-	Environment          string
-	Verbose              bool
-	ListenInterface      string
-	MaxInFlight          int
-	MaxConnectionHandler int
-	OperatorAddr         string
-	InsecureAgents       bool
+	Environment            string
+	Verbose                bool
+	ListenInterface        string
+	AgentTransport         string
+	AgentProxyProtocol     bool
+	MuxKeepAlive           time.Duration // how often sessions are pinged, 0 tunes it to the link
+	MuxWriteTimeout        time.Duration // a ping or a write to an agent taking longer drops the session
+	MuxMaxStreams          int           // streams a session may have open at once before those the agent opens are refused, 0 for no limit
+	DeadSessionWindow      time.Duration // sessions the agent says nothing on for that long are dropped, 0 disables it
+	MaxInFlight            int
+	MaxConnectionHandler   int
+	OperatorAddr           string
+	OperatorCertValidity   time.Duration // how long operator certificates are issued for, 0 for the server certificates' 10 years
+	EnrollAddr             string        // address new operators redeem enrollment tokens on over TLS, disabled if empty
+	InsecureAgents         bool
+	ManageRoutes           bool
+	TCPSACK                bool
+	TCPWindowScaling       bool
+	TCPAutoTuning          bool
+	MaxClockSkew           time.Duration
+	OperatorRateLimit      float64
+	MaxConcurrentBuilds    int
+	ConformanceMode        bool
+	MaxAttachmentSize      int64
+	SessionAttachmentQuota int64
+	GoRoot                 string
+	BuildContainer         string
+	AdminSocket            string
+	HooksFile              string
+	ProfilesFile           string
+	FIPS                   bool   // strict mode, the server requires a FIPS crypto module and only builds FIPS agents
+	CryptoMode             string // as reported by the server
+	Engagement             string // current engagement, as reported by the server
+	WakeDomain             string // delegated to the server, sleeping agents resolve <token>.<domain> to learn about wake ups
+	WakeAddr               string
+	WakeCheck              time.Duration // how often sleeping agents resolve their wake hostname
+	AssetsQuota            int64         // build caches get evicted above it, 0 disables eviction
+	AssetsGCInterval       time.Duration
+	FlowLog                bool // one JSON line per relayed connection
+	FlowLogFile            string
+	FlowLogMaxSize         int64 // the flow log is rotated above it
+	FlowLogKeep            int   // rotated flow logs kept around
+	EgressAlert            bool  // tell operators when an agent reconnects from a different address
+	AnomalyAlerts          bool  // tell operators when relayed traffic stands out from the session's baseline
+	AnomalyWindow          time.Duration
+	AnomalySpike           float64       // times the baseline a window has to move to be reported
+	AnomalyMinBytes        int64         // windows moving less are never reported
+	DecoyPage              string        // served over HTTP to clients of the agent listener without a certificate
+	DecoyServer            string        // Server header of decoy responses
+	CRLAddr                string        // address the CRL is served over HTTP on, disabled if empty
+	CRLPath                string        // URL path of the CRL on that address
+	TunError               string        // why TUN interfaces can't be created, relays are unavailable if set
+	Simulate               bool          // relays run over in-memory links instead of kernel TUN interfaces, see tun.Tun.Link
+	HAPeer                 string        // operator address of the other server of a pair, empty when not paired
+	HACredentials          string        // admin operator file the pair reaches each other with
+	HAPeerAgents           string        // agent servers of the other server, added to every build so agents fail over to it
+	HAInterval             time.Duration // how often the standby copies the state of the active server
+	HAFailoverAfter        time.Duration // the standby takes over once the active server didn't answer for that long
 }
 
 func (cfg *Config) GetRootAppDir() string {
@@ -42,6 +95,37 @@ func (cfg *Config) GetAssetsDir() string {
 	return dir
 }
 
+func (cfg *Config) GetAdminSocket() string {
+	if cfg.AdminSocket != "" {
+		return cfg.AdminSocket
+	}
+	return path.Join(cfg.GetRootAppDir(), "admin.sock")
+}
+
+// GetHooksFile is the JSON file declaring post-build hooks, see asset.hookConfig
+func (cfg *Config) GetHooksFile() string {
+	if cfg.HooksFile != "" {
+		return cfg.HooksFile
+	}
+	return path.Join(cfg.GetRootAppDir(), "hooks.json")
+}
+
+// GetProfilesFile is the YAML or JSON file declaring malleable transport profiles, see malleable.Load
+func (cfg *Config) GetProfilesFile() string {
+	if cfg.ProfilesFile != "" {
+		return cfg.ProfilesFile
+	}
+	return path.Join(cfg.GetRootAppDir(), "profiles.yaml")
+}
+
+// GetFlowLogFile is where relayed connections are written to, see flowlog.Writer
+func (cfg *Config) GetFlowLogFile() string {
+	if cfg.FlowLogFile != "" {
+		return cfg.FlowLogFile
+	}
+	return path.Join(cfg.GetRootAppDir(), "flows.jsonl")
+}
+
 func (cfg *Config) GetStorageDir() string {
 	dir := path.Join(cfg.GetRootAppDir(), "storage")
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
@@ -57,6 +141,9 @@ func (cfg *Config) Proto() *pb.Config {
 	return &pb.Config{
 		OperatorServer: cfg.OperatorAddr,
 		AgentServer:    cfg.ListenInterface,
+		CryptoMode:     fips.Mode(),
+		TunError:       cfg.TunError,
+		Peer:           cfg.HAPeer,
 	}
 }
 
@@ -64,5 +151,9 @@ func ProtoToConfig(p *pb.Config) *Config {
 	return &Config{
 		OperatorAddr:    p.OperatorServer,
 		ListenInterface: p.AgentServer,
+		CryptoMode:      p.CryptoMode,
+		Engagement:      p.Engagement,
+		TunError:        p.TunError,
+		HAPeer:          p.Peer,
 	}
 }
@@ -0,0 +1,244 @@
+package conformance
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+const (
+	throughputSize = 64 * 1024
+	checkTimeout   = 10 * time.Second
+)
+
+// Result is the outcome of a single conformance check
+type Result struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+func (r Result) Passed() bool {
+	return r.Err == nil
+}
+
+type check struct {
+	name string
+	run  func(t *tester) error
+}
+
+// Checks run in order, later ones use the encoding negotiated by the first one.
+// Disconnect is never sent, it makes agents exit
+var checks = []check{
+	{"info exchange and encoding negotiation", checkInfo},
+	{"info exchange in negotiated encoding", checkInfoNegotiated},
+	{"host ping", checkPing},
+	{"connect to a closed port", checkConnectRefused},
+	{"throughput", checkThroughput},
+	{"redirector lifecycle", checkRedirector},
+}
+
+type tester struct {
+	multiplex *yamux.Session
+	encoding  uint8
+}
+
+// Run checks that the agent behind the multiplex speaks the session protocol as specified in doc/agent-protocol.md
+func Run(multiplex *yamux.Session) []Result {
+	t := &tester{multiplex: multiplex, encoding: protocol.EncodingGob}
+
+	var results []Result
+	for _, c := range checks {
+		start := time.Now()
+		err := c.run(t)
+		results = append(results, Result{Name: c.name, Err: err, Duration: time.Since(start)})
+	}
+
+	return results
+}
+
+// exchange sends one packet on a fresh stream and decodes the reply
+func (t *tester) exchange(encoding uint8, msgType uint8, payload interface{}, expected uint8) (protocol.Envelope, net.Conn, error) {
+	stream, err := t.multiplex.Open()
+	if err != nil {
+		return protocol.Envelope{}, nil, err
+	}
+	stream.SetDeadline(time.Now().Add(checkTimeout))
+
+	encoder := protocol.NewEncoder(stream)
+	encoder.SetEncoding(encoding)
+	if err := encoder.Encode(protocol.Envelope{Type: msgType, Payload: payload}); err != nil {
+		stream.Close()
+		return protocol.Envelope{}, nil, err
+	}
+
+	decoder := protocol.NewDecoder(stream)
+	if err := decodeSafely(&decoder); err != nil {
+		stream.Close()
+		return protocol.Envelope{}, nil, fmt.Errorf("could not decode reply: %w", err)
+	}
+
+	if decoder.Envelope.Type != expected {
+		stream.Close()
+		return protocol.Envelope{}, nil, fmt.Errorf("expected message type %d, got %d", expected, decoder.Envelope.Type)
+	}
+
+	return decoder.Envelope, stream, nil
+}
+
+// request is exchange for messages whose reply has to mirror the request encoding
+func (t *tester) request(encoding uint8, msgType uint8, payload interface{}, expected uint8) (protocol.Envelope, net.Conn, error) {
+	reply, stream, err := t.exchange(encoding, msgType, payload, expected)
+	if err != nil {
+		return reply, stream, err
+	}
+
+	if reply.Encoding != encoding {
+		stream.Close()
+		return protocol.Envelope{}, nil, fmt.Errorf("reply must use the request encoding %d, got %d", encoding, reply.Encoding)
+	}
+
+	return reply, stream, nil
+}
+
+// decodeSafely turns gob decoder panics on malformed payloads into errors
+func decodeSafely(decoder *protocol.LigoloDecoder) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed payload: %v", r)
+		}
+	}()
+
+	return decoder.Decode()
+}
+
+// checkInfo accepts a protobuf reply to the gob request, minimal agents skip the request payload
+func checkInfo(t *tester) error {
+	reply, stream, err := t.exchange(protocol.EncodingGob, protocol.MessageInfoRequest, protocol.InfoRequestPacket{Encodings: protocol.SupportedEncodings, Version: protocol.Version}, protocol.MessageInfoReply)
+	if err != nil {
+		return err
+	}
+	stream.Close()
+
+	info := reply.Payload.(protocol.InfoReplyPacket)
+	if info.Name == "" || info.Hostname == "" {
+		return errors.New("name and hostname must be set")
+	}
+
+	if !protocol.IsSupportedEncoding(info.Encoding) {
+		return fmt.Errorf("agent picked unsupported encoding %d", info.Encoding)
+	}
+	if reply.Encoding == protocol.EncodingProtobuf && info.Encoding != protocol.EncodingProtobuf {
+		return errors.New("agents replying in protobuf must negotiate protobuf")
+	}
+	t.encoding = info.Encoding
+
+	if _, err := protocol.NegotiateVersion(info.Protocol, info.MinProtocol); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func checkInfoNegotiated(t *tester) error {
+	_, stream, err := t.request(t.encoding, protocol.MessageInfoRequest, protocol.InfoRequestPacket{Encodings: protocol.SupportedEncodings}, protocol.MessageInfoReply)
+	if err != nil {
+		return err
+	}
+
+	return stream.Close()
+}
+
+func checkPing(t *tester) error {
+	_, stream, err := t.request(t.encoding, protocol.MessageHostPingRequest, protocol.HostPingRequestPacket{Address: "127.0.0.1"}, protocol.MessageHostPingResponse)
+	if err != nil {
+		return err
+	}
+
+	return stream.Close()
+}
+
+func checkConnectRefused(t *tester) error {
+	request := protocol.ConnectRequestPacket{
+		Net:       protocol.Networkv4,
+		Transport: protocol.TransportTCP,
+		Address:   "127.0.0.1",
+		Port:      1,
+	}
+
+	reply, stream, err := t.request(t.encoding, protocol.MessageConnectRequest, request, protocol.MessageConnectResponse)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	response := reply.Payload.(protocol.ConnectResponsePacket)
+	if response.Established {
+		return errors.New("connection to 127.0.0.1:1 should be refused, is something listening there?")
+	}
+
+	if !response.Reset {
+		return errors.New("refused connections must set Reset, the host responded")
+	}
+
+	return nil
+}
+
+func checkThroughput(t *tester) error {
+	reply, stream, err := t.request(t.encoding, protocol.MessageThroughputRequest, protocol.ThroughputRequestPacket{Size: throughputSize}, protocol.MessageThroughputResponse)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	response := reply.Payload.(protocol.ThroughputResponsePacket)
+	if response.Size != throughputSize {
+		return fmt.Errorf("expected %d bytes to be announced, got %d", throughputSize, response.Size)
+	}
+
+	received, err := io.CopyN(io.Discard, stream, response.Size)
+	if err != nil {
+		return fmt.Errorf("received %d of %d bytes: %w", received, response.Size, err)
+	}
+
+	return nil
+}
+
+func checkRedirector(t *tester) error {
+	id := "conformance"
+	request := protocol.RedirectorRequestPacket{
+		ID:      id,
+		Network: "tcp",
+		From:    "127.0.0.1:0",
+		To:      "127.0.0.1:1",
+	}
+
+	reply, stream, err := t.request(t.encoding, protocol.MessageRedirectorRequest, request, protocol.MessageRedirectorResponse)
+	if err != nil {
+		return err
+	}
+	stream.Close()
+
+	response := reply.Payload.(protocol.RedirectorResponsePacket)
+	if response.Err {
+		return fmt.Errorf("could not create redirector: %s", response.ErrString)
+	}
+
+	reply, stream, err = t.request(t.encoding, protocol.MessageRedirectorCloseRequest, protocol.RedirectorCloseRequestPacket{ID: id}, protocol.MessageRedirectorCloseResponse)
+	if err != nil {
+		return err
+	}
+	stream.Close()
+
+	closeResponse := reply.Payload.(protocol.RedirectorCloseResponsePacket)
+	if closeResponse.Err {
+		return fmt.Errorf("could not close redirector: %s", closeResponse.ErrString)
+	}
+
+	return nil
+}
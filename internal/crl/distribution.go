@@ -0,0 +1,61 @@
+package crl
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DistributionPoint serves the CRL over plain HTTP as RFC 5280 has it, the CRL is signed so nothing else protects it.
+// It is generated on every request, so a revocation shows right away
+type DistributionPoint struct {
+	listener net.Listener
+	server   *http.Server
+}
+
+func ListenDistributionPoint(address string, path string, generate func() ([]byte, error)) (*DistributionPoint, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		crl, err := generate()
+		if err != nil {
+			slog.Error("could not generate CRL", slog.Any("error", err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/pkix-crl")
+		w.Write(crl)
+	})
+
+	return &DistributionPoint{
+		listener: listener,
+		server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}, nil
+}
+
+func (dp *DistributionPoint) Serve() {
+	slog.Info("CRL distribution point started", slog.String("address", dp.listener.Addr().String()))
+
+	if err := dp.server.Serve(dp.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("CRL distribution point failed", slog.Any("error", err))
+	}
+}
+
+func (dp *DistributionPoint) Close() error {
+	return dp.server.Close()
+}
@@ -2,13 +2,20 @@ package crl
 
 import (
 	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
 )
 
 type RevokedCertificate struct {
-	Reason      string
-	Certificate []byte
-	Thumbprint  [sha1.Size]byte
+	Reason       string
+	Certificate  []byte
+	Thumbprint   [sha1.Size]byte
+	SerialNumber string    `json:",omitempty"` // decimal, empty for revocations predating the X.509 CRL, which it leaves out
+	Subject      string    `json:",omitempty"`
+	RevokedAt    time.Time `json:",omitempty"`
 }
 
 func (rc *RevokedCertificate) Hash() string {
@@ -18,3 +25,36 @@ func (rc *RevokedCertificate) Hash() string {
 func (rc *RevokedCertificate) String() string {
 	return fmt.Sprintf("Reason=%s", rc.Reason)
 }
+
+func (rc *RevokedCertificate) Proto() *pb.RevokedCert {
+	result := &pb.RevokedCert{
+		Hash:         rc.Hash(),
+		SerialNumber: rc.SerialNumber,
+		Subject:      rc.Subject,
+		Reason:       rc.Reason,
+	}
+
+	if !rc.RevokedAt.IsZero() {
+		result.RevokedAt = rc.RevokedAt.UnixNano()
+	}
+
+	return result
+}
+
+func ProtoToRevokedCertificate(p *pb.RevokedCert) *RevokedCertificate {
+	result := &RevokedCertificate{
+		SerialNumber: p.SerialNumber,
+		Subject:      p.Subject,
+		Reason:       p.Reason,
+	}
+
+	if thumbprint, err := hex.DecodeString(p.Hash); err == nil && len(thumbprint) == sha1.Size {
+		copy(result.Thumbprint[:], thumbprint)
+	}
+
+	if p.RevokedAt > 0 {
+		result.RevokedAt = time.Unix(0, p.RevokedAt)
+	}
+
+	return result
+}
@@ -1,7 +1,14 @@
 package crl
 
 import (
+	"crypto"
+	"crypto/rand"
 	"crypto/sha1"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
 )
 
 type CRLService struct {
@@ -18,9 +25,32 @@ func (cs *CRLService) Revoke(thumbprint [sha1.Size]byte, reason string) error {
 	return cs.repo.Save(&RevokedCertificate{
 		Thumbprint: thumbprint,
 		Reason:     reason,
+		RevokedAt:  time.Now(),
 	})
 }
 
+// RevokeCertificate is Revoke keeping what the X.509 CRL lists the certificate by
+func (cs *CRLService) RevokeCertificate(cert *x509.Certificate, reason string) error {
+	return cs.repo.Save(&RevokedCertificate{
+		Reason:       reason,
+		Certificate:  cert.Raw,
+		Thumbprint:   sha1.Sum(cert.Raw),
+		SerialNumber: cert.SerialNumber.String(),
+		Subject:      cert.Subject.CommonName,
+		RevokedAt:    time.Now(),
+	})
+}
+
+// Unrevoke lets a certificate in again, hash being its hex thumbprint
+func (cs *CRLService) Unrevoke(hash string) (*RevokedCertificate, error) {
+	revoked := cs.repo.GetOne(hash)
+	if revoked == nil {
+		return nil, fmt.Errorf("certificate '%s' is not revoked", hash)
+	}
+
+	return revoked, cs.repo.Remove(hash)
+}
+
 func (cs *CRLService) IsRevoked(thumbprint [sha1.Size]byte) bool {
 	crlItem := &RevokedCertificate{
 		Thumbprint: thumbprint,
@@ -32,3 +62,47 @@ func (cs *CRLService) IsRevoked(thumbprint [sha1.Size]byte) bool {
 
 	return false
 }
+
+// GetAll returns the revoked certificates, latest revocation first
+func (cs *CRLService) GetAll() ([]*RevokedCertificate, error) {
+	result, err := cs.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].RevokedAt.After(result[j].RevokedAt)
+	})
+
+	return result, nil
+}
+
+// Generate signs a DER X.509 CRL of the revoked certificates as the issuer, valid for validity. Revocations without
+// a serial number can't be listed, the server still refuses them by thumbprint
+func (cs *CRLService) Generate(issuer *x509.Certificate, key crypto.Signer, validity time.Duration) ([]byte, error) {
+	revoked, err := cs.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.RevocationList{
+		Number:     big.NewInt(now.UnixNano()), // only has to increase from one CRL to the next
+		ThisUpdate: now,
+		NextUpdate: now.Add(validity),
+	}
+
+	for _, cert := range revoked {
+		serial, ok := new(big.Int).SetString(cert.SerialNumber, 10)
+		if !ok {
+			continue
+		}
+
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: cert.RevokedAt,
+		})
+	}
+
+	return x509.CreateRevocationList(rand.Reader, template, issuer, key)
+}
@@ -0,0 +1,226 @@
+package declaration
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
+	"github.com/ttpreport/ligolo-mp/v2/internal/route"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"gopkg.in/yaml.v3"
+)
+
+// Declaration is the state a server should be in for an engagement, kept under version control and reconciled from
+// the admin console. It is written in YAML, JSON works as well being a subset of it
+type Declaration struct {
+	Engagements []Engagement `yaml:"engagements"`
+	Current     *string      `yaml:"current"` // engagement made current, empty for none, left as is if missing
+	Profiles    []Profile    `yaml:"profiles"`
+	Sessions    []Rule       `yaml:"sessions"`
+	Prune       bool         `yaml:"prune"` // remove what the declaration doesn't list, see Diff
+}
+
+type Engagement struct {
+	Name      string   `yaml:"name"`
+	Scope     []string `yaml:"scope"`
+	Start     string   `yaml:"start"` // engagement.DateLayout
+	End       string   `yaml:"end"`
+	Operators []string `yaml:"operators"`
+}
+
+type Profile struct {
+	Name   string  `yaml:"name"`
+	Routes []Route `yaml:"routes"`
+}
+
+type Route struct {
+	Cidr      string `yaml:"cidr"`
+	Metric    int    `yaml:"metric"`
+	Loopback  bool   `yaml:"loopback"`
+	Ports     string `yaml:"ports"`
+	Exclusion bool   `yaml:"exclusion"`
+}
+
+type Redirector struct {
+	Protocol string `yaml:"protocol"`
+	From     string `yaml:"from"`
+	To       string `yaml:"to"`
+	Via      string `yaml:"via"`    // name of the session the server forwards through, empty if the agent dials To
+	Bridge   bool   `yaml:"bridge"` // agents link to the server through it, with no destination, see session.Redirector
+}
+
+// Rule gives routes and redirectors to the sessions it matches
+type Rule struct {
+	Match       Match        `yaml:"match"`
+	Profile     string       `yaml:"profile"` // its routes are added to the rule's own
+	Routes      []Route      `yaml:"routes"`
+	Redirectors []Redirector `yaml:"redirectors"`
+}
+
+// Match selects sessions, empty fields match any session. Name and Platform are shell patterns
+type Match struct {
+	Name       string `yaml:"name"` // alias, or hostname if there's none
+	Hostname   string `yaml:"hostname"`
+	Platform   string `yaml:"platform"` // GOOS/GOARCH of the agent
+	Engagement string `yaml:"engagement"`
+}
+
+func Load(filename string) (*Declaration, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+func Parse(data []byte) (*Declaration, error) {
+	decl := &Declaration{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(decl); err != nil {
+		return nil, fmt.Errorf("invalid declaration: %v", err)
+	}
+
+	if err := decl.Validate(); err != nil {
+		return nil, err
+	}
+
+	return decl, nil
+}
+
+func (decl *Declaration) Validate() error {
+	engagements := make(map[string]bool)
+	for _, e := range decl.Engagements {
+		if engagements[e.Name] {
+			return fmt.Errorf("engagement '%s' is declared twice", e.Name)
+		}
+		engagements[e.Name] = true
+
+		if _, err := e.engagement(); err != nil {
+			return err
+		}
+	}
+
+	profiles := make(map[string]bool)
+	for _, p := range decl.Profiles {
+		if profiles[p.Name] {
+			return fmt.Errorf("profile '%s' is declared twice", p.Name)
+		}
+		profiles[p.Name] = true
+
+		if err := p.profile().Validate(); err != nil {
+			return fmt.Errorf("profile '%s': %v", p.Name, err)
+		}
+	}
+
+	for i, rule := range decl.Sessions {
+		for _, pattern := range []string{rule.Match.Name, rule.Match.Hostname, rule.Match.Platform} {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("session rule %d: %s is invalid pattern", i+1, pattern)
+			}
+		}
+
+		for _, r := range rule.Routes {
+			if _, err := route.NewRoute(r.Cidr, r.Metric, r.Loopback, r.Ports, r.Exclusion); err != nil {
+				return fmt.Errorf("session rule %d: route '%s': %v", i+1, r.Cidr, err)
+			}
+		}
+
+		for _, r := range rule.Redirectors {
+			if r.Bridge {
+				if r.Protocol != "tcp" && r.Protocol != "pipe" {
+					return fmt.Errorf("session rule %d: %s is invalid bridge protocol, expected tcp or pipe", i+1, r.Protocol)
+				}
+				if r.From == "" || r.To != "" || r.Via != "" {
+					return fmt.Errorf("session rule %d: bridges need from and nothing else", i+1)
+				}
+				continue
+			}
+
+			if r.Protocol != "tcp" && r.Protocol != "udp" {
+				return fmt.Errorf("session rule %d: %s is invalid redirector protocol, expected tcp or udp", i+1, r.Protocol)
+			}
+			if r.From == "" || r.To == "" {
+				return fmt.Errorf("session rule %d: redirectors need both from and to", i+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e Engagement) engagement() (*engagement.Engagement, error) {
+	result := &engagement.Engagement{
+		Name:      e.Name,
+		Scope:     e.Scope,
+		Operators: e.Operators,
+	}
+
+	var err error
+	if e.Start != "" {
+		if result.Start, err = time.Parse(engagement.DateLayout, e.Start); err != nil {
+			return nil, fmt.Errorf("engagement '%s': %s is invalid start day, expected %s", e.Name, e.Start, engagement.DateLayout)
+		}
+	}
+	if e.End != "" {
+		if result.End, err = time.Parse(engagement.DateLayout, e.End); err != nil {
+			return nil, fmt.Errorf("engagement '%s': %s is invalid end day, expected %s", e.Name, e.End, engagement.DateLayout)
+		}
+	}
+
+	return result, result.Validate()
+}
+
+func (p Profile) profile() *profile.Profile {
+	result := &profile.Profile{Name: p.Name}
+	for _, r := range p.Routes {
+		result.Routes = append(result.Routes, r.entry())
+	}
+
+	return result
+}
+
+func (r Route) entry() profile.Entry {
+	return profile.Entry{
+		Cidr:        r.Cidr,
+		Metric:      r.Metric,
+		IsLoopback:  r.Loopback,
+		Ports:       r.Ports,
+		IsExclusion: r.Exclusion,
+	}
+}
+
+// Matches tells whether the rule applies to a session
+func (m Match) Matches(sess *session.Session) bool {
+	for _, field := range [][2]string{
+		{m.Name, sess.GetName()},
+		{m.Hostname, sess.Hostname},
+		{m.Platform, sess.Platform},
+	} {
+		if field[0] == "" {
+			continue
+		}
+		if ok, _ := path.Match(field[0], field[1]); !ok {
+			return false
+		}
+	}
+
+	return m.Engagement == "" || m.Engagement == sess.Engagement
+}
+
+// canonicalCidr is how routes are told apart within a session
+func canonicalCidr(cidr string) string {
+	if _, network, err := net.ParseCIDR(strings.TrimSpace(cidr)); err == nil {
+		return network.String()
+	}
+
+	return cidr
+}
@@ -0,0 +1,103 @@
+package declaration
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+	"github.com/ttpreport/ligolo-mp/v2/internal/tun"
+	"github.com/ttpreport/ligolo-mp/v2/pkg/memstore"
+)
+
+const testDeclaration = `
+engagements:
+  - name: acme
+    scope: [10.0.0.0/8]
+    start: 2026-03-02
+    end: 2026-03-06
+current: acme
+profiles:
+  - name: dmz
+    routes:
+      - cidr: 10.1.0.0/16
+sessions:
+  - match: {name: "dc*", platform: "windows/*"}
+    profile: dmz
+    routes:
+      - cidr: 10.2.0.0/16
+        metric: 50
+    redirectors:
+      - {protocol: tcp, from: "0.0.0.0:8443", to: "10.1.0.5:443"}
+prune: true
+`
+
+func testSession(name string, platform string) *session.Session {
+	t, _ := tun.NewTun()
+	return &session.Session{
+		ID:          name,
+		Hostname:    name,
+		Platform:    platform,
+		Tun:         t,
+		Redirectors: memstore.NewSyncmap[string, session.Redirector](),
+	}
+}
+
+func TestParse(t *testing.T) {
+	if _, err := Parse([]byte(testDeclaration)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Parse([]byte("listeners:\n  - addr: 0.0.0.0:443\n")); err == nil {
+		t.Fatal("unknown keys should be refused")
+	}
+
+	if _, err := Parse([]byte("sessions:\n  - routes: [{cidr: 10.0.0.0/8, exclusion: true, loopback: true}]\n")); err == nil {
+		t.Fatal("invalid routes should be refused")
+	}
+
+	if _, err := Parse([]byte("sessions:\n  - redirectors: [{protocol: pipe, from: ligolo, to: 10.0.0.1:445, bridge: true}]\n")); err == nil {
+		t.Fatal("bridges with a destination should be refused")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	decl, err := Parse([]byte(testDeclaration))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dc := testSession("dc01", "windows/amd64")
+	dc.NewRoute("10.1.0.0/16", 0, false, "", false)
+	dc.NewRoute("10.2.0.0/16", 10, false, "", false)
+	dc.NewRoute("192.168.0.0/24", 0, false, "", false)
+	web := testSession("web01", "linux/amd64")
+	web.NewRoute("172.16.0.0/12", 0, false, "", false)
+
+	changes, err := decl.Diff(
+		[]*engagement.Engagement{{Name: "old", Current: true}},
+		[]*profile.Profile{{Name: "dmz", Routes: []profile.Entry{{Cidr: "10.1.0.0/16"}}}},
+		[]*session.Session{dc, web},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, change := range changes {
+		got = append(got, change.String())
+	}
+
+	expected := []string{
+		"+ engagement 'acme'",
+		"~ current engagement 'acme'",
+		"- route '192.168.0.0/24' with metric '0' on 'dc01'",
+		"+ redirector tcp '0.0.0.0:8443'-->'10.1.0.5:443' on 'dc01'",
+		"~ route '10.2.0.0/16' with metric '50' on 'dc01'",
+		"- engagement 'old'",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(got, "\n"))
+	}
+}
@@ -0,0 +1,257 @@
+package declaration
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+type Action string
+
+const (
+	ActionAdd    Action = "+"
+	ActionUpdate Action = "~"
+	ActionRemove Action = "-"
+)
+
+// Change is one step bringing the server to its declared state
+type Change struct {
+	Action     Action
+	Engagement *engagement.Engagement // set for engagement changes
+	Current    *string                // set when the current engagement changes, empty for none
+	Profile    *profile.Profile       // set for profile changes
+	Session    *session.Session       // set for route and redirector changes
+	Route      *profile.Entry
+	RouteID    string // route replaced or removed
+	Redirector *session.Redirector
+}
+
+func (c *Change) String() string {
+	switch {
+	case c.Engagement != nil:
+		return fmt.Sprintf("%s engagement '%s'", c.Action, c.Engagement.Name)
+	case c.Current != nil && *c.Current == "":
+		return fmt.Sprintf("%s no current engagement", c.Action)
+	case c.Current != nil:
+		return fmt.Sprintf("%s current engagement '%s'", c.Action, *c.Current)
+	case c.Profile != nil:
+		return fmt.Sprintf("%s route profile '%s' (%d routes)", c.Action, c.Profile.Name, len(c.Profile.Routes))
+	case c.Route != nil:
+		return fmt.Sprintf("%s route '%s' with metric '%d' on '%s'", c.Action, c.Route.Cidr, c.Route.Metric, c.Session.GetName())
+	case c.Redirector != nil && c.Redirector.Bridge:
+		return fmt.Sprintf("%s bridge %s '%s' on '%s'", c.Action, c.Redirector.Protocol, c.Redirector.From, c.Session.GetName())
+	case c.Redirector != nil:
+		return fmt.Sprintf("%s redirector %s '%s'-->'%s' on '%s'", c.Action, c.Redirector.Protocol, c.Redirector.From, c.Redirector.To, c.Session.GetName())
+	}
+
+	return string(c.Action)
+}
+
+// Diff lists the changes turning the given state into the declared one. Engagements and profiles come first, since
+// routes depend on them, and removals come before additions.
+//
+// Sessions matched by no rule are left alone. Without Prune, nothing is removed but the routes a declared route
+// replaces; with it, undeclared engagements, profiles, and the routes and redirectors of matched sessions go too
+func (decl *Declaration) Diff(engagements []*engagement.Engagement, profiles []*profile.Profile, sessions []*session.Session) ([]*Change, error) {
+	var changes, pruned []*Change
+
+	existingEngagements := make(map[string]*engagement.Engagement)
+	for _, e := range engagements {
+		existingEngagements[e.Name] = e
+	}
+
+	declaredEngagements := make(map[string]bool)
+	for _, declared := range decl.Engagements {
+		e, err := declared.engagement()
+		if err != nil {
+			return nil, err
+		}
+		declaredEngagements[e.Name] = true
+
+		existing, found := existingEngagements[e.Name]
+		switch {
+		case !found:
+			changes = append(changes, &Change{Action: ActionAdd, Engagement: e})
+		case !sameEngagement(existing, e):
+			changes = append(changes, &Change{Action: ActionUpdate, Engagement: e})
+		}
+	}
+
+	for _, e := range engagements {
+		if decl.Prune && !declaredEngagements[e.Name] {
+			pruned = append(pruned, &Change{Action: ActionRemove, Engagement: e})
+		}
+	}
+
+	if decl.Current != nil {
+		current := ""
+		for _, e := range engagements {
+			if e.Current {
+				current = e.Name
+			}
+		}
+
+		if *decl.Current != "" && !declaredEngagements[*decl.Current] && existingEngagements[*decl.Current] == nil {
+			return nil, fmt.Errorf("current engagement '%s' is neither declared nor on the server", *decl.Current)
+		}
+
+		if *decl.Current != current {
+			changes = append(changes, &Change{Action: ActionUpdate, Current: decl.Current})
+		}
+	}
+
+	existingProfiles := make(map[string]*profile.Profile)
+	for _, p := range profiles {
+		existingProfiles[p.Name] = p
+	}
+
+	declaredProfiles := make(map[string]*profile.Profile)
+	for _, declared := range decl.Profiles {
+		p := declared.profile()
+		declaredProfiles[p.Name] = p
+
+		existing, found := existingProfiles[p.Name]
+		switch {
+		case !found:
+			changes = append(changes, &Change{Action: ActionAdd, Profile: p})
+		case !slices.Equal(existing.Routes, p.Routes):
+			changes = append(changes, &Change{Action: ActionUpdate, Profile: p})
+		}
+	}
+
+	for _, p := range profiles {
+		if decl.Prune && declaredProfiles[p.Name] == nil {
+			pruned = append(pruned, &Change{Action: ActionRemove, Profile: p})
+		}
+	}
+
+	for _, sess := range sessions {
+		sessionChanges, err := decl.diffSession(sess, sessions, declaredProfiles, existingProfiles)
+		if err != nil {
+			return nil, fmt.Errorf("session '%s': %v", sess.GetName(), err)
+		}
+		changes = append(changes, sessionChanges...)
+	}
+
+	return append(changes, pruned...), nil
+}
+
+func (decl *Declaration) diffSession(sess *session.Session, sessions []*session.Session, declaredProfiles map[string]*profile.Profile, existingProfiles map[string]*profile.Profile) ([]*Change, error) {
+	routes := make(map[string]profile.Entry)
+	redirectors := make(map[string]session.Redirector)
+	matched := false
+
+	for _, rule := range decl.Sessions {
+		if !rule.Match.Matches(sess) {
+			continue
+		}
+		matched = true
+
+		var entries []profile.Entry
+		if rule.Profile != "" {
+			p := declaredProfiles[rule.Profile]
+			if p == nil {
+				p = existingProfiles[rule.Profile]
+			}
+			if p == nil {
+				return nil, fmt.Errorf("route profile '%s' is neither declared nor on the server", rule.Profile)
+			}
+			entries = append(entries, p.Routes...)
+		}
+		for _, r := range rule.Routes {
+			entries = append(entries, r.entry())
+		}
+
+		for _, entry := range entries {
+			cidr := canonicalCidr(entry.Cidr)
+			if previous, found := routes[cidr]; found && previous != entry {
+				return nil, fmt.Errorf("route '%s' is declared twice with different settings", cidr)
+			}
+			routes[cidr] = entry
+		}
+
+		for _, r := range rule.Redirectors {
+			redirector := session.Redirector{Protocol: r.Protocol, From: r.From, To: r.To, Bridge: r.Bridge}
+			if r.Via != "" {
+				via := sessionByName(sessions, r.Via)
+				if via == nil {
+					return nil, fmt.Errorf("redirector '%s'-->'%s' goes through '%s', which is not a session", r.From, r.To, r.Via)
+				}
+				redirector.Via = via.ID
+			}
+			redirector.ID = redirector.Hash()
+			redirectors[redirector.ID] = redirector
+		}
+	}
+
+	if !matched {
+		return nil, nil
+	}
+
+	var removed, added []*Change
+
+	for _, existing := range sess.Tun.Routes.All() {
+		cidr := existing.Cidr.String()
+		entry, declared := routes[cidr]
+		current := profile.Entry{Cidr: entry.Cidr, Metric: existing.Metric, IsLoopback: existing.IsLoopback, Ports: existing.Ports, IsExclusion: existing.IsExclusion}
+
+		switch {
+		case declared && current == entry:
+			delete(routes, cidr)
+		case declared:
+			added = append(added, &Change{Action: ActionUpdate, Session: sess, Route: &entry, RouteID: existing.ID})
+			delete(routes, cidr)
+		case decl.Prune:
+			removed = append(removed, &Change{Action: ActionRemove, Session: sess, Route: &profile.Entry{Cidr: cidr, Metric: existing.Metric}, RouteID: existing.ID})
+		}
+	}
+
+	for _, entry := range routes {
+		entry := entry
+		added = append(added, &Change{Action: ActionAdd, Session: sess, Route: &entry})
+	}
+
+	for _, existing := range sess.Redirectors.All() {
+		if _, declared := redirectors[existing.ID]; declared {
+			delete(redirectors, existing.ID)
+			continue
+		}
+
+		if decl.Prune {
+			redirector := existing
+			removed = append(removed, &Change{Action: ActionRemove, Session: sess, Redirector: &redirector})
+		}
+	}
+
+	for _, redirector := range redirectors {
+		redirector := redirector
+		added = append(added, &Change{Action: ActionAdd, Session: sess, Redirector: &redirector})
+	}
+
+	// routes and redirectors are kept in maps, sorting keeps plans stable from one run to the next
+	for _, list := range [][]*Change{removed, added} {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].String() < list[j].String()
+		})
+	}
+
+	return append(removed, added...), nil
+}
+
+func sameEngagement(a *engagement.Engagement, b *engagement.Engagement) bool {
+	return slices.Equal(a.Scope, b.Scope) && slices.Equal(a.Operators, b.Operators) && a.Start.Equal(b.Start) && a.End.Equal(b.End)
+}
+
+func sessionByName(sessions []*session.Session, name string) *session.Session {
+	for _, sess := range sessions {
+		if sess.GetName() == name {
+			return sess
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package declaration
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/profile"
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+// Reconciler brings the server to a declared state through the same services operators go through, so scope and
+// route checks still apply
+type Reconciler struct {
+	engagements *engagement.EngagementService
+	profiles    *profile.ProfileService
+	sessions    *session.SessionService
+}
+
+func NewReconciler(engagements *engagement.EngagementService, profiles *profile.ProfileService, sessions *session.SessionService) *Reconciler {
+	return &Reconciler{
+		engagements: engagements,
+		profiles:    profiles,
+		sessions:    sessions,
+	}
+}
+
+// Plan lists what applying the declaration would change, without changing anything
+func (r *Reconciler) Plan(decl *Declaration) ([]*Change, error) {
+	engagements, err := r.engagements.AllEngagements()
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := r.profiles.AllProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := r.sessions.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return decl.Diff(engagements, profiles, sessions)
+}
+
+// Apply makes a single change
+func (r *Reconciler) Apply(change *Change) error {
+	switch {
+	case change.Engagement != nil && change.Action == ActionAdd:
+		return r.engagements.AddEngagement(change.Engagement)
+	case change.Engagement != nil && change.Action == ActionUpdate:
+		return r.engagements.UpdateEngagement(change.Engagement)
+	case change.Engagement != nil:
+		return r.engagements.RemoveEngagement(change.Engagement.Name)
+	case change.Current != nil:
+		return r.engagements.Activate(*change.Current)
+	case change.Profile != nil && change.Action == ActionRemove:
+		_, err := r.profiles.RemoveProfile(change.Profile.Name)
+		return err
+	case change.Profile != nil:
+		return r.profiles.SaveProfile(change.Profile)
+	case change.Route != nil:
+		return r.applyRoute(change)
+	case change.Redirector != nil && change.Action == ActionRemove:
+		return r.sessions.RemoveRedirector(change.Session.ID, change.Redirector.ID)
+	case change.Redirector != nil:
+		return r.sessions.NewRedirector(change.Session.ID, change.Redirector.Protocol, change.Redirector.From, change.Redirector.To, change.Redirector.Via, change.Redirector.Bridge)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) applyRoute(change *Change) error {
+	if change.RouteID != "" {
+		old, err := r.sessions.RemoveRoute(change.Session.ID, change.RouteID)
+		if err != nil || change.Action == ActionRemove {
+			return err
+		}
+
+		if err := r.sessions.NewRoute(change.Session.ID, change.Route.Cidr, change.Route.Metric, change.Route.IsLoopback, change.Route.Ports, change.Route.IsExclusion); err != nil {
+			r.sessions.NewRoute(change.Session.ID, old.Cidr.String(), old.Metric, old.IsLoopback, old.Ports, old.IsExclusion)
+			return err
+		}
+
+		return nil
+	}
+
+	return r.sessions.NewRoute(change.Session.ID, change.Route.Cidr, change.Route.Metric, change.Route.IsLoopback, change.Route.Ports, change.Route.IsExclusion)
+}
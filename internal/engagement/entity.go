@@ -0,0 +1,142 @@
+package engagement
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DateLayout is how engagement days are given, the end day is included
+const DateLayout = "2006-01-02"
+
+// Engagement is what sessions, routes, builds and audit records are filed under. While one is current, routes
+// have to stay in its scope, agents are only built within its dates and only its operators can use the server
+type Engagement struct {
+	Name      string
+	Scope     []string  // CIDRs, empty means anything goes
+	Start     time.Time // zero means no start date
+	End       time.Time // zero means open ended
+	Operators []string  // besides admins, empty means everyone
+	Current   bool
+	Created   time.Time
+}
+
+func (e *Engagement) Validate() error {
+	if strings.TrimSpace(e.Name) == "" {
+		return fmt.Errorf("engagement name is empty")
+	}
+
+	for _, cidr := range e.Scope {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return fmt.Errorf("%s is invalid scope: %v", cidr, err)
+		}
+	}
+
+	if !e.Start.IsZero() && !e.End.IsZero() && e.End.Before(e.Start) {
+		return fmt.Errorf("engagement ends before it starts")
+	}
+
+	return nil
+}
+
+// InScope tells whether the whole of prefix is within the scope
+func (e *Engagement) InScope(prefix netip.Prefix) bool {
+	if len(e.Scope) == 0 {
+		return true
+	}
+
+	prefix = prefix.Masked()
+	for _, cidr := range e.Scope {
+		scope, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+
+		if scope.Bits() <= prefix.Bits() && scope.Contains(prefix.Addr()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Running tells whether now falls within the engagement's days
+func (e *Engagement) Running(now time.Time) bool {
+	if !e.Start.IsZero() && now.Before(e.Start) {
+		return false
+	}
+
+	if !e.End.IsZero() && !now.Before(e.End.AddDate(0, 0, 1)) {
+		return false
+	}
+
+	return true
+}
+
+// Allows tells whether a non-admin operator may work on the engagement
+func (e *Engagement) Allows(operator string) bool {
+	return len(e.Operators) == 0 || slices.Contains(e.Operators, operator)
+}
+
+func (e *Engagement) String() string {
+	days := func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Format(DateLayout)
+	}
+
+	scope := "any"
+	if len(e.Scope) > 0 {
+		scope = strings.Join(e.Scope, ", ")
+	}
+
+	operators := "everyone"
+	if len(e.Operators) > 0 {
+		operators = strings.Join(e.Operators, ", ")
+	}
+
+	return fmt.Sprintf("Name: %s\nDays: %s to %s\nScope: %s\nOperators: %s\nCurrent: %t",
+		e.Name, days(e.Start), days(e.End), scope, operators, e.Current)
+}
+
+func (e *Engagement) Proto() *pb.Engagement {
+	result := &pb.Engagement{
+		Name:      e.Name,
+		Scope:     e.Scope,
+		Operators: e.Operators,
+		Current:   e.Current,
+	}
+
+	if !e.Start.IsZero() {
+		result.Start = timestamppb.New(e.Start)
+	}
+	if !e.End.IsZero() {
+		result.End = timestamppb.New(e.End)
+	}
+
+	return result
+}
+
+func ProtoToEngagement(p *pb.Engagement) *Engagement {
+	result := &Engagement{
+		Name:      p.Name,
+		Scope:     p.Scope,
+		Operators: p.Operators,
+		Current:   p.Current,
+	}
+
+	if p.Start != nil {
+		result.Start = p.Start.AsTime()
+	}
+	if p.End != nil {
+		result.End = p.End.AsTime()
+	}
+
+	return result
+}
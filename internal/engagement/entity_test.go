@@ -0,0 +1,46 @@
+package engagement
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestInScope(t *testing.T) {
+	e := &Engagement{Name: "acme", Scope: []string{"10.0.0.0/16", "192.168.1.0/24"}}
+
+	for cidr, expected := range map[string]bool{
+		"10.0.5.0/24":    true,
+		"10.0.0.0/16":    true,
+		"10.0.0.0/8":     false,
+		"192.168.1.7/32": true,
+		"172.16.0.0/12":  false,
+	} {
+		if got := e.InScope(netip.MustParsePrefix(cidr)); got != expected {
+			t.Errorf("%s: expected in scope %t, got %t", cidr, expected, got)
+		}
+	}
+}
+
+func TestRunning(t *testing.T) {
+	start, _ := time.Parse(DateLayout, "2026-03-02")
+	end, _ := time.Parse(DateLayout, "2026-03-06")
+	e := &Engagement{Name: "acme", Start: start, End: end}
+
+	if e.Running(start.Add(-time.Minute)) {
+		t.Error("should not be running before the start day")
+	}
+
+	if !e.Running(end.Add(23 * time.Hour)) {
+		t.Error("end day should be included")
+	}
+
+	if e.Running(end.AddDate(0, 0, 1)) {
+		t.Error("should not be running after the end day")
+	}
+
+	e.End, e.Start = start, end
+	if err := e.Validate(); err == nil {
+		t.Error("engagement ending before it starts should be rejected")
+	}
+}
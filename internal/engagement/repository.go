@@ -0,0 +1,38 @@
+package engagement
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type EngagementRepository struct {
+	storage *storage.StoreInstance[Engagement]
+}
+
+var table = "engagements"
+
+func NewEngagementRepository(store *storage.Store) (*EngagementRepository, error) {
+	storeInstance, err := storage.GetInstance[Engagement](store, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EngagementRepository{
+		storage: storeInstance,
+	}, nil
+}
+
+func (repo *EngagementRepository) GetOne(name string) (*Engagement, error) {
+	return repo.storage.Get(name)
+}
+
+func (repo *EngagementRepository) GetAll() ([]*Engagement, error) {
+	return repo.storage.GetAll()
+}
+
+func (repo *EngagementRepository) Save(e *Engagement) error {
+	return repo.storage.Set(e.Name, e)
+}
+
+func (repo *EngagementRepository) Remove(e *Engagement) error {
+	return repo.storage.Del(e.Name)
+}
@@ -0,0 +1,196 @@
+package engagement
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"sync"
+	"time"
+)
+
+type EngagementService struct {
+	repo *EngagementRepository
+	mu   sync.Mutex // switching the current engagement touches two records
+}
+
+func NewEngagementService(repo *EngagementRepository) *EngagementService {
+	return &EngagementService{
+		repo: repo,
+	}
+}
+
+// AllEngagements lists engagements by start date, the ones without come first
+func (service *EngagementService) AllEngagements() ([]*Engagement, error) {
+	engagements, err := service.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(engagements, func(i, j int) bool {
+		if !engagements[i].Start.Equal(engagements[j].Start) {
+			return engagements[i].Start.Before(engagements[j].Start)
+		}
+		return engagements[i].Name < engagements[j].Name
+	})
+
+	return engagements, nil
+}
+
+func (service *EngagementService) EngagementByName(name string) (*Engagement, error) {
+	e, err := service.repo.GetOne(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if e == nil {
+		return nil, fmt.Errorf("engagement '%s' not found", name)
+	}
+
+	return e, nil
+}
+
+func (service *EngagementService) AddEngagement(e *Engagement) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	if existing, _ := service.repo.GetOne(e.Name); existing != nil {
+		return fmt.Errorf("engagement '%s' already exists", e.Name)
+	}
+
+	e.Current = false
+	e.Created = time.Now()
+
+	return service.repo.Save(e)
+}
+
+// UpdateEngagement replaces the days, scope and operators of an engagement, it stays current if it was
+func (service *EngagementService) UpdateEngagement(e *Engagement) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	existing, err := service.EngagementByName(e.Name)
+	if err != nil {
+		return err
+	}
+
+	e.Current = existing.Current
+	e.Created = existing.Created
+
+	return service.repo.Save(e)
+}
+
+// RemoveEngagement forgets an engagement, what was filed under it keeps the name
+func (service *EngagementService) RemoveEngagement(name string) error {
+	e, err := service.EngagementByName(name)
+	if err != nil {
+		return err
+	}
+
+	return service.repo.Remove(e)
+}
+
+// Activate makes an engagement the current one, an empty name leaves the server without any
+func (service *EngagementService) Activate(name string) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	var next *Engagement
+	if name != "" {
+		var err error
+		if next, err = service.EngagementByName(name); err != nil {
+			return err
+		}
+	}
+
+	if current := service.Current(); current != nil {
+		current.Current = false
+		if err := service.repo.Save(current); err != nil {
+			return err
+		}
+	}
+
+	if next == nil {
+		return nil
+	}
+
+	next.Current = true
+	return service.repo.Save(next)
+}
+
+// Current returns the engagement new sessions, builds and audit records are filed under, if any
+func (service *EngagementService) Current() *Engagement {
+	engagements, err := service.repo.GetAll()
+	if err != nil {
+		return nil
+	}
+
+	for _, e := range engagements {
+		if e.Current {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// CurrentName is the name of the current engagement, empty if there is none
+func (service *EngagementService) CurrentName() string {
+	if current := service.Current(); current != nil {
+		return current.Name
+	}
+
+	return ""
+}
+
+// Admit tells whether an operator may use the server under the current engagement
+func (service *EngagementService) Admit(operator string, isAdmin bool) error {
+	current := service.Current()
+	if current == nil || isAdmin || current.Allows(operator) {
+		return nil
+	}
+
+	return fmt.Errorf("%s is not an operator of engagement '%s'", operator, current.Name)
+}
+
+// CheckBuild refuses to build agents outside of the current engagement's days
+func (service *EngagementService) CheckBuild() error {
+	current := service.Current()
+	if current == nil || current.Running(time.Now()) {
+		return nil
+	}
+
+	return fmt.Errorf("engagement '%s' is not running", current.Name)
+}
+
+// CheckRoute refuses routes outside the scope or the days of the engagement a session is filed under. Exclusions
+// only take traffic away and loopback routes lead to the agent itself, so they are always fine
+func (service *EngagementService) CheckRoute(name string, cidr string, isLoopback bool, isExclusion bool) error {
+	if name == "" || isLoopback || isExclusion {
+		return nil
+	}
+
+	e, err := service.repo.GetOne(name)
+	if err != nil || e == nil {
+		return err // the engagement was removed, nothing to enforce anymore
+	}
+
+	if !e.Running(time.Now()) {
+		return fmt.Errorf("engagement '%s' is not running", e.Name)
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return fmt.Errorf("%s is invalid route: %v", cidr, err)
+	}
+
+	if !e.InScope(prefix) {
+		return fmt.Errorf("%s is outside the scope of engagement '%s'", cidr, e.Name)
+	}
+
+	return nil
+}
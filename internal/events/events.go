@@ -3,6 +3,7 @@ package events
 import (
 	"fmt"
 	"log/slog"
+	"slices"
 )
 
 type EventType int
@@ -32,9 +33,39 @@ func (t EventType) Slog() slog.Level {
 	return slog.LevelInfo
 }
 
+// Topics mark the events clients act upon rather than just log, e.g. to notify the operator or refresh a view
+const (
+	TopicSessionConnected    = "session.connected"
+	TopicSessionDisconnected = "session.disconnected"
+	TopicRouteAdded          = "route.added"
+	TopicRouteRemoved        = "route.removed"
+	TopicCertRevoked         = "cert.revoked"
+	TopicBuildFinished       = "build.finished"
+)
+
+var Topics = []string{
+	TopicSessionConnected,
+	TopicSessionDisconnected,
+	TopicRouteAdded,
+	TopicRouteRemoved,
+	TopicCertRevoked,
+	TopicBuildFinished,
+}
+
 type Event struct {
-	Type EventType
-	Data string
+	Type    EventType
+	Data    string
+	Topic   string // empty for events that are only meant to be logged
+	Subject string // what the event is about, e.g. a session ID for session and route topics
+}
+
+// Matches tells whether a subscriber to topics gets the event, no topics at all subscribes to every event
+func (e *Event) Matches(topics []string) bool {
+	if len(topics) == 0 {
+		return true
+	}
+
+	return slices.Contains(topics, e.Topic)
 }
 
 var eventStream chan *Event
@@ -47,6 +78,11 @@ func Publish(eventType EventType, eventData string, args ...any) {
 	eventStream <- &Event{Type: eventType, Data: fmt.Sprintf(eventData, args...)}
 }
 
+// PublishTopic is Publish for an event of a topic, subject being what it is about
+func PublishTopic(topic string, subject string, eventType EventType, eventData string, args ...any) {
+	eventStream <- &Event{Type: eventType, Data: fmt.Sprintf(eventData, args...), Topic: topic, Subject: subject}
+}
+
 func Recv() *Event {
 	return <-eventStream
 }
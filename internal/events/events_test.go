@@ -0,0 +1,21 @@
+package events
+
+import "testing"
+
+func TestMatches(t *testing.T) {
+	event := &Event{Topic: TopicRouteAdded, Subject: "session"}
+	if !event.Matches(nil) {
+		t.Error("no topics should match every event")
+	}
+	if !event.Matches([]string{TopicSessionConnected, TopicRouteAdded}) {
+		t.Error("event should match its topic")
+	}
+	if event.Matches([]string{TopicRouteRemoved}) {
+		t.Error("event shouldn't match another topic")
+	}
+
+	untagged := &Event{Data: "log line"}
+	if untagged.Matches([]string{TopicRouteAdded}) {
+		t.Error("events without a topic shouldn't match topic subscriptions")
+	}
+}
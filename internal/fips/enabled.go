@@ -0,0 +1,8 @@
+//go:build !boringcrypto
+// +build !boringcrypto
+
+package fips
+
+func Enabled() bool {
+	return false
+}
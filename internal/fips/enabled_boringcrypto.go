@@ -0,0 +1,13 @@
+//go:build boringcrypto
+// +build boringcrypto
+
+package fips
+
+import (
+	"crypto/boring"
+	_ "crypto/tls/fipsonly"
+)
+
+func Enabled() bool {
+	return boring.Enabled()
+}
@@ -0,0 +1,22 @@
+// Package fips reports whether the binary runs on a FIPS validated crypto module. Builds made with
+// GOEXPERIMENT=boringcrypto route crypto/* through BoringCrypto and restrict TLS to approved settings,
+// so everything using the standard library (operator and agent TLS, hashes, any at-rest encryption) is covered
+package fips
+
+import "crypto/tls"
+
+// Mode describes the crypto module in use, shown to operators in the server pane
+func Mode() string {
+	if Enabled() {
+		return "FIPS (boringcrypto)"
+	}
+	return "standard"
+}
+
+// TLSMinVersion is TLS 1.3 unless FIPS mode is on, older BoringCrypto modules only approve TLS 1.2
+func TLSMinVersion() uint16 {
+	if Enabled() {
+		return tls.VersionTLS12
+	}
+	return tls.VersionTLS13
+}
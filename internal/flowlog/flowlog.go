@@ -0,0 +1,136 @@
+// Package flowlog writes one JSON line per connection relayed through a tunnel, so evidence of what was touched
+// doesn't depend on someone remembering to export it. Files are rotated by size, the way most log shippers expect
+package flowlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Record is a relayed connection, fields are named for SIEMs rather than for Go
+type Record struct {
+	Time        time.Time `json:"time"` // when the connection ended
+	Engagement  string    `json:"engagement,omitempty"`
+	Session     string    `json:"session"`
+	SessionName string    `json:"session_name"`
+	Operator    string    `json:"operator,omitempty"` // who started the relay
+	Protocol    string    `json:"protocol"`
+	SrcAddr     string    `json:"src_addr"`
+	SrcPort     uint16    `json:"src_port"`
+	DstAddr     string    `json:"dst_addr"`
+	DstPort     uint16    `json:"dst_port"`
+	BytesOut    int64     `json:"bytes_out"` // sent to the destination
+	BytesIn     int64     `json:"bytes_in"`  // received from the destination
+	DurationMs  int64     `json:"duration_ms"`
+}
+
+// Writer appends records to path, once it grows past maxSize it becomes path.1, path.1 becomes path.2 and so on,
+// keeping at most keep rotated files
+type Writer struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	keep    int
+	file    *os.File
+	size    int64
+}
+
+func NewWriter(path string, maxSize int64, keep int) (*Writer, error) {
+	w := &Writer{
+		path:    path,
+		maxSize: maxSize,
+		keep:    keep,
+	}
+
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *Writer) open() error {
+	file, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	w.file = file
+	w.size = info.Size()
+
+	return nil
+}
+
+func (w *Writer) Write(record Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return fmt.Errorf("flow log is closed")
+	}
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(line)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := w.file.Write(line)
+	w.size += int64(n)
+
+	return err
+}
+
+func (w *Writer) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+
+	if w.keep > 0 {
+		os.Remove(w.rotated(w.keep))
+		for i := w.keep - 1; i > 0; i-- {
+			os.Rename(w.rotated(i), w.rotated(i+1))
+		}
+		if err := os.Rename(w.path, w.rotated(1)); err != nil {
+			return err
+		}
+	} else if err := os.Remove(w.path); err != nil {
+		return err
+	}
+
+	return w.open()
+}
+
+func (w *Writer) rotated(i int) string {
+	return fmt.Sprintf("%s.%d", w.path, i)
+}
+
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.file.Close()
+	w.file = nil
+
+	return err
+}
@@ -0,0 +1,50 @@
+package flowlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flows.jsonl")
+
+	w, err := NewWriter(path, 300, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	for i := 0; i < 10; i++ {
+		if err := w.Write(Record{Session: "s1", Protocol: "tcp", DstAddr: "10.0.0.1", DstPort: uint16(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Fatal("only two rotated files should be kept")
+	}
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		file, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		info, _ := file.Stat()
+		if info.Size() > 300 {
+			t.Errorf("%s is over the max size", name)
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var record Record
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+				t.Errorf("%s has an invalid line: %s", name, err)
+			}
+		}
+		file.Close()
+	}
+}
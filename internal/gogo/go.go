@@ -2,36 +2,103 @@ package gogo
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
+	"go/version"
 	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/winres"
 )
 
 const (
 	goDirName = "go"
+
+	// MinGoVersion - Oldest toolchain that builds the vendored agent
+	MinGoVersion = "go1.20"
 )
 
+// `go tool dist list` output per GOROOT, it only changes with the toolchain
+var distListCache sync.Map
+
 // GoConfig - Env variables for Go compiler
 type GoConfig struct {
 	ProjectDir string
 
 	GOOS       string
 	GOARCH     string
+	GOARM      string
+	GOMIPS     string
 	GOROOT     string
+	Container  string // image to run the toolchain in, GOROOT is ignored when set
 	GOCACHE    string
 	GOMODCACHE string
 	GOPROXY    string
 	CGO        string
 	CC         string
+	EXPERIMENT string // GOEXPERIMENT, e.g. boringcrypto for FIPS builds
 	CXX        string
 	HTTPPROXY  string
 	HTTPSPROXY string
 
 	Obfuscate bool
 	GOGARBLE  string
+	Garble    GarbleOptions
+
+	BuildMode string
+	Tags      []string
+
+	Resources *winres.Resources // icon, manifest and version info, windows only
+}
+
+// GarbleOptions - Obfuscation knobs, a fixed seed makes the obfuscation reproducible
+type GarbleOptions struct {
+	Seed     string // base64, random if empty
+	Tiny     bool
+	Literals bool
+}
+
+func (opts GarbleOptions) flags() []string {
+	seed := opts.Seed
+	if seed == "" {
+		seed = "random"
+	}
+
+	flags := []string{fmt.Sprintf("-seed=%s", seed)}
+	if opts.Literals {
+		flags = append(flags, "-literals")
+	}
+	if opts.Tiny {
+		flags = append(flags, "-tiny")
+	}
+	return flags
+}
+
+// NewGarbleSeed - Random seed in the format garble expects
+func NewGarbleSeed() (string, error) {
+	seed := make([]byte, 16)
+	if _, err := rand.Read(seed); err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(seed), nil
+}
+
+// ValidateGarbleSeed - Garble wants base64 of at least 8 bytes
+func ValidateGarbleSeed(seed string) error {
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(seed, "="))
+	if err != nil {
+		return fmt.Errorf("garble seed must be base64: %s", err)
+	}
+	if len(decoded) < 8 {
+		return fmt.Errorf("garble seed must be at least 8 bytes long")
+	}
+	return nil
 }
 
 // GetGoRootDir - Get the path to GOROOT
@@ -63,17 +130,14 @@ func getHomeDir() string {
 }
 
 // GarbleCmd - Execute a go command
-func GarbleCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
+func GarbleCmd(ctx context.Context, config GoConfig, cwd string, command []string) ([]byte, error) {
 	target := fmt.Sprintf("%s/%s", config.GOOS, config.GOARCH)
 	if _, ok := ValidCompilerTargets(config)[target]; !ok {
 		return nil, fmt.Errorf("invalid compiler target: %s", target)
 	}
-	garbleBinPath := filepath.Join(config.GOROOT, "bin", "garble")
-	garbleFlags := []string{"-seed=random", "-literals", "-tiny"}
+	garbleFlags := config.Garble.flags()
 	command = append(garbleFlags, command...)
-	cmd := exec.Command(garbleBinPath, command...)
-	cmd.Dir = cwd
-	cmd.Env = []string{
+	env := []string{
 		fmt.Sprintf("CGO_ENABLED=%s", config.CGO),
 		fmt.Sprintf("GOOS=%s", config.GOOS),
 		fmt.Sprintf("GOARCH=%s", config.GOARCH),
@@ -84,6 +148,9 @@ func GarbleCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 		fmt.Sprintf("GOGARBLE=%s", config.GOGARBLE),
 		fmt.Sprintf("HOME=%s", getHomeDir()),
 	}
+	env = append(env, targetEnv(config)...)
+	env = append(env, compilerEnv(config)...)
+	cmd := toolchainCmd(ctx, config, cwd, garbleBinary(config), command, env)
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -98,11 +165,8 @@ func GarbleCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 }
 
 // GoCmd - Execute a go command
-func GoCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
-	goBinPath := filepath.Join(config.GOROOT, "bin", "go")
-	cmd := exec.Command(goBinPath, command...)
-	cmd.Dir = cwd
-	cmd.Env = []string{
+func GoCmd(ctx context.Context, config GoConfig, cwd string, command []string) ([]byte, error) {
+	env := []string{
 		fmt.Sprintf("CGO_ENABLED=%s", config.CGO),
 		fmt.Sprintf("GOOS=%s", config.GOOS),
 		fmt.Sprintf("GOARCH=%s", config.GOARCH),
@@ -111,6 +175,9 @@ func GoCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 		fmt.Sprintf("GOMODCACHE=%s", config.GOMODCACHE),
 		fmt.Sprintf("PATH=%s:%s", filepath.Join(config.GOROOT, "bin"), os.Getenv("PATH")),
 	}
+	env = append(env, targetEnv(config)...)
+	env = append(env, compilerEnv(config)...)
+	cmd := toolchainCmd(ctx, config, cwd, filepath.Join(config.GOROOT, "bin", "go"), command, env)
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
@@ -124,31 +191,142 @@ func GoCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 	return stdout.Bytes(), err
 }
 
+// toolchainCmd - Prepare a toolchain command, either on the host or in a throwaway container. The project
+// and cache directories are mounted at the same paths, so nothing in the command needs rewriting
+func toolchainCmd(ctx context.Context, config GoConfig, cwd string, binPath string, args []string, env []string) *exec.Cmd {
+	if config.Container == "" {
+		cmd := exec.CommandContext(ctx, binPath, args...)
+		cmd.Dir = cwd
+		cmd.Env = env
+		return cmd
+	}
+
+	runArgs := []string{"run", "--rm"}
+	for _, dir := range []string{config.ProjectDir, config.GOCACHE, config.GOMODCACHE} {
+		if dir == "" {
+			continue
+		}
+		runArgs = append(runArgs, "-v", fmt.Sprintf("%s:%s", dir, dir))
+		if cwd != "" && strings.HasPrefix(cwd, dir) {
+			runArgs = append(runArgs, "-w", cwd)
+		}
+	}
+	for _, e := range env {
+		// the image knows where its own toolchain lives
+		if strings.HasPrefix(e, "PATH=") || strings.HasPrefix(e, "HOME=") {
+			continue
+		}
+		runArgs = append(runArgs, "-e", e)
+	}
+	runArgs = append(runArgs, config.Container, filepath.Base(binPath))
+	runArgs = append(runArgs, args...)
+
+	return exec.CommandContext(ctx, containerRuntime(), runArgs...)
+}
+
+// containerRuntime - Prefer docker, fall back to podman. DOCKER_HOST and friends are inherited
+func containerRuntime() string {
+	if _, err := exec.LookPath("docker"); err != nil {
+		if _, err := exec.LookPath("podman"); err == nil {
+			return "podman"
+		}
+	}
+	return "docker"
+}
+
+// garbleBinary - The embedded toolchain ships garble next to go, a system one may have it anywhere in PATH
+func garbleBinary(config GoConfig) string {
+	garbleBinPath := filepath.Join(config.GOROOT, "bin", "garble")
+	if config.Container != "" {
+		return garbleBinPath
+	}
+	if _, err := os.Stat(garbleBinPath); err != nil {
+		if found, err := exec.LookPath("garble"); err == nil {
+			return found
+		}
+	}
+	return garbleBinPath
+}
+
+// targetEnv - Architecture variant variables, only set when the target needs them
+func targetEnv(config GoConfig) []string {
+	var env []string
+	if config.GOARM != "" {
+		env = append(env, fmt.Sprintf("GOARM=%s", config.GOARM))
+	}
+	if config.GOMIPS != "" {
+		env = append(env, fmt.Sprintf("GOMIPS=%s", config.GOMIPS))
+	}
+	if config.EXPERIMENT != "" {
+		env = append(env, fmt.Sprintf("GOEXPERIMENT=%s", config.EXPERIMENT))
+	}
+	return env
+}
+
+// compilerEnv - C toolchain variables, only needed for cgo builds
+func compilerEnv(config GoConfig) []string {
+	var env []string
+	if config.CC != "" {
+		env = append(env, fmt.Sprintf("CC=%s", config.CC))
+	}
+	if config.CXX != "" {
+		env = append(env, fmt.Sprintf("CXX=%s", config.CXX))
+	}
+	return env
+}
+
 // GoBuild - Execute a go build command, returns stdout/error
-func GoBuild(config GoConfig, src string, dest string) ([]byte, error) {
+func GoBuild(ctx context.Context, config GoConfig, src string, dest string) ([]byte, error) {
 	target := fmt.Sprintf("%s/%s", config.GOOS, config.GOARCH)
 
 	if _, ok := ValidCompilerTargets(config)[target]; !ok {
 		return nil, fmt.Errorf("Invalid compiler target: %s", target)
 	}
+
+	if !config.Resources.Empty() {
+		if err := writeResources(config, src); err != nil {
+			return nil, err
+		}
+	}
+
 	var goCommand = []string{"build"}
 
 	goCommand = append(goCommand, "-trimpath") // remove absolute paths from any compiled binary
 	goCommand = append(goCommand, "-mod=vendor")
 	goCommand = append(goCommand, []string{"-ldflags", "-s -w"}...) // strip binary
+	if config.BuildMode != "" {
+		goCommand = append(goCommand, fmt.Sprintf("-buildmode=%s", config.BuildMode))
+	}
+	if len(config.Tags) > 0 {
+		goCommand = append(goCommand, []string{"-tags", strings.Join(config.Tags, ",")}...)
+	}
 	goCommand = append(goCommand, []string{"-o", dest, "."}...)
 
 	if config.Obfuscate {
-		return GarbleCmd(config, src, goCommand)
+		return GarbleCmd(ctx, config, src, goCommand)
+	}
+	return GoCmd(ctx, config, src, goCommand)
+}
+
+// writeResources drops the compiled resources next to the sources, the linker picks up .syso files by itself
+func writeResources(config GoConfig, src string) error {
+	if config.GOOS != "windows" {
+		return fmt.Errorf("resources can only be embedded in windows binaries")
+	}
+
+	syso, err := config.Resources.Syso(config.GOARCH)
+	if err != nil {
+		return err
 	}
-	return GoCmd(config, src, goCommand)
+
+	return os.WriteFile(filepath.Join(src, fmt.Sprintf("rsrc_windows_%s.syso", config.GOARCH)), syso, 0600)
 }
 
 // GoMod - Execute go module commands in src dir
 func GoMod(config GoConfig, src string, args []string) ([]byte, error) {
 	goCommand := []string{"mod"}
 	goCommand = append(goCommand, args...)
-	return GoCmd(config, src, goCommand)
+	return GoCmd(context.Background(), config, src, goCommand)
 }
 
 // GoVersion - Execute a go version command, returns stdout/error
@@ -158,7 +336,32 @@ func GoVersion(config GoConfig) ([]byte, error) {
 	if err != nil {
 		slog.Error("Go version failed", slog.Any("error", err))
 	}
-	return GoCmd(config, wd, goCommand)
+	return GoCmd(context.Background(), config, wd, goCommand)
+}
+
+// CheckVersion - Make sure the toolchain runs and is recent enough for the agent
+func CheckVersion(config GoConfig) (string, error) {
+	out, err := GoVersion(config)
+	if err != nil {
+		return "", fmt.Errorf("could not run go toolchain: %s", err)
+	}
+
+	// go version go1.23.5 linux/amd64
+	fields := strings.Fields(string(out))
+	if len(fields) < 3 || !version.IsValid(fields[2]) {
+		return "", fmt.Errorf("unrecognised go version: %s", strings.TrimSpace(string(out)))
+	}
+
+	if version.Compare(fields[2], MinGoVersion) < 0 {
+		return fields[2], fmt.Errorf("go toolchain %s is too old, at least %s is required", fields[2], MinGoVersion)
+	}
+
+	return fields[2], nil
+}
+
+// ForgetToolchain - Drop what is cached about the toolchain at goRoot, for when it gets replaced in place
+func ForgetToolchain(goRoot string) {
+	distListCache.Delete(goRoot)
 }
 
 // ValidCompilerTargets - Returns a map of valid compiler targets
@@ -170,16 +373,26 @@ func ValidCompilerTargets(config GoConfig) map[string]bool {
 	return validTargets
 }
 
-// GoToolDistList - Get a list of supported GOOS/GOARCH pairs
+// GoToolDistList - Get a list of supported GOOS/GOARCH pairs, cached per toolchain
 func GoToolDistList(config GoConfig) []string {
+	toolchain := config.GOROOT
+	if config.Container != "" {
+		toolchain = config.Container
+	}
+
+	if cached, ok := distListCache.Load(toolchain); ok {
+		return cached.([]string)
+	}
+
 	var goCommand = []string{"tool", "dist", "list"}
 	wd, _ := os.Getwd()
-	data, err := GoCmd(config, wd, goCommand)
+	data, err := GoCmd(context.Background(), config, wd, goCommand)
 
 	if err != nil {
 		slog.Error("Golang tool dist list failed", slog.Any("error", err))
 		return nil
 	}
 	lines := strings.Split(string(data), "\n")
+	distListCache.Store(toolchain, lines)
 	return lines
 }
@@ -0,0 +1,51 @@
+package gogo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AgentTargets - Architectures offered for agents, per GOOS. Variants (armv7, mips-softfloat) map onto GOARM/GOMIPS
+var AgentTargets = map[string][]string{
+	"windows": {"amd64", "386", "arm64"},
+	"linux": {
+		"amd64", "386", "arm64", "armv7", "armv6", "armv5",
+		"mips", "mipsle", "mips-softfloat", "mipsle-softfloat",
+		"mips64", "mips64le", "riscv64",
+	},
+	"freebsd": {"amd64", "386", "arm64", "armv7"},
+	"darwin":  {"amd64", "arm64"},
+}
+
+// Target - Toolchain settings for a GOOS/arch pair
+type Target struct {
+	GOOS   string
+	GOARCH string
+	GOARM  string
+	GOMIPS string
+}
+
+// ParseTarget - Validates arch for goos and splits it into GOARCH and its variant
+func ParseTarget(goos string, arch string) (Target, error) {
+	archs, ok := AgentTargets[goos]
+	if !ok {
+		return Target{}, fmt.Errorf("%s is not supported agent OS", goos)
+	}
+
+	if !slices.Contains(archs, arch) {
+		return Target{}, fmt.Errorf("%s is not supported architecture for %s agents, expected one of: %s", arch, goos, strings.Join(archs, ", "))
+	}
+
+	target := Target{GOOS: goos, GOARCH: arch}
+	switch {
+	case strings.HasPrefix(arch, "armv"):
+		target.GOARCH = "arm"
+		target.GOARM = strings.TrimPrefix(arch, "armv")
+	case strings.HasSuffix(arch, "-softfloat"):
+		target.GOARCH = strings.TrimSuffix(arch, "-softfloat")
+		target.GOMIPS = "softfloat"
+	}
+
+	return target, nil
+}
@@ -0,0 +1,168 @@
+package ha
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+	api "github.com/ttpreport/ligolo-mp/v2/pkg/api/v1"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// dialTimeout bounds every attempt at reaching the peer, an unreachable peer is what a failover is decided on
+const dialTimeout = 10 * time.Second
+
+// Peer is the other server of a pair, reached over its operator address with admin credentials both servers know.
+// Only the active server serves operators, a peer that answers is the active one
+type Peer struct {
+	addr    string
+	connect func(ctx context.Context) (pb.LigoloClient, io.Closer, error)
+}
+
+// NewPeer reads the admin operator file of the pair, addr replaces the server it was exported for
+func NewPeer(credentials string, addr string) (*Peer, error) {
+	config, err := api.LoadConfig(credentials)
+	if err != nil {
+		return nil, err
+	}
+	config.Server = addr
+
+	return &Peer{
+		addr: addr,
+		connect: func(ctx context.Context) (pb.LigoloClient, io.Closer, error) {
+			client, err := api.Dial(ctx, config)
+			if err != nil {
+				return nil, nil, err
+			}
+			return client.Raw(), client, nil
+		},
+	}, nil
+}
+
+func (peer *Peer) Addr() string {
+	return peer.addr
+}
+
+func (peer *Peer) dial(ctx context.Context) (pb.LigoloClient, io.Closer, error) {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	return peer.connect(ctx)
+}
+
+// replicate copies the whole state of the peer into store, the archive is encrypted with a passphrase made up for
+// this pull on top of TLS. It returns the number of rows copied
+func (peer *Peer) replicate(ctx context.Context, store *storage.Store) (int, error) {
+	client, conn, err := peer.dial(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return 0, err
+	}
+	passphrase := hex.EncodeToString(secret)
+
+	stream, err := client.ReplicateServerState(ctx, &pb.ExportServerStateReq{Passphrase: passphrase})
+	if err != nil {
+		return 0, err
+	}
+
+	var archive bytes.Buffer
+	var size int64
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if r.Size != 0 {
+			size = r.Size
+		}
+		archive.Write(r.Chunk)
+	}
+
+	if int64(archive.Len()) != size {
+		return 0, fmt.Errorf("replication interrupted after %d bytes", archive.Len())
+	}
+
+	_, rows, err := store.Import(archive.Bytes(), passphrase)
+	return rows, err
+}
+
+// Standby keeps store a copy of the state of the peer every interval, as long as the peer answers. It returns once
+// the peer didn't answer for failoverAfter, the server takes over as the active one then and agents failing over
+// find their sessions and routes. It also returns, with an error, when ctx is done
+func Standby(ctx context.Context, store *storage.Store, peer *Peer, interval time.Duration, failoverAfter time.Duration) error {
+	slog.Info("standing by", slog.String("peer", peer.Addr()), slog.Duration("interval", interval), slog.Duration("failover_after", failoverAfter))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastSeen := time.Now() // a peer that never answers is waited for as long
+	reachable := false
+	for {
+		rows, err := peer.replicate(ctx, store)
+		switch {
+		case err == nil:
+			if !reachable {
+				slog.Info("replicating the active server", slog.String("peer", peer.Addr()), slog.Int("rows", rows))
+			}
+			lastSeen, reachable = time.Now(), true
+		case time.Since(lastSeen) >= failoverAfter:
+			slog.Warn("active server is gone, taking over", slog.String("peer", peer.Addr()), slog.Time("last_seen", lastSeen), slog.Any("error", err))
+			return nil
+		default:
+			if reachable {
+				slog.Warn("active server doesn't answer", slog.String("peer", peer.Addr()), slog.Any("error", err))
+			}
+			reachable = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Watch calls conflict when the peer starts serving operators while this server is the active one, e.g. both took
+// over during a network partition or the former active server came back without standing by. It returns when ctx
+// is done
+func Watch(ctx context.Context, peer *Peer, interval time.Duration, conflict func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	active := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, conn, err := peer.dial(ctx)
+		if err != nil {
+			active = false
+			continue
+		}
+		conn.Close()
+
+		if !active {
+			conflict()
+		}
+		active = true
+	}
+}
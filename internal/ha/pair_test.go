@@ -0,0 +1,229 @@
+package ha
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/grpc"
+)
+
+type testRecord struct {
+	Name string
+}
+
+// fakePeer stands for the other server of the pair, it serves the state of its store while it's up
+type fakePeer struct {
+	mu         sync.Mutex
+	up         bool
+	store      *storage.Store
+	lastServed time.Time
+}
+
+func newFakePeer(t *testing.T) *fakePeer {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	table, err := storage.GetInstance[testRecord](store, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Set("alpha", &testRecord{Name: "alpha"}); err != nil {
+		t.Fatal(err)
+	}
+
+	return &fakePeer{up: true, store: store}
+}
+
+func (f *fakePeer) setUp(up bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.up = up
+}
+
+func (f *fakePeer) served() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.lastServed
+}
+
+func (f *fakePeer) peer() *Peer {
+	return &Peer{
+		addr: "peer:58008",
+		connect: func(ctx context.Context) (pb.LigoloClient, io.Closer, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			if !f.up {
+				return nil, nil, errors.New("connection refused")
+			}
+			client := &fakeClient{peer: f}
+			return client, client, nil
+		},
+	}
+}
+
+type fakeClient struct {
+	pb.LigoloClient
+	peer *fakePeer
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+func (c *fakeClient) ReplicateServerState(ctx context.Context, in *pb.ExportServerStateReq, opts ...grpc.CallOption) (pb.Ligolo_ReplicateServerStateClient, error) {
+	archive, _, err := c.peer.store.Export(in.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	c.peer.mu.Lock()
+	c.peer.lastServed = time.Now()
+	c.peer.mu.Unlock()
+
+	half := len(archive) / 2
+	return &fakeStream{chunks: []*pb.ExportServerStateResp{
+		{Size: int64(len(archive)), Chunk: archive[:half]},
+		{Chunk: archive[half:]},
+	}}, nil
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	chunks []*pb.ExportServerStateResp
+}
+
+func (s *fakeStream) Recv() (*pb.ExportServerStateResp, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func newTestStore(t *testing.T) *storage.Store {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	return store
+}
+
+func TestStandbyFailover(t *testing.T) {
+	fake := newFakePeer(t)
+	store := newTestStore(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	failoverAfter := 100 * time.Millisecond
+	time.AfterFunc(50*time.Millisecond, func() { fake.setUp(false) })
+
+	if err := Standby(ctx, store, fake.peer(), 10*time.Millisecond, failoverAfter); err != nil {
+		t.Fatalf("standby should take over, got %v", err)
+	}
+
+	if since := time.Since(fake.served()); since < failoverAfter {
+		t.Fatalf("took over %s after the peer last answered, before the failover timeout", since)
+	}
+
+	table, err := storage.GetInstance[testRecord](store, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record, err := table.Get("alpha"); err != nil || record == nil || record.Name != "alpha" {
+		t.Fatalf("the state of the peer should be replicated, got %+v, %v", record, err)
+	}
+}
+
+func TestStandbyHeartbeat(t *testing.T) {
+	fake := newFakePeer(t)
+	store := newTestStore(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 700*time.Millisecond)
+	defer cancel()
+
+	// the peer keeps dropping for less than the failover timeout, each answer starts the wait over
+	go func() {
+		for {
+			fake.setUp(false)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(80 * time.Millisecond):
+			}
+
+			fake.setUp(true)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(40 * time.Millisecond):
+			}
+		}
+	}()
+
+	if err := Standby(ctx, store, fake.peer(), 10*time.Millisecond, 200*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("standby shouldn't take over while the peer answers, got %v", err)
+	}
+}
+
+func TestWatchConflict(t *testing.T) {
+	fake := newFakePeer(t)
+	fake.setUp(false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	conflicts := make(chan struct{}, 10)
+	done := make(chan struct{})
+	go func() {
+		Watch(ctx, fake.peer(), 10*time.Millisecond, func() { conflicts <- struct{}{} })
+		close(done)
+	}()
+
+	expect := func(conflict bool) {
+		t.Helper()
+
+		select {
+		case <-conflicts:
+			if !conflict {
+				t.Fatal("conflict reported while the peer isn't serving or was already reported")
+			}
+		case <-time.After(200 * time.Millisecond):
+			if conflict {
+				t.Fatal("peer serving operators wasn't reported")
+			}
+		}
+	}
+
+	expect(false)
+
+	fake.setUp(true)
+	expect(true)
+	expect(false) // once per takeover
+
+	fake.setUp(false)
+	time.Sleep(50 * time.Millisecond)
+	fake.setUp(true)
+	expect(true)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watch should return once ctx is done")
+	}
+}
@@ -0,0 +1,135 @@
+package listener
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Listener is where agents connect. Admins add them at runtime next to the ones the server was started with, which
+// are static: they can't be changed or removed
+type Listener struct {
+	Name        string
+	Transport   string // as with -agent-transport, optionally with a profile, e.g. wss+office
+	Address     string // host:port
+	Certificate string // PEM certificate presented instead of the server's, empty keeps it
+	Key         string // PEM key of the certificate
+	// ProxyProtocol has the listener expect a PROXY protocol header from redirectors in front of it, so sessions
+	// get the address of the agent rather than the redirector's
+	ProxyProtocol bool
+	Enabled       bool
+	Static        bool
+	Error         string // why it couldn't listen when last enabled
+	Created       time.Time
+}
+
+func (l *Listener) Validate() error {
+	if strings.TrimSpace(l.Name) == "" {
+		return fmt.Errorf("listener name is empty")
+	}
+
+	if strings.TrimSpace(l.Transport) == "" {
+		return fmt.Errorf("listener transport is empty")
+	}
+
+	if strings.ContainsAny(l.Transport, ",@") {
+		return fmt.Errorf("a listener takes a single transport, its address goes separately")
+	}
+
+	if _, _, err := net.SplitHostPort(l.Address); err != nil {
+		return fmt.Errorf("%s is invalid address: %v", l.Address, err)
+	}
+
+	if (l.Certificate == "") != (l.Key == "") {
+		return fmt.Errorf("listener certificate and key go together")
+	}
+
+	if l.Certificate != "" {
+		if _, err := l.KeyPair(); err != nil {
+			return fmt.Errorf("invalid listener certificate: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// KeyPair is the certificate the listener presents, nil if it keeps the server's
+func (l *Listener) KeyPair() (*tls.Certificate, error) {
+	if l.Certificate == "" {
+		return nil, nil
+	}
+
+	cert, err := tls.X509KeyPair([]byte(l.Certificate), []byte(l.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// Status is whether agents can connect, and why not
+func (l *Listener) Status() string {
+	switch {
+	case !l.Enabled:
+		return "disabled"
+	case l.Error != "":
+		return fmt.Sprintf("failed: %s", l.Error)
+	default:
+		return "listening"
+	}
+}
+
+func (l *Listener) String() string {
+	certificate := "server's"
+	if l.Certificate != "" {
+		certificate = "custom"
+	}
+
+	return fmt.Sprintf("Name: %s\nTransport: %s\nAddress: %s\nCertificate: %s\nPROXY protocol: %t\nStatus: %s\nStatic: %t",
+		l.Name, l.Transport, l.Address, certificate, l.ProxyProtocol, l.Status(), l.Static)
+}
+
+// Proto leaves the key out, it doesn't leave the server
+func (l *Listener) Proto() *pb.Listener {
+	result := &pb.Listener{
+		Name:          l.Name,
+		Transport:     l.Transport,
+		Address:       l.Address,
+		Certificate:   l.Certificate,
+		ProxyProtocol: l.ProxyProtocol,
+		Enabled:       l.Enabled,
+		Static:        l.Static,
+		Error:         l.Error,
+	}
+
+	if !l.Created.IsZero() {
+		result.Created = timestamppb.New(l.Created)
+	}
+
+	return result
+}
+
+func ProtoToListener(p *pb.Listener) *Listener {
+	result := &Listener{
+		Name:          p.Name,
+		Transport:     p.Transport,
+		Address:       p.Address,
+		Certificate:   p.Certificate,
+		Key:           p.Key,
+		ProxyProtocol: p.ProxyProtocol,
+		Enabled:       p.Enabled,
+		Static:        p.Static,
+		Error:         p.Error,
+	}
+
+	if p.Created != nil {
+		result.Created = p.Created.AsTime()
+	}
+
+	return result
+}
@@ -0,0 +1,38 @@
+package listener
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type ListenerRepository struct {
+	storage *storage.StoreInstance[Listener]
+}
+
+var table = "listeners"
+
+func NewListenerRepository(store *storage.Store) (*ListenerRepository, error) {
+	storeInstance, err := storage.GetInstance[Listener](store, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListenerRepository{
+		storage: storeInstance,
+	}, nil
+}
+
+func (repo *ListenerRepository) GetOne(name string) (*Listener, error) {
+	return repo.storage.Get(name)
+}
+
+func (repo *ListenerRepository) GetAll() ([]*Listener, error) {
+	return repo.storage.GetAll()
+}
+
+func (repo *ListenerRepository) Save(l *Listener) error {
+	return repo.storage.Set(l.Name, l)
+}
+
+func (repo *ListenerRepository) Remove(l *Listener) error {
+	return repo.storage.Del(l.Name)
+}
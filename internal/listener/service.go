@@ -0,0 +1,190 @@
+package listener
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Runner starts and stops listeners, the agent server does
+type Runner interface {
+	Start(l *Listener) error
+	Stop(name string)
+}
+
+type ListenerService struct {
+	repo   *ListenerRepository
+	runner Runner
+	static []*Listener
+	mu     sync.Mutex // starting and stopping goes along with saving
+}
+
+func NewListenerService(repo *ListenerRepository) *ListenerService {
+	return &ListenerService{
+		repo: repo,
+	}
+}
+
+// Run hands listeners to the runner: the static ones are already listening, the enabled ones admins added are
+// started. One failing to start is recorded on it, the server still starts
+func (service *ListenerService) Run(runner Runner, static []*Listener) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.runner = runner
+	service.static = static
+
+	listeners, err := service.repo.GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, l := range listeners {
+		if !l.Enabled {
+			continue
+		}
+
+		l.Error = ""
+		if err := runner.Start(l); err != nil {
+			slog.Error("Could not start agent listener", slog.String("listener", l.Name), slog.Any("error", err))
+			l.Error = err.Error()
+		}
+		if err := service.repo.Save(l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AllListeners lists static listeners first, then the others by name
+func (service *ListenerService) AllListeners() ([]*Listener, error) {
+	listeners, err := service.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(listeners, func(i, j int) bool {
+		return listeners[i].Name < listeners[j].Name
+	})
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	return append(append([]*Listener{}, service.static...), listeners...), nil
+}
+
+func (service *ListenerService) ListenerByName(name string) (*Listener, error) {
+	l, err := service.repo.GetOne(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if l == nil {
+		return nil, fmt.Errorf("listener '%s' not found", name)
+	}
+
+	return l, nil
+}
+
+// AddListener saves the listener once it listens, if enabled, so that a mistake doesn't get saved along
+func (service *ListenerService) AddListener(l *Listener) error {
+	if err := l.Validate(); err != nil {
+		return err
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.runner == nil {
+		return fmt.Errorf("agent server is not running")
+	}
+
+	if service.isStatic(l.Name) {
+		return fmt.Errorf("listener '%s' already exists", l.Name)
+	}
+	if existing, _ := service.repo.GetOne(l.Name); existing != nil {
+		return fmt.Errorf("listener '%s' already exists", l.Name)
+	}
+
+	l.Static = false
+	l.Error = ""
+	l.Created = time.Now()
+
+	if l.Enabled {
+		if err := service.runner.Start(l); err != nil {
+			return err
+		}
+	}
+
+	if err := service.repo.Save(l); err != nil {
+		service.runner.Stop(l.Name)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveListener stops the listener and forgets it, sessions that came in on it stay up
+func (service *ListenerService) RemoveListener(name string) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	l, err := service.changeable(name)
+	if err != nil {
+		return err
+	}
+
+	service.runner.Stop(l.Name)
+
+	return service.repo.Remove(l)
+}
+
+// SetEnabled starts or stops the listener, sessions that came in on it stay up when it stops
+func (service *ListenerService) SetEnabled(name string, enabled bool) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	l, err := service.changeable(name)
+	if err != nil {
+		return err
+	}
+
+	if l.Enabled == enabled && l.Error == "" {
+		return nil
+	}
+
+	service.runner.Stop(l.Name)
+	l.Enabled, l.Error = enabled, ""
+	if enabled {
+		if err := service.runner.Start(l); err != nil {
+			return err
+		}
+	}
+
+	return service.repo.Save(l)
+}
+
+func (service *ListenerService) changeable(name string) (*Listener, error) {
+	if service.isStatic(name) {
+		return nil, fmt.Errorf("listener '%s' was given on the command line, restart the server to change it", name)
+	}
+
+	if service.runner == nil {
+		return nil, fmt.Errorf("agent server is not running")
+	}
+
+	return service.ListenerByName(name)
+}
+
+func (service *ListenerService) isStatic(name string) bool {
+	for _, l := range service.static {
+		if l.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
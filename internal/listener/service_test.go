@@ -0,0 +1,105 @@
+package listener
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type fakeRunner struct {
+	running map[string]bool
+	fail    bool
+}
+
+func (r *fakeRunner) Start(l *Listener) error {
+	if r.fail {
+		return errors.New("address already in use")
+	}
+	r.running[l.Name] = true
+	return nil
+}
+
+func (r *fakeRunner) Stop(name string) {
+	delete(r.running, name)
+}
+
+func newTestService(t *testing.T) (*ListenerService, *fakeRunner) {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	repo, err := NewListenerRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewListenerService(repo)
+	runner := &fakeRunner{running: make(map[string]bool)}
+	static := []*Listener{{Name: "tls@0.0.0.0:11601", Transport: "tls", Address: "0.0.0.0:11601", Enabled: true, Static: true}}
+	if err := service.Run(runner, static); err != nil {
+		t.Fatal(err)
+	}
+
+	return service, runner
+}
+
+func TestListenerLifecycle(t *testing.T) {
+	service, runner := newTestService(t)
+
+	l := &Listener{Name: "https", Transport: "wss", Address: "0.0.0.0:443", Enabled: true}
+	if err := service.AddListener(l); err != nil {
+		t.Fatal(err)
+	}
+	if !runner.running["https"] {
+		t.Fatal("enabled listener should be started")
+	}
+
+	if err := service.SetEnabled("https", false); err != nil {
+		t.Fatal(err)
+	}
+	if runner.running["https"] {
+		t.Error("disabled listener should be stopped")
+	}
+
+	listeners, err := service.AllListeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listeners) != 2 || !listeners[0].Static || listeners[1].Enabled {
+		t.Errorf("expected the static listener then the disabled one, got %v", listeners)
+	}
+
+	if err := service.RemoveListener("https"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.ListenerByName("https"); err == nil {
+		t.Error("removed listener should be gone")
+	}
+}
+
+func TestStaticListener(t *testing.T) {
+	service, _ := newTestService(t)
+
+	if err := service.RemoveListener("tls@0.0.0.0:11601"); err == nil {
+		t.Error("static listener should not be removable")
+	}
+
+	if err := service.AddListener(&Listener{Name: "tls@0.0.0.0:11601", Transport: "tls", Address: "0.0.0.0:8443"}); err == nil {
+		t.Error("name of a static listener should be taken")
+	}
+}
+
+func TestFailingListenerNotSaved(t *testing.T) {
+	service, runner := newTestService(t)
+	runner.fail = true
+
+	if err := service.AddListener(&Listener{Name: "https", Transport: "wss", Address: "0.0.0.0:443", Enabled: true}); err == nil {
+		t.Fatal("listener that can't listen should be rejected")
+	}
+	if _, err := service.ListenerByName("https"); err == nil {
+		t.Error("listener that can't listen should not be saved")
+	}
+}
@@ -0,0 +1,100 @@
+package malleable
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/transport"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads the profiles file on every call, so admins can edit it without restarting the server. It lists
+// profiles in YAML, or JSON being a subset of it, e.g.
+//
+//	# profiles.yaml
+//	- name: office
+//	  description: Outlook on the web
+//	  fingerprint: chrome
+//	  uris: [/owa/sync, /ews/exchange.asmx]
+//	  headers: {Accept-Language: en-US}
+//	  jitter: 20ms
+//	  padding_min: 16
+//	  padding_max: 256
+func Load(filename string) (map[string]*transport.Profile, error) {
+	content, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []*transport.Profile
+	if err := yaml.Unmarshal(content, &profiles); err != nil {
+		return nil, fmt.Errorf("invalid profiles file: %w", err)
+	}
+
+	result := make(map[string]*transport.Profile)
+	for _, profile := range profiles {
+		if err := profile.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid profiles file: %w", err)
+		}
+		if _, ok := result[profile.Name]; ok {
+			return nil, fmt.Errorf("invalid profiles file: profile '%s' is declared twice", profile.Name)
+		}
+
+		result[profile.Name] = profile
+	}
+
+	return result, nil
+}
+
+// Get returns the profile with the given name
+func Get(filename string, name string) (*transport.Profile, error) {
+	profiles, err := Load(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("transport profile '%s' does not exist", name)
+	}
+
+	return profile, nil
+}
+
+// All returns the profiles sorted by name
+func All(filename string) ([]*transport.Profile, error) {
+	profiles, err := Load(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*transport.Profile
+	for _, profile := range profiles {
+		result = append(result, profile)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func Proto(profile *transport.Profile) *pb.TransportProfile {
+	return &pb.TransportProfile{
+		Name:        profile.Name,
+		Description: profile.Description,
+	}
+}
+
+func ProtoToProfile(p *pb.TransportProfile) *transport.Profile {
+	return &transport.Profile{
+		Name:        p.Name,
+		Description: p.Description,
+	}
+}
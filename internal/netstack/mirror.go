@@ -0,0 +1,146 @@
+package netstack
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gvisor.dev/gvisor/pkg/tcpip"
+	"gvisor.dev/gvisor/pkg/tcpip/link/nested"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
+)
+
+// Mirror receives a copy of every packet going through the netstack
+type Mirror interface {
+	WritePacket(packet []byte) error
+	Close() error
+}
+
+// NewMirror opens a mirror sink. Target is either "pcap:<path>" (regular file or FIFO) or "iface:<name>"
+func NewMirror(target string) (Mirror, error) {
+	kind, value, found := strings.Cut(target, ":")
+	if !found || value == "" {
+		return nil, fmt.Errorf("invalid mirror target '%s', expected pcap:<path> or iface:<name>", target)
+	}
+
+	switch kind {
+	case "pcap":
+		return newPcapMirror(value)
+	case "iface":
+		return newIfaceMirror(value)
+	default:
+		return nil, fmt.Errorf("unknown mirror type '%s'", kind)
+	}
+}
+
+// pcapMirror writes packets in pcap format with raw IP link type, readable by tcpdump, Zeek, Suricata, etc.
+type pcapMirror struct {
+	sync.Mutex
+	file *os.File
+}
+
+const (
+	pcapMagic      = 0xa1b2c3d4
+	pcapSnapLen    = 65535
+	pcapLinkTypeIP = 101
+)
+
+func newPcapMirror(path string) (Mirror, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 24)
+	binary.LittleEndian.PutUint32(header[0:4], pcapMagic)
+	binary.LittleEndian.PutUint16(header[4:6], 2)
+	binary.LittleEndian.PutUint16(header[6:8], 4)
+	binary.LittleEndian.PutUint32(header[16:20], pcapSnapLen)
+	binary.LittleEndian.PutUint32(header[20:24], pcapLinkTypeIP)
+	if _, err := file.Write(header); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &pcapMirror{file: file}, nil
+}
+
+func (m *pcapMirror) WritePacket(packet []byte) error {
+	now := time.Now()
+	captured := packet
+	if len(captured) > pcapSnapLen {
+		captured = captured[:pcapSnapLen]
+	}
+
+	record := make([]byte, 16, 16+len(captured))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(now.Unix()))
+	binary.LittleEndian.PutUint32(record[4:8], uint32(now.Nanosecond()/1000))
+	binary.LittleEndian.PutUint32(record[8:12], uint32(len(captured)))
+	binary.LittleEndian.PutUint32(record[12:16], uint32(len(packet)))
+	record = append(record, captured...)
+
+	m.Lock()
+	defer m.Unlock()
+	_, err := m.file.Write(record)
+	return err
+}
+
+func (m *pcapMirror) Close() error {
+	m.Lock()
+	defer m.Unlock()
+	return m.file.Close()
+}
+
+// mirrorEndpoint sits between the tun and the stack and copies all packets to the active mirror, if any
+type mirrorEndpoint struct {
+	nested.Endpoint
+	mu     sync.RWMutex
+	mirror Mirror
+}
+
+func newMirrorEndpoint(lower stack.LinkEndpoint) *mirrorEndpoint {
+	ep := &mirrorEndpoint{}
+	ep.Endpoint.Init(lower, ep)
+	return ep
+}
+
+func (e *mirrorEndpoint) setMirror(m Mirror) {
+	e.mu.Lock()
+	old := e.mirror
+	e.mirror = m
+	e.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+}
+
+func (e *mirrorEndpoint) dump(pkt *stack.PacketBuffer) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.mirror == nil {
+		return
+	}
+
+	view := pkt.ToView()
+	defer view.Release()
+	e.mirror.WritePacket(view.AsSlice())
+}
+
+// DeliverNetworkPacket implements stack.NetworkDispatcher
+func (e *mirrorEndpoint) DeliverNetworkPacket(protocol tcpip.NetworkProtocolNumber, pkt *stack.PacketBuffer) {
+	e.dump(pkt)
+	e.Endpoint.DeliverNetworkPacket(protocol, pkt)
+}
+
+// WritePackets implements stack.LinkEndpoint
+func (e *mirrorEndpoint) WritePackets(pkts stack.PacketBufferList) (int, tcpip.Error) {
+	for _, pkt := range pkts.AsSlice() {
+		e.dump(pkt)
+	}
+	return e.Endpoint.WritePackets(pkts)
+}
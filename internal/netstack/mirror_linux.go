@@ -0,0 +1,54 @@
+//go:build linux
+
+package netstack
+
+import (
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// ifaceMirror transmits packets on a local interface (e.g. a dummy one), so sniffers attached to it can see them
+type ifaceMirror struct {
+	fd   int
+	addr unix.SockaddrLinklayer
+}
+
+func newIfaceMirror(name string) (Mirror, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ifaceMirror{
+		fd: fd,
+		addr: unix.SockaddrLinklayer{
+			Ifindex: iface.Index,
+			Halen:   6,
+		},
+	}, nil
+}
+
+func (m *ifaceMirror) WritePacket(packet []byte) error {
+	addr := m.addr
+	if len(packet) > 0 && packet[0]>>4 == 6 {
+		addr.Protocol = htons(unix.ETH_P_IPV6)
+	} else {
+		addr.Protocol = htons(unix.ETH_P_IP)
+	}
+
+	return unix.Sendto(m.fd, packet, 0, &addr)
+}
+
+func (m *ifaceMirror) Close() error {
+	return unix.Close(m.fd)
+}
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
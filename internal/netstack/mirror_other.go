@@ -0,0 +1,9 @@
+//go:build !linux
+
+package netstack
+
+import "errors"
+
+func newIfaceMirror(name string) (Mirror, error) {
+	return nil, errors.New("interface mirroring is only supported on linux")
+}
@@ -3,10 +3,15 @@ package netstack
 import (
 	"bytes"
 	"errors"
+	"expvar"
+	"fmt"
 	"io"
 	"log/slog"
+	"math"
 	"net"
+	"net/netip"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/yamux"
 	"github.com/ttpreport/ligolo-mp/v2/internal/netstack/tun"
@@ -28,52 +33,70 @@ import (
 	"gvisor.dev/gvisor/pkg/waiter"
 )
 
-type TunConn struct {
-	Protocol tcpip.TransportProtocolNumber
-	Handler  interface{}
+// poolMetrics exposes connection pool contention, see -pprof-addr
+var poolMetrics = expvar.NewMap("netstack_pool")
+
+// TunConn is a flow handed over by the netstack, one of TCPConn, UDPConn or ICMPConn.
+// New protocols only need a new variant, the relay loop dispatches through handle
+type TunConn interface {
+	// Protocol returns the transport protocol of the flow
+	Protocol() tcpip.TransportProtocolNumber
+	// Terminate is called when connections need to be terminated. For now, this is only useful for TCP connections
+	Terminate(reset bool)
+
+	handle(nic *NIC, multiplex *yamux.Session, routes []route.Route, spoofSource bool, compress bool)
 }
 
-// IsTCP check if the current TunConn is TCP
-func (t TunConn) IsTCP() bool {
-	return t.Protocol == tcp.ProtocolNumber
+// streamConn is a TunConn relayed to the agent as a connection
+type streamConn interface {
+	TunConn
+
+	endpointID() stack.TransportEndpointID
+	transport() uint8
+	// open creates the local side of the relay, close is called once relaying is over
+	open(wq *waiter.Queue) (conn net.Conn, close func(), err error)
 }
 
-// GetTCP returns the handler as a TCPConn
-func (t TunConn) GetTCP() TCPConn {
-	return t.Handler.(TCPConn)
+var (
+	_ streamConn = TCPConn{}
+	_ streamConn = UDPConn{}
+	_ TunConn    = ICMPConn{}
+)
+
+// TCPConn represents a TCP Forwarder connection
+type TCPConn struct {
+	EndpointID stack.TransportEndpointID
+	Request    *tcp.ForwarderRequest
 }
 
-// IsUDP check if the current TunConn is UDP
-func (t TunConn) IsUDP() bool {
-	return t.Protocol == udp.ProtocolNumber
+func (c TCPConn) Protocol() tcpip.TransportProtocolNumber {
+	return tcp.ProtocolNumber
 }
 
-// GetUDP returns the handler as a UDPConn
-func (t TunConn) GetUDP() UDPConn {
-	return t.Handler.(UDPConn)
+func (c TCPConn) Terminate(reset bool) {
+	c.Request.Complete(reset)
 }
 
-// IsICMP check if the current TunConn is ICMP
-func (t TunConn) IsICMP() bool {
-	return t.Protocol == icmp.ProtocolNumber4
+func (c TCPConn) handle(nic *NIC, multiplex *yamux.Session, routes []route.Route, spoofSource bool, compress bool) {
+	nic.relay(c, multiplex, routes, spoofSource, compress)
 }
 
-// GetICMP returns the handler as a ICMPConn
-func (t TunConn) GetICMP() ICMPConn {
-	return t.Handler.(ICMPConn)
+func (c TCPConn) endpointID() stack.TransportEndpointID {
+	return c.EndpointID
 }
 
-// Terminate is call when connections need to be terminated. For now, this is only useful for TCP connections
-func (t TunConn) Terminate(reset bool) {
-	if t.IsTCP() {
-		t.GetTCP().Request.Complete(reset)
-	}
+func (c TCPConn) transport() uint8 {
+	return protocol.TransportTCP
 }
 
-// TCPConn represents a TCP Forwarder connection
-type TCPConn struct {
-	EndpointID stack.TransportEndpointID
-	Request    *tcp.ForwarderRequest
+func (c TCPConn) open(wq *waiter.Queue) (net.Conn, func(), error) {
+	ep, iperr := c.Request.CreateEndpoint(wq)
+	if iperr != nil {
+		return nil, nil, errors.New(iperr.String())
+	}
+
+	// I don't like this, but TIME_WAIT overflows within gvisor otherwise -- gotta investigate
+	return gonet.NewTCPConn(wq, ep), ep.Abort, nil
 }
 
 // UDPConn represents a UDP Forwarder connection
@@ -82,17 +105,86 @@ type UDPConn struct {
 	Request    *udp.ForwarderRequest
 }
 
+func (c UDPConn) Protocol() tcpip.TransportProtocolNumber {
+	return udp.ProtocolNumber
+}
+
+func (c UDPConn) Terminate(reset bool) {}
+
+func (c UDPConn) handle(nic *NIC, multiplex *yamux.Session, routes []route.Route, spoofSource bool, compress bool) {
+	nic.relay(c, multiplex, routes, spoofSource, compress)
+}
+
+func (c UDPConn) endpointID() stack.TransportEndpointID {
+	return c.EndpointID
+}
+
+func (c UDPConn) transport() uint8 {
+	return protocol.TransportUDP
+}
+
+func (c UDPConn) open(wq *waiter.Queue) (net.Conn, func(), error) {
+	ep, iperr := c.Request.CreateEndpoint(wq)
+	if iperr != nil {
+		return nil, nil, errors.New(iperr.String())
+	}
+
+	return gonet.NewUDPConn(wq, ep), func() {}, nil
+}
+
 // ICMPConn represents a ICMP Packet Buffer
 type ICMPConn struct {
-	Request stack.PacketBuffer
+	Request *stack.PacketBuffer
+}
+
+func (c ICMPConn) Protocol() tcpip.TransportProtocolNumber {
+	return icmp.ProtocolNumber4
+}
+
+func (c ICMPConn) Terminate(reset bool) {}
+
+func (c ICMPConn) handle(nic *NIC, multiplex *yamux.Session, routes []route.Route, spoofSource bool, compress bool) {
+	// ICMPs can't be relayed
+	nic.handleICMP(c, multiplex, routes)
 }
 
-// NetStack is the structure used to store the connection pool and the gvisor network stack
+// Flow is a connection the netstack relayed, reported once it is over
+type Flow struct {
+	Protocol    string
+	Source      netip.AddrPort
+	Destination netip.AddrPort
+	Sent        int64 // bytes sent to the destination
+	Received    int64 // bytes received from the destination
+	Start       time.Time
+	Duration    time.Duration
+}
+
+// NetStack is the gvisor network stack shared by all relaying sessions, each of them is attached through its own NIC
 type NetStack struct {
-	pool  *ConnPool
-	stack *stack.Stack
-	sync.Mutex
-	closeChan chan bool
+	stack          *stack.Stack
+	closeChan      chan bool
+	maxConnections int // pool size of each NIC
+	maxInFlight    int // TCP handshakes in flight per NIC
+
+	mu       sync.RWMutex
+	nics     map[tcpip.NICID]*NIC
+	sessions map[string]*NIC
+	lastID   tcpip.NICID
+}
+
+// NIC is the link of a single session to the netstack. Flows coming through it are queued in its own pool and
+// relayed to the agent of that session
+type NIC struct {
+	id          tcpip.NICID
+	sessionID   string
+	stack       *stack.Stack
+	pool        *ConnPool
+	mirror      *mirrorEndpoint
+	tcp         *tcp.Forwarder
+	udp         *udp.Forwarder
+	encoding    uint8      // session protocol encoding, set before relaying starts
+	compression uint8      // relay stream compression negotiated with the agent, set before relaying starts
+	onFlow      func(Flow) // set before relaying starts, may be nil
 }
 
 // GetStack returns the current Gvisor stack.Stack object
@@ -100,47 +192,187 @@ func (s *NetStack) GetStack() *stack.Stack {
 	return s.stack
 }
 
-// SetConnPool is used to change the current connPool. It must be used after switching Ligolo agents
-func (s *NetStack) SetConnPool(connPool *ConnPool) {
-	s.Lock()
-	s.pool = connPool
-	s.Unlock()
+// AddNIC attaches the tun of a session to the netstack, a session has at most one NIC
+func (s *NetStack) AddNIC(sessionID string, tunName string) (*NIC, error) {
+	if s.NIC(sessionID) != nil {
+		return nil, fmt.Errorf("session %s already has a NIC", sessionID)
+	}
+
+	linkEP, _, err := tun.New(tunName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.AddLinkNIC(sessionID, linkEP)
+}
+
+// AddLinkNIC attaches the session through any link endpoint, such as a tun.Channel when there is no kernel TUN
+func (s *NetStack) AddLinkNIC(sessionID string, linkEP stack.LinkEndpoint) (*NIC, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[sessionID]; ok {
+		return nil, fmt.Errorf("session %s already has a NIC", sessionID)
+	}
+
+	s.lastID++
+	nic := &NIC{
+		id:        s.lastID,
+		sessionID: sessionID,
+		stack:     s.stack,
+		pool:      NewConnPool(s.maxConnections),
+		mirror:    newMirrorEndpoint(linkEP), // wrap the link, so packets can be mirrored
+	}
+
+	nic.tcp = tcp.NewForwarder(s.stack, 0, s.maxInFlight, func(request *tcp.ForwarderRequest) {
+		nic.queue(TCPConn{
+			EndpointID: request.ID(),
+			Request:    request,
+		})
+	})
+	nic.udp = udp.NewForwarder(s.stack, func(request *udp.ForwarderRequest) {
+		nic.queue(UDPConn{
+			EndpointID: request.ID(),
+			Request:    request,
+		})
+	})
+
+	if err := s.stack.CreateNIC(nic.id, nic.mirror); err != nil {
+		return nil, errors.New(err.String())
+	}
+
+	// Allow all routes by default, flows are answered on the NIC they came from
+	s.stack.AddRoute(tcpip.Route{Destination: header.IPv4EmptySubnet, NIC: nic.id})
+	s.stack.AddRoute(tcpip.Route{Destination: header.IPv6EmptySubnet, NIC: nic.id})
+
+	// Allow packets from all sources/destinations
+	s.stack.SetPromiscuousMode(nic.id, true)
+	s.stack.SetSpoofing(nic.id, true)
+
+	s.nics[nic.id] = nic
+	s.sessions[sessionID] = nic
+
+	return nic, nil
+}
+
+// RemoveNIC detaches the session from the netstack and closes its pool
+func (s *NetStack) RemoveNIC(sessionID string) error {
+	s.mu.Lock()
+	nic, ok := s.sessions[sessionID]
+	if ok {
+		delete(s.sessions, sessionID)
+		delete(s.nics, nic.id)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("session %s has no NIC", sessionID)
+	}
+
+	nic.SetMirror(nil)
+	nic.pool.Close()
+	s.stack.RemoveRoutes(func(r tcpip.Route) bool {
+		return r.NIC == nic.id
+	})
+	if err := s.stack.RemoveNIC(nic.id); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// NIC returns the NIC of the session, nil if it has none
+func (s *NetStack) NIC(sessionID string) *NIC {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.sessions[sessionID]
+}
+
+func (s *NetStack) nic(id tcpip.NICID) *NIC {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.nics[id]
 }
 
 // Cleans up after gVisor. Couldn't find a better way
 func (s *NetStack) Destroy() error {
-	s.pool.Close()
+	s.mu.RLock()
+	var sessions []string
+	for sessionID := range s.sessions {
+		sessions = append(sessions, sessionID)
+	}
+	s.mu.RUnlock()
+
+	for _, sessionID := range sessions {
+		s.RemoveNIC(sessionID)
+	}
+
 	s.closeChan <- true
 	s.stack.Destroy()
 
 	return nil
 }
 
-func (s *NetStack) ClosePool() <-chan interface{} {
-	return s.pool.CloseChan
+// ID returns the gvisor NIC ID, unique while the netstack lives
+func (nic *NIC) ID() tcpip.NICID {
+	return nic.id
 }
 
-func (s *NetStack) GetTunConn() <-chan TunConn {
-	return s.pool.Pool
+// SessionID returns the session the NIC belongs to
+func (nic *NIC) SessionID() string {
+	return nic.sessionID
 }
 
-func (ns *NetStack) HandlePacket(localConn TunConn, multiplex *yamux.Session, localRoutes []route.Route) {
-	var endpointID stack.TransportEndpointID
-	var prototransport uint8
-	var protonet uint8
+// SetEncoding sets the encoding used for requests to the agent
+func (nic *NIC) SetEncoding(encoding uint8) {
+	nic.encoding = encoding
+}
 
-	switch localConn.Protocol {
-	case tcp.ProtocolNumber:
-		endpointID = localConn.GetTCP().EndpointID
-		prototransport = protocol.TransportTCP
-	case udp.ProtocolNumber:
-		endpointID = localConn.GetUDP().EndpointID
-		prototransport = protocol.TransportUDP
-	case icmp.ProtocolNumber4:
-		// ICMPs can't be relayed
-		ns.handleICMP(localConn, multiplex, localRoutes)
-		return
+// SetCompression sets how relayed streams get compressed when compression is on for them, CompressionNone if the
+// agent can't
+func (nic *NIC) SetCompression(compression uint8) {
+	nic.compression = compression
+}
+
+// SetFlowFunc sets what relayed flows get reported to
+func (nic *NIC) SetFlowFunc(f func(Flow)) {
+	nic.onFlow = f
+}
+
+// SetMirror replaces the sink receiving a copy of all packets of the NIC, nil disables mirroring
+func (nic *NIC) SetMirror(m Mirror) {
+	nic.mirror.setMirror(m)
+}
+
+func (nic *NIC) ClosePool() <-chan interface{} {
+	return nic.pool.CloseChan
+}
+
+func (nic *NIC) GetTunConn() <-chan TunConn {
+	return nic.pool.Pool
+}
+
+func (nic *NIC) HandlePacket(localConn TunConn, multiplex *yamux.Session, routes []route.Route, spoofSource bool, compress bool) {
+	localConn.handle(nic, multiplex, routes, spoofSource, compress)
+}
+
+func (nic *NIC) queue(conn TunConn) {
+	if nic.pool.Closed() {
+		return // If connPool is closed, ignore packet.
+	}
+
+	if err := nic.pool.Add(conn); err != nil {
+		slog.Error("Netstack encountered an error", slog.Any("error", err))
 	}
+}
+
+// relay asks the agent to connect to the flow destination and pipes the flow through
+func (nic *NIC) relay(localConn streamConn, multiplex *yamux.Session, routes []route.Route, spoofSource bool, compress bool) {
+	endpointID := localConn.endpointID()
+	prototransport := localConn.transport()
+	var protonet uint8
 
 	if endpointID.LocalAddress.To4() != (tcpip.Address{}) {
 		protonet = protocol.Networkv4
@@ -149,17 +381,28 @@ func (ns *NetStack) HandlePacket(localConn TunConn, multiplex *yamux.Session, lo
 	}
 
 	address := endpointID.LocalAddress.String()
-	for _, localRoute := range localRoutes {
-		ip := net.ParseIP(address)
-		if localRoute.Cidr.Contains(ip) {
-			if protonet == protocol.Networkv4 {
-				address = "127.0.0.1"
-			} else {
-				address = "::1"
-			}
 
-			break
+	matched := matchRoute(routes, net.ParseIP(address))
+	if matched != nil && matched.IsExclusion {
+		slog.Debug("destination is excluded from session", slog.Any("route", matched), slog.Any("address", address))
+		localConn.Terminate(true)
+		return
+	}
+
+	if matched != nil && !matched.AllowsPort(endpointID.LocalPort) {
+		slog.Debug("port is filtered by route", slog.Any("route", matched), slog.Any("port", endpointID.LocalPort))
+		localConn.Terminate(true)
+		return
+	}
+
+	isLoopback := false
+	if matched != nil && matched.IsLoopback {
+		if protonet == protocol.Networkv4 {
+			address = "127.0.0.1"
+		} else {
+			address = "::1"
 		}
+		isLoopback = true
 	}
 
 	connectPacket := protocol.ConnectRequestPacket{
@@ -169,6 +412,18 @@ func (ns *NetStack) HandlePacket(localConn TunConn, multiplex *yamux.Session, lo
 		Port:      endpointID.LocalPort,
 	}
 
+	if spoofSource && !isLoopback {
+		connectPacket.SourceAddress = endpointID.RemoteAddress.String()
+	}
+
+	// datagrams are left alone, they are small and relayed one by one
+	if matched != nil {
+		compress = matched.Compresses(compress)
+	}
+	if compress && prototransport == protocol.TransportTCP {
+		connectPacket.Compression = nic.compression
+	}
+
 	yamuxConnectionSession, err := multiplex.Open()
 	if err != nil {
 		slog.Debug("Packet handler encountered an error #1",
@@ -179,6 +434,7 @@ func (ns *NetStack) HandlePacket(localConn TunConn, multiplex *yamux.Session, lo
 	defer yamuxConnectionSession.Close()
 
 	protocolEncoder := protocol.NewEncoder(yamuxConnectionSession)
+	protocolEncoder.SetEncoding(nic.encoding)
 	protocolDecoder := protocol.NewDecoder(yamuxConnectionSession)
 
 	if err := protocolEncoder.Encode(protocol.Envelope{
@@ -202,42 +458,90 @@ func (ns *NetStack) HandlePacket(localConn TunConn, multiplex *yamux.Session, lo
 
 	response := protocolDecoder.Envelope.Payload
 	reply := response.(protocol.ConnectResponsePacket)
+	if reply.Established && connectPacket.SourceAddress != "" && !reply.Spoofed {
+		slog.Debug("agent could not spoof source address, connected with its own",
+			slog.Any("source", connectPacket.SourceAddress),
+			slog.Any("address", address),
+		)
+	}
+
 	if reply.Established {
 		defer localConn.Terminate(true)
 		var wq waiter.Queue
-		if localConn.IsTCP() {
-			ep, iperr := localConn.GetTCP().Request.CreateEndpoint(&wq)
-			if iperr != nil {
-				slog.Debug("Packet handler encountered an error #4",
-					slog.Any("error", iperr),
-				)
-				return
-			}
-			gonetConn := gonet.NewTCPConn(&wq, ep)
-			relay.StartRelay(yamuxConnectionSession, gonetConn)
-			ep.Abort() // I don't like this, but TIME_WAIT overflows within gvisor otherwise -- gotta investigate
-		} else if localConn.IsUDP() {
-			defer localConn.Terminate(false)
-			ep, iperr := localConn.GetUDP().Request.CreateEndpoint(&wq)
-			if iperr != nil {
-				slog.Error("Packet handler encountered an error #5",
-					slog.Any("error", iperr),
-				)
-				return
-			}
+		gonetConn, closeConn, err := localConn.open(&wq)
+		if err != nil {
+			slog.Debug("Packet handler encountered an error #4",
+				slog.Any("error", err),
+			)
+			return
+		}
+		start := time.Now()
+		if matched != nil {
+			matched.Usage.Open(start)
+		}
 
-			gonetConn := gonet.NewUDPConn(&wq, ep)
-			relay.StartRelay(yamuxConnectionSession, gonetConn)
+		received, sent := relay.StartRelay(protocol.Compress(yamuxConnectionSession, connectPacket.Compression), gonetConn)
+		closeConn()
+
+		if matched != nil {
+			matched.Usage.Close(sent+received, time.Now())
+		}
+
+		if nic.onFlow != nil {
+			nic.onFlow(Flow{
+				Protocol:    transportName(prototransport),
+				Source:      netip.AddrPortFrom(addrFrom(endpointID.RemoteAddress), endpointID.RemotePort),
+				Destination: netip.AddrPortFrom(addrFrom(endpointID.LocalAddress), endpointID.LocalPort),
+				Sent:        sent,
+				Received:    received,
+				Start:       start,
+				Duration:    time.Since(start),
+			})
 		}
 	} else {
 		localConn.Terminate(reply.Reset)
 	}
 }
 
+func transportName(transport uint8) string {
+	switch transport {
+	case protocol.TransportTCP:
+		return "tcp"
+	case protocol.TransportUDP:
+		return "udp"
+	default:
+		return "unknown"
+	}
+}
+
+func addrFrom(address tcpip.Address) netip.Addr {
+	addr, _ := netip.AddrFromSlice(address.AsSlice())
+	return addr
+}
+
+// matchRoute returns the most specific route containing the IP, exclusions included
+func matchRoute(routes []route.Route, ip net.IP) *route.Route {
+	var result *route.Route
+	bestLen := -1
+	for i := range routes {
+		if !routes[i].Cidr.Contains(ip) {
+			continue
+		}
+
+		ones, _ := routes[i].Cidr.Mask.Size()
+		if ones > bestLen {
+			bestLen = ones
+			result = &routes[i]
+		}
+	}
+
+	return result
+}
+
 // icmpResponder handle ICMP packets coming to gvisor/netstack.
 // Instead of responding to all ICMPs ECHO by default, we try to
 // execute a ping on the Agent, and depending of the response, we
-// send a ICMP reply back.
+// send a ICMP reply back. Packets go to the NIC they came from.
 func (ns *NetStack) icmpResponder() (chan bool, error) {
 	quit := make(chan bool)
 	var wq waiter.Queue
@@ -266,7 +570,7 @@ func (ns *NetStack) icmpResponder() (chan bool, error) {
 				case <-quit:
 					return
 				case <-ch:
-					_, err := rawProto.Read(&buff, tcpip.ReadOptions{})
+					res, err := rawProto.Read(&buff, tcpip.ReadOptions{NeedRemoteAddr: true})
 
 					if err != nil {
 						if _, ok := err.(*tcpip.ErrWouldBlock); ok {
@@ -296,25 +600,20 @@ func (ns *NetStack) icmpResponder() (chan bool, error) {
 					packetbuff.NetworkProtocolNumber = ipv4.ProtocolNumber
 					packetbuff.TransportProtocolNumber = icmp.ProtocolNumber4
 					packetbuff.NetworkHeader().Consume(hlen)
-					tunConn := TunConn{
-						Protocol: icmp.ProtocolNumber4,
-						Handler:  ICMPConn{Request: *packetbuff},
-					}
+					tunConn := ICMPConn{Request: packetbuff}
 
-					ns.Lock()
-					if ns.pool == nil || ns.pool.Closed() {
-						ns.Unlock()
-						continue // If connPool is closed, ignore packet.
+					nic := ns.nic(res.RemoteAddr.NIC)
+					if nic == nil || nic.pool.Closed() {
+						packetbuff.DecRef()
+						continue // If the NIC is gone or its connPool is closed, ignore packet.
 					}
 
-					if err := ns.pool.Add(tunConn); err != nil {
-						ns.Unlock()
+					if err := nic.pool.Add(tunConn); err != nil {
 						slog.Error("ICMP responder encountered an error",
 							slog.Any("error", err),
 						)
 						continue // Unknown error, continue...
 					}
-					ns.Unlock()
 				}
 			}
 
@@ -325,8 +624,10 @@ func (ns *NetStack) icmpResponder() (chan bool, error) {
 
 // handleICMP process incoming ICMP packets and, depending on the target host status, respond a ICMP ECHO Reply
 // Please note that other ICMP messages are not yet supported.
-func (ns *NetStack) handleICMP(localConn TunConn, multiplex *yamux.Session, localRoutes []route.Route) {
-	pkt := localConn.GetICMP().Request
+func (nic *NIC) handleICMP(localConn ICMPConn, multiplex *yamux.Session, routes []route.Route) {
+	pkt := localConn.Request
+	defer pkt.DecRef()
+
 	v, ok := pkt.Data().PullUp(header.ICMPv4MinimumSize)
 	if !ok {
 		return
@@ -336,11 +637,13 @@ func (ns *NetStack) handleICMP(localConn TunConn, multiplex *yamux.Session, loca
 		iph := header.IPv4(pkt.NetworkHeader().Slice())
 
 		address := iph.DestinationAddress().String()
-		for _, localRoute := range localRoutes {
-			ip := net.ParseIP(address)
-			if localRoute.Cidr.Contains(ip) {
+		if matched := matchRoute(routes, net.ParseIP(address)); matched != nil {
+			if matched.IsExclusion {
+				return
+			}
+
+			if matched.IsLoopback {
 				address = "127.0.0.1"
-				break
 			}
 		}
 
@@ -359,6 +662,7 @@ func (ns *NetStack) handleICMP(localConn TunConn, multiplex *yamux.Session, loca
 		icmpPacket := protocol.HostPingRequestPacket{Address: address}
 
 		protocolEncoder := protocol.NewEncoder(yamuxConnectionSession)
+		protocolEncoder.SetEncoding(nic.encoding)
 		protocolDecoder := protocol.NewDecoder(yamuxConnectionSession)
 
 		if err := protocolEncoder.Encode(protocol.Envelope{
@@ -383,7 +687,7 @@ func (ns *NetStack) handleICMP(localConn TunConn, multiplex *yamux.Session, loca
 		reply := response.(protocol.HostPingResponsePacket)
 		if reply.Alive {
 			slog.Debug("Host is alive, sending reply")
-			ns.ProcessICMP(&pkt)
+			nic.ProcessICMP(pkt)
 
 		}
 
@@ -393,7 +697,7 @@ func (ns *NetStack) handleICMP(localConn TunConn, multiplex *yamux.Session, loca
 
 // ProcessICMP send back a ICMP echo reply from after receiving a echo request.
 // This code come mostly from pkg/tcpip/network/ipv4/icmp.go
-func (ns *NetStack) ProcessICMP(pkt *stack.PacketBuffer) {
+func (nic *NIC) ProcessICMP(pkt *stack.PacketBuffer) {
 	// (gvisor) pkg/tcpip/network/ipv4/icmp.go:174 - handleICMP
 
 	// ICMP packets don't have their TransportHeader fields set. See
@@ -441,7 +745,7 @@ func (ns *NetStack) ProcessICMP(pkt *stack.PacketBuffer) {
 			localAddr = tcpip.Address{}
 		}
 
-		r, err := ns.stack.FindRoute(1, localAddr, ipHdr.SourceAddress(), ipv4.ProtocolNumber, false /* multicastLoop */)
+		r, err := nic.stack.FindRoute(nic.id, localAddr, ipHdr.SourceAddress(), ipv4.ProtocolNumber, false /* multicastLoop */)
 		if err != nil {
 			// If we cannot find a route to the destination, silently drop the packet.
 			return
@@ -472,41 +776,55 @@ func (ns *NetStack) ProcessICMP(pkt *stack.PacketBuffer) {
 	}
 }
 
+// ConnPool queues new flows for the relay. Pool is never closed, consumers have to watch CloseChan
+// so that producers never need a lock around sending
 type ConnPool struct {
 	CloseChan chan interface{}
 	Pool      chan TunConn
-	sync.Mutex
+	closeOnce sync.Once
 }
 
 func NewConnPool(size int) *ConnPool {
 	return &ConnPool{CloseChan: make(chan interface{}), Pool: make(chan TunConn, size)}
 }
+
 func (p *ConnPool) Add(packet TunConn) error {
-	p.Lock()
-	defer p.Unlock()
+	if p.Closed() {
+		return errors.New("pool is closed")
+	}
 
 	select {
-	case <-p.CloseChan:
-		return errors.New("pool is closed")
+	case p.Pool <- packet:
+		poolMetrics.Add("queued", 1)
+		return nil
 	default:
-		p.Pool <- packet
 	}
-	return nil
-}
 
-func (p *ConnPool) Close() error {
-	p.Lock()
-	defer p.Unlock()
+	// pool is full, the relay can't keep up
+	start := time.Now()
+	poolMetrics.Add("blocked", 1)
+	defer func() {
+		poolMetrics.Add("blocked_ns", int64(time.Since(start)))
+	}()
 
 	select {
+	case p.Pool <- packet:
+		poolMetrics.Add("queued", 1)
+		return nil
 	case <-p.CloseChan:
-		return errors.New("pool is already closed")
-	default:
-		close(p.CloseChan)
-		close(p.Pool)
-		p.Pool = nil
+		poolMetrics.Add("dropped", 1)
+		return errors.New("pool is closed")
 	}
-	return nil
+}
+
+func (p *ConnPool) Close() error {
+	err := errors.New("pool is already closed")
+	p.closeOnce.Do(func() {
+		close(p.CloseChan)
+		err = nil
+	})
+
+	return err
 }
 
 func (p *ConnPool) Closed() bool {
@@ -519,20 +837,28 @@ func (p *ConnPool) Closed() bool {
 }
 
 func (p *ConnPool) Get() (TunConn, error) {
-	p.Lock()
-	defer p.Unlock()
 	select {
 	case <-p.CloseChan:
-		return TunConn{}, errors.New("pool is closed")
+		return nil, errors.New("pool is closed")
 	case tunconn := <-p.Pool:
 		return tunconn, nil
 	}
 }
 
-func NewNetstack(maxConnections int, maxInFlight int, tunName string) (*NetStack, error) {
-	connPool := NewConnPool(maxConnections)
+// TCPOptions tunes the TCP implementation of the netstack
+type TCPOptions struct {
+	SACK          bool // selective acknowledgements
+	WindowScaling bool // receive windows larger than 64KiB
+	AutoTuning    bool // receive buffer auto-tuning
+}
+
+// NewNetstack creates a netstack without any NIC, sessions attach to it with AddNIC
+func NewNetstack(maxConnections int, maxInFlight int, tcpOptions TCPOptions) (*NetStack, error) {
 	ns := &NetStack{
-		pool: connPool,
+		maxConnections: maxConnections,
+		maxInFlight:    maxInFlight,
+		nics:           make(map[tcpip.NICID]*NIC),
+		sessions:       make(map[string]*NIC),
 	}
 	ns.stack = stack.New(stack.Options{
 		NetworkProtocols: []stack.NetworkProtocolFactory{
@@ -552,63 +878,22 @@ func NewNetstack(maxConnections int, maxInFlight int, tunName string) (*NetStack
 	ns.stack.SetICMPLimit(0)
 	ns.stack.SetICMPBurst(0)
 
-	// Forward TCP connections
-	tcpHandler := tcp.NewForwarder(ns.stack, 0, maxInFlight, func(request *tcp.ForwarderRequest) {
-		tcpConn := TCPConn{
-			EndpointID: request.ID(),
-			Request:    request,
-		}
-
-		ns.Lock()
-		defer ns.Unlock()
-		if ns.pool == nil || ns.pool.Closed() {
-			return // If connPool is closed, ignore packet.
-		}
-
-		if err := ns.pool.Add(TunConn{
-			tcp.ProtocolNumber,
-			tcpConn,
-		}); err != nil {
-			slog.Error("Netstack encountered an error", slog.Any("error", err))
+	// Forward TCP and UDP connections to the forwarders of the NIC they came from
+	ns.stack.SetTransportProtocolHandler(tcp.ProtocolNumber, func(id stack.TransportEndpointID, pkt *stack.PacketBuffer) bool {
+		nic := ns.nic(pkt.NICID)
+		if nic == nil {
+			return false
 		}
+		return nic.tcp.HandlePacket(id, pkt)
 	})
-
-	// Forward UDP connections
-	udpHandler := udp.NewForwarder(ns.stack, func(request *udp.ForwarderRequest) {
-		udpConn := UDPConn{
-			EndpointID: request.ID(),
-			Request:    request,
-		}
-
-		ns.Lock()
-		defer ns.Unlock()
-
-		if ns.pool == nil || ns.pool.Closed() {
-			return // If connPool is closed, ignore packet.
-		}
-
-		if err := ns.pool.Add(TunConn{
-			udp.ProtocolNumber,
-			udpConn,
-		}); err != nil {
-			slog.Error("Netstack encountered an error", slog.Any("error", err))
+	ns.stack.SetTransportProtocolHandler(udp.ProtocolNumber, func(id stack.TransportEndpointID, pkt *stack.PacketBuffer) bool {
+		nic := ns.nic(pkt.NICID)
+		if nic == nil {
+			return false
 		}
+		return nic.udp.HandlePacket(id, pkt)
 	})
 
-	// Register forwarders
-	ns.stack.SetTransportProtocolHandler(tcp.ProtocolNumber, tcpHandler.HandlePacket)
-	ns.stack.SetTransportProtocolHandler(udp.ProtocolNumber, udpHandler.HandlePacket)
-
-	linkEP, _, err := tun.New(tunName)
-	if err != nil {
-		return nil, err
-	}
-
-	// Create a new NIC
-	if err := ns.stack.CreateNIC(1, linkEP); err != nil {
-		return nil, errors.New(err.String())
-	}
-
 	// Start a endpoint that will reply to ICMP echo queries
 	closeChan, err := ns.icmpResponder()
 	if err != nil {
@@ -617,33 +902,31 @@ func NewNetstack(maxConnections int, maxInFlight int, tunName string) (*NetStack
 
 	ns.closeChan = closeChan
 
-	// Allow all routes by default
-	ns.stack.SetRouteTable([]tcpip.Route{
-		{
-			Destination: header.IPv4EmptySubnet,
-			NIC:         1,
-		},
-		{
-			Destination: header.IPv6EmptySubnet,
-			NIC:         1,
-		},
-	})
-
 	// Enable forwarding
 	ns.stack.SetForwardingDefaultAndAllNICs(ipv4.ProtocolNumber, false)
 	ns.stack.SetForwardingDefaultAndAllNICs(ipv6.ProtocolNumber, false)
 
 	// Enable TCP SACK
-	nsacks := tcpip.TCPSACKEnabled(false)
+	nsacks := tcpip.TCPSACKEnabled(tcpOptions.SACK)
 	ns.stack.SetTransportProtocolOption(tcp.ProtocolNumber, &nsacks)
 
+	// Window scale is only negotiated when the receive buffer can grow past 64KiB
+	if !tcpOptions.WindowScaling {
+		rcvBuf := tcpip.TCPReceiveBufferSizeRangeOption{
+			Min:     tcp.MinBufferSize,
+			Default: math.MaxUint16,
+			Max:     math.MaxUint16,
+		}
+		ns.stack.SetTransportProtocolOption(tcp.ProtocolNumber, &rcvBuf)
+	}
+
+	// Receive buffer auto-tuning
+	moderateRcvBuf := tcpip.TCPModerateReceiveBufferOption(tcpOptions.AutoTuning)
+	ns.stack.SetTransportProtocolOption(tcp.ProtocolNumber, &moderateRcvBuf)
+
 	// Disable SYN-Cookies, as this can mess with nmap scans
 	synCookies := tcpip.TCPAlwaysUseSynCookies(false)
 	ns.stack.SetTransportProtocolOption(tcp.ProtocolNumber, &synCookies)
 
-	// Allow packets from all sources/destinations
-	ns.stack.SetPromiscuousMode(1, true)
-	ns.stack.SetSpoofing(1, true)
-
 	return ns, nil
 }
@@ -0,0 +1,56 @@
+package netstack
+
+import (
+	"testing"
+
+	"gvisor.dev/gvisor/pkg/tcpip/transport/tcp"
+)
+
+func TestConnPoolClosed(t *testing.T) {
+	pool := NewConnPool(1)
+	if err := pool.Add(TCPConn{}); err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+
+	conn, err := pool.Get()
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if conn.Protocol() != tcp.ProtocolNumber {
+		t.Fatalf("unexpected protocol %d", conn.Protocol())
+	}
+
+	if err := pool.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	if err := pool.Close(); err == nil {
+		t.Fatal("closing twice should fail")
+	}
+	if err := pool.Add(UDPConn{}); err == nil {
+		t.Fatal("add on a closed pool should fail")
+	}
+}
+
+func BenchmarkConnPoolDispatch(b *testing.B) {
+	pool := NewConnPool(1024)
+	conns := []TunConn{TCPConn{}, UDPConn{}, ICMPConn{}}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := pool.Add(conns[i%len(conns)]); err != nil {
+			b.Fatal(err)
+		}
+
+		conn, err := pool.Get()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		switch conn.(type) {
+		case streamConn:
+		case ICMPConn:
+		default:
+			b.Fatalf("unexpected variant %T", conn)
+		}
+	}
+}
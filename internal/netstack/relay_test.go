@@ -0,0 +1,177 @@
+package netstack
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp/v2/internal/netstack/tun"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	"gvisor.dev/gvisor/pkg/tcpip"
+	"gvisor.dev/gvisor/pkg/tcpip/adapters/gonet"
+	"gvisor.dev/gvisor/pkg/tcpip/header"
+	"gvisor.dev/gvisor/pkg/tcpip/network/ipv4"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/tcp"
+)
+
+// echoAgent answers connect requests like an agent would, then echoes whatever it gets back
+func echoAgent(t *testing.T, conn net.Conn, requests chan<- protocol.ConnectRequestPacket) {
+	config := yamux.DefaultConfig()
+	config.LogOutput = io.Discard
+	session, err := yamux.Server(conn, config)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for {
+		stream, err := session.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer stream.Close()
+
+			decoder := protocol.NewDecoder(stream)
+			if err := decoder.Decode(); err != nil {
+				return
+			}
+			request := decoder.Envelope.Payload.(protocol.ConnectRequestPacket)
+			requests <- request
+
+			encoder := protocol.NewEncoder(stream)
+			if err := encoder.Encode(protocol.Envelope{
+				Type:    protocol.MessageConnectResponse,
+				Payload: protocol.ConnectResponsePacket{Established: true},
+			}); err != nil {
+				return
+			}
+
+			relayed := protocol.Compress(stream, request.Compression)
+			io.Copy(relayed, relayed)
+		}()
+	}
+}
+
+// bridge carries packets between two links, like a kernel routing between a TUN and a process
+func bridge(ctx context.Context, from *tun.Channel, to *tun.Channel) {
+	for {
+		packet := from.ReadPacket(ctx)
+		if packet == nil {
+			return
+		}
+		to.InjectPacket(packet)
+	}
+}
+
+func TestRelayOverChannelLink(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	ns, err := NewNetstack(16, 16, TCPOptions{SACK: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ns.Destroy()
+
+	link := tun.NewChannel(256, 1500)
+	nic, err := ns.AddLinkNIC("session", link)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flows := make(chan Flow, 1)
+	nic.SetFlowFunc(func(flow Flow) { flows <- flow })
+	nic.SetCompression(protocol.CompressionSnappy)
+
+	// the operator side, where the kernel would be
+	client := stack.New(stack.Options{
+		NetworkProtocols:   []stack.NetworkProtocolFactory{ipv4.NewProtocol},
+		TransportProtocols: []stack.TransportProtocolFactory{tcp.NewProtocol},
+	})
+	defer client.Destroy()
+
+	clientLink := tun.NewChannel(256, 1500)
+	if err := client.CreateNIC(1, clientLink); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.AddProtocolAddress(1, tcpip.ProtocolAddress{
+		Protocol:          ipv4.ProtocolNumber,
+		AddressWithPrefix: tcpip.AddrFrom4([4]byte{10, 0, 0, 1}).WithPrefix(),
+	}, stack.AddressProperties{}); err != nil {
+		t.Fatal(err)
+	}
+	client.SetRouteTable([]tcpip.Route{{Destination: header.IPv4EmptySubnet, NIC: 1}})
+
+	go bridge(ctx, link, clientLink)
+	go bridge(ctx, clientLink, link)
+
+	serverConn, agentConn := net.Pipe()
+	defer serverConn.Close()
+
+	requests := make(chan protocol.ConnectRequestPacket, 1)
+	go echoAgent(t, agentConn, requests)
+
+	config := yamux.DefaultConfig()
+	config.LogOutput = io.Discard
+	multiplex, err := yamux.Client(serverConn, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-nic.ClosePool():
+				return
+			case conn := <-nic.GetTunConn():
+				go nic.HandlePacket(conn, multiplex, nil, false, true)
+			}
+		}
+	}()
+
+	conn, err := gonet.DialContextTCP(ctx, client, tcpip.FullAddress{
+		NIC:  1,
+		Addr: tcpip.AddrFrom4([4]byte{192, 0, 2, 10}),
+		Port: 8080,
+	}, ipv4.ProtocolNumber)
+	if err != nil {
+		t.Fatalf("dial failed: %v", err)
+	}
+
+	select {
+	case request := <-requests:
+		if request.Address != "192.0.2.10" || request.Port != 8080 || request.Transport != protocol.TransportTCP || request.Compression != protocol.CompressionSnappy {
+			t.Fatalf("unexpected connect request: %+v", request)
+		}
+	case <-ctx.Done():
+		t.Fatal("agent got no connect request")
+	}
+
+	if _, err := conn.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	reply := make([]byte, 5)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		t.Fatal(err)
+	}
+	if string(reply) != "hello" {
+		t.Fatalf("unexpected echo %q", reply)
+	}
+	conn.Close()
+
+	select {
+	case flow := <-flows:
+		if flow.Protocol != "tcp" || flow.Destination.String() != "192.0.2.10:8080" || flow.Sent != 5 || flow.Received != 5 {
+			t.Fatalf("unexpected flow: %+v", flow)
+		}
+	case <-ctx.Done():
+		t.Fatal("flow was not reported")
+	}
+}
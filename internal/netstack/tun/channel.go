@@ -0,0 +1,56 @@
+package tun
+
+import (
+	"context"
+	"errors"
+
+	"gvisor.dev/gvisor/pkg/buffer"
+	"gvisor.dev/gvisor/pkg/tcpip"
+	"gvisor.dev/gvisor/pkg/tcpip/header"
+	"gvisor.dev/gvisor/pkg/tcpip/link/channel"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
+)
+
+// Channel is a link endpoint backed by Go channels instead of a kernel TUN, so the netstack can be driven without
+// privileges and on any platform. Raw IP packets go in through InjectPacket and come out of ReadPacket
+type Channel struct {
+	*channel.Endpoint
+}
+
+// NewChannel creates a link queueing up to size outbound packets
+func NewChannel(size int, mtu uint32) *Channel {
+	return &Channel{Endpoint: channel.New(size, mtu, "")}
+}
+
+// InjectPacket hands an IPv4 or IPv6 packet to the netstack, as if it was read from a TUN
+func (c *Channel) InjectPacket(packet []byte) error {
+	var protocol tcpip.NetworkProtocolNumber
+	switch header.IPVersion(packet) {
+	case header.IPv4Version:
+		protocol = header.IPv4ProtocolNumber
+	case header.IPv6Version:
+		protocol = header.IPv6ProtocolNumber
+	default:
+		return errors.New("not an IP packet")
+	}
+
+	pkt := stack.NewPacketBuffer(stack.PacketBufferOptions{
+		Payload: buffer.MakeWithData(packet),
+	})
+	defer pkt.DecRef()
+
+	c.InjectInbound(protocol, pkt)
+
+	return nil
+}
+
+// ReadPacket returns the next packet the netstack sent, nil once ctx is done
+func (c *Channel) ReadPacket(ctx context.Context) []byte {
+	pkt := c.ReadContext(ctx)
+	if pkt == nil {
+		return nil
+	}
+	defer pkt.DecRef()
+
+	return pkt.ToView().AsSlice()
+}
@@ -7,6 +7,10 @@ import (
 	"github.com/vishvananda/netlink"
 )
 
+func Available() error {
+	return fmt.Errorf("not implemented for this architecture")
+}
+
 func New() (int, string, error) {
 	return 0, "", fmt.Errorf("not implmeneted for this architecture")
 }
@@ -30,3 +34,11 @@ func GetName(ID int) (string, error) {
 func GetRoute(address net.IP) ([]netlink.Route, error) {
 	return nil, fmt.Errorf("not implemented for this architecture")
 }
+
+func AddBypassRoute(ID int, CIDR *net.IPNet, metric int) error {
+	return fmt.Errorf("not implemented for this architecture")
+}
+
+func RemoveBypassRoute(CIDR *net.IPNet, metric int) error {
+	return fmt.Errorf("not implemented for this architecture")
+}
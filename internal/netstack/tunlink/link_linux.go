@@ -3,11 +3,23 @@
 package tunlink
 
 import (
+	"fmt"
 	"net"
+	"os"
 
 	"github.com/vishvananda/netlink"
 )
 
+// Available tells whether TUN interfaces can be created, containers often come without /dev/net/tun
+func Available() error {
+	f, err := os.OpenFile("/dev/net/tun", os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
 func New() (int, string, error) {
 	la := netlink.NewLinkAttrs()
 	link := &netlink.Tuntap{
@@ -85,3 +97,50 @@ func GetRoute(address net.IP) ([]netlink.Route, error) {
 
 	return routes, nil
 }
+
+// bypassProtocol tags routes that keep excluded networks out of the tunnels
+const bypassProtocol = netlink.RouteProtocol(0x4c)
+
+// AddBypassRoute routes CIDR the way the system would without the tun link
+func AddBypassRoute(ID int, CIDR *net.IPNet, metric int) error {
+	routes, err := netlink.RouteGet(CIDR.IP)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range routes {
+		if r.LinkIndex == ID {
+			continue
+		}
+
+		route := &netlink.Route{
+			LinkIndex: r.LinkIndex,
+			Dst:       CIDR,
+			Gw:        r.Gw,
+			Priority:  metric,
+			Protocol:  bypassProtocol,
+		}
+		return netlink.RouteAdd(route)
+	}
+
+	return fmt.Errorf("no route to %s outside of the tunnel", CIDR)
+}
+
+func RemoveBypassRoute(CIDR *net.IPNet, metric int) error {
+	filter := &netlink.Route{
+		Dst:      CIDR,
+		Protocol: bypassProtocol,
+	}
+	routes, err := netlink.RouteListFiltered(netlink.FAMILY_ALL, filter, netlink.RT_FILTER_DST|netlink.RT_FILTER_PROTOCOL)
+	if err != nil {
+		return err
+	}
+
+	for _, route := range routes {
+		if route.Priority == metric {
+			netlink.RouteDel(&route)
+		}
+	}
+
+	return nil
+}
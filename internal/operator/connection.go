@@ -0,0 +1,108 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/grpc"
+)
+
+// Connection is a single authenticated channel to the server, shared by all views of the client
+type Connection struct {
+	conn        *grpc.ClientConn
+	subMutex    sync.Mutex
+	subscribers map[string]chan *pb.Event
+	join        context.Context
+	cancelJoin  context.CancelFunc
+}
+
+// Subscribe registers a named listener for server events. All subscribers are fed from one event stream,
+// the channel is closed when the connection goes away
+func (oper *Operator) Subscribe(name string) (<-chan *pb.Event, error) {
+	oper.subMutex.Lock()
+	defer oper.subMutex.Unlock()
+
+	if oper.client == nil {
+		return nil, errors.New("not connected")
+	}
+
+	if oper.join == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := oper.client.Join(ctx, &pb.Empty{})
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		oper.join = ctx
+		oper.cancelJoin = cancel
+		oper.subscribers = make(map[string]chan *pb.Event)
+		go oper.dispatchEvents(ctx, stream)
+	}
+
+	if _, exists := oper.subscribers[name]; exists {
+		return nil, errors.New("already subscribed")
+	}
+
+	events := make(chan *pb.Event, 64)
+	oper.subscribers[name] = events
+
+	return events, nil
+}
+
+func (oper *Operator) Unsubscribe(name string) {
+	oper.subMutex.Lock()
+	defer oper.subMutex.Unlock()
+
+	if events, ok := oper.subscribers[name]; ok {
+		close(events)
+		delete(oper.subscribers, name)
+	}
+}
+
+func (oper *Operator) dispatchEvents(ctx context.Context, stream pb.Ligolo_JoinClient) {
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			oper.subMutex.Lock()
+			if oper.join == ctx { // otherwise the connection was already reset
+				oper.resetSubscriptions()
+			}
+			oper.subMutex.Unlock()
+			return
+		}
+
+		oper.subMutex.Lock()
+		if oper.join == ctx {
+			for _, events := range oper.subscribers {
+				select {
+				case events <- event:
+				default: // slow subscriber, it will catch up on the next event
+				}
+			}
+		}
+		oper.subMutex.Unlock()
+	}
+}
+
+func (oper *Operator) closeSubscriptions() {
+	oper.subMutex.Lock()
+	defer oper.subMutex.Unlock()
+
+	oper.resetSubscriptions()
+}
+
+func (oper *Operator) resetSubscriptions() {
+	if oper.cancelJoin != nil {
+		oper.cancelJoin()
+		oper.cancelJoin = nil
+		oper.join = nil
+	}
+
+	for name, events := range oper.subscribers {
+		close(events)
+		delete(oper.subscribers, name)
+	}
+}
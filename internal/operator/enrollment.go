@@ -0,0 +1,138 @@
+package operator
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// enrollmentScheme prefixes enrollment codes, e.g. ligolo-enroll://1.2.3.4:58009/<token>?ca=<pin>
+const enrollmentScheme = "ligolo-enroll"
+
+// Enrollment lets a new teammate fetch their operator config once, with a token, instead of an admin exporting it and
+// handing it over. Only a hash of the token is kept, the operator is created when the token is used
+type Enrollment struct {
+	ID        string
+	TokenHash []byte
+	Name      string
+	Role      string
+	Server    string
+	CA        string // intermediate CA the certificate is issued from, the server CA if empty
+	CreatedBy string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// newEnrollmentToken returns a token, <ID>.<secret>, and the hash of its secret
+func newEnrollmentToken() (string, string, []byte, error) {
+	id := make([]byte, 6)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", nil, err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", nil, err
+	}
+
+	encodedSecret := base64.RawURLEncoding.EncodeToString(secret)
+	hash := sha256.Sum256([]byte(encodedSecret))
+
+	return hex.EncodeToString(id), fmt.Sprintf("%s.%s", hex.EncodeToString(id), encodedSecret), hash[:], nil
+}
+
+func splitEnrollmentToken(token string) (string, string, error) {
+	id, secret, ok := strings.Cut(token, ".")
+	if !ok || id == "" || secret == "" {
+		return "", "", errors.New("malformed enrollment token")
+	}
+
+	return id, secret, nil
+}
+
+// Matches tells in constant time whether the secret is the one the enrollment was created with
+func (e *Enrollment) Matches(secret string) bool {
+	hash := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare(hash[:], e.TokenHash) == 1
+}
+
+func (e *Enrollment) Expired() bool {
+	return time.Now().After(e.ExpiresAt)
+}
+
+func (e *Enrollment) String() string {
+	return fmt.Sprintf("ID=%s Name=%s Role=%s Server=%s CreatedBy=%s ExpiresAt=%s", e.ID, e.Name, e.Role, e.Server, e.CreatedBy, e.ExpiresAt.Format(time.RFC3339))
+}
+
+func (e *Enrollment) Proto() *pb.Enrollment {
+	return &pb.Enrollment{
+		ID:        e.ID,
+		Name:      e.Name,
+		Role:      e.Role,
+		Server:    e.Server,
+		CA:        e.CA,
+		CreatedBy: e.CreatedBy,
+		CreatedAt: e.CreatedAt.UnixNano(),
+		ExpiresAt: e.ExpiresAt.UnixNano(),
+	}
+}
+
+func ProtoToEnrollment(p *pb.Enrollment) *Enrollment {
+	return &Enrollment{
+		ID:        p.ID,
+		Name:      p.Name,
+		Role:      p.Role,
+		Server:    p.Server,
+		CA:        p.CA,
+		CreatedBy: p.CreatedBy,
+		CreatedAt: time.Unix(0, p.CreatedAt),
+		ExpiresAt: time.Unix(0, p.ExpiresAt),
+	}
+}
+
+// EnrollmentCode is what a new teammate is given: where to enroll, the token, and the pin of the CA so that the
+// enrollment endpoint can be trusted without having anything from the server yet
+func EnrollmentCode(address string, token string, caPin string) string {
+	code := url.URL{
+		Scheme:   enrollmentScheme,
+		Host:     address,
+		Path:     "/" + token,
+		RawQuery: url.Values{"ca": []string{caPin}}.Encode(),
+	}
+
+	return code.String()
+}
+
+// ParseEnrollmentCode returns the address of the enrollment endpoint, the token and the pin of the CA of a code
+func ParseEnrollmentCode(code string) (string, string, string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(code))
+	if err != nil || parsed.Scheme != enrollmentScheme {
+		return "", "", "", errors.New("not an enrollment code")
+	}
+
+	if _, _, err := net.SplitHostPort(parsed.Host); err != nil {
+		return "", "", "", fmt.Errorf("enrollment address is malformed: %s", err)
+	}
+
+	token := strings.TrimPrefix(parsed.Path, "/")
+	if _, _, err := splitEnrollmentToken(token); err != nil {
+		return "", "", "", err
+	}
+
+	pin := parsed.Query().Get("ca")
+	if pin == "" {
+		return "", "", "", errors.New("enrollment code lacks the CA pin")
+	}
+
+	return parsed.Host, token, pin, nil
+}
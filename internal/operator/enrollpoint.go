@@ -0,0 +1,171 @@
+package operator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	enrollPath         = "/enroll"
+	enrollMaxTokenSize = 1024
+)
+
+type enrollRequest struct {
+	Token string
+}
+
+// EnrollmentPoint takes enrollment tokens over TLS and answers with the operator config of whoever was enrolled. It
+// presents the operator server certificate along with the CA, new teammates check it against the pin of the CA in
+// their enrollment code since they have nothing from the server yet
+type EnrollmentPoint struct {
+	listener net.Listener
+	server   *http.Server
+}
+
+func ListenEnrollmentPoint(address string, cert tls.Certificate, enroll func(token string) (*Operator, error)) (*EnrollmentPoint, error) {
+	listener, err := tls.Listen("tcp", address, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(enrollPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req enrollRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, enrollMaxTokenSize)).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		oper, err := enroll(req.Token)
+		if err != nil {
+			slog.Warn("enrollment refused", slog.Any("remote", r.RemoteAddr), slog.Any("error", err))
+			http.Error(w, "enrollment refused", http.StatusForbidden)
+			return
+		}
+
+		config, err := oper.ToBytes()
+		if err != nil {
+			slog.Error("could not export enrolled operator", slog.Any("operator", oper.Name), slog.Any("error", err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(config)
+	})
+
+	return &EnrollmentPoint{
+		listener: listener,
+		server: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}, nil
+}
+
+func (ep *EnrollmentPoint) Serve() {
+	slog.Info("Enrollment endpoint started", slog.String("address", ep.listener.Addr().String()))
+
+	if err := ep.server.Serve(ep.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("Enrollment endpoint failed", slog.Any("error", err))
+	}
+}
+
+func (ep *EnrollmentPoint) Close() error {
+	return ep.server.Close()
+}
+
+// Enroll redeems an enrollment code, it returns the operator config the server issued
+func Enroll(code string) ([]byte, error) {
+	address, token, pin, err := ParseEnrollmentCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify:    true, // checked against the pin below
+				VerifyPeerCertificate: verifyPinnedChain(pin),
+			},
+		},
+	}
+
+	body, err := json.Marshal(enrollRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Post(fmt.Sprintf("https://%s%s", address, enrollPath), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server refused the enrollment (%s), the code may have been used or have expired", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// verifyPinnedChain accepts the server certificate when it chains to a CA, sent along, whose public key has the pin.
+// The rest of what the server sends can be intermediates between the two
+func verifyPinnedChain(pin string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) < 2 {
+			return errors.New("server didn't send its CA")
+		}
+
+		var certs []*x509.Certificate
+		for _, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+
+		roots := x509.NewCertPool()
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+			if base64.StdEncoding.EncodeToString(hash[:]) == pin {
+				roots.AddCert(cert)
+			} else {
+				intermediates.AddCert(cert)
+			}
+		}
+
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		if err != nil {
+			return fmt.Errorf("server certificate doesn't match the CA of the enrollment code: %w", err)
+		}
+
+		return nil
+	}
+}
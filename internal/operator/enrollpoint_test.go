@@ -0,0 +1,86 @@
+package operator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestVerifyPinnedChain(t *testing.T) {
+	issue := func(name string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  isCA,
+			BasicConstraintsValid: true,
+		}
+		if isCA {
+			template.KeyUsage = x509.KeyUsageCertSign
+		} else {
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		}
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert, key
+	}
+
+	pinOf := func(cert *x509.Certificate) string {
+		hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+		return base64.StdEncoding.EncodeToString(hash[:])
+	}
+
+	root, rootKey := issue("root", true, nil, nil)
+	intermediate, intermediateKey := issue("intermediate", true, root, rootKey)
+	server, _ := issue("server", false, intermediate, intermediateKey)
+	other, _ := issue("other", true, nil, nil)
+
+	for _, tc := range []struct {
+		name  string
+		pin   string
+		chain []*x509.Certificate
+		valid bool
+	}{
+		{"pinned root through an intermediate", pinOf(root), []*x509.Certificate{server, intermediate, root}, true},
+		{"pinned intermediate", pinOf(intermediate), []*x509.Certificate{server, intermediate}, true},
+		{"missing intermediate", pinOf(root), []*x509.Certificate{server, root}, false},
+		{"wrong pin", pinOf(other), []*x509.Certificate{server, intermediate, root}, false},
+		{"no CA", pinOf(root), []*x509.Certificate{server}, false},
+	} {
+		var rawCerts [][]byte
+		for _, cert := range tc.chain {
+			rawCerts = append(rawCerts, cert.Raw)
+		}
+
+		err := verifyPinnedChain(tc.pin)(rawCerts, nil)
+		if tc.valid && err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s: accepted", tc.name)
+		}
+	}
+}
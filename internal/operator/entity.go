@@ -22,18 +22,16 @@ import (
 type Operator struct {
 	Name       string
 	IsAdmin    bool
+	Role       string
 	IsOnline   bool `json:"-"`
 	Server     string
+	Standby    string `json:",omitempty"` // other server of a pair, tried when Server doesn't answer
 	CA         []byte
 	Cert       *certificate.Certificate
 	Connection `json:"-"`
 	client     pb.LigoloClient
 }
 
-type Connection struct {
-	conn *grpc.ClientConn
-}
-
 func (oper *Operator) ToFile(path string) (string, error) {
 	operBytes, err := json.Marshal(oper)
 	if err != nil {
@@ -96,13 +94,13 @@ func (oper *Operator) Connect() error {
 		},
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	conn, err := grpc.DialContext(ctx, oper.Server,
-		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(20*1024*1024)),
-		grpc.WithBlock(),
-	)
+	conn, err := oper.dial(oper.Server, tlsConfig)
+	if err != nil && oper.Standby != "" {
+		// the other server of the pair took over, it's the one to try first from now on
+		if conn, err = oper.dial(oper.Standby, tlsConfig); err == nil {
+			oper.Server, oper.Standby = oper.Standby, oper.Server
+		}
+	}
 	if err != nil {
 		oper.conn = nil
 		return err
@@ -114,11 +112,24 @@ func (oper *Operator) Connect() error {
 	return nil
 }
 
+func (oper *Operator) dial(server string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return grpc.DialContext(ctx, server,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(20*1024*1024)),
+		grpc.WithBlock(),
+	)
+}
+
 func (oper *Operator) Disconnect() error {
 	if oper.conn == nil {
 		return nil
 	}
 
+	oper.closeSubscriptions()
+
 	if err := oper.conn.Close(); err != nil {
 		return err
 	}
@@ -140,14 +151,28 @@ func (oper *Operator) Client() pb.LigoloClient {
 	return oper.client
 }
 
+// CertRenewalWindow is how long before their certificate expires operators are told to renew it
+const CertRenewalWindow = 14 * 24 * time.Hour
+
+// CertExpiresWithin tells whether the certificate of the operator expires, or has expired, in less than d. The
+// certificate has to be renewed before it expires, the server refuses it afterwards
+func (oper *Operator) CertExpiresWithin(d time.Duration) bool {
+	if oper.Cert == nil {
+		return false
+	}
+
+	return time.Until(oper.Cert.ExpiryDate()) < d
+}
+
 func (oper *Operator) String() string {
-	return fmt.Sprintf("Name=%s IsAdmin=%s", oper.Name, utils.HumanBool(oper.IsAdmin))
+	return fmt.Sprintf("Name=%s IsAdmin=%s Role=%s", oper.Name, utils.HumanBool(oper.IsAdmin), oper.GetRole())
 }
 
 func (oper *Operator) Proto() *pb.Operator {
 	return &pb.Operator{
 		Name:     oper.Name,
 		IsAdmin:  oper.IsAdmin,
+		Role:     oper.GetRole(),
 		IsOnline: oper.IsOnline,
 		Server:   oper.Server,
 		Cert:     oper.Cert.Proto(),
@@ -159,6 +184,7 @@ func ProtoToOperator(p *pb.Operator) *Operator {
 	return &Operator{
 		Name:     p.Name,
 		IsAdmin:  p.IsAdmin,
+		Role:     p.Role,
 		IsOnline: p.IsOnline,
 		Server:   p.Server,
 		Cert:     certificate.ProtoToCertificate(p.Cert),
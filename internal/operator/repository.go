@@ -5,10 +5,12 @@ import (
 )
 
 type OperatorRepository struct {
-	storage *storage.StoreInstance[Operator]
+	storage     *storage.StoreInstance[Operator]
+	enrollments *storage.StoreInstance[Enrollment]
 }
 
 var table = "operators"
+var enrollmentsTable = "enrollments"
 
 func NewOperatorRepository(store *storage.Store) (*OperatorRepository, error) {
 	storeInstance, err := storage.GetInstance[Operator](store, table)
@@ -16,8 +18,14 @@ func NewOperatorRepository(store *storage.Store) (*OperatorRepository, error) {
 		return nil, err
 	}
 
+	enrollments, err := storage.GetInstance[Enrollment](store, enrollmentsTable)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OperatorRepository{
-		storage: storeInstance,
+		storage:     storeInstance,
+		enrollments: enrollments,
 	}, nil
 }
 
@@ -50,3 +58,19 @@ func (repo *OperatorRepository) Exists(oper *Operator) bool {
 		return true
 	}
 }
+
+func (repo *OperatorRepository) GetEnrollment(id string) (*Enrollment, error) {
+	return repo.enrollments.Get(id)
+}
+
+func (repo *OperatorRepository) GetEnrollments() ([]*Enrollment, error) {
+	return repo.enrollments.GetAll()
+}
+
+func (repo *OperatorRepository) SaveEnrollment(enrollment *Enrollment) error {
+	return repo.enrollments.Set(enrollment.ID, enrollment)
+}
+
+func (repo *OperatorRepository) RemoveEnrollment(enrollment *Enrollment) error {
+	return repo.enrollments.Del(enrollment.ID)
+}
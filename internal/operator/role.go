@@ -0,0 +1,51 @@
+package operator
+
+import "fmt"
+
+const (
+	RoleAdmin    = "admin"     // manages operators, certificates and listeners, on top of what operators do
+	RoleOperator = "operator"  // works sessions, routes and builds
+	RoleReadOnly = "read-only" // observes sessions, routes and builds, changes nothing
+)
+
+// Roles are listed from the most to the least privileged
+var Roles = []string{RoleAdmin, RoleOperator, RoleReadOnly}
+
+var roleRanks = map[string]int{
+	RoleReadOnly: 1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+func ValidateRole(role string) error {
+	if _, ok := roleRanks[role]; !ok {
+		return fmt.Errorf("unknown role '%s', must be one of %v", role, Roles)
+	}
+
+	return nil
+}
+
+// GetRole is the role of the operator. Operators stored before roles existed only have IsAdmin, and are admins or
+// operators
+func (oper *Operator) GetRole() string {
+	if oper.Role != "" {
+		return oper.Role
+	}
+
+	if oper.IsAdmin {
+		return RoleAdmin
+	}
+
+	return RoleOperator
+}
+
+// SetRole keeps IsAdmin in line with the role, it is what older clients read
+func (oper *Operator) SetRole(role string) {
+	oper.Role = role
+	oper.IsAdmin = role == RoleAdmin
+}
+
+// Can tells whether the role of the operator includes the given one
+func (oper *Operator) Can(role string) bool {
+	return roleRanks[oper.GetRole()] >= roleRanks[role]
+}
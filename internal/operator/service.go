@@ -2,8 +2,12 @@ package operator
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
@@ -30,7 +34,7 @@ func (service *OperatorService) Init() error {
 	}
 
 	if len(operators) < 1 {
-		_, err := service.NewOperator("admin", true, service.config.OperatorAddr)
+		_, err := service.NewOperator("admin", RoleAdmin, service.config.OperatorAddr)
 		if err != nil {
 			return err
 		}
@@ -39,21 +43,37 @@ func (service *OperatorService) Init() error {
 	return nil
 }
 
-func (service *OperatorService) NewOperator(name string, isAdmin bool, server string) (*Operator, error) {
+func (service *OperatorService) NewOperator(name string, role string, server string) (*Operator, error) {
+	return service.NewOperatorFrom(name, role, server, "")
+}
+
+// NewOperatorFrom is NewOperator with the certificate issued from an intermediate CA, from the server CA if ca is
+// empty. Operators always get the server CA to check the server against
+func (service *OperatorService) NewOperatorFrom(name string, role string, server string, ca string) (*Operator, error) {
+	if err := ValidateRole(role); err != nil {
+		return nil, err
+	}
+
 	oper := &Operator{
-		Name:    name,
-		IsAdmin: isAdmin,
-		Server:  server,
+		Name:   name,
+		Server: server,
 	}
+	oper.SetRole(role)
 
 	if service.repo.Exists(oper) {
 		return nil, fmt.Errorf("operator '%s' already exists", name)
 	}
 
-	CA := service.certService.GetCA()
-	oper.CA = CA.Certificate
+	var authority *certificate.Authority
+	if ca != "" {
+		if authority = service.certService.GetAuthority(ca); authority == nil {
+			return nil, fmt.Errorf("CA '%s' not found", ca)
+		}
+	}
+
+	oper.CA = service.certService.GetCA().Certificate
 
-	operCert, err := service.certService.GenerateCert(oper.Name, CA)
+	operCert, err := service.certService.Issue(oper.Name, authority, service.certValidity())
 	if err != nil {
 		return nil, err
 	}
@@ -75,18 +95,61 @@ func (service *OperatorService) AllOperators() ([]*Operator, error) {
 	return service.repo.GetAll()
 }
 
-func (service *OperatorService) NewOperatorFromFile(path string) (*Operator, error) {
-	var oper *Operator
+// certValidity is how long operator certificates are issued for
+func (service *OperatorService) certValidity() time.Duration {
+	if service.config.OperatorCertValidity > 0 {
+		return service.config.OperatorCertValidity
+	}
+
+	return 10 * 365 * 24 * time.Hour
+}
+
+// RenewCertificate reissues the certificate of an operator with the same key, so the config they hold only needs the
+// new certificate
+func (service *OperatorService) RenewCertificate(name string) (*Operator, error) {
+	oper, err := service.repo.GetOne(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if oper == nil {
+		return nil, fmt.Errorf("operator '%s' not found", name)
+	}
+
+	cert, err := service.certService.Reissue(oper.Cert, service.certValidity())
+	if err != nil {
+		return nil, err
+	}
+	oper.Cert = cert
+
+	return oper, service.repo.Save(oper)
+}
+
+// SaveOperator stores credentials as they are, e.g. once their certificate was renewed
+func (service *OperatorService) SaveOperator(oper *Operator) error {
+	return service.repo.Save(oper)
+}
 
+func (service *OperatorService) NewOperatorFromFile(path string) (*Operator, error) {
 	operBytes, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = json.Unmarshal(operBytes, &oper); err != nil {
+	return service.ImportOperator(operBytes)
+}
+
+// ImportOperator stores credentials exported or enrolled from a server, renamed if the name is taken
+func (service *OperatorService) ImportOperator(operBytes []byte) (*Operator, error) {
+	var oper *Operator
+	if err := json.Unmarshal(operBytes, &oper); err != nil {
 		return nil, err
 	}
 
+	if oper == nil || oper.Cert == nil {
+		return nil, errors.New("not an operator config")
+	}
+
 	try := 1
 	name := oper.Name
 	for service.repo.Exists(oper) {
@@ -114,7 +177,11 @@ func (service *OperatorService) RemoveOperator(name string) (*Operator, error) {
 	return oper, service.repo.Remove(oper)
 }
 
-func (service *OperatorService) PromoteOperator(name string) (*Operator, error) {
+func (service *OperatorService) SetOperatorRole(name string, role string) (*Operator, error) {
+	if err := ValidateRole(role); err != nil {
+		return nil, err
+	}
+
 	oper, err := service.repo.GetOne(name)
 	if err != nil {
 		return nil, err
@@ -124,22 +191,166 @@ func (service *OperatorService) PromoteOperator(name string) (*Operator, error)
 		return nil, fmt.Errorf("operator '%s' not found", name)
 	}
 
-	oper.IsAdmin = true
+	oper.SetRole(role)
 
 	return oper, service.repo.Save(oper)
 }
 
-func (service *OperatorService) DemoteOperator(name string) (*Operator, error) {
-	oper, err := service.repo.GetOne(name)
+// NewEnrollment creates an operator to be, whoever has the token returned can enroll as them once before it expires.
+// Their certificate is issued from the intermediate CA ca, from the server CA if it's empty
+func (service *OperatorService) NewEnrollment(name string, role string, server string, ca string, ttl time.Duration, createdBy string) (*Enrollment, string, error) {
+	if err := ValidateRole(role); err != nil {
+		return nil, "", err
+	}
+
+	if name == "" {
+		return nil, "", errors.New("name is empty")
+	}
+
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		return nil, "", fmt.Errorf("server is malformed: %s", err)
+	}
+
+	if ttl <= 0 {
+		return nil, "", errors.New("enrollment must expire")
+	}
+
+	if service.config.EnrollAddr == "" {
+		return nil, "", errors.New("enrollment is disabled, start the server with -enroll-addr")
+	}
+
+	if service.repo.Exists(&Operator{Name: name}) {
+		return nil, "", fmt.Errorf("operator '%s' already exists", name)
+	}
+
+	if ca != "" {
+		authority := service.certService.GetAuthority(ca)
+		if authority == nil {
+			return nil, "", fmt.Errorf("CA '%s' not found", ca)
+		}
+		if authority.Revoked {
+			return nil, "", fmt.Errorf("CA '%s' is revoked", ca)
+		}
+	}
+
+	pending, err := service.Enrollments()
+	if err != nil {
+		return nil, "", err
+	}
+	for _, enrollment := range pending {
+		if enrollment.Name == name {
+			return nil, "", fmt.Errorf("operator '%s' is already waiting to enroll", name)
+		}
+	}
+
+	id, token, hash, err := newEnrollmentToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	enrollment := &Enrollment{
+		ID:        id,
+		TokenHash: hash,
+		Name:      name,
+		Role:      role,
+		Server:    server,
+		CA:        ca,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	if err := service.repo.SaveEnrollment(enrollment); err != nil {
+		return nil, "", err
+	}
+
+	return enrollment, token, nil
+}
+
+// EnrollmentCode is what to hand over to whoever enrolls: the enrollment endpoint, on the host of the server they
+// connect to, the token and the pin of the CA
+func (service *OperatorService) EnrollmentCode(enrollment *Enrollment, token string) (string, error) {
+	host, _, err := net.SplitHostPort(enrollment.Server)
+	if err != nil {
+		return "", err
+	}
+
+	_, port, err := net.SplitHostPort(service.config.EnrollAddr)
+	if err != nil {
+		return "", err
+	}
+
+	pin, err := service.certService.GetCA().SPKI()
+	if err != nil {
+		return "", err
+	}
+
+	return EnrollmentCode(net.JoinHostPort(host, port), token, pin), nil
+}
+
+// Enrollments are those waiting to be used, oldest first, expired ones are removed along the way
+func (service *OperatorService) Enrollments() ([]*Enrollment, error) {
+	all, err := service.repo.GetEnrollments()
 	if err != nil {
 		return nil, err
 	}
 
-	if oper == nil {
-		return nil, fmt.Errorf("operator '%s' not found", name)
+	var result []*Enrollment
+	for _, enrollment := range all {
+		if enrollment.Expired() {
+			if err := service.repo.RemoveEnrollment(enrollment); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		result = append(result, enrollment)
 	}
 
-	oper.IsAdmin = false
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
 
-	return oper, service.repo.Save(oper)
+	return result, nil
+}
+
+func (service *OperatorService) CancelEnrollment(id string) (*Enrollment, error) {
+	enrollment, err := service.repo.GetEnrollment(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if enrollment == nil {
+		return nil, fmt.Errorf("enrollment '%s' not found", id)
+	}
+
+	return enrollment, service.repo.RemoveEnrollment(enrollment)
+}
+
+// Enroll creates the operator a token was issued for, the token can't be used again whatever happens
+func (service *OperatorService) Enroll(token string) (*Operator, error) {
+	id, secret, err := splitEnrollmentToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := service.repo.GetEnrollment(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if enrollment == nil || !enrollment.Matches(secret) {
+		return nil, errors.New("unknown enrollment token")
+	}
+
+	if err := service.repo.RemoveEnrollment(enrollment); err != nil {
+		return nil, err
+	}
+
+	if enrollment.Expired() {
+		return nil, errors.New("enrollment token expired")
+	}
+
+	return service.NewOperatorFrom(enrollment.Name, enrollment.Role, enrollment.Server, enrollment.CA)
 }
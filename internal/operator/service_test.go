@@ -0,0 +1,178 @@
+package operator
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
+	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/crl"
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+func newTestService(t *testing.T) (*OperatorService, *certificate.CertificateService) {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	certRepo, err := certificate.NewCertificateRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crlRepo, err := crl.NewCRLRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certService := certificate.NewCertificateService(certRepo, crl.NewCRLService(crlRepo))
+	if err := certService.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	repo, err := NewOperatorRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{OperatorAddr: "127.0.0.1:58008", OperatorCertValidity: 24 * time.Hour, EnrollAddr: "0.0.0.0:58009"}
+	return NewOperatorService(cfg, repo, certService), certService
+}
+
+func TestRenewCertificate(t *testing.T) {
+	service, _ := newTestService(t)
+
+	oper, err := service.NewOperator("alice", RoleOperator, "127.0.0.1:58008")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !oper.CertExpiresWithin(25*time.Hour) || oper.CertExpiresWithin(23*time.Hour) {
+		t.Fatalf("certificate should expire in a day, expires %s", oper.Cert.ExpiryDate())
+	}
+
+	service.config.OperatorCertValidity = 48 * time.Hour
+	renewed, err := service.RenewCertificate("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed.CertExpiresWithin(47 * time.Hour) {
+		t.Fatalf("renewed certificate should expire in two days, expires %s", renewed.Cert.ExpiryDate())
+	}
+	if string(renewed.Cert.Key) != string(oper.Cert.Key) {
+		t.Fatal("renewal shouldn't change the key")
+	}
+}
+
+func TestEnroll(t *testing.T) {
+	service, _ := newTestService(t)
+
+	if _, _, err := service.NewEnrollment("bob", RoleReadOnly, "nowhere", "", time.Hour, "admin"); err == nil {
+		t.Fatal("a malformed server should be refused")
+	}
+
+	enrollment, token, err := service.NewEnrollment("bob", RoleReadOnly, "127.0.0.1:58008", "", time.Hour, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(enrollment.TokenHash), token) {
+		t.Fatal("the token itself shouldn't be stored")
+	}
+
+	if _, _, err := service.NewEnrollment("bob", RoleReadOnly, "127.0.0.1:58008", "", time.Hour, "admin"); err == nil {
+		t.Fatal("bob is already waiting to enroll")
+	}
+
+	id, _, _ := splitEnrollmentToken(token)
+	if _, err := service.Enroll(id + ".wrong"); err == nil {
+		t.Fatal("a wrong secret should be refused")
+	}
+
+	oper, err := service.Enroll(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oper.Name != "bob" || oper.GetRole() != RoleReadOnly {
+		t.Fatalf("enrolled the wrong operator: %s", oper)
+	}
+
+	if _, err := service.Enroll(token); err == nil {
+		t.Fatal("a token should only be used once")
+	}
+}
+
+func TestEnrollExpired(t *testing.T) {
+	service, _ := newTestService(t)
+
+	_, token, err := service.NewEnrollment("carol", RoleOperator, "127.0.0.1:58008", "", time.Nanosecond, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+
+	if _, err := service.Enroll(token); err == nil {
+		t.Fatal("an expired token should be refused")
+	}
+
+	pending, err := service.Enrollments()
+	if err != nil || len(pending) != 0 {
+		t.Fatalf("no enrollment should be left, got %v (%v)", pending, err)
+	}
+}
+
+func TestEnrollmentPoint(t *testing.T) {
+	service, certService := newTestService(t)
+
+	_, token, err := service.NewEnrollment("dave", RoleOperator, "127.0.0.1:58008", "", time.Hour, "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverCert, err := certService.GetOperatorServerCert().ChainedKeyPair(certService.GetCA())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	point, err := ListenEnrollmentPoint("127.0.0.1:0", serverCert, service.Enroll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer point.Close()
+	go point.Serve()
+
+	address := point.listener.Addr().String()
+
+	otherCA, err := certService.GenerateCA("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPin, _ := otherCA.SPKI()
+	if _, err := Enroll(EnrollmentCode(address, token, otherPin)); err == nil {
+		t.Fatal("a server of another CA should be refused")
+	}
+
+	pin, _ := certService.GetCA().SPKI()
+	config, err := Enroll(EnrollmentCode(address, token, pin))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := service.ImportOperator(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(imported.Name, "dave") {
+		t.Fatalf("imported the wrong operator: %s", imported)
+	}
+	if _, err := tls.X509KeyPair(imported.Cert.Certificate, imported.Cert.Key); err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(imported.CA)
+	if !imported.Cert.IssuedBy(pool) {
+		t.Fatal("enrolled certificate should be issued by the CA")
+	}
+}
@@ -0,0 +1,71 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/route"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+type Profile struct {
+	Name   string
+	Routes []Entry
+}
+
+type Entry struct {
+	Cidr        string
+	Metric      int
+	IsLoopback  bool
+	Ports       string
+	IsExclusion bool
+}
+
+func (profile *Profile) Validate() error {
+	if profile.Name == "" {
+		return fmt.Errorf("profile name is empty")
+	}
+
+	for _, entry := range profile.Routes {
+		if _, err := route.NewRoute(entry.Cidr, entry.Metric, entry.IsLoopback, entry.Ports, entry.IsExclusion); err != nil {
+			return fmt.Errorf("route '%s': %v", entry.Cidr, err)
+		}
+	}
+
+	return nil
+}
+
+func (profile *Profile) Proto() *pb.RouteProfile {
+	var routes []*pb.Route
+	for _, entry := range profile.Routes {
+		routes = append(routes, &pb.Route{
+			Cidr:        entry.Cidr,
+			Metric:      int32(entry.Metric),
+			IsLoopback:  entry.IsLoopback,
+			Ports:       entry.Ports,
+			IsExclusion: entry.IsExclusion,
+		})
+	}
+
+	return &pb.RouteProfile{
+		Name:   profile.Name,
+		Routes: routes,
+	}
+}
+
+func ProtoToProfile(p *pb.RouteProfile) *Profile {
+	var entries []Entry
+	for _, r := range p.Routes {
+		entries = append(entries, Entry{
+			Cidr:        r.Cidr,
+			Metric:      int(r.Metric),
+			IsLoopback:  r.IsLoopback,
+			Ports:       r.Ports,
+			IsExclusion: r.IsExclusion,
+		})
+	}
+
+	return &Profile{
+		Name:   p.Name,
+		Routes: entries,
+	}
+}
@@ -0,0 +1,38 @@
+package profile
+
+import (
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+)
+
+type ProfileRepository struct {
+	storage *storage.StoreInstance[Profile]
+}
+
+var table = "route_profiles"
+
+func NewProfileRepository(store *storage.Store) (*ProfileRepository, error) {
+	storeInstance, err := storage.GetInstance[Profile](store, table)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfileRepository{
+		storage: storeInstance,
+	}, nil
+}
+
+func (repo *ProfileRepository) GetOne(name string) (*Profile, error) {
+	return repo.storage.Get(name)
+}
+
+func (repo *ProfileRepository) GetAll() ([]*Profile, error) {
+	return repo.storage.GetAll()
+}
+
+func (repo *ProfileRepository) Save(profile *Profile) error {
+	return repo.storage.Set(profile.Name, profile)
+}
+
+func (repo *ProfileRepository) Remove(profile *Profile) error {
+	return repo.storage.Del(profile.Name)
+}
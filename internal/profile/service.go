@@ -0,0 +1,63 @@
+package profile
+
+import (
+	"fmt"
+	"sort"
+)
+
+type ProfileService struct {
+	repo *ProfileRepository
+}
+
+func NewProfileService(repo *ProfileRepository) *ProfileService {
+	return &ProfileService{
+		repo: repo,
+	}
+}
+
+func (service *ProfileService) AllProfiles() ([]*Profile, error) {
+	profiles, err := service.repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	return profiles, nil
+}
+
+func (service *ProfileService) ProfileByName(name string) (*Profile, error) {
+	profile, err := service.repo.GetOne(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == nil {
+		return nil, fmt.Errorf("route profile '%s' not found", name)
+	}
+
+	return profile, nil
+}
+
+func (service *ProfileService) SaveProfile(profile *Profile) error {
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+
+	return service.repo.Save(profile)
+}
+
+func (service *ProfileService) RemoveProfile(name string) (*Profile, error) {
+	profile, err := service.ProfileByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.repo.Remove(profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
@@ -25,16 +25,31 @@ func (d *LigoloDecoder) Decode() error {
 		return err
 	}
 
+	d.Envelope.Encoding = EncodingGob
+	if d.Envelope.Type&encodingFlag != 0 {
+		d.Envelope.Type &^= encodingFlag
+		d.Envelope.Encoding = EncodingProtobuf
+	}
+
 	if err := binary.Read(d.reader, binary.LittleEndian, &d.Envelope.Size); err != nil {
 		return err
 	}
 
 	payload := make([]byte, d.Envelope.Size)
 
-	if _, err := d.reader.Read(payload); err != nil {
+	if _, err := io.ReadFull(d.reader, payload); err != nil {
 		return err
 	}
 
+	if d.Envelope.Encoding == EncodingProtobuf {
+		p, err := unmarshalProtobuf(d.Envelope.Type, payload)
+		if err != nil {
+			return err
+		}
+		d.Envelope.Payload = p
+		return nil
+	}
+
 	gobdecoder := gob.NewDecoder(bytes.NewReader(payload))
 
 	// Kind of dirty, but it's the only way I found to satisfy gob
@@ -111,6 +126,252 @@ func (d *LigoloDecoder) Decode() error {
 			panic(err)
 		}
 		d.Envelope.Payload = p
+	case MessageThroughputRequest:
+		p := ThroughputRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageThroughputResponse:
+		p := ThroughputResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBeaconRequest:
+		p := BeaconRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBeaconResponse:
+		p := BeaconResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageForwardRequest:
+		p := ForwardRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageForwardResponse:
+		p := ForwardResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageSocksRequest:
+		p := SocksRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageSocksResponse:
+		p := SocksResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageFileListRequest:
+		p := FileListRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageFileListResponse:
+		p := FileListResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageFileDownloadRequest:
+		p := FileDownloadRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageFileUploadRequest:
+		p := FileUploadRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageFileTransferResponse:
+		p := FileTransferResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageFileHash:
+		p := FileHashPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageExecRequest:
+		p := ExecRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageExecOutput:
+		p := ExecOutputPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageExecExit:
+		p := ExecExitPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageUpdateRequest:
+		p := UpdateRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageUpdateResponse:
+		p := UpdateResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageScheduleRequest:
+		p := ScheduleRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageScheduleResponse:
+		p := ScheduleResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageNetworkRequest:
+		p := NetworkRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageNetworkResponse:
+		p := NetworkResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessagePersistRequest:
+		p := PersistRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessagePersistResponse:
+		p := PersistResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBurnRequest:
+		p := BurnRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBurnResponse:
+		p := BurnResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBreakoutRequest:
+		p := BreakoutRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBreakoutResponse:
+		p := BreakoutResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageHostInfoRequest:
+		p := HostInfoRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageHostInfoResponse:
+		p := HostInfoResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageCrashReport:
+		p := CrashReportPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBandwidthRequest:
+		p := BandwidthRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageBandwidthResponse:
+		p := BandwidthResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageSweepRequest:
+		p := SweepRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageSweepResult:
+		p := SweepResultPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageSweepDone:
+		p := SweepDonePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageDiagnosticsRequest:
+		p := DiagnosticsRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageDiagnosticsResponse:
+		p := DiagnosticsResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageCertificateRequest:
+		p := CertificateRequestPacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
+	case MessageCertificateResponse:
+		p := CertificateResponsePacket{}
+		if err := gobdecoder.Decode(&p); err != nil {
+			panic(err)
+		}
+		d.Envelope.Payload = p
 	default:
 		return errors.New("invalid message type")
 	}
@@ -0,0 +1,36 @@
+package protocol
+
+const (
+	EncodingGob = uint8(iota)
+	EncodingProtobuf
+)
+
+// encodingFlag marks protobuf payloads in the envelope type, gob peers never get to see it
+const encodingFlag = uint8(0x80)
+
+// SupportedEncodings is advertised by the server in InfoRequestPacket, most preferred first
+var SupportedEncodings = []uint8{EncodingProtobuf, EncodingGob}
+
+// NegotiateEncoding picks the first of our encodings the peer offered, gob if none
+func NegotiateEncoding(offered []uint8) uint8 {
+	for _, encoding := range SupportedEncodings {
+		for _, candidate := range offered {
+			if encoding == candidate {
+				return encoding
+			}
+		}
+	}
+
+	return EncodingGob
+}
+
+// IsSupportedEncoding reports whether the encoding can be used on this side
+func IsSupportedEncoding(encoding uint8) bool {
+	for _, candidate := range SupportedEncodings {
+		if candidate == encoding {
+			return true
+		}
+	}
+
+	return false
+}
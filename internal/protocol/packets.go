@@ -1,16 +1,21 @@
 package protocol
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"net"
+	"strings"
 
 	"github.com/ttpreport/ligolo-mp/v2/internal/relay"
 )
 
 // Envelope is the structure used when Encoding/Decode ligolo packets
 type Envelope struct {
-	Type    uint8
-	Size    int32
-	Payload interface{}
+	Type     uint8
+	Size     int32
+	Payload  interface{}
+	Encoding uint8 // set when decoding, replies should use the same encoding
 }
 
 const (
@@ -28,8 +33,58 @@ const (
 	MessageRedirectorCloseResponse
 	MessageDisconnectRequest
 	MessageDisconnectResponse
+	MessageThroughputRequest
+	MessageThroughputResponse
+	MessageBeaconRequest
+	MessageBeaconResponse
+	MessageForwardRequest
+	MessageForwardResponse
+	MessageSocksRequest
+	MessageSocksResponse
+	MessageFileListRequest
+	MessageFileListResponse
+	MessageFileDownloadRequest
+	MessageFileUploadRequest
+	MessageFileTransferResponse
+	MessageFileHash
+	MessageExecRequest
+	MessageExecOutput
+	MessageExecExit
+	MessageUpdateRequest
+	MessageUpdateResponse
+	MessageScheduleRequest
+	MessageScheduleResponse
+	MessageNetworkRequest
+	MessageNetworkResponse
+	MessagePersistRequest
+	MessagePersistResponse
+	MessageBurnRequest
+	MessageBurnResponse
+	MessageBreakoutRequest
+	MessageBreakoutResponse
+	MessageHostInfoRequest
+	MessageHostInfoResponse
+	MessageCrashReport
+	MessageBandwidthRequest
+	MessageBandwidthResponse
+	MessageSweepRequest
+	MessageSweepResult
+	MessageSweepDone
+	MessageDiagnosticsRequest
+	MessageDiagnosticsResponse
+	MessageCertificateRequest
+	MessageCertificateResponse
 )
 
+// WakeProof is the TXT record wake hostnames resolve to when an operator asked a sleeping agent to check in.
+// It's keyed with the WakeKey the agent got before going to sleep, so only the server can produce it
+func WakeProof(key []byte, token string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.ToLower(token)))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
 const (
 	TransportTCP = uint8(iota)
 	TransportUDP
@@ -41,20 +96,51 @@ const (
 )
 
 type InfoRequestPacket struct {
+	Encodings    []uint8 // offered by the server, most preferred first
+	Version      uint16  // protocol spoken by the server, see NegotiateVersion
+	Compressions []uint8 // relay stream compressions offered by the server, most preferred first
 }
 
 type InfoReplyPacket struct {
-	Name        string
-	Hostname    string
-	Interfaces  []NetInterface
-	Redirectors []RedirectorInterface
+	Name         string
+	Hostname     string
+	Interfaces   []NetInterface
+	Redirectors  []RedirectorInterface
+	Container    ContainerInfo
+	Time         int64    // agent's wall clock at reply time, unix nanoseconds
+	Encoding     uint8    // picked by the agent from the offered encodings, used for the rest of the session
+	Beacon       int64    // beacon interval the agent was built with in nanoseconds, zero if it's always connected
+	Socks        string   // address of the running SOCKS5 service, empty if it's off
+	Exec         bool     // the agent was built with command execution
+	Platform     string   // GOOS/GOARCH the agent was built for
+	Version      string   // SHA-256 of the agent executable, empty if it can't replace itself (DLL, service...)
+	Jitter       uint8    // percentage reconnect waits are randomly shortened by
+	WorkingHours string   // windows the agent stays dormant in, see WorkingHours
+	Zone         int32    // offset of the agent's local time to UTC in seconds, working hours are in local time
+	EgressPath   string   // how the agent reached the server, e.g. "direct" or "http://proxy:8080 (pac)"
+	Persistence  []string // persistence methods the agent was built with, e.g. "runkey" or "systemd"
+	Host         *HostInfo
+	Crashes      []Crash // crashes the agent recovered from that the server hasn't heard of yet
+	Transport    string  // transport the agent connected over, e.g. "tls" or "dns"
+	Protocol     uint16  // newest protocol the agent speaks, zero for agents predating versioning
+	MinProtocol  uint16  // oldest protocol the agent still talks
+	Compression  uint8   // relay stream compression the agent picked, see NegotiateCompression
+}
+
+// ContainerInfo describes the container the agent is running in, zero value if it's not containerized
+type ContainerInfo struct {
+	Runtime     string // e.g. "docker", "podman", "kubernetes"
+	HostNetwork bool   // container shares the network namespace of the host
+	HostNetns   string // path to the host network namespace, when it's reachable from the container
+	ViaHost     bool   // outbound connections are made from the host network namespace
 }
 
 type RedirectorRequestPacket struct {
 	ID      string
-	Network string
+	Network string // tcp, udp, or pipe for bridges taking agents over SMB
 	From    string
 	To      string
+	Forward bool // connections are handed to the server with a ForwardRequestPacket instead of dialing To
 }
 
 type RedirectorResponsePacket struct {
@@ -80,6 +166,8 @@ type NetInterface struct {
 	HardwareAddr net.HardwareAddr // IEEE MAC-48, EUI-48 and EUI-64 form
 	Flags        net.Flags        // e.g., FlagUp, FlagLoopback, FlagMulticast
 	Addresses    []string
+	Gateways     []string
+	DNSServers   []string
 }
 
 // NewNetInterfaces converts a net.Interface slice to a NetInterface slice that can be transmitted over Gob
@@ -125,18 +213,37 @@ type RedirectorInterface struct {
 	To      string
 }
 
+// Neighbor is an entry of the ARP or NDP table of the agent
+type Neighbor struct {
+	Address      string
+	HardwareAddr net.HardwareAddr
+	Interface    string
+	State        string // e.g. "reachable", "stale", "permanent"
+}
+
+// SystemRoute is an entry of the routing table of the agent
+type SystemRoute struct {
+	Destination string // CIDR
+	Gateway     string // empty if directly connected
+	Interface   string
+	Metric      int
+}
+
 // ConnectRequestPacket is sent by the proxy to request a new TCP/UDP connection
 type ConnectRequestPacket struct {
-	Net       uint8
-	Transport uint8
-	Address   string
-	Port      uint16
+	Net           uint8
+	Transport     uint8
+	Address       string
+	Port          uint16
+	SourceAddress string // original source IP to spoof, empty if the agent should use its own
+	Compression   uint8  // how the stream is compressed once connected, one of those negotiated at connect
 }
 
 // ConnectResponsePacket is the response to the ConnectRequestPacket and indicate if the connection can be established, and if a RST packet need to be sent
 type ConnectResponsePacket struct {
 	Established bool
 	Reset       bool
+	Spoofed     bool // connection was made from the requested source address
 }
 
 // DisconnectRequestPacket is send to terminate agent
@@ -154,3 +261,302 @@ type HostPingRequestPacket struct {
 type HostPingResponsePacket struct {
 	Alive bool
 }
+
+// ThroughputRequestPacket asks the agent to send Size bytes of filler data over the stream
+type ThroughputRequestPacket struct {
+	Size int64
+}
+
+// ThroughputResponsePacket precedes the filler data and contains the amount of bytes that will actually be sent
+type ThroughputResponsePacket struct {
+	Size int64
+}
+
+// BeaconRequestPacket updates the low-and-slow settings of the agent. With Sleep set, the server drops the
+// tunnel once the response is received and the agent stays away until the interval elapses or it's woken up
+type BeaconRequestPacket struct {
+	Interval  int64  // nanoseconds between check-ins, zero keeps the agent connected
+	Jitter    uint8  // percentage the interval is randomly shortened by
+	WakeHost  string // <token>.<domain>, its TXT record is the WakeProof of the token when the agent should check in early
+	WakeKey   []byte // changes every time the agent is sent to sleep, along with the token
+	WakeCheck int64  // nanoseconds between wake host lookups
+	Sleep     bool
+}
+
+// ForwardRequestPacket is sent by the agent, on a stream it opens, for every connection accepted by a forwarding
+// redirector. The server reaches the destination through another session
+type ForwardRequestPacket struct {
+	RedirectorID string
+}
+
+// ForwardResponsePacket tells the agent whether the destination was reached, the stream then carries the connection
+type ForwardResponsePacket struct {
+	Established bool
+}
+
+// SocksRequestPacket starts the SOCKS5 service the agent runs for tooling on the target or its neighbors,
+// replacing the running one, or stops it when Address is empty
+type SocksRequestPacket struct {
+	Address  string // host:port to listen on, e.g. 127.0.0.1:1080
+	Username string // both empty if clients don't have to authenticate
+	Password string
+}
+
+// SocksResponsePacket tells where the service ended up listening, empty once it's stopped
+type SocksResponsePacket struct {
+	Address   string
+	Err       bool
+	ErrString string
+}
+
+// FileListRequestPacket lists a directory on the target, an empty Path is the agent's working directory
+// and a file is listed alone in its directory
+type FileListRequestPacket struct {
+	Path string
+}
+
+type FileEntry struct {
+	Name    string
+	Size    int64
+	Mode    uint32 // os.FileMode
+	ModTime int64  // unix seconds
+	IsDir   bool
+}
+
+// FileListResponsePacket contains the absolute path of the directory that was listed, so relative paths can
+// be resolved
+type FileListResponsePacket struct {
+	Path      string
+	Entries   []FileEntry
+	Err       bool
+	ErrString string
+}
+
+// FileDownloadRequestPacket asks for the content of a file starting at Offset. The agent answers with a
+// FileTransferResponsePacket, the raw content and a FileHashPacket of the whole file
+type FileDownloadRequestPacket struct {
+	Path   string
+	Offset int64
+}
+
+// FileUploadRequestPacket writes Size bytes at Offset, the file is truncated there first so interrupted
+// uploads can be resumed. Once the agent acknowledged with a FileTransferResponsePacket, the raw content
+// follows on the stream and the agent answers with a FileHashPacket of the whole file
+type FileUploadRequestPacket struct {
+	Path   string
+	Offset int64
+	Size   int64
+	Mode   uint32 // permissions of files that get created
+}
+
+// FileTransferResponsePacket precedes the content of a download with the amount of bytes that will be sent,
+// for uploads it only tells whether the agent is ready to receive
+type FileTransferResponsePacket struct {
+	Size      int64
+	Total     int64 // size of the whole file
+	Err       bool
+	ErrString string
+}
+
+// FileHashPacket closes a transfer with the size and SHA-256 of the whole file as it is on the target
+type FileHashPacket struct {
+	Size      int64
+	Sha256    string // hex
+	Err       bool
+	ErrString string
+}
+
+// ExecRequestPacket runs a command through the shell of the target, /bin/sh or cmd.exe. The agent streams
+// ExecOutputPacket as the command writes and closes with an ExecExitPacket
+type ExecRequestPacket struct {
+	Command string
+	Timeout int64 // nanoseconds before the command gets killed, zero waits for it
+}
+
+type ExecOutputPacket struct {
+	Stderr bool
+	Data   []byte
+}
+
+// ExecExitPacket ends a command, agents built without command execution only send this one with an error
+type ExecExitPacket struct {
+	ExitCode  int32 // -1 if the command didn't run to completion
+	Err       bool
+	ErrString string
+}
+
+// UpdateRequestPacket replaces the agent executable, the new one follows on the stream. The agent answers with
+// an UpdateResponsePacket once it got all of it and then restarts from it, reconnecting right away
+type UpdateRequestPacket struct {
+	Size   int64
+	Sha256 string // hex
+}
+
+type UpdateResponsePacket struct {
+	Err       bool
+	ErrString string
+}
+
+// ScheduleRequestPacket replaces the working hours of the agent until it restarts. If one of the windows is
+// running, the agent drops the session right after the ScheduleResponsePacket
+type ScheduleRequestPacket struct {
+	WorkingHours string // see WorkingHours, empty keeps the agent connected around the clock
+}
+
+type ScheduleResponsePacket struct {
+	Err       bool
+	ErrString string
+}
+
+// BeaconResponsePacket acknowledges the BeaconRequestPacket with the interval the agent is now using
+type BeaconResponsePacket struct {
+	Interval int64
+}
+
+// NetworkRequestPacket asks the agent for its interfaces, neighbors and routing table as they are now
+type NetworkRequestPacket struct{}
+
+type NetworkResponsePacket struct {
+	Interfaces []NetInterface
+	Neighbors  []Neighbor
+	Routes     []SystemRoute
+	Errors     []string // what couldn't be collected, the rest is still reported
+}
+
+// PersistRequestPacket installs the agent with one of the methods it reports, or removes what it installed
+type PersistRequestPacket struct {
+	Method    string
+	Uninstall bool
+}
+
+type PersistResponsePacket struct {
+	Location  string // what was created or removed, e.g. a registry value or a unit file
+	Err       bool
+	ErrString string
+}
+
+// BurnRequestPacket has the agent remove its persistence, its executable and what it left behind, then exit
+type BurnRequestPacket struct {
+	Persisted []string // persistence methods the server knows were installed, failing to remove them is reported
+}
+
+type BurnResponsePacket struct {
+	Removed []string // what the agent removed or scheduled for removal once it exits
+	Errors  []string // what it couldn't remove, it exits regardless
+}
+
+// BreakoutRequestPacket replaces the breakout table of the agent. Relayed connections to a network it covers leave
+// through the given interface instead of following the routing table of the target
+type BreakoutRequestPacket struct {
+	Rules []BreakoutRule
+}
+
+type BreakoutRule struct {
+	Cidr      string
+	Interface string // empty follows the routing table, e.g. to carve a network out of a wider rule
+}
+
+type BreakoutResponsePacket struct {
+	Err       bool
+	ErrString string
+}
+
+// HostInfo describes the target an agent runs on. Agents built without host information collection report none
+type HostInfo struct {
+	OS         string   // name and version of the operating system
+	Username   string   // user the agent runs as, DOMAIN\user on Windows
+	Privileged bool     // root, or an elevated administrator
+	Privileges []string // notable privileges held: token privileges on Windows, effective capabilities on Linux
+	Domain     string   // Active Directory domain or Kerberos realm the host is joined to, empty if none
+	DNSServers []string
+	Gateways   []string // default gateways
+	Errors     []string // what couldn't be collected, the rest is still reported
+}
+
+// HostInfoRequestPacket asks the agent to collect host information again
+type HostInfoRequestPacket struct{}
+
+type HostInfoResponsePacket struct {
+	Host *HostInfo // nil if the agent was built without host information collection
+}
+
+// Crash sums up panics the agent recovered from at the same place. Stacks are reduced to their function names
+// and hashed, so nothing about the target ends up in the report
+type Crash struct {
+	Where     string // what the agent was doing: "tunnel", "stream" or "redirector"
+	Panic     string // panic message, truncated
+	Frame     string // function that panicked
+	StackHash string
+	Count     int
+	First     int64 // agent's wall clock, unix nanoseconds
+	Last      int64
+}
+
+// CrashReportPacket is sent by the agent on a stream of its own when it recovers from a panic while connected, the
+// server doesn't answer
+type CrashReportPacket struct {
+	Crashes []Crash
+}
+
+// BandwidthRequestPacket caps the bandwidth of the tunnel connection, each way
+type BandwidthRequestPacket struct {
+	Limit int64 // bytes per second, 0 lifts the cap
+}
+
+type BandwidthResponsePacket struct {
+	Err       bool
+	ErrString string
+}
+
+// SweepRequestPacket has the agent look for live hosts itself, with ICMP echo if it may open raw or ping sockets
+// and TCP connects to Ports otherwise. It streams a SweepResultPacket per live host and closes with a
+// SweepDonePacket, closing the stream stops the sweep
+type SweepRequestPacket struct {
+	Targets []string // addresses and networks
+	Ports   []uint16 // TCP ports probed on every host, open ones are reported
+	Timeout int64    // nanoseconds a host gets to answer
+	Workers int32    // hosts probed over TCP at once
+}
+
+type SweepResultPacket struct {
+	Address string
+	Method  string   // "icmp" if the host answered an echo request, "tcp" if it only answered a connect
+	RTT     int64    // nanoseconds
+	Open    []uint16 // ports that accepted a connection
+}
+
+type SweepDonePacket struct {
+	Probed    int32
+	Alive     int32
+	ICMP      bool // echo requests could be sent
+	Err       bool
+	ErrString string
+}
+
+// DiagnosticsRequestPacket has the agent test how it reaches the server: every configured server, the proxy, name
+// resolution, its clock against its certificates and the MTU of the interface it connects from
+type DiagnosticsRequestPacket struct{}
+
+type DiagnosticsResponsePacket struct {
+	Checks []DiagnosticCheck
+}
+
+// CertificateRequestPacket hands the agent a new certificate and key issued by the same CA. The agent drops the
+// connection once it answers and connects back with them, it only keeps them in memory
+type CertificateRequestPacket struct {
+	Certificate []byte // PEM
+	Key         []byte // PEM
+}
+
+type CertificateResponsePacket struct {
+	Err       bool
+	ErrString string
+}
+
+type DiagnosticCheck struct {
+	Name     string // "server", "proxy", "dns", "clock" or "mtu"
+	Target   string // what was checked, e.g. the server address
+	OK       bool
+	Detail   string // what was found, or why it failed
+	Duration int64  // nanoseconds the check took, zero if it doesn't apply
+}
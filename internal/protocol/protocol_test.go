@@ -2,8 +2,13 @@ package protocol
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io"
+	"net"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestEncodeDecode(t *testing.T) {
@@ -30,3 +35,273 @@ func TestEncodeDecode(t *testing.T) {
 	}
 
 }
+
+func TestEncodeDecodeProtobuf(t *testing.T) {
+	var buffer bytes.Buffer
+
+	reply := InfoReplyPacket{
+		Name:     "hello",
+		Time:     -42,
+		Encoding: EncodingProtobuf,
+		Interfaces: []NetInterface{
+			{Index: 1, Name: "eth0", HardwareAddr: []byte{0xde, 0xad, 0xbe, 0xef, 0, 1}, Addresses: []string{"10.0.0.1/24"}},
+			{Index: 2, Name: "lo"},
+		},
+		Container:   ContainerInfo{Runtime: "docker", ViaHost: true},
+		Socks:       "127.0.0.1:1080",
+		Zone:        -5 * 3600,
+		EgressPath:  "http://proxy:8080 (pac)",
+		Transport:   "dns",
+		Protocol:    Version,
+		MinProtocol: MinVersion,
+		Compression: CompressionSnappy,
+		Persistence: []string{"cron", "systemd"},
+		Host:        &HostInfo{OS: "Ubuntu 22.04.4 LTS", Username: "www-data", Gateways: []string{"10.0.0.1"}},
+		Crashes:     []Crash{{Where: "stream", Frame: "main.handleConn", StackHash: "0123456789abcdef", Count: 3, First: -1, Last: 1}},
+	}
+
+	enc := NewEncoder(&buffer)
+	enc.SetEncoding(EncodingProtobuf)
+	if err := enc.Encode(Envelope{Type: MessageInfoReply, Payload: reply}); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := NewDecoder(&buffer)
+	if err := dec.Decode(); err != nil {
+		t.Fatal(err)
+	}
+
+	if dec.Envelope.Type != MessageInfoReply || dec.Envelope.Encoding != EncodingProtobuf {
+		t.Fatalf("invalid envelope decoded: %+v", dec.Envelope)
+	}
+
+	got := dec.Envelope.Payload.(InfoReplyPacket)
+	if got.Name != reply.Name || got.Time != reply.Time || got.Encoding != reply.Encoding || got.Container != reply.Container || got.Socks != reply.Socks || got.Zone != reply.Zone || got.EgressPath != reply.EgressPath || got.Transport != reply.Transport || got.Protocol != reply.Protocol || got.MinProtocol != reply.MinProtocol || got.Compression != reply.Compression {
+		t.Fatalf("invalid packet decoded: %+v", got)
+	}
+
+	if len(got.Interfaces) != 2 || got.Interfaces[0].HardwareAddr.String() != "de:ad:be:ef:00:01" || got.Interfaces[1].Name != "lo" {
+		t.Fatalf("invalid interfaces decoded: %+v", got.Interfaces)
+	}
+
+	if len(got.Persistence) != 2 || got.Persistence[1] != "systemd" {
+		t.Fatalf("invalid persistence methods decoded: %+v", got.Persistence)
+	}
+
+	if got.Host == nil || got.Host.Username != "www-data" || len(got.Host.Gateways) != 1 {
+		t.Fatalf("invalid host information decoded: %+v", got.Host)
+	}
+
+	if len(got.Crashes) != 1 || got.Crashes[0] != reply.Crashes[0] {
+		t.Fatalf("invalid crashes decoded: %+v", got.Crashes)
+	}
+}
+
+func TestEncodeDecodeNetworkProtobuf(t *testing.T) {
+	var buffer bytes.Buffer
+
+	response := NetworkResponsePacket{
+		Interfaces: []NetInterface{{Index: 1, Name: "eth0"}},
+		Neighbors:  []Neighbor{{Address: "10.0.0.2", HardwareAddr: []byte{0xde, 0xad, 0xbe, 0xef, 0, 2}, Interface: "eth0", State: "reachable"}},
+		Routes:     []SystemRoute{{Destination: "0.0.0.0/0", Gateway: "10.0.0.1", Interface: "eth0", Metric: 100}},
+		Errors:     []string{"neighbors: not supported"},
+	}
+
+	enc := NewEncoder(&buffer)
+	enc.SetEncoding(EncodingProtobuf)
+	if err := enc.Encode(Envelope{Type: MessageNetworkResponse, Payload: response}); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := NewDecoder(&buffer)
+	if err := dec.Decode(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := dec.Envelope.Payload.(NetworkResponsePacket)
+	if len(got.Interfaces) != 1 || len(got.Neighbors) != 1 || len(got.Routes) != 1 || len(got.Errors) != 1 {
+		t.Fatalf("invalid packet decoded: %+v", got)
+	}
+
+	if got.Neighbors[0].HardwareAddr.String() != "de:ad:be:ef:00:02" || got.Neighbors[0].State != "reachable" || got.Routes[0] != response.Routes[0] {
+		t.Fatalf("invalid entries decoded: %+v", got)
+	}
+}
+
+// fillPacket sets every field of a packet, so fields left out of the protobuf conversions don't go unnoticed
+func fillPacket(v reflect.Value, seed *int) {
+	*seed++
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(fmt.Sprintf("value %d", *seed))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(-*seed))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		v.SetUint(uint64(*seed))
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		fillPacket(v.Index(0), seed)
+		fillPacket(v.Index(1), seed)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillPacket(v.Elem(), seed)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fillPacket(v.Field(i), seed)
+		}
+	}
+}
+
+func TestProtobufFields(t *testing.T) {
+	for msgType, packet := range map[uint8]interface{}{
+		MessageInfoRequest:             InfoRequestPacket{},
+		MessageInfoReply:               InfoReplyPacket{},
+		MessageConnectRequest:          ConnectRequestPacket{},
+		MessageConnectResponse:         ConnectResponsePacket{},
+		MessageHostPingRequest:         HostPingRequestPacket{},
+		MessageHostPingResponse:        HostPingResponsePacket{},
+		MessageRedirectorRequest:       RedirectorRequestPacket{},
+		MessageRedirectorResponse:      RedirectorResponsePacket{},
+		MessageRedirectorCloseRequest:  RedirectorCloseRequestPacket{},
+		MessageRedirectorCloseResponse: RedirectorCloseResponsePacket{},
+		MessageDisconnectRequest:       DisconnectRequestPacket{},
+		MessageDisconnectResponse:      DisconnectResponsePacket{},
+		MessageThroughputRequest:       ThroughputRequestPacket{},
+		MessageThroughputResponse:      ThroughputResponsePacket{},
+		MessageBeaconRequest:           BeaconRequestPacket{},
+		MessageBeaconResponse:          BeaconResponsePacket{},
+		MessageForwardRequest:          ForwardRequestPacket{},
+		MessageForwardResponse:         ForwardResponsePacket{},
+		MessageSocksRequest:            SocksRequestPacket{},
+		MessageSocksResponse:           SocksResponsePacket{},
+		MessageFileListRequest:         FileListRequestPacket{},
+		MessageFileListResponse:        FileListResponsePacket{},
+		MessageFileDownloadRequest:     FileDownloadRequestPacket{},
+		MessageFileUploadRequest:       FileUploadRequestPacket{},
+		MessageFileTransferResponse:    FileTransferResponsePacket{},
+		MessageFileHash:                FileHashPacket{},
+		MessageExecRequest:             ExecRequestPacket{},
+		MessageExecOutput:              ExecOutputPacket{},
+		MessageExecExit:                ExecExitPacket{},
+		MessageUpdateRequest:           UpdateRequestPacket{},
+		MessageUpdateResponse:          UpdateResponsePacket{},
+		MessageScheduleRequest:         ScheduleRequestPacket{},
+		MessageScheduleResponse:        ScheduleResponsePacket{},
+		MessageNetworkRequest:          NetworkRequestPacket{},
+		MessageNetworkResponse:         NetworkResponsePacket{},
+		MessagePersistRequest:          PersistRequestPacket{},
+		MessagePersistResponse:         PersistResponsePacket{},
+		MessageBurnRequest:             BurnRequestPacket{},
+		MessageBurnResponse:            BurnResponsePacket{},
+		MessageBreakoutRequest:         BreakoutRequestPacket{},
+		MessageBreakoutResponse:        BreakoutResponsePacket{},
+		MessageHostInfoRequest:         HostInfoRequestPacket{},
+		MessageHostInfoResponse:        HostInfoResponsePacket{},
+		MessageCrashReport:             CrashReportPacket{},
+		MessageBandwidthRequest:        BandwidthRequestPacket{},
+		MessageBandwidthResponse:       BandwidthResponsePacket{},
+		MessageSweepRequest:            SweepRequestPacket{},
+		MessageSweepResult:             SweepResultPacket{},
+		MessageSweepDone:               SweepDonePacket{},
+		MessageDiagnosticsRequest:      DiagnosticsRequestPacket{},
+		MessageDiagnosticsResponse:     DiagnosticsResponsePacket{},
+		MessageCertificateRequest:      CertificateRequestPacket{},
+		MessageCertificateResponse:     CertificateResponsePacket{},
+	} {
+		filled := reflect.New(reflect.TypeOf(packet)).Elem()
+		fillPacket(filled, new(int))
+
+		data, err := marshalProtobuf(filled.Interface())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := unmarshalProtobuf(msgType, data)
+		if err != nil {
+			t.Fatalf("%T: %s", packet, err)
+		}
+
+		if !reflect.DeepEqual(got, filled.Interface()) {
+			t.Errorf("%T: got %+v, want %+v", packet, got, filled.Interface())
+		}
+	}
+}
+
+func TestWorkingHours(t *testing.T) {
+	hours, err := ParseWorkingHours("mon-fri 08:00-18:00, sat 22:00-02:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(day int, clock string) time.Time { // 2024-01-01 is a Monday
+		parsed, _ := time.Parse("15:04", clock)
+		return time.Date(2024, 1, day, parsed.Hour(), parsed.Minute(), 0, 0, time.UTC)
+	}
+
+	for _, check := range []struct {
+		at     time.Time
+		active bool
+		until  time.Time
+		next   time.Time
+	}{
+		{at: at(1, "07:59"), next: at(1, "08:00")},
+		{at: at(1, "08:00"), active: true, until: at(1, "18:00"), next: at(2, "08:00")},
+		{at: at(5, "18:00"), next: at(6, "22:00")},
+		{at: at(7, "01:00"), active: true, until: at(7, "02:00"), next: at(8, "08:00")},
+	} {
+		active, until := hours.Active(check.at)
+		if active != check.active || !until.Equal(check.until) {
+			t.Errorf("%s: got active=%v until %s", check.at, active, until)
+		}
+
+		if next := hours.Next(check.at); !next.Equal(check.next) {
+			t.Errorf("%s: got next window at %s", check.at, next)
+		}
+	}
+
+	for _, invalid := range []string{"08:00", "mon-fry 08:00-18:00", "08:00-08:00", "25:00-02:00"} {
+		if _, err := ParseWorkingHours(invalid); err == nil {
+			t.Errorf("%s: parsed", invalid)
+		}
+	}
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	if version, err := NegotiateVersion(0, 0); err != nil || version != 1 {
+		t.Errorf("agent predating versioning: got v%d, %v", version, err)
+	}
+
+	if version, err := NegotiateVersion(Version+1, MinVersion); err != nil || version != Version {
+		t.Errorf("newer agent: got v%d, %v", version, err)
+	}
+
+	if _, err := NegotiateVersion(Version+2, Version+1); !errors.Is(err, ErrIncompatible) {
+		t.Errorf("agent needing a newer server: got %v", err)
+	}
+}
+
+func TestCompress(t *testing.T) {
+	if NegotiateCompression(nil) != CompressionNone || NegotiateCompression([]uint8{CompressionSnappy}) != CompressionSnappy {
+		t.Fatal("invalid compression negotiated")
+	}
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	sent := bytes.Repeat([]byte("ligolo "), 10000)
+	go func() {
+		Compress(client, CompressionSnappy).Write(sent)
+	}()
+
+	received := make([]byte, len(sent))
+	if _, err := io.ReadFull(Compress(server, CompressionSnappy), received); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(sent, received) {
+		t.Fatal("invalid data decompressed")
+	}
+}
@@ -5,21 +5,27 @@ import (
 	"net"
 )
 
+// Dialer is used by redirectors to reach their destination
+type Dialer interface {
+	Dial(network string, address string) (net.Conn, error)
+}
+
 type Redirector struct {
 	ID       string
 	Network  string
 	From     string
 	To       string
 	Listener net.Listener
+	Dialer   Dialer
 }
 
-func NewLRedirector(id string, network string, from string, to string) (Redirector, error) {
+func NewLRedirector(id string, network string, from string, to string, dialer Dialer) (Redirector, error) {
 	lis, err := net.Listen(network, from)
 
 	if err != nil {
 		return Redirector{}, err
 	}
-	return Redirector{ID: id, Network: network, From: from, To: to, Listener: lis}, nil
+	return Redirector{ID: id, Network: network, From: from, To: to, Listener: lis, Dialer: dialer}, nil
 }
 
 func (s *Redirector) ListenAndRelay() error {
@@ -29,9 +35,10 @@ func (s *Redirector) ListenAndRelay() error {
 			return err
 		}
 
-		rconn, err := net.Dial(s.Network, s.To)
+		rconn, err := s.Dialer.Dial(s.Network, s.To)
 		if err != nil {
-			return err
+			lconn.Close() // the destination may come back, keep listening
+			continue
 		}
 
 		go StartRelay(lconn, rconn)
@@ -6,22 +6,26 @@ import (
 	"sync"
 )
 
-func StartRelay(src net.Conn, dst net.Conn) {
+// StartRelay pipes src and dst into each other until either side is done, it returns how many bytes went
+// from src to dst and from dst to src
+func StartRelay(src net.Conn, dst net.Conn) (int64, int64) {
 	done := make(chan struct{})
 	once := &sync.Once{}
 
 	var wg sync.WaitGroup
 	wg.Add(2)
 
+	var toDst, toSrc int64
+
 	go func() {
 		defer wg.Done()
-		io.Copy(dst, src)
+		toDst, _ = io.Copy(dst, src)
 		once.Do(func() { close(done) })
 	}()
 
 	go func() {
 		defer wg.Done()
-		io.Copy(src, dst)
+		toSrc, _ = io.Copy(src, dst)
 		once.Do(func() { close(done) })
 	}()
 
@@ -30,4 +34,6 @@ func StartRelay(src net.Conn, dst net.Conn) {
 	src.Close()
 
 	wg.Wait()
+
+	return toDst, toSrc
 }
@@ -3,55 +3,195 @@ package route
 import (
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/ttpreport/ligolo-mp/v2/cmd/client/tui/utils"
 	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Route struct {
-	ID         string
-	Cidr       *net.IPNet
-	IsLoopback bool
-	Metric     int
+	ID          string
+	Cidr        *net.IPNet
+	IsLoopback  bool
+	Metric      int
+	Ports       string
+	IsExclusion bool   // traffic is kept out of the tunnel even if a broader route matches
+	Usage       *Usage // nil for routes stored before usage was tracked
+	Tags        []string
+	Note        string
+	Compression string // overrides whether the session compresses connections to the route, empty to follow it
 }
 
-func NewRoute(cidr string, metric int, isLoopback bool) (*Route, error) {
+const (
+	CompressionDefault = ""
+	CompressionOn      = "on"
+	CompressionOff     = "off"
+)
+
+// ValidateCompression checks a compression override of a route
+func ValidateCompression(compression string) error {
+	switch compression {
+	case CompressionDefault, CompressionOn, CompressionOff:
+		return nil
+	default:
+		return fmt.Errorf("invalid compression override '%s', must be '%s', '%s' or empty", compression, CompressionOn, CompressionOff)
+	}
+}
+
+// Compresses tells whether connections to the route are compressed, given whether the session compresses them
+func (route *Route) Compresses(session bool) bool {
+	switch route.Compression {
+	case CompressionOn:
+		return true
+	case CompressionOff:
+		return false
+	default:
+		return session
+	}
+}
+
+func NewRoute(cidr string, metric int, isLoopback bool, ports string, isExclusion bool) (*Route, error) {
 	_, dst, err := net.ParseCIDR(cidr)
 	if err != nil {
 		return nil, err
 	}
 
+	if isExclusion && (isLoopback || ports != "") {
+		return nil, fmt.Errorf("exclusion routes can't be loopback or have port filters")
+	}
+
+	if _, err := ParsePorts(ports); err != nil {
+		return nil, err
+	}
+
 	return &Route{
-		ID:         uuid.New().String(),
-		Cidr:       dst,
-		IsLoopback: isLoopback,
-		Metric:     metric,
+		ID:          uuid.New().String(),
+		Cidr:        dst,
+		IsLoopback:  isLoopback,
+		Metric:      metric,
+		Ports:       ports,
+		IsExclusion: isExclusion,
+		Usage:       &Usage{},
 	}, nil
 }
 
+type PortRange struct {
+	From uint16
+	To   uint16
+}
+
+// ParsePorts parses a port filter like "22,80,8000-8100". Empty filter allows all ports
+func ParsePorts(ports string) ([]PortRange, error) {
+	var result []PortRange
+	if strings.TrimSpace(ports) == "" {
+		return result, nil
+	}
+
+	for _, part := range strings.Split(ports, ",") {
+		part = strings.TrimSpace(part)
+		from, to, isRange := strings.Cut(part, "-")
+
+		start, err := strconv.ParseUint(strings.TrimSpace(from), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port '%s'", part)
+		}
+
+		end := start
+		if isRange {
+			end, err = strconv.ParseUint(strings.TrimSpace(to), 10, 16)
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid port range '%s'", part)
+			}
+		}
+
+		result = append(result, PortRange{From: uint16(start), To: uint16(end)})
+	}
+
+	return result, nil
+}
+
+// AllowsPort checks if the port passes the route's port filter
+func (route *Route) AllowsPort(port uint16) bool {
+	ranges, err := ParsePorts(route.Ports)
+	if err != nil || len(ranges) == 0 {
+		return true
+	}
+
+	for _, r := range ranges {
+		if port >= r.From && port <= r.To {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (route *Route) String() string {
-	return fmt.Sprintf("CIDR=%s, IsLoopback=%s, Metric=%d", route.Cidr.String(), utils.HumanBool(route.IsLoopback), route.Metric)
+	return fmt.Sprintf("CIDR=%s, IsLoopback=%s, Metric=%d, Ports=%s, IsExclusion=%s", route.Cidr.String(), utils.HumanBool(route.IsLoopback), route.Metric, route.Ports, utils.HumanBool(route.IsExclusion))
 }
 
 func (route *Route) Proto() *pb.Route {
-	return &pb.Route{
-		ID:         route.ID,
-		Cidr:       route.Cidr.String(),
-		IsLoopback: route.IsLoopback,
-		Metric:     int32(route.Metric),
+	result := &pb.Route{
+		ID:          route.ID,
+		Cidr:        route.Cidr.String(),
+		IsLoopback:  route.IsLoopback,
+		Metric:      int32(route.Metric),
+		Ports:       route.Ports,
+		IsExclusion: route.IsExclusion,
+		Flows:       route.Usage.Flows(),
+		Bytes:       route.Usage.Bytes(),
+		Tags:        route.Tags,
+		Note:        route.Note,
+		Compression: route.Compression,
+	}
+
+	if lastUsed := route.Usage.LastUsed(); !lastUsed.IsZero() {
+		result.LastUsed = timestamppb.New(lastUsed)
 	}
+
+	return result
 }
 
 func ProtoToRoute(p *pb.Route) *Route {
 	_, cidr, _ := net.ParseCIDR(p.Cidr)
 
+	var lastUsed time.Time
+	if p.LastUsed != nil {
+		lastUsed = p.LastUsed.AsTime()
+	}
+
 	return &Route{
-		ID:         p.ID,
-		Cidr:       cidr,
-		IsLoopback: p.IsLoopback,
-		Metric:     int(p.Metric),
+		ID:          p.ID,
+		Cidr:        cidr,
+		IsLoopback:  p.IsLoopback,
+		Metric:      int(p.Metric),
+		Ports:       p.Ports,
+		IsExclusion: p.IsExclusion,
+		Usage:       NewUsage(p.Flows, p.Bytes, lastUsed),
+		Tags:        p.Tags,
+		Note:        p.Note,
+		Compression: p.Compression,
+	}
+}
+
+// MatchesFilter tells whether the network, a tag or the note of the route contains the query, regardless of case
+func (route *Route) MatchesFilter(query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
 	}
+
+	for _, field := range append([]string{route.Cidr.String(), route.Note}, route.Tags...) {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+
+	return false
 }
 
 type Trace struct {
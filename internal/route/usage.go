@@ -0,0 +1,108 @@
+package route
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+)
+
+// Usage counts the flows a route served. Copies of a route handed to the netstack share it, so counters are atomic
+type Usage struct {
+	flows    atomic.Int64
+	bytes    atomic.Int64
+	lastUsed atomic.Int64 // unix nanoseconds, zero if the route was never used
+}
+
+type usageJSON struct {
+	Flows    int64
+	Bytes    int64
+	LastUsed time.Time
+}
+
+func NewUsage(flows int64, bytes int64, lastUsed time.Time) *Usage {
+	usage := &Usage{}
+	usage.set(usageJSON{Flows: flows, Bytes: bytes, LastUsed: lastUsed})
+
+	return usage
+}
+
+// Open records a flow established through the route
+func (u *Usage) Open(at time.Time) {
+	if u == nil {
+		return
+	}
+
+	u.flows.Add(1)
+	u.touch(at)
+}
+
+// Close records the bytes a flow moved once it is over
+func (u *Usage) Close(bytes int64, at time.Time) {
+	if u == nil {
+		return
+	}
+
+	u.bytes.Add(bytes)
+	u.touch(at)
+}
+
+func (u *Usage) touch(at time.Time) {
+	for {
+		last := u.lastUsed.Load()
+		if last >= at.UnixNano() || u.lastUsed.CompareAndSwap(last, at.UnixNano()) {
+			return
+		}
+	}
+}
+
+func (u *Usage) Flows() int64 {
+	if u == nil {
+		return 0
+	}
+
+	return u.flows.Load()
+}
+
+func (u *Usage) Bytes() int64 {
+	if u == nil {
+		return 0
+	}
+
+	return u.bytes.Load()
+}
+
+// LastUsed is zero if no flow went through the route yet
+func (u *Usage) LastUsed() time.Time {
+	if u == nil || u.lastUsed.Load() == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, u.lastUsed.Load())
+}
+
+func (u *Usage) set(v usageJSON) {
+	u.flows.Store(v.Flows)
+	u.bytes.Store(v.Bytes)
+	if !v.LastUsed.IsZero() {
+		u.lastUsed.Store(v.LastUsed.UnixNano())
+	}
+}
+
+func (u *Usage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(usageJSON{
+		Flows:    u.Flows(),
+		Bytes:    u.Bytes(),
+		LastUsed: u.LastUsed(),
+	})
+}
+
+func (u *Usage) UnmarshalJSON(data []byte) error {
+	var v usageJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	u.set(v)
+
+	return nil
+}
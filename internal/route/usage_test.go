@@ -0,0 +1,48 @@
+package route
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUsage(t *testing.T) {
+	r, err := NewRoute("10.0.0.0/24", 0, false, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	copied := *r // what the netstack gets
+	copied.Usage.Open(start)
+	copied.Usage.Close(1500, start.Add(time.Second))
+	copied.Usage.Open(start) // a flow opened earlier must not move the last use back
+
+	if r.Usage.Flows() != 2 || r.Usage.Bytes() != 1500 {
+		t.Errorf("expected 2 flows and 1500 bytes, got %d and %d", r.Usage.Flows(), r.Usage.Bytes())
+	}
+
+	if !r.Usage.LastUsed().Equal(start.Add(time.Second)) {
+		t.Errorf("unexpected last use %s", r.Usage.LastUsed())
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var restored Route
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+
+	if restored.Usage.Flows() != 2 || restored.Usage.Bytes() != 1500 || !restored.Usage.LastUsed().Equal(r.Usage.LastUsed()) {
+		t.Errorf("usage did not survive storage: %s", data)
+	}
+
+	var legacy *Usage
+	legacy.Open(start)
+	if legacy.Flows() != 0 || !legacy.LastUsed().IsZero() {
+		t.Error("nil usage should count nothing")
+	}
+}
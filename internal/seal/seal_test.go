@@ -0,0 +1,28 @@
+package seal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	fields := []string{"socks5://127.0.0.1:1080", "1.3.3.7:11601\nwss://c2.example.com", "", "-----BEGIN CERTIFICATE-----"}
+
+	sealed, err := Seal(fields, Secret(KeyHostname, "WKS-042.corp.local"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opened, err := Open(sealed, Secret(KeyHostname, "wks-042"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(fields, opened) {
+		t.Fatalf("unexpected fields %q", opened)
+	}
+
+	if _, err := Open(sealed, Secret(KeyHostname, "wks-043")); err != ErrInvalidKey {
+		t.Fatalf("wrong key should be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+package session
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/route"
+)
+
+// ParseTags reads tags separated by commas, tags may have spaces, e.g. "DC subnet, web DMZ"
+func ParseTags(text string) []string {
+	var tags []string
+	for _, tag := range strings.Split(text, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// FormatTags writes tags the way ParseTags reads them
+func FormatTags(tags []string) string {
+	return strings.Join(tags, ", ")
+}
+
+// MatchesFilter tells whether the name, hostname, a tag or the note of the session contains the query, regardless
+// of case. Routes are left out, see Filter
+func (sess *Session) MatchesFilter(query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+
+	for _, field := range append([]string{sess.GetName(), sess.Hostname, sess.Note}, sess.Tags...) {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Filter keeps the sessions matching the query, or with a route that does
+func Filter(sessions []*Session, query string) []*Session {
+	var result []*Session
+	for _, sess := range sessions {
+		if sess.MatchesFilter(query) {
+			result = append(result, sess)
+			continue
+		}
+
+		for _, r := range sess.Tun.Routes.All() {
+			if r.MatchesFilter(query) {
+				result = append(result, sess)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// Annotate sets the tags and note of a session, or of one of its routes if routeID isn't empty
+func (ss *SessionService) Annotate(sessID string, routeID string, tags []string, note string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	tags = ParseTags(strings.Join(tags, ","))
+	note = strings.TrimSpace(note)
+
+	if routeID == "" {
+		session.Tags = tags
+		session.Note = note
+		return ss.repo.Save(session)
+	}
+
+	r := session.Tun.Routes.Get(routeID)
+	if r == nil {
+		return fmt.Errorf("route '%s' not found", routeID)
+	}
+	r.Tags = tags
+	r.Note = note
+
+	return ss.repo.Save(session)
+}
+
+// CarryAnnotation gives the route of a session for a network the tags, note and compression override of the route it
+// replaced, routes are recreated when they're edited or moved
+func (ss *SessionService) CarryAnnotation(sessID string, cidr string, old *route.Route) error {
+	if len(old.Tags) == 0 && old.Note == "" && old.Compression == route.CompressionDefault {
+		return nil
+	}
+
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+	if err != nil {
+		return err
+	}
+
+	for _, r := range session.Tun.Routes.All() {
+		if r.Cidr.String() == network.String() {
+			r.Tags = old.Tags
+			r.Note = old.Note
+			r.Compression = old.Compression
+			return ss.repo.Save(session)
+		}
+	}
+
+	return fmt.Errorf("route '%s' not found", cidr)
+}
@@ -0,0 +1,122 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// bandwidthRestoreTimeout bounds pushing the bandwidth cap to an agent that reconnected
+const bandwidthRestoreTimeout = 10 * time.Second
+
+var bitrateUnits = map[string]float64{"": 1, "k": 1e3, "m": 1e6, "g": 1e9}
+
+// ParseBandwidth reads a bitrate the way link speeds are written, e.g. "512k" or "10M" for 10 Mbit/s, and gives it
+// in bytes per second. Empty or 0 lifts the cap
+func ParseBandwidth(text string) (int64, error) {
+	rate := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(text)), "bit/s")
+	if rate == "" {
+		return 0, nil
+	}
+
+	number := strings.TrimRight(rate, "kmg")
+	unit, ok := bitrateUnits[rate[len(number):]]
+	value, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if !ok || err != nil || value < 0 {
+		return 0, fmt.Errorf("%s is invalid bandwidth, expected e.g. 512k or 10M", text)
+	}
+
+	limit := int64(value * unit / 8)
+	if value > 0 && limit == 0 {
+		return 0, fmt.Errorf("%s is below a byte per second", text)
+	}
+
+	return limit, nil
+}
+
+// FormatBandwidth writes a cap the way ParseBandwidth reads it
+func FormatBandwidth(limit int64) string {
+	if limit == 0 {
+		return ""
+	}
+
+	bits := float64(limit * 8)
+	for _, unit := range []string{"G", "M", "k"} {
+		if size := bitrateUnits[strings.ToLower(unit)]; bits >= size {
+			return strconv.FormatFloat(math.Round(bits/size*100)/100, 'f', -1, 64) + unit
+		}
+	}
+
+	return strconv.FormatFloat(bits, 'f', -1, 64)
+}
+
+func (sess *Session) remoteBandwidth(ctx context.Context, limit int64) error {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageBandwidthRequest,
+		Payload: protocol.BandwidthRequestPacket{Limit: limit},
+	}); err != nil {
+		return stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.BandwidthResponsePacket)
+	if !ok {
+		return fmt.Errorf("agent does not support bandwidth caps")
+	}
+	if response.Err {
+		return errors.New(response.ErrString)
+	}
+
+	return nil
+}
+
+// restoreBandwidth pushes the cap of a session to its agent again once it reconnects, it may have restarted since
+func (sess *Session) restoreBandwidth(limit int64) error {
+	sess.BandwidthCap = limit
+	if limit == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), bandwidthRestoreTimeout)
+	defer cancel()
+
+	return sess.remoteBandwidth(ctx, limit)
+}
+
+// SetBandwidth caps the bandwidth the agent of the session takes from the target network, in bytes per second. The
+// agent gets the cap right away if it's connected, or when it next connects
+func (ss *SessionService) SetBandwidth(ctx context.Context, sessID string, limit int64) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if limit < 0 {
+		return fmt.Errorf("%d is invalid bandwidth", limit)
+	}
+
+	if session.IsConnected {
+		if err := session.remoteBandwidth(ctx, limit); err != nil {
+			return err
+		}
+	}
+
+	session.BandwidthCap = limit
+
+	return ss.repo.Save(session)
+}
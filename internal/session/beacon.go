@@ -0,0 +1,257 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// Beacon is the low-and-slow state of a session. A beaconing agent is sent back to sleep every time it checks in,
+// unless an operator woke it up, in which case the tunnel stays up until it's put to sleep again
+type Beacon struct {
+	Interval     time.Duration // zero means the agent is always connected
+	Jitter       uint8         // percentage the interval is randomly shortened by
+	Awake        bool          // operator wants the tunnel kept up
+	WakeToken    string        // label of the hostname the agent resolves while asleep
+	WakeKey      []byte        // what the wake proof is keyed with, both change every time the agent goes to sleep
+	WorkingHours string        // windows the agent stays dormant in, as it reported them
+	Zone         int32         // offset of the agent's local time to UTC in seconds
+}
+
+func (b Beacon) Asleep() bool {
+	return b.Interval > 0 && !b.Awake
+}
+
+// Dormant tells whether the agent is within its working hours at the given time, and until when
+func (b Beacon) Dormant(at time.Time) (bool, time.Time) {
+	hours, err := protocol.ParseWorkingHours(b.WorkingHours)
+	if err != nil {
+		return false, time.Time{}
+	}
+
+	return hours.Active(at.In(time.FixedZone("", int(b.Zone))))
+}
+
+func newWakeSecrets() (string, []byte, error) {
+	token := make([]byte, 8)
+	if _, err := rand.Read(token); err != nil {
+		return "", nil, err
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", nil, err
+	}
+
+	return hex.EncodeToString(token), key, nil
+}
+
+func (sess *Session) remoteBeacon(request protocol.BeaconRequestPacket) error {
+	if !sess.IsMultiplexOpen() {
+		return fmt.Errorf("multiplex is disconnected")
+	}
+
+	stream, err := sess.Multiplex.Open()
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
+	protocolDecoder := protocol.NewDecoder(stream)
+
+	if err := protocolEncoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageBeaconRequest,
+		Payload: request,
+	}); err != nil {
+		return err
+	}
+
+	if err := protocolDecoder.Decode(); err != nil {
+		return err
+	}
+
+	if _, ok := protocolDecoder.Envelope.Payload.(protocol.BeaconResponsePacket); !ok {
+		return fmt.Errorf("agent does not support beaconing")
+	}
+
+	return nil
+}
+
+// SyncBeacon pushes the beacon settings to a connected agent, and drops the tunnel if it should be asleep
+func (ss *SessionService) SyncBeacon(sessID string) error {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if !sess.IsConnected {
+		return nil
+	}
+
+	request := protocol.BeaconRequestPacket{
+		Interval: int64(sess.Beacon.Interval),
+		Jitter:   sess.Beacon.Jitter,
+		Sleep:    sess.Beacon.Asleep(),
+	}
+
+	// fresh secrets for every sleep, a wake proof seen on the wire is useless afterwards
+	token, key := sess.Beacon.WakeToken, sess.Beacon.WakeKey
+	if request.Sleep || token == "" {
+		var err error
+		if token, key, err = newWakeSecrets(); err != nil {
+			return err
+		}
+	}
+
+	if ss.config.WakeDomain != "" {
+		request.WakeHost = fmt.Sprintf("%s.%s", token, ss.config.WakeDomain)
+		request.WakeKey = key
+		request.WakeCheck = int64(ss.config.WakeCheck)
+	}
+
+	if err := sess.remoteBeacon(request); err != nil {
+		return err
+	}
+
+	if token != sess.Beacon.WakeToken {
+		sess.Beacon.WakeToken = token
+		sess.Beacon.WakeKey = key
+
+		if err := ss.repo.Save(sess); err != nil {
+			return err
+		}
+	}
+
+	if request.Sleep {
+		sess.Drop(DisconnectSleep, "")
+		sess.Multiplex.Close() // the session monitor cleans up as for any disconnect
+	}
+
+	return nil
+}
+
+// SetBeacon changes how often the agent checks in, a zero interval keeps it connected
+func (ss *SessionService) SetBeacon(sessID string, interval time.Duration, jitter uint8) error {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if interval < 0 {
+		return fmt.Errorf("beacon interval can't be negative")
+	}
+
+	if jitter > 100 {
+		return fmt.Errorf("jitter is a percentage, it can't be over 100")
+	}
+
+	sess.Beacon.Interval = interval
+	sess.Beacon.Jitter = jitter
+
+	if err := ss.repo.Save(sess); err != nil {
+		return err
+	}
+
+	return ss.SyncBeacon(sessID)
+}
+
+func (sess *Session) remoteSchedule(workingHours string) error {
+	stream, err := sess.openRemoteStream(context.Background())
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageScheduleRequest,
+		Payload: protocol.ScheduleRequestPacket{WorkingHours: workingHours},
+	}); err != nil {
+		return err
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return err
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.ScheduleResponsePacket)
+	if !ok {
+		return fmt.Errorf("agent does not support working hours")
+	}
+	if response.Err {
+		return errors.New(response.ErrString)
+	}
+
+	return nil
+}
+
+// SetWorkingHours changes the windows a connected agent stays dormant in, until it restarts. If one of them is
+// running, the agent drops the tunnel right away
+func (ss *SessionService) SetWorkingHours(sessID string, workingHours string) error {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := protocol.ParseWorkingHours(workingHours); err != nil {
+		return err
+	}
+
+	if err := sess.remoteSchedule(workingHours); err != nil {
+		return err
+	}
+
+	sess.Beacon.WorkingHours = workingHours
+	if dormant, until := sess.Beacon.Dormant(time.Now().Add(sess.ClockSkew)); dormant {
+		sess.Drop(DisconnectDormant, fmt.Sprintf("until %s agent time", until.Format(time.DateTime)))
+	}
+
+	return ss.repo.Save(sess)
+}
+
+// Wake keeps the tunnel of a beaconing agent up, or sends it back to sleep. A sleeping agent notices the wake
+// up at its next check-in, or sooner if the wake DNS responder is enabled
+func (ss *SessionService) Wake(sessID string, awake bool) error {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if sess.Beacon.Interval == 0 {
+		return fmt.Errorf("session '%s' is not beaconing", sessID)
+	}
+
+	sess.Beacon.Awake = awake
+
+	if err := ss.repo.Save(sess); err != nil {
+		return err
+	}
+
+	if !awake {
+		return ss.SyncBeacon(sessID)
+	}
+
+	return nil
+}
+
+// WakePending returns the wake key of the agent resolving the token if it should check in now, nil otherwise
+func (ss *SessionService) WakePending(token string) []byte {
+	sessions, err := ss.repo.GetAll()
+	if err != nil {
+		return nil
+	}
+
+	for _, sess := range sessions {
+		if sess.Beacon.WakeToken == token && sess.Beacon.Awake {
+			return sess.Beacon.WakeKey
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// breakoutRestoreTimeout bounds pushing the breakout table to an agent that reconnected
+const breakoutRestoreTimeout = 10 * time.Second
+
+// ParseBreakout reads a breakout table written one rule per line: a network and the interface of the target its
+// connections leave through, e.g. "10.20.0.0/16 eth1". A network alone follows the routing table of the target
+func ParseBreakout(text string) ([]protocol.BreakoutRule, error) {
+	var rules []protocol.BreakoutRule
+	for _, line := range strings.Split(text, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		// Windows interface names have spaces, e.g. "Ethernet 2"
+		rule := protocol.BreakoutRule{Cidr: fields[0], Interface: strings.Join(fields[1:], " ")}
+		if _, _, err := net.ParseCIDR(rule.Cidr); err != nil {
+			return nil, fmt.Errorf("%s is invalid network", rule.Cidr)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// FormatBreakout writes a breakout table the way ParseBreakout reads it
+func FormatBreakout(rules []protocol.BreakoutRule) string {
+	var lines []string
+	for _, rule := range rules {
+		lines = append(lines, strings.TrimSpace(rule.Cidr+" "+rule.Interface))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func BreakoutProto(rules []protocol.BreakoutRule) []*pb.BreakoutRule {
+	var result []*pb.BreakoutRule
+	for _, rule := range rules {
+		result = append(result, &pb.BreakoutRule{Cidr: rule.Cidr, Interface: rule.Interface})
+	}
+
+	return result
+}
+
+func ProtoToBreakout(rules []*pb.BreakoutRule) []protocol.BreakoutRule {
+	var result []protocol.BreakoutRule
+	for _, rule := range rules {
+		result = append(result, protocol.BreakoutRule{Cidr: rule.Cidr, Interface: rule.Interface})
+	}
+
+	return result
+}
+
+func (sess *Session) remoteBreakout(ctx context.Context, rules []protocol.BreakoutRule) error {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageBreakoutRequest,
+		Payload: protocol.BreakoutRequestPacket{Rules: rules},
+	}); err != nil {
+		return stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.BreakoutResponsePacket)
+	if !ok {
+		return fmt.Errorf("agent does not support breakout")
+	}
+	if response.Err {
+		return errors.New(response.ErrString)
+	}
+
+	return nil
+}
+
+// restoreBreakout pushes the table of a session to its agent again once it reconnects, it may have restarted since
+func (sess *Session) restoreBreakout(rules []protocol.BreakoutRule) error {
+	sess.Breakout = rules
+	if len(rules) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), breakoutRestoreTimeout)
+	defer cancel()
+
+	return sess.remoteBreakout(ctx, rules)
+}
+
+// SetBreakout replaces the interfaces relayed connections of the session leave through, by destination. The agent
+// gets the table right away if it's connected, or when it next connects
+func (ss *SessionService) SetBreakout(ctx context.Context, sessID string, rules []protocol.BreakoutRule) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	for _, rule := range rules {
+		if _, _, err := net.ParseCIDR(rule.Cidr); err != nil {
+			return fmt.Errorf("%s is invalid network", rule.Cidr)
+		}
+	}
+
+	if session.IsConnected {
+		if err := session.remoteBreakout(ctx, rules); err != nil {
+			return err
+		}
+	}
+
+	session.Breakout = rules
+
+	return ss.repo.Save(session)
+}
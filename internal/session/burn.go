@@ -0,0 +1,63 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// Burn has the agent remove its persistence, its executable and its leftovers from the target, then exit. It returns
+// what the agent reported removing and what it couldn't, it is gone either way
+func (sess *Session) Burn(ctx context.Context) ([]string, []string, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageBurnRequest,
+		Payload: protocol.BurnRequestPacket{Persisted: sess.Persisted},
+	}); err != nil {
+		return nil, nil, stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return nil, nil, stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.BurnResponsePacket)
+	if !ok {
+		return nil, nil, fmt.Errorf("agent does not support burning")
+	}
+
+	return response.Removed, response.Errors, nil
+}
+
+// Burn cleans the agent of a session off its target. The session is kept, with what the agent couldn't remove
+// still listed as persisted
+func (ss *SessionService) Burn(ctx context.Context, sessID string, operator string) ([]string, []string, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sess.Drop(DisconnectBurned, fmt.Sprintf("burned by %s", operator))
+
+	removed, errs, err := sess.Burn(ctx)
+	if err != nil {
+		sess.Disconnection = Disconnection{} // the agent is still there, let the next disconnect tell why
+		return nil, nil, err
+	}
+
+	sess.Persisted = slices.DeleteFunc(sess.Persisted, func(method string) bool {
+		return !slices.ContainsFunc(errs, func(e string) bool {
+			return strings.HasPrefix(e, method+":")
+		})
+	})
+
+	return removed, errs, ss.repo.Save(sess)
+}
@@ -0,0 +1,68 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrClaimed is returned for calls on a session claimed by another operator, who has to release it or be overridden
+// by an admin first
+var ErrClaimed = errors.New("session is claimed")
+
+// CheckClaim tells whether an operator may work with the session: change its relay, routes and settings, run
+// commands and transfer files through it, or read what it collected
+func (sess *Session) CheckClaim(operator string) error {
+	if sess.Claim != "" && sess.Claim != operator {
+		return fmt.Errorf("%w: '%s' is claimed by %s, it has to be released or overridden by an admin first", ErrClaimed, sess.GetName(), sess.Claim)
+	}
+
+	return nil
+}
+
+// CheckClaim is Session.CheckClaim by ID, sessions that don't exist are left to the caller to report
+func (ss *SessionService) CheckClaim(sessID string, operator string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return nil
+	}
+
+	return session.CheckClaim(operator)
+}
+
+// Claim makes the operator the only one who may work with the session, or releases it. A claim
+// held by someone else is only taken over or released by an admin with override, the previous claimant is returned
+// then
+func (ss *SessionService) Claim(sessID string, operator string, isAdmin bool, release bool, override bool) (string, error) {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return "", fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	previous := session.Claim
+	if previous != "" && previous != operator {
+		if !override {
+			return "", fmt.Errorf("%w: '%s' is claimed by %s", ErrClaimed, session.GetName(), previous)
+		}
+		if !isAdmin {
+			return "", fmt.Errorf("%w: '%s' is claimed by %s, only admins can override it", ErrClaimed, session.GetName(), previous)
+		}
+	}
+
+	if release {
+		if previous == "" {
+			return "", fmt.Errorf("'%s' isn't claimed", session.GetName())
+		}
+		session.Claim = ""
+	} else {
+		if previous == operator {
+			return "", fmt.Errorf("'%s' is already claimed by %s", session.GetName(), operator)
+		}
+		session.Claim = operator
+	}
+
+	if previous == operator {
+		previous = ""
+	}
+
+	return previous, ss.repo.Save(session)
+}
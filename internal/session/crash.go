@@ -0,0 +1,137 @@
+package session
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxCrashes is how many distinct crashes a session keeps, the ones seen last win
+const maxCrashes = 20
+
+// Crash is a panic the agent recovered from, or several of them at the same place
+type Crash struct {
+	Where     string // what the agent was doing: "tunnel", "stream" or "redirector"
+	Panic     string
+	Frame     string // function that panicked
+	StackHash string // hash of the functions on the stack, the same from one build to the next
+	Count     int
+	First     time.Time
+	Last      time.Time
+}
+
+func (c Crash) String() string {
+	return fmt.Sprintf("%dx in %s, last %s: %s (%s, stack %s)", c.Count, c.Where, c.Last.Local().Format(time.DateTime), c.Panic, c.Frame, c.StackHash)
+}
+
+func CrashesProto(crashes []Crash) []*pb.Crash {
+	var result []*pb.Crash
+	for _, c := range crashes {
+		result = append(result, &pb.Crash{
+			Where:     c.Where,
+			Panic:     c.Panic,
+			Frame:     c.Frame,
+			StackHash: c.StackHash,
+			Count:     uint32(c.Count),
+			First:     timestamppb.New(c.First),
+			Last:      timestamppb.New(c.Last),
+		})
+	}
+
+	return result
+}
+
+func ProtoToCrashes(crashes []*pb.Crash) []Crash {
+	var result []Crash
+	for _, c := range crashes {
+		result = append(result, Crash{
+			Where:     c.Where,
+			Panic:     c.Panic,
+			Frame:     c.Frame,
+			StackHash: c.StackHash,
+			Count:     int(c.Count),
+			First:     c.First.AsTime(),
+			Last:      c.Last.AsTime(),
+		})
+	}
+
+	return result
+}
+
+// agentCrashes converts crashes reported by the agent, its clock is taken into account
+func (sess *Session) agentCrashes(reports []protocol.Crash) []Crash {
+	var result []Crash
+	for _, report := range reports {
+		result = append(result, Crash{
+			Where:     report.Where,
+			Panic:     report.Panic,
+			Frame:     report.Frame,
+			StackHash: report.StackHash,
+			Count:     report.Count,
+			First:     sess.ServerTime(time.Unix(0, report.First)),
+			Last:      sess.ServerTime(time.Unix(0, report.Last)),
+		})
+	}
+
+	return result
+}
+
+// addCrashes merges crashes into the ones of the session, by place
+func (sess *Session) addCrashes(crashes []Crash) {
+next:
+	for _, crash := range crashes {
+		for i := range sess.Crashes {
+			existing := &sess.Crashes[i]
+			if existing.Where == crash.Where && existing.StackHash == crash.StackHash {
+				existing.Count += crash.Count
+				if crash.First.Before(existing.First) {
+					existing.First = crash.First
+				}
+				if crash.Last.After(existing.Last) {
+					existing.Last = crash.Last
+					existing.Panic = crash.Panic
+				}
+				continue next
+			}
+		}
+
+		sess.Crashes = append(sess.Crashes, crash)
+	}
+
+	sort.Slice(sess.Crashes, func(i, j int) bool {
+		return sess.Crashes[i].Last.After(sess.Crashes[j].Last)
+	})
+	if len(sess.Crashes) > maxCrashes {
+		sess.Crashes = sess.Crashes[:maxCrashes]
+	}
+}
+
+// publishCrashes lets operators know the agent of a session recovered from crashes
+func publishCrashes(sess *Session, crashes []Crash) {
+	for _, crash := range crashes {
+		slog.Warn("agent recovered from a crash", slog.String("session", sess.GetName()), slog.String("where", crash.Where), slog.String("panic", crash.Panic), slog.String("frame", crash.Frame), slog.String("stack", crash.StackHash))
+		events.Publish(events.WARNING, "'%s' agent recovered from a crash in %s (%dx): %s", sess.GetName(), crash.Where, crash.Count, crash.Panic)
+	}
+}
+
+// crashed records the crashes an agent reports while connected
+func (ss *SessionService) crashed(sessID string, reports []protocol.Crash) {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return
+	}
+
+	crashes := sess.agentCrashes(reports)
+	sess.addCrashes(crashes)
+	if err := ss.repo.Save(sess); err != nil {
+		slog.Error("could not save crash reports", slog.String("session", sessID), slog.Any("error", err))
+	}
+
+	publishCrashes(sess, crashes)
+}
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+// maxClockSkew is how far the agent's clock may drift from the server's before it's reported, certificates and
+// working hours are off by as much
+const maxClockSkew = 5 * time.Minute
+
+func DiagnosticsProto(checks []protocol.DiagnosticCheck) []*pb.DiagnosticCheck {
+	var result []*pb.DiagnosticCheck
+	for _, check := range checks {
+		result = append(result, &pb.DiagnosticCheck{
+			Name:       check.Name,
+			Target:     check.Target,
+			OK:         check.OK,
+			Detail:     check.Detail,
+			DurationUs: time.Duration(check.Duration).Microseconds(),
+		})
+	}
+
+	return result
+}
+
+func ProtoToDiagnostics(checks []*pb.DiagnosticCheck) []protocol.DiagnosticCheck {
+	var result []protocol.DiagnosticCheck
+	for _, check := range checks {
+		result = append(result, protocol.DiagnosticCheck{
+			Name:     check.Name,
+			Target:   check.Target,
+			OK:       check.OK,
+			Detail:   check.Detail,
+			Duration: int64(time.Duration(check.DurationUs) * time.Microsecond),
+		})
+	}
+
+	return result
+}
+
+// Diagnose has the agent check how it reaches the server
+func (sess *Session) Diagnose(ctx context.Context) ([]protocol.DiagnosticCheck, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageDiagnosticsRequest,
+		Payload: protocol.DiagnosticsRequestPacket{},
+	}); err != nil {
+		return nil, stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return nil, stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.DiagnosticsResponsePacket)
+	if !ok {
+		return nil, fmt.Errorf("agent does not support diagnostics")
+	}
+
+	return response.Checks, nil
+}
+
+// Diagnose runs the self-test of a connected agent, with the clock skew the server measured on top
+func (ss *SessionService) Diagnose(ctx context.Context, sessID string) ([]protocol.DiagnosticCheck, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return nil, err
+	}
+
+	checks, err := sess.Diagnose(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	skew := sess.ClockSkew
+	if skew < 0 {
+		skew = -skew
+	}
+
+	return append(checks, protocol.DiagnosticCheck{
+		Name:   "clock",
+		Target: "server",
+		OK:     skew <= maxClockSkew,
+		Detail: fmt.Sprintf("agent clock is %s off the server's", sess.ClockSkew),
+	}), nil
+}
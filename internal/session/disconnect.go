@@ -0,0 +1,121 @@
+package session
+
+import (
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DisconnectReason says why a session dropped
+type DisconnectReason string
+
+const (
+	DisconnectUnknown   DisconnectReason = ""
+	DisconnectAgentExit DisconnectReason = "agent exit"           // the agent closed the connection
+	DisconnectTLS       DisconnectReason = "TLS error"            // the TLS layer failed, e.g. a bad record or an alert
+	DisconnectNetwork   DisconnectReason = "connection lost"      // the connection was reset or timed out underneath
+	DisconnectKeepAlive DisconnectReason = "keepalive timeout"    // the agent stopped answering pings
+	DisconnectRevoked   DisconnectReason = "revoked certificate"  // the agent's certificate got revoked while connected
+	DisconnectKilled    DisconnectReason = "operator kill"        // an operator killed the agent
+	DisconnectSleep     DisconnectReason = "beacon sleep"         // the agent went to sleep until its next check-in
+	DisconnectServer    DisconnectReason = "server shutdown"      // the server stopped while the agent was connected
+	DisconnectUpdate    DisconnectReason = "agent update"         // the agent restarted from a new build
+	DisconnectDormant   DisconnectReason = "working hours"        // the agent went dormant until its working hours are over
+	DisconnectBurned    DisconnectReason = "agent burned"         // the agent removed itself from the target and exited
+	DisconnectRotated   DisconnectReason = "certificate rotation" // the agent reconnected with a new certificate
+)
+
+func (r DisconnectReason) String() string {
+	if r == DisconnectUnknown {
+		return "unknown"
+	}
+
+	return string(r)
+}
+
+// Disconnection is the last time a session dropped and why, a session that connects again starts without one
+type Disconnection struct {
+	Reason DisconnectReason
+	Note   string    // details, like the error or the operator who killed the agent
+	At     time.Time // zero until the session is actually disconnected
+}
+
+// Drop records why the session is about to drop, the first reason given wins
+func (sess *Session) Drop(reason DisconnectReason, note string) {
+	if sess.Disconnection.Reason != DisconnectUnknown {
+		return
+	}
+
+	sess.Disconnection.Reason = reason
+	sess.Disconnection.Note = note
+}
+
+// ConnWatcher remembers the first read error of an agent connection, the multiplexer swallows it, and when the
+// agent was last heard from
+type ConnWatcher struct {
+	net.Conn
+
+	mutex    sync.Mutex
+	err      error
+	lastRead atomic.Int64 // unix nanoseconds
+}
+
+func NewConnWatcher(conn net.Conn) *ConnWatcher {
+	watcher := &ConnWatcher{Conn: conn}
+	watcher.lastRead.Store(time.Now().UnixNano())
+
+	return watcher
+}
+
+func (c *ConnWatcher) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.lastRead.Store(time.Now().UnixNano())
+	}
+	if err != nil {
+		c.mutex.Lock()
+		if c.err == nil {
+			c.err = err
+		}
+		c.mutex.Unlock()
+	}
+
+	return n, err
+}
+
+// Silence is how long the agent hasn't sent anything for, pings included
+func (c *ConnWatcher) Silence() time.Duration {
+	return time.Since(time.Unix(0, c.lastRead.Load()))
+}
+
+// Reason classifies the read error the connection died with
+func (c *ConnWatcher) Reason() (DisconnectReason, string) {
+	c.mutex.Lock()
+	err := c.err
+	c.mutex.Unlock()
+
+	if err == nil {
+		return DisconnectUnknown, ""
+	}
+
+	if errors.Is(err, io.EOF) {
+		return DisconnectAgentExit, ""
+	}
+
+	if errors.Is(err, net.ErrClosed) {
+		return DisconnectUnknown, "" // closed on our side, whoever closed it gave the reason
+	}
+
+	var alert tls.AlertError
+	var record tls.RecordHeaderError
+	if errors.As(err, &alert) || errors.As(err, &record) || strings.Contains(err.Error(), "tls:") {
+		return DisconnectTLS, err.Error()
+	}
+
+	return DisconnectNetwork, err.Error()
+}
@@ -0,0 +1,31 @@
+package session
+
+import (
+	"net"
+	"testing"
+)
+
+func TestConnWatcher(t *testing.T) {
+	server, agent := net.Pipe()
+	watcher := NewConnWatcher(server)
+
+	if reason, _ := watcher.Reason(); reason != DisconnectUnknown {
+		t.Fatalf("a live connection has no reason to drop, got %s", reason)
+	}
+
+	agent.Close()
+	if _, err := watcher.Read(make([]byte, 1)); err == nil {
+		t.Fatal("read should fail once the agent is gone")
+	}
+
+	if reason, _ := watcher.Reason(); reason != DisconnectAgentExit {
+		t.Fatalf("agent closing its end is an exit, got %s", reason)
+	}
+
+	sess := &Session{}
+	sess.Drop(DisconnectKilled, "killed by alice")
+	sess.Drop(DisconnectKeepAlive, "")
+	if sess.Disconnection.Reason != DisconnectKilled || sess.Disconnection.Note != "killed by alice" {
+		t.Fatalf("the first reason given should win, got %+v", sess.Disconnection)
+	}
+}
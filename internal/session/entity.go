@@ -5,12 +5,15 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/netip"
 	"sort"
 	"time"
 
 	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp/v2/internal/netstack"
 	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
 	"github.com/ttpreport/ligolo-mp/v2/internal/route"
 	"github.com/ttpreport/ligolo-mp/v2/internal/tun"
@@ -22,15 +25,60 @@ import (
 type Session struct {
 	ID          string
 	Alias       string
+	Tags        []string // set by operators to share context, e.g. "DC subnet"
+	Note        string
 	IsConnected bool
 	IsRelaying  bool
 	Redirectors *memstore.Syncmap[string, Redirector]
 	Tun         *tun.Tun
 	Hostname    string
 	Interfaces  *memstore.Syncslice[protocol.NetInterface]
+	Container   protocol.ContainerInfo
+	ClockSkew   time.Duration
+	Encoding    uint8          // session protocol encoding negotiated with the agent
+	Protocol    uint16         // session protocol version negotiated with the agent
+	Outdated    bool           // the agent speaks an older protocol than the server, a rebuild brings it up to date
+	Compression uint8          // relay stream compression negotiated with the agent, none if it can't compress
+	Link        Link           // multiplexer settings tuned for the agent connection
+	Beacon      Beacon         // low-and-slow mode
+	Socks       Socks          // SOCKS5 service on the target
+	Exec        bool           // the agent was built with command execution
+	Platform    string         // GOOS/GOARCH of the agent
+	Version     string         // SHA-256 of the agent executable, empty if it can't update itself
 	Multiplex   *yamux.Session `json:"-"`
+	Engagement  string         // engagement the session was filed under when it first connected
+	RelayedBy   string         // operator who started the relay
 	FirstSeen   time.Time
 	LastSeen    time.Time
+
+	Egress         string    // address the agent connected from, as the server sees it
+	PreviousEgress string    // egress before the last change
+	EgressChanged  time.Time // zero if the egress never changed
+	EgressPath     string    // how the agent reached the server, as the agent reports it
+	Transport      string    // transport the agent connected over, empty for agents that don't report it
+	Bridge         string    // session the agent links to the server through, empty if it connects directly
+	Listener       string    // listener the agent connected on, empty if it links through a bridge
+	Certificate    []byte    `json:",omitempty"` // DER of the certificate the agent connected with, none without TLS
+
+	Neighbors        []protocol.Neighbor    // ARP/NDP table as of the last network refresh
+	SystemRoutes     []protocol.SystemRoute // routing table of the agent as of the last network refresh
+	NetworkRefreshed time.Time              // zero until an operator refreshes the network view
+
+	Handoff *Handoff // transfer to another operator waiting for them to accept, nil if there's none
+	Claim   string   // operator the session belongs to, through a claim or a handoff. Empty if it's anyone's
+
+	Persistence []string // persistence methods the agent was built with
+	Persisted   []string // persistence methods installed on the target and not removed since
+
+	Breakout     []protocol.BreakoutRule // interfaces of the target relayed connections leave through, by destination
+	BandwidthCap int64                   // bytes per second the tunnel connection takes each way, 0 for no cap
+
+	Host          *protocol.HostInfo // nil if the agent was built without host information collection
+	HostRefreshed time.Time          // when the agent last collected Host
+
+	Crashes []Crash // panics the agent recovered from, most recent first
+
+	Disconnection Disconnection // why the session last dropped
 }
 
 type Redirector struct {
@@ -38,6 +86,8 @@ type Redirector struct {
 	Protocol string
 	From     string
 	To       string
+	Via      string // session the server reaches To through, empty if the agent dials it itself
+	Bridge   bool   // connections are agents linking through the session, the server takes them as sessions of their own
 }
 
 func (r *Redirector) Hash() string {
@@ -45,6 +95,12 @@ func (r *Redirector) Hash() string {
 	hasher.Write([]byte(r.Protocol))
 	hasher.Write([]byte(r.From))
 	hasher.Write([]byte(r.To))
+	if r.Via != "" {
+		hasher.Write([]byte(r.Via))
+	}
+	if r.Bridge {
+		hasher.Write([]byte("bridge"))
+	}
 	return hex.EncodeToString(hasher.Sum(nil))
 }
 
@@ -81,6 +137,16 @@ func (sess *Session) GetLastSeen() time.Time {
 	return sess.LastSeen
 }
 
+// ServerTime converts a timestamp taken from the agent's clock to server time
+func (sess *Session) ServerTime(agentTime time.Time) time.Time {
+	return agentTime.Add(-sess.ClockSkew)
+}
+
+// SlowTransport tells whether the agent is connected over a fallback transport of a few kilobytes per second
+func (sess *Session) SlowTransport() bool {
+	return sess.Transport == "dns"
+}
+
 func (sess *Session) RouteOverlaps(cidr string) (string, bool) {
 	for _, route := range sess.Tun.GetRoutes() {
 		slog.Debug("checking route", slog.Any("route", route))
@@ -106,8 +172,8 @@ func (sess *Session) RouteOverlaps(cidr string) (string, bool) {
 	return "", false
 }
 
-func (sess *Session) NewRoute(cidr string, metric int, isLoopback bool) error {
-	if err := sess.Tun.NewRoute(cidr, metric, isLoopback); err != nil {
+func (sess *Session) NewRoute(cidr string, metric int, isLoopback bool, ports string, isExclusion bool) error {
+	if err := sess.Tun.NewRoute(cidr, metric, isLoopback, ports, isExclusion); err != nil {
 		return err
 	}
 
@@ -135,18 +201,64 @@ func (sess *Session) RemoveRoute(id string) (*route.Route, error) {
 
 func (sess *Session) Copy(source *Session) {
 	sess.Alias = source.Alias
+	sess.Tags = source.Tags
+	sess.Note = source.Note
 	sess.FirstSeen = source.FirstSeen
+	sess.Engagement = source.Engagement
+	sess.RelayedBy = source.RelayedBy
+	sess.PreviousEgress = source.PreviousEgress
+	sess.EgressChanged = source.EgressChanged
+	sess.Handoff = source.Handoff
+	sess.Claim = source.Claim
+	sess.Persisted = source.Persisted
+	sess.addCrashes(source.Crashes)
+	if err := sess.restoreBreakout(source.Breakout); err != nil {
+		slog.Error("could not restore breakout table", slog.Any("error", err))
+	}
+	if err := sess.restoreBandwidth(source.BandwidthCap); err != nil {
+		slog.Error("could not restore bandwidth cap", slog.Any("error", err))
+	}
+	sess.Tun.SpoofSource = source.Tun.SpoofSource
+	sess.Tun.Compress = source.Tun.Compress
+	sess.Tun.Mirror = source.Tun.Mirror
+
+	if source.Socks.Enabled() && sess.Socks.Listening == "" { // the agent was restarted, bring the service back
+		if err := sess.SetSocks(source.Socks.Address, source.Socks.Username, source.Socks.Password); err != nil {
+			slog.Error("could not restore SOCKS5 service", slog.Any("error", err))
+		}
+	} else {
+		sess.Socks.Address = source.Socks.Address
+		sess.Socks.Username = source.Socks.Username
+		sess.Socks.Password = source.Socks.Password
+	}
+
+	sess.Beacon.Awake = source.Beacon.Awake
+	sess.Beacon.WakeToken = source.Beacon.WakeToken
+	sess.Beacon.WakeKey = source.Beacon.WakeKey
+	if source.Beacon.Interval > 0 { // tuned by an operator, otherwise the agent's own interval is kept
+		sess.Beacon.Interval = source.Beacon.Interval
+		sess.Beacon.Jitter = source.Beacon.Jitter
+	}
 
 	for _, route := range source.Tun.GetRoutes() {
-		if err := sess.NewRoute(route.Cidr.String(), route.Metric, route.IsLoopback); err != nil {
+		if err := sess.NewRoute(route.Cidr.String(), route.Metric, route.IsLoopback, route.Ports, route.IsExclusion); err != nil {
 			slog.Error("could not create new route", slog.Any("route", route))
 			continue
 		}
+
+		for _, restored := range sess.Tun.Routes.All() {
+			if route.Usage != nil && restored.Cidr.String() == route.Cidr.String() {
+				restored.Usage = route.Usage // counters carry over reconnects
+				restored.Tags = route.Tags
+				restored.Note = route.Note
+				restored.Compression = route.Compression
+			}
+		}
 		slog.Debug("route restored", slog.Any("route", route))
 	}
 
 	for _, redirector := range source.Redirectors.All() {
-		if err := sess.NewRedirector(redirector.Protocol, redirector.From, redirector.To); err != nil {
+		if err := sess.NewRedirector(redirector.Protocol, redirector.From, redirector.To, redirector.Via, redirector.Bridge); err != nil {
 			slog.Error("could not create new redirector", slog.Any("redirector", redirector))
 		}
 		slog.Debug("redirector restored", slog.Any("redirector", redirector))
@@ -156,12 +268,59 @@ func (sess *Session) Copy(source *Session) {
 func (sess *Session) Connect(multiplex *yamux.Session) error {
 	sess.Multiplex = multiplex
 
+	sess.Egress = multiplex.RemoteAddr().String()
+	if host, _, err := net.SplitHostPort(sess.Egress); err == nil {
+		sess.Egress = host
+	}
+
+	requested := time.Now()
 	info, err := sess.remoteGetInfo()
 	if err != nil {
 		return err
 	}
 	slog.Debug("received network info from remote")
 
+	version, err := protocol.NegotiateVersion(info.Protocol, info.MinProtocol)
+	if err != nil {
+		return err
+	}
+	sess.Protocol = version
+	sess.Outdated = version < protocol.Version
+
+	sess.Encoding = protocol.EncodingGob
+	if protocol.IsSupportedEncoding(info.Encoding) { // older agents only speak gob
+		sess.Encoding = info.Encoding
+	}
+
+	sess.Compression = protocol.CompressionNone
+	if protocol.IsSupportedCompression(info.Compression) {
+		sess.Compression = info.Compression
+	}
+
+	sess.Beacon.Interval = time.Duration(info.Beacon)
+	sess.Beacon.Jitter = info.Jitter
+	sess.Beacon.WorkingHours = info.WorkingHours
+	sess.Beacon.Zone = info.Zone
+	sess.EgressPath = info.EgressPath
+	sess.Transport = info.Transport
+	sess.Socks.Listening = info.Socks
+	sess.Exec = info.Exec
+	sess.Persistence = info.Persistence
+	sess.Host = info.Host
+	if info.Host != nil {
+		sess.HostRefreshed = time.Now()
+	}
+	sess.Platform = info.Platform
+	sess.Version = info.Version
+
+	sess.ClockSkew = 0
+	if info.Time != 0 { // older agents don't report their clock
+		rtt := time.Since(requested)
+		sess.ClockSkew = time.Unix(0, info.Time).Sub(requested.Add(rtt / 2)).Round(time.Second)
+	}
+
+	sess.addCrashes(sess.agentCrashes(info.Crashes))
+
 	for _, iface := range info.Interfaces {
 		sess.Interfaces.Append(iface)
 	}
@@ -175,12 +334,13 @@ func (sess *Session) Connect(multiplex *yamux.Session) error {
 		}
 	}
 	for _, redirector := range sess.Redirectors.All() {
-		if err := sess.NewRedirector(redirector.Protocol, redirector.From, redirector.To); err != nil {
+		if err := sess.NewRedirector(redirector.Protocol, redirector.From, redirector.To, redirector.Via, redirector.Bridge); err != nil {
 			slog.Error("could not create redirector", slog.Any("error", err))
 		}
 	}
 
 	sess.Hostname = info.Hostname
+	sess.Container = info.Container
 	sess.ID = sess.Hash()
 	sess.IsConnected = true
 
@@ -200,13 +360,13 @@ func (sess *Session) Disconnect() error {
 	return sess.remoteDestroySession()
 }
 
-func (sess *Session) StartRelay(maxConnections int, maxInFlight int) error {
+func (sess *Session) StartRelay(ns *netstack.NetStack, manageRoutes bool, simulate bool) error {
 	if sess.IsRelaying {
 		return fmt.Errorf("relay is already running")
 	}
 
 	if sess.IsConnected {
-		if err := sess.Tun.Start(sess.Multiplex, maxConnections, maxInFlight); err != nil {
+		if err := sess.Tun.Start(ns, sess.ID, sess.Multiplex, sess.Encoding, sess.Compression, manageRoutes, simulate); err != nil {
 			return err
 		}
 	}
@@ -227,18 +387,24 @@ func (sess *Session) StopRelay() error {
 	return nil
 }
 
-func (sess *Session) NewRedirector(proto string, from string, to string) error {
+func (sess *Session) Throughput(size int64) (int64, time.Duration, error) {
+	return sess.remoteThroughput(size)
+}
+
+func (sess *Session) NewRedirector(proto string, from string, to string, via string, bridge bool) error {
 	redirector := Redirector{
 		Protocol: proto,
 		From:     from,
 		To:       to,
+		Via:      via,
+		Bridge:   bridge,
 	}
 	redirector.ID = redirector.Hash()
 
 	sess.Redirectors.Set(redirector.ID, redirector)
 
 	if sess.IsConnected {
-		if err := sess.remoteCreateRedirector(redirector.ID, proto, from, to); err != nil {
+		if err := sess.remoteCreateRedirector(redirector.ID, proto, from, to, via != "" || bridge); err != nil {
 			return err
 		}
 	}
@@ -285,12 +451,13 @@ func (sess *Session) remoteGetInfo() (protocol.InfoReplyPacket, error) {
 	}
 	defer stream.Close()
 
+	// always gob, the encoding is only negotiated by this exchange
 	protocolEncoder := protocol.NewEncoder(stream)
 	protocolDecoder := protocol.NewDecoder(stream)
 
 	if err := protocolEncoder.Encode(protocol.Envelope{
 		Type:    protocol.MessageInfoRequest,
-		Payload: protocol.InfoRequestPacket{},
+		Payload: protocol.InfoRequestPacket{Encodings: protocol.SupportedEncodings, Version: protocol.Version, Compressions: protocol.SupportedCompressions},
 	}); err != nil {
 		return protocol.InfoReplyPacket{}, err
 	}
@@ -305,6 +472,42 @@ func (sess *Session) remoteGetInfo() (protocol.InfoReplyPacket, error) {
 	return response, nil
 }
 
+func (sess *Session) remoteThroughput(size int64) (int64, time.Duration, error) {
+	if !sess.IsMultiplexOpen() {
+		return 0, 0, fmt.Errorf("multiplex is disconnected")
+	}
+
+	stream, err := sess.Multiplex.Open()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer stream.Close()
+
+	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
+	protocolDecoder := protocol.NewDecoder(stream)
+
+	start := time.Now()
+	if err := protocolEncoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageThroughputRequest,
+		Payload: protocol.ThroughputRequestPacket{Size: size},
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	if err := protocolDecoder.Decode(); err != nil {
+		return 0, 0, err
+	}
+	response := protocolDecoder.Envelope.Payload.(protocol.ThroughputResponsePacket)
+
+	received, err := io.CopyN(io.Discard, stream, response.Size)
+	if err != nil {
+		return received, time.Since(start), err
+	}
+
+	return received, time.Since(start), nil
+}
+
 func (sess *Session) remoteDestroySession() error {
 	if !sess.IsMultiplexOpen() {
 		return nil
@@ -317,6 +520,7 @@ func (sess *Session) remoteDestroySession() error {
 	defer stream.Close()
 
 	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
 	if err := protocolEncoder.Encode(protocol.Envelope{
 		Type:    protocol.MessageDisconnectRequest,
 		Payload: protocol.DisconnectRequestPacket{},
@@ -327,7 +531,7 @@ func (sess *Session) remoteDestroySession() error {
 	return nil
 }
 
-func (sess *Session) remoteCreateRedirector(id string, proto string, from string, to string) error {
+func (sess *Session) remoteCreateRedirector(id string, proto string, from string, to string, forward bool) error {
 	if !sess.IsMultiplexOpen() {
 		return fmt.Errorf("multiplex is disconnected")
 	}
@@ -339,6 +543,7 @@ func (sess *Session) remoteCreateRedirector(id string, proto string, from string
 	defer stream.Close()
 
 	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
 	protocolDecoder := protocol.NewDecoder(stream)
 
 	redirectorPacket := protocol.RedirectorRequestPacket{
@@ -346,6 +551,7 @@ func (sess *Session) remoteCreateRedirector(id string, proto string, from string
 		Network: proto,
 		From:    from,
 		To:      to,
+		Forward: forward,
 	}
 	if err := protocolEncoder.Encode(protocol.Envelope{
 		Type:    protocol.MessageRedirectorRequest,
@@ -379,6 +585,7 @@ func (sess *Session) remoteRemoveRedirector(id string) error {
 	defer stream.Close()
 
 	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
 	protocolDecoder := protocol.NewDecoder(stream)
 
 	closeRequest := protocol.RedirectorCloseRequestPacket{ID: id}
@@ -426,8 +633,11 @@ func (sess *Session) Proto() *pb.Session {
 	var ifaces []*pb.Interface
 	for _, ifaceInfo := range sess.Interfaces.All() {
 		ifaces = append(ifaces, &pb.Interface{
-			Name: ifaceInfo.Name,
-			IPs:  ifaceInfo.Addresses,
+			Name:       ifaceInfo.Name,
+			IPs:        ifaceInfo.Addresses,
+			Index:      int32(ifaceInfo.Index),
+			Gateways:   ifaceInfo.Gateways,
+			DNSServers: ifaceInfo.DNSServers,
 		})
 	}
 
@@ -438,20 +648,124 @@ func (sess *Session) Proto() *pb.Session {
 			Protocol: r.Protocol,
 			From:     r.From,
 			To:       r.To,
+			Via:      r.Via,
+			Bridge:   r.Bridge,
+		})
+	}
+
+	var neighbors []*pb.Neighbor
+	for _, neighbor := range sess.Neighbors {
+		neighbors = append(neighbors, &pb.Neighbor{
+			Address:      neighbor.Address,
+			HardwareAddr: neighbor.HardwareAddr.String(),
+			Interface:    neighbor.Interface,
+			State:        neighbor.State,
 		})
 	}
 
+	var systemRoutes []*pb.SystemRoute
+	for _, route := range sess.SystemRoutes {
+		systemRoutes = append(systemRoutes, &pb.SystemRoute{
+			Destination: route.Destination,
+			Gateway:     route.Gateway,
+			Interface:   route.Interface,
+			Metric:      int32(route.Metric),
+		})
+	}
+
+	var hostRefreshed *timestamppb.Timestamp
+	if !sess.HostRefreshed.IsZero() {
+		hostRefreshed = timestamppb.New(sess.HostRefreshed)
+	}
+
+	var networkRefreshed *timestamppb.Timestamp
+	if !sess.NetworkRefreshed.IsZero() {
+		networkRefreshed = timestamppb.New(sess.NetworkRefreshed)
+	}
+
+	var egressChanged *timestamppb.Timestamp
+	if !sess.EgressChanged.IsZero() {
+		egressChanged = timestamppb.New(sess.EgressChanged)
+	}
+
+	var disconnection *pb.Disconnection
+	if !sess.Disconnection.At.IsZero() {
+		disconnection = &pb.Disconnection{
+			Reason: string(sess.Disconnection.Reason),
+			Note:   sess.Disconnection.Note,
+			At:     timestamppb.New(sess.Disconnection.At),
+		}
+	}
+
 	return &pb.Session{
 		ID:          sess.ID,
 		Alias:       sess.Alias,
+		Tags:        sess.Tags,
+		Note:        sess.Note,
 		IsConnected: sess.IsConnected,
 		IsRelaying:  sess.IsRelaying,
 		Redirectors: redirectors,
 		Tun:         sess.Tun.Proto(),
 		Hostname:    sess.Hostname,
 		Interfaces:  ifaces,
+		Container: &pb.Container{
+			Runtime:     sess.Container.Runtime,
+			HostNetwork: sess.Container.HostNetwork,
+			HostNetns:   sess.Container.HostNetns,
+			ViaHost:     sess.Container.ViaHost,
+		},
 		FirstSeen:   timestamppb.New(sess.GetFirstSeen()),
 		LastSeen:    timestamppb.New(sess.GetLastSeen()),
+		ClockSkewMs: sess.ClockSkew.Milliseconds(),
+		Link: &pb.Link{
+			RttUs:       sess.Link.RTT.Microseconds(),
+			Bandwidth:   sess.Link.Bandwidth,
+			WindowSize:  sess.Link.WindowSize,
+			KeepAliveMs: sess.Link.KeepAlive.Milliseconds(),
+		},
+		Beacon: &pb.Beacon{
+			IntervalMs:   sess.Beacon.Interval.Milliseconds(),
+			Jitter:       uint32(sess.Beacon.Jitter),
+			Awake:        sess.Beacon.Awake,
+			WorkingHours: sess.Beacon.WorkingHours,
+			Zone:         sess.Beacon.Zone,
+		},
+		Socks: &pb.Socks{
+			Address:   sess.Socks.Address,
+			Username:  sess.Socks.Username,
+			Listening: sess.Socks.Listening,
+		},
+		Engagement:     sess.Engagement,
+		Egress:         sess.Egress,
+		PreviousEgress: sess.PreviousEgress,
+		EgressChanged:  egressChanged,
+		EgressPath:     sess.EgressPath,
+		Transport:      sess.Transport,
+		Bridge:         sess.Bridge,
+		Listener:       sess.Listener,
+		Disconnection:  disconnection,
+		Exec:           sess.Exec,
+		Platform:       sess.Platform,
+		Version:        sess.Version,
+		Protocol:       uint32(sess.Protocol),
+		Outdated:       sess.Outdated,
+		Compression:    uint32(sess.Compression),
+
+		Neighbors:        neighbors,
+		SystemRoutes:     systemRoutes,
+		NetworkRefreshed: networkRefreshed,
+
+		Handoff: sess.Handoff.Proto(),
+		Claim:   sess.Claim,
+
+		Persistence:  sess.Persistence,
+		Persisted:    sess.Persisted,
+		Breakout:     BreakoutProto(sess.Breakout),
+		BandwidthCap: sess.BandwidthCap,
+
+		Host:          HostInfoProto(sess.Host),
+		HostRefreshed: hostRefreshed,
+		Crashes:       CrashesProto(sess.Crashes),
 	}
 }
 
@@ -463,6 +777,8 @@ func ProtoToSession(p *pb.Session) *Session {
 			Protocol: r.Protocol,
 			From:     r.From,
 			To:       r.To,
+			Via:      r.Via,
+			Bridge:   r.Bridge,
 		}
 
 		redirectors.Set(redirector.ID, redirector)
@@ -471,21 +787,127 @@ func ProtoToSession(p *pb.Session) *Session {
 	ifaces := memstore.NewSyncslice[protocol.NetInterface]()
 	for _, iface := range p.Interfaces {
 		ifaces.Append(protocol.NetInterface{
-			Name:      iface.Name,
-			Addresses: iface.IPs,
+			Name:       iface.Name,
+			Addresses:  iface.IPs,
+			Index:      int(iface.Index),
+			Gateways:   iface.Gateways,
+			DNSServers: iface.DNSServers,
+		})
+	}
+
+	var neighbors []protocol.Neighbor
+	for _, neighbor := range p.Neighbors {
+		hardwareAddr, _ := net.ParseMAC(neighbor.HardwareAddr)
+		neighbors = append(neighbors, protocol.Neighbor{
+			Address:      neighbor.Address,
+			HardwareAddr: hardwareAddr,
+			Interface:    neighbor.Interface,
+			State:        neighbor.State,
+		})
+	}
+
+	var systemRoutes []protocol.SystemRoute
+	for _, route := range p.SystemRoutes {
+		systemRoutes = append(systemRoutes, protocol.SystemRoute{
+			Destination: route.Destination,
+			Gateway:     route.Gateway,
+			Interface:   route.Interface,
+			Metric:      int(route.Metric),
 		})
 	}
 
+	var hostRefreshed time.Time
+	if p.HostRefreshed != nil {
+		hostRefreshed = p.HostRefreshed.AsTime()
+	}
+
+	var networkRefreshed time.Time
+	if p.NetworkRefreshed != nil {
+		networkRefreshed = p.NetworkRefreshed.AsTime()
+	}
+
+	var egressChanged time.Time
+	if p.EgressChanged != nil {
+		egressChanged = p.EgressChanged.AsTime()
+	}
+
+	var disconnection Disconnection
+	if p.Disconnection != nil {
+		disconnection = Disconnection{
+			Reason: DisconnectReason(p.Disconnection.Reason),
+			Note:   p.Disconnection.Note,
+			At:     p.Disconnection.At.AsTime(),
+		}
+	}
+
 	return &Session{
 		ID:          p.ID,
 		Alias:       p.Alias,
+		Tags:        p.Tags,
+		Note:        p.Note,
 		IsConnected: p.IsConnected,
 		IsRelaying:  p.IsRelaying,
 		Redirectors: redirectors,
 		Tun:         tun.ProtoToTun(p.Tun),
 		Hostname:    p.Hostname,
 		Interfaces:  ifaces,
-		FirstSeen:   p.FirstSeen.AsTime(),
-		LastSeen:    p.LastSeen.AsTime(),
+		Container: protocol.ContainerInfo{
+			Runtime:     p.Container.GetRuntime(),
+			HostNetwork: p.Container.GetHostNetwork(),
+			HostNetns:   p.Container.GetHostNetns(),
+			ViaHost:     p.Container.GetViaHost(),
+		},
+		FirstSeen: p.FirstSeen.AsTime(),
+		LastSeen:  p.LastSeen.AsTime(),
+		ClockSkew: time.Duration(p.ClockSkewMs) * time.Millisecond,
+		Link: Link{
+			RTT:        time.Duration(p.Link.GetRttUs()) * time.Microsecond,
+			Bandwidth:  p.Link.GetBandwidth(),
+			WindowSize: p.Link.GetWindowSize(),
+			KeepAlive:  time.Duration(p.Link.GetKeepAliveMs()) * time.Millisecond,
+		},
+		Beacon: Beacon{
+			Interval:     time.Duration(p.Beacon.GetIntervalMs()) * time.Millisecond,
+			Jitter:       uint8(p.Beacon.GetJitter()),
+			Awake:        p.Beacon.GetAwake(),
+			WorkingHours: p.Beacon.GetWorkingHours(),
+			Zone:         p.Beacon.GetZone(),
+		},
+		Socks: Socks{
+			Address:   p.Socks.GetAddress(),
+			Username:  p.Socks.GetUsername(),
+			Listening: p.Socks.GetListening(),
+		},
+		Engagement:     p.Engagement,
+		Egress:         p.Egress,
+		PreviousEgress: p.PreviousEgress,
+		EgressChanged:  egressChanged,
+		EgressPath:     p.EgressPath,
+		Transport:      p.Transport,
+		Bridge:         p.Bridge,
+		Listener:       p.Listener,
+		Disconnection:  disconnection,
+		Exec:           p.Exec,
+		Platform:       p.Platform,
+		Version:        p.Version,
+		Protocol:       uint16(p.Protocol),
+		Outdated:       p.Outdated,
+		Compression:    uint8(p.Compression),
+
+		Neighbors:        neighbors,
+		SystemRoutes:     systemRoutes,
+		NetworkRefreshed: networkRefreshed,
+
+		Handoff: ProtoToHandoff(p.Handoff),
+		Claim:   p.Claim,
+
+		Persistence:  p.Persistence,
+		Persisted:    p.Persisted,
+		Breakout:     ProtoToBreakout(p.Breakout),
+		BandwidthCap: p.BandwidthCap,
+
+		Host:          ProtoToHostInfo(p.Host),
+		HostRefreshed: hostRefreshed,
+		Crashes:       ProtoToCrashes(p.Crashes),
 	}
 }
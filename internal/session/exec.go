@@ -0,0 +1,66 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// RunCommand runs a command through the shell of the target and returns its exit code, output is called as
+// the command writes. Cancelling the context kills the command
+func (sess *Session) RunCommand(ctx context.Context, command string, timeout time.Duration, output func(stderr bool, data []byte) error) (int, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return -1, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageExecRequest,
+		Payload: protocol.ExecRequestPacket{Command: command, Timeout: int64(timeout)},
+	}); err != nil {
+		return -1, stream.err(err)
+	}
+
+	for {
+		if err := stream.decoder.Decode(); err != nil {
+			return -1, stream.err(err)
+		}
+
+		switch payload := stream.decoder.Envelope.Payload.(type) {
+		case protocol.ExecOutputPacket:
+			if err := output(payload.Stderr, payload.Data); err != nil {
+				return -1, err
+			}
+		case protocol.ExecExitPacket:
+			if payload.Err {
+				return -1, errors.New(payload.ErrString)
+			}
+
+			return int(payload.ExitCode), nil
+		default:
+			return -1, fmt.Errorf("agent does not support command execution")
+		}
+	}
+}
+
+// RunCommand runs a command on a connected agent that was built with command execution
+func (ss *SessionService) RunCommand(ctx context.Context, sessID string, command string, timeout time.Duration, output func(stderr bool, data []byte) error) (int, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return -1, err
+	}
+
+	if !sess.Exec {
+		return -1, fmt.Errorf("agent of '%s' was built without command execution", sess.GetName())
+	}
+
+	if command == "" {
+		return -1, fmt.Errorf("empty command")
+	}
+
+	return sess.RunCommand(ctx, command, timeout, output)
+}
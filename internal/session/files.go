@@ -0,0 +1,283 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fileChunkSize is the largest piece of a file sent in a single message
+const fileChunkSize = 1 << 20
+
+type FileEntry struct {
+	Name    string
+	Size    int64
+	Mode    os.FileMode
+	ModTime time.Time
+	IsDir   bool
+}
+
+func (entry FileEntry) Proto() *pb.FileEntry {
+	return &pb.FileEntry{
+		Name:    entry.Name,
+		Size:    entry.Size,
+		Mode:    uint32(entry.Mode),
+		ModTime: timestamppb.New(entry.ModTime),
+		IsDir:   entry.IsDir,
+	}
+}
+
+func ProtoToFileEntry(p *pb.FileEntry) FileEntry {
+	return FileEntry{
+		Name:    p.Name,
+		Size:    p.Size,
+		Mode:    os.FileMode(p.Mode),
+		ModTime: p.ModTime.AsTime(),
+		IsDir:   p.IsDir,
+	}
+}
+
+// FileHash is what the agent reports once a transfer is over, for the whole file and not only the part that
+// was transferred, so resumed transfers can be verified too
+type FileHash struct {
+	Size   int64
+	Sha256 string
+}
+
+// ListFiles lists a directory on the target, or a single file, and returns the absolute path of the directory
+func (sess *Session) ListFiles(path string) (string, []FileEntry, error) {
+	stream, err := sess.openRemoteStream(context.Background())
+	if err != nil {
+		return "", nil, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageFileListRequest,
+		Payload: protocol.FileListRequestPacket{Path: path},
+	}); err != nil {
+		return "", nil, err
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return "", nil, err
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.FileListResponsePacket)
+	if !ok {
+		return "", nil, fmt.Errorf("agent does not support file transfers")
+	}
+	if response.Err {
+		return "", nil, errors.New(response.ErrString)
+	}
+
+	var entries []FileEntry
+	for _, entry := range response.Entries {
+		entries = append(entries, FileEntry{
+			Name:    entry.Name,
+			Size:    entry.Size,
+			Mode:    os.FileMode(entry.Mode),
+			ModTime: time.Unix(entry.ModTime, 0),
+			IsDir:   entry.IsDir,
+		})
+	}
+
+	return response.Path, entries, nil
+}
+
+// DownloadFile copies a file of the target from offset into dst, start is called with the amount of bytes
+// that will follow and the size of the whole file before anything is written
+func (sess *Session) DownloadFile(ctx context.Context, path string, offset int64, start func(size int64, total int64) error, dst io.Writer) (FileHash, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return FileHash{}, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageFileDownloadRequest,
+		Payload: protocol.FileDownloadRequestPacket{Path: path, Offset: offset},
+	}); err != nil {
+		return FileHash{}, stream.err(err)
+	}
+
+	response, err := stream.transferResponse()
+	if err != nil {
+		return FileHash{}, err
+	}
+
+	if err := start(response.Size, response.Total); err != nil {
+		return FileHash{}, err
+	}
+
+	if _, err := io.CopyBuffer(dst, io.LimitReader(stream, response.Size), make([]byte, fileChunkSize)); err != nil {
+		return FileHash{}, stream.err(err)
+	}
+
+	return stream.hash()
+}
+
+// UploadFile writes size bytes read from src at offset of a file on the target, what's past offset is
+// discarded first. Files that get created have the permissions of mode
+func (sess *Session) UploadFile(ctx context.Context, path string, offset int64, size int64, mode os.FileMode, src io.Reader) (FileHash, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return FileHash{}, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type: protocol.MessageFileUploadRequest,
+		Payload: protocol.FileUploadRequestPacket{
+			Path:   path,
+			Offset: offset,
+			Size:   size,
+			Mode:   uint32(mode.Perm()),
+		},
+	}); err != nil {
+		return FileHash{}, stream.err(err)
+	}
+
+	if _, err := stream.transferResponse(); err != nil {
+		return FileHash{}, err
+	}
+
+	written, err := io.CopyBuffer(stream, io.LimitReader(src, size), make([]byte, fileChunkSize))
+	if err != nil {
+		return FileHash{}, stream.err(err)
+	}
+	if written != size {
+		return FileHash{}, fmt.Errorf("upload interrupted after %d of %d bytes", written, size)
+	}
+
+	return stream.hash()
+}
+
+// remoteStream is a stream to the agent that gets closed when the context is done, so transfers and commands
+// can be aborted
+type remoteStream struct {
+	io.ReadWriteCloser
+
+	ctx     context.Context
+	stop    func() bool
+	encoder protocol.LigoloEncoder
+	decoder protocol.LigoloDecoder
+}
+
+func (sess *Session) openRemoteStream(ctx context.Context) (*remoteStream, error) {
+	if !sess.IsMultiplexOpen() {
+		return nil, fmt.Errorf("multiplex is disconnected")
+	}
+
+	conn, err := sess.Multiplex.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &remoteStream{
+		ReadWriteCloser: conn,
+		ctx:             ctx,
+		stop:            context.AfterFunc(ctx, func() { conn.Close() }),
+		encoder:         protocol.NewEncoder(conn),
+		decoder:         protocol.NewDecoder(conn),
+	}
+	stream.encoder.SetEncoding(sess.Encoding)
+
+	return stream, nil
+}
+
+func (stream *remoteStream) Close() error {
+	stream.stop()
+	return stream.ReadWriteCloser.Close()
+}
+
+// err reports why the stream broke, a cancelled transfer shows up as a closed stream otherwise
+func (stream *remoteStream) err(err error) error {
+	if ctxErr := stream.ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	return err
+}
+
+func (stream *remoteStream) transferResponse() (protocol.FileTransferResponsePacket, error) {
+	if err := stream.decoder.Decode(); err != nil {
+		return protocol.FileTransferResponsePacket{}, stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.FileTransferResponsePacket)
+	if !ok {
+		return response, fmt.Errorf("agent does not support file transfers")
+	}
+	if response.Err {
+		return response, errors.New(response.ErrString)
+	}
+
+	return response, nil
+}
+
+func (stream *remoteStream) hash() (FileHash, error) {
+	if err := stream.decoder.Decode(); err != nil {
+		return FileHash{}, stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.FileHashPacket)
+	if !ok {
+		return FileHash{}, fmt.Errorf("unexpected %T from agent", stream.decoder.Envelope.Payload)
+	}
+	if response.Err {
+		return FileHash{}, fmt.Errorf("transfer done but the file could not be hashed: %s", response.ErrString)
+	}
+
+	return FileHash{Size: response.Size, Sha256: response.Sha256}, nil
+}
+
+// ListFiles lists a path on a connected agent
+func (ss *SessionService) ListFiles(sessID string, path string) (string, []FileEntry, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sess.ListFiles(path)
+}
+
+// DownloadFile fetches a file from a connected agent, see Session.DownloadFile
+func (ss *SessionService) DownloadFile(ctx context.Context, sessID string, path string, offset int64, start func(size int64, total int64) error, dst io.Writer) (FileHash, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return FileHash{}, err
+	}
+
+	return sess.DownloadFile(ctx, path, offset, start, dst)
+}
+
+// UploadFile sends a file to a connected agent, see Session.UploadFile
+func (ss *SessionService) UploadFile(ctx context.Context, sessID string, path string, offset int64, size int64, mode os.FileMode, src io.Reader) (FileHash, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return FileHash{}, err
+	}
+
+	return sess.UploadFile(ctx, path, offset, size, mode, src)
+}
+
+func (ss *SessionService) connectedSession(sessID string) (*Session, error) {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return nil, fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if !sess.IsConnected {
+		return nil, fmt.Errorf("session '%s' is not connected", sessID)
+	}
+
+	return sess, nil
+}
@@ -0,0 +1,189 @@
+package session
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	"github.com/ttpreport/ligolo-mp/v2/internal/relay"
+)
+
+// ServeForwards takes the connections forwarding redirectors of the session hand back to the server, until the
+// agent disconnects. Streams beyond the configured limit are refused
+func (ss *SessionService) ServeForwards(sess *Session) {
+	for {
+		stream, err := sess.Multiplex.Accept()
+		if err != nil {
+			return
+		}
+
+		if limit := ss.config.MuxMaxStreams; limit > 0 && sess.Multiplex.NumStreams() > limit {
+			slog.Debug("agent opened too many streams, refusing one", slog.String("session", sess.GetName()), slog.Int("limit", limit))
+			stream.Close()
+			continue
+		}
+
+		go ss.forward(sess.ID, stream)
+	}
+}
+
+func (ss *SessionService) forward(sessID string, stream net.Conn) {
+	decoder := protocol.NewDecoder(stream)
+	if err := decoder.Decode(); err != nil {
+		stream.Close()
+		return
+	}
+
+	if report, ok := decoder.Envelope.Payload.(protocol.CrashReportPacket); ok {
+		stream.Close()
+		ss.crashed(sessID, report.Crashes)
+		return
+	}
+
+	encoder := protocol.NewEncoder(stream)
+	encoder.SetEncoding(decoder.Envelope.Encoding)
+
+	request, ok := decoder.Envelope.Payload.(protocol.ForwardRequestPacket)
+	if !ok {
+		stream.Close()
+		return
+	}
+
+	if sess := ss.repo.GetOne(sessID); sess != nil && sess.Redirectors.Exists(request.RedirectorID) && sess.GetRedirector(request.RedirectorID).Bridge {
+		ss.bridge(sessID, stream, encoder)
+		return
+	}
+
+	target, err := ss.dialVia(sessID, request.RedirectorID)
+	if err != nil {
+		slog.Debug("could not forward redirector connection", slog.String("redirector", request.RedirectorID), slog.Any("error", err))
+	}
+
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageForwardResponse,
+		Payload: protocol.ForwardResponsePacket{Established: target != nil},
+	}); err != nil || target == nil {
+		if target != nil {
+			target.Close()
+		}
+		stream.Close()
+		return
+	}
+
+	relay.StartRelay(stream, target)
+}
+
+// HandleBridged sets where agents linking through a bridge go, the agent server takes them as any other connection
+func (ss *SessionService) HandleBridged(f func(conn net.Conn, via string)) {
+	ss.bridged = f
+}
+
+// SetBridge records the session an agent linked through, it is cleared as soon as the agent connects again
+func (ss *SessionService) SetBridge(sessID string, via string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	session.Bridge = via
+
+	return ss.repo.Save(session)
+}
+
+// bridge hands the stream to the agent server. The agent on the other end speaks TLS with the server, the bridging
+// agent only relays it
+func (ss *SessionService) bridge(sessID string, stream net.Conn, encoder protocol.LigoloEncoder) {
+	if err := encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageForwardResponse,
+		Payload: protocol.ForwardResponsePacket{Established: ss.bridged != nil},
+	}); err != nil || ss.bridged == nil {
+		stream.Close()
+		return
+	}
+
+	slog.Debug("agent linking through bridge", slog.String("session", sessID))
+	ss.bridged(stream, sessID)
+}
+
+// dialVia reaches the destination of a redirector through the session it forwards to
+func (ss *SessionService) dialVia(sessID string, redirectorID string) (net.Conn, error) {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return nil, fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if !sess.Redirectors.Exists(redirectorID) {
+		return nil, fmt.Errorf("redirector '%s' not found", redirectorID)
+	}
+
+	redirector := sess.GetRedirector(redirectorID)
+	if redirector.Via == "" {
+		return nil, fmt.Errorf("redirector '%s' does not forward", redirectorID)
+	}
+
+	via := ss.repo.GetOne(redirector.Via)
+	if via == nil || !via.IsConnected {
+		return nil, fmt.Errorf("session '%s' is not connected", redirector.Via)
+	}
+
+	return via.remoteConnect(redirector.To)
+}
+
+// remoteConnect has the agent open a TCP connection, the stream carries it once established
+func (sess *Session) remoteConnect(address string) (net.Conn, error) {
+	if !sess.IsMultiplexOpen() {
+		return nil, fmt.Errorf("multiplex is disconnected")
+	}
+
+	host, portString, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid port", portString)
+	}
+
+	network := protocol.Networkv4
+	if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+		network = protocol.Networkv6
+	}
+
+	stream, err := sess.Multiplex.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
+	protocolDecoder := protocol.NewDecoder(stream)
+
+	if err := protocolEncoder.Encode(protocol.Envelope{
+		Type: protocol.MessageConnectRequest,
+		Payload: protocol.ConnectRequestPacket{
+			Net:       network,
+			Transport: protocol.TransportTCP,
+			Address:   host,
+			Port:      uint16(port),
+		},
+	}); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	if err := protocolDecoder.Decode(); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	response, ok := protocolDecoder.Envelope.Payload.(protocol.ConnectResponsePacket)
+	if !ok || !response.Established {
+		stream.Close()
+		return nil, fmt.Errorf("%s is unreachable from '%s'", address, sess.GetName())
+	}
+
+	return stream, nil
+}
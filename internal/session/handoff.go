@@ -0,0 +1,113 @@
+package session
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handoff is a transfer of a session to another operator, waiting for them to accept it
+type Handoff struct {
+	From      string
+	To        string
+	Note      string // left for the recipient, attached to the session once they accept
+	Layout    string // layout preset of the sender, the recipient's client switches to it on acceptance
+	Requested time.Time
+}
+
+func (h *Handoff) Proto() *pb.Handoff {
+	if h == nil {
+		return nil
+	}
+
+	return &pb.Handoff{
+		From:      h.From,
+		To:        h.To,
+		Note:      h.Note,
+		Layout:    h.Layout,
+		Requested: timestamppb.New(h.Requested),
+	}
+}
+
+func ProtoToHandoff(p *pb.Handoff) *Handoff {
+	if p == nil {
+		return nil
+	}
+
+	return &Handoff{
+		From:      p.From,
+		To:        p.To,
+		Note:      p.Note,
+		Layout:    p.Layout,
+		Requested: p.Requested.AsTime(),
+	}
+}
+
+// CanHandOff tells whether an operator may hand the session off, which is up to its claimant, or anyone while it's
+// unclaimed. Admins can always do it
+func (sess *Session) CanHandOff(operator string, isAdmin bool) bool {
+	return isAdmin || sess.Claim == "" || sess.Claim == operator
+}
+
+// RequestHandoff offers the session to another operator, replacing any handoff still pending
+func (ss *SessionService) RequestHandoff(sessID string, from string, isAdmin bool, to string, note string, layout string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if !session.CanHandOff(from, isAdmin) {
+		return fmt.Errorf("%w: '%s' is claimed by %s", ErrClaimed, session.GetName(), session.Claim)
+	}
+
+	to = strings.TrimSpace(to)
+	if to == "" {
+		return fmt.Errorf("no operator to hand off to")
+	}
+	if to == from || to == session.Claim {
+		return fmt.Errorf("%s already holds '%s'", to, session.GetName())
+	}
+
+	session.Handoff = &Handoff{
+		From:      from,
+		To:        to,
+		Note:      strings.TrimSpace(note),
+		Layout:    layout,
+		Requested: time.Now(),
+	}
+
+	return ss.repo.Save(session)
+}
+
+// AnswerHandoff settles the pending handoff. The recipient accepts or declines it, the sender can only withdraw it.
+// Accepting moves the claim to the recipient, unless someone else claimed the session since it was offered
+func (ss *SessionService) AnswerHandoff(sessID string, operator string, accept bool) (*Handoff, error) {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return nil, fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	handoff := session.Handoff
+	if handoff == nil {
+		return nil, fmt.Errorf("'%s' has no pending handoff", session.GetName())
+	}
+
+	switch {
+	case operator == handoff.To:
+		if accept {
+			if session.Claim != "" && session.Claim != handoff.From {
+				return nil, fmt.Errorf("%w: '%s' was claimed by %s after it was handed off", ErrClaimed, session.GetName(), session.Claim)
+			}
+			session.Claim = handoff.To
+		}
+	case operator == handoff.From && !accept:
+	default:
+		return nil, fmt.Errorf("'%s' is being handed off to %s", session.GetName(), handoff.To)
+	}
+
+	session.Handoff = nil
+	return handoff, ss.repo.Save(session)
+}
@@ -0,0 +1,98 @@
+package session
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	"github.com/ttpreport/ligolo-mp/v2/internal/storage"
+	"github.com/ttpreport/ligolo-mp/v2/pkg/memstore"
+)
+
+func newTestService(t *testing.T) *SessionService {
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	repo, err := NewSessionRepository(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSessionService(nil, repo, nil, nil)
+}
+
+func newTestSession(t *testing.T, ss *SessionService, id string) *Session {
+	sess := &Session{
+		ID:          id,
+		Hostname:    id,
+		IsConnected: true,
+		Interfaces:  memstore.NewSyncslice[protocol.NetInterface](),
+		Redirectors: memstore.NewSyncmap[string, Redirector](),
+	}
+	if err := ss.SaveSession(sess); err != nil {
+		t.Fatal(err)
+	}
+
+	return sess
+}
+
+func TestHandoffMovesClaim(t *testing.T) {
+	ss := newTestService(t)
+
+	unclaimed := newTestSession(t, ss, "web")
+	if err := ss.RequestHandoff(unclaimed.ID, "alice", false, "bob", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ss.AnswerHandoff(unclaimed.ID, "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	if unclaimed.Claim != "bob" {
+		t.Fatalf("accepting a handoff should claim the session, got %q", unclaimed.Claim)
+	}
+
+	claimed := newTestSession(t, ss, "dc")
+	if _, err := ss.Claim(claimed.ID, "alice", false, false, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := ss.RequestHandoff(claimed.ID, "bob", false, "carol", "", ""); !errors.Is(err, ErrClaimed) {
+		t.Fatalf("only the claimant should hand a session off, got %v", err)
+	}
+	if err := ss.RequestHandoff(claimed.ID, "alice", false, "bob", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ss.AnswerHandoff(claimed.ID, "bob", true); err != nil {
+		t.Fatal(err)
+	}
+	if claimed.Claim != "bob" {
+		t.Fatalf("the claim should move to the recipient, got %q", claimed.Claim)
+	}
+}
+
+func TestHandoffAfterOverride(t *testing.T) {
+	ss := newTestService(t)
+
+	sess := newTestSession(t, ss, "dc")
+	if _, err := ss.Claim(sess.ID, "alice", false, false, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := ss.RequestHandoff(sess.ID, "alice", false, "bob", "", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ss.Claim(sess.ID, "carol", false, false, true); !errors.Is(err, ErrClaimed) {
+		t.Fatalf("only admins should override a claim, got %v", err)
+	}
+	if previous, err := ss.Claim(sess.ID, "dave", true, false, true); err != nil || previous != "alice" {
+		t.Fatalf("admins should override a claim, got %q, %v", previous, err)
+	}
+
+	if _, err := ss.AnswerHandoff(sess.ID, "bob", true); !errors.Is(err, ErrClaimed) {
+		t.Fatalf("a handoff shouldn't take a claim the sender lost, got %v", err)
+	}
+	if sess.Claim != "dave" {
+		t.Fatalf("the claim should stay with the admin, got %q", sess.Claim)
+	}
+}
@@ -0,0 +1,91 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+)
+
+func HostInfoProto(host *protocol.HostInfo) *pb.HostInfo {
+	if host == nil {
+		return nil
+	}
+
+	return &pb.HostInfo{
+		OS:         host.OS,
+		Username:   host.Username,
+		Privileged: host.Privileged,
+		Privileges: host.Privileges,
+		Domain:     host.Domain,
+		DNSServers: host.DNSServers,
+		Gateways:   host.Gateways,
+		Errors:     host.Errors,
+	}
+}
+
+func ProtoToHostInfo(p *pb.HostInfo) *protocol.HostInfo {
+	if p == nil {
+		return nil
+	}
+
+	return &protocol.HostInfo{
+		OS:         p.OS,
+		Username:   p.Username,
+		Privileged: p.Privileged,
+		Privileges: p.Privileges,
+		Domain:     p.Domain,
+		DNSServers: p.DNSServers,
+		Gateways:   p.Gateways,
+		Errors:     p.Errors,
+	}
+}
+
+// RefreshHostInfo asks the agent to collect host information again
+func (sess *Session) RefreshHostInfo(ctx context.Context) error {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageHostInfoRequest,
+		Payload: protocol.HostInfoRequestPacket{},
+	}); err != nil {
+		return stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.HostInfoResponsePacket)
+	if !ok {
+		return fmt.Errorf("agent does not support host information")
+	}
+	if response.Host == nil {
+		return fmt.Errorf("agent was built without host information collection")
+	}
+
+	sess.Host = response.Host
+	sess.HostRefreshed = time.Now()
+
+	return nil
+}
+
+// RefreshHostInfo updates the host information of the session from its agent
+func (ss *SessionService) RefreshHostInfo(ctx context.Context, sessID string) error {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return err
+	}
+
+	if err := sess.RefreshHostInfo(ctx); err != nil {
+		return err
+	}
+
+	return ss.repo.Save(sess)
+}
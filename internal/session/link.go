@@ -0,0 +1,129 @@
+package session
+
+import (
+	"io"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+const (
+	linkProbeSize    = 1 << 20
+	linkProbeTimeout = 5 * time.Second
+	linkPings        = 3
+
+	minStreamWindow = 256 * 1024 // yamux default
+	maxStreamWindow = 16 * 1024 * 1024
+
+	minKeepAlive = 30 * time.Second
+	maxKeepAlive = 2 * time.Minute
+)
+
+// Link holds what was measured on the agent connection and the multiplexer settings derived from it
+type Link struct {
+	RTT        time.Duration
+	Bandwidth  int64 // bytes per second, 0 if the agent couldn't be probed
+	WindowSize uint32
+	KeepAlive  time.Duration
+}
+
+// ProbeLink measures the agent connection before any session traffic. Stream windows are only ever read by yamux
+// on live streams, so settings derived from the probe can be applied to the config the multiplexer was created with
+func ProbeLink(multiplex *yamux.Session) Link {
+	var rtt time.Duration
+	for i := 0; i < linkPings; i++ {
+		sample, err := multiplex.Ping()
+		if err != nil {
+			break
+		}
+
+		if rtt == 0 || sample < rtt {
+			rtt = sample
+		}
+	}
+
+	bandwidth := probeBandwidth(multiplex, rtt)
+
+	return NewLink(rtt, bandwidth)
+}
+
+// probeBandwidth pulls a throughput sample in gob, the encoding isn't negotiated yet. Agents that don't
+// know the request close the stream and leave us with 0
+func probeBandwidth(multiplex *yamux.Session, rtt time.Duration) int64 {
+	stream, err := multiplex.Open()
+	if err != nil {
+		return 0
+	}
+	defer stream.Close()
+	stream.SetDeadline(time.Now().Add(linkProbeTimeout))
+
+	protocolEncoder := protocol.NewEncoder(stream)
+	protocolDecoder := protocol.NewDecoder(stream)
+
+	start := time.Now()
+	if err := protocolEncoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageThroughputRequest,
+		Payload: protocol.ThroughputRequestPacket{Size: linkProbeSize},
+	}); err != nil {
+		return 0
+	}
+
+	if err := protocolDecoder.Decode(); err != nil || protocolDecoder.Envelope.Type != protocol.MessageThroughputResponse {
+		return 0
+	}
+	response := protocolDecoder.Envelope.Payload.(protocol.ThroughputResponsePacket)
+
+	// a slow link still gives a usable sample when the deadline hits
+	received, _ := io.CopyN(io.Discard, stream, response.Size)
+	elapsed := time.Since(start) - rtt
+	if received == 0 || elapsed <= 0 {
+		return 0
+	}
+
+	return int64(float64(received) / elapsed.Seconds())
+}
+
+// NewLink sizes stream windows to twice the bandwidth-delay product and spaces keepalives with the latency
+func NewLink(rtt time.Duration, bandwidth int64) Link {
+	link := Link{
+		RTT:        rtt,
+		Bandwidth:  bandwidth,
+		WindowSize: minStreamWindow,
+		KeepAlive:  minKeepAlive,
+	}
+
+	if rtt <= 0 {
+		return link
+	}
+
+	bdp := float64(bandwidth) * rtt.Seconds()
+
+	// the probe itself is capped by the default window, when it hits that ceiling the link can do more than we saw
+	if bdp >= 0.8*minStreamWindow {
+		bdp *= 4
+	}
+
+	window := 2 * bdp
+	switch {
+	case window > maxStreamWindow:
+		link.WindowSize = maxStreamWindow
+	case window > minStreamWindow:
+		link.WindowSize = uint32(window)
+	}
+
+	keepAlive := 20 * rtt
+	switch {
+	case keepAlive > maxKeepAlive:
+		link.KeepAlive = maxKeepAlive
+	case keepAlive > minKeepAlive:
+		link.KeepAlive = keepAlive.Round(time.Second)
+	}
+
+	return link
+}
+
+// Apply updates the multiplexer config, only safe while no streams are open
+func (link Link) Apply(config *yamux.Config) {
+	config.MaxStreamWindowSize = link.WindowSize
+}
@@ -0,0 +1,34 @@
+package session
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewLink(t *testing.T) {
+	unprobed := NewLink(0, 0)
+	if unprobed.WindowSize != minStreamWindow || unprobed.KeepAlive != minKeepAlive {
+		t.Fatalf("unprobed links should keep the yamux defaults, got %+v", unprobed)
+	}
+
+	lan := NewLink(time.Millisecond, 10*1024*1024)
+	if lan.WindowSize != minStreamWindow {
+		t.Fatalf("low latency links don't need bigger windows, got %d", lan.WindowSize)
+	}
+
+	// 300ms at the ceiling of the default window, the probe couldn't see the real bandwidth
+	satellite := NewLink(300*time.Millisecond, minStreamWindow*10/3)
+	if satellite.WindowSize <= minStreamWindow || satellite.WindowSize > maxStreamWindow {
+		t.Fatalf("high latency links should get bigger windows, got %d", satellite.WindowSize)
+	}
+
+	congested := NewLink(3*time.Second, 0)
+	if congested.KeepAlive <= minKeepAlive || congested.KeepAlive > maxKeepAlive {
+		t.Fatalf("very high latency links should get sparser keepalives, got %s", congested.KeepAlive)
+	}
+
+	huge := NewLink(10*time.Second, 1<<30)
+	if huge.WindowSize != maxStreamWindow || huge.KeepAlive != maxKeepAlive {
+		t.Fatalf("settings should be capped, got %+v", huge)
+	}
+}
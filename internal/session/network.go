@@ -0,0 +1,63 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+	"github.com/ttpreport/ligolo-mp/v2/pkg/memstore"
+)
+
+// RefreshNetwork asks the agent for its interfaces, neighbors and routes as they are now. What the agent couldn't
+// collect is returned, the rest is still updated
+func (sess *Session) RefreshNetwork(ctx context.Context) ([]string, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageNetworkRequest,
+		Payload: protocol.NetworkRequestPacket{},
+	}); err != nil {
+		return nil, stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return nil, stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.NetworkResponsePacket)
+	if !ok {
+		return nil, fmt.Errorf("agent does not support network refresh")
+	}
+
+	interfaces := memstore.NewSyncslice[protocol.NetInterface]()
+	for _, iface := range response.Interfaces {
+		interfaces.Append(iface)
+	}
+
+	sess.Interfaces = interfaces
+	sess.Neighbors = response.Neighbors
+	sess.SystemRoutes = response.Routes
+	sess.NetworkRefreshed = time.Now()
+
+	return response.Errors, nil
+}
+
+// RefreshNetwork updates the network view of the session from its agent
+func (ss *SessionService) RefreshNetwork(ctx context.Context, sessID string) ([]string, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return nil, err
+	}
+
+	errs, err := sess.RefreshNetwork(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return errs, ss.repo.Save(sess)
+}
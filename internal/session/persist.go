@@ -0,0 +1,68 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// Persist has the agent install itself with one of the persistence methods it was built with, or remove it.
+// It returns what the agent created or removed
+func (sess *Session) Persist(ctx context.Context, method string, uninstall bool) (string, error) {
+	if !slices.Contains(sess.Persistence, method) {
+		return "", fmt.Errorf("agent of '%s' wasn't built with %s persistence", sess.GetName(), method)
+	}
+
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessagePersistRequest,
+		Payload: protocol.PersistRequestPacket{Method: method, Uninstall: uninstall},
+	}); err != nil {
+		return "", stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return "", stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.PersistResponsePacket)
+	if !ok {
+		return "", fmt.Errorf("agent does not support persistence")
+	}
+	if response.Err {
+		return "", errors.New(response.ErrString)
+	}
+
+	return response.Location, nil
+}
+
+// Persist installs or removes persistence on the target and keeps track of what's left there, so it can be cleaned
+// up before the engagement ends
+func (ss *SessionService) Persist(ctx context.Context, sessID string, method string, uninstall bool) (string, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return "", err
+	}
+
+	location, err := sess.Persist(ctx, method, uninstall)
+	if err != nil {
+		return "", err
+	}
+
+	sess.Persisted = slices.DeleteFunc(sess.Persisted, func(installed string) bool {
+		return installed == method
+	})
+	if !uninstall {
+		sess.Persisted = append(sess.Persisted, method)
+	}
+
+	return location, ss.repo.Save(sess)
+}
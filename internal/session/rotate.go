@@ -0,0 +1,91 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// rotationVersion is the first session protocol version agents take a new certificate with
+const rotationVersion = uint16(4)
+
+// PushCertificate hands the agent a new certificate and key, it connects back with them once it answers
+func (sess *Session) PushCertificate(ctx context.Context, cert []byte, key []byte) error {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageCertificateRequest,
+		Payload: protocol.CertificateRequestPacket{Certificate: cert, Key: key},
+	}); err != nil {
+		return stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.CertificateResponsePacket)
+	if !ok {
+		return fmt.Errorf("agent does not support certificate rotation")
+	}
+	if response.Err {
+		return errors.New(response.ErrString)
+	}
+
+	return nil
+}
+
+// RotateCertificate makes the agent of a session connect with cert and key instead and waits until it's back with
+// them. It returns the session and the DER of the certificate the agent connected with before, which is left for
+// the caller to revoke
+func (ss *SessionService) RotateCertificate(ctx context.Context, sessID string, cert []byte, key []byte, operator string) (*Session, []byte, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if sess.Protocol < rotationVersion {
+		return nil, nil, fmt.Errorf("agent of '%s' predates certificate rotation, update it first", sess.GetName())
+	}
+
+	block, _ := pem.Decode(cert)
+	if block == nil {
+		return nil, nil, errors.New("invalid certificate")
+	}
+
+	previous := sess.Certificate
+	sess.Drop(DisconnectRotated, fmt.Sprintf("rotated by %s", operator))
+	if err := sess.PushCertificate(ctx, cert, key); err != nil {
+		if sess.IsConnected {
+			sess.Disconnection = Disconnection{}
+		}
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateReconnectTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("agent of '%s' took the certificate but hasn't connected back with it yet", sess.GetName())
+		case <-ticker.C:
+			rotated := ss.repo.GetOne(sessID)
+			if rotated != nil && rotated.IsConnected && bytes.Equal(rotated.Certificate, block.Bytes) {
+				return rotated, previous, nil
+			}
+		}
+	}
+}
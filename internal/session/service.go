@@ -5,35 +5,58 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/yamux"
+	"github.com/ttpreport/ligolo-mp/v2/internal/anomaly"
 	"github.com/ttpreport/ligolo-mp/v2/internal/config"
+	"github.com/ttpreport/ligolo-mp/v2/internal/engagement"
+	"github.com/ttpreport/ligolo-mp/v2/internal/events"
+	"github.com/ttpreport/ligolo-mp/v2/internal/flowlog"
+	"github.com/ttpreport/ligolo-mp/v2/internal/netstack"
 	"github.com/ttpreport/ligolo-mp/v2/internal/netstack/tunlink"
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
 	"github.com/ttpreport/ligolo-mp/v2/internal/route"
 )
 
 type SessionService struct {
-	repo   *SessionRepository
-	config *config.Config
+	repo        *SessionRepository
+	config      *config.Config
+	engagements *engagement.EngagementService
+	flows       *flowlog.Writer // nil if the flow log is disabled
+
+	detectorsMu sync.Mutex
+	detectors   map[string]*anomaly.Detector // per session, kept across relay restarts
+
+	netstackMu sync.Mutex
+	netstack   *netstack.NetStack // shared by all relays, created with the first one
+
+	bridged func(conn net.Conn, via string) // takes agents linking through a bridge, see HandleBridged
 }
 
-func NewSessionService(config *config.Config, repo *SessionRepository) *SessionService {
+func NewSessionService(config *config.Config, repo *SessionRepository, engagements *engagement.EngagementService, flows *flowlog.Writer) *SessionService {
 	return &SessionService{
-		repo:   repo,
-		config: config,
+		repo:        repo,
+		config:      config,
+		engagements: engagements,
+		flows:       flows,
+		detectors:   make(map[string]*anomaly.Detector),
 	}
 }
 
-func (ss *SessionService) NewSession(multiplex *yamux.Session) (*Session, error) {
+func (ss *SessionService) NewSession(multiplex *yamux.Session, link Link) (*Session, error) {
 	session, err := new()
 	if err != nil {
 		return nil, err
 	}
+	session.Link = link
+	session.Engagement = ss.engagements.CurrentName() // a restored session keeps its own
 
 	if err := session.Connect(multiplex); err != nil {
 		return nil, err
 	}
+	reported := append([]Crash(nil), session.Crashes...) // the agent reports the crashes that dropped it last time when it connects again
 
 	savedSession := ss.repo.GetOne(session.ID)
 	if savedSession != nil {
@@ -52,8 +75,16 @@ func (ss *SessionService) NewSession(multiplex *yamux.Session) (*Session, error)
 
 		session.Copy(savedSession)
 
-		if savedSession.IsRelaying {
-			if err := ss.StartRelay(session.ID); err != nil {
+		if savedSession.Egress != "" && savedSession.Egress != session.Egress {
+			slog.Info("agent egress address changed", slog.String("session", session.GetName()), slog.String("from", savedSession.Egress), slog.String("to", session.Egress))
+			session.PreviousEgress = savedSession.Egress
+			session.EgressChanged = time.Now()
+		}
+
+		if savedSession.IsRelaying && session.Beacon.Asleep() {
+			session.IsRelaying = true // the tun only comes up once the agent is woken up
+		} else if savedSession.IsRelaying {
+			if err := ss.StartRelay(session.ID, session.RelayedBy); err != nil {
 				slog.Error("could not start relay", slog.Any("error", err))
 			}
 		}
@@ -61,6 +92,8 @@ func (ss *SessionService) NewSession(multiplex *yamux.Session) (*Session, error)
 		slog.Debug("session restored")
 	}
 
+	publishCrashes(session, reported)
+
 	return session, ss.repo.Save(session)
 }
 
@@ -72,12 +105,18 @@ func (ss *SessionService) SaveSession(sess *Session) error {
 	return ss.repo.Save(sess)
 }
 
-func (ss *SessionService) DisconnectSession(sessID string) error {
+// DisconnectSession cleans up after a dropped session, the reason only counts if nobody gave one before
+func (ss *SessionService) DisconnectSession(sessID string, reason DisconnectReason, note string) error {
 	sess := ss.GetSession(sessID)
 	if sess == nil {
 		return fmt.Errorf("session '%s' does not exist", sessID)
 	}
 
+	sess.Drop(reason, note)
+	if sess.Disconnection.At.IsZero() {
+		sess.Disconnection.At = time.Now()
+	}
+
 	if err := sess.Disconnect(); err != nil {
 		slog.Warn("session disconnect encountered an error", slog.Any("error", err))
 	}
@@ -95,9 +134,29 @@ func (ss *SessionService) KillSession(sessID string) (*Session, error) {
 
 	sess.Disconnect()
 
+	ss.detectorsMu.Lock()
+	delete(ss.detectors, sess.ID)
+	ss.detectorsMu.Unlock()
+
 	return sess, ss.repo.Remove(sess)
 }
 
+// KillAgent makes the agent exit but keeps the session, with the operator noted as the reason it dropped
+func (ss *SessionService) KillAgent(sessID string, operator string) (*Session, error) {
+	sess := ss.GetSession(sessID)
+	if sess == nil {
+		return nil, fmt.Errorf("session '%s' does not exist", sessID)
+	}
+
+	if !sess.IsConnected {
+		return nil, fmt.Errorf("session '%s' is not connected", sessID)
+	}
+
+	sess.Drop(DisconnectKilled, fmt.Sprintf("killed by %s", operator))
+
+	return sess, sess.Disconnect() // the session monitor records the disconnect once the agent is gone
+}
+
 func (ss *SessionService) RenameSession(id string, alias string) error {
 	session := ss.repo.GetOne(id)
 	if session == nil {
@@ -107,7 +166,7 @@ func (ss *SessionService) RenameSession(id string, alias string) error {
 	return ss.repo.Save(session)
 }
 
-func (ss *SessionService) NewRoute(sessionID string, cidr string, metric int, isLoopback bool) error {
+func (ss *SessionService) NewRoute(sessionID string, cidr string, metric int, isLoopback bool, ports string, isExclusion bool) error {
 	slog.Debug("adding new route to session")
 
 	session := ss.repo.GetOne(sessionID)
@@ -116,7 +175,11 @@ func (ss *SessionService) NewRoute(sessionID string, cidr string, metric int, is
 	}
 	slog.Debug("found session in storage", slog.Any("session", session))
 
-	err := session.NewRoute(cidr, metric, isLoopback)
+	if err := ss.engagements.CheckRoute(session.Engagement, cidr, isLoopback, isExclusion); err != nil {
+		return err
+	}
+
+	err := session.NewRoute(cidr, metric, isLoopback, ports, isExclusion)
 	if err != nil {
 		return err
 	}
@@ -140,7 +203,7 @@ func (ss *SessionService) RemoveRoute(sessionID string, routeID string) (*route.
 	return route, ss.repo.Save(session)
 }
 
-func (ss *SessionService) StartRelay(sessID string) error {
+func (ss *SessionService) StartRelay(sessID string, operator string) error {
 	slog.Debug("activating relay")
 	session := ss.repo.GetOne(sessID)
 	if session == nil {
@@ -148,7 +211,152 @@ func (ss *SessionService) StartRelay(sessID string) error {
 	}
 	slog.Debug("got session from storage", slog.Any("session", session))
 
-	if err := session.StartRelay(ss.config.MaxConnectionHandler, ss.config.MaxInFlight); err != nil {
+	if ss.config.TunError != "" {
+		return fmt.Errorf("relays are unavailable on this server: %s", ss.config.TunError)
+	}
+
+	if !session.IsRelaying {
+		session.RelayedBy = operator
+	}
+	session.Tun.SetFlowFunc(ss.flowFunc(session))
+
+	ns, err := ss.getNetstack()
+	if err != nil {
+		return err
+	}
+
+	if err := session.StartRelay(ns, ss.config.ManageRoutes, ss.config.Simulate); err != nil {
+		return err
+	}
+
+	return ss.repo.Save(session)
+}
+
+// flowFunc writes the flows relayed for the session to the flow log and watches them for anomalies
+func (ss *SessionService) flowFunc(session *Session) func(netstack.Flow) {
+	detector := ss.detector(session.ID)
+	if ss.flows == nil && detector == nil {
+		return nil
+	}
+
+	return func(flow netstack.Flow) {
+		if ss.flows != nil {
+			ss.logFlow(session, flow)
+		}
+
+		if detector != nil {
+			for _, alert := range detector.Observe(flow.Start.Add(flow.Duration), flow.Protocol, flow.Destination.Port(), flow.Sent+flow.Received) {
+				slog.Warn("traffic anomaly", slog.String("session", session.GetName()), slog.String("anomaly", alert))
+				events.Publish(events.WARNING, "'%s' %s", session.GetName(), alert)
+			}
+		}
+	}
+}
+
+// detector returns the anomaly detector of a session, nil if anomaly alerts are disabled
+func (ss *SessionService) detector(sessID string) *anomaly.Detector {
+	if !ss.config.AnomalyAlerts {
+		return nil
+	}
+
+	ss.detectorsMu.Lock()
+	defer ss.detectorsMu.Unlock()
+
+	detector, ok := ss.detectors[sessID]
+	if !ok {
+		detector = anomaly.NewDetector(anomaly.Options{
+			Window:   ss.config.AnomalyWindow,
+			Spike:    ss.config.AnomalySpike,
+			MinBytes: ss.config.AnomalyMinBytes,
+		})
+		ss.detectors[sessID] = detector
+	}
+
+	return detector
+}
+
+// logFlow writes a flow relayed for the session to the flow log
+func (ss *SessionService) logFlow(session *Session, flow netstack.Flow) {
+	err := ss.flows.Write(flowlog.Record{
+		Time:        flow.Start.Add(flow.Duration),
+		Engagement:  session.Engagement,
+		Session:     session.ID,
+		SessionName: session.GetName(),
+		Operator:    session.RelayedBy,
+		Protocol:    flow.Protocol,
+		SrcAddr:     flow.Source.Addr().String(),
+		SrcPort:     flow.Source.Port(),
+		DstAddr:     flow.Destination.Addr().String(),
+		DstPort:     flow.Destination.Port(),
+		BytesOut:    flow.Sent,
+		BytesIn:     flow.Received,
+		DurationMs:  flow.Duration.Milliseconds(),
+	})
+	if err != nil {
+		slog.Error("could not write to the flow log", slog.Any("error", err))
+	}
+}
+
+func (ss *SessionService) Throughput(sessID string, size int64) (int64, time.Duration, error) {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return 0, 0, fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	return session.Throughput(size)
+}
+
+func (ss *SessionService) SetSpoofSource(sessID string, enabled bool) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	session.Tun.SpoofSource = enabled
+
+	return ss.repo.Save(session)
+}
+
+// SetCompression turns compression of relayed connections "on" or "off" for a session, or overrides it for one of its
+// routes, an empty override has the route follow the session
+func (ss *SessionService) SetCompression(sessID string, routeID string, compression string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if err := route.ValidateCompression(compression); err != nil {
+		return err
+	}
+
+	if compression == route.CompressionOn && session.Compression == protocol.CompressionNone && session.IsConnected {
+		return fmt.Errorf("agent can't compress relayed connections, rebuild it")
+	}
+
+	if routeID == "" {
+		if compression == route.CompressionDefault {
+			return fmt.Errorf("compression must be '%s' or '%s' for a session", route.CompressionOn, route.CompressionOff)
+		}
+		session.Tun.Compress = compression == route.CompressionOn
+		return ss.repo.Save(session)
+	}
+
+	r := session.Tun.Routes.Get(routeID)
+	if r == nil {
+		return fmt.Errorf("route '%s' not found", routeID)
+	}
+	r.Compression = compression
+
+	return ss.repo.Save(session)
+}
+
+func (ss *SessionService) SetMirror(sessID string, target string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if err := session.Tun.SetMirror(target); err != nil {
 		return err
 	}
 
@@ -168,13 +376,46 @@ func (ss *SessionService) StopRelay(sessID string) error {
 	return ss.repo.Save(session)
 }
 
-func (ss *SessionService) NewRedirector(sessID string, proto string, from string, to string) error {
+// NewRedirector listens on the agent of the session. With via set, connections are handed to the server, which
+// reaches the destination through the via session, so listeners can be composed across pivots. A bridge has neither
+// destination nor via, its connections are agents without egress linking to the server through the session
+func (ss *SessionService) NewRedirector(sessID string, proto string, from string, to string, via string, bridge bool) error {
 	session := ss.repo.GetOne(sessID)
 	if session == nil {
 		return fmt.Errorf("session '%s' not found", sessID)
 	}
 
-	if err := session.NewRedirector(proto, from, to); err != nil {
+	if bridge {
+		if to != "" || via != "" {
+			return fmt.Errorf("bridges take agents to the server, they have no destination")
+		}
+
+		if proto != "tcp" && proto != "pipe" {
+			return fmt.Errorf("bridges listen on tcp or on a named pipe")
+		}
+	} else if proto == "pipe" {
+		return fmt.Errorf("only bridges can listen on a named pipe")
+	}
+
+	if via != "" {
+		if via == sessID {
+			return fmt.Errorf("redirector can't forward through its own session")
+		}
+
+		if ss.repo.GetOne(via) == nil {
+			return fmt.Errorf("session '%s' not found", via)
+		}
+
+		if proto != "tcp" {
+			return fmt.Errorf("only tcp redirectors can forward through another session")
+		}
+
+		if _, _, err := net.SplitHostPort(to); err != nil {
+			return fmt.Errorf("%s is invalid destination: %s", to, err)
+		}
+	}
+
+	if err := session.NewRedirector(proto, from, to, via, bridge); err != nil {
 		return err
 	}
 
@@ -207,6 +448,41 @@ func (ss *SessionService) UpdateLastSeen(sessID string) error {
 	return ss.repo.Save(session)
 }
 
+func (ss *SessionService) UpdateRTT(sessID string, rtt time.Duration) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	session.Link.RTT = rtt
+
+	return ss.repo.Save(session)
+}
+
+// SetListener records the listener an agent connected on
+func (ss *SessionService) SetListener(sessID string, name string) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	session.Listener = name
+
+	return ss.repo.Save(session)
+}
+
+// SetCertificate records the certificate the agent connected with, so that operators can revoke it
+func (ss *SessionService) SetCertificate(sessID string, cert []byte) error {
+	session := ss.repo.GetOne(sessID)
+	if session == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	session.Certificate = cert
+
+	return ss.repo.Save(session)
+}
+
 func (ss *SessionService) GetAll() ([]*Session, error) {
 	return ss.repo.GetAll()
 }
@@ -286,6 +562,31 @@ func (ss *SessionService) Traceroute(address string) ([]route.Trace, error) {
 	return result, nil
 }
 
+// getNetstack returns the netstack relays attach to, sessions each get their own NIC in it
+func (ss *SessionService) getNetstack() (*netstack.NetStack, error) {
+	ss.netstackMu.Lock()
+	defer ss.netstackMu.Unlock()
+
+	if ss.netstack == nil {
+		ns, err := netstack.NewNetstack(ss.config.MaxConnectionHandler, ss.config.MaxInFlight, ss.tcpOptions())
+		if err != nil {
+			return nil, err
+		}
+		slog.Debug("netstack created")
+		ss.netstack = ns
+	}
+
+	return ss.netstack, nil
+}
+
+func (ss *SessionService) tcpOptions() netstack.TCPOptions {
+	return netstack.TCPOptions{
+		SACK:          ss.config.TCPSACK,
+		WindowScaling: ss.config.TCPWindowScaling,
+		AutoTuning:    ss.config.TCPAutoTuning,
+	}
+}
+
 func (ss *SessionService) Init() error {
 	return nil
 }
@@ -297,6 +598,10 @@ func (ss *SessionService) CleanUp() error {
 	}
 
 	for _, session := range sessions {
+		if session.IsConnected { // the server went down without releasing it
+			session.Drop(DisconnectServer, "")
+			session.Disconnection.At = session.LastSeen
+		}
 		session.Disconnect()
 		session.CleanUp()
 		ss.repo.Save(session)
@@ -304,3 +609,25 @@ func (ss *SessionService) CleanUp() error {
 
 	return nil
 }
+
+// Release stops every session without asking agents to exit, they keep retrying and reconnect once the
+// server is back
+func (ss *SessionService) Release() error {
+	sessions, err := ss.repo.GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		session.CleanUp()
+		if session.IsMultiplexOpen() {
+			session.Drop(DisconnectServer, "")
+			session.Disconnection.At = time.Now()
+			session.Multiplex.Close()
+		}
+		session.IsConnected = false
+		ss.repo.Save(session)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// Socks is the SOCKS5 service the agent runs on the target, for tooling there to use the pivot directly. What
+// was asked for is kept, so the service comes back when a restarted agent reconnects
+type Socks struct {
+	Address   string // where the service was asked to listen, empty if it's off
+	Username  string // empty means no authentication
+	Password  string
+	Listening string // address the agent reports listening on, empty if the service isn't running
+}
+
+func (s Socks) Enabled() bool {
+	return s.Address != ""
+}
+
+// SetSocks starts the SOCKS5 service on the agent, replacing the running one, an empty address stops it
+func (sess *Session) SetSocks(address string, username string, password string) error {
+	listening, err := sess.remoteSocks(address, username, password)
+	if err != nil {
+		return err
+	}
+
+	sess.Socks = Socks{
+		Address:   address,
+		Username:  username,
+		Password:  password,
+		Listening: listening,
+	}
+
+	return nil
+}
+
+func (sess *Session) remoteSocks(address string, username string, password string) (string, error) {
+	if !sess.IsMultiplexOpen() {
+		return "", fmt.Errorf("multiplex is disconnected")
+	}
+
+	stream, err := sess.Multiplex.Open()
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+
+	protocolEncoder := protocol.NewEncoder(stream)
+	protocolEncoder.SetEncoding(sess.Encoding)
+	protocolDecoder := protocol.NewDecoder(stream)
+
+	if err := protocolEncoder.Encode(protocol.Envelope{
+		Type: protocol.MessageSocksRequest,
+		Payload: protocol.SocksRequestPacket{
+			Address:  address,
+			Username: username,
+			Password: password,
+		},
+	}); err != nil {
+		return "", err
+	}
+
+	if err := protocolDecoder.Decode(); err != nil {
+		return "", err
+	}
+
+	response, ok := protocolDecoder.Envelope.Payload.(protocol.SocksResponsePacket)
+	if !ok {
+		return "", fmt.Errorf("agent does not support the SOCKS5 service")
+	}
+	if response.Err {
+		return "", errors.New(response.ErrString)
+	}
+
+	return response.Address, nil
+}
+
+// SetSocks starts, replaces or, with an empty address, stops the SOCKS5 service of a connected agent
+func (ss *SessionService) SetSocks(sessID string, address string, username string, password string) error {
+	sess := ss.repo.GetOne(sessID)
+	if sess == nil {
+		return fmt.Errorf("session '%s' not found", sessID)
+	}
+
+	if !sess.IsConnected {
+		return fmt.Errorf("session '%s' is not connected", sessID)
+	}
+
+	if username == "" && password != "" {
+		return fmt.Errorf("a password needs a username")
+	}
+
+	if len(username) > 255 || len(password) > 255 {
+		return fmt.Errorf("SOCKS5 credentials can't be longer than 255 bytes")
+	}
+
+	if err := sess.SetSocks(address, username, password); err != nil {
+		return err
+	}
+
+	return ss.repo.Save(sess)
+}
@@ -0,0 +1,120 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// ParseSweepTargets reads addresses and networks separated by commas or spaces
+func ParseSweepTargets(text string) ([]string, error) {
+	targets := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n'
+	})
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("nothing to sweep")
+	}
+
+	for _, target := range targets {
+		if net.ParseIP(target) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(target); err != nil {
+			return nil, fmt.Errorf("%s is neither an address nor a network", target)
+		}
+	}
+
+	return targets, nil
+}
+
+// ParsePorts reads TCP ports separated by commas, with ranges, e.g. "22,80,8000-8010"
+func ParsePorts(text string) ([]uint16, error) {
+	var ports []uint16
+	for _, field := range strings.Split(text, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(field, "-")
+		first, err := strconv.ParseUint(strings.TrimSpace(from), 10, 16)
+		if err != nil || first == 0 {
+			return nil, fmt.Errorf("%s is invalid port", field)
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.ParseUint(strings.TrimSpace(to), 10, 16); err != nil || last < first {
+				return nil, fmt.Errorf("%s is invalid port range", field)
+			}
+		}
+
+		for port := first; port <= last; port++ {
+			ports = append(ports, uint16(port))
+		}
+	}
+
+	return ports, nil
+}
+
+// Sweep has the agent look for live hosts on its own network, so a simple alive check doesn't push a relayed
+// connection through the tunnel per host and port. Live hosts are passed to result as the agent finds them,
+// cancelling the context stops the sweep
+func (sess *Session) Sweep(ctx context.Context, request protocol.SweepRequestPacket, result func(protocol.SweepResultPacket) error) (protocol.SweepDonePacket, error) {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return protocol.SweepDonePacket{}, err
+	}
+	defer stream.Close()
+
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageSweepRequest,
+		Payload: request,
+	}); err != nil {
+		return protocol.SweepDonePacket{}, stream.err(err)
+	}
+
+	for {
+		if err := stream.decoder.Decode(); err != nil {
+			return protocol.SweepDonePacket{}, stream.err(err)
+		}
+
+		switch payload := stream.decoder.Envelope.Payload.(type) {
+		case protocol.SweepResultPacket:
+			if err := result(payload); err != nil {
+				return protocol.SweepDonePacket{}, err
+			}
+		case protocol.SweepDonePacket:
+			if payload.Err {
+				return payload, errors.New(payload.ErrString)
+			}
+
+			return payload, nil
+		default:
+			return protocol.SweepDonePacket{}, fmt.Errorf("agent does not support sweeps")
+		}
+	}
+}
+
+// Sweep looks for live hosts from a connected agent
+func (ss *SessionService) Sweep(ctx context.Context, sessID string, targets []string, ports []uint16, timeout time.Duration, result func(protocol.SweepResultPacket) error) (protocol.SweepDonePacket, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return protocol.SweepDonePacket{}, err
+	}
+
+	if len(targets) == 0 {
+		return protocol.SweepDonePacket{}, fmt.Errorf("nothing to sweep")
+	}
+
+	return sess.Sweep(ctx, protocol.SweepRequestPacket{
+		Targets: targets,
+		Ports:   ports,
+		Timeout: int64(timeout),
+	}, result)
+}
@@ -0,0 +1,94 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+// updateReconnectTimeout is how long an updated agent gets to connect back
+const updateReconnectTimeout = 2 * time.Minute
+
+// PushUpdate sends a new executable to the agent, which restarts from it once it's in place
+func (sess *Session) PushUpdate(ctx context.Context, binary []byte) error {
+	stream, err := sess.openRemoteStream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	hash := sha256.Sum256(binary)
+	if err := stream.encoder.Encode(protocol.Envelope{
+		Type:    protocol.MessageUpdateRequest,
+		Payload: protocol.UpdateRequestPacket{Size: int64(len(binary)), Sha256: hex.EncodeToString(hash[:])},
+	}); err != nil {
+		return stream.err(err)
+	}
+
+	if _, err := bytes.NewReader(binary).WriteTo(stream); err != nil {
+		return stream.err(err)
+	}
+
+	if err := stream.decoder.Decode(); err != nil {
+		return stream.err(err)
+	}
+
+	response, ok := stream.decoder.Envelope.Payload.(protocol.UpdateResponsePacket)
+	if !ok {
+		return fmt.Errorf("agent does not support updates")
+	}
+	if response.Err {
+		return errors.New(response.ErrString)
+	}
+
+	return nil
+}
+
+// UpdateAgent replaces the agent of a session with binary and waits until the agent is connected again running
+// it, version is the SHA-256 the new agent will report
+func (ss *SessionService) UpdateAgent(ctx context.Context, sessID string, binary []byte, version string, operator string) (*Session, error) {
+	sess, err := ss.connectedSession(sessID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sess.Version == "" {
+		return nil, fmt.Errorf("agent of '%s' doesn't run as an executable it can replace", sess.GetName())
+	}
+
+	if sess.Version == version {
+		return nil, fmt.Errorf("agent of '%s' already runs this build", sess.GetName())
+	}
+
+	sess.Drop(DisconnectUpdate, fmt.Sprintf("updated by %s", operator))
+	if err := sess.PushUpdate(ctx, binary); err != nil {
+		if sess.IsConnected {
+			sess.Disconnection = Disconnection{}
+		}
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateReconnectTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("agent of '%s' took the update but hasn't connected back with it yet", sess.GetName())
+		case <-ticker.C:
+			updated := ss.repo.GetOne(sessID)
+			if updated != nil && updated.IsConnected && updated.Version == version {
+				return updated, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package snapshot
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// HostDiff lists what changed on a host present in both exports
+type HostDiff struct {
+	Host               string
+	AddedAddresses     []string
+	RemovedAddresses   []string
+	AddedRoutes        []string
+	RemovedRoutes      []string
+	AddedRedirectors   []string
+	RemovedRedirectors []string
+}
+
+func (diff *HostDiff) empty() bool {
+	return len(diff.AddedAddresses)+len(diff.RemovedAddresses)+len(diff.AddedRoutes)+len(diff.RemovedRoutes)+
+		len(diff.AddedRedirectors)+len(diff.RemovedRedirectors) == 0
+}
+
+// Diff is the difference between two exports, hosts are matched by hostname
+type Diff struct {
+	Before       *Snapshot
+	After        *Snapshot
+	AddedHosts   []*SessionState
+	RemovedHosts []*SessionState
+	Changed      []*HostDiff
+}
+
+func Compare(before *Snapshot, after *Snapshot) *Diff {
+	diff := &Diff{
+		Before: before,
+		After:  after,
+	}
+
+	oldHosts := hostsByKey(before)
+	newHosts := hostsByKey(after)
+
+	for _, state := range before.Sessions {
+		if _, ok := newHosts[state.key()]; !ok {
+			diff.RemovedHosts = append(diff.RemovedHosts, state)
+		}
+	}
+
+	for _, state := range after.Sessions {
+		previous, ok := oldHosts[state.key()]
+		if !ok {
+			diff.AddedHosts = append(diff.AddedHosts, state)
+			continue
+		}
+
+		hostDiff := &HostDiff{Host: state.key()}
+		hostDiff.AddedAddresses, hostDiff.RemovedAddresses = compareSets(previous.Addresses, state.Addresses)
+		hostDiff.AddedRoutes, hostDiff.RemovedRoutes = compareSets(previous.Routes, state.Routes)
+		hostDiff.AddedRedirectors, hostDiff.RemovedRedirectors = compareSets(previous.Redirectors, state.Redirectors)
+		if !hostDiff.empty() {
+			diff.Changed = append(diff.Changed, hostDiff)
+		}
+	}
+
+	return diff
+}
+
+// hostsByKey keeps the first session per host, several sessions on one host share their view of it
+func hostsByKey(snap *Snapshot) map[string]*SessionState {
+	result := make(map[string]*SessionState)
+	for _, state := range snap.Sessions {
+		if _, ok := result[state.key()]; !ok {
+			result[state.key()] = state
+		}
+	}
+	return result
+}
+
+func compareSets(before []string, after []string) (added []string, removed []string) {
+	for _, item := range after {
+		if !slices.Contains(before, item) {
+			added = append(added, item)
+		}
+	}
+
+	for _, item := range before {
+		if !slices.Contains(after, item) {
+			removed = append(removed, item)
+		}
+	}
+
+	return added, removed
+}
+
+func (diff *Diff) Empty() bool {
+	return len(diff.AddedHosts)+len(diff.RemovedHosts)+len(diff.Changed) == 0
+}
+
+func (diff *Diff) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Comparing export from %s with %s\n", diff.Before.Created.Format("2006-01-02 15:04"), diff.After.Created.Format("2006-01-02 15:04"))
+
+	if diff.Empty() {
+		sb.WriteString("\nNo changes\n")
+		return sb.String()
+	}
+
+	for _, state := range diff.AddedHosts {
+		fmt.Fprintf(&sb, "\n+ %s\n", state.key())
+		writeItems(&sb, "+", "address", state.Addresses)
+		writeItems(&sb, "+", "route", state.Routes)
+		writeItems(&sb, "+", "redirector", state.Redirectors)
+	}
+
+	for _, state := range diff.RemovedHosts {
+		fmt.Fprintf(&sb, "\n- %s\n", state.key())
+	}
+
+	for _, host := range diff.Changed {
+		fmt.Fprintf(&sb, "\n~ %s\n", host.Host)
+		writeItems(&sb, "+", "address", host.AddedAddresses)
+		writeItems(&sb, "-", "address", host.RemovedAddresses)
+		writeItems(&sb, "+", "route", host.AddedRoutes)
+		writeItems(&sb, "-", "route", host.RemovedRoutes)
+		writeItems(&sb, "+", "redirector", host.AddedRedirectors)
+		writeItems(&sb, "-", "redirector", host.RemovedRedirectors)
+	}
+
+	return sb.String()
+}
+
+func writeItems(sb *strings.Builder, sign string, kind string, items []string) {
+	for _, item := range items {
+		fmt.Fprintf(sb, "    %s %s %s\n", sign, kind, item)
+	}
+}
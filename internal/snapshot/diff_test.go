@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	before := &Snapshot{
+		Version: Version,
+		Sessions: []*SessionState{
+			{Hostname: "dc01", Addresses: []string{"10.0.0.10/24"}, Routes: []string{"10.0.0.0/24"}},
+			{Hostname: "web01", Addresses: []string{"10.0.1.5/24"}},
+			{Hostname: "old01"},
+		},
+	}
+	after := &Snapshot{
+		Version: Version,
+		Sessions: []*SessionState{
+			{Hostname: "dc01", Addresses: []string{"10.0.0.10/24", "172.16.0.10/16"}, Routes: []string{"172.16.0.0/16"}},
+			{Hostname: "web01", Addresses: []string{"10.0.1.5/24"}},
+			{Hostname: "new01"},
+		},
+	}
+
+	diff := Compare(before, after)
+
+	if len(diff.AddedHosts) != 1 || diff.AddedHosts[0].Hostname != "new01" {
+		t.Errorf("expected new01 to be added, got %v", diff.AddedHosts)
+	}
+
+	if len(diff.RemovedHosts) != 1 || diff.RemovedHosts[0].Hostname != "old01" {
+		t.Errorf("expected old01 to be removed, got %v", diff.RemovedHosts)
+	}
+
+	if len(diff.Changed) != 1 {
+		t.Fatalf("expected only dc01 to change, got %d hosts", len(diff.Changed))
+	}
+
+	dc := diff.Changed[0]
+	if dc.Host != "dc01" ||
+		!slices.Equal(dc.AddedAddresses, []string{"172.16.0.10/16"}) || dc.RemovedAddresses != nil ||
+		!slices.Equal(dc.AddedRoutes, []string{"172.16.0.0/16"}) || !slices.Equal(dc.RemovedRoutes, []string{"10.0.0.0/24"}) {
+		t.Errorf("unexpected dc01 changes: %+v", dc)
+	}
+
+	if Compare(after, after).Empty() != true {
+		t.Error("an export compared with itself should have no changes")
+	}
+}
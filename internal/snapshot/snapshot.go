@@ -0,0 +1,129 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/session"
+)
+
+const Version = 1
+
+// Snapshot is the state of an engagement as seen by an operator, kept independent from session internals so
+// exports stay comparable across releases
+type Snapshot struct {
+	Version  int
+	Created  time.Time
+	Sessions []*SessionState
+}
+
+// SessionState is what a session looked like, agents are rebuilt between engagements so hosts are matched
+// by hostname rather than session ID
+type SessionState struct {
+	Name        string
+	Hostname    string
+	Addresses   []string // CIDR notation, all interfaces
+	Routes      []string
+	Redirectors []string
+}
+
+func New(sessions []*session.Session) *Snapshot {
+	snap := &Snapshot{
+		Version: Version,
+		Created: time.Now(),
+	}
+
+	for _, sess := range sessions {
+		state := &SessionState{
+			Name:     sess.GetName(),
+			Hostname: sess.Hostname,
+		}
+
+		if sess.Interfaces != nil {
+			for _, iface := range sess.Interfaces.All() {
+				state.Addresses = append(state.Addresses, iface.Addresses...)
+			}
+		}
+
+		if sess.Tun != nil && sess.Tun.Routes != nil {
+			for _, r := range sess.Tun.Routes.All() {
+				state.Routes = append(state.Routes, routeString(r.Cidr.String(), r.Ports, r.IsLoopback, r.IsExclusion))
+			}
+		}
+
+		if sess.Redirectors != nil {
+			for _, redir := range sess.Redirectors.All() {
+				state.Redirectors = append(state.Redirectors, fmt.Sprintf("%s %s -> %s", redir.Protocol, redir.From, redir.To))
+			}
+		}
+
+		sort.Strings(state.Addresses)
+		sort.Strings(state.Routes)
+		sort.Strings(state.Redirectors)
+		snap.Sessions = append(snap.Sessions, state)
+	}
+
+	sort.Slice(snap.Sessions, func(i, j int) bool {
+		return snap.Sessions[i].key() < snap.Sessions[j].key()
+	})
+
+	return snap
+}
+
+func routeString(cidr string, ports string, isLoopback bool, isExclusion bool) string {
+	var attrs []string
+	if ports != "" {
+		attrs = append(attrs, "ports "+ports)
+	}
+	if isLoopback {
+		attrs = append(attrs, "loopback")
+	}
+	if isExclusion {
+		attrs = append(attrs, "exclusion")
+	}
+
+	if len(attrs) == 0 {
+		return cidr
+	}
+
+	return fmt.Sprintf("%s (%s)", cidr, strings.Join(attrs, ", "))
+}
+
+func (state *SessionState) key() string {
+	if state.Hostname != "" {
+		return state.Hostname
+	}
+
+	return state.Name
+}
+
+func Load(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("%s is not a state export: %w", path, err)
+	}
+
+	if snap.Version == 0 || snap.Version > Version {
+		return nil, fmt.Errorf("%s has unsupported export version %d", path, snap.Version)
+	}
+
+	return &snap, nil
+}
+
+func (snap *Snapshot) Save(path string) error {
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
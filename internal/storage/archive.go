@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// archiveMagic starts every state archive, the salt of the passphrase follows it
+var archiveMagic = []byte("ligolo-mp state\x00\x01")
+
+// archiveName is what the content of an archive is sealed under
+const archiveName = "archive"
+
+// ErrImported is returned by writes once the state was replaced by an import, services still hold the previous one
+// and would write it back over the imported rows
+var ErrImported = errors.New("server state was replaced by an import, restart the server to load it")
+
+// ErrWrongPassphrase is returned when an archive doesn't open with the passphrase it's imported with
+var ErrWrongPassphrase = errors.New("wrong passphrase, or the archive is corrupted")
+
+// validTable keeps the names of archived tables to those repositories use, they end up in queries
+var validTable = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// archive is the content of an export: every row of every table by key, as the JSON repositories store
+type archive struct {
+	Created time.Time
+	Tables  map[string]map[string]json.RawMessage
+}
+
+// Export seals every row of the store in one archive, encrypted with a key derived from passphrase. It's the same
+// whether the store is encrypted or not, and opens on any server with the passphrase. It returns the number of rows
+func (store *Store) Export(passphrase string) ([]byte, int, error) {
+	tables, err := store.tables()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tx, err := store.db.Begin()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	content := archive{
+		Created: time.Now(),
+		Tables:  make(map[string]map[string]json.RawMessage),
+	}
+
+	count := 0
+	for _, table := range tables {
+		rows, err := tx.Query("SELECT name, value FROM " + table)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		content.Tables[table] = make(map[string]json.RawMessage)
+		for rows.Next() {
+			var row StorageRow
+			if err := rows.Scan(&row.Name, &row.Value); err != nil {
+				rows.Close()
+				return nil, 0, err
+			}
+
+			key, value, err := store.rowKeyValue(row.Name, row.Value)
+			if err != nil {
+				rows.Close()
+				return nil, 0, fmt.Errorf("could not read %s: %w", table, err)
+			}
+
+			content.Tables[table][key] = value
+			count++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	var plain bytes.Buffer
+	compressor := gzip.NewWriter(&plain)
+	if err := json.NewEncoder(compressor).Encode(content); err != nil {
+		return nil, 0, err
+	}
+	if err := compressor.Close(); err != nil {
+		return nil, 0, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, 0, err
+	}
+
+	cipher, err := archiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sealed, err := cipher.seal(archiveName, "", plain.Bytes())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := append([]byte{}, archiveMagic...)
+	result = append(result, salt...)
+	result = append(result, sealed...)
+
+	return result, count, nil
+}
+
+// Import replaces every row of the store with those of an archive in one transaction, tables the archive doesn't
+// have are emptied. Writes are refused afterwards, until the server is restarted and loads the imported state. It
+// returns the number of tables and rows imported
+func (store *Store) Import(data []byte, passphrase string) (int, int, error) {
+	if !bytes.HasPrefix(data, archiveMagic) || len(data) < len(archiveMagic)+16 {
+		return 0, 0, errors.New("not a server state archive")
+	}
+	data = data[len(archiveMagic):]
+
+	cipher, err := archiveCipher(passphrase, data[:16])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	_, plain, err := cipher.open(archiveName, data[16:])
+	if err != nil {
+		return 0, 0, ErrWrongPassphrase
+	}
+
+	decompressor, err := gzip.NewReader(bytes.NewReader(plain))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var content archive
+	if err := json.NewDecoder(decompressor).Decode(&content); err != nil {
+		return 0, 0, fmt.Errorf("malformed archive: %w", err)
+	}
+
+	for table := range content.Tables {
+		if !validTable.MatchString(table) || table == metaTable {
+			return 0, 0, fmt.Errorf("malformed archive: invalid table %q", table)
+		}
+	}
+
+	tables, err := store.tables()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tx, err := store.db.Begin()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback()
+
+	for _, table := range tables {
+		if _, err := tx.Exec("DELETE FROM " + table); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	count := 0
+	for table, rows := range content.Tables {
+		if _, err := tx.Exec("CREATE TABLE IF NOT EXISTS " + table + " (name TEXT NOT NULL PRIMARY KEY, value BLOB)"); err != nil {
+			return 0, 0, err
+		}
+
+		for key, value := range rows {
+			name, value, err := store.row(key, value)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			if _, err := tx.Exec("INSERT INTO "+table+" (name, value) VALUES(?, ?)", name, value); err != nil {
+				return 0, 0, err
+			}
+			count++
+		}
+	}
+
+	store.imported.Store(true)
+	if err := tx.Commit(); err != nil {
+		store.imported.Store(false)
+		return 0, 0, err
+	}
+
+	return len(content.Tables), count, nil
+}
+
+// Imported tells whether the state was replaced by an import since the server started
+func (store *Store) Imported() bool {
+	return store.imported.Load()
+}
+
+func archiveCipher(passphrase string, salt []byte) (*valueCipher, error) {
+	key, err := PassphraseKey(passphrase)(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newValueCipher(key)
+}
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/hkdf"
+)
+
+// sealedPrefix marks encrypted values, JSON never starts with a NUL
+var sealedPrefix = []byte("\x00enc1")
+
+// ErrWrongKey is returned when an encrypted store is opened with another key than the one it was encrypted with
+var ErrWrongKey = errors.New("storage key doesn't match the one the storage was encrypted with")
+
+// KeySource derives the 32 bytes key of an encrypted store, salt is kept in the store along with it
+type KeySource func(salt []byte) ([]byte, error)
+
+// FileKey reads the key from a file, base64 encoded. The file is created with a random key if it doesn't exist
+func FileKey(path string) KeySource {
+	return func(_ []byte) ([]byte, error) {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			key := make([]byte, 32)
+			if _, err := rand.Read(key); err != nil {
+				return nil, err
+			}
+
+			encoded := base64.StdEncoding.EncodeToString(key) + "\n"
+			if err := os.WriteFile(path, []byte(encoded), 0600); err != nil {
+				return nil, err
+			}
+
+			return key, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("%s isn't a storage key, it should hold 32 bytes base64 encoded", path)
+		}
+
+		return key, nil
+	}
+}
+
+// PassphraseKey derives the key from a passphrase with argon2id
+func PassphraseKey(passphrase string) KeySource {
+	return func(salt []byte) ([]byte, error) {
+		if passphrase == "" {
+			return nil, errors.New("storage passphrase is empty")
+		}
+
+		return argon2.IDKey([]byte(passphrase), salt, 3, 64*1024, 4, 32), nil
+	}
+}
+
+// valueCipher seals values with AES-GCM and hides row names behind an HMAC, both with keys derived from the store key
+type valueCipher struct {
+	aead  cipher.AEAD
+	names []byte
+}
+
+func newValueCipher(key []byte) (*valueCipher, error) {
+	valueKey := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, key, nil, []byte("ligolo-mp storage values")), valueKey); err != nil {
+		return nil, err
+	}
+
+	names := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, key, nil, []byte("ligolo-mp storage names")), names); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(valueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &valueCipher{
+		aead:  aead,
+		names: names,
+	}, nil
+}
+
+// name is what a row is stored under, the keys used by repositories carry names and addresses too
+func (c *valueCipher) name(key string) string {
+	mac := hmac.New(sha256.New, c.names)
+	mac.Write([]byte(key))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// seal encrypts a value bound to the row it's stored under, so rows can't be swapped. The key the row was set with
+// is sealed along with it, the name alone doesn't give it back
+func (c *valueCipher) seal(name string, key string, value []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	plain := binary.AppendUvarint(nil, uint64(len(key)))
+	plain = append(plain, key...)
+	plain = append(plain, value...)
+
+	result := append([]byte{}, sealedPrefix...)
+	result = append(result, nonce...)
+	return c.aead.Seal(result, nonce, plain, []byte(name)), nil
+}
+
+func (c *valueCipher) open(name string, sealed []byte) (string, []byte, error) {
+	if !isSealed(sealed) {
+		return "", nil, fmt.Errorf("value of %s isn't encrypted", name)
+	}
+
+	sealed = sealed[len(sealedPrefix):]
+	if len(sealed) < c.aead.NonceSize() {
+		return "", nil, fmt.Errorf("value of %s is truncated", name)
+	}
+
+	plain, err := c.aead.Open(nil, sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():], []byte(name))
+	if err != nil {
+		return "", nil, err
+	}
+
+	size, n := binary.Uvarint(plain)
+	if n <= 0 || uint64(len(plain)-n) < size {
+		return "", nil, fmt.Errorf("value of %s is malformed", name)
+	}
+
+	return string(plain[n : n+int(size)]), plain[n+int(size):], nil
+}
+
+func isSealed(value []byte) bool {
+	return bytes.HasPrefix(value, sealedPrefix)
+}
@@ -1,17 +1,31 @@
 package storage
 
 import (
+	"crypto/rand"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"path/filepath"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"database/sql"
 
 	_ "modernc.org/sqlite"
 )
 
+// metaTable keeps what opening an encrypted store takes, in the clear: the salt and a value sealed with the key
+const metaTable = "storage_meta"
+
+// keyCheck is sealed with the key when the store gets encrypted, a wrong key fails to open it
+const keyCheck = "ligolo-mp"
+
 type Store struct {
-	db *sql.DB
+	db     *sql.DB
+	cipher *valueCipher // nil for a store kept in the clear
+
+	imported atomic.Bool
 }
 
 type StoreInstance[T any] struct {
@@ -31,9 +45,226 @@ func New(storage_path string) (*Store, error) {
 		return nil, err
 	}
 
-	return &Store{
+	store := &Store{
 		db: db,
-	}, nil
+	}
+
+	if _, err := store.meta("salt"); err == nil {
+		db.Close()
+		return nil, errors.New("storage is encrypted, a key is needed to open it")
+	}
+
+	return store, nil
+}
+
+// NewEncrypted opens the store with every value sealed with AES-GCM and row names hidden, the key being derived from
+// source. A store kept in the clear until then is encrypted on the spot
+func NewEncrypted(storage_path string, source KeySource) (*Store, error) {
+	db, err := sql.Open("sqlite", filepath.Join(storage_path, "data.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	store := &Store{
+		db: db,
+	}
+
+	if err := store.unlock(source); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (store *Store) unlock(source KeySource) error {
+	if _, err := store.db.Exec("CREATE TABLE IF NOT EXISTS " + metaTable + " (name TEXT NOT NULL PRIMARY KEY, value BLOB)"); err != nil {
+		return err
+	}
+
+	salt, err := store.meta("salt")
+	fresh := errors.Is(err, sql.ErrNoRows)
+	if fresh {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	key, err := source(salt)
+	if err != nil {
+		return err
+	}
+
+	cipher, err := newValueCipher(key)
+	if err != nil {
+		return err
+	}
+
+	if !fresh {
+		check, err := store.meta("check")
+		if err != nil {
+			return err
+		}
+
+		if _, plain, err := cipher.open(metaTable, check); err != nil || string(plain) != keyCheck {
+			return ErrWrongKey
+		}
+
+		store.cipher = cipher
+		return nil
+	}
+
+	check, err := cipher.seal(metaTable, "", []byte(keyCheck))
+	if err != nil {
+		return err
+	}
+
+	store.cipher = cipher
+	return store.encrypt(salt, check)
+}
+
+func (store *Store) meta(name string) ([]byte, error) {
+	var value []byte
+	err := store.db.QueryRow("SELECT value FROM "+metaTable+" WHERE name = ?", name).Scan(&value)
+	if err != nil && strings.Contains(err.Error(), "no such table") {
+		return nil, sql.ErrNoRows
+	}
+
+	return value, err
+}
+
+// encrypt seals every row stored in the clear in one transaction, then rewrites the database file so that nothing
+// of them is left in its free pages
+func (store *Store) encrypt(salt []byte, check []byte) error {
+	tables, err := store.tables()
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	migrated := 0
+	for _, table := range tables {
+		rows, err := tx.Query("SELECT name, value FROM " + table)
+		if err != nil {
+			return err
+		}
+
+		var plain []StorageRow
+		for rows.Next() {
+			var row StorageRow
+			if err := rows.Scan(&row.Name, &row.Value); err != nil {
+				rows.Close()
+				return err
+			}
+			if !isSealed(row.Value) {
+				plain = append(plain, row)
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, row := range plain {
+			name := store.cipher.name(row.Name)
+			sealed, err := store.cipher.seal(name, row.Name, row.Value)
+			if err != nil {
+				return err
+			}
+
+			if _, err := tx.Exec("DELETE FROM "+table+" WHERE name = ?", row.Name); err != nil {
+				return err
+			}
+			if _, err := tx.Exec("INSERT INTO "+table+" (name, value) VALUES(?, ?)", name, sealed); err != nil {
+				return err
+			}
+		}
+		migrated += len(plain)
+	}
+
+	for name, value := range map[string][]byte{"salt": salt, "check": check} {
+		if _, err := tx.Exec("INSERT INTO "+metaTable+" (name, value) VALUES(?, ?)", name, value); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if _, err := store.db.Exec("VACUUM"); err != nil {
+		return err
+	}
+
+	slog.Info("storage encrypted", slog.Int("tables", len(tables)), slog.Int("rows", migrated))
+
+	return nil
+}
+
+// tables are those repositories keep their rows in
+func (store *Store) tables() ([]string, error) {
+	rows, err := store.db.Query("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' AND name != ?", metaTable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		result = append(result, name)
+	}
+
+	return result, rows.Err()
+}
+
+// Encrypted tells whether values are sealed at rest
+func (store *Store) Encrypted() bool {
+	return store.cipher != nil
+}
+
+// row is the name and value a key and its value are stored as
+func (store *Store) row(key string, value []byte) (string, []byte, error) {
+	if store.cipher == nil {
+		return key, value, nil
+	}
+
+	name := store.cipher.name(key)
+	sealed, err := store.cipher.seal(name, key, value)
+	return name, sealed, err
+}
+
+func (store *Store) rowName(key string) string {
+	if store.cipher == nil {
+		return key
+	}
+
+	return store.cipher.name(key)
+}
+
+func (store *Store) rowValue(name string, value []byte) ([]byte, error) {
+	_, value, err := store.rowKeyValue(name, value)
+	return value, err
+}
+
+// rowKeyValue is the key and value a row was set with
+func (store *Store) rowKeyValue(name string, value []byte) (string, []byte, error) {
+	if store.cipher == nil {
+		return name, value, nil
+	}
+
+	return store.cipher.open(name, value)
 }
 
 func (store *Store) Close() error {
@@ -57,13 +288,22 @@ func (s *StoreInstance[T]) Set(key string, value *T) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.imported.Load() {
+		return ErrImported
+	}
+
 	object, err := json.Marshal(value)
 	if err != nil {
 		return err
 	}
 
+	name, object, err := s.row(key, object)
+	if err != nil {
+		return err
+	}
+
 	query := "INSERT INTO " + s.table + " (name, value) VALUES(?, ?) ON CONFLICT (name) DO UPDATE SET value = ?"
-	_, err = s.db.Exec(query, key, object, object)
+	_, err = s.db.Exec(query, name, object, object)
 	return err
 }
 
@@ -74,7 +314,7 @@ func (s *StoreInstance[T]) Get(key string) (*T, error) {
 	var result *T
 	row := new(StorageRow)
 	query := "SELECT name, value FROM " + s.table + " WHERE name = ?"
-	err := s.db.QueryRow(query, key).Scan(&row.Name, &row.Value)
+	err := s.db.QueryRow(query, s.rowName(key)).Scan(&row.Name, &row.Value)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -83,7 +323,12 @@ func (s *StoreInstance[T]) Get(key string) (*T, error) {
 		}
 	}
 
-	err = json.Unmarshal(row.Value, &result)
+	object, err := s.rowValue(row.Name, row.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(object, &result)
 	return result, err
 }
 
@@ -93,7 +338,7 @@ func (s *StoreInstance[T]) GetAll() ([]*T, error) {
 
 	var result []*T
 
-	query := "SELECT value FROM " + s.table
+	query := "SELECT name, value FROM " + s.table
 	rows, err := s.db.Query(query)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -102,13 +347,19 @@ func (s *StoreInstance[T]) GetAll() ([]*T, error) {
 			return nil, err
 		}
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		row := new(StorageRow)
-		rows.Scan(&row.Value)
+		rows.Scan(&row.Name, &row.Value)
+
+		object, err := s.rowValue(row.Name, row.Value)
+		if err != nil {
+			return nil, err
+		}
 
 		var value *T
-		if err := json.Unmarshal(row.Value, &value); err != nil {
+		if err := json.Unmarshal(object, &value); err != nil {
 			return nil, err
 		}
 
@@ -122,8 +373,12 @@ func (s *StoreInstance[T]) Del(key string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.imported.Load() {
+		return ErrImported
+	}
+
 	query := "DELETE FROM " + s.table + " WHERE name = ?"
-	_, err := s.db.Exec(query, key)
+	_, err := s.db.Exec(query, s.rowName(key))
 	return err
 }
 
@@ -131,6 +386,10 @@ func (s *StoreInstance[T]) DelAll() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.imported.Load() {
+		return ErrImported
+	}
+
 	query := "DELETE FROM " + s.table
 	_, err := s.db.Exec(query)
 	return err
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+type testRecord struct {
+	Name   string
+	Secret string
+}
+
+func TestEncrypted(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "storage.key")
+
+	plain, err := New(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := GetInstance[testRecord](plain, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Set("alpha", &testRecord{Name: "alpha", Secret: "hunter2"}); err != nil {
+		t.Fatal(err)
+	}
+	plain.Close()
+
+	// a store kept in the clear is encrypted when first opened with a key
+	store, err := NewEncrypted(dir, FileKey(keyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table, err = GetInstance[testRecord](store, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := table.Get("alpha")
+	if err != nil || record == nil || record.Secret != "hunter2" {
+		t.Fatalf("migrated record = %v, %v", record, err)
+	}
+
+	if err := table.Set("beta", &testRecord{Name: "beta", Secret: "swordfish"}); err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := table.GetAll()
+	if err != nil || len(all) != 2 {
+		t.Fatalf("GetAll = %d records, %v", len(all), err)
+	}
+
+	rows, err := store.db.Query("SELECT name, value FROM records")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var name string
+		var value []byte
+		rows.Scan(&name, &value)
+
+		if name == "alpha" || name == "beta" || !isSealed(value) {
+			t.Errorf("row %s is stored in the clear", name)
+		}
+		if bytes.Contains(value, []byte("hunter2")) || bytes.Contains(value, []byte("swordfish")) {
+			t.Errorf("row %s leaks its value", name)
+		}
+	}
+	rows.Close()
+
+	if err := table.Del("beta"); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	if _, err := New(dir); err == nil {
+		t.Fatal("encrypted store opened without a key")
+	}
+
+	if _, err := NewEncrypted(dir, PassphraseKey("not the key")); !errors.Is(err, ErrWrongKey) {
+		t.Fatalf("wrong key: got %v, want %v", err, ErrWrongKey)
+	}
+
+	store, err = NewEncrypted(dir, FileKey(keyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	table, err = GetInstance[testRecord](store, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if record, err := table.Get("beta"); err != nil || record != nil {
+		t.Fatalf("deleted record = %v, %v", record, err)
+	}
+	if record, err := table.Get("alpha"); err != nil || record == nil || record.Name != "alpha" {
+		t.Fatalf("record after reopening = %v, %v", record, err)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	source, err := NewEncrypted(t.TempDir(), PassphraseKey("storage passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.Close()
+
+	records, err := GetInstance[testRecord](source, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"alpha", "beta"} {
+		if err := records.Set(name, &testRecord{Name: name, Secret: "hunter2"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive, count, err := source.Export("archive passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("exported %d rows, want 2", count)
+	}
+	if bytes.Contains(archive, []byte("hunter2")) || bytes.Contains(archive, []byte("alpha")) {
+		t.Fatal("archive leaks its content")
+	}
+
+	target, err := New(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	stale, err := GetInstance[testRecord](target, "stale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stale.Set("gamma", &testRecord{Name: "gamma"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := target.Import(archive, "not the passphrase"); !errors.Is(err, ErrWrongPassphrase) {
+		t.Fatalf("wrong passphrase: got %v, want %v", err, ErrWrongPassphrase)
+	}
+	if target.Imported() {
+		t.Fatal("failed import marked the store as imported")
+	}
+
+	tables, rows, err := target.Import(archive, "archive passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tables != 1 || rows != 2 {
+		t.Fatalf("imported %d tables and %d rows, want 1 and 2", tables, rows)
+	}
+
+	if all, err := stale.GetAll(); err != nil || len(all) != 0 {
+		t.Fatalf("table missing from the archive kept %d rows, %v", len(all), err)
+	}
+
+	imported, err := GetInstance[testRecord](target, "records")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record, err := imported.Get("beta"); err != nil || record == nil || record.Secret != "hunter2" {
+		t.Fatalf("imported record = %v, %v", record, err)
+	}
+
+	if err := imported.Set("delta", &testRecord{Name: "delta"}); !errors.Is(err, ErrImported) {
+		t.Fatalf("write after import: got %v, want %v", err, ErrImported)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build !windows
+
+package transport
+
+import (
+	"errors"
+	"net"
+)
+
+var errNoPipes = errors.New("named pipes are only available on windows")
+
+// ListenPipe accepts connections on a local named pipe
+func ListenPipe(name string) (net.Listener, error) {
+	return nil, errNoPipes
+}
+
+// DialPipe connects to a named pipe by its full path, e.g. \\host\pipe\name
+func DialPipe(path string) (net.Conn, error) {
+	return nil, errNoPipes
+}
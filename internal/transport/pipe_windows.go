@@ -0,0 +1,262 @@
+//go:build windows
+
+package transport
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	pipeBuffer = 64 * 1024
+	pipeSDDL   = "D:P(A;;GA;;;WD)" // clients come over SMB as whoever they authenticated as, any of them may connect
+)
+
+type pipeAddr string
+
+func (a pipeAddr) Network() string {
+	return "pipe"
+}
+
+func (a pipeAddr) String() string {
+	return string(a)
+}
+
+// ListenPipe accepts connections on a local named pipe
+func ListenPipe(name string) (net.Listener, error) {
+	path := `\\.\pipe\` + name
+
+	handle, err := createPipe(path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &pipeListener{path: path}
+	l.next.Store(uintptr(handle))
+
+	return l, nil
+}
+
+// DialPipe connects to a named pipe by its full path, e.g. \\host\pipe\name
+func DialPipe(path string) (net.Conn, error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		handle, err := windows.CreateFile(name, windows.GENERIC_READ|windows.GENERIC_WRITE, 0, nil, windows.OPEN_EXISTING, windows.FILE_FLAG_OVERLAPPED, 0)
+		if err == nil {
+			return &pipeConn{handle: handle, addr: pipeAddr(path)}, nil
+		}
+
+		// every instance is taken until the listener creates the next one
+		if err != windows.ERROR_PIPE_BUSY || attempt == 50 {
+			return nil, &net.OpError{Op: "dial", Net: "pipe", Addr: pipeAddr(path), Err: err}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func createPipe(path string, first bool) (windows.Handle, error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return windows.InvalidHandle, err
+	}
+
+	sd, err := windows.SecurityDescriptorFromString(pipeSDDL)
+	if err != nil {
+		return windows.InvalidHandle, err
+	}
+	sa := &windows.SecurityAttributes{SecurityDescriptor: sd}
+	sa.Length = uint32(unsafe.Sizeof(*sa))
+
+	flags := uint32(windows.PIPE_ACCESS_DUPLEX | windows.FILE_FLAG_OVERLAPPED)
+	if first {
+		flags |= windows.FILE_FLAG_FIRST_PIPE_INSTANCE // fail rather than share a name someone else listens on
+	}
+
+	return windows.CreateNamedPipe(name, flags, windows.PIPE_TYPE_BYTE|windows.PIPE_READMODE_BYTE|windows.PIPE_WAIT, windows.PIPE_UNLIMITED_INSTANCES, pipeBuffer, pipeBuffer, 0, sa)
+}
+
+// overlapped runs one I/O operation to completion. Every operation has its own event, so reads and writes on a
+// connection don't wait on each other
+func overlapped(handle windows.Handle, deadline time.Time, op func(*windows.Overlapped) error) (uint32, error) {
+	event, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer windows.CloseHandle(event)
+
+	o := &windows.Overlapped{HEvent: event}
+	if err := op(o); err != nil && err != windows.ERROR_IO_PENDING {
+		return 0, err
+	}
+
+	timeout := uint32(windows.INFINITE)
+	if !deadline.IsZero() {
+		timeout = 0
+		if remaining := time.Until(deadline); remaining > 0 {
+			timeout = uint32(remaining.Milliseconds())
+		}
+	}
+
+	var done uint32
+	if result, _ := windows.WaitForSingleObject(event, timeout); result == uint32(windows.WAIT_TIMEOUT) {
+		windows.CancelIoEx(handle, o)
+		windows.GetOverlappedResult(handle, o, &done, true)
+		return done, os.ErrDeadlineExceeded
+	}
+
+	return done, windows.GetOverlappedResult(handle, o, &done, true)
+}
+
+type pipeListener struct {
+	path   string
+	mu     sync.Mutex
+	next   atomic.Uintptr // handle of the instance waiting for the next client
+	closed atomic.Bool
+}
+
+func (l *pipeListener) Accept() (net.Conn, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed.Load() {
+		return nil, net.ErrClosed
+	}
+
+	handle := windows.Handle(l.next.Load())
+	_, err := overlapped(handle, time.Time{}, func(o *windows.Overlapped) error {
+		return windows.ConnectNamedPipe(handle, o)
+	})
+	if err != nil && err != windows.ERROR_PIPE_CONNECTED {
+		if l.closed.Load() {
+			return nil, net.ErrClosed
+		}
+		return nil, &net.OpError{Op: "accept", Net: "pipe", Addr: l.Addr(), Err: err}
+	}
+
+	conn := &pipeConn{handle: handle, addr: pipeAddr(l.path)}
+
+	next, err := createPipe(l.path, false)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	l.next.Store(uintptr(next))
+
+	return conn, nil
+}
+
+func (l *pipeListener) Close() error {
+	if l.closed.Swap(true) {
+		return nil
+	}
+
+	// wakes up a pending Accept, which holds the lock, until it lets go
+	for !l.mu.TryLock() {
+		windows.CancelIoEx(windows.Handle(l.next.Load()), nil)
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer l.mu.Unlock()
+
+	return windows.CloseHandle(windows.Handle(l.next.Load()))
+}
+
+func (l *pipeListener) Addr() net.Addr {
+	return pipeAddr(l.path)
+}
+
+type pipeConn struct {
+	handle windows.Handle
+	addr   pipeAddr
+	closed atomic.Bool
+
+	readDeadline  atomic.Value // time.Time
+	writeDeadline atomic.Value // time.Time
+}
+
+func (c *pipeConn) Read(b []byte) (int, error) {
+	deadline, _ := c.readDeadline.Load().(time.Time)
+	n, err := overlapped(c.handle, deadline, func(o *windows.Overlapped) error {
+		return windows.ReadFile(c.handle, b, nil, o)
+	})
+
+	switch {
+	case err == nil || errors.Is(err, os.ErrDeadlineExceeded):
+		return int(n), err
+	case c.closed.Load():
+		return int(n), net.ErrClosed
+	case err == windows.ERROR_BROKEN_PIPE || err == windows.ERROR_PIPE_NOT_CONNECTED || err == windows.ERROR_NO_DATA:
+		return int(n), io.EOF
+	}
+
+	return int(n), &net.OpError{Op: "read", Net: "pipe", Addr: c.addr, Err: err}
+}
+
+func (c *pipeConn) Write(b []byte) (int, error) {
+	var written int
+	for written < len(b) {
+		deadline, _ := c.writeDeadline.Load().(time.Time)
+		n, err := overlapped(c.handle, deadline, func(o *windows.Overlapped) error {
+			return windows.WriteFile(c.handle, b[written:], nil, o)
+		})
+		written += int(n)
+
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, os.ErrDeadlineExceeded):
+			return written, err
+		case c.closed.Load():
+			return written, net.ErrClosed
+		}
+
+		return written, &net.OpError{Op: "write", Net: "pipe", Addr: c.addr, Err: err}
+	}
+
+	return written, nil
+}
+
+func (c *pipeConn) Close() error {
+	if c.closed.Swap(true) {
+		return nil
+	}
+
+	windows.CancelIoEx(c.handle, nil)
+	return windows.CloseHandle(c.handle)
+}
+
+func (c *pipeConn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+func (c *pipeConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// SetDeadline and its siblings apply to the operations started afterwards, not to those already waiting
+func (c *pipeConn) SetDeadline(t time.Time) error {
+	c.readDeadline.Store(t)
+	c.writeDeadline.Store(t)
+	return nil
+}
+
+func (c *pipeConn) SetReadDeadline(t time.Time) error {
+	c.readDeadline.Store(t)
+	return nil
+}
+
+func (c *pipeConn) SetWriteDeadline(t time.Time) error {
+	c.writeDeadline.Store(t)
+	return nil
+}
@@ -0,0 +1,214 @@
+package transport
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Fingerprints are the ClientHellos agents can mimic, "go" or empty keeps Go's own
+var Fingerprints = []string{"go", "chrome", "firefox", "safari", "edge", "ios", "randomized"}
+
+const (
+	maxPadding    = 4096
+	maxFrameData  = 16 * 1024
+	profileHeader = 4 // data and padding length of a frame
+)
+
+// Profile shapes how agents and the server look on the wire, so that they blend in with the traffic of a target
+// environment. Builds embed one and the server listens with the same one, e.g. wss+office@0.0.0.0:443. Profiles are
+// written in YAML or JSON on the server, agents get them as JSON
+type Profile struct {
+	Name        string            `json:"name" yaml:"name"`
+	Description string            `json:"description,omitempty" yaml:"description"`
+	Fingerprint string            `json:"fingerprint,omitempty" yaml:"fingerprint"` // ClientHello agents send, see Fingerprints
+	Headers     map[string]string `json:"headers,omitempty" yaml:"headers"`         // sent by agents with WebSocket upgrades and DNS-over-HTTPS queries
+	URIs        []string          `json:"uris,omitempty" yaml:"uris"`               // agents pick one per WebSocket connection, the server takes no other
+	Jitter      string            `json:"jitter,omitempty" yaml:"jitter"`           // writes are delayed by up to that long, e.g. 20ms
+	PaddingMin  int               `json:"padding_min,omitempty" yaml:"padding_min"` // random bytes added to every write, within the TLS session
+	PaddingMax  int               `json:"padding_max,omitempty" yaml:"padding_max"`
+	jitter      time.Duration
+}
+
+// Validate checks the profile and gets it ready to shape connections
+func (p *Profile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("profile name is empty")
+	}
+
+	if p.Fingerprint != "" && !contains(Fingerprints, p.Fingerprint) {
+		return fmt.Errorf("profile '%s': fingerprint must be one of %s", p.Name, strings.Join(Fingerprints, ", "))
+	}
+
+	for _, uri := range p.URIs {
+		if !strings.HasPrefix(uri, "/") {
+			return fmt.Errorf("profile '%s': uri '%s' must start with /", p.Name, uri)
+		}
+	}
+
+	for name, value := range p.Headers {
+		if strings.ContainsAny(name+value, "\r\n") {
+			return fmt.Errorf("profile '%s': header '%s' spans several lines", p.Name, name)
+		}
+	}
+
+	p.jitter = 0
+	if p.Jitter != "" {
+		jitter, err := time.ParseDuration(p.Jitter)
+		if err != nil || jitter < 0 || jitter > time.Second {
+			return fmt.Errorf("profile '%s': jitter must be a duration up to 1s", p.Name)
+		}
+		p.jitter = jitter
+	}
+
+	if p.PaddingMin < 0 || p.PaddingMax < p.PaddingMin || p.PaddingMax > maxPadding {
+		return fmt.Errorf("profile '%s': padding must be between 0 and %d bytes, min not above max", p.Name, maxPadding)
+	}
+
+	return nil
+}
+
+// GetName is the name of the profile, empty for none
+func (p *Profile) GetName() string {
+	if p == nil {
+		return ""
+	}
+
+	return p.Name
+}
+
+// URI picks the path of a WebSocket connection, empty if the profile has none
+func (p *Profile) URI() string {
+	if p == nil || len(p.URIs) == 0 {
+		return ""
+	}
+
+	return p.URIs[randomInt(len(p.URIs))]
+}
+
+// Shape delays and pads the writes of a connection as the profile says. Padding frames the data, so both ends
+// have to shape with the same profile. A nil profile leaves the connection as it is
+func (p *Profile) Shape(conn net.Conn) net.Conn {
+	if p == nil || (p.jitter == 0 && p.PaddingMax == 0) {
+		return conn
+	}
+
+	return &shapedConn{Conn: conn, profile: p}
+}
+
+// shapedConn frames data with padding when the profile pads: 2 bytes of data length, 2 bytes of padding length, the
+// data then the padding
+type shapedConn struct {
+	net.Conn
+	profile *Profile
+	writeMu sync.Mutex
+	left    int // data left to read in the current frame
+	padding int // padding after it
+}
+
+func (c *shapedConn) Read(b []byte) (int, error) {
+	if c.profile.PaddingMax == 0 {
+		return c.Conn.Read(b)
+	}
+
+	for c.left == 0 {
+		if c.padding > 0 {
+			if _, err := io.CopyN(io.Discard, c.Conn, int64(c.padding)); err != nil {
+				return 0, err
+			}
+			c.padding = 0
+		}
+
+		var header [profileHeader]byte
+		if _, err := io.ReadFull(c.Conn, header[:]); err != nil {
+			return 0, err
+		}
+		c.left = int(binary.BigEndian.Uint16(header[:]))
+		c.padding = int(binary.BigEndian.Uint16(header[2:]))
+	}
+
+	if len(b) > c.left {
+		b = b[:c.left]
+	}
+	n, err := c.Conn.Read(b)
+	c.left -= n
+
+	return n, err
+}
+
+func (c *shapedConn) Write(b []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.profile.jitter > 0 {
+		time.Sleep(time.Duration(randomInt(int(c.profile.jitter))))
+	}
+
+	if c.profile.PaddingMax == 0 {
+		return c.Conn.Write(b)
+	}
+
+	written := 0
+	for len(b) > 0 {
+		data := b
+		if len(data) > maxFrameData {
+			data = data[:maxFrameData]
+		}
+		padding := c.profile.PaddingMin + randomInt(c.profile.PaddingMax-c.profile.PaddingMin+1)
+
+		frame := make([]byte, profileHeader+len(data)+padding)
+		binary.BigEndian.PutUint16(frame, uint16(len(data)))
+		binary.BigEndian.PutUint16(frame[2:], uint16(padding))
+		copy(frame[profileHeader:], data)
+		if _, err := rand.Read(frame[profileHeader+len(data):]); err != nil {
+			return written, err
+		}
+
+		if _, err := c.Conn.Write(frame); err != nil {
+			return written, err
+		}
+		written += len(data)
+		b = b[len(data):]
+	}
+
+	return written, nil
+}
+
+// ConnectionState is the one of the TLS connection underneath, for the server to check the agent's certificate
+func (c *shapedConn) ConnectionState() tls.ConnectionState {
+	if tlsConn, ok := c.Conn.(interface{ ConnectionState() tls.ConnectionState }); ok {
+		return tlsConn.ConnectionState()
+	}
+
+	return tls.ConnectionState{}
+}
+
+func randomInt(n int) int {
+	if n <= 1 {
+		return 0
+	}
+
+	result, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0
+	}
+
+	return int(result.Int64())
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,191 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+)
+
+// quicProtocol is negotiated with ALPN, which QUIC can't do without
+const quicProtocol = "ligolo-mp"
+
+// quicPreamble opens the stream of a connection, streams only reach the server once something is written to them
+// and the agent speaks second
+var quicPreamble = []byte{0x4c}
+
+// QUICSettings configures the QUIC transport
+type QUICSettings struct {
+	KeepAlive   time.Duration // keeps NAT mappings open, the session has its own keepalive on top
+	IdleTimeout time.Duration
+}
+
+// QUIC carries the session over a single stream of a QUIC connection, on UDP. Loss on one packet doesn't stall the
+// whole link the way it does over TCP, the multiplexed streams still share that one QUIC stream though. The TLS
+// handshake is part of QUIC, with the same certificates as the tls transport
+type QUIC struct {
+	settings QUICSettings
+}
+
+func NewQUIC(settings QUICSettings) *QUIC {
+	return &QUIC{settings: settings}
+}
+
+func (t *QUIC) Name() string {
+	return "quic"
+}
+
+func (t *QUIC) config() *quic.Config {
+	return &quic.Config{
+		KeepAlivePeriod: t.settings.KeepAlive,
+		MaxIdleTimeout:  t.settings.IdleTimeout,
+	}
+}
+
+// Listen only takes clients with a certificate when the configuration allows them without, QUIC has no decoy to
+// send them to
+func (t *QUIC) Listen(address string, config *tls.Config) (net.Listener, error) {
+	config = config.Clone()
+	config.NextProtos = []string{quicProtocol}
+	if config.ClientAuth == tls.VerifyClientCertIfGiven {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	lis, err := quic.ListenAddr(address, config, t.config())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &quicListener{
+		listener: lis,
+		accepted: make(chan net.Conn),
+		closed:   make(chan struct{}),
+	}
+	go result.serve()
+
+	return result, nil
+}
+
+// Dial goes straight to the server, HTTP and SOCKS proxies don't carry UDP
+func (t *QUIC) Dial(dialer Dialer, address string, config *tls.Config) (net.Conn, error) {
+	timeout := 10 * time.Second
+	switch d := dialer.(type) {
+	case *net.Dialer:
+		if d.Timeout > 0 {
+			timeout = d.Timeout
+		}
+	default:
+		return nil, errors.New("quic can't go through a proxy")
+	}
+
+	config = config.Clone()
+	config.NextProtos = []string{quicProtocol}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	conn, err := quic.DialAddr(ctx, address, config, t.config())
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		conn.CloseWithError(0, "")
+		return nil, err
+	}
+
+	if _, err := stream.Write(quicPreamble); err != nil {
+		conn.CloseWithError(0, "")
+		return nil, err
+	}
+
+	return &quicConn{Stream: stream, conn: conn}, nil
+}
+
+type quicListener struct {
+	listener *quic.Listener
+	accepted chan net.Conn
+	closed   chan struct{}
+}
+
+// serve waits for the stream of each connection on its own, a client that never opens one doesn't hold the others
+func (l *quicListener) serve() {
+	for {
+		conn, err := l.listener.Accept(context.Background())
+		if err != nil {
+			close(l.closed)
+			return
+		}
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			stream, err := conn.AcceptStream(ctx)
+			if err != nil {
+				conn.CloseWithError(0, "")
+				return
+			}
+
+			preamble := make([]byte, len(quicPreamble))
+			stream.SetReadDeadline(time.Now().Add(10 * time.Second))
+			if _, err := io.ReadFull(stream, preamble); err != nil || preamble[0] != quicPreamble[0] {
+				conn.CloseWithError(0, "")
+				return
+			}
+			stream.SetReadDeadline(time.Time{})
+
+			select {
+			case l.accepted <- &quicConn{Stream: stream, conn: conn}:
+			case <-l.closed:
+				conn.CloseWithError(0, "")
+			}
+		}()
+	}
+}
+
+func (l *quicListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.accepted:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *quicListener) Close() error {
+	return l.listener.Close()
+}
+
+func (l *quicListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// quicConn is the stream carrying the session, closing it closes the connection
+type quicConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *quicConn) Close() error {
+	c.Stream.Close()
+	return c.conn.CloseWithError(0, "")
+}
+
+// ConnectionState is the TLS state of the connection, the way a tls.Conn tells it
+func (c *quicConn) ConnectionState() tls.ConnectionState {
+	return c.conn.ConnectionState().TLS
+}
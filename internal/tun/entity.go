@@ -7,18 +7,33 @@ import (
 
 	"github.com/hashicorp/yamux"
 	"github.com/ttpreport/ligolo-mp/v2/internal/netstack"
+	nstun "github.com/ttpreport/ligolo-mp/v2/internal/netstack/tun"
 	"github.com/ttpreport/ligolo-mp/v2/internal/netstack/tunlink"
 	"github.com/ttpreport/ligolo-mp/v2/internal/route"
 	"github.com/ttpreport/ligolo-mp/v2/pkg/memstore"
 	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
 )
 
+// simulatedQueue and simulatedMTU size the in-memory link of simulated tuns
+const (
+	simulatedQueue = 1024
+	simulatedMTU   = 1500
+)
+
 type Tun struct {
-	ID       int
-	Name     string
-	Active   bool
-	Routes   *memstore.Syncmap[string, *route.Route]
-	netstack *netstack.NetStack `json:"-"`
+	ID           int
+	Name         string
+	Active       bool
+	Routes       *memstore.Syncmap[string, *route.Route]
+	SpoofSource  bool
+	Compress     bool // compress relayed connections, routes may override it
+	Mirror       string
+	netstack     *netstack.NetStack `json:"-"`
+	nic          *netstack.NIC      `json:"-"`
+	link         *nstun.Channel     `json:"-"` // in-memory link of a simulated tun, nil for a kernel TUN
+	manageRoutes bool
+	bypassRoutes []route.Route
+	onFlow       func(netstack.Flow)
 }
 
 func NewTun() (*Tun, error) {
@@ -30,39 +45,72 @@ func NewTun() (*Tun, error) {
 	return ret, nil
 }
 
-func (t *Tun) Start(multiplex *yamux.Session, maxConnections int, maxInFlight int) error {
+// Start brings the tun up and attaches it to the netstack as the NIC of the session. A simulated tun runs over
+// an in-memory link instead of a kernel TUN, so nothing from the host reaches it and routes are never programmed
+func (t *Tun) Start(ns *netstack.NetStack, sessionID string, multiplex *yamux.Session, encoding uint8, compression uint8, manageRoutes bool, simulate bool) error {
 	if t.Active {
 		return nil
 	}
 
-	linkID, linkName, err := tunlink.New()
-	if err != nil {
-		slog.Error("could not create tun link")
-		return err
-	}
-	slog.Debug("tun link created", slog.Any("id", linkID), slog.Any("name", linkName))
+	var nic *netstack.NIC
+	if simulate {
+		t.link = nstun.NewChannel(simulatedQueue, simulatedMTU)
 
-	t.ID = linkID
-	t.Name = linkName
+		var err error
+		nic, err = ns.AddLinkNIC(sessionID, t.link)
+		if err != nil {
+			slog.Error("could not attach simulated tun to netstack")
+			t.link = nil
+			return err
+		}
 
-	ns, err := netstack.NewNetstack(maxConnections, maxInFlight, t.Name)
-	if err != nil {
-		slog.Error("could not create netstack")
-		return err
+		t.ID = 0
+		t.Name = fmt.Sprintf("sim%d", nic.ID())
+		t.manageRoutes = false
+	} else {
+		linkID, linkName, err := tunlink.New()
+		if err != nil {
+			slog.Error("could not create tun link")
+			return err
+		}
+		slog.Debug("tun link created", slog.Any("id", linkID), slog.Any("name", linkName))
+
+		t.ID = linkID
+		t.Name = linkName
+		t.manageRoutes = manageRoutes
+
+		nic, err = ns.AddNIC(sessionID, t.Name)
+		if err != nil {
+			slog.Error("could not attach tun to netstack")
+			if err := tunlink.Remove(t.ID); err != nil {
+				slog.Debug("could not delete link", slog.Any("error", err))
+			}
+			return err
+		}
+	}
+	slog.Debug("tun attached to netstack", slog.Any("nic", nic.ID()))
+	nic.SetEncoding(encoding)
+	nic.SetCompression(compression)
+	nic.SetFlowFunc(t.onFlow)
+
+	for _, existing := range t.Routes.All() {
+		if existing.Usage == nil { // stored before usage was tracked
+			existing.Usage = &route.Usage{}
+		}
 	}
-	slog.Debug("netstack created", slog.Any("netstack", ns))
 
 	t.netstack = ns
+	t.nic = nic
 
 	go func() {
 		for {
 			select {
-			case <-t.netstack.ClosePool(): // pool closed, we can't process packets!
+			case <-nic.ClosePool(): // pool closed, we can't process packets!
 				slog.Debug("connection pool closed")
 				return
-			case relayPacket := <-t.netstack.GetTunConn(): // Process connections/packets
-				localRoutes := t.GetLocalRoutes() // a bit dirty, but allows granular localhost routing
-				go t.netstack.HandlePacket(relayPacket, multiplex, localRoutes)
+			case relayPacket := <-nic.GetTunConn(): // Process connections/packets
+				routes := t.GetRoutes() // a bit dirty, but allows granular localhost routing and port filtering
+				go nic.HandlePacket(relayPacket, multiplex, routes, t.SpoofSource, t.Compress)
 			}
 		}
 	}()
@@ -71,6 +119,12 @@ func (t *Tun) Start(multiplex *yamux.Session, maxConnections int, maxInFlight in
 
 	slog.Debug("tun activated")
 
+	if t.Mirror != "" {
+		if err := t.applyMirror(); err != nil {
+			slog.Error("could not start traffic mirroring", slog.Any("err", err), slog.Any("mirror", t.Mirror))
+		}
+	}
+
 	if err := t.ApplyRoutes(); err != nil {
 		slog.Error("could not apply routes")
 	}
@@ -79,50 +133,140 @@ func (t *Tun) Start(multiplex *yamux.Session, maxConnections int, maxInFlight in
 }
 
 func (t *Tun) Stop() {
-	if err := tunlink.Remove(t.ID); err != nil {
-		slog.Debug("could not delete link", slog.Any("error", err))
+	if t.link == nil {
+		if err := tunlink.Remove(t.ID); err != nil {
+			slog.Debug("could not delete link", slog.Any("error", err))
+		}
+	}
+	t.removeBypassRoutes()
+	if t.manageRoutes && len(t.Routes.All()) > 0 {
+		slog.Info("system routes removed along with tun", slog.Any("dev", t.Name))
 	}
 	slog.Debug("tun removed", slog.Any("tun", t))
 
-	if t.netstack != nil {
-		if err := t.netstack.Destroy(); err != nil {
-			slog.Debug("could not destroy netstack", slog.Any("err", err), slog.Any("netstack", t.netstack))
+	if t.nic != nil {
+		if err := t.netstack.RemoveNIC(t.nic.SessionID()); err != nil {
+			slog.Debug("could not detach tun from netstack", slog.Any("err", err), slog.Any("nic", t.nic.ID()))
 		}
+		t.nic = nil
 	}
+	t.link = nil
 
 	t.Active = false
 }
 
+// Link is the in-memory link of a simulated tun, raw IP packets injected into it are relayed as if they came
+// from the host. Nil unless the tun was started in simulate mode
+func (t *Tun) Link() *nstun.Channel {
+	return t.link
+}
+
 func (t *Tun) ApplyRoutes() error {
 	if t.Active {
+		if !t.manageRoutes {
+			slog.Debug("route management is disabled, skipping", slog.Any("tun", t))
+			return nil
+		}
+
 		slog.Debug("applying routes")
 
 		if err := t.removeAllRoutes(); err != nil {
 			slog.Warn("could not remove current routes", slog.Any("err", err))
 		}
+		t.removeBypassRoutes()
 
+		// exclusions go first, otherwise the lookup would resolve into this very tun
 		for _, route := range t.Routes.All() {
+			if !route.IsExclusion {
+				continue
+			}
+
+			if err := tunlink.AddBypassRoute(t.ID, route.Cidr, route.Metric); err != nil {
+				slog.Error("could not add exclusion route to the system", slog.Any("err", err), slog.Any("route", route))
+				continue
+			}
+			t.bypassRoutes = append(t.bypassRoutes, *route)
+			slog.Info("system exclusion route added", slog.Any("cidr", route.Cidr.String()), slog.Any("metric", route.Metric))
+		}
+
+		for _, route := range t.Routes.All() {
+			if route.IsExclusion {
+				continue
+			}
+
 			err := tunlink.AddRoute(t.ID, route.Cidr, route.Metric)
 			if err != nil {
 				slog.Error("could not add route to the system", slog.Any("err", err), slog.Any("route", route))
+				continue
 			}
+			slog.Info("system route added", slog.Any("cidr", route.Cidr.String()), slog.Any("dev", t.Name), slog.Any("metric", route.Metric))
 		}
 	}
 
 	return nil
 }
 
+// SetFlowFunc sets what connections relayed by the tun get reported to, it applies from the next start
+func (t *Tun) SetFlowFunc(f func(netstack.Flow)) {
+	t.onFlow = f
+}
+
+func (t *Tun) SetMirror(target string) error {
+	prev := t.Mirror
+	t.Mirror = target
+
+	if err := t.applyMirror(); err != nil {
+		t.Mirror = prev
+		return err
+	}
+
+	return nil
+}
+
+func (t *Tun) applyMirror() error {
+	if !t.Active || t.nic == nil {
+		return nil
+	}
+
+	if t.Mirror == "" {
+		t.nic.SetMirror(nil)
+		return nil
+	}
+
+	mirror, err := netstack.NewMirror(t.Mirror)
+	if err != nil {
+		return err
+	}
+	t.nic.SetMirror(mirror)
+
+	slog.Info("traffic mirroring started", slog.Any("tun", t.Name), slog.Any("mirror", t.Mirror))
+
+	return nil
+}
+
 func (t *Tun) removeAllRoutes() error {
 	err := tunlink.RemoveAllRoutes(t.ID)
 	if err != nil {
 		slog.Error("could not find link", slog.Any("link_id", t.ID))
 		return err
 	}
+	slog.Info("system routes flushed", slog.Any("dev", t.Name))
 
 	return nil
 }
 
-func (t *Tun) NewRoute(cidr string, metric int, isLoopback bool) error {
+func (t *Tun) removeBypassRoutes() {
+	for _, route := range t.bypassRoutes {
+		if err := tunlink.RemoveBypassRoute(route.Cidr, route.Metric); err != nil {
+			slog.Warn("could not remove exclusion route", slog.Any("err", err), slog.Any("route", route))
+			continue
+		}
+		slog.Info("system exclusion route removed", slog.Any("cidr", route.Cidr.String()))
+	}
+	t.bypassRoutes = nil
+}
+
+func (t *Tun) NewRoute(cidr string, metric int, isLoopback bool, ports string, isExclusion bool) error {
 	slog.Debug("adding route to tun", slog.Any("route", cidr))
 
 	_, newRoute, err := net.ParseCIDR(cidr)
@@ -136,9 +280,9 @@ func (t *Tun) NewRoute(cidr string, metric int, isLoopback bool) error {
 		}
 	}
 
-	route, err := route.NewRoute(cidr, metric, isLoopback)
+	route, err := route.NewRoute(cidr, metric, isLoopback, ports, isExclusion)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	t.Routes.Set(route.ID, route)
@@ -169,6 +313,9 @@ func (t *Tun) RemoveRoute(id string) (*route.Route, error) {
 }
 
 func (t *Tun) GetName() (string, error) {
+	if t.link != nil {
+		return t.Name, nil
+	}
 	return tunlink.GetName(t.ID)
 }
 
@@ -203,8 +350,11 @@ func (t *Tun) Proto() *pb.Tun {
 	}
 
 	return &pb.Tun{
-		Name:   t.Name,
-		Routes: Routes,
+		Name:        t.Name,
+		Routes:      Routes,
+		SpoofSource: t.SpoofSource,
+		Mirror:      t.Mirror,
+		Compress:    t.Compress,
 	}
 }
 
@@ -215,7 +365,10 @@ func ProtoToTun(p *pb.Tun) *Tun {
 	}
 
 	return &Tun{
-		Name:   p.Name,
-		Routes: routes,
+		Name:        p.Name,
+		Routes:      routes,
+		SpoofSource: p.SpoofSource,
+		Mirror:      p.Mirror,
+		Compress:    p.Compress,
 	}
 }
@@ -0,0 +1,45 @@
+package tun
+
+import (
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/netstack"
+)
+
+func TestSimulatedTun(t *testing.T) {
+	ns, err := netstack.NewNetstack(16, 16, netstack.TCPOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ns.Destroy()
+
+	tun, err := NewTun()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tun.NewRoute("10.0.0.0/24", 0, false, "", false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tun.Start(ns, "session", nil, 0, 0, true, true); err != nil {
+		t.Fatal(err)
+	}
+	if !tun.Active || tun.Link() == nil || ns.NIC("session") == nil {
+		t.Fatal("simulated tun should be attached to the netstack over its link")
+	}
+	if name, err := tun.GetName(); err != nil || name != tun.Name {
+		t.Fatalf("got name %q, %v", name, err)
+	}
+
+	if err := tun.Link().InjectPacket([]byte{0x45}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tun.Link().InjectPacket([]byte{0x00}); err == nil {
+		t.Fatal("only IP packets should be injected")
+	}
+
+	tun.Stop()
+	if tun.Active || tun.Link() != nil || ns.NIC("session") != nil {
+		t.Fatal("stopping the tun should detach it")
+	}
+}
@@ -0,0 +1,169 @@
+// Package wake is a minimal authoritative DNS responder for waking sleeping agents. The wake domain has to be
+// delegated to the server (NS record), so agents reach it through their usual resolvers and never talk to the
+// server directly while asleep
+package wake
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+const (
+	rcodeFormErr = 1
+	rcodeNotImp  = 4
+	rcodeRefused = 5
+
+	typeTXT = 16
+	classIN = 1
+)
+
+var errNotQuery = errors.New("not a query")
+
+// Responder answers TXT queries for <token>.<domain> with the protocol.WakeProof of the token when pending returns
+// the agent's wake key, and with a random lookalike otherwise, so the wake up can't be told apart on the wire
+type Responder struct {
+	conn    net.PacketConn
+	domain  string
+	pending func(token string) []byte
+}
+
+func Listen(address string, domain string, pending func(token string) []byte) (*Responder, error) {
+	conn, err := net.ListenPacket("udp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Responder{
+		conn:    conn,
+		domain:  strings.ToLower(strings.Trim(domain, ".")),
+		pending: pending,
+	}, nil
+}
+
+func (r *Responder) Serve() {
+	slog.Info("Wake DNS responder started", slog.String("address", r.conn.LocalAddr().String()), slog.String("domain", r.domain))
+
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+
+		response, err := Answer(buf[:n], r.domain, r.pending)
+		if err != nil {
+			slog.Debug("dropped wake query", slog.Any("from", addr), slog.Any("error", err))
+			continue
+		}
+
+		r.conn.WriteTo(response, addr)
+	}
+}
+
+func (r *Responder) Close() error {
+	return r.conn.Close()
+}
+
+// Answer builds the response to a single DNS query
+func Answer(query []byte, domain string, pending func(token string) []byte) ([]byte, error) {
+	if len(query) < 12 {
+		return nil, errNotQuery
+	}
+
+	flags := binary.BigEndian.Uint16(query[2:])
+	if flags&0x8000 != 0 {
+		return nil, errNotQuery
+	}
+
+	if opcode := flags >> 11 & 0xf; opcode != 0 {
+		return header(query, rcodeNotImp, 0), nil
+	}
+
+	if binary.BigEndian.Uint16(query[4:]) != 1 {
+		return header(query, rcodeFormErr, 0), nil
+	}
+
+	name, end, ok := parseName(query, 12)
+	if !ok || end+4 > len(query) {
+		return header(query, rcodeFormErr, 0), nil
+	}
+	question := query[12 : end+4]
+	qtype := binary.BigEndian.Uint16(query[end:])
+	qclass := binary.BigEndian.Uint16(query[end+2:])
+
+	if name != domain && !strings.HasSuffix(name, "."+domain) {
+		return append(header(query, rcodeRefused, 0), question...), nil
+	}
+
+	token := strings.TrimSuffix(strings.TrimSuffix(name, domain), ".")
+	if qtype != typeTXT || qclass != classIN || token == "" {
+		return append(header(query, 0, 0), question...), nil
+	}
+
+	key := pending(token)
+	if key == nil {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	proof := protocol.WakeProof(key, token)
+
+	response := append(header(query, 0, 1), question...)
+	response = append(response, 0xc0, 12) // pointer to the question name
+	response = binary.BigEndian.AppendUint16(response, typeTXT)
+	response = binary.BigEndian.AppendUint16(response, classIN)
+	response = binary.BigEndian.AppendUint32(response, 0) // TTL, resolvers shouldn't cache it
+	response = binary.BigEndian.AppendUint16(response, uint16(len(proof)+1))
+	response = append(response, byte(len(proof)))
+
+	return append(response, proof...), nil
+}
+
+// header is an authoritative response header with the question count of the query
+func header(query []byte, rcode uint16, answers uint16) []byte {
+	flags := binary.BigEndian.Uint16(query[2:])
+
+	out := make([]byte, 12)
+	copy(out, query[:2])
+	binary.BigEndian.PutUint16(out[2:], 0x8000|0x0400|flags&0x0100|rcode)
+	if rcode != rcodeFormErr && rcode != rcodeNotImp {
+		binary.BigEndian.PutUint16(out[4:], 1)
+	}
+	binary.BigEndian.PutUint16(out[6:], answers)
+
+	return out
+}
+
+// parseName reads an uncompressed name, lowercased, and returns where it ends
+func parseName(msg []byte, offset int) (string, int, bool) {
+	var labels []string
+	for {
+		if offset >= len(msg) {
+			return "", 0, false
+		}
+
+		length := int(msg[offset])
+		offset++
+		if length == 0 {
+			break
+		}
+		if length > 63 || offset+length > len(msg) {
+			return "", 0, false
+		}
+
+		labels = append(labels, strings.ToLower(string(msg[offset:offset+length])))
+		offset += length
+	}
+
+	return strings.Join(labels, "."), offset, true
+}
@@ -0,0 +1,60 @@
+package wake
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/protocol"
+)
+
+func testQuery(name string, qtype uint16) []byte {
+	query := []byte{0x13, 0x37, 0x01, 0x00, 0, 1, 0, 0, 0, 0, 0, 0}
+	for _, label := range strings.Split(name, ".") {
+		query = append(query, byte(len(label)))
+		query = append(query, label...)
+	}
+	query = append(query, 0)
+	query = binary.BigEndian.AppendUint16(query, qtype)
+	return binary.BigEndian.AppendUint16(query, classIN)
+}
+
+func TestAnswer(t *testing.T) {
+	key := []byte("wake key")
+	pending := func(token string) []byte {
+		if token == "cafe" {
+			return key
+		}
+		return nil
+	}
+
+	for name, woken := range map[string]bool{
+		"CAFE.Wake.Example.com": true,
+		"beef.wake.example.com": false,
+	} {
+		query := testQuery(name, typeTXT)
+		response, err := Answer(query, "wake.example.com", pending)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(response[:2], query[:2]) || binary.BigEndian.Uint16(response[6:]) != 1 {
+			t.Fatalf("%s: unexpected header %x", name, response[:12])
+		}
+
+		token, _, _ := strings.Cut(name, ".")
+		proof := protocol.WakeProof(key, token)
+		if txt := string(response[len(response)-len(proof):]); (txt == proof) != woken {
+			t.Fatalf("%s: unexpected TXT record %s", name, txt)
+		}
+	}
+
+	response, err := Answer(testQuery("cafe.example.org", typeTXT), "wake.example.com", pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rcode := binary.BigEndian.Uint16(response[2:]) & 0xf; rcode != rcodeRefused {
+		t.Fatalf("queries outside the domain should be refused, got rcode %d", rcode)
+	}
+}
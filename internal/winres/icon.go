@@ -0,0 +1,84 @@
+package winres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// iconEntry is an ICONDIRENTRY, the first 12 bytes are shared with the group icon resource
+type iconEntry struct {
+	Width      uint8
+	Height     uint8
+	ColorCount uint8
+	Reserved   uint8
+	Planes     uint16
+	BitCount   uint16
+	BytesInRes uint32
+	Offset     uint32
+}
+
+type icon struct {
+	entries []iconEntry
+	images  [][]byte
+}
+
+// parseIcon splits an .ico file into its images, each one becomes an RT_ICON resource
+func parseIcon(data []byte) (*icon, error) {
+	var header struct {
+		Reserved uint16
+		Type     uint16
+		Count    uint16
+	}
+
+	reader := bytes.NewReader(data)
+	if err := binary.Read(reader, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+
+	if header.Reserved != 0 || header.Type != 1 {
+		return nil, errors.New("not an .ico file")
+	}
+	if header.Count == 0 {
+		return nil, errors.New("icon has no images")
+	}
+
+	result := &icon{}
+	for i := 0; i < int(header.Count); i++ {
+		var entry iconEntry
+		if err := binary.Read(reader, binary.LittleEndian, &entry); err != nil {
+			return nil, err
+		}
+
+		end := uint64(entry.Offset) + uint64(entry.BytesInRes)
+		if entry.BytesInRes == 0 || end > uint64(len(data)) {
+			return nil, fmt.Errorf("image %d is out of bounds", i)
+		}
+
+		result.entries = append(result.entries, entry)
+		result.images = append(result.images, data[entry.Offset:end])
+	}
+
+	return result, nil
+}
+
+// group is the RT_GROUP_ICON resource, the ICO directory with file offsets replaced by resource IDs
+func (ico *icon) group() []byte {
+	var out bytes.Buffer
+	le := binary.LittleEndian
+
+	binary.Write(&out, le, uint16(0))
+	binary.Write(&out, le, uint16(1))
+	binary.Write(&out, le, uint16(len(ico.entries)))
+
+	for i, entry := range ico.entries {
+		out.Write([]byte{entry.Width, entry.Height, entry.ColorCount, entry.Reserved})
+		binary.Write(&out, le, entry.Planes)
+		binary.Write(&out, le, entry.BitCount)
+		binary.Write(&out, le, entry.BytesInRes)
+		binary.Write(&out, le, uint16(i+1))
+	}
+
+	return out.Bytes()
+}
@@ -0,0 +1,162 @@
+package winres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// VersionInfo is the VERSIONINFO resource shown in the file properties, empty fields are left out
+type VersionInfo struct {
+	CompanyName      string
+	ProductName      string
+	FileDescription  string
+	FileVersion      string // up to four dot separated numbers, e.g. 10.0.19041.1
+	ProductVersion   string
+	OriginalFilename string
+	LegalCopyright   string
+}
+
+func (info *VersionInfo) Empty() bool {
+	return info == nil || *info == VersionInfo{}
+}
+
+func (info *VersionInfo) Validate() error {
+	if info.Empty() {
+		return nil
+	}
+
+	if _, _, err := parseVersion(info.FileVersion); err != nil {
+		return fmt.Errorf("invalid file version: %w", err)
+	}
+
+	if _, _, err := parseVersion(info.ProductVersion); err != nil {
+		return fmt.Errorf("invalid product version: %w", err)
+	}
+
+	return nil
+}
+
+// parseVersion packs a.b.c.d into the two DWORDs of VS_FIXEDFILEINFO, missing parts are zero
+func parseVersion(version string) (uint32, uint32, error) {
+	var parts [4]uint16
+
+	if version = strings.TrimSpace(version); version != "" {
+		fields := strings.Split(version, ".")
+		if len(fields) > 4 {
+			return 0, 0, fmt.Errorf("%s has more than four parts", version)
+		}
+
+		for i, field := range fields {
+			n, err := strconv.ParseUint(field, 10, 16)
+			if err != nil {
+				return 0, 0, fmt.Errorf("%s is not a version number", version)
+			}
+			parts[i] = uint16(n)
+		}
+	}
+
+	return uint32(parts[0])<<16 | uint32(parts[1]), uint32(parts[2])<<16 | uint32(parts[3]), nil
+}
+
+func (info *VersionInfo) strings() [][2]string {
+	var result [][2]string
+	for _, field := range [][2]string{
+		{"CompanyName", info.CompanyName},
+		{"FileDescription", info.FileDescription},
+		{"FileVersion", info.FileVersion},
+		{"LegalCopyright", info.LegalCopyright},
+		{"OriginalFilename", info.OriginalFilename},
+		{"ProductName", info.ProductName},
+		{"ProductVersion", info.ProductVersion},
+	} {
+		if field[1] != "" {
+			result = append(result, field)
+		}
+	}
+
+	return result
+}
+
+// encode builds VS_VERSIONINFO with an en-US, Unicode string table
+func (info *VersionInfo) encode() ([]byte, error) {
+	fileMS, fileLS, err := parseVersion(info.FileVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	productMS, productLS, err := parseVersion(info.ProductVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixed bytes.Buffer
+	for _, v := range []uint32{
+		0xfeef04bd, // signature
+		0x00010000, // structure version
+		fileMS, fileLS,
+		productMS, productLS,
+		0x3f,    // flags mask
+		0,       // flags
+		0x40004, // VOS_NT_WINDOWS32
+		1,       // VFT_APP
+		0,       // subtype
+		0, 0,    // date
+	} {
+		binary.Write(&fixed, binary.LittleEndian, v)
+	}
+
+	var stringNodes [][]byte
+	for _, field := range info.strings() {
+		value := utf16z(field[1])
+		stringNodes = append(stringNodes, versionNode(field[0], 1, value, uint16(len(value)/2)))
+	}
+
+	stringTable := versionNode("040904b0", 1, nil, 0, stringNodes...)
+	stringFileInfo := versionNode("StringFileInfo", 1, nil, 0, stringTable)
+
+	translation := binary.LittleEndian.AppendUint32(nil, 0x04b00409) // en-US, Unicode
+	varFileInfo := versionNode("VarFileInfo", 1, nil, 0, versionNode("Translation", 0, translation, uint16(len(translation))))
+
+	return versionNode("VS_VERSION_INFO", 0, fixed.Bytes(), uint16(fixed.Len()), stringFileInfo, varFileInfo), nil
+}
+
+// versionNode is the header/key/value/children layout shared by every VERSIONINFO block, all parts are 32-bit aligned
+func versionNode(key string, valueType uint16, value []byte, valueLength uint16, children ...[]byte) []byte {
+	var out bytes.Buffer
+	le := binary.LittleEndian
+
+	binary.Write(&out, le, uint16(0)) // length, patched below
+	binary.Write(&out, le, valueLength)
+	binary.Write(&out, le, valueType)
+	out.Write(utf16z(key))
+	pad(&out)
+
+	out.Write(value)
+
+	for _, child := range children {
+		pad(&out)
+		out.Write(child)
+	}
+
+	result := out.Bytes()
+	le.PutUint16(result, uint16(len(result)))
+
+	return result
+}
+
+func pad(out *bytes.Buffer) {
+	out.Write(make([]byte, align(out.Len(), 4)-out.Len()))
+}
+
+func utf16z(s string) []byte {
+	var out []byte
+	for _, c := range utf16.Encode([]rune(s)) {
+		out = binary.LittleEndian.AppendUint16(out, c)
+	}
+
+	return append(out, 0, 0)
+}
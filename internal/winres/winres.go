@@ -0,0 +1,289 @@
+package winres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	MaxIconSize     = 1 << 20
+	MaxManifestSize = 64 * 1024
+)
+
+// resource types, see winuser.h
+const (
+	rtIcon      = 3
+	rtGroupIcon = 14
+	rtVersion   = 16
+	rtManifest  = 24
+)
+
+const langEnUS = 0x0409
+
+// Resources is what gets embedded in a Windows binary, every part is optional
+type Resources struct {
+	Icon     []byte // .ico file
+	Manifest []byte // application manifest XML
+	Version  *VersionInfo
+}
+
+func (res *Resources) Empty() bool {
+	return res == nil || (len(res.Icon) == 0 && len(res.Manifest) == 0 && res.Version.Empty())
+}
+
+// Validate checks the operator supplied files before a build is queued
+func (res *Resources) Validate() error {
+	if len(res.Icon) > 0 {
+		if len(res.Icon) > MaxIconSize {
+			return fmt.Errorf("icon is larger than %d bytes", MaxIconSize)
+		}
+		if _, err := parseIcon(res.Icon); err != nil {
+			return fmt.Errorf("invalid icon: %w", err)
+		}
+	}
+
+	if len(res.Manifest) > 0 {
+		if len(res.Manifest) > MaxManifestSize {
+			return fmt.Errorf("manifest is larger than %d bytes", MaxManifestSize)
+		}
+		if err := checkXML(res.Manifest); err != nil {
+			return fmt.Errorf("invalid manifest: %w", err)
+		}
+	}
+
+	return res.Version.Validate()
+}
+
+func checkXML(data []byte) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+type resource struct {
+	typ  uint32
+	id   uint32
+	data []byte
+}
+
+func (res *Resources) resources() ([]resource, error) {
+	var result []resource
+
+	if len(res.Icon) > 0 {
+		icon, err := parseIcon(res.Icon)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, image := range icon.images {
+			result = append(result, resource{typ: rtIcon, id: uint32(i + 1), data: image})
+		}
+		result = append(result, resource{typ: rtGroupIcon, id: 1, data: icon.group()})
+	}
+
+	if !res.Version.Empty() {
+		version, err := res.Version.encode()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resource{typ: rtVersion, id: 1, data: version})
+	}
+
+	if len(res.Manifest) > 0 {
+		result = append(result, resource{typ: rtManifest, id: 1, data: res.Manifest})
+	}
+
+	return result, nil
+}
+
+// COFF machine types and the matching relocation used for RVAs
+var machines = map[string]struct {
+	machine    uint16
+	relocation uint16
+}{
+	"386":   {machine: 0x14c, relocation: 0x7},  // IMAGE_REL_I386_DIR32NB
+	"amd64": {machine: 0x8664, relocation: 0x3}, // IMAGE_REL_AMD64_ADDR32NB
+	"arm64": {machine: 0xaa64, relocation: 0x2}, // IMAGE_REL_ARM64_ADDR32NB
+}
+
+// Syso renders the resources as a COFF object, the Go linker embeds any .syso file found in the package directory
+func (res *Resources) Syso(goarch string) ([]byte, error) {
+	arch, ok := machines[goarch]
+	if !ok {
+		return nil, fmt.Errorf("resources are not supported on windows/%s", goarch)
+	}
+
+	resources, err := res.resources()
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, errors.New("no resources to embed")
+	}
+
+	section, relocations := buildSection(resources)
+
+	const (
+		fileHeaderSize    = 20
+		sectionHeaderSize = 40
+		relocationSize    = 10
+	)
+
+	sectionOffset := uint32(fileHeaderSize + sectionHeaderSize)
+	relocationsOffset := sectionOffset + uint32(len(section))
+	symbolsOffset := relocationsOffset + uint32(len(relocations)*relocationSize)
+
+	var out bytes.Buffer
+	le := binary.LittleEndian
+
+	// file header
+	binary.Write(&out, le, arch.machine)
+	binary.Write(&out, le, uint16(1)) // sections
+	binary.Write(&out, le, uint32(0)) // timestamp, kept at zero for reproducible builds
+	binary.Write(&out, le, symbolsOffset)
+	binary.Write(&out, le, uint32(1)) // symbols
+	binary.Write(&out, le, uint16(0)) // no optional header in objects
+	binary.Write(&out, le, uint16(0x0004))
+
+	// section header
+	out.WriteString(".rsrc\x00\x00\x00")
+	binary.Write(&out, le, uint32(0)) // virtual size
+	binary.Write(&out, le, uint32(0)) // virtual address
+	binary.Write(&out, le, uint32(len(section)))
+	binary.Write(&out, le, sectionOffset)
+	binary.Write(&out, le, relocationsOffset)
+	binary.Write(&out, le, uint32(0)) // line numbers
+	binary.Write(&out, le, uint16(len(relocations)))
+	binary.Write(&out, le, uint16(0))
+	binary.Write(&out, le, uint32(0x40000040)) // initialized data, readable
+
+	out.Write(section)
+
+	for _, offset := range relocations {
+		binary.Write(&out, le, offset)
+		binary.Write(&out, le, uint32(0)) // the .rsrc section symbol
+		binary.Write(&out, le, arch.relocation)
+	}
+
+	// symbol table, a single static symbol for the section
+	out.WriteString(".rsrc\x00\x00\x00")
+	binary.Write(&out, le, uint32(0))
+	binary.Write(&out, le, uint16(1)) // section number
+	binary.Write(&out, le, uint16(0))
+	out.WriteByte(3) // IMAGE_SYM_CLASS_STATIC
+	out.WriteByte(0)
+
+	// empty string table
+	binary.Write(&out, le, uint32(4))
+
+	return out.Bytes(), nil
+}
+
+// buildSection lays out the type/ID/language directory tree followed by the data, it returns the section along
+// with the offsets of the data entry RVAs the linker has to relocate
+func buildSection(resources []resource) ([]byte, []uint32) {
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].typ != resources[j].typ {
+			return resources[i].typ < resources[j].typ
+		}
+		return resources[i].id < resources[j].id
+	})
+
+	var types []uint32
+	byType := map[uint32][]resource{}
+	for _, r := range resources {
+		if _, ok := byType[r.typ]; !ok {
+			types = append(types, r.typ)
+		}
+		byType[r.typ] = append(byType[r.typ], r)
+	}
+
+	const (
+		dirSize   = 16
+		entrySize = 8
+		dataSize  = 16
+		subdir    = 0x80000000
+	)
+
+	// sizes of each level, every directory is followed by its entries
+	rootSize := dirSize + entrySize*len(types)
+	idLevelSize := 0
+	for _, typ := range types {
+		idLevelSize += dirSize + entrySize*len(byType[typ])
+	}
+	langLevelSize := len(resources) * (dirSize + entrySize)
+	dataEntriesOffset := rootSize + idLevelSize + langLevelSize
+	dataOffset := dataEntriesOffset + len(resources)*dataSize
+
+	var out bytes.Buffer
+	le := binary.LittleEndian
+	writeDir := func(entries int) {
+		binary.Write(&out, le, uint32(0)) // characteristics
+		binary.Write(&out, le, uint32(0)) // timestamp
+		binary.Write(&out, le, uint32(0)) // version
+		binary.Write(&out, le, uint16(0)) // named entries
+		binary.Write(&out, le, uint16(entries))
+	}
+
+	writeDir(len(types))
+	next := rootSize
+	for _, typ := range types {
+		binary.Write(&out, le, typ)
+		binary.Write(&out, le, uint32(next)|subdir)
+		next += dirSize + entrySize*len(byType[typ])
+	}
+
+	langDir := rootSize + idLevelSize
+	for _, typ := range types {
+		writeDir(len(byType[typ]))
+		for _, r := range byType[typ] {
+			binary.Write(&out, le, r.id)
+			binary.Write(&out, le, uint32(langDir)|subdir)
+			langDir += dirSize + entrySize
+		}
+	}
+
+	for i := range resources {
+		writeDir(1)
+		binary.Write(&out, le, uint32(langEnUS))
+		binary.Write(&out, le, uint32(dataEntriesOffset+i*dataSize))
+	}
+
+	var relocations []uint32
+	offset := dataOffset
+	for _, typ := range types {
+		for _, r := range byType[typ] {
+			relocations = append(relocations, uint32(out.Len()))
+			binary.Write(&out, le, uint32(offset)) // relocated into an RVA by the linker
+			binary.Write(&out, le, uint32(len(r.data)))
+			binary.Write(&out, le, uint32(0)) // code page
+			binary.Write(&out, le, uint32(0))
+			offset += align(len(r.data), 8)
+		}
+	}
+
+	for _, typ := range types {
+		for _, r := range byType[typ] {
+			out.Write(r.data)
+			out.Write(make([]byte, align(len(r.data), 8)-len(r.data)))
+		}
+	}
+
+	return out.Bytes(), relocations
+}
+
+func align(n int, to int) int {
+	return (n + to - 1) / to * to
+}
@@ -0,0 +1,75 @@
+package winres
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"testing"
+)
+
+func testIcon() []byte {
+	image := bytes.Repeat([]byte{0xab}, 64)
+
+	var icon bytes.Buffer
+	binary.Write(&icon, binary.LittleEndian, []uint16{0, 1, 1})
+	icon.Write([]byte{16, 16, 0, 0})
+	binary.Write(&icon, binary.LittleEndian, []uint16{1, 32})
+	binary.Write(&icon, binary.LittleEndian, []uint32{uint32(len(image)), 22})
+	icon.Write(image)
+
+	return icon.Bytes()
+}
+
+func TestSyso(t *testing.T) {
+	res := &Resources{
+		Icon:     testIcon(),
+		Manifest: []byte(`<assembly xmlns="urn:schemas-microsoft-com:asm.v1" manifestVersion="1.0"/>`),
+		Version:  &VersionInfo{CompanyName: "Contoso Ltd", FileVersion: "10.0.19041.1"},
+	}
+
+	if err := res.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	syso, err := res.Syso("amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	object, err := pe.NewFile(bytes.NewReader(syso))
+	if err != nil {
+		t.Fatalf("syso is not a valid COFF object: %s", err)
+	}
+
+	section := object.Section(".rsrc")
+	if section == nil {
+		t.Fatal("missing .rsrc section")
+	}
+
+	// icon image, group icon, version and manifest
+	if len(section.Relocs) != 4 {
+		t.Fatalf("expected a relocation per resource, got %d", len(section.Relocs))
+	}
+
+	data, _ := section.Data()
+	if !bytes.Contains(data, utf16z("Contoso Ltd")) || !bytes.Contains(data, res.Manifest) {
+		t.Fatal("resources are missing from the section")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for name, res := range map[string]*Resources{
+		"icon":     {Icon: []byte("not an icon")},
+		"manifest": {Manifest: []byte("<assembly>")},
+		"version":  {Version: &VersionInfo{FileVersion: "1.2.3.4.5"}},
+	} {
+		if err := res.Validate(); err == nil {
+			t.Errorf("invalid %s should be rejected", name)
+		}
+	}
+
+	ms, ls, err := parseVersion("10.0.19041")
+	if err != nil || ms != 10<<16 || ls != 19041<<16 {
+		t.Fatalf("unexpected version %x.%x (%v)", ms, ls, err)
+	}
+}
@@ -0,0 +1,341 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	pb "github.com/ttpreport/ligolo-mp/v2/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const maxMessageSize = 20 * 1024 * 1024
+
+// Config is an operator file as exported by the server, e.g. alice_10.0.0.1:58008_ligolo-mp.json
+type Config struct {
+	Name   string
+	Server string
+	CA     []byte
+	Cert   struct {
+		Certificate []byte
+		Key         []byte
+	}
+}
+
+// LoadConfig reads an operator file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid operator file: %v", err)
+	}
+
+	if config.Server == "" || config.CA == nil || config.Cert.Certificate == nil || config.Cert.Key == nil {
+		return nil, errors.New("invalid operator file: server, CA or certificate is missing")
+	}
+
+	return &config, nil
+}
+
+// Client is a connection to the server as the operator of the config
+type Client struct {
+	conn     *grpc.ClientConn
+	client   pb.LigoloClient
+	metadata *pb.GetMetadataResp
+}
+
+// Dial connects to the server and checks it serves this version of the API
+func Dial(ctx context.Context, config *Config) (*Client, error) {
+	operatorCert, err := tls.X509KeyPair(config.Cert.Certificate, config.Cert.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	ca := x509.NewCertPool()
+	if ok := ca.AppendCertsFromPEM(config.CA); !ok {
+		return nil, errors.New("invalid CA certificate")
+	}
+
+	// the server certificate is only issued for the loopback address, so only the chain is verified
+	tlsConfig := &tls.Config{
+		Certificates:       []tls.Certificate{operatorCert},
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+
+			_, err = cert.Verify(x509.VerifyOptions{Roots: ca})
+			return err
+		},
+	}
+
+	conn, err := grpc.DialContext(ctx, config.Server,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize)),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		conn:   conn,
+		client: pb.NewLigoloClient(conn),
+	}
+
+	c.metadata, err = c.client.GetMetadata(ctx, &pb.Empty{})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if c.metadata.APIVersion != Version {
+		conn.Close()
+		return nil, fmt.Errorf("%w: server serves v%d, v%d is needed", ErrIncompatible, c.metadata.APIVersion, Version)
+	}
+
+	return c, nil
+}
+
+// Close disconnects from the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Raw is the whole gRPC service, calls not listed in the package documentation aren't covered by the version
+func (c *Client) Raw() pb.LigoloClient {
+	return c.client
+}
+
+// Metadata is what the server reported at Dial: the operator, the server config and the engagement
+func (c *Client) Metadata() *pb.GetMetadataResp {
+	return c.metadata
+}
+
+// Sessions lists every session of the server, connected or not
+func (c *Client) Sessions(ctx context.Context) ([]*pb.Session, error) {
+	r, err := c.client.GetSessions(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Sessions, nil
+}
+
+// Session finds a session by ID
+func (c *Client) Session(ctx context.Context, sessionID string) (*pb.Session, error) {
+	sessions, err := c.Sessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		if session.ID == sessionID {
+			return session, nil
+		}
+	}
+
+	return nil, fmt.Errorf("session '%s' not found", sessionID)
+}
+
+func (c *Client) StartRelay(ctx context.Context, sessionID string) error {
+	_, err := c.client.StartRelay(ctx, &pb.StartRelayReq{SessionID: sessionID})
+	return err
+}
+
+func (c *Client) StopRelay(ctx context.Context, sessionID string) error {
+	_, err := c.client.StopRelay(ctx, &pb.StopRelayReq{SessionID: sessionID})
+	return err
+}
+
+// AddRoute routes a network through a session, e.g. &pb.Route{Cidr: "10.0.0.0/24"}
+func (c *Client) AddRoute(ctx context.Context, sessionID string, route *pb.Route) error {
+	_, err := c.client.AddRoute(ctx, &pb.AddRouteReq{SessionID: sessionID, Route: route})
+	return err
+}
+
+func (c *Client) DelRoute(ctx context.Context, sessionID string, routeID string) error {
+	_, err := c.client.DelRoute(ctx, &pb.DelRouteReq{SessionID: sessionID, RouteID: routeID})
+	return err
+}
+
+// GenerateAgent builds an agent and waits for it, progress is called with every step of the build and may be nil
+func (c *Client) GenerateAgent(ctx context.Context, req *pb.GenerateAgentReq, progress func(string)) (*pb.GenerateAgentResp, error) {
+	stream, err := c.client.GenerateAgent(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return nil, errors.New("build ended without an agent")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if r.Progress != "" && progress != nil {
+			progress(r.Progress)
+		}
+
+		if r.AgentBinary != nil {
+			return r, nil
+		}
+	}
+}
+
+// Builds lists the agents built on the server
+func (c *Client) Builds(ctx context.Context) ([]*pb.AgentBuild, error) {
+	r, err := c.client.GetAgentBuilds(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Builds, nil
+}
+
+func (c *Client) DownloadBuild(ctx context.Context, buildID string) (*pb.DownloadAgentBuildResp, error) {
+	return c.client.DownloadAgentBuild(ctx, &pb.DownloadAgentBuildReq{ID: buildID})
+}
+
+// Certs lists the certificates of the server
+func (c *Client) Certs(ctx context.Context) ([]*pb.Cert, error) {
+	r, err := c.client.GetCerts(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Certs, nil
+}
+
+func (c *Client) RegenCert(ctx context.Context, name string) error {
+	_, err := c.client.RegenCert(ctx, &pb.RegenCertReq{Name: name})
+	return err
+}
+
+// RenewCert renews the certificate of the operator of the config, with the same key. The operator file keeps
+// working until the old certificate expires, write the new one to it before then
+func (c *Client) RenewCert(ctx context.Context) ([]byte, error) {
+	r, err := c.client.RenewOperatorCert(ctx, &pb.RenewOperatorCertReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Cert.Certificate, nil
+}
+
+// ExportServerState writes the whole server state to w, as an archive encrypted with passphrase, and returns how many
+// rows it holds. Only admins can export it
+func (c *Client) ExportServerState(ctx context.Context, passphrase string, w io.Writer) (int64, error) {
+	stream, err := c.client.ExportServerState(ctx, &pb.ExportServerStateReq{Passphrase: passphrase})
+	if err != nil {
+		return 0, err
+	}
+
+	var rows int64
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if r.Rows != 0 {
+			rows = r.Rows
+		}
+		if _, err := w.Write(r.Chunk); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// ImportServerState replaces the whole server state with an archive ExportServerState wrote. The server refuses
+// changes until it's restarted with the imported state, credentials it issued before stop working then
+func (c *Client) ImportServerState(ctx context.Context, passphrase string, r io.Reader) (*pb.ImportServerStateResp, error) {
+	stream, err := c.client.ImportServerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.Send(&pb.ImportServerStateReq{Passphrase: passphrase}); err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(chunk)
+		if n > 0 {
+			if err := stream.Send(&pb.ImportServerStateReq{Chunk: chunk[:n]}); err == io.EOF {
+				break // the server gave up, CloseAndRecv tells why
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// Events streams the events of the server until ctx is done or the connection drops, the channel is closed then
+func (c *Client) Events(ctx context.Context) (<-chan *pb.Event, error) {
+	stream, err := c.client.Join(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return forwardEvents(ctx, stream), nil
+}
+
+// Subscribe is Events for some topics only, e.g. "session.connected" or "build.finished", without announcing the
+// operator to others
+func (c *Client) Subscribe(ctx context.Context, topics ...string) (<-chan *pb.Event, error) {
+	stream, err := c.client.Subscribe(ctx, &pb.SubscribeReq{Topics: topics})
+	if err != nil {
+		return nil, err
+	}
+
+	return forwardEvents(ctx, stream), nil
+}
+
+func forwardEvents(ctx context.Context, stream pb.Ligolo_JoinClient) <-chan *pb.Event {
+	events := make(chan *pb.Event)
+	go func() {
+		defer close(events)
+
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
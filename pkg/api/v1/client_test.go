@@ -0,0 +1,41 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/ttpreport/ligolo-mp/v2/internal/certificate"
+	"github.com/ttpreport/ligolo-mp/v2/internal/operator"
+)
+
+func TestLoadConfig(t *testing.T) {
+	oper := &operator.Operator{
+		Name:   "alice",
+		Server: "127.0.0.1:58008",
+		CA:     []byte("ca"),
+		Cert:   &certificate.Certificate{Name: "alice", Certificate: []byte("cert"), Key: []byte("key")},
+	}
+
+	path, err := oper.ToFile(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if config.Name != oper.Name || config.Server != oper.Server || string(config.CA) != "ca" ||
+		string(config.Cert.Certificate) != "cert" || string(config.Cert.Key) != "key" {
+		t.Fatalf("config doesn't match the operator file: %+v", config)
+	}
+
+	oper.Cert = nil
+	if path, err = oper.ToFile(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Fatal("an operator file without a certificate was loaded")
+	}
+}
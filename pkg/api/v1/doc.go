@@ -0,0 +1,19 @@
+// Package api is the operator API of ligolo-mp for scripts and tools that drive a server without the TUI.
+//
+// The API is the Ligolo gRPC service of protobuf/ligolo.proto, served over mutual TLS with the operator
+// certificates the server exports. Version 1 covers:
+//
+//   - sessions: GetSessions, RenameSession, StartRelay, StopRelay, KillSession
+//   - routes: AddRoute, EditRoute, DelRoute
+//   - builds: GenerateAgent, CancelAgentBuild, GetAgentBuilds, DownloadAgentBuild
+//   - certificates: GetCerts, RegenCert, RenewOperatorCert
+//   - events: Join, Subscribe
+//
+// Within a version these calls keep their names and fields keep their numbers and meaning, new fields and calls
+// may be added. Anything else the service has is what the TUI uses and may change between releases, it stays
+// reachable through Client.Raw.
+//
+// Clients in other languages are generated from protobuf/ligolo.proto, e.g. for Python:
+//
+//	python -m grpc_tools.protoc -I protobuf --python_out=. --grpc_python_out=. protobuf/ligolo.proto
+package api
@@ -0,0 +1,9 @@
+package api
+
+import "errors"
+
+// Version is the operator API this package speaks, servers report theirs in GetMetadataResp.APIVersion
+const Version = uint32(1)
+
+// ErrIncompatible is returned by Dial when the server serves another version of the API, or none at all
+var ErrIncompatible = errors.New("incompatible operator API")
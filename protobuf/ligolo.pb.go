@@ -119,8 +119,10 @@ type Event struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type int32  `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
-	Data string `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Type    int32  `protobuf:"varint,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Data    string `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	Topic   string `protobuf:"bytes,3,opt,name=Topic,proto3" json:"Topic,omitempty"`
+	Subject string `protobuf:"bytes,4,opt,name=Subject,proto3" json:"Subject,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -169,27 +171,122 @@ func (x *Event) GetData() string {
 	return ""
 }
 
+func (x *Event) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Event) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type SubscribeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []string `protobuf:"bytes,1,rep,name=Topics,proto3" json:"Topics,omitempty"`
+}
+
+func (x *SubscribeReq) Reset() {
+	*x = SubscribeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeReq) ProtoMessage() {}
+
+func (x *SubscribeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeReq.ProtoReflect.Descriptor instead.
+func (*SubscribeReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubscribeReq) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
 type Session struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Alias       string                 `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
-	Hostname    string                 `protobuf:"bytes,3,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	Tun         *Tun                   `protobuf:"bytes,4,opt,name=Tun,proto3" json:"Tun,omitempty"`
-	IsConnected bool                   `protobuf:"varint,5,opt,name=IsConnected,proto3" json:"IsConnected,omitempty"`
-	IsRelaying  bool                   `protobuf:"varint,6,opt,name=IsRelaying,proto3" json:"IsRelaying,omitempty"`
-	Interfaces  []*Interface           `protobuf:"bytes,7,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
-	Redirectors []*Redirector          `protobuf:"bytes,8,rep,name=Redirectors,proto3" json:"Redirectors,omitempty"`
-	FirstSeen   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=FirstSeen,proto3" json:"FirstSeen,omitempty"`
-	LastSeen    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"`
+	ID               string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Alias            string                 `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
+	Hostname         string                 `protobuf:"bytes,3,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Tun              *Tun                   `protobuf:"bytes,4,opt,name=Tun,proto3" json:"Tun,omitempty"`
+	IsConnected      bool                   `protobuf:"varint,5,opt,name=IsConnected,proto3" json:"IsConnected,omitempty"`
+	IsRelaying       bool                   `protobuf:"varint,6,opt,name=IsRelaying,proto3" json:"IsRelaying,omitempty"`
+	Interfaces       []*Interface           `protobuf:"bytes,7,rep,name=Interfaces,proto3" json:"Interfaces,omitempty"`
+	Redirectors      []*Redirector          `protobuf:"bytes,8,rep,name=Redirectors,proto3" json:"Redirectors,omitempty"`
+	FirstSeen        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=FirstSeen,proto3" json:"FirstSeen,omitempty"`
+	LastSeen         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=LastSeen,proto3" json:"LastSeen,omitempty"`
+	Container        *Container             `protobuf:"bytes,11,opt,name=Container,proto3" json:"Container,omitempty"`
+	ClockSkewMs      int64                  `protobuf:"varint,12,opt,name=ClockSkewMs,proto3" json:"ClockSkewMs,omitempty"`
+	Link             *Link                  `protobuf:"bytes,13,opt,name=Link,proto3" json:"Link,omitempty"`
+	Beacon           *Beacon                `protobuf:"bytes,14,opt,name=Beacon,proto3" json:"Beacon,omitempty"`
+	Engagement       string                 `protobuf:"bytes,15,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+	Egress           string                 `protobuf:"bytes,16,opt,name=Egress,proto3" json:"Egress,omitempty"`
+	PreviousEgress   string                 `protobuf:"bytes,17,opt,name=PreviousEgress,proto3" json:"PreviousEgress,omitempty"`
+	EgressChanged    *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=EgressChanged,proto3" json:"EgressChanged,omitempty"`
+	Socks            *Socks                 `protobuf:"bytes,19,opt,name=Socks,proto3" json:"Socks,omitempty"`
+	Disconnection    *Disconnection         `protobuf:"bytes,20,opt,name=Disconnection,proto3" json:"Disconnection,omitempty"`
+	Exec             bool                   `protobuf:"varint,21,opt,name=Exec,proto3" json:"Exec,omitempty"`
+	Platform         string                 `protobuf:"bytes,22,opt,name=Platform,proto3" json:"Platform,omitempty"`
+	Version          string                 `protobuf:"bytes,23,opt,name=Version,proto3" json:"Version,omitempty"`
+	Neighbors        []*Neighbor            `protobuf:"bytes,24,rep,name=Neighbors,proto3" json:"Neighbors,omitempty"`
+	SystemRoutes     []*SystemRoute         `protobuf:"bytes,25,rep,name=SystemRoutes,proto3" json:"SystemRoutes,omitempty"`
+	NetworkRefreshed *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=NetworkRefreshed,proto3" json:"NetworkRefreshed,omitempty"`
+	EgressPath       string                 `protobuf:"bytes,27,opt,name=EgressPath,proto3" json:"EgressPath,omitempty"`
+	Handoff          *Handoff               `protobuf:"bytes,29,opt,name=Handoff,proto3" json:"Handoff,omitempty"`
+	Persistence      []string               `protobuf:"bytes,30,rep,name=Persistence,proto3" json:"Persistence,omitempty"`
+	Persisted        []string               `protobuf:"bytes,31,rep,name=Persisted,proto3" json:"Persisted,omitempty"`
+	Bridge           string                 `protobuf:"bytes,32,opt,name=Bridge,proto3" json:"Bridge,omitempty"`
+	Breakout         []*BreakoutRule        `protobuf:"bytes,33,rep,name=Breakout,proto3" json:"Breakout,omitempty"`
+	Host             *HostInfo              `protobuf:"bytes,34,opt,name=Host,proto3" json:"Host,omitempty"`
+	HostRefreshed    *timestamppb.Timestamp `protobuf:"bytes,35,opt,name=HostRefreshed,proto3" json:"HostRefreshed,omitempty"`
+	Crashes          []*Crash               `protobuf:"bytes,36,rep,name=Crashes,proto3" json:"Crashes,omitempty"`
+	BandwidthCap     int64                  `protobuf:"varint,37,opt,name=BandwidthCap,proto3" json:"BandwidthCap,omitempty"`
+	Tags             []string               `protobuf:"bytes,38,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Note             string                 `protobuf:"bytes,39,opt,name=Note,proto3" json:"Note,omitempty"`
+	Transport        string                 `protobuf:"bytes,40,opt,name=Transport,proto3" json:"Transport,omitempty"`
+	Listener         string                 `protobuf:"bytes,41,opt,name=Listener,proto3" json:"Listener,omitempty"`
+	Protocol         uint32                 `protobuf:"varint,42,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Outdated         bool                   `protobuf:"varint,43,opt,name=Outdated,proto3" json:"Outdated,omitempty"`
+	Compression      uint32                 `protobuf:"varint,44,opt,name=Compression,proto3" json:"Compression,omitempty"`
+	Claim            string                 `protobuf:"bytes,45,opt,name=Claim,proto3" json:"Claim,omitempty"`
 }
 
 func (x *Session) Reset() {
 	*x = Session{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[3]
+		mi := &file_protobuf_ligolo_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +299,7 @@ func (x *Session) String() string {
 func (*Session) ProtoMessage() {}
 
 func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[3]
+	mi := &file_protobuf_ligolo_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +312,7 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Session.ProtoReflect.Descriptor instead.
 func (*Session) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{3}
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Session) GetID() string {
@@ -288,215 +385,275 @@ func (x *Session) GetLastSeen() *timestamppb.Timestamp {
 	return nil
 }
 
-type Tun struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name   string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Routes []*Route `protobuf:"bytes,2,rep,name=Routes,proto3" json:"Routes,omitempty"`
+func (x *Session) GetContainer() *Container {
+	if x != nil {
+		return x.Container
+	}
+	return nil
 }
 
-func (x *Tun) Reset() {
-	*x = Tun{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Session) GetClockSkewMs() int64 {
+	if x != nil {
+		return x.ClockSkewMs
 	}
+	return 0
 }
 
-func (x *Tun) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Session) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
 }
 
-func (*Tun) ProtoMessage() {}
+func (x *Session) GetBeacon() *Beacon {
+	if x != nil {
+		return x.Beacon
+	}
+	return nil
+}
 
-func (x *Tun) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Session) GetEngagement() string {
+	if x != nil {
+		return x.Engagement
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Tun.ProtoReflect.Descriptor instead.
-func (*Tun) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{4}
+func (x *Session) GetEgress() string {
+	if x != nil {
+		return x.Egress
+	}
+	return ""
 }
 
-func (x *Tun) GetName() string {
+func (x *Session) GetPreviousEgress() string {
 	if x != nil {
-		return x.Name
+		return x.PreviousEgress
 	}
 	return ""
 }
 
-func (x *Tun) GetRoutes() []*Route {
+func (x *Session) GetEgressChanged() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Routes
+		return x.EgressChanged
 	}
 	return nil
 }
 
-type Interface struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Session) GetSocks() *Socks {
+	if x != nil {
+		return x.Socks
+	}
+	return nil
+}
 
-	Name string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	IPs  []string `protobuf:"bytes,2,rep,name=IPs,proto3" json:"IPs,omitempty"`
+func (x *Session) GetDisconnection() *Disconnection {
+	if x != nil {
+		return x.Disconnection
+	}
+	return nil
 }
 
-func (x *Interface) Reset() {
-	*x = Interface{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Session) GetExec() bool {
+	if x != nil {
+		return x.Exec
 	}
+	return false
 }
 
-func (x *Interface) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Session) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
 }
 
-func (*Interface) ProtoMessage() {}
+func (x *Session) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
 
-func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Session) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
-func (*Interface) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{5}
+func (x *Session) GetSystemRoutes() []*SystemRoute {
+	if x != nil {
+		return x.SystemRoutes
+	}
+	return nil
 }
 
-func (x *Interface) GetName() string {
+func (x *Session) GetNetworkRefreshed() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.NetworkRefreshed
+	}
+	return nil
+}
+
+func (x *Session) GetEgressPath() string {
+	if x != nil {
+		return x.EgressPath
 	}
 	return ""
 }
 
-func (x *Interface) GetIPs() []string {
+func (x *Session) GetHandoff() *Handoff {
 	if x != nil {
-		return x.IPs
+		return x.Handoff
 	}
 	return nil
 }
 
-type Route struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Session) GetPersistence() []string {
+	if x != nil {
+		return x.Persistence
+	}
+	return nil
+}
 
-	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Cidr       string `protobuf:"bytes,2,opt,name=Cidr,proto3" json:"Cidr,omitempty"`
-	IsLoopback bool   `protobuf:"varint,3,opt,name=IsLoopback,proto3" json:"IsLoopback,omitempty"`
-	Metric     int32  `protobuf:"varint,4,opt,name=Metric,proto3" json:"Metric,omitempty"`
+func (x *Session) GetPersisted() []string {
+	if x != nil {
+		return x.Persisted
+	}
+	return nil
 }
 
-func (x *Route) Reset() {
-	*x = Route{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Session) GetBridge() string {
+	if x != nil {
+		return x.Bridge
 	}
+	return ""
 }
 
-func (x *Route) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Session) GetBreakout() []*BreakoutRule {
+	if x != nil {
+		return x.Breakout
+	}
+	return nil
 }
 
-func (*Route) ProtoMessage() {}
+func (x *Session) GetHost() *HostInfo {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
 
-func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Session) GetHostRefreshed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HostRefreshed
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Route.ProtoReflect.Descriptor instead.
-func (*Route) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{6}
+func (x *Session) GetCrashes() []*Crash {
+	if x != nil {
+		return x.Crashes
+	}
+	return nil
 }
 
-func (x *Route) GetID() string {
+func (x *Session) GetBandwidthCap() int64 {
 	if x != nil {
-		return x.ID
+		return x.BandwidthCap
+	}
+	return 0
+}
+
+func (x *Session) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Session) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
 	return ""
 }
 
-func (x *Route) GetCidr() string {
+func (x *Session) GetTransport() string {
 	if x != nil {
-		return x.Cidr
+		return x.Transport
 	}
 	return ""
 }
 
-func (x *Route) GetIsLoopback() bool {
+func (x *Session) GetListener() string {
 	if x != nil {
-		return x.IsLoopback
+		return x.Listener
+	}
+	return ""
+}
+
+func (x *Session) GetProtocol() uint32 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *Session) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
 	}
 	return false
 }
 
-func (x *Route) GetMetric() int32 {
+func (x *Session) GetCompression() uint32 {
 	if x != nil {
-		return x.Metric
+		return x.Compression
 	}
 	return 0
 }
 
-type Redirector struct {
+func (x *Session) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+type Crash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Protocol string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	From     string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
-	To       string `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	Where     string                 `protobuf:"bytes,1,opt,name=Where,proto3" json:"Where,omitempty"`
+	Panic     string                 `protobuf:"bytes,2,opt,name=Panic,proto3" json:"Panic,omitempty"`
+	Frame     string                 `protobuf:"bytes,3,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	StackHash string                 `protobuf:"bytes,4,opt,name=StackHash,proto3" json:"StackHash,omitempty"`
+	Count     uint32                 `protobuf:"varint,5,opt,name=Count,proto3" json:"Count,omitempty"`
+	First     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=First,proto3" json:"First,omitempty"`
+	Last      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=Last,proto3" json:"Last,omitempty"`
 }
 
-func (x *Redirector) Reset() {
-	*x = Redirector{}
+func (x *Crash) Reset() {
+	*x = Crash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[7]
+		mi := &file_protobuf_ligolo_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Redirector) String() string {
+func (x *Crash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Redirector) ProtoMessage() {}
+func (*Crash) ProtoMessage() {}
 
-func (x *Redirector) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[7]
+func (x *Crash) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,140 +664,92 @@ func (x *Redirector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Redirector.ProtoReflect.Descriptor instead.
-func (*Redirector) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Crash.ProtoReflect.Descriptor instead.
+func (*Crash) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Redirector) GetID() string {
+func (x *Crash) GetWhere() string {
 	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *Redirector) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
+		return x.Where
 	}
 	return ""
 }
 
-func (x *Redirector) GetFrom() string {
+func (x *Crash) GetPanic() string {
 	if x != nil {
-		return x.From
+		return x.Panic
 	}
 	return ""
 }
 
-func (x *Redirector) GetTo() string {
+func (x *Crash) GetFrame() string {
 	if x != nil {
-		return x.To
+		return x.Frame
 	}
 	return ""
 }
 
-type Cert struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	ExpiryDate  string `protobuf:"bytes,2,opt,name=ExpiryDate,proto3" json:"ExpiryDate,omitempty"`
-	Certificate []byte `protobuf:"bytes,3,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
-	Key         []byte `protobuf:"bytes,4,opt,name=Key,proto3" json:"Key,omitempty"`
-}
-
-func (x *Cert) Reset() {
-	*x = Cert{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Cert) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Cert) ProtoMessage() {}
-
-func (x *Cert) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Cert.ProtoReflect.Descriptor instead.
-func (*Cert) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Cert) GetName() string {
+func (x *Crash) GetStackHash() string {
 	if x != nil {
-		return x.Name
+		return x.StackHash
 	}
 	return ""
 }
 
-func (x *Cert) GetExpiryDate() string {
+func (x *Crash) GetCount() uint32 {
 	if x != nil {
-		return x.ExpiryDate
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *Cert) GetCertificate() []byte {
+func (x *Crash) GetFirst() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Certificate
+		return x.First
 	}
 	return nil
 }
 
-func (x *Cert) GetKey() []byte {
+func (x *Crash) GetLast() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Key
+		return x.Last
 	}
 	return nil
 }
 
-type Operator struct {
+type HostInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	IsAdmin  bool   `protobuf:"varint,2,opt,name=IsAdmin,proto3" json:"IsAdmin,omitempty"`
-	Server   string `protobuf:"bytes,3,opt,name=Server,proto3" json:"Server,omitempty"`
-	IsOnline bool   `protobuf:"varint,4,opt,name=IsOnline,proto3" json:"IsOnline,omitempty"`
-	Cert     *Cert  `protobuf:"bytes,5,opt,name=Cert,proto3" json:"Cert,omitempty"`
-	CA       []byte `protobuf:"bytes,6,opt,name=CA,proto3" json:"CA,omitempty"`
+	OS         string   `protobuf:"bytes,1,opt,name=OS,proto3" json:"OS,omitempty"`
+	Username   string   `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	Privileged bool     `protobuf:"varint,3,opt,name=Privileged,proto3" json:"Privileged,omitempty"`
+	Privileges []string `protobuf:"bytes,4,rep,name=Privileges,proto3" json:"Privileges,omitempty"`
+	Domain     string   `protobuf:"bytes,5,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	DNSServers []string `protobuf:"bytes,6,rep,name=DNSServers,proto3" json:"DNSServers,omitempty"`
+	Gateways   []string `protobuf:"bytes,7,rep,name=Gateways,proto3" json:"Gateways,omitempty"`
+	Errors     []string `protobuf:"bytes,8,rep,name=Errors,proto3" json:"Errors,omitempty"`
 }
 
-func (x *Operator) Reset() {
-	*x = Operator{}
+func (x *HostInfo) Reset() {
+	*x = HostInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[9]
+		mi := &file_protobuf_ligolo_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operator) String() string {
+func (x *HostInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operator) ProtoMessage() {}
+func (*HostInfo) ProtoMessage() {}
 
-func (x *Operator) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[9]
+func (x *HostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,79 +760,94 @@ func (x *Operator) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operator.ProtoReflect.Descriptor instead.
-func (*Operator) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use HostInfo.ProtoReflect.Descriptor instead.
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Operator) GetName() string {
+func (x *HostInfo) GetOS() string {
 	if x != nil {
-		return x.Name
+		return x.OS
 	}
 	return ""
 }
 
-func (x *Operator) GetIsAdmin() bool {
+func (x *HostInfo) GetUsername() string {
 	if x != nil {
-		return x.IsAdmin
+		return x.Username
+	}
+	return ""
+}
+
+func (x *HostInfo) GetPrivileged() bool {
+	if x != nil {
+		return x.Privileged
 	}
 	return false
 }
 
-func (x *Operator) GetServer() string {
+func (x *HostInfo) GetPrivileges() []string {
 	if x != nil {
-		return x.Server
+		return x.Privileges
+	}
+	return nil
+}
+
+func (x *HostInfo) GetDomain() string {
+	if x != nil {
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *Operator) GetIsOnline() bool {
+func (x *HostInfo) GetDNSServers() []string {
 	if x != nil {
-		return x.IsOnline
+		return x.DNSServers
 	}
-	return false
+	return nil
 }
 
-func (x *Operator) GetCert() *Cert {
+func (x *HostInfo) GetGateways() []string {
 	if x != nil {
-		return x.Cert
+		return x.Gateways
 	}
 	return nil
 }
 
-func (x *Operator) GetCA() []byte {
+func (x *HostInfo) GetErrors() []string {
 	if x != nil {
-		return x.CA
+		return x.Errors
 	}
 	return nil
 }
 
-type Config struct {
+type Disconnection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OperatorServer string `protobuf:"bytes,1,opt,name=OperatorServer,proto3" json:"OperatorServer,omitempty"`
-	AgentServer    string `protobuf:"bytes,2,opt,name=AgentServer,proto3" json:"AgentServer,omitempty"`
+	Reason string                 `protobuf:"bytes,1,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Note   string                 `protobuf:"bytes,2,opt,name=Note,proto3" json:"Note,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=At,proto3" json:"At,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *Disconnection) Reset() {
+	*x = Disconnection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[10]
+		mi := &file_protobuf_ligolo_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config) String() string {
+func (x *Disconnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*Disconnection) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[10]
+func (x *Disconnection) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,54 +858,61 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Disconnection.ProtoReflect.Descriptor instead.
+func (*Disconnection) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Config) GetOperatorServer() string {
+func (x *Disconnection) GetReason() string {
 	if x != nil {
-		return x.OperatorServer
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Config) GetAgentServer() string {
+func (x *Disconnection) GetNote() string {
 	if x != nil {
-		return x.AgentServer
+		return x.Note
 	}
 	return ""
 }
 
-type Traceroute struct {
+func (x *Disconnection) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type Handoff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsInternal bool   `protobuf:"varint,1,opt,name=IsInternal,proto3" json:"IsInternal,omitempty"`
-	Session    string `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
-	Iface      string `protobuf:"bytes,3,opt,name=Iface,proto3" json:"Iface,omitempty"`
-	Via        string `protobuf:"bytes,4,opt,name=Via,proto3" json:"Via,omitempty"`
-	Metric     int32  `protobuf:"varint,5,opt,name=Metric,proto3" json:"Metric,omitempty"`
+	From      string                 `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To        string                 `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	Note      string                 `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	Layout    string                 `protobuf:"bytes,4,opt,name=Layout,proto3" json:"Layout,omitempty"`
+	Requested *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Requested,proto3" json:"Requested,omitempty"`
 }
 
-func (x *Traceroute) Reset() {
-	*x = Traceroute{}
+func (x *Handoff) Reset() {
+	*x = Handoff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[11]
+		mi := &file_protobuf_ligolo_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Traceroute) String() string {
+func (x *Handoff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Traceroute) ProtoMessage() {}
+func (*Handoff) ProtoMessage() {}
 
-func (x *Traceroute) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[11]
+func (x *Handoff) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,74 +923,75 @@ func (x *Traceroute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Traceroute.ProtoReflect.Descriptor instead.
-func (*Traceroute) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Handoff.ProtoReflect.Descriptor instead.
+func (*Handoff) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Traceroute) GetIsInternal() bool {
+func (x *Handoff) GetFrom() string {
 	if x != nil {
-		return x.IsInternal
+		return x.From
 	}
-	return false
+	return ""
 }
 
-func (x *Traceroute) GetSession() string {
+func (x *Handoff) GetTo() string {
 	if x != nil {
-		return x.Session
+		return x.To
 	}
 	return ""
 }
 
-func (x *Traceroute) GetIface() string {
+func (x *Handoff) GetNote() string {
 	if x != nil {
-		return x.Iface
+		return x.Note
 	}
 	return ""
 }
 
-func (x *Traceroute) GetVia() string {
+func (x *Handoff) GetLayout() string {
 	if x != nil {
-		return x.Via
+		return x.Layout
 	}
 	return ""
 }
 
-func (x *Traceroute) GetMetric() int32 {
+func (x *Handoff) GetRequested() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Metric
+		return x.Requested
 	}
-	return 0
+	return nil
 }
 
-type AddRedirectorReq struct {
+type Beacon struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	Protocol  string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
-	From      string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
-	To        string `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	IntervalMs   int64  `protobuf:"varint,1,opt,name=IntervalMs,proto3" json:"IntervalMs,omitempty"`
+	Jitter       uint32 `protobuf:"varint,2,opt,name=Jitter,proto3" json:"Jitter,omitempty"`
+	Awake        bool   `protobuf:"varint,3,opt,name=Awake,proto3" json:"Awake,omitempty"`
+	WorkingHours string `protobuf:"bytes,4,opt,name=WorkingHours,proto3" json:"WorkingHours,omitempty"`
+	Zone         int32  `protobuf:"varint,5,opt,name=Zone,proto3" json:"Zone,omitempty"`
 }
 
-func (x *AddRedirectorReq) Reset() {
-	*x = AddRedirectorReq{}
+func (x *Beacon) Reset() {
+	*x = Beacon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[12]
+		mi := &file_protobuf_ligolo_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddRedirectorReq) String() string {
+func (x *Beacon) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRedirectorReq) ProtoMessage() {}
+func (*Beacon) ProtoMessage() {}
 
-func (x *AddRedirectorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[12]
+func (x *Beacon) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,65 +1002,73 @@ func (x *AddRedirectorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRedirectorReq.ProtoReflect.Descriptor instead.
-func (*AddRedirectorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Beacon.ProtoReflect.Descriptor instead.
+func (*Beacon) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AddRedirectorReq) GetSessionID() string {
+func (x *Beacon) GetIntervalMs() int64 {
 	if x != nil {
-		return x.SessionID
+		return x.IntervalMs
 	}
-	return ""
+	return 0
 }
 
-func (x *AddRedirectorReq) GetProtocol() string {
+func (x *Beacon) GetJitter() uint32 {
 	if x != nil {
-		return x.Protocol
+		return x.Jitter
 	}
-	return ""
+	return 0
 }
 
-func (x *AddRedirectorReq) GetFrom() string {
+func (x *Beacon) GetAwake() bool {
 	if x != nil {
-		return x.From
+		return x.Awake
 	}
-	return ""
+	return false
 }
 
-func (x *AddRedirectorReq) GetTo() string {
+func (x *Beacon) GetWorkingHours() string {
 	if x != nil {
-		return x.To
+		return x.WorkingHours
 	}
 	return ""
 }
 
-type DelRedirectorReq struct {
+func (x *Beacon) GetZone() int32 {
+	if x != nil {
+		return x.Zone
+	}
+	return 0
+}
+
+type Socks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID    string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	RedirectorID string `protobuf:"bytes,2,opt,name=RedirectorID,proto3" json:"RedirectorID,omitempty"`
+	Address   string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	Listening string `protobuf:"bytes,3,opt,name=Listening,proto3" json:"Listening,omitempty"`
 }
 
-func (x *DelRedirectorReq) Reset() {
-	*x = DelRedirectorReq{}
+func (x *Socks) Reset() {
+	*x = Socks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[13]
+		mi := &file_protobuf_ligolo_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelRedirectorReq) String() string {
+func (x *Socks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelRedirectorReq) ProtoMessage() {}
+func (*Socks) ProtoMessage() {}
 
-func (x *DelRedirectorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[13]
+func (x *Socks) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,50 +1079,60 @@ func (x *DelRedirectorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelRedirectorReq.ProtoReflect.Descriptor instead.
-func (*DelRedirectorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Socks.ProtoReflect.Descriptor instead.
+func (*Socks) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DelRedirectorReq) GetSessionID() string {
+func (x *Socks) GetAddress() string {
 	if x != nil {
-		return x.SessionID
+		return x.Address
 	}
 	return ""
 }
 
-func (x *DelRedirectorReq) GetRedirectorID() string {
+func (x *Socks) GetUsername() string {
 	if x != nil {
-		return x.RedirectorID
+		return x.Username
 	}
 	return ""
 }
 
-type GetSessionsResp struct {
+func (x *Socks) GetListening() string {
+	if x != nil {
+		return x.Listening
+	}
+	return ""
+}
+
+type Link struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+	RttUs       int64  `protobuf:"varint,1,opt,name=RttUs,proto3" json:"RttUs,omitempty"`
+	Bandwidth   int64  `protobuf:"varint,2,opt,name=Bandwidth,proto3" json:"Bandwidth,omitempty"`
+	WindowSize  uint32 `protobuf:"varint,3,opt,name=WindowSize,proto3" json:"WindowSize,omitempty"`
+	KeepAliveMs int64  `protobuf:"varint,4,opt,name=KeepAliveMs,proto3" json:"KeepAliveMs,omitempty"`
 }
 
-func (x *GetSessionsResp) Reset() {
-	*x = GetSessionsResp{}
+func (x *Link) Reset() {
+	*x = Link{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[14]
+		mi := &file_protobuf_ligolo_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSessionsResp) String() string {
+func (x *Link) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSessionsResp) ProtoMessage() {}
+func (*Link) ProtoMessage() {}
 
-func (x *GetSessionsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[14]
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,44 +1143,71 @@ func (x *GetSessionsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSessionsResp.ProtoReflect.Descriptor instead.
-func (*GetSessionsResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetSessionsResp) GetSessions() []*Session {
+func (x *Link) GetRttUs() int64 {
 	if x != nil {
-		return x.Sessions
+		return x.RttUs
 	}
-	return nil
+	return 0
 }
 
-type RenameSessionReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	Alias     string `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
+func (x *Link) GetBandwidth() int64 {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return 0
 }
 
-func (x *RenameSessionReq) Reset() {
-	*x = RenameSessionReq{}
+func (x *Link) GetWindowSize() uint32 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+func (x *Link) GetKeepAliveMs() int64 {
+	if x != nil {
+		return x.KeepAliveMs
+	}
+	return 0
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SessionID string                 `protobuf:"bytes,2,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Note      string                 `protobuf:"bytes,4,opt,name=Note,proto3" json:"Note,omitempty"`
+	Operator  string                 `protobuf:"bytes,5,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Size      int64                  `protobuf:"varint,6,opt,name=Size,proto3" json:"Size,omitempty"`
+	Sha256    string                 `protobuf:"bytes,7,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=Created,proto3" json:"Created,omitempty"`
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[15]
+		mi := &file_protobuf_ligolo_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameSessionReq) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameSessionReq) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *RenameSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[15]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,97 +1218,95 @@ func (x *RenameSessionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameSessionReq.ProtoReflect.Descriptor instead.
-func (*RenameSessionReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RenameSessionReq) GetSessionID() string {
+func (x *Attachment) GetID() string {
 	if x != nil {
-		return x.SessionID
+		return x.ID
 	}
 	return ""
 }
 
-func (x *RenameSessionReq) GetAlias() string {
+func (x *Attachment) GetSessionID() string {
 	if x != nil {
-		return x.Alias
+		return x.SessionID
 	}
 	return ""
 }
 
-type StartRelayReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *StartRelayReq) Reset() {
-	*x = StartRelayReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Attachment) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
+	return ""
 }
 
-func (x *StartRelayReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Attachment) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
 }
 
-func (*StartRelayReq) ProtoMessage() {}
-
-func (x *StartRelayReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StartRelayReq.ProtoReflect.Descriptor instead.
-func (*StartRelayReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{16}
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
 }
 
-func (x *StartRelayReq) GetSessionID() string {
+func (x *Attachment) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SessionID
+		return x.Created
 	}
-	return ""
+	return nil
 }
 
-type StopRelayReq struct {
+type Container struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Runtime     string `protobuf:"bytes,1,opt,name=Runtime,proto3" json:"Runtime,omitempty"`
+	HostNetwork bool   `protobuf:"varint,2,opt,name=HostNetwork,proto3" json:"HostNetwork,omitempty"`
+	HostNetns   string `protobuf:"bytes,3,opt,name=HostNetns,proto3" json:"HostNetns,omitempty"`
+	ViaHost     bool   `protobuf:"varint,4,opt,name=ViaHost,proto3" json:"ViaHost,omitempty"`
 }
 
-func (x *StopRelayReq) Reset() {
-	*x = StopRelayReq{}
+func (x *Container) Reset() {
+	*x = Container{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[17]
+		mi := &file_protobuf_ligolo_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopRelayReq) String() string {
+func (x *Container) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRelayReq) ProtoMessage() {}
+func (*Container) ProtoMessage() {}
 
-func (x *StopRelayReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[17]
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,43 +1317,68 @@ func (x *StopRelayReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRelayReq.ProtoReflect.Descriptor instead.
-func (*StopRelayReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StopRelayReq) GetSessionID() string {
+func (x *Container) GetRuntime() string {
 	if x != nil {
-		return x.SessionID
+		return x.Runtime
 	}
 	return ""
 }
 
-type KillSessionReq struct {
+func (x *Container) GetHostNetwork() bool {
+	if x != nil {
+		return x.HostNetwork
+	}
+	return false
+}
+
+func (x *Container) GetHostNetns() string {
+	if x != nil {
+		return x.HostNetns
+	}
+	return ""
+}
+
+func (x *Container) GetViaHost() bool {
+	if x != nil {
+		return x.ViaHost
+	}
+	return false
+}
+
+type Tun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Routes      []*Route `protobuf:"bytes,2,rep,name=Routes,proto3" json:"Routes,omitempty"`
+	SpoofSource bool     `protobuf:"varint,3,opt,name=SpoofSource,proto3" json:"SpoofSource,omitempty"`
+	Mirror      string   `protobuf:"bytes,4,opt,name=Mirror,proto3" json:"Mirror,omitempty"`
+	Compress    bool     `protobuf:"varint,5,opt,name=Compress,proto3" json:"Compress,omitempty"`
 }
 
-func (x *KillSessionReq) Reset() {
-	*x = KillSessionReq{}
+func (x *Tun) Reset() {
+	*x = Tun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[18]
+		mi := &file_protobuf_ligolo_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KillSessionReq) String() string {
+func (x *Tun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillSessionReq) ProtoMessage() {}
+func (*Tun) ProtoMessage() {}
 
-func (x *KillSessionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[18]
+func (x *Tun) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,44 +1389,75 @@ func (x *KillSessionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillSessionReq.ProtoReflect.Descriptor instead.
-func (*KillSessionReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Tun.ProtoReflect.Descriptor instead.
+func (*Tun) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *KillSessionReq) GetSessionID() string {
+func (x *Tun) GetName() string {
 	if x != nil {
-		return x.SessionID
+		return x.Name
 	}
 	return ""
 }
 
-type AddRouteReq struct {
+func (x *Tun) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *Tun) GetSpoofSource() bool {
+	if x != nil {
+		return x.SpoofSource
+	}
+	return false
+}
+
+func (x *Tun) GetMirror() string {
+	if x != nil {
+		return x.Mirror
+	}
+	return ""
+}
+
+func (x *Tun) GetCompress() bool {
+	if x != nil {
+		return x.Compress
+	}
+	return false
+}
+
+type Interface struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	Route     *Route `protobuf:"bytes,2,opt,name=Route,proto3" json:"Route,omitempty"`
+	Name       string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	IPs        []string `protobuf:"bytes,2,rep,name=IPs,proto3" json:"IPs,omitempty"`
+	Index      int32    `protobuf:"varint,3,opt,name=Index,proto3" json:"Index,omitempty"`
+	Gateways   []string `protobuf:"bytes,4,rep,name=Gateways,proto3" json:"Gateways,omitempty"`
+	DNSServers []string `protobuf:"bytes,5,rep,name=DNSServers,proto3" json:"DNSServers,omitempty"`
 }
 
-func (x *AddRouteReq) Reset() {
-	*x = AddRouteReq{}
+func (x *Interface) Reset() {
+	*x = Interface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[19]
+		mi := &file_protobuf_ligolo_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddRouteReq) String() string {
+func (x *Interface) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRouteReq) ProtoMessage() {}
+func (*Interface) ProtoMessage() {}
 
-func (x *AddRouteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[19]
+func (x *Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,52 +1468,74 @@ func (x *AddRouteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRouteReq.ProtoReflect.Descriptor instead.
-func (*AddRouteReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
+func (*Interface) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AddRouteReq) GetSessionID() string {
+func (x *Interface) GetName() string {
 	if x != nil {
-		return x.SessionID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AddRouteReq) GetRoute() *Route {
+func (x *Interface) GetIPs() []string {
 	if x != nil {
-		return x.Route
+		return x.IPs
 	}
 	return nil
 }
 
-type EditRouteReq struct {
+func (x *Interface) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Interface) GetGateways() []string {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *Interface) GetDNSServers() []string {
+	if x != nil {
+		return x.DNSServers
+	}
+	return nil
+}
+
+type Neighbor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	RouteID   string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
-	Route     *Route `protobuf:"bytes,3,opt,name=Route,proto3" json:"Route,omitempty"`
+	Address      string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	HardwareAddr string `protobuf:"bytes,2,opt,name=HardwareAddr,proto3" json:"HardwareAddr,omitempty"`
+	Interface    string `protobuf:"bytes,3,opt,name=Interface,proto3" json:"Interface,omitempty"`
+	State        string `protobuf:"bytes,4,opt,name=State,proto3" json:"State,omitempty"`
 }
 
-func (x *EditRouteReq) Reset() {
-	*x = EditRouteReq{}
+func (x *Neighbor) Reset() {
+	*x = Neighbor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[20]
+		mi := &file_protobuf_ligolo_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EditRouteReq) String() string {
+func (x *Neighbor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditRouteReq) ProtoMessage() {}
+func (*Neighbor) ProtoMessage() {}
 
-func (x *EditRouteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[20]
+func (x *Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,59 +1546,67 @@ func (x *EditRouteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditRouteReq.ProtoReflect.Descriptor instead.
-func (*EditRouteReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EditRouteReq) GetSessionID() string {
+func (x *Neighbor) GetAddress() string {
 	if x != nil {
-		return x.SessionID
+		return x.Address
 	}
 	return ""
 }
 
-func (x *EditRouteReq) GetRouteID() string {
+func (x *Neighbor) GetHardwareAddr() string {
 	if x != nil {
-		return x.RouteID
+		return x.HardwareAddr
 	}
 	return ""
 }
 
-func (x *EditRouteReq) GetRoute() *Route {
+func (x *Neighbor) GetInterface() string {
 	if x != nil {
-		return x.Route
+		return x.Interface
 	}
-	return nil
+	return ""
 }
 
-type MoveRouteReq struct {
+func (x *Neighbor) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type SystemRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldSessionID string `protobuf:"bytes,1,opt,name=OldSessionID,proto3" json:"OldSessionID,omitempty"`
-	RouteID      string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
-	NewSessionID string `protobuf:"bytes,3,opt,name=NewSessionID,proto3" json:"NewSessionID,omitempty"`
+	Destination string `protobuf:"bytes,1,opt,name=Destination,proto3" json:"Destination,omitempty"`
+	Gateway     string `protobuf:"bytes,2,opt,name=Gateway,proto3" json:"Gateway,omitempty"`
+	Interface   string `protobuf:"bytes,3,opt,name=Interface,proto3" json:"Interface,omitempty"`
+	Metric      int32  `protobuf:"varint,4,opt,name=Metric,proto3" json:"Metric,omitempty"`
 }
 
-func (x *MoveRouteReq) Reset() {
-	*x = MoveRouteReq{}
+func (x *SystemRoute) Reset() {
+	*x = SystemRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[21]
+		mi := &file_protobuf_ligolo_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MoveRouteReq) String() string {
+func (x *SystemRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveRouteReq) ProtoMessage() {}
+func (*SystemRoute) ProtoMessage() {}
 
-func (x *MoveRouteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[21]
+func (x *SystemRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,58 +1617,75 @@ func (x *MoveRouteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveRouteReq.ProtoReflect.Descriptor instead.
-func (*MoveRouteReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SystemRoute.ProtoReflect.Descriptor instead.
+func (*SystemRoute) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MoveRouteReq) GetOldSessionID() string {
+func (x *SystemRoute) GetDestination() string {
 	if x != nil {
-		return x.OldSessionID
+		return x.Destination
 	}
 	return ""
 }
 
-func (x *MoveRouteReq) GetRouteID() string {
+func (x *SystemRoute) GetGateway() string {
 	if x != nil {
-		return x.RouteID
+		return x.Gateway
 	}
 	return ""
 }
 
-func (x *MoveRouteReq) GetNewSessionID() string {
+func (x *SystemRoute) GetInterface() string {
 	if x != nil {
-		return x.NewSessionID
+		return x.Interface
 	}
 	return ""
 }
 
-type DelRouteReq struct {
+func (x *SystemRoute) GetMetric() int32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+type Route struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	RouteID   string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
+	ID          string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Cidr        string                 `protobuf:"bytes,2,opt,name=Cidr,proto3" json:"Cidr,omitempty"`
+	IsLoopback  bool                   `protobuf:"varint,3,opt,name=IsLoopback,proto3" json:"IsLoopback,omitempty"`
+	Metric      int32                  `protobuf:"varint,4,opt,name=Metric,proto3" json:"Metric,omitempty"`
+	Ports       string                 `protobuf:"bytes,5,opt,name=Ports,proto3" json:"Ports,omitempty"`
+	IsExclusion bool                   `protobuf:"varint,6,opt,name=IsExclusion,proto3" json:"IsExclusion,omitempty"`
+	Flows       int64                  `protobuf:"varint,7,opt,name=Flows,proto3" json:"Flows,omitempty"`
+	Bytes       int64                  `protobuf:"varint,8,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
+	LastUsed    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=LastUsed,proto3" json:"LastUsed,omitempty"`
+	Tags        []string               `protobuf:"bytes,10,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Note        string                 `protobuf:"bytes,11,opt,name=Note,proto3" json:"Note,omitempty"`
+	Compression string                 `protobuf:"bytes,12,opt,name=Compression,proto3" json:"Compression,omitempty"`
 }
 
-func (x *DelRouteReq) Reset() {
-	*x = DelRouteReq{}
+func (x *Route) Reset() {
+	*x = Route{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[22]
+		mi := &file_protobuf_ligolo_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelRouteReq) String() string {
+func (x *Route) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelRouteReq) ProtoMessage() {}
+func (*Route) ProtoMessage() {}
 
-func (x *DelRouteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[22]
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,137 +1696,121 @@ func (x *DelRouteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelRouteReq.ProtoReflect.Descriptor instead.
-func (*DelRouteReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DelRouteReq) GetSessionID() string {
+func (x *Route) GetID() string {
 	if x != nil {
-		return x.SessionID
+		return x.ID
 	}
 	return ""
 }
 
-func (x *DelRouteReq) GetRouteID() string {
+func (x *Route) GetCidr() string {
 	if x != nil {
-		return x.RouteID
+		return x.Cidr
 	}
 	return ""
 }
 
-type GenerateAgentReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Servers        string `protobuf:"bytes,1,opt,name=Servers,proto3" json:"Servers,omitempty"`
-	GOOS           string `protobuf:"bytes,2,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
-	GOARCH         string `protobuf:"bytes,3,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
-	Obfuscate      bool   `protobuf:"varint,4,opt,name=Obfuscate,proto3" json:"Obfuscate,omitempty"`
-	ProxyServer    string `protobuf:"bytes,5,opt,name=ProxyServer,proto3" json:"ProxyServer,omitempty"`
-	IgnoreEnvProxy bool   `protobuf:"varint,6,opt,name=IgnoreEnvProxy,proto3" json:"IgnoreEnvProxy,omitempty"`
-}
-
-func (x *GenerateAgentReq) Reset() {
-	*x = GenerateAgentReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Route) GetIsLoopback() bool {
+	if x != nil {
+		return x.IsLoopback
 	}
+	return false
 }
 
-func (x *GenerateAgentReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Route) GetMetric() int32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
 }
 
-func (*GenerateAgentReq) ProtoMessage() {}
-
-func (x *GenerateAgentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Route) GetPorts() string {
+	if x != nil {
+		return x.Ports
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GenerateAgentReq.ProtoReflect.Descriptor instead.
-func (*GenerateAgentReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{23}
+func (x *Route) GetIsExclusion() bool {
+	if x != nil {
+		return x.IsExclusion
+	}
+	return false
 }
 
-func (x *GenerateAgentReq) GetServers() string {
+func (x *Route) GetFlows() int64 {
 	if x != nil {
-		return x.Servers
+		return x.Flows
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateAgentReq) GetGOOS() string {
+func (x *Route) GetBytes() int64 {
 	if x != nil {
-		return x.GOOS
+		return x.Bytes
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateAgentReq) GetGOARCH() string {
+func (x *Route) GetLastUsed() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GOARCH
+		return x.LastUsed
 	}
-	return ""
+	return nil
 }
 
-func (x *GenerateAgentReq) GetObfuscate() bool {
+func (x *Route) GetTags() []string {
 	if x != nil {
-		return x.Obfuscate
+		return x.Tags
 	}
-	return false
+	return nil
 }
 
-func (x *GenerateAgentReq) GetProxyServer() string {
+func (x *Route) GetNote() string {
 	if x != nil {
-		return x.ProxyServer
+		return x.Note
 	}
 	return ""
 }
 
-func (x *GenerateAgentReq) GetIgnoreEnvProxy() bool {
+func (x *Route) GetCompression() string {
 	if x != nil {
-		return x.IgnoreEnvProxy
+		return x.Compression
 	}
-	return false
+	return ""
 }
 
-type GenerateAgentResp struct {
+type RouteProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AgentBinary []byte `protobuf:"bytes,1,opt,name=AgentBinary,proto3" json:"AgentBinary,omitempty"`
+	Name   string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Routes []*Route `protobuf:"bytes,2,rep,name=Routes,proto3" json:"Routes,omitempty"`
 }
 
-func (x *GenerateAgentResp) Reset() {
-	*x = GenerateAgentResp{}
+func (x *RouteProfile) Reset() {
+	*x = RouteProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[24]
+		mi := &file_protobuf_ligolo_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateAgentResp) String() string {
+func (x *RouteProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAgentResp) ProtoMessage() {}
+func (*RouteProfile) ProtoMessage() {}
 
-func (x *GenerateAgentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[24]
+func (x *RouteProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,43 +1821,55 @@ func (x *GenerateAgentResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAgentResp.ProtoReflect.Descriptor instead.
-func (*GenerateAgentResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RouteProfile.ProtoReflect.Descriptor instead.
+func (*RouteProfile) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GenerateAgentResp) GetAgentBinary() []byte {
+func (x *RouteProfile) GetName() string {
 	if x != nil {
-		return x.AgentBinary
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RouteProfile) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
 	}
 	return nil
 }
 
-type TracerouteReq struct {
+type Redirector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IP string `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
+	ID       string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Protocol string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	From     string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	To       string `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	Via      string `protobuf:"bytes,5,opt,name=Via,proto3" json:"Via,omitempty"`
+	Bridge   bool   `protobuf:"varint,6,opt,name=Bridge,proto3" json:"Bridge,omitempty"`
 }
 
-func (x *TracerouteReq) Reset() {
-	*x = TracerouteReq{}
+func (x *Redirector) Reset() {
+	*x = Redirector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[25]
+		mi := &file_protobuf_ligolo_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TracerouteReq) String() string {
+func (x *Redirector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TracerouteReq) ProtoMessage() {}
+func (*Redirector) ProtoMessage() {}
 
-func (x *TracerouteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[25]
+func (x *Redirector) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,90 +1880,81 @@ func (x *TracerouteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TracerouteReq.ProtoReflect.Descriptor instead.
-func (*TracerouteReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Redirector.ProtoReflect.Descriptor instead.
+func (*Redirector) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TracerouteReq) GetIP() string {
+func (x *Redirector) GetID() string {
 	if x != nil {
-		return x.IP
+		return x.ID
 	}
 	return ""
 }
 
-type TracerouteResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Trace []*Traceroute `protobuf:"bytes,1,rep,name=Trace,proto3" json:"Trace,omitempty"`
-}
-
-func (x *TracerouteResp) Reset() {
-	*x = TracerouteResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Redirector) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
 	}
+	return ""
 }
 
-func (x *TracerouteResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Redirector) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
-func (*TracerouteResp) ProtoMessage() {}
-
-func (x *TracerouteResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Redirector) GetTo() string {
+	if x != nil {
+		return x.To
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TracerouteResp.ProtoReflect.Descriptor instead.
-func (*TracerouteResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{26}
+func (x *Redirector) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
 }
 
-func (x *TracerouteResp) GetTrace() []*Traceroute {
+func (x *Redirector) GetBridge() bool {
 	if x != nil {
-		return x.Trace
+		return x.Bridge
 	}
-	return nil
+	return false
 }
 
-type GetCertsResp struct {
+type Cert struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Certs []*Cert `protobuf:"bytes,1,rep,name=Certs,proto3" json:"Certs,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	ExpiryDate  string `protobuf:"bytes,2,opt,name=ExpiryDate,proto3" json:"ExpiryDate,omitempty"`
+	Certificate []byte `protobuf:"bytes,3,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+	Key         []byte `protobuf:"bytes,4,opt,name=Key,proto3" json:"Key,omitempty"`
 }
 
-func (x *GetCertsResp) Reset() {
-	*x = GetCertsResp{}
+func (x *Cert) Reset() {
+	*x = Cert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[27]
+		mi := &file_protobuf_ligolo_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCertsResp) String() string {
+func (x *Cert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCertsResp) ProtoMessage() {}
+func (*Cert) ProtoMessage() {}
 
-func (x *GetCertsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[27]
+func (x *Cert) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,43 +1965,70 @@ func (x *GetCertsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCertsResp.ProtoReflect.Descriptor instead.
-func (*GetCertsResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Cert.ProtoReflect.Descriptor instead.
+func (*Cert) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetCertsResp) GetCerts() []*Cert {
+func (x *Cert) GetName() string {
 	if x != nil {
-		return x.Certs
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type RegenCertReq struct {
+func (x *Cert) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *Cert) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *Cert) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type Operator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	IsAdmin  bool   `protobuf:"varint,2,opt,name=IsAdmin,proto3" json:"IsAdmin,omitempty"`
+	Server   string `protobuf:"bytes,3,opt,name=Server,proto3" json:"Server,omitempty"`
+	IsOnline bool   `protobuf:"varint,4,opt,name=IsOnline,proto3" json:"IsOnline,omitempty"`
+	Cert     *Cert  `protobuf:"bytes,5,opt,name=Cert,proto3" json:"Cert,omitempty"`
+	CA       []byte `protobuf:"bytes,6,opt,name=CA,proto3" json:"CA,omitempty"`
+	Role     string `protobuf:"bytes,7,opt,name=Role,proto3" json:"Role,omitempty"`
 }
 
-func (x *RegenCertReq) Reset() {
-	*x = RegenCertReq{}
+func (x *Operator) Reset() {
+	*x = Operator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[28]
+		mi := &file_protobuf_ligolo_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegenCertReq) String() string {
+func (x *Operator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegenCertReq) ProtoMessage() {}
+func (*Operator) ProtoMessage() {}
 
-func (x *RegenCertReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[28]
+func (x *Operator) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,90 +2039,90 @@ func (x *RegenCertReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegenCertReq.ProtoReflect.Descriptor instead.
-func (*RegenCertReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use Operator.ProtoReflect.Descriptor instead.
+func (*Operator) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RegenCertReq) GetName() string {
+func (x *Operator) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type GetOperatorsResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Operators []*Operator `protobuf:"bytes,1,rep,name=Operators,proto3" json:"Operators,omitempty"`
+func (x *Operator) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
 }
 
-func (x *GetOperatorsResp) Reset() {
-	*x = GetOperatorsResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Operator) GetServer() string {
+	if x != nil {
+		return x.Server
 	}
+	return ""
 }
 
-func (x *GetOperatorsResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Operator) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
 }
 
-func (*GetOperatorsResp) ProtoMessage() {}
-
-func (x *GetOperatorsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Operator) GetCert() *Cert {
+	if x != nil {
+		return x.Cert
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetOperatorsResp.ProtoReflect.Descriptor instead.
-func (*GetOperatorsResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{29}
+func (x *Operator) GetCA() []byte {
+	if x != nil {
+		return x.CA
+	}
+	return nil
 }
 
-func (x *GetOperatorsResp) GetOperators() []*Operator {
+func (x *Operator) GetRole() string {
 	if x != nil {
-		return x.Operators
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-type ExportOperatorReq struct {
+type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	OperatorServer string `protobuf:"bytes,1,opt,name=OperatorServer,proto3" json:"OperatorServer,omitempty"`
+	AgentServer    string `protobuf:"bytes,2,opt,name=AgentServer,proto3" json:"AgentServer,omitempty"`
+	CryptoMode     string `protobuf:"bytes,3,opt,name=CryptoMode,proto3" json:"CryptoMode,omitempty"`
+	Engagement     string `protobuf:"bytes,4,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+	TunError       string `protobuf:"bytes,5,opt,name=TunError,proto3" json:"TunError,omitempty"`
+	Peer           string `protobuf:"bytes,6,opt,name=Peer,proto3" json:"Peer,omitempty"`
 }
 
-func (x *ExportOperatorReq) Reset() {
-	*x = ExportOperatorReq{}
+func (x *Config) Reset() {
+	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[30]
+		mi := &file_protobuf_ligolo_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportOperatorReq) String() string {
+func (x *Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOperatorReq) ProtoMessage() {}
+func (*Config) ProtoMessage() {}
 
-func (x *ExportOperatorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[30]
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,98 +2133,83 @@ func (x *ExportOperatorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOperatorReq.ProtoReflect.Descriptor instead.
-func (*ExportOperatorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ExportOperatorReq) GetName() string {
+func (x *Config) GetOperatorServer() string {
 	if x != nil {
-		return x.Name
+		return x.OperatorServer
 	}
 	return ""
 }
 
-type ExportOperatorResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
-	Config   []byte    `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
-}
-
-func (x *ExportOperatorResp) Reset() {
-	*x = ExportOperatorResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Config) GetAgentServer() string {
+	if x != nil {
+		return x.AgentServer
 	}
+	return ""
 }
 
-func (x *ExportOperatorResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ExportOperatorResp) ProtoMessage() {}
-
-func (x *ExportOperatorResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Config) GetCryptoMode() string {
+	if x != nil {
+		return x.CryptoMode
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ExportOperatorResp.ProtoReflect.Descriptor instead.
-func (*ExportOperatorResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{31}
+func (x *Config) GetEngagement() string {
+	if x != nil {
+		return x.Engagement
+	}
+	return ""
 }
 
-func (x *ExportOperatorResp) GetOperator() *Operator {
+func (x *Config) GetTunError() string {
 	if x != nil {
-		return x.Operator
+		return x.TunError
 	}
-	return nil
+	return ""
 }
 
-func (x *ExportOperatorResp) GetConfig() []byte {
+func (x *Config) GetPeer() string {
 	if x != nil {
-		return x.Config
+		return x.Peer
 	}
-	return nil
+	return ""
 }
 
-type AddOperatorReq struct {
+type Engagement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Name      string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Scope     []string               `protobuf:"bytes,2,rep,name=Scope,proto3" json:"Scope,omitempty"`
+	Start     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Start,proto3" json:"Start,omitempty"`
+	End       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=End,proto3" json:"End,omitempty"`
+	Operators []string               `protobuf:"bytes,5,rep,name=Operators,proto3" json:"Operators,omitempty"`
+	Current   bool                   `protobuf:"varint,6,opt,name=Current,proto3" json:"Current,omitempty"`
 }
 
-func (x *AddOperatorReq) Reset() {
-	*x = AddOperatorReq{}
+func (x *Engagement) Reset() {
+	*x = Engagement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[32]
+		mi := &file_protobuf_ligolo_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddOperatorReq) String() string {
+func (x *Engagement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOperatorReq) ProtoMessage() {}
+func (*Engagement) ProtoMessage() {}
 
-func (x *AddOperatorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[32]
+func (x *Engagement) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1943,43 +2220,82 @@ func (x *AddOperatorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOperatorReq.ProtoReflect.Descriptor instead.
-func (*AddOperatorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Engagement.ProtoReflect.Descriptor instead.
+func (*Engagement) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AddOperatorReq) GetOperator() *Operator {
+func (x *Engagement) GetName() string {
 	if x != nil {
-		return x.Operator
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Engagement) GetScope() []string {
+	if x != nil {
+		return x.Scope
 	}
 	return nil
 }
 
-type AddOperatorResp struct {
+func (x *Engagement) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Engagement) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Engagement) GetOperators() []string {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+func (x *Engagement) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type Traceroute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	IsInternal bool   `protobuf:"varint,1,opt,name=IsInternal,proto3" json:"IsInternal,omitempty"`
+	Session    string `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
+	Iface      string `protobuf:"bytes,3,opt,name=Iface,proto3" json:"Iface,omitempty"`
+	Via        string `protobuf:"bytes,4,opt,name=Via,proto3" json:"Via,omitempty"`
+	Metric     int32  `protobuf:"varint,5,opt,name=Metric,proto3" json:"Metric,omitempty"`
 }
 
-func (x *AddOperatorResp) Reset() {
-	*x = AddOperatorResp{}
+func (x *Traceroute) Reset() {
+	*x = Traceroute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[33]
+		mi := &file_protobuf_ligolo_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddOperatorResp) String() string {
+func (x *Traceroute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOperatorResp) ProtoMessage() {}
+func (*Traceroute) ProtoMessage() {}
 
-func (x *AddOperatorResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[33]
+func (x *Traceroute) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1990,43 +2306,80 @@ func (x *AddOperatorResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOperatorResp.ProtoReflect.Descriptor instead.
-func (*AddOperatorResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{33}
-}
+// Deprecated: Use Traceroute.ProtoReflect.Descriptor instead.
+func (*Traceroute) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{25}
+}
 
-func (x *AddOperatorResp) GetOperator() *Operator {
+func (x *Traceroute) GetIsInternal() bool {
 	if x != nil {
-		return x.Operator
+		return x.IsInternal
 	}
-	return nil
+	return false
 }
 
-type DelOperatorReq struct {
+func (x *Traceroute) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *Traceroute) GetIface() string {
+	if x != nil {
+		return x.Iface
+	}
+	return ""
+}
+
+func (x *Traceroute) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *Traceroute) GetMetric() int32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+type Listener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Transport     string                 `protobuf:"bytes,2,opt,name=Transport,proto3" json:"Transport,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Certificate   string                 `protobuf:"bytes,4,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+	Key           string                 `protobuf:"bytes,5,opt,name=Key,proto3" json:"Key,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Static        bool                   `protobuf:"varint,7,opt,name=Static,proto3" json:"Static,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=Created,proto3" json:"Created,omitempty"`
+	ProxyProtocol bool                   `protobuf:"varint,10,opt,name=ProxyProtocol,proto3" json:"ProxyProtocol,omitempty"`
 }
 
-func (x *DelOperatorReq) Reset() {
-	*x = DelOperatorReq{}
+func (x *Listener) Reset() {
+	*x = Listener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[34]
+		mi := &file_protobuf_ligolo_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelOperatorReq) String() string {
+func (x *Listener) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelOperatorReq) ProtoMessage() {}
+func (*Listener) ProtoMessage() {}
 
-func (x *DelOperatorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[34]
+func (x *Listener) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,43 +2390,111 @@ func (x *DelOperatorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelOperatorReq.ProtoReflect.Descriptor instead.
-func (*DelOperatorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use Listener.ProtoReflect.Descriptor instead.
+func (*Listener) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DelOperatorReq) GetName() string {
+func (x *Listener) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type PromoteOperatorReq struct {
+func (x *Listener) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *Listener) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Listener) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *Listener) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Listener) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Listener) GetStatic() bool {
+	if x != nil {
+		return x.Static
+	}
+	return false
+}
+
+func (x *Listener) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Listener) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Listener) GetProxyProtocol() bool {
+	if x != nil {
+		return x.ProxyProtocol
+	}
+	return false
+}
+
+type AddRedirectorReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Protocol  string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	To        string `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	Via       string `protobuf:"bytes,5,opt,name=Via,proto3" json:"Via,omitempty"`
+	Bridge    bool   `protobuf:"varint,6,opt,name=Bridge,proto3" json:"Bridge,omitempty"`
 }
 
-func (x *PromoteOperatorReq) Reset() {
-	*x = PromoteOperatorReq{}
+func (x *AddRedirectorReq) Reset() {
+	*x = AddRedirectorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[35]
+		mi := &file_protobuf_ligolo_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromoteOperatorReq) String() string {
+func (x *AddRedirectorReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoteOperatorReq) ProtoMessage() {}
+func (*AddRedirectorReq) ProtoMessage() {}
 
-func (x *PromoteOperatorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[35]
+func (x *AddRedirectorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,43 +2505,79 @@ func (x *PromoteOperatorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoteOperatorReq.ProtoReflect.Descriptor instead.
-func (*PromoteOperatorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use AddRedirectorReq.ProtoReflect.Descriptor instead.
+func (*AddRedirectorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PromoteOperatorReq) GetName() string {
+func (x *AddRedirectorReq) GetSessionID() string {
 	if x != nil {
-		return x.Name
+		return x.SessionID
 	}
 	return ""
 }
 
-type DemoteOperatorReq struct {
+func (x *AddRedirectorReq) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *AddRedirectorReq) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AddRedirectorReq) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AddRedirectorReq) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *AddRedirectorReq) GetBridge() bool {
+	if x != nil {
+		return x.Bridge
+	}
+	return false
+}
+
+type DelRedirectorReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	SessionID    string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	RedirectorID string `protobuf:"bytes,2,opt,name=RedirectorID,proto3" json:"RedirectorID,omitempty"`
 }
 
-func (x *DemoteOperatorReq) Reset() {
-	*x = DemoteOperatorReq{}
+func (x *DelRedirectorReq) Reset() {
+	*x = DelRedirectorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[36]
+		mi := &file_protobuf_ligolo_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DemoteOperatorReq) String() string {
+func (x *DelRedirectorReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DemoteOperatorReq) ProtoMessage() {}
+func (*DelRedirectorReq) ProtoMessage() {}
 
-func (x *DemoteOperatorReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[36]
+func (x *DelRedirectorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,500 +2588,12631 @@ func (x *DemoteOperatorReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DemoteOperatorReq.ProtoReflect.Descriptor instead.
-func (*DemoteOperatorReq) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use DelRedirectorReq.ProtoReflect.Descriptor instead.
+func (*DelRedirectorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DemoteOperatorReq) GetName() string {
+func (x *DelRedirectorReq) GetSessionID() string {
 	if x != nil {
-		return x.Name
+		return x.SessionID
 	}
 	return ""
 }
 
-type GetMetadataResp struct {
+func (x *DelRedirectorReq) GetRedirectorID() string {
+	if x != nil {
+		return x.RedirectorID
+	}
+	return ""
+}
+
+type GetSessionsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
-	Config   *Config   `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
 }
 
-func (x *GetMetadataResp) Reset() {
-	*x = GetMetadataResp{}
+func (x *GetSessionsResp) Reset() {
+	*x = GetSessionsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_ligolo_proto_msgTypes[37]
+		mi := &file_protobuf_ligolo_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMetadataResp) String() string {
+func (x *GetSessionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetadataResp) ProtoMessage() {}
+func (*GetSessionsResp) ProtoMessage() {}
 
-func (x *GetMetadataResp) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_ligolo_proto_msgTypes[37]
+func (x *GetSessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetMetadataResp.ProtoReflect.Descriptor instead.
-func (*GetMetadataResp) Descriptor() ([]byte, []int) {
-	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetMetadataResp) GetOperator() *Operator {
-	if x != nil {
-		return x.Operator
-	}
-	return nil
-}
-
-func (x *GetMetadataResp) GetConfig() *Config {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-var File_protobuf_ligolo_proto protoreflect.FileDescriptor
-
-var file_protobuf_ligolo_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x05, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x22, 0x87, 0x03, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x03, 0x54, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x75, 0x6e, 0x52, 0x03, 0x54, 0x75, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x69,
-	0x6e, 0x67, 0x12, 0x31, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b,
-	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x46,
-	0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73,
-	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65,
-	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x40, 0x0a,
-	0x03, 0x54, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22,
-	0x31, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x49, 0x50, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49,
-	0x50, 0x73, 0x22, 0x63, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43,
-	0x69, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69, 0x64, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x5c, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x6e, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x4f, 0x6e,
-	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x4f, 0x6e,
-	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74,
-	0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x43, 0x41, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x43, 0x41, 0x22, 0x52, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49,
-	0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x49, 0x66, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x69, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x56, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x22, 0x70, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x54, 0x6f, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x3e, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2b,
-	0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x52,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
-	0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a,
-	0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x6c,
-	0x69, 0x61, 0x73, 0x22, 0x2d, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x61,
-	0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52,
-	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x22, 0x2e, 0x0a, 0x0e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x22, 0x50, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x23, 0x0a,
-	0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c,
-	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x22, 0x6b, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22,
-	0x70, 0x0a, 0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x22, 0x0a, 0x0c, 0x4f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a,
-	0x0c, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x22, 0x45, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47,
-	0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41,
-	0x52, 0x43, 0x48, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x49, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x22, 0x35, 0x0a, 0x11, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x20, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x22, 0x1f, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x50, 0x22, 0x3a, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x22,
-	0x32, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x22, 0x0a, 0x05, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x05, 0x43, 0x65,
-	0x72, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x43, 0x65, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x09, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c,
-	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x22, 0x3e, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x3f, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x24, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
-	0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a,
-	0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x69,
-	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x32, 0xb2, 0x0a, 0x0a, 0x06, 0x4c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x12, 0x28,
-	0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x52, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x69,
-	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4b,
-	0x69, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
-	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x34,
-	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x15, 0x2e, 0x6c,
-	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x79,
-	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x6c, 0x61,
-	0x79, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64,
-	0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x45, 0x64,
-	0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x45, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
-	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32,
-	0x0a, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x6c, 0x69,
-	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x13,
-	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41,
-	0x64, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69,
-	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x32, 0x0a, 0x09, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x6c,
-	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49,
-	0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6c, 0x69,
-	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x41, 0x64, 0x64,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x44,
-	0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6c,
-	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16,
-	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x74, 0x70, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x2f, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2d, 0x6d, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_protobuf_ligolo_proto_rawDescOnce sync.Once
-	file_protobuf_ligolo_proto_rawDescData = file_protobuf_ligolo_proto_rawDesc
-)
-
-func file_protobuf_ligolo_proto_rawDescGZIP() []byte {
-	file_protobuf_ligolo_proto_rawDescOnce.Do(func() {
-		file_protobuf_ligolo_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_ligolo_proto_rawDescData)
-	})
-	return file_protobuf_ligolo_proto_rawDescData
-}
-
-var file_protobuf_ligolo_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
-var file_protobuf_ligolo_proto_goTypes = []interface{}{
-	(*Empty)(nil),                 // 0: ligolo.Empty
-	(*Error)(nil),                 // 1: ligolo.Error
-	(*Event)(nil),                 // 2: ligolo.Event
-	(*Session)(nil),               // 3: ligolo.Session
-	(*Tun)(nil),                   // 4: ligolo.Tun
-	(*Interface)(nil),             // 5: ligolo.Interface
-	(*Route)(nil),                 // 6: ligolo.Route
-	(*Redirector)(nil),            // 7: ligolo.Redirector
-	(*Cert)(nil),                  // 8: ligolo.Cert
-	(*Operator)(nil),              // 9: ligolo.Operator
-	(*Config)(nil),                // 10: ligolo.Config
-	(*Traceroute)(nil),            // 11: ligolo.Traceroute
-	(*AddRedirectorReq)(nil),      // 12: ligolo.AddRedirectorReq
-	(*DelRedirectorReq)(nil),      // 13: ligolo.DelRedirectorReq
-	(*GetSessionsResp)(nil),       // 14: ligolo.GetSessionsResp
-	(*RenameSessionReq)(nil),      // 15: ligolo.RenameSessionReq
-	(*StartRelayReq)(nil),         // 16: ligolo.StartRelayReq
-	(*StopRelayReq)(nil),          // 17: ligolo.StopRelayReq
-	(*KillSessionReq)(nil),        // 18: ligolo.KillSessionReq
-	(*AddRouteReq)(nil),           // 19: ligolo.AddRouteReq
-	(*EditRouteReq)(nil),          // 20: ligolo.EditRouteReq
-	(*MoveRouteReq)(nil),          // 21: ligolo.MoveRouteReq
-	(*DelRouteReq)(nil),           // 22: ligolo.DelRouteReq
-	(*GenerateAgentReq)(nil),      // 23: ligolo.GenerateAgentReq
-	(*GenerateAgentResp)(nil),     // 24: ligolo.GenerateAgentResp
-	(*TracerouteReq)(nil),         // 25: ligolo.TracerouteReq
-	(*TracerouteResp)(nil),        // 26: ligolo.TracerouteResp
-	(*GetCertsResp)(nil),          // 27: ligolo.GetCertsResp
-	(*RegenCertReq)(nil),          // 28: ligolo.RegenCertReq
-	(*GetOperatorsResp)(nil),      // 29: ligolo.GetOperatorsResp
-	(*ExportOperatorReq)(nil),     // 30: ligolo.ExportOperatorReq
-	(*ExportOperatorResp)(nil),    // 31: ligolo.ExportOperatorResp
-	(*AddOperatorReq)(nil),        // 32: ligolo.AddOperatorReq
-	(*AddOperatorResp)(nil),       // 33: ligolo.AddOperatorResp
-	(*DelOperatorReq)(nil),        // 34: ligolo.DelOperatorReq
-	(*PromoteOperatorReq)(nil),    // 35: ligolo.PromoteOperatorReq
-	(*DemoteOperatorReq)(nil),     // 36: ligolo.DemoteOperatorReq
-	(*GetMetadataResp)(nil),       // 37: ligolo.GetMetadataResp
-	(*timestamppb.Timestamp)(nil), // 38: google.protobuf.Timestamp
-}
-var file_protobuf_ligolo_proto_depIdxs = []int32{
-	4,  // 0: ligolo.Session.Tun:type_name -> ligolo.Tun
-	5,  // 1: ligolo.Session.Interfaces:type_name -> ligolo.Interface
-	7,  // 2: ligolo.Session.Redirectors:type_name -> ligolo.Redirector
-	38, // 3: ligolo.Session.FirstSeen:type_name -> google.protobuf.Timestamp
-	38, // 4: ligolo.Session.LastSeen:type_name -> google.protobuf.Timestamp
-	6,  // 5: ligolo.Tun.Routes:type_name -> ligolo.Route
-	8,  // 6: ligolo.Operator.Cert:type_name -> ligolo.Cert
-	3,  // 7: ligolo.GetSessionsResp.Sessions:type_name -> ligolo.Session
-	6,  // 8: ligolo.AddRouteReq.Route:type_name -> ligolo.Route
-	6,  // 9: ligolo.EditRouteReq.Route:type_name -> ligolo.Route
-	11, // 10: ligolo.TracerouteResp.Trace:type_name -> ligolo.Traceroute
-	8,  // 11: ligolo.GetCertsResp.Certs:type_name -> ligolo.Cert
-	9,  // 12: ligolo.GetOperatorsResp.Operators:type_name -> ligolo.Operator
-	9,  // 13: ligolo.ExportOperatorResp.Operator:type_name -> ligolo.Operator
-	9,  // 14: ligolo.AddOperatorReq.Operator:type_name -> ligolo.Operator
-	9,  // 15: ligolo.AddOperatorResp.Operator:type_name -> ligolo.Operator
-	9,  // 16: ligolo.GetMetadataResp.Operator:type_name -> ligolo.Operator
-	10, // 17: ligolo.GetMetadataResp.Config:type_name -> ligolo.Config
-	0,  // 18: ligolo.Ligolo.Join:input_type -> ligolo.Empty
-	0,  // 19: ligolo.Ligolo.GetMetadata:input_type -> ligolo.Empty
-	0,  // 20: ligolo.Ligolo.GetSessions:input_type -> ligolo.Empty
-	15, // 21: ligolo.Ligolo.RenameSession:input_type -> ligolo.RenameSessionReq
-	18, // 22: ligolo.Ligolo.KillSession:input_type -> ligolo.KillSessionReq
-	16, // 23: ligolo.Ligolo.StartRelay:input_type -> ligolo.StartRelayReq
-	17, // 24: ligolo.Ligolo.StopRelay:input_type -> ligolo.StopRelayReq
-	19, // 25: ligolo.Ligolo.AddRoute:input_type -> ligolo.AddRouteReq
-	20, // 26: ligolo.Ligolo.EditRoute:input_type -> ligolo.EditRouteReq
-	21, // 27: ligolo.Ligolo.MoveRoute:input_type -> ligolo.MoveRouteReq
-	22, // 28: ligolo.Ligolo.DelRoute:input_type -> ligolo.DelRouteReq
-	12, // 29: ligolo.Ligolo.AddRedirector:input_type -> ligolo.AddRedirectorReq
-	13, // 30: ligolo.Ligolo.DelRedirector:input_type -> ligolo.DelRedirectorReq
-	0,  // 31: ligolo.Ligolo.GetCerts:input_type -> ligolo.Empty
-	28, // 32: ligolo.Ligolo.RegenCert:input_type -> ligolo.RegenCertReq
-	0,  // 33: ligolo.Ligolo.GetOperators:input_type -> ligolo.Empty
-	30, // 34: ligolo.Ligolo.ExportOperator:input_type -> ligolo.ExportOperatorReq
-	32, // 35: ligolo.Ligolo.AddOperator:input_type -> ligolo.AddOperatorReq
-	34, // 36: ligolo.Ligolo.DelOperator:input_type -> ligolo.DelOperatorReq
-	35, // 37: ligolo.Ligolo.PromoteOperator:input_type -> ligolo.PromoteOperatorReq
-	36, // 38: ligolo.Ligolo.DemoteOperator:input_type -> ligolo.DemoteOperatorReq
-	23, // 39: ligolo.Ligolo.GenerateAgent:input_type -> ligolo.GenerateAgentReq
-	25, // 40: ligolo.Ligolo.Traceroute:input_type -> ligolo.TracerouteReq
-	2,  // 41: ligolo.Ligolo.Join:output_type -> ligolo.Event
-	37, // 42: ligolo.Ligolo.GetMetadata:output_type -> ligolo.GetMetadataResp
-	14, // 43: ligolo.Ligolo.GetSessions:output_type -> ligolo.GetSessionsResp
-	0,  // 44: ligolo.Ligolo.RenameSession:output_type -> ligolo.Empty
-	0,  // 45: ligolo.Ligolo.KillSession:output_type -> ligolo.Empty
-	0,  // 46: ligolo.Ligolo.StartRelay:output_type -> ligolo.Empty
-	0,  // 47: ligolo.Ligolo.StopRelay:output_type -> ligolo.Empty
-	0,  // 48: ligolo.Ligolo.AddRoute:output_type -> ligolo.Empty
-	0,  // 49: ligolo.Ligolo.EditRoute:output_type -> ligolo.Empty
-	0,  // 50: ligolo.Ligolo.MoveRoute:output_type -> ligolo.Empty
-	0,  // 51: ligolo.Ligolo.DelRoute:output_type -> ligolo.Empty
-	0,  // 52: ligolo.Ligolo.AddRedirector:output_type -> ligolo.Empty
-	0,  // 53: ligolo.Ligolo.DelRedirector:output_type -> ligolo.Empty
-	27, // 54: ligolo.Ligolo.GetCerts:output_type -> ligolo.GetCertsResp
-	0,  // 55: ligolo.Ligolo.RegenCert:output_type -> ligolo.Empty
-	29, // 56: ligolo.Ligolo.GetOperators:output_type -> ligolo.GetOperatorsResp
-	31, // 57: ligolo.Ligolo.ExportOperator:output_type -> ligolo.ExportOperatorResp
-	33, // 58: ligolo.Ligolo.AddOperator:output_type -> ligolo.AddOperatorResp
-	0,  // 59: ligolo.Ligolo.DelOperator:output_type -> ligolo.Empty
-	0,  // 60: ligolo.Ligolo.PromoteOperator:output_type -> ligolo.Empty
-	0,  // 61: ligolo.Ligolo.DemoteOperator:output_type -> ligolo.Empty
-	24, // 62: ligolo.Ligolo.GenerateAgent:output_type -> ligolo.GenerateAgentResp
-	26, // 63: ligolo.Ligolo.Traceroute:output_type -> ligolo.TracerouteResp
-	41, // [41:64] is the sub-list for method output_type
-	18, // [18:41] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
-}
-
-func init() { file_protobuf_ligolo_proto_init() }
-func file_protobuf_ligolo_proto_init() {
-	if File_protobuf_ligolo_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_protobuf_ligolo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionsResp.ProtoReflect.Descriptor instead.
+func (*GetSessionsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSessionsResp) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RenameSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Alias     string `protobuf:"bytes,2,opt,name=Alias,proto3" json:"Alias,omitempty"`
+}
+
+func (x *RenameSessionReq) Reset() {
+	*x = RenameSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSessionReq) ProtoMessage() {}
+
+func (x *RenameSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSessionReq.ProtoReflect.Descriptor instead.
+func (*RenameSessionReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RenameSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *RenameSessionReq) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type StartRelayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *StartRelayReq) Reset() {
+	*x = StartRelayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartRelayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRelayReq) ProtoMessage() {}
+
+func (x *StartRelayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartRelayReq.ProtoReflect.Descriptor instead.
+func (*StartRelayReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StartRelayReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type StopRelayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *StopRelayReq) Reset() {
+	*x = StopRelayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopRelayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRelayReq) ProtoMessage() {}
+
+func (x *StopRelayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRelayReq.ProtoReflect.Descriptor instead.
+func (*StopRelayReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StopRelayReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type SetSpoofSourceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Enabled   bool   `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+}
+
+func (x *SetSpoofSourceReq) Reset() {
+	*x = SetSpoofSourceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSpoofSourceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSpoofSourceReq) ProtoMessage() {}
+
+func (x *SetSpoofSourceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSpoofSourceReq.ProtoReflect.Descriptor instead.
+func (*SetSpoofSourceReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetSpoofSourceReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetSpoofSourceReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetCompressionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID   string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	RouteID     string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`         // empty for the session
+	Compression string `protobuf:"bytes,3,opt,name=Compression,proto3" json:"Compression,omitempty"` // "on" or "off", empty too for a route to follow its session
+}
+
+func (x *SetCompressionReq) Reset() {
+	*x = SetCompressionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCompressionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCompressionReq) ProtoMessage() {}
+
+func (x *SetCompressionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCompressionReq.ProtoReflect.Descriptor instead.
+func (*SetCompressionReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetCompressionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetCompressionReq) GetRouteID() string {
+	if x != nil {
+		return x.RouteID
+	}
+	return ""
+}
+
+func (x *SetCompressionReq) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+type SetMirrorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Target    string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+}
+
+func (x *SetMirrorReq) Reset() {
+	*x = SetMirrorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMirrorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMirrorReq) ProtoMessage() {}
+
+func (x *SetMirrorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMirrorReq.ProtoReflect.Descriptor instead.
+func (*SetMirrorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetMirrorReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetMirrorReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type SetBeaconReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID  string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	IntervalMs int64  `protobuf:"varint,2,opt,name=IntervalMs,proto3" json:"IntervalMs,omitempty"`
+	Jitter     uint32 `protobuf:"varint,3,opt,name=Jitter,proto3" json:"Jitter,omitempty"`
+}
+
+func (x *SetBeaconReq) Reset() {
+	*x = SetBeaconReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBeaconReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBeaconReq) ProtoMessage() {}
+
+func (x *SetBeaconReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBeaconReq.ProtoReflect.Descriptor instead.
+func (*SetBeaconReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetBeaconReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetBeaconReq) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *SetBeaconReq) GetJitter() uint32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+type SetWorkingHoursReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID    string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	WorkingHours string `protobuf:"bytes,2,opt,name=WorkingHours,proto3" json:"WorkingHours,omitempty"`
+}
+
+func (x *SetWorkingHoursReq) Reset() {
+	*x = SetWorkingHoursReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkingHoursReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkingHoursReq) ProtoMessage() {}
+
+func (x *SetWorkingHoursReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkingHoursReq.ProtoReflect.Descriptor instead.
+func (*SetWorkingHoursReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetWorkingHoursReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetWorkingHoursReq) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
+}
+
+type WakeSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Awake     bool   `protobuf:"varint,2,opt,name=Awake,proto3" json:"Awake,omitempty"`
+}
+
+func (x *WakeSessionReq) Reset() {
+	*x = WakeSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WakeSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WakeSessionReq) ProtoMessage() {}
+
+func (x *WakeSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WakeSessionReq.ProtoReflect.Descriptor instead.
+func (*WakeSessionReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WakeSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *WakeSessionReq) GetAwake() bool {
+	if x != nil {
+		return x.Awake
+	}
+	return false
+}
+
+type SetSocksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Username  string `protobuf:"bytes,3,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password  string `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *SetSocksReq) Reset() {
+	*x = SetSocksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSocksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSocksReq) ProtoMessage() {}
+
+func (x *SetSocksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSocksReq.ProtoReflect.Descriptor instead.
+func (*SetSocksReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetSocksReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetSocksReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetSocksReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SetSocksReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// path empty lists the working directory of the agent
+type ListFilesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+}
+
+func (x *ListFilesReq) Reset() {
+	*x = ListFilesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesReq) ProtoMessage() {}
+
+func (x *ListFilesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesReq.ProtoReflect.Descriptor instead.
+func (*ListFilesReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListFilesReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ListFilesReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Size    int64                  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+	Mode    uint32                 `protobuf:"varint,3,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	ModTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ModTime,proto3" json:"ModTime,omitempty"`
+	IsDir   bool                   `protobuf:"varint,5,opt,name=IsDir,proto3" json:"IsDir,omitempty"`
+}
+
+func (x *FileEntry) Reset() {
+	*x = FileEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileEntry) ProtoMessage() {}
+
+func (x *FileEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileEntry.ProtoReflect.Descriptor instead.
+func (*FileEntry) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FileEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileEntry) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileEntry) GetModTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModTime
+	}
+	return nil
+}
+
+func (x *FileEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+type ListFilesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string       `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Entries []*FileEntry `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (x *ListFilesResp) Reset() {
+	*x = ListFilesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesResp) ProtoMessage() {}
+
+func (x *ListFilesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesResp.ProtoReflect.Descriptor instead.
+func (*ListFilesResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListFilesResp) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListFilesResp) GetEntries() []*FileEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type DownloadFileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (x *DownloadFileReq) Reset() {
+	*x = DownloadFileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileReq) ProtoMessage() {}
+
+func (x *DownloadFileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileReq.ProtoReflect.Descriptor instead.
+func (*DownloadFileReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DownloadFileReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *DownloadFileReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DownloadFileReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// the first message carries Size and Total, then chunks follow and the last one carries the Sha256 of the whole file
+type DownloadFileResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size   int64  `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	Total  int64  `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	Chunk  []byte `protobuf:"bytes,3,opt,name=Chunk,proto3" json:"Chunk,omitempty"`
+	Sha256 string `protobuf:"bytes,4,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+}
+
+func (x *DownloadFileResp) Reset() {
+	*x = DownloadFileResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileResp) ProtoMessage() {}
+
+func (x *DownloadFileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileResp.ProtoReflect.Descriptor instead.
+func (*DownloadFileResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DownloadFileResp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadFileResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DownloadFileResp) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *DownloadFileResp) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// the first message carries everything but the content, then chunks follow
+type UploadFileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=Path,proto3" json:"Path,omitempty"`
+	Offset    int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Size      int64  `protobuf:"varint,4,opt,name=Size,proto3" json:"Size,omitempty"`
+	Mode      uint32 `protobuf:"varint,5,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Chunk     []byte `protobuf:"bytes,6,opt,name=Chunk,proto3" json:"Chunk,omitempty"`
+}
+
+func (x *UploadFileReq) Reset() {
+	*x = UploadFileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileReq) ProtoMessage() {}
+
+func (x *UploadFileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileReq.ProtoReflect.Descriptor instead.
+func (*UploadFileReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UploadFileReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UploadFileReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UploadFileReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadFileReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadFileReq) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *UploadFileReq) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type UploadFileResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  int64  `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	Sha256 string `protobuf:"bytes,2,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+}
+
+func (x *UploadFileResp) Reset() {
+	*x = UploadFileResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileResp) ProtoMessage() {}
+
+func (x *UploadFileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileResp.ProtoReflect.Descriptor instead.
+func (*UploadFileResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UploadFileResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UploadFileResp) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ExecReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Command   string `protobuf:"bytes,2,opt,name=Command,proto3" json:"Command,omitempty"`
+	TimeoutMs int64  `protobuf:"varint,3,opt,name=TimeoutMs,proto3" json:"TimeoutMs,omitempty"`
+}
+
+func (x *ExecReq) Reset() {
+	*x = ExecReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecReq) ProtoMessage() {}
+
+func (x *ExecReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecReq.ProtoReflect.Descriptor instead.
+func (*ExecReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExecReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ExecReq) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ExecReq) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// output comes as it's written, the last message has Exited set
+type ExecResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stdout   []byte `protobuf:"bytes,1,opt,name=Stdout,proto3" json:"Stdout,omitempty"`
+	Stderr   []byte `protobuf:"bytes,2,opt,name=Stderr,proto3" json:"Stderr,omitempty"`
+	Exited   bool   `protobuf:"varint,3,opt,name=Exited,proto3" json:"Exited,omitempty"`
+	ExitCode int32  `protobuf:"varint,4,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"`
+}
+
+func (x *ExecResp) Reset() {
+	*x = ExecResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResp) ProtoMessage() {}
+
+func (x *ExecResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResp.ProtoReflect.Descriptor instead.
+func (*ExecResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExecResp) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
+	}
+	return nil
+}
+
+func (x *ExecResp) GetStderr() []byte {
+	if x != nil {
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecResp) GetExited() bool {
+	if x != nil {
+		return x.Exited
+	}
+	return false
+}
+
+func (x *ExecResp) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type SweepReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string   `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Targets   []string `protobuf:"bytes,2,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	Ports     []uint32 `protobuf:"varint,3,rep,packed,name=Ports,proto3" json:"Ports,omitempty"`
+	TimeoutMs int64    `protobuf:"varint,4,opt,name=TimeoutMs,proto3" json:"TimeoutMs,omitempty"`
+}
+
+func (x *SweepReq) Reset() {
+	*x = SweepReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepReq) ProtoMessage() {}
+
+func (x *SweepReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepReq.ProtoReflect.Descriptor instead.
+func (*SweepReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SweepReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SweepReq) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SweepReq) GetPorts() []uint32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *SweepReq) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type SweepHost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Method  string   `protobuf:"bytes,2,opt,name=Method,proto3" json:"Method,omitempty"`
+	RttUs   int64    `protobuf:"varint,3,opt,name=RttUs,proto3" json:"RttUs,omitempty"`
+	Open    []uint32 `protobuf:"varint,4,rep,packed,name=Open,proto3" json:"Open,omitempty"`
+}
+
+func (x *SweepHost) Reset() {
+	*x = SweepHost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepHost) ProtoMessage() {}
+
+func (x *SweepHost) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepHost.ProtoReflect.Descriptor instead.
+func (*SweepHost) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SweepHost) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SweepHost) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SweepHost) GetRttUs() int64 {
+	if x != nil {
+		return x.RttUs
+	}
+	return 0
+}
+
+func (x *SweepHost) GetOpen() []uint32 {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+// live hosts come as the agent finds them, the last message has Done set
+type SweepResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host   *SweepHost `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Done   bool       `protobuf:"varint,2,opt,name=Done,proto3" json:"Done,omitempty"`
+	Probed int32      `protobuf:"varint,3,opt,name=Probed,proto3" json:"Probed,omitempty"`
+	Alive  int32      `protobuf:"varint,4,opt,name=Alive,proto3" json:"Alive,omitempty"`
+	ICMP   bool       `protobuf:"varint,5,opt,name=ICMP,proto3" json:"ICMP,omitempty"`
+}
+
+func (x *SweepResp) Reset() {
+	*x = SweepResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepResp) ProtoMessage() {}
+
+func (x *SweepResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SweepResp.ProtoReflect.Descriptor instead.
+func (*SweepResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SweepResp) GetHost() *SweepHost {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *SweepResp) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *SweepResp) GetProbed() int32 {
+	if x != nil {
+		return x.Probed
+	}
+	return 0
+}
+
+func (x *SweepResp) GetAlive() int32 {
+	if x != nil {
+		return x.Alive
+	}
+	return 0
+}
+
+func (x *SweepResp) GetICMP() bool {
+	if x != nil {
+		return x.ICMP
+	}
+	return false
+}
+
+// the build has to be kept on the server, the call returns once the agent is back running it
+type UpdateAgentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	BuildID   string `protobuf:"bytes,2,opt,name=BuildID,proto3" json:"BuildID,omitempty"`
+}
+
+func (x *UpdateAgentReq) Reset() {
+	*x = UpdateAgentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAgentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentReq) ProtoMessage() {}
+
+func (x *UpdateAgentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentReq.ProtoReflect.Descriptor instead.
+func (*UpdateAgentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateAgentReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *UpdateAgentReq) GetBuildID() string {
+	if x != nil {
+		return x.BuildID
+	}
+	return ""
+}
+
+type UpdateAgentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *UpdateAgentResp) Reset() {
+	*x = UpdateAgentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAgentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentResp) ProtoMessage() {}
+
+func (x *UpdateAgentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentResp.ProtoReflect.Descriptor instead.
+func (*UpdateAgentResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateAgentResp) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type RotateAgentCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *RotateAgentCertReq) Reset() {
+	*x = RotateAgentCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateAgentCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAgentCertReq) ProtoMessage() {}
+
+func (x *RotateAgentCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAgentCertReq.ProtoReflect.Descriptor instead.
+func (*RotateAgentCertReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RotateAgentCertReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RotateAgentCertResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate []byte `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"` // PEM the agent is connected with now
+	Revoked     string `protobuf:"bytes,2,opt,name=Revoked,proto3" json:"Revoked,omitempty"`         // serial of the certificate it connected with before
+}
+
+func (x *RotateAgentCertResp) Reset() {
+	*x = RotateAgentCertResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateAgentCertResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAgentCertResp) ProtoMessage() {}
+
+func (x *RotateAgentCertResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAgentCertResp.ProtoReflect.Descriptor instead.
+func (*RotateAgentCertResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RotateAgentCertResp) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *RotateAgentCertResp) GetRevoked() string {
+	if x != nil {
+		return x.Revoked
+	}
+	return ""
+}
+
+type RefreshNetworkReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *RefreshNetworkReq) Reset() {
+	*x = RefreshNetworkReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshNetworkReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshNetworkReq) ProtoMessage() {}
+
+func (x *RefreshNetworkReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshNetworkReq.ProtoReflect.Descriptor instead.
+func (*RefreshNetworkReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RefreshNetworkReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RefreshNetworkResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []string `protobuf:"bytes,1,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *RefreshNetworkResp) Reset() {
+	*x = RefreshNetworkResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshNetworkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshNetworkResp) ProtoMessage() {}
+
+func (x *RefreshNetworkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshNetworkResp.ProtoReflect.Descriptor instead.
+func (*RefreshNetworkResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RefreshNetworkResp) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type RefreshHostInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *RefreshHostInfoReq) Reset() {
+	*x = RefreshHostInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshHostInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshHostInfoReq) ProtoMessage() {}
+
+func (x *RefreshHostInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshHostInfoReq.ProtoReflect.Descriptor instead.
+func (*RefreshHostInfoReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RefreshHostInfoReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type HandoffSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Operator  string `protobuf:"bytes,2,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Note      string `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	Layout    string `protobuf:"bytes,4,opt,name=Layout,proto3" json:"Layout,omitempty"`
+}
+
+func (x *HandoffSessionReq) Reset() {
+	*x = HandoffSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandoffSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoffSessionReq) ProtoMessage() {}
+
+func (x *HandoffSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoffSessionReq.ProtoReflect.Descriptor instead.
+func (*HandoffSessionReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HandoffSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *HandoffSessionReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *HandoffSessionReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *HandoffSessionReq) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+type AnswerHandoffReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Accept    bool   `protobuf:"varint,2,opt,name=Accept,proto3" json:"Accept,omitempty"`
+}
+
+func (x *AnswerHandoffReq) Reset() {
+	*x = AnswerHandoffReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerHandoffReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerHandoffReq) ProtoMessage() {}
+
+func (x *AnswerHandoffReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerHandoffReq.ProtoReflect.Descriptor instead.
+func (*AnswerHandoffReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AnswerHandoffReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AnswerHandoffReq) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type ClaimSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Release   bool   `protobuf:"varint,2,opt,name=Release,proto3" json:"Release,omitempty"`
+	Override  bool   `protobuf:"varint,3,opt,name=Override,proto3" json:"Override,omitempty"` // take over or release a claim held by someone else
+}
+
+func (x *ClaimSessionReq) Reset() {
+	*x = ClaimSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimSessionReq) ProtoMessage() {}
+
+func (x *ClaimSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimSessionReq.ProtoReflect.Descriptor instead.
+func (*ClaimSessionReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ClaimSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ClaimSessionReq) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+func (x *ClaimSessionReq) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+type PersistReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Method    string `protobuf:"bytes,2,opt,name=Method,proto3" json:"Method,omitempty"`
+	Uninstall bool   `protobuf:"varint,3,opt,name=Uninstall,proto3" json:"Uninstall,omitempty"`
+}
+
+func (x *PersistReq) Reset() {
+	*x = PersistReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistReq) ProtoMessage() {}
+
+func (x *PersistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistReq.ProtoReflect.Descriptor instead.
+func (*PersistReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PersistReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PersistReq) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PersistReq) GetUninstall() bool {
+	if x != nil {
+		return x.Uninstall
+	}
+	return false
+}
+
+type PersistResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=Location,proto3" json:"Location,omitempty"`
+}
+
+func (x *PersistResp) Reset() {
+	*x = PersistResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistResp) ProtoMessage() {}
+
+func (x *PersistResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistResp.ProtoReflect.Descriptor instead.
+func (*PersistResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PersistResp) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type BreakoutRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cidr      string `protobuf:"bytes,1,opt,name=Cidr,proto3" json:"Cidr,omitempty"`
+	Interface string `protobuf:"bytes,2,opt,name=Interface,proto3" json:"Interface,omitempty"`
+}
+
+func (x *BreakoutRule) Reset() {
+	*x = BreakoutRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakoutRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakoutRule) ProtoMessage() {}
+
+func (x *BreakoutRule) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakoutRule.ProtoReflect.Descriptor instead.
+func (*BreakoutRule) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *BreakoutRule) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *BreakoutRule) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+type SetBreakoutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string          `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Rules     []*BreakoutRule `protobuf:"bytes,2,rep,name=Rules,proto3" json:"Rules,omitempty"`
+}
+
+func (x *SetBreakoutReq) Reset() {
+	*x = SetBreakoutReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBreakoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBreakoutReq) ProtoMessage() {}
+
+func (x *SetBreakoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBreakoutReq.ProtoReflect.Descriptor instead.
+func (*SetBreakoutReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SetBreakoutReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetBreakoutReq) GetRules() []*BreakoutRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetBandwidthReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Limit     int64  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *SetBandwidthReq) Reset() {
+	*x = SetBandwidthReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBandwidthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBandwidthReq) ProtoMessage() {}
+
+func (x *SetBandwidthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBandwidthReq.ProtoReflect.Descriptor instead.
+func (*SetBandwidthReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetBandwidthReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SetBandwidthReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DiagnoseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *DiagnoseReq) Reset() {
+	*x = DiagnoseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseReq) ProtoMessage() {}
+
+func (x *DiagnoseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseReq.ProtoReflect.Descriptor instead.
+func (*DiagnoseReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DiagnoseReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type DiagnosticCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Target     string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	OK         bool   `protobuf:"varint,3,opt,name=OK,proto3" json:"OK,omitempty"`
+	Detail     string `protobuf:"bytes,4,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	DurationUs int64  `protobuf:"varint,5,opt,name=DurationUs,proto3" json:"DurationUs,omitempty"`
+}
+
+func (x *DiagnosticCheck) Reset() {
+	*x = DiagnosticCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticCheck) ProtoMessage() {}
+
+func (x *DiagnosticCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticCheck.ProtoReflect.Descriptor instead.
+func (*DiagnosticCheck) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DiagnosticCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetOK() bool {
+	if x != nil {
+		return x.OK
+	}
+	return false
+}
+
+func (x *DiagnosticCheck) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetDurationUs() int64 {
+	if x != nil {
+		return x.DurationUs
+	}
+	return 0
+}
+
+type DiagnoseResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks []*DiagnosticCheck `protobuf:"bytes,1,rep,name=Checks,proto3" json:"Checks,omitempty"`
+}
+
+func (x *DiagnoseResp) Reset() {
+	*x = DiagnoseResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnoseResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseResp) ProtoMessage() {}
+
+func (x *DiagnoseResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseResp.ProtoReflect.Descriptor instead.
+func (*DiagnoseResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DiagnoseResp) GetChecks() []*DiagnosticCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type AnnotateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string   `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	RouteID   string   `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
+	Tags      []string `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Note      string   `protobuf:"bytes,4,opt,name=Note,proto3" json:"Note,omitempty"`
+}
+
+func (x *AnnotateReq) Reset() {
+	*x = AnnotateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnotateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotateReq) ProtoMessage() {}
+
+func (x *AnnotateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotateReq.ProtoReflect.Descriptor instead.
+func (*AnnotateReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AnnotateReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AnnotateReq) GetRouteID() string {
+	if x != nil {
+		return x.RouteID
+	}
+	return ""
+}
+
+func (x *AnnotateReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AnnotateReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type KillSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Keep      bool   `protobuf:"varint,2,opt,name=Keep,proto3" json:"Keep,omitempty"`
+}
+
+func (x *KillSessionReq) Reset() {
+	*x = KillSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSessionReq) ProtoMessage() {}
+
+func (x *KillSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSessionReq.ProtoReflect.Descriptor instead.
+func (*KillSessionReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *KillSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *KillSessionReq) GetKeep() bool {
+	if x != nil {
+		return x.Keep
+	}
+	return false
+}
+
+type BurnAgentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *BurnAgentReq) Reset() {
+	*x = BurnAgentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnAgentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnAgentReq) ProtoMessage() {}
+
+func (x *BurnAgentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnAgentReq.ProtoReflect.Descriptor instead.
+func (*BurnAgentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BurnAgentReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type BurnAgentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed []string `protobuf:"bytes,1,rep,name=Removed,proto3" json:"Removed,omitempty"`
+	Errors  []string `protobuf:"bytes,2,rep,name=Errors,proto3" json:"Errors,omitempty"`
+}
+
+func (x *BurnAgentResp) Reset() {
+	*x = BurnAgentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnAgentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnAgentResp) ProtoMessage() {}
+
+func (x *BurnAgentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnAgentResp.ProtoReflect.Descriptor instead.
+func (*BurnAgentResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BurnAgentResp) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *BurnAgentResp) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type AddRouteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Route     *Route `protobuf:"bytes,2,opt,name=Route,proto3" json:"Route,omitempty"`
+}
+
+func (x *AddRouteReq) Reset() {
+	*x = AddRouteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRouteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRouteReq) ProtoMessage() {}
+
+func (x *AddRouteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRouteReq.ProtoReflect.Descriptor instead.
+func (*AddRouteReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddRouteReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AddRouteReq) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type EditRouteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	RouteID   string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
+	Route     *Route `protobuf:"bytes,3,opt,name=Route,proto3" json:"Route,omitempty"`
+}
+
+func (x *EditRouteReq) Reset() {
+	*x = EditRouteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditRouteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditRouteReq) ProtoMessage() {}
+
+func (x *EditRouteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditRouteReq.ProtoReflect.Descriptor instead.
+func (*EditRouteReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EditRouteReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *EditRouteReq) GetRouteID() string {
+	if x != nil {
+		return x.RouteID
+	}
+	return ""
+}
+
+func (x *EditRouteReq) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type MoveRouteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldSessionID string `protobuf:"bytes,1,opt,name=OldSessionID,proto3" json:"OldSessionID,omitempty"`
+	RouteID      string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
+	NewSessionID string `protobuf:"bytes,3,opt,name=NewSessionID,proto3" json:"NewSessionID,omitempty"`
+}
+
+func (x *MoveRouteReq) Reset() {
+	*x = MoveRouteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveRouteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRouteReq) ProtoMessage() {}
+
+func (x *MoveRouteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRouteReq.ProtoReflect.Descriptor instead.
+func (*MoveRouteReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MoveRouteReq) GetOldSessionID() string {
+	if x != nil {
+		return x.OldSessionID
+	}
+	return ""
+}
+
+func (x *MoveRouteReq) GetRouteID() string {
+	if x != nil {
+		return x.RouteID
+	}
+	return ""
+}
+
+func (x *MoveRouteReq) GetNewSessionID() string {
+	if x != nil {
+		return x.NewSessionID
+	}
+	return ""
+}
+
+type DelRouteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	RouteID   string `protobuf:"bytes,2,opt,name=RouteID,proto3" json:"RouteID,omitempty"`
+}
+
+func (x *DelRouteReq) Reset() {
+	*x = DelRouteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelRouteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelRouteReq) ProtoMessage() {}
+
+func (x *DelRouteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelRouteReq.ProtoReflect.Descriptor instead.
+func (*DelRouteReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DelRouteReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *DelRouteReq) GetRouteID() string {
+	if x != nil {
+		return x.RouteID
+	}
+	return ""
+}
+
+type GetRouteProfilesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*RouteProfile `protobuf:"bytes,1,rep,name=Profiles,proto3" json:"Profiles,omitempty"`
+}
+
+func (x *GetRouteProfilesResp) Reset() {
+	*x = GetRouteProfilesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRouteProfilesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteProfilesResp) ProtoMessage() {}
+
+func (x *GetRouteProfilesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteProfilesResp.ProtoReflect.Descriptor instead.
+func (*GetRouteProfilesResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetRouteProfilesResp) GetProfiles() []*RouteProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type AddRouteProfileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *RouteProfile `protobuf:"bytes,1,opt,name=Profile,proto3" json:"Profile,omitempty"`
+}
+
+func (x *AddRouteProfileReq) Reset() {
+	*x = AddRouteProfileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRouteProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRouteProfileReq) ProtoMessage() {}
+
+func (x *AddRouteProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRouteProfileReq.ProtoReflect.Descriptor instead.
+func (*AddRouteProfileReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AddRouteProfileReq) GetProfile() *RouteProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetAttachmentsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (x *GetAttachmentsReq) Reset() {
+	*x = GetAttachmentsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttachmentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentsReq) ProtoMessage() {}
+
+func (x *GetAttachmentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentsReq.ProtoReflect.Descriptor instead.
+func (*GetAttachmentsReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetAttachmentsReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type GetAttachmentsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=Attachments,proto3" json:"Attachments,omitempty"`
+}
+
+func (x *GetAttachmentsResp) Reset() {
+	*x = GetAttachmentsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttachmentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentsResp) ProtoMessage() {}
+
+func (x *GetAttachmentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentsResp.ProtoReflect.Descriptor instead.
+func (*GetAttachmentsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetAttachmentsResp) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type AddAttachmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Note      string `protobuf:"bytes,3,opt,name=Note,proto3" json:"Note,omitempty"`
+	Content   []byte `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *AddAttachmentReq) Reset() {
+	*x = AddAttachmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAttachmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttachmentReq) ProtoMessage() {}
+
+func (x *AddAttachmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttachmentReq.ProtoReflect.Descriptor instead.
+func (*AddAttachmentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AddAttachmentReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AddAttachmentReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAttachmentReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AddAttachmentReq) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DownloadAttachmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DownloadAttachmentReq) Reset() {
+	*x = DownloadAttachmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAttachmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentReq) ProtoMessage() {}
+
+func (x *DownloadAttachmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentReq.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DownloadAttachmentReq) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type DownloadAttachmentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=Attachment,proto3" json:"Attachment,omitempty"`
+	Content    []byte      `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *DownloadAttachmentResp) Reset() {
+	*x = DownloadAttachmentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAttachmentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResp) ProtoMessage() {}
+
+func (x *DownloadAttachmentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResp.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DownloadAttachmentResp) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResp) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DelAttachmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DelAttachmentReq) Reset() {
+	*x = DelAttachmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelAttachmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelAttachmentReq) ProtoMessage() {}
+
+func (x *DelAttachmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelAttachmentReq.ProtoReflect.Descriptor instead.
+func (*DelAttachmentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DelAttachmentReq) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type DelRouteProfileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DelRouteProfileReq) Reset() {
+	*x = DelRouteProfileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelRouteProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelRouteProfileReq) ProtoMessage() {}
+
+func (x *DelRouteProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelRouteProfileReq.ProtoReflect.Descriptor instead.
+func (*DelRouteProfileReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DelRouteProfileReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ApplyRouteProfileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *ApplyRouteProfileReq) Reset() {
+	*x = ApplyRouteProfileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyRouteProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRouteProfileReq) ProtoMessage() {}
+
+func (x *ApplyRouteProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRouteProfileReq.ProtoReflect.Descriptor instead.
+func (*ApplyRouteProfileReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ApplyRouteProfileReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ApplyRouteProfileReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateAgentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers        string            `protobuf:"bytes,1,opt,name=Servers,proto3" json:"Servers,omitempty"`
+	GOOS           string            `protobuf:"bytes,2,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
+	GOARCH         string            `protobuf:"bytes,3,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
+	Obfuscate      bool              `protobuf:"varint,4,opt,name=Obfuscate,proto3" json:"Obfuscate,omitempty"`
+	ProxyServer    string            `protobuf:"bytes,5,opt,name=ProxyServer,proto3" json:"ProxyServer,omitempty"`
+	IgnoreEnvProxy bool              `protobuf:"varint,6,opt,name=IgnoreEnvProxy,proto3" json:"IgnoreEnvProxy,omitempty"`
+	Netns          string            `protobuf:"bytes,7,opt,name=Netns,proto3" json:"Netns,omitempty"`
+	VRF            string            `protobuf:"bytes,8,opt,name=VRF,proto3" json:"VRF,omitempty"`
+	Format         string            `protobuf:"bytes,9,opt,name=Format,proto3" json:"Format,omitempty"`
+	GarbleSeed     string            `protobuf:"bytes,10,opt,name=GarbleSeed,proto3" json:"GarbleSeed,omitempty"`
+	GarbleTiny     bool              `protobuf:"varint,11,opt,name=GarbleTiny,proto3" json:"GarbleTiny,omitempty"`
+	GarbleLiterals bool              `protobuf:"varint,12,opt,name=GarbleLiterals,proto3" json:"GarbleLiterals,omitempty"`
+	Campaign       string            `protobuf:"bytes,13,opt,name=Campaign,proto3" json:"Campaign,omitempty"`
+	Template       string            `protobuf:"bytes,14,opt,name=Template,proto3" json:"Template,omitempty"`
+	SigningKey     string            `protobuf:"bytes,15,opt,name=SigningKey,proto3" json:"SigningKey,omitempty"`
+	Staged         bool              `protobuf:"varint,16,opt,name=Staged,proto3" json:"Staged,omitempty"`
+	Resources      *WindowsResources `protobuf:"bytes,17,opt,name=Resources,proto3" json:"Resources,omitempty"`
+	Beacon         string            `protobuf:"bytes,18,opt,name=Beacon,proto3" json:"Beacon,omitempty"`
+	Hooks          []string          `protobuf:"bytes,19,rep,name=Hooks,proto3" json:"Hooks,omitempty"`
+	KeySource      string            `protobuf:"bytes,20,opt,name=KeySource,proto3" json:"KeySource,omitempty"`
+	Key            string            `protobuf:"bytes,21,opt,name=Key,proto3" json:"Key,omitempty"`
+	Rotation       string            `protobuf:"bytes,22,opt,name=Rotation,proto3" json:"Rotation,omitempty"`
+	Retries        uint32            `protobuf:"varint,23,opt,name=Retries,proto3" json:"Retries,omitempty"`
+	Backoff        string            `protobuf:"bytes,24,opt,name=Backoff,proto3" json:"Backoff,omitempty"`
+	Guardrails     *Guardrails       `protobuf:"bytes,25,opt,name=Guardrails,proto3" json:"Guardrails,omitempty"`
+	UserAgent      string            `protobuf:"bytes,26,opt,name=UserAgent,proto3" json:"UserAgent,omitempty"`
+	Exec           bool              `protobuf:"varint,27,opt,name=Exec,proto3" json:"Exec,omitempty"`
+	Schedule       *Schedule         `protobuf:"bytes,28,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	Persist        bool              `protobuf:"varint,29,opt,name=Persist,proto3" json:"Persist,omitempty"`
+	HostInfo       bool              `protobuf:"varint,30,opt,name=HostInfo,proto3" json:"HostInfo,omitempty"`
+	Loader         bool              `protobuf:"varint,31,opt,name=Loader,proto3" json:"Loader,omitempty"`
+	Override       bool              `protobuf:"varint,32,opt,name=Override,proto3" json:"Override,omitempty"`
+	Profile        string            `protobuf:"bytes,33,opt,name=Profile,proto3" json:"Profile,omitempty"`
+	Multiplexer    *Multiplexer      `protobuf:"bytes,34,opt,name=Multiplexer,proto3" json:"Multiplexer,omitempty"`
+	Pinning        *Pinning          `protobuf:"bytes,35,opt,name=Pinning,proto3" json:"Pinning,omitempty"`
+	CA             string            `protobuf:"bytes,36,opt,name=CA,proto3" json:"CA,omitempty"` // intermediate CA issuing the agent certificate, the current engagement's if empty
+}
+
+func (x *GenerateAgentReq) Reset() {
+	*x = GenerateAgentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAgentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAgentReq) ProtoMessage() {}
+
+func (x *GenerateAgentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAgentReq.ProtoReflect.Descriptor instead.
+func (*GenerateAgentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GenerateAgentReq) GetServers() string {
+	if x != nil {
+		return x.Servers
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetGOOS() string {
+	if x != nil {
+		return x.GOOS
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetGOARCH() string {
+	if x != nil {
+		return x.GOARCH
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetObfuscate() bool {
+	if x != nil {
+		return x.Obfuscate
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetProxyServer() string {
+	if x != nil {
+		return x.ProxyServer
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetIgnoreEnvProxy() bool {
+	if x != nil {
+		return x.IgnoreEnvProxy
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetNetns() string {
+	if x != nil {
+		return x.Netns
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetVRF() string {
+	if x != nil {
+		return x.VRF
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetGarbleSeed() string {
+	if x != nil {
+		return x.GarbleSeed
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetGarbleTiny() bool {
+	if x != nil {
+		return x.GarbleTiny
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetGarbleLiterals() bool {
+	if x != nil {
+		return x.GarbleLiterals
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetStaged() bool {
+	if x != nil {
+		return x.Staged
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetResources() *WindowsResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *GenerateAgentReq) GetBeacon() string {
+	if x != nil {
+		return x.Beacon
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetHooks() []string {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *GenerateAgentReq) GetKeySource() string {
+	if x != nil {
+		return x.KeySource
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetRotation() string {
+	if x != nil {
+		return x.Rotation
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *GenerateAgentReq) GetBackoff() string {
+	if x != nil {
+		return x.Backoff
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetGuardrails() *Guardrails {
+	if x != nil {
+		return x.Guardrails
+	}
+	return nil
+}
+
+func (x *GenerateAgentReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetExec() bool {
+	if x != nil {
+		return x.Exec
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *GenerateAgentReq) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetHostInfo() bool {
+	if x != nil {
+		return x.HostInfo
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetLoader() bool {
+	if x != nil {
+		return x.Loader
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *GenerateAgentReq) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *GenerateAgentReq) GetMultiplexer() *Multiplexer {
+	if x != nil {
+		return x.Multiplexer
+	}
+	return nil
+}
+
+func (x *GenerateAgentReq) GetPinning() *Pinning {
+	if x != nil {
+		return x.Pinning
+	}
+	return nil
+}
+
+func (x *GenerateAgentReq) GetCA() string {
+	if x != nil {
+		return x.CA
+	}
+	return ""
+}
+
+type WindowsResources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Icon             []byte `protobuf:"bytes,1,opt,name=Icon,proto3" json:"Icon,omitempty"`
+	Manifest         []byte `protobuf:"bytes,2,opt,name=Manifest,proto3" json:"Manifest,omitempty"`
+	CompanyName      string `protobuf:"bytes,3,opt,name=CompanyName,proto3" json:"CompanyName,omitempty"`
+	ProductName      string `protobuf:"bytes,4,opt,name=ProductName,proto3" json:"ProductName,omitempty"`
+	FileDescription  string `protobuf:"bytes,5,opt,name=FileDescription,proto3" json:"FileDescription,omitempty"`
+	FileVersion      string `protobuf:"bytes,6,opt,name=FileVersion,proto3" json:"FileVersion,omitempty"`
+	ProductVersion   string `protobuf:"bytes,7,opt,name=ProductVersion,proto3" json:"ProductVersion,omitempty"`
+	OriginalFilename string `protobuf:"bytes,8,opt,name=OriginalFilename,proto3" json:"OriginalFilename,omitempty"`
+	LegalCopyright   string `protobuf:"bytes,9,opt,name=LegalCopyright,proto3" json:"LegalCopyright,omitempty"`
+}
+
+func (x *WindowsResources) Reset() {
+	*x = WindowsResources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowsResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsResources) ProtoMessage() {}
+
+func (x *WindowsResources) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsResources.ProtoReflect.Descriptor instead.
+func (*WindowsResources) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *WindowsResources) GetIcon() []byte {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *WindowsResources) GetManifest() []byte {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *WindowsResources) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *WindowsResources) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *WindowsResources) GetFileDescription() string {
+	if x != nil {
+		return x.FileDescription
+	}
+	return ""
+}
+
+func (x *WindowsResources) GetFileVersion() string {
+	if x != nil {
+		return x.FileVersion
+	}
+	return ""
+}
+
+func (x *WindowsResources) GetProductVersion() string {
+	if x != nil {
+		return x.ProductVersion
+	}
+	return ""
+}
+
+func (x *WindowsResources) GetOriginalFilename() string {
+	if x != nil {
+		return x.OriginalFilename
+	}
+	return ""
+}
+
+func (x *WindowsResources) GetLegalCopyright() string {
+	if x != nil {
+		return x.LegalCopyright
+	}
+	return ""
+}
+
+type Guardrails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain    string `protobuf:"bytes,1,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	Hostname  string `protobuf:"bytes,2,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Username  string `protobuf:"bytes,3,opt,name=Username,proto3" json:"Username,omitempty"`
+	Locale    string `protobuf:"bytes,4,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	NotBefore string `protobuf:"bytes,5,opt,name=NotBefore,proto3" json:"NotBefore,omitempty"`
+	NotAfter  string `protobuf:"bytes,6,opt,name=NotAfter,proto3" json:"NotAfter,omitempty"`
+}
+
+func (x *Guardrails) Reset() {
+	*x = Guardrails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Guardrails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardrails) ProtoMessage() {}
+
+func (x *Guardrails) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardrails.ProtoReflect.Descriptor instead.
+func (*Guardrails) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Guardrails) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Guardrails) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Guardrails) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Guardrails) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Guardrails) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *Guardrails) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jitter       uint32 `protobuf:"varint,1,opt,name=Jitter,proto3" json:"Jitter,omitempty"`
+	WorkingHours string `protobuf:"bytes,2,opt,name=WorkingHours,proto3" json:"WorkingHours,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Schedule) GetJitter() uint32 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *Schedule) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
+}
+
+type Multiplexer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeepAlive    string `protobuf:"bytes,1,opt,name=KeepAlive,proto3" json:"KeepAlive,omitempty"`
+	WriteTimeout string `protobuf:"bytes,2,opt,name=WriteTimeout,proto3" json:"WriteTimeout,omitempty"`
+	MaxStreams   uint32 `protobuf:"varint,3,opt,name=MaxStreams,proto3" json:"MaxStreams,omitempty"`
+}
+
+func (x *Multiplexer) Reset() {
+	*x = Multiplexer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Multiplexer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Multiplexer) ProtoMessage() {}
+
+func (x *Multiplexer) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Multiplexer.ProtoReflect.Descriptor instead.
+func (*Multiplexer) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Multiplexer) GetKeepAlive() string {
+	if x != nil {
+		return x.KeepAlive
+	}
+	return ""
+}
+
+func (x *Multiplexer) GetWriteTimeout() string {
+	if x != nil {
+		return x.WriteTimeout
+	}
+	return ""
+}
+
+func (x *Multiplexer) GetMaxStreams() uint32 {
+	if x != nil {
+		return x.MaxStreams
+	}
+	return 0
+}
+
+type Pinning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode string   `protobuf:"bytes,1,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	Pins []string `protobuf:"bytes,2,rep,name=Pins,proto3" json:"Pins,omitempty"`
+}
+
+func (x *Pinning) Reset() {
+	*x = Pinning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pinning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pinning) ProtoMessage() {}
+
+func (x *Pinning) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pinning.ProtoReflect.Descriptor instead.
+func (*Pinning) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Pinning) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Pinning) GetPins() []string {
+	if x != nil {
+		return x.Pins
+	}
+	return nil
+}
+
+type AgentBuild struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID             string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GOOS           string                 `protobuf:"bytes,2,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
+	GOARCH         string                 `protobuf:"bytes,3,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
+	Format         string                 `protobuf:"bytes,4,opt,name=Format,proto3" json:"Format,omitempty"`
+	Obfuscate      bool                   `protobuf:"varint,5,opt,name=Obfuscate,proto3" json:"Obfuscate,omitempty"`
+	GarbleSeed     string                 `protobuf:"bytes,6,opt,name=GarbleSeed,proto3" json:"GarbleSeed,omitempty"`
+	GarbleTiny     bool                   `protobuf:"varint,7,opt,name=GarbleTiny,proto3" json:"GarbleTiny,omitempty"`
+	GarbleLiterals bool                   `protobuf:"varint,8,opt,name=GarbleLiterals,proto3" json:"GarbleLiterals,omitempty"`
+	Sha256         string                 `protobuf:"bytes,9,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+	Created        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=Created,proto3" json:"Created,omitempty"`
+	Operator       string                 `protobuf:"bytes,11,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Campaign       string                 `protobuf:"bytes,12,opt,name=Campaign,proto3" json:"Campaign,omitempty"`
+	Template       string                 `protobuf:"bytes,13,opt,name=Template,proto3" json:"Template,omitempty"`
+	SigningKey     string                 `protobuf:"bytes,14,opt,name=SigningKey,proto3" json:"SigningKey,omitempty"`
+	Staged         bool                   `protobuf:"varint,15,opt,name=Staged,proto3" json:"Staged,omitempty"`
+	StageSha256    string                 `protobuf:"bytes,16,opt,name=StageSha256,proto3" json:"StageSha256,omitempty"`
+	FIPS           bool                   `protobuf:"varint,17,opt,name=FIPS,proto3" json:"FIPS,omitempty"`
+	Hooks          []string               `protobuf:"bytes,18,rep,name=Hooks,proto3" json:"Hooks,omitempty"`
+	KeySource      string                 `protobuf:"bytes,19,opt,name=KeySource,proto3" json:"KeySource,omitempty"`
+	Guardrails     *Guardrails            `protobuf:"bytes,20,opt,name=Guardrails,proto3" json:"Guardrails,omitempty"`
+	Stored         bool                   `protobuf:"varint,21,opt,name=Stored,proto3" json:"Stored,omitempty"`
+	Engagement     string                 `protobuf:"bytes,22,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+	Exec           bool                   `protobuf:"varint,23,opt,name=Exec,proto3" json:"Exec,omitempty"`
+	Schedule       *Schedule              `protobuf:"bytes,24,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	Persist        bool                   `protobuf:"varint,25,opt,name=Persist,proto3" json:"Persist,omitempty"`
+	HostInfo       bool                   `protobuf:"varint,26,opt,name=HostInfo,proto3" json:"HostInfo,omitempty"`
+	Loader         bool                   `protobuf:"varint,27,opt,name=Loader,proto3" json:"Loader,omitempty"`
+	Override       bool                   `protobuf:"varint,28,opt,name=Override,proto3" json:"Override,omitempty"`
+	Profile        string                 `protobuf:"bytes,29,opt,name=Profile,proto3" json:"Profile,omitempty"`
+	Multiplexer    *Multiplexer           `protobuf:"bytes,30,opt,name=Multiplexer,proto3" json:"Multiplexer,omitempty"`
+	Pinning        *Pinning               `protobuf:"bytes,31,opt,name=Pinning,proto3" json:"Pinning,omitempty"`
+}
+
+func (x *AgentBuild) Reset() {
+	*x = AgentBuild{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentBuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentBuild) ProtoMessage() {}
+
+func (x *AgentBuild) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentBuild.ProtoReflect.Descriptor instead.
+func (*AgentBuild) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AgentBuild) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetGOOS() string {
+	if x != nil {
+		return x.GOOS
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetGOARCH() string {
+	if x != nil {
+		return x.GOARCH
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetObfuscate() bool {
+	if x != nil {
+		return x.Obfuscate
+	}
+	return false
+}
+
+func (x *AgentBuild) GetGarbleSeed() string {
+	if x != nil {
+		return x.GarbleSeed
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetGarbleTiny() bool {
+	if x != nil {
+		return x.GarbleTiny
+	}
+	return false
+}
+
+func (x *AgentBuild) GetGarbleLiterals() bool {
+	if x != nil {
+		return x.GarbleLiterals
+	}
+	return false
+}
+
+func (x *AgentBuild) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *AgentBuild) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetSigningKey() string {
+	if x != nil {
+		return x.SigningKey
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetStaged() bool {
+	if x != nil {
+		return x.Staged
+	}
+	return false
+}
+
+func (x *AgentBuild) GetStageSha256() string {
+	if x != nil {
+		return x.StageSha256
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetFIPS() bool {
+	if x != nil {
+		return x.FIPS
+	}
+	return false
+}
+
+func (x *AgentBuild) GetHooks() []string {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *AgentBuild) GetKeySource() string {
+	if x != nil {
+		return x.KeySource
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetGuardrails() *Guardrails {
+	if x != nil {
+		return x.Guardrails
+	}
+	return nil
+}
+
+func (x *AgentBuild) GetStored() bool {
+	if x != nil {
+		return x.Stored
+	}
+	return false
+}
+
+func (x *AgentBuild) GetEngagement() string {
+	if x != nil {
+		return x.Engagement
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetExec() bool {
+	if x != nil {
+		return x.Exec
+	}
+	return false
+}
+
+func (x *AgentBuild) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *AgentBuild) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+func (x *AgentBuild) GetHostInfo() bool {
+	if x != nil {
+		return x.HostInfo
+	}
+	return false
+}
+
+func (x *AgentBuild) GetLoader() bool {
+	if x != nil {
+		return x.Loader
+	}
+	return false
+}
+
+func (x *AgentBuild) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *AgentBuild) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *AgentBuild) GetMultiplexer() *Multiplexer {
+	if x != nil {
+		return x.Multiplexer
+	}
+	return nil
+}
+
+func (x *AgentBuild) GetPinning() *Pinning {
+	if x != nil {
+		return x.Pinning
+	}
+	return nil
+}
+
+type GenerateAgentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentBinary []byte      `protobuf:"bytes,1,opt,name=AgentBinary,proto3" json:"AgentBinary,omitempty"`
+	JobID       string      `protobuf:"bytes,2,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	Progress    string      `protobuf:"bytes,3,opt,name=Progress,proto3" json:"Progress,omitempty"`
+	Build       *AgentBuild `protobuf:"bytes,4,opt,name=Build,proto3" json:"Build,omitempty"`
+	Signature   []byte      `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Loader      []byte      `protobuf:"bytes,6,opt,name=Loader,proto3" json:"Loader,omitempty"`
+}
+
+func (x *GenerateAgentResp) Reset() {
+	*x = GenerateAgentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAgentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAgentResp) ProtoMessage() {}
+
+func (x *GenerateAgentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAgentResp.ProtoReflect.Descriptor instead.
+func (*GenerateAgentResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GenerateAgentResp) GetAgentBinary() []byte {
+	if x != nil {
+		return x.AgentBinary
+	}
+	return nil
+}
+
+func (x *GenerateAgentResp) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *GenerateAgentResp) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+func (x *GenerateAgentResp) GetBuild() *AgentBuild {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *GenerateAgentResp) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *GenerateAgentResp) GetLoader() []byte {
+	if x != nil {
+		return x.Loader
+	}
+	return nil
+}
+
+type RenderAgentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request *GenerateAgentReq `protobuf:"bytes,1,opt,name=Request,proto3" json:"Request,omitempty"`
+	Redact  bool              `protobuf:"varint,2,opt,name=Redact,proto3" json:"Redact,omitempty"`
+}
+
+func (x *RenderAgentReq) Reset() {
+	*x = RenderAgentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderAgentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderAgentReq) ProtoMessage() {}
+
+func (x *RenderAgentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderAgentReq.ProtoReflect.Descriptor instead.
+func (*RenderAgentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RenderAgentReq) GetRequest() *GenerateAgentReq {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *RenderAgentReq) GetRedact() bool {
+	if x != nil {
+		return x.Redact
+	}
+	return false
+}
+
+type RenderAgentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source   []byte `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	Problems string `protobuf:"bytes,2,opt,name=Problems,proto3" json:"Problems,omitempty"`
+}
+
+func (x *RenderAgentResp) Reset() {
+	*x = RenderAgentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderAgentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderAgentResp) ProtoMessage() {}
+
+func (x *RenderAgentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderAgentResp.ProtoReflect.Descriptor instead.
+func (*RenderAgentResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RenderAgentResp) GetSource() []byte {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *RenderAgentResp) GetProblems() string {
+	if x != nil {
+		return x.Problems
+	}
+	return ""
+}
+
+type CancelAgentBuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID string `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+}
+
+func (x *CancelAgentBuildReq) Reset() {
+	*x = CancelAgentBuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelAgentBuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAgentBuildReq) ProtoMessage() {}
+
+func (x *CancelAgentBuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAgentBuildReq.ProtoReflect.Descriptor instead.
+func (*CancelAgentBuildReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CancelAgentBuildReq) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type AgentTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Sha256 string `protobuf:"bytes,2,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+	Size   int64  `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
+}
+
+func (x *AgentTemplate) Reset() {
+	*x = AgentTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentTemplate) ProtoMessage() {}
+
+func (x *AgentTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentTemplate.ProtoReflect.Descriptor instead.
+func (*AgentTemplate) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AgentTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AgentTemplate) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetAgentTemplatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*AgentTemplate `protobuf:"bytes,1,rep,name=Templates,proto3" json:"Templates,omitempty"`
+}
+
+func (x *GetAgentTemplatesResp) Reset() {
+	*x = GetAgentTemplatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentTemplatesResp) ProtoMessage() {}
+
+func (x *GetAgentTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentTemplatesResp.ProtoReflect.Descriptor instead.
+func (*GetAgentTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetAgentTemplatesResp) GetTemplates() []*AgentTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type AddAgentTemplateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Source []byte `protobuf:"bytes,2,opt,name=Source,proto3" json:"Source,omitempty"`
+}
+
+func (x *AddAgentTemplateReq) Reset() {
+	*x = AddAgentTemplateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAgentTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAgentTemplateReq) ProtoMessage() {}
+
+func (x *AddAgentTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAgentTemplateReq.ProtoReflect.Descriptor instead.
+func (*AddAgentTemplateReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AddAgentTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAgentTemplateReq) GetSource() []byte {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type DelAgentTemplateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DelAgentTemplateReq) Reset() {
+	*x = DelAgentTemplateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelAgentTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelAgentTemplateReq) ProtoMessage() {}
+
+func (x *DelAgentTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelAgentTemplateReq.ProtoReflect.Descriptor instead.
+func (*DelAgentTemplateReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DelAgentTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SigningKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Sha256 string `protobuf:"bytes,3,opt,name=Sha256,proto3" json:"Sha256,omitempty"`
+}
+
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SigningKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SigningKey) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SigningKey) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type GetSigningKeysResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*SigningKey `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (x *GetSigningKeysResp) Reset() {
+	*x = GetSigningKeysResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSigningKeysResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSigningKeysResp) ProtoMessage() {}
+
+func (x *GetSigningKeysResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSigningKeysResp.ProtoReflect.Descriptor instead.
+func (*GetSigningKeysResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetSigningKeysResp) GetKeys() []*SigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type BuildHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Targets     []string `protobuf:"bytes,3,rep,name=Targets,proto3" json:"Targets,omitempty"`
+}
+
+func (x *BuildHook) Reset() {
+	*x = BuildHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildHook) ProtoMessage() {}
+
+func (x *BuildHook) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildHook.ProtoReflect.Descriptor instead.
+func (*BuildHook) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *BuildHook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildHook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BuildHook) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type GetBuildHooksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hooks []*BuildHook `protobuf:"bytes,1,rep,name=Hooks,proto3" json:"Hooks,omitempty"`
+}
+
+func (x *GetBuildHooksResp) Reset() {
+	*x = GetBuildHooksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBuildHooksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBuildHooksResp) ProtoMessage() {}
+
+func (x *GetBuildHooksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBuildHooksResp.ProtoReflect.Descriptor instead.
+func (*GetBuildHooksResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetBuildHooksResp) GetHooks() []*BuildHook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+type TransportProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+}
+
+func (x *TransportProfile) Reset() {
+	*x = TransportProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransportProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransportProfile) ProtoMessage() {}
+
+func (x *TransportProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransportProfile.ProtoReflect.Descriptor instead.
+func (*TransportProfile) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TransportProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TransportProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetTransportProfilesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*TransportProfile `protobuf:"bytes,1,rep,name=Profiles,proto3" json:"Profiles,omitempty"`
+}
+
+func (x *GetTransportProfilesResp) Reset() {
+	*x = GetTransportProfilesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransportProfilesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransportProfilesResp) ProtoMessage() {}
+
+func (x *GetTransportProfilesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransportProfilesResp.ProtoReflect.Descriptor instead.
+func (*GetTransportProfilesResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetTransportProfilesResp) GetProfiles() []*TransportProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type AssetUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category  string `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+	Bytes     int64  `protobuf:"varint,2,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
+	Files     int64  `protobuf:"varint,3,opt,name=Files,proto3" json:"Files,omitempty"`
+	Evictable bool   `protobuf:"varint,4,opt,name=Evictable,proto3" json:"Evictable,omitempty"`
+}
+
+func (x *AssetUsage) Reset() {
+	*x = AssetUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetUsage) ProtoMessage() {}
+
+func (x *AssetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetUsage.ProtoReflect.Descriptor instead.
+func (*AssetUsage) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AssetUsage) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AssetUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *AssetUsage) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+func (x *AssetUsage) GetEvictable() bool {
+	if x != nil {
+		return x.Evictable
+	}
+	return false
+}
+
+type Toolchain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     string                 `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	Origin      string                 `protobuf:"bytes,2,opt,name=Origin,proto3" json:"Origin,omitempty"`
+	Installed   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Installed,proto3" json:"Installed,omitempty"`
+	CanRollback bool                   `protobuf:"varint,4,opt,name=CanRollback,proto3" json:"CanRollback,omitempty"`
+}
+
+func (x *Toolchain) Reset() {
+	*x = Toolchain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Toolchain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toolchain) ProtoMessage() {}
+
+func (x *Toolchain) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toolchain.ProtoReflect.Descriptor instead.
+func (*Toolchain) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Toolchain) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Toolchain) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Toolchain) GetInstalled() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Installed
+	}
+	return nil
+}
+
+func (x *Toolchain) GetCanRollback() bool {
+	if x != nil {
+		return x.CanRollback
+	}
+	return false
+}
+
+type GetAssetUsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage []*AssetUsage `protobuf:"bytes,1,rep,name=Usage,proto3" json:"Usage,omitempty"`
+	Quota int64         `protobuf:"varint,2,opt,name=Quota,proto3" json:"Quota,omitempty"`
+}
+
+func (x *GetAssetUsageResp) Reset() {
+	*x = GetAssetUsageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAssetUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssetUsageResp) ProtoMessage() {}
+
+func (x *GetAssetUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssetUsageResp.ProtoReflect.Descriptor instead.
+func (*GetAssetUsageResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetAssetUsageResp) GetUsage() []*AssetUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetAssetUsageResp) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+type CollectAssetsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category string `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+}
+
+func (x *CollectAssetsReq) Reset() {
+	*x = CollectAssetsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAssetsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAssetsReq) ProtoMessage() {}
+
+func (x *CollectAssetsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAssetsReq.ProtoReflect.Descriptor instead.
+func (*CollectAssetsReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CollectAssetsReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type CollectAssetsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Freed int64 `protobuf:"varint,1,opt,name=Freed,proto3" json:"Freed,omitempty"`
+}
+
+func (x *CollectAssetsResp) Reset() {
+	*x = CollectAssetsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectAssetsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectAssetsResp) ProtoMessage() {}
+
+func (x *CollectAssetsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectAssetsResp.ProtoReflect.Descriptor instead.
+func (*CollectAssetsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CollectAssetsResp) GetFreed() int64 {
+	if x != nil {
+		return x.Freed
+	}
+	return 0
+}
+
+type AddSigningKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Key      []byte `protobuf:"bytes,3,opt,name=Key,proto3" json:"Key,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *AddSigningKeyReq) Reset() {
+	*x = AddSigningKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSigningKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSigningKeyReq) ProtoMessage() {}
+
+func (x *AddSigningKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSigningKeyReq.ProtoReflect.Descriptor instead.
+func (*AddSigningKeyReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AddSigningKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddSigningKeyReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddSigningKeyReq) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *AddSigningKeyReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type DelSigningKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DelSigningKeyReq) Reset() {
+	*x = DelSigningKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelSigningKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelSigningKeyReq) ProtoMessage() {}
+
+func (x *DelSigningKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelSigningKeyReq.ProtoReflect.Descriptor instead.
+func (*DelSigningKeyReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DelSigningKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LookupAgentBuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+}
+
+func (x *LookupAgentBuildReq) Reset() {
+	*x = LookupAgentBuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupAgentBuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupAgentBuildReq) ProtoMessage() {}
+
+func (x *LookupAgentBuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupAgentBuildReq.ProtoReflect.Descriptor instead.
+func (*LookupAgentBuildReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *LookupAgentBuildReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type LookupAgentBuildResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Builds []*AgentBuild `protobuf:"bytes,1,rep,name=Builds,proto3" json:"Builds,omitempty"`
+}
+
+func (x *LookupAgentBuildResp) Reset() {
+	*x = LookupAgentBuildResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupAgentBuildResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupAgentBuildResp) ProtoMessage() {}
+
+func (x *LookupAgentBuildResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupAgentBuildResp.ProtoReflect.Descriptor instead.
+func (*LookupAgentBuildResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *LookupAgentBuildResp) GetBuilds() []*AgentBuild {
+	if x != nil {
+		return x.Builds
+	}
+	return nil
+}
+
+type GetAgentBuildsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Builds []*AgentBuild `protobuf:"bytes,1,rep,name=Builds,proto3" json:"Builds,omitempty"`
+}
+
+func (x *GetAgentBuildsResp) Reset() {
+	*x = GetAgentBuildsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgentBuildsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentBuildsResp) ProtoMessage() {}
+
+func (x *GetAgentBuildsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentBuildsResp.ProtoReflect.Descriptor instead.
+func (*GetAgentBuildsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetAgentBuildsResp) GetBuilds() []*AgentBuild {
+	if x != nil {
+		return x.Builds
+	}
+	return nil
+}
+
+type DownloadAgentBuildReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DownloadAgentBuildReq) Reset() {
+	*x = DownloadAgentBuildReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAgentBuildReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAgentBuildReq) ProtoMessage() {}
+
+func (x *DownloadAgentBuildReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAgentBuildReq.ProtoReflect.Descriptor instead.
+func (*DownloadAgentBuildReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DownloadAgentBuildReq) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type DownloadAgentBuildResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentBinary []byte      `protobuf:"bytes,1,opt,name=AgentBinary,proto3" json:"AgentBinary,omitempty"`
+	Signature   []byte      `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Build       *AgentBuild `protobuf:"bytes,3,opt,name=Build,proto3" json:"Build,omitempty"`
+	Loader      []byte      `protobuf:"bytes,4,opt,name=Loader,proto3" json:"Loader,omitempty"`
+}
+
+func (x *DownloadAgentBuildResp) Reset() {
+	*x = DownloadAgentBuildResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAgentBuildResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAgentBuildResp) ProtoMessage() {}
+
+func (x *DownloadAgentBuildResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAgentBuildResp.ProtoReflect.Descriptor instead.
+func (*DownloadAgentBuildResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DownloadAgentBuildResp) GetAgentBinary() []byte {
+	if x != nil {
+		return x.AgentBinary
+	}
+	return nil
+}
+
+func (x *DownloadAgentBuildResp) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *DownloadAgentBuildResp) GetBuild() *AgentBuild {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *DownloadAgentBuildResp) GetLoader() []byte {
+	if x != nil {
+		return x.Loader
+	}
+	return nil
+}
+
+type RegenerateAgentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID  string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Key string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (x *RegenerateAgentReq) Reset() {
+	*x = RegenerateAgentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateAgentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateAgentReq) ProtoMessage() {}
+
+func (x *RegenerateAgentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateAgentReq.ProtoReflect.Descriptor instead.
+func (*RegenerateAgentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RegenerateAgentReq) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *RegenerateAgentReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BuildRecipeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipe []byte `protobuf:"bytes,1,opt,name=Recipe,proto3" json:"Recipe,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (x *BuildRecipeReq) Reset() {
+	*x = BuildRecipeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildRecipeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildRecipeReq) ProtoMessage() {}
+
+func (x *BuildRecipeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildRecipeReq.ProtoReflect.Descriptor instead.
+func (*BuildRecipeReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *BuildRecipeReq) GetRecipe() []byte {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *BuildRecipeReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type BuildRecipeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress string        `protobuf:"bytes,1,opt,name=Progress,proto3" json:"Progress,omitempty"`
+	Archive  []byte        `protobuf:"bytes,2,opt,name=Archive,proto3" json:"Archive,omitempty"`
+	Builds   []*AgentBuild `protobuf:"bytes,3,rep,name=Builds,proto3" json:"Builds,omitempty"`
+}
+
+func (x *BuildRecipeResp) Reset() {
+	*x = BuildRecipeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildRecipeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildRecipeResp) ProtoMessage() {}
+
+func (x *BuildRecipeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildRecipeResp.ProtoReflect.Descriptor instead.
+func (*BuildRecipeResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *BuildRecipeResp) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+func (x *BuildRecipeResp) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *BuildRecipeResp) GetBuilds() []*AgentBuild {
+	if x != nil {
+		return x.Builds
+	}
+	return nil
+}
+
+type UploadToolchainReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=Chunk,proto3" json:"Chunk,omitempty"`
+}
+
+func (x *UploadToolchainReq) Reset() {
+	*x = UploadToolchainReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadToolchainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadToolchainReq) ProtoMessage() {}
+
+func (x *UploadToolchainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadToolchainReq.ProtoReflect.Descriptor instead.
+func (*UploadToolchainReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UploadToolchainReq) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type FetchToolchainReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *FetchToolchainReq) Reset() {
+	*x = FetchToolchainReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchToolchainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchToolchainReq) ProtoMessage() {}
+
+func (x *FetchToolchainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchToolchainReq.ProtoReflect.Descriptor instead.
+func (*FetchToolchainReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *FetchToolchainReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type TracerouteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IP string `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
+}
+
+func (x *TracerouteReq) Reset() {
+	*x = TracerouteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteReq) ProtoMessage() {}
+
+func (x *TracerouteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteReq.ProtoReflect.Descriptor instead.
+func (*TracerouteReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TracerouteReq) GetIP() string {
+	if x != nil {
+		return x.IP
+	}
+	return ""
+}
+
+type TracerouteResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trace []*Traceroute `protobuf:"bytes,1,rep,name=Trace,proto3" json:"Trace,omitempty"`
+}
+
+func (x *TracerouteResp) Reset() {
+	*x = TracerouteResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracerouteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracerouteResp) ProtoMessage() {}
+
+func (x *TracerouteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracerouteResp.ProtoReflect.Descriptor instead.
+func (*TracerouteResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *TracerouteResp) GetTrace() []*Traceroute {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type ThroughputReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Size      int64  `protobuf:"varint,2,opt,name=Size,proto3" json:"Size,omitempty"`
+}
+
+func (x *ThroughputReq) Reset() {
+	*x = ThroughputReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThroughputReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputReq) ProtoMessage() {}
+
+func (x *ThroughputReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputReq.ProtoReflect.Descriptor instead.
+func (*ThroughputReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ThroughputReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ThroughputReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ThroughputResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bytes      int64 `protobuf:"varint,1,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
+	DurationMs int64 `protobuf:"varint,2,opt,name=DurationMs,proto3" json:"DurationMs,omitempty"`
+}
+
+func (x *ThroughputResp) Reset() {
+	*x = ThroughputResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThroughputResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputResp) ProtoMessage() {}
+
+func (x *ThroughputResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputResp.ProtoReflect.Descriptor instead.
+func (*ThroughputResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ThroughputResp) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *ThroughputResp) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type GetCertsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certs []*Cert `protobuf:"bytes,1,rep,name=Certs,proto3" json:"Certs,omitempty"`
+}
+
+func (x *GetCertsResp) Reset() {
+	*x = GetCertsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCertsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertsResp) ProtoMessage() {}
+
+func (x *GetCertsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertsResp.ProtoReflect.Descriptor instead.
+func (*GetCertsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetCertsResp) GetCerts() []*Cert {
+	if x != nil {
+		return x.Certs
+	}
+	return nil
+}
+
+type RegenCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *RegenCertReq) Reset() {
+	*x = RegenCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenCertReq) ProtoMessage() {}
+
+func (x *RegenCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenCertReq.ProtoReflect.Descriptor instead.
+func (*RegenCertReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RegenCertReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RevokedCert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash         string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	SerialNumber string `protobuf:"bytes,2,opt,name=SerialNumber,proto3" json:"SerialNumber,omitempty"`
+	Subject      string `protobuf:"bytes,3,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	RevokedAt    int64  `protobuf:"varint,5,opt,name=RevokedAt,proto3" json:"RevokedAt,omitempty"`
+}
+
+func (x *RevokedCert) Reset() {
+	*x = RevokedCert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokedCert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedCert) ProtoMessage() {}
+
+func (x *RevokedCert) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedCert.ProtoReflect.Descriptor instead.
+func (*RevokedCert) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RevokedCert) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *RevokedCert) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *RevokedCert) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RevokedCert) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RevokedCert) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+type GetRevokedCertsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certs []*RevokedCert `protobuf:"bytes,1,rep,name=Certs,proto3" json:"Certs,omitempty"`
+}
+
+func (x *GetRevokedCertsResp) Reset() {
+	*x = GetRevokedCertsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRevokedCertsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevokedCertsResp) ProtoMessage() {}
+
+func (x *GetRevokedCertsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevokedCertsResp.ProtoReflect.Descriptor instead.
+func (*GetRevokedCertsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetRevokedCertsResp) GetCerts() []*RevokedCert {
+	if x != nil {
+		return x.Certs
+	}
+	return nil
+}
+
+type RevokeCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID   string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Certificate []byte `protobuf:"bytes,2,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+	Reason      string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *RevokeCertReq) Reset() {
+	*x = RevokeCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCertReq) ProtoMessage() {}
+
+func (x *RevokeCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCertReq.ProtoReflect.Descriptor instead.
+func (*RevokeCertReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *RevokeCertReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *RevokeCertReq) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *RevokeCertReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnrevokeCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (x *UnrevokeCertReq) Reset() {
+	*x = UnrevokeCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnrevokeCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnrevokeCertReq) ProtoMessage() {}
+
+func (x *UnrevokeCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnrevokeCertReq.ProtoReflect.Descriptor instead.
+func (*UnrevokeCertReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *UnrevokeCertReq) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetCRLResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CRL []byte `protobuf:"bytes,1,opt,name=CRL,proto3" json:"CRL,omitempty"`
+}
+
+func (x *GetCRLResp) Reset() {
+	*x = GetCRLResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCRLResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCRLResp) ProtoMessage() {}
+
+func (x *GetCRLResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCRLResp.ProtoReflect.Descriptor instead.
+func (*GetCRLResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetCRLResp) GetCRL() []byte {
+	if x != nil {
+		return x.CRL
+	}
+	return nil
+}
+
+type CA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Engagement  string `protobuf:"bytes,2,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+	CreatedBy   string `protobuf:"bytes,3,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,4,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Certificate []byte `protobuf:"bytes,5,opt,name=Certificate,proto3" json:"Certificate,omitempty"` // PEM
+	Revoked     bool   `protobuf:"varint,6,opt,name=Revoked,proto3" json:"Revoked,omitempty"`
+}
+
+func (x *CA) Reset() {
+	*x = CA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CA) ProtoMessage() {}
+
+func (x *CA) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CA.ProtoReflect.Descriptor instead.
+func (*CA) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CA) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CA) GetEngagement() string {
+	if x != nil {
+		return x.Engagement
+	}
+	return ""
+}
+
+func (x *CA) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CA) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CA) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *CA) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type GetCAsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CAs []*CA `protobuf:"bytes,1,rep,name=CAs,proto3" json:"CAs,omitempty"`
+}
+
+func (x *GetCAsResp) Reset() {
+	*x = GetCAsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCAsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCAsResp) ProtoMessage() {}
+
+func (x *GetCAsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCAsResp.ProtoReflect.Descriptor instead.
+func (*GetCAsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetCAsResp) GetCAs() []*CA {
+	if x != nil {
+		return x.CAs
+	}
+	return nil
+}
+
+type AddCAReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Engagement string `protobuf:"bytes,2,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+}
+
+func (x *AddCAReq) Reset() {
+	*x = AddCAReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCAReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCAReq) ProtoMessage() {}
+
+func (x *AddCAReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCAReq.ProtoReflect.Descriptor instead.
+func (*AddCAReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *AddCAReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddCAReq) GetEngagement() string {
+	if x != nil {
+		return x.Engagement
+	}
+	return ""
+}
+
+type AddCAResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CA *CA `protobuf:"bytes,1,opt,name=CA,proto3" json:"CA,omitempty"`
+}
+
+func (x *AddCAResp) Reset() {
+	*x = AddCAResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCAResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCAResp) ProtoMessage() {}
+
+func (x *AddCAResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCAResp.ProtoReflect.Descriptor instead.
+func (*AddCAResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *AddCAResp) GetCA() *CA {
+	if x != nil {
+		return x.CA
+	}
+	return nil
+}
+
+type RevokeCAReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *RevokeCAReq) Reset() {
+	*x = RevokeCAReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeCAReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCAReq) ProtoMessage() {}
+
+func (x *RevokeCAReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCAReq.ProtoReflect.Descriptor instead.
+func (*RevokeCAReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *RevokeCAReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RevokeCAReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ExportServerStateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+}
+
+func (x *ExportServerStateReq) Reset() {
+	*x = ExportServerStateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportServerStateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportServerStateReq) ProtoMessage() {}
+
+func (x *ExportServerStateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportServerStateReq.ProtoReflect.Descriptor instead.
+func (*ExportServerStateReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ExportServerStateReq) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+// the first message carries Size and Rows, then chunks of the archive follow
+type ExportServerStateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size  int64  `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	Rows  int64  `protobuf:"varint,2,opt,name=Rows,proto3" json:"Rows,omitempty"`
+	Chunk []byte `protobuf:"bytes,3,opt,name=Chunk,proto3" json:"Chunk,omitempty"`
+}
+
+func (x *ExportServerStateResp) Reset() {
+	*x = ExportServerStateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportServerStateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportServerStateResp) ProtoMessage() {}
+
+func (x *ExportServerStateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportServerStateResp.ProtoReflect.Descriptor instead.
+func (*ExportServerStateResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ExportServerStateResp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExportServerStateResp) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ExportServerStateResp) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// the first message carries the passphrase, then chunks of the archive follow
+type ImportServerStateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passphrase string `protobuf:"bytes,1,opt,name=Passphrase,proto3" json:"Passphrase,omitempty"`
+	Chunk      []byte `protobuf:"bytes,2,opt,name=Chunk,proto3" json:"Chunk,omitempty"`
+}
+
+func (x *ImportServerStateReq) Reset() {
+	*x = ImportServerStateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportServerStateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportServerStateReq) ProtoMessage() {}
+
+func (x *ImportServerStateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportServerStateReq.ProtoReflect.Descriptor instead.
+func (*ImportServerStateReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ImportServerStateReq) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *ImportServerStateReq) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportServerStateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tables int64 `protobuf:"varint,1,opt,name=Tables,proto3" json:"Tables,omitempty"`
+	Rows   int64 `protobuf:"varint,2,opt,name=Rows,proto3" json:"Rows,omitempty"`
+}
+
+func (x *ImportServerStateResp) Reset() {
+	*x = ImportServerStateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportServerStateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportServerStateResp) ProtoMessage() {}
+
+func (x *ImportServerStateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportServerStateResp.ProtoReflect.Descriptor instead.
+func (*ImportServerStateResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ImportServerStateResp) GetTables() int64 {
+	if x != nil {
+		return x.Tables
+	}
+	return 0
+}
+
+func (x *ImportServerStateResp) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type GetOperatorsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operators []*Operator `protobuf:"bytes,1,rep,name=Operators,proto3" json:"Operators,omitempty"`
+}
+
+func (x *GetOperatorsResp) Reset() {
+	*x = GetOperatorsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperatorsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperatorsResp) ProtoMessage() {}
+
+func (x *GetOperatorsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperatorsResp.ProtoReflect.Descriptor instead.
+func (*GetOperatorsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetOperatorsResp) GetOperators() []*Operator {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+type ExportOperatorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *ExportOperatorReq) Reset() {
+	*x = ExportOperatorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOperatorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOperatorReq) ProtoMessage() {}
+
+func (x *ExportOperatorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOperatorReq.ProtoReflect.Descriptor instead.
+func (*ExportOperatorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ExportOperatorReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExportOperatorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Config   []byte    `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+}
+
+func (x *ExportOperatorResp) Reset() {
+	*x = ExportOperatorResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportOperatorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOperatorResp) ProtoMessage() {}
+
+func (x *ExportOperatorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOperatorResp.ProtoReflect.Descriptor instead.
+func (*ExportOperatorResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ExportOperatorResp) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *ExportOperatorResp) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type AddOperatorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	CA       string    `protobuf:"bytes,2,opt,name=CA,proto3" json:"CA,omitempty"` // intermediate CA issuing the operator certificate, the current engagement's if empty
+}
+
+func (x *AddOperatorReq) Reset() {
+	*x = AddOperatorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOperatorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOperatorReq) ProtoMessage() {}
+
+func (x *AddOperatorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOperatorReq.ProtoReflect.Descriptor instead.
+func (*AddOperatorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AddOperatorReq) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *AddOperatorReq) GetCA() string {
+	if x != nil {
+		return x.CA
+	}
+	return ""
+}
+
+type AddOperatorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+}
+
+func (x *AddOperatorResp) Reset() {
+	*x = AddOperatorResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOperatorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOperatorResp) ProtoMessage() {}
+
+func (x *AddOperatorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOperatorResp.ProtoReflect.Descriptor instead.
+func (*AddOperatorResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *AddOperatorResp) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+type DelOperatorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DelOperatorReq) Reset() {
+	*x = DelOperatorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelOperatorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelOperatorReq) ProtoMessage() {}
+
+func (x *DelOperatorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelOperatorReq.ProtoReflect.Descriptor instead.
+func (*DelOperatorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *DelOperatorReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PromoteOperatorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *PromoteOperatorReq) Reset() {
+	*x = PromoteOperatorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteOperatorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteOperatorReq) ProtoMessage() {}
+
+func (x *PromoteOperatorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteOperatorReq.ProtoReflect.Descriptor instead.
+func (*PromoteOperatorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *PromoteOperatorReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DemoteOperatorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DemoteOperatorReq) Reset() {
+	*x = DemoteOperatorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DemoteOperatorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemoteOperatorReq) ProtoMessage() {}
+
+func (x *DemoteOperatorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemoteOperatorReq.ProtoReflect.Descriptor instead.
+func (*DemoteOperatorReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *DemoteOperatorReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetOperatorRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
+}
+
+func (x *SetOperatorRoleReq) Reset() {
+	*x = SetOperatorRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOperatorRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOperatorRoleReq) ProtoMessage() {}
+
+func (x *SetOperatorRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOperatorRoleReq.ProtoReflect.Descriptor instead.
+func (*SetOperatorRoleReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *SetOperatorRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetOperatorRoleReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RenewOperatorCertReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"` // the calling operator if empty
+}
+
+func (x *RenewOperatorCertReq) Reset() {
+	*x = RenewOperatorCertReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewOperatorCertReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewOperatorCertReq) ProtoMessage() {}
+
+func (x *RenewOperatorCertReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewOperatorCertReq.ProtoReflect.Descriptor instead.
+func (*RenewOperatorCertReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *RenewOperatorCertReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenewOperatorCertResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cert *Cert `protobuf:"bytes,1,opt,name=Cert,proto3" json:"Cert,omitempty"`
+}
+
+func (x *RenewOperatorCertResp) Reset() {
+	*x = RenewOperatorCertResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewOperatorCertResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewOperatorCertResp) ProtoMessage() {}
+
+func (x *RenewOperatorCertResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewOperatorCertResp.ProtoReflect.Descriptor instead.
+func (*RenewOperatorCertResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *RenewOperatorCertResp) GetCert() *Cert {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+type Enrollment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Role      string `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	Server    string `protobuf:"bytes,4,opt,name=Server,proto3" json:"Server,omitempty"`
+	CreatedBy string `protobuf:"bytes,5,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,7,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	CA        string `protobuf:"bytes,8,opt,name=CA,proto3" json:"CA,omitempty"`
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *Enrollment) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Enrollment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Enrollment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Enrollment) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *Enrollment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Enrollment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Enrollment) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Enrollment) GetCA() string {
+	if x != nil {
+		return x.CA
+	}
+	return ""
+}
+
+type GetEnrollmentsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollments []*Enrollment `protobuf:"bytes,1,rep,name=Enrollments,proto3" json:"Enrollments,omitempty"`
+}
+
+func (x *GetEnrollmentsResp) Reset() {
+	*x = GetEnrollmentsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnrollmentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnrollmentsResp) ProtoMessage() {}
+
+func (x *GetEnrollmentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnrollmentsResp.ProtoReflect.Descriptor instead.
+func (*GetEnrollmentsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *GetEnrollmentsResp) GetEnrollments() []*Enrollment {
+	if x != nil {
+		return x.Enrollments
+	}
+	return nil
+}
+
+type AddEnrollmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Role   string `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
+	Server string `protobuf:"bytes,3,opt,name=Server,proto3" json:"Server,omitempty"`
+	TTL    string `protobuf:"bytes,4,opt,name=TTL,proto3" json:"TTL,omitempty"` // Go duration, 24h if empty
+	CA     string `protobuf:"bytes,5,opt,name=CA,proto3" json:"CA,omitempty"`   // intermediate CA issuing the operator certificate, the current engagement's if empty
+}
+
+func (x *AddEnrollmentReq) Reset() {
+	*x = AddEnrollmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEnrollmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEnrollmentReq) ProtoMessage() {}
+
+func (x *AddEnrollmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEnrollmentReq.ProtoReflect.Descriptor instead.
+func (*AddEnrollmentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *AddEnrollmentReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddEnrollmentReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddEnrollmentReq) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *AddEnrollmentReq) GetTTL() string {
+	if x != nil {
+		return x.TTL
+	}
+	return ""
+}
+
+func (x *AddEnrollmentReq) GetCA() string {
+	if x != nil {
+		return x.CA
+	}
+	return ""
+}
+
+type AddEnrollmentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enrollment *Enrollment `protobuf:"bytes,1,opt,name=Enrollment,proto3" json:"Enrollment,omitempty"`
+	Code       string      `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+}
+
+func (x *AddEnrollmentResp) Reset() {
+	*x = AddEnrollmentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEnrollmentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEnrollmentResp) ProtoMessage() {}
+
+func (x *AddEnrollmentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEnrollmentResp.ProtoReflect.Descriptor instead.
+func (*AddEnrollmentResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *AddEnrollmentResp) GetEnrollment() *Enrollment {
+	if x != nil {
+		return x.Enrollment
+	}
+	return nil
+}
+
+func (x *AddEnrollmentResp) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DelEnrollmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *DelEnrollmentReq) Reset() {
+	*x = DelEnrollmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelEnrollmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelEnrollmentReq) ProtoMessage() {}
+
+func (x *DelEnrollmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelEnrollmentReq.ProtoReflect.Descriptor instead.
+func (*DelEnrollmentReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *DelEnrollmentReq) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type GetEngagementsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Engagements []*Engagement `protobuf:"bytes,1,rep,name=Engagements,proto3" json:"Engagements,omitempty"`
+}
+
+func (x *GetEngagementsResp) Reset() {
+	*x = GetEngagementsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEngagementsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEngagementsResp) ProtoMessage() {}
+
+func (x *GetEngagementsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEngagementsResp.ProtoReflect.Descriptor instead.
+func (*GetEngagementsResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetEngagementsResp) GetEngagements() []*Engagement {
+	if x != nil {
+		return x.Engagements
+	}
+	return nil
+}
+
+type AddEngagementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Engagement *Engagement `protobuf:"bytes,1,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+}
+
+func (x *AddEngagementReq) Reset() {
+	*x = AddEngagementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEngagementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEngagementReq) ProtoMessage() {}
+
+func (x *AddEngagementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEngagementReq.ProtoReflect.Descriptor instead.
+func (*AddEngagementReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *AddEngagementReq) GetEngagement() *Engagement {
+	if x != nil {
+		return x.Engagement
+	}
+	return nil
+}
+
+type DelEngagementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DelEngagementReq) Reset() {
+	*x = DelEngagementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelEngagementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelEngagementReq) ProtoMessage() {}
+
+func (x *DelEngagementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelEngagementReq.ProtoReflect.Descriptor instead.
+func (*DelEngagementReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *DelEngagementReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ActivateEngagementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *ActivateEngagementReq) Reset() {
+	*x = ActivateEngagementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateEngagementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEngagementReq) ProtoMessage() {}
+
+func (x *ActivateEngagementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEngagementReq.ProtoReflect.Descriptor instead.
+func (*ActivateEngagementReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ActivateEngagementReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ReplayReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Speed float64 `protobuf:"fixed64,1,opt,name=Speed,proto3" json:"Speed,omitempty"`
+	Since int64   `protobuf:"varint,2,opt,name=Since,proto3" json:"Since,omitempty"`
+}
+
+func (x *ReplayReq) Reset() {
+	*x = ReplayReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayReq) ProtoMessage() {}
+
+func (x *ReplayReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayReq.ProtoReflect.Descriptor instead.
+func (*ReplayReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ReplayReq) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *ReplayReq) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type ReplayEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time     int64      `protobuf:"varint,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	Event    *Event     `protobuf:"bytes,2,opt,name=Event,proto3" json:"Event,omitempty"`
+	Snapshot bool       `protobuf:"varint,3,opt,name=Snapshot,proto3" json:"Snapshot,omitempty"`
+	Sessions []*Session `protobuf:"bytes,4,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+}
+
+func (x *ReplayEvent) Reset() {
+	*x = ReplayEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEvent) ProtoMessage() {}
+
+func (x *ReplayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEvent.ProtoReflect.Descriptor instead.
+func (*ReplayEvent) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ReplayEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ReplayEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ReplayEvent) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *ReplayEvent) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type AuditAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string                 `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	Operator   string                 `protobuf:"bytes,3,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Role       string                 `protobuf:"bytes,4,opt,name=Role,proto3" json:"Role,omitempty"`
+	Method     string                 `protobuf:"bytes,5,opt,name=Method,proto3" json:"Method,omitempty"`
+	Params     string                 `protobuf:"bytes,6,opt,name=Params,proto3" json:"Params,omitempty"`
+	Error      string                 `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+	Engagement string                 `protobuf:"bytes,8,opt,name=Engagement,proto3" json:"Engagement,omitempty"`
+}
+
+func (x *AuditAction) Reset() {
+	*x = AuditAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditAction) ProtoMessage() {}
+
+func (x *AuditAction) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditAction.ProtoReflect.Descriptor instead.
+func (*AuditAction) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AuditAction) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *AuditAction) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AuditAction) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AuditAction) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuditAction) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditAction) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+func (x *AuditAction) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditAction) GetEngagement() string {
+	if x != nil {
+		return x.Engagement
+	}
+	return ""
+}
+
+type GetAuditLogReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator string                 `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Method   string                 `protobuf:"bytes,2,opt,name=Method,proto3" json:"Method,omitempty"`
+	Query    string                 `protobuf:"bytes,3,opt,name=Query,proto3" json:"Query,omitempty"`
+	Since    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Since,proto3" json:"Since,omitempty"`
+	Until    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Until,proto3" json:"Until,omitempty"`
+	Failed   bool                   `protobuf:"varint,6,opt,name=Failed,proto3" json:"Failed,omitempty"`
+	Limit    uint32                 `protobuf:"varint,7,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *GetAuditLogReq) Reset() {
+	*x = GetAuditLogReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogReq) ProtoMessage() {}
+
+func (x *GetAuditLogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogReq.ProtoReflect.Descriptor instead.
+func (*GetAuditLogReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetAuditLogReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *GetAuditLogReq) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GetAuditLogReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetAuditLogReq) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetAuditLogReq) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetAuditLogReq) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *GetAuditLogReq) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAuditLogResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*AuditAction `protobuf:"bytes,1,rep,name=Actions,proto3" json:"Actions,omitempty"`
+}
+
+func (x *GetAuditLogResp) Reset() {
+	*x = GetAuditLogResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResp) ProtoMessage() {}
+
+func (x *GetAuditLogResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResp.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetAuditLogResp) GetActions() []*AuditAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type GetMetadataResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator   *Operator `protobuf:"bytes,1,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Config     *Config   `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+	APIVersion uint32    `protobuf:"varint,3,opt,name=APIVersion,proto3" json:"APIVersion,omitempty"`
+}
+
+func (x *GetMetadataResp) Reset() {
+	*x = GetMetadataResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetadataResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetadataResp) ProtoMessage() {}
+
+func (x *GetMetadataResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetadataResp.ProtoReflect.Descriptor instead.
+func (*GetMetadataResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetMetadataResp) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *GetMetadataResp) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetMetadataResp) GetAPIVersion() uint32 {
+	if x != nil {
+		return x.APIVersion
+	}
+	return 0
+}
+
+type GetListenersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listeners []*Listener `protobuf:"bytes,1,rep,name=Listeners,proto3" json:"Listeners,omitempty"`
+}
+
+func (x *GetListenersResp) Reset() {
+	*x = GetListenersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListenersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListenersResp) ProtoMessage() {}
+
+func (x *GetListenersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListenersResp.ProtoReflect.Descriptor instead.
+func (*GetListenersResp) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *GetListenersResp) GetListeners() []*Listener {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+type AddListenerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listener *Listener `protobuf:"bytes,1,opt,name=Listener,proto3" json:"Listener,omitempty"`
+}
+
+func (x *AddListenerReq) Reset() {
+	*x = AddListenerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddListenerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListenerReq) ProtoMessage() {}
+
+func (x *AddListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListenerReq.ProtoReflect.Descriptor instead.
+func (*AddListenerReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *AddListenerReq) GetListener() *Listener {
+	if x != nil {
+		return x.Listener
+	}
+	return nil
+}
+
+type DelListenerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *DelListenerReq) Reset() {
+	*x = DelListenerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelListenerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelListenerReq) ProtoMessage() {}
+
+func (x *DelListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelListenerReq.ProtoReflect.Descriptor instead.
+func (*DelListenerReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *DelListenerReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetListenerEnabledReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+}
+
+func (x *SetListenerEnabledReq) Reset() {
+	*x = SetListenerEnabledReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_ligolo_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetListenerEnabledReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetListenerEnabledReq) ProtoMessage() {}
+
+func (x *SetListenerEnabledReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_ligolo_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetListenerEnabledReq.ProtoReflect.Descriptor instead.
+func (*SetListenerEnabledReq) Descriptor() ([]byte, []int) {
+	return file_protobuf_ligolo_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *SetListenerEnabledReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetListenerEnabledReq) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+var File_protobuf_ligolo_proto protoreflect.FileDescriptor
+
+var file_protobuf_ligolo_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x37, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x26, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x85, 0x0d, 0x0a, 0x07,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x54, 0x75, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x54, 0x75, 0x6e, 0x52, 0x03, 0x54, 0x75, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49,
+	0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x49, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x0a, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x36, 0x0a,
+	0x08, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x6b, 0x65, 0x77, 0x4d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x4d, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x06, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x05, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x09, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73,
+	0x12, 0x37, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x0c, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x29, 0x0a, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x66, 0x66, 0x52, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x20, 0x0a, 0x0b,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x1e, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x1f, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d,
+	0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x12, 0x27,
+	0x0a, 0x07, 0x43, 0x72, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x07,
+	0x43, 0x72, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x43, 0x61, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x42,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x43, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x61, 0x67, 0x73, 0x18, 0x26, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x75, 0x74,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x75, 0x74,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4a, 0x04, 0x08,
+	0x1c, 0x10, 0x1d, 0x22, 0xdf, 0x01, 0x0a, 0x05, 0x43, 0x72, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x57, 0x68, 0x65, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x57, 0x68,
+	0x65, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x46, 0x69, 0x72, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x4c, 0x61, 0x73, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x4f, 0x53, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x4e, 0x53, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x41, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x07, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12,
+	0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x54, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x06, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x41, 0x77, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x77, 0x61,
+	0x6b, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x5b, 0x0a, 0x05, 0x53, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x7c, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x52, 0x74, 0x74, 0x55, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x52, 0x74, 0x74, 0x55, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65,
+	0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c,
+	0x69, 0x76, 0x65, 0x4d, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x12, 0x34, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x56, 0x69, 0x61, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x56, 0x69, 0x61, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x03, 0x54, 0x75,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x53, 0x70, 0x6f, 0x6f, 0x66, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x53, 0x70, 0x6f, 0x6f, 0x66, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x50, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x49, 0x50, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x4e, 0x53, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x44, 0x4e,
+	0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0x7c, 0x0a, 0x08, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7f, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xc9, 0x02, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x69, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x43, 0x69, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x4c, 0x6f, 0x6f, 0x70, 0x62,
+	0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x4c, 0x6f, 0x6f,
+	0x70, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a,
+	0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x46, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x36, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0x86,
+	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x10, 0x0a,
+	0x03, 0x56, 0x69, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x56, 0x69, 0x61, 0x12,
+	0x16, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x22, 0x6e, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0xb2, 0x01, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73,
+	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73,
+	0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x43, 0x41, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x43, 0x41, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0xc2, 0x01, 0x0a,
+	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x50, 0x65, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x65, 0x65,
+	0x72, 0x22, 0xce, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03,
+	0x45, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x49,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x49, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x69, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x56, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xae, 0x02, 0x0a, 0x08,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x34, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x9a, 0x01, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x12,
+	0x10, 0x0a, 0x03, 0x56, 0x69, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x56, 0x69,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22,
+	0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x2b, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x46, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x2d, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65,
+	0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x6f, 0x66,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x44, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x64, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x22, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x77, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x41, 0x77, 0x61, 0x6b, 0x65, 0x22, 0x7d, 0x0a, 0x0b, 0x53, 0x65,
+	0x74, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x93, 0x01, 0x0a, 0x09,
+	0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49,
+	0x73, 0x44, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x44, 0x69,
+	0x72, 0x22, 0x50, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x6a, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x97, 0x01, 0x0a,
+	0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x3e, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x5f, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x6e, 0x0a, 0x08, 0x45, 0x78, 0x65, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x74, 0x64,
+	0x65, 0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x78, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x45,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x45,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x76, 0x0a, 0x08, 0x53, 0x77, 0x65, 0x65, 0x70,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22,
+	0x67, 0x0a, 0x09, 0x53, 0x77, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x52, 0x74, 0x74, 0x55, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x52,
+	0x74, 0x74, 0x55, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x65,
+	0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x44, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x44, 0x6f, 0x6e,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x69,
+	0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x49,
+	0x43, 0x4d, 0x50, 0x22, 0x48, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x2b, 0x0a,
+	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x12, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x51,
+	0x0a, 0x13, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x22, 0x31, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x48, 0x6f, 0x73,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x79, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66,
+	0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x22, 0x48, 0x0a, 0x10, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6f,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x65, 0x0a, 0x0f, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x22, 0x60, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x6e, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x22, 0x29, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x40, 0x0a, 0x0c, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x69, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x22, 0x5a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x2a, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f,
+	0x75, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x45, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x2b, 0x0a, 0x0b, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x4b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x4f,
+	0x4b, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x22, 0x3f, 0x0a, 0x0c, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x06, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x6d, 0x0a, 0x0b, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x22, 0x42, 0x0a, 0x0e, 0x4b, 0x69, 0x6c,
+	0x6c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4b, 0x65, 0x65,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x65, 0x65, 0x70, 0x22, 0x2c, 0x0a,
+	0x0c, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x41, 0x0a, 0x0d, 0x42,
+	0x75, 0x72, 0x6e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x50,
+	0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x22, 0x6b, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x70, 0x0a,
+	0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a,
+	0x0c, 0x4f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x4e,
+	0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
+	0x45, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x44, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x31, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x34, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x6f, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0x66, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0a,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x28,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xc8, 0x08, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x1c, 0x0a,
+	0x09, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x76,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4e, 0x65, 0x74, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x56,
+	0x52, 0x46, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x56, 0x52, 0x46, 0x12, 0x16, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x53,
+	0x65, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x47, 0x61, 0x72, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x54,
+	0x69, 0x6e, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x47, 0x61, 0x72, 0x62, 0x6c,
+	0x65, 0x54, 0x69, 0x6e, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x4c,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x47,
+	0x61, 0x72, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x48, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x12, 0x32, 0x0a, 0x0a, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x08, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x65, 0x72, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x65, 0x78, 0x65, 0x72, 0x52, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78,
+	0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x50, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x50, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x43, 0x41, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x43, 0x41, 0x22, 0xce, 0x02,
+	0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x43,
+	0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x4c, 0x65, 0x67, 0x61, 0x6c, 0x43, 0x6f, 0x70, 0x79, 0x72, 0x69, 0x67, 0x68, 0x74, 0x22, 0xae,
+	0x01, 0x0a, 0x0a, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x6f, 0x74, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x46, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x4a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x6f, 0x0a, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x78, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4b, 0x65, 0x65, 0x70, 0x41,
+	0x6c, 0x69, 0x76, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61,
+	0x78, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x31, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x50, 0x69, 0x6e, 0x73, 0x22, 0xbe, 0x07, 0x0a, 0x0a,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f,
+	0x4f, 0x53, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16,
+	0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6e, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6e, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x47, 0x61, 0x72, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x34, 0x0a, 0x07,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x74, 0x61, 0x67, 0x65, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x12, 0x12, 0x0a, 0x04, 0x46, 0x49, 0x50, 0x53, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x46, 0x49, 0x50, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4b, 0x65,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4b,
+	0x65, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x0a, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4c, 0x6f,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x78, 0x65, 0x72, 0x52, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x78, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x1f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x50, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xc7, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x22, 0x5c, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x22, 0x2b, 0x0a, 0x13, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x0d, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x33, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x44, 0x65,
+	0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x4b, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0x5b, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x3c,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x05, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x48, 0x0a, 0x10,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x34, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x45, 0x76, 0x69, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x45, 0x76, 0x69, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a,
+	0x09, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x43, 0x61, 0x6e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x53, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a,
+	0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x2e, 0x0a,
+	0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x29, 0x0a,
+	0x11, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x72, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x46, 0x72, 0x65, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x2a, 0x0a, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2a, 0x0a, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x22, 0x27, 0x0a,
+	0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x3a, 0x0a, 0x0e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x73, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x12, 0x2a, 0x0a, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x52, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x12,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x2d, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x22, 0x3a, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x0d, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x46, 0x0a, 0x0e, 0x54, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0x32, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x22, 0x0a, 0x05, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x05, 0x43, 0x65,
+	0x72, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x43, 0x65, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x43, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x52, 0x05, 0x43, 0x65, 0x72, 0x74,
+	0x73, 0x22, 0x67, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x0f, 0x55, 0x6e,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x1e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x43, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x43, 0x52,
+	0x4c, 0x22, 0xb0, 0x01, 0x0a, 0x02, 0x43, 0x41, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x41, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x03, 0x43, 0x41, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x41, 0x52, 0x03, 0x43, 0x41, 0x73,
+	0x22, 0x3e, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x43, 0x41, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x27, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a,
+	0x02, 0x43, 0x41, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x43, 0x41, 0x52, 0x02, 0x43, 0x41, 0x22, 0x39, 0x0a, 0x0b, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x41, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x15,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x22, 0x4c, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x22, 0x43, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x09, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x27, 0x0a, 0x11, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x4e, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x43, 0x41, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x43, 0x41, 0x22,
+	0x3f, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x24, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x15, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x04,
+	0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x22, 0xc6,
+	0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x43, 0x41, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x43, 0x41, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a,
+	0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x54, 0x4c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x0e, 0x0a, 0x02, 0x43, 0x41, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x43, 0x41, 0x22, 0x5b, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32,
+	0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6e, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x34, 0x0a, 0x0b, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x45, 0x6e, 0x67, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x67,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x0a, 0x45, 0x6e,
+	0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x37, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x8f, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x2b, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe3,
+	0x01, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2e,
+	0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x41, 0x50, 0x49, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x22, 0x24, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x32, 0xcd, 0x32, 0x0a, 0x06, 0x4c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x12, 0x28, 0x0a, 0x04, 0x4a,
+	0x6f, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x06, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x42,
+	0x75, 0x72, 0x6e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6f, 0x6f, 0x66, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x70, 0x6f, 0x6f, 0x66, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a,
+	0x09, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x32, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x14,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x57, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x57, 0x61,
+	0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a,
+	0x08, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x28, 0x01, 0x12, 0x2d, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x0f, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x77, 0x65, 0x65, 0x70, 0x12, 0x10, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x77, 0x65, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f,
+	0x66, 0x66, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0d, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x18,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x48, 0x61,
+	0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x12, 0x12,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x53, 0x65, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x38, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x48,
+	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x12, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09, 0x4d, 0x6f,
+	0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x30,
+	0x0a, 0x08, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x41, 0x64, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65,
+	0x6c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x64,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x09,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x12, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x12,
+	0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x55, 0x6e, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x55, 0x6e, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x2d, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x43, 0x52, 0x4c, 0x12, 0x0d, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x2d, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x43, 0x41, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x2e, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x43, 0x41, 0x12, 0x10, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x41, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x41, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x41, 0x12, 0x13, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x41, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x28, 0x01, 0x12, 0x57, 0x0a,
+	0x14, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b,
+	0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x36,
+	0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x65,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x45, 0x6e, 0x72,
+	0x6f, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0d, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x64,
+	0x64, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x45, 0x6e, 0x67,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x44,
+	0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1d, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x0f, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0b,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x16, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x32, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f,
+	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x6f, 0x6f,
+	0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x00, 0x28, 0x01, 0x12, 0x40, 0x0a, 0x0e, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x2e, 0x6c,
+	0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x6f, 0x6c, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x11,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x11, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1b,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0d, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x18,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x6c, 0x69, 0x67,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3b, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0d,
+	0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e,
+	0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x6c, 0x69,
+	0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12,
+	0x15, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x74, 0x70, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x6c, 0x69, 0x67, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x6d, 0x70, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_ligolo_proto_rawDescOnce sync.Once
+	file_protobuf_ligolo_proto_rawDescData = file_protobuf_ligolo_proto_rawDesc
+)
+
+func file_protobuf_ligolo_proto_rawDescGZIP() []byte {
+	file_protobuf_ligolo_proto_rawDescOnce.Do(func() {
+		file_protobuf_ligolo_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_ligolo_proto_rawDescData)
+	})
+	return file_protobuf_ligolo_proto_rawDescData
+}
+
+var file_protobuf_ligolo_proto_msgTypes = make([]protoimpl.MessageInfo, 176)
+var file_protobuf_ligolo_proto_goTypes = []interface{}{
+	(*Empty)(nil),                    // 0: ligolo.Empty
+	(*Error)(nil),                    // 1: ligolo.Error
+	(*Event)(nil),                    // 2: ligolo.Event
+	(*SubscribeReq)(nil),             // 3: ligolo.SubscribeReq
+	(*Session)(nil),                  // 4: ligolo.Session
+	(*Crash)(nil),                    // 5: ligolo.Crash
+	(*HostInfo)(nil),                 // 6: ligolo.HostInfo
+	(*Disconnection)(nil),            // 7: ligolo.Disconnection
+	(*Handoff)(nil),                  // 8: ligolo.Handoff
+	(*Beacon)(nil),                   // 9: ligolo.Beacon
+	(*Socks)(nil),                    // 10: ligolo.Socks
+	(*Link)(nil),                     // 11: ligolo.Link
+	(*Attachment)(nil),               // 12: ligolo.Attachment
+	(*Container)(nil),                // 13: ligolo.Container
+	(*Tun)(nil),                      // 14: ligolo.Tun
+	(*Interface)(nil),                // 15: ligolo.Interface
+	(*Neighbor)(nil),                 // 16: ligolo.Neighbor
+	(*SystemRoute)(nil),              // 17: ligolo.SystemRoute
+	(*Route)(nil),                    // 18: ligolo.Route
+	(*RouteProfile)(nil),             // 19: ligolo.RouteProfile
+	(*Redirector)(nil),               // 20: ligolo.Redirector
+	(*Cert)(nil),                     // 21: ligolo.Cert
+	(*Operator)(nil),                 // 22: ligolo.Operator
+	(*Config)(nil),                   // 23: ligolo.Config
+	(*Engagement)(nil),               // 24: ligolo.Engagement
+	(*Traceroute)(nil),               // 25: ligolo.Traceroute
+	(*Listener)(nil),                 // 26: ligolo.Listener
+	(*AddRedirectorReq)(nil),         // 27: ligolo.AddRedirectorReq
+	(*DelRedirectorReq)(nil),         // 28: ligolo.DelRedirectorReq
+	(*GetSessionsResp)(nil),          // 29: ligolo.GetSessionsResp
+	(*RenameSessionReq)(nil),         // 30: ligolo.RenameSessionReq
+	(*StartRelayReq)(nil),            // 31: ligolo.StartRelayReq
+	(*StopRelayReq)(nil),             // 32: ligolo.StopRelayReq
+	(*SetSpoofSourceReq)(nil),        // 33: ligolo.SetSpoofSourceReq
+	(*SetCompressionReq)(nil),        // 34: ligolo.SetCompressionReq
+	(*SetMirrorReq)(nil),             // 35: ligolo.SetMirrorReq
+	(*SetBeaconReq)(nil),             // 36: ligolo.SetBeaconReq
+	(*SetWorkingHoursReq)(nil),       // 37: ligolo.SetWorkingHoursReq
+	(*WakeSessionReq)(nil),           // 38: ligolo.WakeSessionReq
+	(*SetSocksReq)(nil),              // 39: ligolo.SetSocksReq
+	(*ListFilesReq)(nil),             // 40: ligolo.ListFilesReq
+	(*FileEntry)(nil),                // 41: ligolo.FileEntry
+	(*ListFilesResp)(nil),            // 42: ligolo.ListFilesResp
+	(*DownloadFileReq)(nil),          // 43: ligolo.DownloadFileReq
+	(*DownloadFileResp)(nil),         // 44: ligolo.DownloadFileResp
+	(*UploadFileReq)(nil),            // 45: ligolo.UploadFileReq
+	(*UploadFileResp)(nil),           // 46: ligolo.UploadFileResp
+	(*ExecReq)(nil),                  // 47: ligolo.ExecReq
+	(*ExecResp)(nil),                 // 48: ligolo.ExecResp
+	(*SweepReq)(nil),                 // 49: ligolo.SweepReq
+	(*SweepHost)(nil),                // 50: ligolo.SweepHost
+	(*SweepResp)(nil),                // 51: ligolo.SweepResp
+	(*UpdateAgentReq)(nil),           // 52: ligolo.UpdateAgentReq
+	(*UpdateAgentResp)(nil),          // 53: ligolo.UpdateAgentResp
+	(*RotateAgentCertReq)(nil),       // 54: ligolo.RotateAgentCertReq
+	(*RotateAgentCertResp)(nil),      // 55: ligolo.RotateAgentCertResp
+	(*RefreshNetworkReq)(nil),        // 56: ligolo.RefreshNetworkReq
+	(*RefreshNetworkResp)(nil),       // 57: ligolo.RefreshNetworkResp
+	(*RefreshHostInfoReq)(nil),       // 58: ligolo.RefreshHostInfoReq
+	(*HandoffSessionReq)(nil),        // 59: ligolo.HandoffSessionReq
+	(*AnswerHandoffReq)(nil),         // 60: ligolo.AnswerHandoffReq
+	(*ClaimSessionReq)(nil),          // 61: ligolo.ClaimSessionReq
+	(*PersistReq)(nil),               // 62: ligolo.PersistReq
+	(*PersistResp)(nil),              // 63: ligolo.PersistResp
+	(*BreakoutRule)(nil),             // 64: ligolo.BreakoutRule
+	(*SetBreakoutReq)(nil),           // 65: ligolo.SetBreakoutReq
+	(*SetBandwidthReq)(nil),          // 66: ligolo.SetBandwidthReq
+	(*DiagnoseReq)(nil),              // 67: ligolo.DiagnoseReq
+	(*DiagnosticCheck)(nil),          // 68: ligolo.DiagnosticCheck
+	(*DiagnoseResp)(nil),             // 69: ligolo.DiagnoseResp
+	(*AnnotateReq)(nil),              // 70: ligolo.AnnotateReq
+	(*KillSessionReq)(nil),           // 71: ligolo.KillSessionReq
+	(*BurnAgentReq)(nil),             // 72: ligolo.BurnAgentReq
+	(*BurnAgentResp)(nil),            // 73: ligolo.BurnAgentResp
+	(*AddRouteReq)(nil),              // 74: ligolo.AddRouteReq
+	(*EditRouteReq)(nil),             // 75: ligolo.EditRouteReq
+	(*MoveRouteReq)(nil),             // 76: ligolo.MoveRouteReq
+	(*DelRouteReq)(nil),              // 77: ligolo.DelRouteReq
+	(*GetRouteProfilesResp)(nil),     // 78: ligolo.GetRouteProfilesResp
+	(*AddRouteProfileReq)(nil),       // 79: ligolo.AddRouteProfileReq
+	(*GetAttachmentsReq)(nil),        // 80: ligolo.GetAttachmentsReq
+	(*GetAttachmentsResp)(nil),       // 81: ligolo.GetAttachmentsResp
+	(*AddAttachmentReq)(nil),         // 82: ligolo.AddAttachmentReq
+	(*DownloadAttachmentReq)(nil),    // 83: ligolo.DownloadAttachmentReq
+	(*DownloadAttachmentResp)(nil),   // 84: ligolo.DownloadAttachmentResp
+	(*DelAttachmentReq)(nil),         // 85: ligolo.DelAttachmentReq
+	(*DelRouteProfileReq)(nil),       // 86: ligolo.DelRouteProfileReq
+	(*ApplyRouteProfileReq)(nil),     // 87: ligolo.ApplyRouteProfileReq
+	(*GenerateAgentReq)(nil),         // 88: ligolo.GenerateAgentReq
+	(*WindowsResources)(nil),         // 89: ligolo.WindowsResources
+	(*Guardrails)(nil),               // 90: ligolo.Guardrails
+	(*Schedule)(nil),                 // 91: ligolo.Schedule
+	(*Multiplexer)(nil),              // 92: ligolo.Multiplexer
+	(*Pinning)(nil),                  // 93: ligolo.Pinning
+	(*AgentBuild)(nil),               // 94: ligolo.AgentBuild
+	(*GenerateAgentResp)(nil),        // 95: ligolo.GenerateAgentResp
+	(*RenderAgentReq)(nil),           // 96: ligolo.RenderAgentReq
+	(*RenderAgentResp)(nil),          // 97: ligolo.RenderAgentResp
+	(*CancelAgentBuildReq)(nil),      // 98: ligolo.CancelAgentBuildReq
+	(*AgentTemplate)(nil),            // 99: ligolo.AgentTemplate
+	(*GetAgentTemplatesResp)(nil),    // 100: ligolo.GetAgentTemplatesResp
+	(*AddAgentTemplateReq)(nil),      // 101: ligolo.AddAgentTemplateReq
+	(*DelAgentTemplateReq)(nil),      // 102: ligolo.DelAgentTemplateReq
+	(*SigningKey)(nil),               // 103: ligolo.SigningKey
+	(*GetSigningKeysResp)(nil),       // 104: ligolo.GetSigningKeysResp
+	(*BuildHook)(nil),                // 105: ligolo.BuildHook
+	(*GetBuildHooksResp)(nil),        // 106: ligolo.GetBuildHooksResp
+	(*TransportProfile)(nil),         // 107: ligolo.TransportProfile
+	(*GetTransportProfilesResp)(nil), // 108: ligolo.GetTransportProfilesResp
+	(*AssetUsage)(nil),               // 109: ligolo.AssetUsage
+	(*Toolchain)(nil),                // 110: ligolo.Toolchain
+	(*GetAssetUsageResp)(nil),        // 111: ligolo.GetAssetUsageResp
+	(*CollectAssetsReq)(nil),         // 112: ligolo.CollectAssetsReq
+	(*CollectAssetsResp)(nil),        // 113: ligolo.CollectAssetsResp
+	(*AddSigningKeyReq)(nil),         // 114: ligolo.AddSigningKeyReq
+	(*DelSigningKeyReq)(nil),         // 115: ligolo.DelSigningKeyReq
+	(*LookupAgentBuildReq)(nil),      // 116: ligolo.LookupAgentBuildReq
+	(*LookupAgentBuildResp)(nil),     // 117: ligolo.LookupAgentBuildResp
+	(*GetAgentBuildsResp)(nil),       // 118: ligolo.GetAgentBuildsResp
+	(*DownloadAgentBuildReq)(nil),    // 119: ligolo.DownloadAgentBuildReq
+	(*DownloadAgentBuildResp)(nil),   // 120: ligolo.DownloadAgentBuildResp
+	(*RegenerateAgentReq)(nil),       // 121: ligolo.RegenerateAgentReq
+	(*BuildRecipeReq)(nil),           // 122: ligolo.BuildRecipeReq
+	(*BuildRecipeResp)(nil),          // 123: ligolo.BuildRecipeResp
+	(*UploadToolchainReq)(nil),       // 124: ligolo.UploadToolchainReq
+	(*FetchToolchainReq)(nil),        // 125: ligolo.FetchToolchainReq
+	(*TracerouteReq)(nil),            // 126: ligolo.TracerouteReq
+	(*TracerouteResp)(nil),           // 127: ligolo.TracerouteResp
+	(*ThroughputReq)(nil),            // 128: ligolo.ThroughputReq
+	(*ThroughputResp)(nil),           // 129: ligolo.ThroughputResp
+	(*GetCertsResp)(nil),             // 130: ligolo.GetCertsResp
+	(*RegenCertReq)(nil),             // 131: ligolo.RegenCertReq
+	(*RevokedCert)(nil),              // 132: ligolo.RevokedCert
+	(*GetRevokedCertsResp)(nil),      // 133: ligolo.GetRevokedCertsResp
+	(*RevokeCertReq)(nil),            // 134: ligolo.RevokeCertReq
+	(*UnrevokeCertReq)(nil),          // 135: ligolo.UnrevokeCertReq
+	(*GetCRLResp)(nil),               // 136: ligolo.GetCRLResp
+	(*CA)(nil),                       // 137: ligolo.CA
+	(*GetCAsResp)(nil),               // 138: ligolo.GetCAsResp
+	(*AddCAReq)(nil),                 // 139: ligolo.AddCAReq
+	(*AddCAResp)(nil),                // 140: ligolo.AddCAResp
+	(*RevokeCAReq)(nil),              // 141: ligolo.RevokeCAReq
+	(*ExportServerStateReq)(nil),     // 142: ligolo.ExportServerStateReq
+	(*ExportServerStateResp)(nil),    // 143: ligolo.ExportServerStateResp
+	(*ImportServerStateReq)(nil),     // 144: ligolo.ImportServerStateReq
+	(*ImportServerStateResp)(nil),    // 145: ligolo.ImportServerStateResp
+	(*GetOperatorsResp)(nil),         // 146: ligolo.GetOperatorsResp
+	(*ExportOperatorReq)(nil),        // 147: ligolo.ExportOperatorReq
+	(*ExportOperatorResp)(nil),       // 148: ligolo.ExportOperatorResp
+	(*AddOperatorReq)(nil),           // 149: ligolo.AddOperatorReq
+	(*AddOperatorResp)(nil),          // 150: ligolo.AddOperatorResp
+	(*DelOperatorReq)(nil),           // 151: ligolo.DelOperatorReq
+	(*PromoteOperatorReq)(nil),       // 152: ligolo.PromoteOperatorReq
+	(*DemoteOperatorReq)(nil),        // 153: ligolo.DemoteOperatorReq
+	(*SetOperatorRoleReq)(nil),       // 154: ligolo.SetOperatorRoleReq
+	(*RenewOperatorCertReq)(nil),     // 155: ligolo.RenewOperatorCertReq
+	(*RenewOperatorCertResp)(nil),    // 156: ligolo.RenewOperatorCertResp
+	(*Enrollment)(nil),               // 157: ligolo.Enrollment
+	(*GetEnrollmentsResp)(nil),       // 158: ligolo.GetEnrollmentsResp
+	(*AddEnrollmentReq)(nil),         // 159: ligolo.AddEnrollmentReq
+	(*AddEnrollmentResp)(nil),        // 160: ligolo.AddEnrollmentResp
+	(*DelEnrollmentReq)(nil),         // 161: ligolo.DelEnrollmentReq
+	(*GetEngagementsResp)(nil),       // 162: ligolo.GetEngagementsResp
+	(*AddEngagementReq)(nil),         // 163: ligolo.AddEngagementReq
+	(*DelEngagementReq)(nil),         // 164: ligolo.DelEngagementReq
+	(*ActivateEngagementReq)(nil),    // 165: ligolo.ActivateEngagementReq
+	(*ReplayReq)(nil),                // 166: ligolo.ReplayReq
+	(*ReplayEvent)(nil),              // 167: ligolo.ReplayEvent
+	(*AuditAction)(nil),              // 168: ligolo.AuditAction
+	(*GetAuditLogReq)(nil),           // 169: ligolo.GetAuditLogReq
+	(*GetAuditLogResp)(nil),          // 170: ligolo.GetAuditLogResp
+	(*GetMetadataResp)(nil),          // 171: ligolo.GetMetadataResp
+	(*GetListenersResp)(nil),         // 172: ligolo.GetListenersResp
+	(*AddListenerReq)(nil),           // 173: ligolo.AddListenerReq
+	(*DelListenerReq)(nil),           // 174: ligolo.DelListenerReq
+	(*SetListenerEnabledReq)(nil),    // 175: ligolo.SetListenerEnabledReq
+	(*timestamppb.Timestamp)(nil),    // 176: google.protobuf.Timestamp
+}
+var file_protobuf_ligolo_proto_depIdxs = []int32{
+	14,  // 0: ligolo.Session.Tun:type_name -> ligolo.Tun
+	15,  // 1: ligolo.Session.Interfaces:type_name -> ligolo.Interface
+	20,  // 2: ligolo.Session.Redirectors:type_name -> ligolo.Redirector
+	176, // 3: ligolo.Session.FirstSeen:type_name -> google.protobuf.Timestamp
+	176, // 4: ligolo.Session.LastSeen:type_name -> google.protobuf.Timestamp
+	13,  // 5: ligolo.Session.Container:type_name -> ligolo.Container
+	11,  // 6: ligolo.Session.Link:type_name -> ligolo.Link
+	9,   // 7: ligolo.Session.Beacon:type_name -> ligolo.Beacon
+	176, // 8: ligolo.Session.EgressChanged:type_name -> google.protobuf.Timestamp
+	10,  // 9: ligolo.Session.Socks:type_name -> ligolo.Socks
+	7,   // 10: ligolo.Session.Disconnection:type_name -> ligolo.Disconnection
+	16,  // 11: ligolo.Session.Neighbors:type_name -> ligolo.Neighbor
+	17,  // 12: ligolo.Session.SystemRoutes:type_name -> ligolo.SystemRoute
+	176, // 13: ligolo.Session.NetworkRefreshed:type_name -> google.protobuf.Timestamp
+	8,   // 14: ligolo.Session.Handoff:type_name -> ligolo.Handoff
+	64,  // 15: ligolo.Session.Breakout:type_name -> ligolo.BreakoutRule
+	6,   // 16: ligolo.Session.Host:type_name -> ligolo.HostInfo
+	176, // 17: ligolo.Session.HostRefreshed:type_name -> google.protobuf.Timestamp
+	5,   // 18: ligolo.Session.Crashes:type_name -> ligolo.Crash
+	176, // 19: ligolo.Crash.First:type_name -> google.protobuf.Timestamp
+	176, // 20: ligolo.Crash.Last:type_name -> google.protobuf.Timestamp
+	176, // 21: ligolo.Disconnection.At:type_name -> google.protobuf.Timestamp
+	176, // 22: ligolo.Handoff.Requested:type_name -> google.protobuf.Timestamp
+	176, // 23: ligolo.Attachment.Created:type_name -> google.protobuf.Timestamp
+	18,  // 24: ligolo.Tun.Routes:type_name -> ligolo.Route
+	176, // 25: ligolo.Route.LastUsed:type_name -> google.protobuf.Timestamp
+	18,  // 26: ligolo.RouteProfile.Routes:type_name -> ligolo.Route
+	21,  // 27: ligolo.Operator.Cert:type_name -> ligolo.Cert
+	176, // 28: ligolo.Engagement.Start:type_name -> google.protobuf.Timestamp
+	176, // 29: ligolo.Engagement.End:type_name -> google.protobuf.Timestamp
+	176, // 30: ligolo.Listener.Created:type_name -> google.protobuf.Timestamp
+	4,   // 31: ligolo.GetSessionsResp.Sessions:type_name -> ligolo.Session
+	176, // 32: ligolo.FileEntry.ModTime:type_name -> google.protobuf.Timestamp
+	41,  // 33: ligolo.ListFilesResp.Entries:type_name -> ligolo.FileEntry
+	50,  // 34: ligolo.SweepResp.Host:type_name -> ligolo.SweepHost
+	64,  // 35: ligolo.SetBreakoutReq.Rules:type_name -> ligolo.BreakoutRule
+	68,  // 36: ligolo.DiagnoseResp.Checks:type_name -> ligolo.DiagnosticCheck
+	18,  // 37: ligolo.AddRouteReq.Route:type_name -> ligolo.Route
+	18,  // 38: ligolo.EditRouteReq.Route:type_name -> ligolo.Route
+	19,  // 39: ligolo.GetRouteProfilesResp.Profiles:type_name -> ligolo.RouteProfile
+	19,  // 40: ligolo.AddRouteProfileReq.Profile:type_name -> ligolo.RouteProfile
+	12,  // 41: ligolo.GetAttachmentsResp.Attachments:type_name -> ligolo.Attachment
+	12,  // 42: ligolo.DownloadAttachmentResp.Attachment:type_name -> ligolo.Attachment
+	89,  // 43: ligolo.GenerateAgentReq.Resources:type_name -> ligolo.WindowsResources
+	90,  // 44: ligolo.GenerateAgentReq.Guardrails:type_name -> ligolo.Guardrails
+	91,  // 45: ligolo.GenerateAgentReq.Schedule:type_name -> ligolo.Schedule
+	92,  // 46: ligolo.GenerateAgentReq.Multiplexer:type_name -> ligolo.Multiplexer
+	93,  // 47: ligolo.GenerateAgentReq.Pinning:type_name -> ligolo.Pinning
+	176, // 48: ligolo.AgentBuild.Created:type_name -> google.protobuf.Timestamp
+	90,  // 49: ligolo.AgentBuild.Guardrails:type_name -> ligolo.Guardrails
+	91,  // 50: ligolo.AgentBuild.Schedule:type_name -> ligolo.Schedule
+	92,  // 51: ligolo.AgentBuild.Multiplexer:type_name -> ligolo.Multiplexer
+	93,  // 52: ligolo.AgentBuild.Pinning:type_name -> ligolo.Pinning
+	94,  // 53: ligolo.GenerateAgentResp.Build:type_name -> ligolo.AgentBuild
+	88,  // 54: ligolo.RenderAgentReq.Request:type_name -> ligolo.GenerateAgentReq
+	99,  // 55: ligolo.GetAgentTemplatesResp.Templates:type_name -> ligolo.AgentTemplate
+	103, // 56: ligolo.GetSigningKeysResp.Keys:type_name -> ligolo.SigningKey
+	105, // 57: ligolo.GetBuildHooksResp.Hooks:type_name -> ligolo.BuildHook
+	107, // 58: ligolo.GetTransportProfilesResp.Profiles:type_name -> ligolo.TransportProfile
+	176, // 59: ligolo.Toolchain.Installed:type_name -> google.protobuf.Timestamp
+	109, // 60: ligolo.GetAssetUsageResp.Usage:type_name -> ligolo.AssetUsage
+	94,  // 61: ligolo.LookupAgentBuildResp.Builds:type_name -> ligolo.AgentBuild
+	94,  // 62: ligolo.GetAgentBuildsResp.Builds:type_name -> ligolo.AgentBuild
+	94,  // 63: ligolo.DownloadAgentBuildResp.Build:type_name -> ligolo.AgentBuild
+	94,  // 64: ligolo.BuildRecipeResp.Builds:type_name -> ligolo.AgentBuild
+	25,  // 65: ligolo.TracerouteResp.Trace:type_name -> ligolo.Traceroute
+	21,  // 66: ligolo.GetCertsResp.Certs:type_name -> ligolo.Cert
+	132, // 67: ligolo.GetRevokedCertsResp.Certs:type_name -> ligolo.RevokedCert
+	137, // 68: ligolo.GetCAsResp.CAs:type_name -> ligolo.CA
+	137, // 69: ligolo.AddCAResp.CA:type_name -> ligolo.CA
+	22,  // 70: ligolo.GetOperatorsResp.Operators:type_name -> ligolo.Operator
+	22,  // 71: ligolo.ExportOperatorResp.Operator:type_name -> ligolo.Operator
+	22,  // 72: ligolo.AddOperatorReq.Operator:type_name -> ligolo.Operator
+	22,  // 73: ligolo.AddOperatorResp.Operator:type_name -> ligolo.Operator
+	21,  // 74: ligolo.RenewOperatorCertResp.Cert:type_name -> ligolo.Cert
+	157, // 75: ligolo.GetEnrollmentsResp.Enrollments:type_name -> ligolo.Enrollment
+	157, // 76: ligolo.AddEnrollmentResp.Enrollment:type_name -> ligolo.Enrollment
+	24,  // 77: ligolo.GetEngagementsResp.Engagements:type_name -> ligolo.Engagement
+	24,  // 78: ligolo.AddEngagementReq.Engagement:type_name -> ligolo.Engagement
+	2,   // 79: ligolo.ReplayEvent.Event:type_name -> ligolo.Event
+	4,   // 80: ligolo.ReplayEvent.Sessions:type_name -> ligolo.Session
+	176, // 81: ligolo.AuditAction.Time:type_name -> google.protobuf.Timestamp
+	176, // 82: ligolo.GetAuditLogReq.Since:type_name -> google.protobuf.Timestamp
+	176, // 83: ligolo.GetAuditLogReq.Until:type_name -> google.protobuf.Timestamp
+	168, // 84: ligolo.GetAuditLogResp.Actions:type_name -> ligolo.AuditAction
+	22,  // 85: ligolo.GetMetadataResp.Operator:type_name -> ligolo.Operator
+	23,  // 86: ligolo.GetMetadataResp.Config:type_name -> ligolo.Config
+	26,  // 87: ligolo.GetListenersResp.Listeners:type_name -> ligolo.Listener
+	26,  // 88: ligolo.AddListenerReq.Listener:type_name -> ligolo.Listener
+	0,   // 89: ligolo.Ligolo.Join:input_type -> ligolo.Empty
+	3,   // 90: ligolo.Ligolo.Subscribe:input_type -> ligolo.SubscribeReq
+	166, // 91: ligolo.Ligolo.Replay:input_type -> ligolo.ReplayReq
+	169, // 92: ligolo.Ligolo.GetAuditLog:input_type -> ligolo.GetAuditLogReq
+	0,   // 93: ligolo.Ligolo.GetMetadata:input_type -> ligolo.Empty
+	0,   // 94: ligolo.Ligolo.GetSessions:input_type -> ligolo.Empty
+	30,  // 95: ligolo.Ligolo.RenameSession:input_type -> ligolo.RenameSessionReq
+	70,  // 96: ligolo.Ligolo.Annotate:input_type -> ligolo.AnnotateReq
+	71,  // 97: ligolo.Ligolo.KillSession:input_type -> ligolo.KillSessionReq
+	72,  // 98: ligolo.Ligolo.BurnAgent:input_type -> ligolo.BurnAgentReq
+	31,  // 99: ligolo.Ligolo.StartRelay:input_type -> ligolo.StartRelayReq
+	32,  // 100: ligolo.Ligolo.StopRelay:input_type -> ligolo.StopRelayReq
+	33,  // 101: ligolo.Ligolo.SetSpoofSource:input_type -> ligolo.SetSpoofSourceReq
+	34,  // 102: ligolo.Ligolo.SetCompression:input_type -> ligolo.SetCompressionReq
+	35,  // 103: ligolo.Ligolo.SetMirror:input_type -> ligolo.SetMirrorReq
+	36,  // 104: ligolo.Ligolo.SetBeacon:input_type -> ligolo.SetBeaconReq
+	37,  // 105: ligolo.Ligolo.SetWorkingHours:input_type -> ligolo.SetWorkingHoursReq
+	38,  // 106: ligolo.Ligolo.WakeSession:input_type -> ligolo.WakeSessionReq
+	39,  // 107: ligolo.Ligolo.SetSocks:input_type -> ligolo.SetSocksReq
+	40,  // 108: ligolo.Ligolo.ListFiles:input_type -> ligolo.ListFilesReq
+	43,  // 109: ligolo.Ligolo.DownloadFile:input_type -> ligolo.DownloadFileReq
+	45,  // 110: ligolo.Ligolo.UploadFile:input_type -> ligolo.UploadFileReq
+	47,  // 111: ligolo.Ligolo.Exec:input_type -> ligolo.ExecReq
+	49,  // 112: ligolo.Ligolo.Sweep:input_type -> ligolo.SweepReq
+	52,  // 113: ligolo.Ligolo.UpdateAgent:input_type -> ligolo.UpdateAgentReq
+	54,  // 114: ligolo.Ligolo.RotateAgentCert:input_type -> ligolo.RotateAgentCertReq
+	56,  // 115: ligolo.Ligolo.RefreshNetwork:input_type -> ligolo.RefreshNetworkReq
+	59,  // 116: ligolo.Ligolo.HandoffSession:input_type -> ligolo.HandoffSessionReq
+	60,  // 117: ligolo.Ligolo.AnswerHandoff:input_type -> ligolo.AnswerHandoffReq
+	61,  // 118: ligolo.Ligolo.ClaimSession:input_type -> ligolo.ClaimSessionReq
+	62,  // 119: ligolo.Ligolo.Persist:input_type -> ligolo.PersistReq
+	65,  // 120: ligolo.Ligolo.SetBreakout:input_type -> ligolo.SetBreakoutReq
+	66,  // 121: ligolo.Ligolo.SetBandwidth:input_type -> ligolo.SetBandwidthReq
+	58,  // 122: ligolo.Ligolo.RefreshHostInfo:input_type -> ligolo.RefreshHostInfoReq
+	67,  // 123: ligolo.Ligolo.Diagnose:input_type -> ligolo.DiagnoseReq
+	74,  // 124: ligolo.Ligolo.AddRoute:input_type -> ligolo.AddRouteReq
+	75,  // 125: ligolo.Ligolo.EditRoute:input_type -> ligolo.EditRouteReq
+	76,  // 126: ligolo.Ligolo.MoveRoute:input_type -> ligolo.MoveRouteReq
+	77,  // 127: ligolo.Ligolo.DelRoute:input_type -> ligolo.DelRouteReq
+	0,   // 128: ligolo.Ligolo.GetRouteProfiles:input_type -> ligolo.Empty
+	79,  // 129: ligolo.Ligolo.AddRouteProfile:input_type -> ligolo.AddRouteProfileReq
+	86,  // 130: ligolo.Ligolo.DelRouteProfile:input_type -> ligolo.DelRouteProfileReq
+	87,  // 131: ligolo.Ligolo.ApplyRouteProfile:input_type -> ligolo.ApplyRouteProfileReq
+	27,  // 132: ligolo.Ligolo.AddRedirector:input_type -> ligolo.AddRedirectorReq
+	28,  // 133: ligolo.Ligolo.DelRedirector:input_type -> ligolo.DelRedirectorReq
+	80,  // 134: ligolo.Ligolo.GetAttachments:input_type -> ligolo.GetAttachmentsReq
+	82,  // 135: ligolo.Ligolo.AddAttachment:input_type -> ligolo.AddAttachmentReq
+	83,  // 136: ligolo.Ligolo.DownloadAttachment:input_type -> ligolo.DownloadAttachmentReq
+	85,  // 137: ligolo.Ligolo.DelAttachment:input_type -> ligolo.DelAttachmentReq
+	0,   // 138: ligolo.Ligolo.GetCerts:input_type -> ligolo.Empty
+	131, // 139: ligolo.Ligolo.RegenCert:input_type -> ligolo.RegenCertReq
+	0,   // 140: ligolo.Ligolo.GetRevokedCerts:input_type -> ligolo.Empty
+	134, // 141: ligolo.Ligolo.RevokeCert:input_type -> ligolo.RevokeCertReq
+	135, // 142: ligolo.Ligolo.UnrevokeCert:input_type -> ligolo.UnrevokeCertReq
+	0,   // 143: ligolo.Ligolo.GetCRL:input_type -> ligolo.Empty
+	0,   // 144: ligolo.Ligolo.GetCAs:input_type -> ligolo.Empty
+	139, // 145: ligolo.Ligolo.AddCA:input_type -> ligolo.AddCAReq
+	141, // 146: ligolo.Ligolo.RevokeCA:input_type -> ligolo.RevokeCAReq
+	142, // 147: ligolo.Ligolo.ExportServerState:input_type -> ligolo.ExportServerStateReq
+	144, // 148: ligolo.Ligolo.ImportServerState:input_type -> ligolo.ImportServerStateReq
+	142, // 149: ligolo.Ligolo.ReplicateServerState:input_type -> ligolo.ExportServerStateReq
+	0,   // 150: ligolo.Ligolo.GetOperators:input_type -> ligolo.Empty
+	147, // 151: ligolo.Ligolo.ExportOperator:input_type -> ligolo.ExportOperatorReq
+	149, // 152: ligolo.Ligolo.AddOperator:input_type -> ligolo.AddOperatorReq
+	151, // 153: ligolo.Ligolo.DelOperator:input_type -> ligolo.DelOperatorReq
+	152, // 154: ligolo.Ligolo.PromoteOperator:input_type -> ligolo.PromoteOperatorReq
+	153, // 155: ligolo.Ligolo.DemoteOperator:input_type -> ligolo.DemoteOperatorReq
+	154, // 156: ligolo.Ligolo.SetOperatorRole:input_type -> ligolo.SetOperatorRoleReq
+	155, // 157: ligolo.Ligolo.RenewOperatorCert:input_type -> ligolo.RenewOperatorCertReq
+	0,   // 158: ligolo.Ligolo.GetEnrollments:input_type -> ligolo.Empty
+	159, // 159: ligolo.Ligolo.AddEnrollment:input_type -> ligolo.AddEnrollmentReq
+	161, // 160: ligolo.Ligolo.DelEnrollment:input_type -> ligolo.DelEnrollmentReq
+	0,   // 161: ligolo.Ligolo.GetEngagements:input_type -> ligolo.Empty
+	163, // 162: ligolo.Ligolo.AddEngagement:input_type -> ligolo.AddEngagementReq
+	164, // 163: ligolo.Ligolo.DelEngagement:input_type -> ligolo.DelEngagementReq
+	165, // 164: ligolo.Ligolo.ActivateEngagement:input_type -> ligolo.ActivateEngagementReq
+	0,   // 165: ligolo.Ligolo.GetListeners:input_type -> ligolo.Empty
+	173, // 166: ligolo.Ligolo.AddListener:input_type -> ligolo.AddListenerReq
+	174, // 167: ligolo.Ligolo.DelListener:input_type -> ligolo.DelListenerReq
+	175, // 168: ligolo.Ligolo.SetListenerEnabled:input_type -> ligolo.SetListenerEnabledReq
+	88,  // 169: ligolo.Ligolo.GenerateAgent:input_type -> ligolo.GenerateAgentReq
+	96,  // 170: ligolo.Ligolo.RenderAgent:input_type -> ligolo.RenderAgentReq
+	98,  // 171: ligolo.Ligolo.CancelAgentBuild:input_type -> ligolo.CancelAgentBuildReq
+	116, // 172: ligolo.Ligolo.LookupAgentBuild:input_type -> ligolo.LookupAgentBuildReq
+	0,   // 173: ligolo.Ligolo.GetAgentBuilds:input_type -> ligolo.Empty
+	119, // 174: ligolo.Ligolo.DownloadAgentBuild:input_type -> ligolo.DownloadAgentBuildReq
+	121, // 175: ligolo.Ligolo.RegenerateAgent:input_type -> ligolo.RegenerateAgentReq
+	122, // 176: ligolo.Ligolo.BuildRecipe:input_type -> ligolo.BuildRecipeReq
+	0,   // 177: ligolo.Ligolo.GetToolchain:input_type -> ligolo.Empty
+	124, // 178: ligolo.Ligolo.UploadToolchain:input_type -> ligolo.UploadToolchainReq
+	125, // 179: ligolo.Ligolo.FetchToolchain:input_type -> ligolo.FetchToolchainReq
+	0,   // 180: ligolo.Ligolo.RollbackToolchain:input_type -> ligolo.Empty
+	0,   // 181: ligolo.Ligolo.GetAgentTemplates:input_type -> ligolo.Empty
+	101, // 182: ligolo.Ligolo.AddAgentTemplate:input_type -> ligolo.AddAgentTemplateReq
+	102, // 183: ligolo.Ligolo.DelAgentTemplate:input_type -> ligolo.DelAgentTemplateReq
+	0,   // 184: ligolo.Ligolo.GetSigningKeys:input_type -> ligolo.Empty
+	114, // 185: ligolo.Ligolo.AddSigningKey:input_type -> ligolo.AddSigningKeyReq
+	115, // 186: ligolo.Ligolo.DelSigningKey:input_type -> ligolo.DelSigningKeyReq
+	0,   // 187: ligolo.Ligolo.GetBuildHooks:input_type -> ligolo.Empty
+	0,   // 188: ligolo.Ligolo.GetTransportProfiles:input_type -> ligolo.Empty
+	0,   // 189: ligolo.Ligolo.GetAssetUsage:input_type -> ligolo.Empty
+	112, // 190: ligolo.Ligolo.CollectAssets:input_type -> ligolo.CollectAssetsReq
+	126, // 191: ligolo.Ligolo.Traceroute:input_type -> ligolo.TracerouteReq
+	128, // 192: ligolo.Ligolo.Throughput:input_type -> ligolo.ThroughputReq
+	2,   // 193: ligolo.Ligolo.Join:output_type -> ligolo.Event
+	2,   // 194: ligolo.Ligolo.Subscribe:output_type -> ligolo.Event
+	167, // 195: ligolo.Ligolo.Replay:output_type -> ligolo.ReplayEvent
+	170, // 196: ligolo.Ligolo.GetAuditLog:output_type -> ligolo.GetAuditLogResp
+	171, // 197: ligolo.Ligolo.GetMetadata:output_type -> ligolo.GetMetadataResp
+	29,  // 198: ligolo.Ligolo.GetSessions:output_type -> ligolo.GetSessionsResp
+	0,   // 199: ligolo.Ligolo.RenameSession:output_type -> ligolo.Empty
+	0,   // 200: ligolo.Ligolo.Annotate:output_type -> ligolo.Empty
+	0,   // 201: ligolo.Ligolo.KillSession:output_type -> ligolo.Empty
+	73,  // 202: ligolo.Ligolo.BurnAgent:output_type -> ligolo.BurnAgentResp
+	0,   // 203: ligolo.Ligolo.StartRelay:output_type -> ligolo.Empty
+	0,   // 204: ligolo.Ligolo.StopRelay:output_type -> ligolo.Empty
+	0,   // 205: ligolo.Ligolo.SetSpoofSource:output_type -> ligolo.Empty
+	0,   // 206: ligolo.Ligolo.SetCompression:output_type -> ligolo.Empty
+	0,   // 207: ligolo.Ligolo.SetMirror:output_type -> ligolo.Empty
+	0,   // 208: ligolo.Ligolo.SetBeacon:output_type -> ligolo.Empty
+	0,   // 209: ligolo.Ligolo.SetWorkingHours:output_type -> ligolo.Empty
+	0,   // 210: ligolo.Ligolo.WakeSession:output_type -> ligolo.Empty
+	0,   // 211: ligolo.Ligolo.SetSocks:output_type -> ligolo.Empty
+	42,  // 212: ligolo.Ligolo.ListFiles:output_type -> ligolo.ListFilesResp
+	44,  // 213: ligolo.Ligolo.DownloadFile:output_type -> ligolo.DownloadFileResp
+	46,  // 214: ligolo.Ligolo.UploadFile:output_type -> ligolo.UploadFileResp
+	48,  // 215: ligolo.Ligolo.Exec:output_type -> ligolo.ExecResp
+	51,  // 216: ligolo.Ligolo.Sweep:output_type -> ligolo.SweepResp
+	53,  // 217: ligolo.Ligolo.UpdateAgent:output_type -> ligolo.UpdateAgentResp
+	55,  // 218: ligolo.Ligolo.RotateAgentCert:output_type -> ligolo.RotateAgentCertResp
+	57,  // 219: ligolo.Ligolo.RefreshNetwork:output_type -> ligolo.RefreshNetworkResp
+	0,   // 220: ligolo.Ligolo.HandoffSession:output_type -> ligolo.Empty
+	0,   // 221: ligolo.Ligolo.AnswerHandoff:output_type -> ligolo.Empty
+	0,   // 222: ligolo.Ligolo.ClaimSession:output_type -> ligolo.Empty
+	63,  // 223: ligolo.Ligolo.Persist:output_type -> ligolo.PersistResp
+	0,   // 224: ligolo.Ligolo.SetBreakout:output_type -> ligolo.Empty
+	0,   // 225: ligolo.Ligolo.SetBandwidth:output_type -> ligolo.Empty
+	0,   // 226: ligolo.Ligolo.RefreshHostInfo:output_type -> ligolo.Empty
+	69,  // 227: ligolo.Ligolo.Diagnose:output_type -> ligolo.DiagnoseResp
+	0,   // 228: ligolo.Ligolo.AddRoute:output_type -> ligolo.Empty
+	0,   // 229: ligolo.Ligolo.EditRoute:output_type -> ligolo.Empty
+	0,   // 230: ligolo.Ligolo.MoveRoute:output_type -> ligolo.Empty
+	0,   // 231: ligolo.Ligolo.DelRoute:output_type -> ligolo.Empty
+	78,  // 232: ligolo.Ligolo.GetRouteProfiles:output_type -> ligolo.GetRouteProfilesResp
+	0,   // 233: ligolo.Ligolo.AddRouteProfile:output_type -> ligolo.Empty
+	0,   // 234: ligolo.Ligolo.DelRouteProfile:output_type -> ligolo.Empty
+	0,   // 235: ligolo.Ligolo.ApplyRouteProfile:output_type -> ligolo.Empty
+	0,   // 236: ligolo.Ligolo.AddRedirector:output_type -> ligolo.Empty
+	0,   // 237: ligolo.Ligolo.DelRedirector:output_type -> ligolo.Empty
+	81,  // 238: ligolo.Ligolo.GetAttachments:output_type -> ligolo.GetAttachmentsResp
+	0,   // 239: ligolo.Ligolo.AddAttachment:output_type -> ligolo.Empty
+	84,  // 240: ligolo.Ligolo.DownloadAttachment:output_type -> ligolo.DownloadAttachmentResp
+	0,   // 241: ligolo.Ligolo.DelAttachment:output_type -> ligolo.Empty
+	130, // 242: ligolo.Ligolo.GetCerts:output_type -> ligolo.GetCertsResp
+	0,   // 243: ligolo.Ligolo.RegenCert:output_type -> ligolo.Empty
+	133, // 244: ligolo.Ligolo.GetRevokedCerts:output_type -> ligolo.GetRevokedCertsResp
+	0,   // 245: ligolo.Ligolo.RevokeCert:output_type -> ligolo.Empty
+	0,   // 246: ligolo.Ligolo.UnrevokeCert:output_type -> ligolo.Empty
+	136, // 247: ligolo.Ligolo.GetCRL:output_type -> ligolo.GetCRLResp
+	138, // 248: ligolo.Ligolo.GetCAs:output_type -> ligolo.GetCAsResp
+	140, // 249: ligolo.Ligolo.AddCA:output_type -> ligolo.AddCAResp
+	0,   // 250: ligolo.Ligolo.RevokeCA:output_type -> ligolo.Empty
+	143, // 251: ligolo.Ligolo.ExportServerState:output_type -> ligolo.ExportServerStateResp
+	145, // 252: ligolo.Ligolo.ImportServerState:output_type -> ligolo.ImportServerStateResp
+	143, // 253: ligolo.Ligolo.ReplicateServerState:output_type -> ligolo.ExportServerStateResp
+	146, // 254: ligolo.Ligolo.GetOperators:output_type -> ligolo.GetOperatorsResp
+	148, // 255: ligolo.Ligolo.ExportOperator:output_type -> ligolo.ExportOperatorResp
+	150, // 256: ligolo.Ligolo.AddOperator:output_type -> ligolo.AddOperatorResp
+	0,   // 257: ligolo.Ligolo.DelOperator:output_type -> ligolo.Empty
+	0,   // 258: ligolo.Ligolo.PromoteOperator:output_type -> ligolo.Empty
+	0,   // 259: ligolo.Ligolo.DemoteOperator:output_type -> ligolo.Empty
+	0,   // 260: ligolo.Ligolo.SetOperatorRole:output_type -> ligolo.Empty
+	156, // 261: ligolo.Ligolo.RenewOperatorCert:output_type -> ligolo.RenewOperatorCertResp
+	158, // 262: ligolo.Ligolo.GetEnrollments:output_type -> ligolo.GetEnrollmentsResp
+	160, // 263: ligolo.Ligolo.AddEnrollment:output_type -> ligolo.AddEnrollmentResp
+	0,   // 264: ligolo.Ligolo.DelEnrollment:output_type -> ligolo.Empty
+	162, // 265: ligolo.Ligolo.GetEngagements:output_type -> ligolo.GetEngagementsResp
+	0,   // 266: ligolo.Ligolo.AddEngagement:output_type -> ligolo.Empty
+	0,   // 267: ligolo.Ligolo.DelEngagement:output_type -> ligolo.Empty
+	0,   // 268: ligolo.Ligolo.ActivateEngagement:output_type -> ligolo.Empty
+	172, // 269: ligolo.Ligolo.GetListeners:output_type -> ligolo.GetListenersResp
+	0,   // 270: ligolo.Ligolo.AddListener:output_type -> ligolo.Empty
+	0,   // 271: ligolo.Ligolo.DelListener:output_type -> ligolo.Empty
+	0,   // 272: ligolo.Ligolo.SetListenerEnabled:output_type -> ligolo.Empty
+	95,  // 273: ligolo.Ligolo.GenerateAgent:output_type -> ligolo.GenerateAgentResp
+	97,  // 274: ligolo.Ligolo.RenderAgent:output_type -> ligolo.RenderAgentResp
+	0,   // 275: ligolo.Ligolo.CancelAgentBuild:output_type -> ligolo.Empty
+	117, // 276: ligolo.Ligolo.LookupAgentBuild:output_type -> ligolo.LookupAgentBuildResp
+	118, // 277: ligolo.Ligolo.GetAgentBuilds:output_type -> ligolo.GetAgentBuildsResp
+	120, // 278: ligolo.Ligolo.DownloadAgentBuild:output_type -> ligolo.DownloadAgentBuildResp
+	95,  // 279: ligolo.Ligolo.RegenerateAgent:output_type -> ligolo.GenerateAgentResp
+	123, // 280: ligolo.Ligolo.BuildRecipe:output_type -> ligolo.BuildRecipeResp
+	110, // 281: ligolo.Ligolo.GetToolchain:output_type -> ligolo.Toolchain
+	110, // 282: ligolo.Ligolo.UploadToolchain:output_type -> ligolo.Toolchain
+	110, // 283: ligolo.Ligolo.FetchToolchain:output_type -> ligolo.Toolchain
+	110, // 284: ligolo.Ligolo.RollbackToolchain:output_type -> ligolo.Toolchain
+	100, // 285: ligolo.Ligolo.GetAgentTemplates:output_type -> ligolo.GetAgentTemplatesResp
+	0,   // 286: ligolo.Ligolo.AddAgentTemplate:output_type -> ligolo.Empty
+	0,   // 287: ligolo.Ligolo.DelAgentTemplate:output_type -> ligolo.Empty
+	104, // 288: ligolo.Ligolo.GetSigningKeys:output_type -> ligolo.GetSigningKeysResp
+	0,   // 289: ligolo.Ligolo.AddSigningKey:output_type -> ligolo.Empty
+	0,   // 290: ligolo.Ligolo.DelSigningKey:output_type -> ligolo.Empty
+	106, // 291: ligolo.Ligolo.GetBuildHooks:output_type -> ligolo.GetBuildHooksResp
+	108, // 292: ligolo.Ligolo.GetTransportProfiles:output_type -> ligolo.GetTransportProfilesResp
+	111, // 293: ligolo.Ligolo.GetAssetUsage:output_type -> ligolo.GetAssetUsageResp
+	113, // 294: ligolo.Ligolo.CollectAssets:output_type -> ligolo.CollectAssetsResp
+	127, // 295: ligolo.Ligolo.Traceroute:output_type -> ligolo.TracerouteResp
+	129, // 296: ligolo.Ligolo.Throughput:output_type -> ligolo.ThroughputResp
+	193, // [193:297] is the sub-list for method output_type
+	89,  // [89:193] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_ligolo_proto_init() }
+func file_protobuf_ligolo_proto_init() {
+	if File_protobuf_ligolo_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_ligolo_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Crash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Disconnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Handoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Beacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Socks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Container); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Neighbor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Redirector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Engagement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Traceroute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRedirectorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelRedirectorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRelayReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRelayReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSpoofSourceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCompressionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMirrorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBeaconReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorkingHoursReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WakeSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSocksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFileResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFileResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepHost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAgentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAgentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateAgentCertReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateAgentCertResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshNetworkReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshNetworkResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshHostInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandoffSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerHandoffReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakoutRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBreakoutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBandwidthReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnoseResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnotateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnAgentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnAgentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRouteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditRouteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveRouteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelRouteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRouteProfilesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRouteProfileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAttachmentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAttachmentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAttachmentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAttachmentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelRouteProfileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyRouteProfileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateAgentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindowsResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Guardrails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Multiplexer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pinning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentBuild); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateAgentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderAgentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderAgentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAgentBuildReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentTemplatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAgentTemplateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAgentTemplateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSigningKeysResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildHook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBuildHooksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransportProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransportProfilesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Toolchain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssetUsageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAssetsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectAssetsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSigningKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelSigningKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupAgentBuildReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupAgentBuildResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAgentBuildsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAgentBuildReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAgentBuildResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateAgentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildRecipeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildRecipeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadToolchainReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchToolchainReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracerouteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThroughputReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThroughputResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCertsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenCertReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2635,8 +15223,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Error); i {
+		file_protobuf_ligolo_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokedCert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2647,8 +15235,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_protobuf_ligolo_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRevokedCertsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2659,8 +15247,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Session); i {
+		file_protobuf_ligolo_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeCertReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2671,8 +15259,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tun); i {
+		file_protobuf_ligolo_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnrevokeCertReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2683,8 +15271,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface); i {
+		file_protobuf_ligolo_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCRLResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2695,8 +15283,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Route); i {
+		file_protobuf_ligolo_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CA); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2707,8 +15295,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Redirector); i {
+		file_protobuf_ligolo_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCAsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2719,8 +15307,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cert); i {
+		file_protobuf_ligolo_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCAReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2731,8 +15319,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operator); i {
+		file_protobuf_ligolo_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCAResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2743,8 +15331,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		file_protobuf_ligolo_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeCAReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2755,8 +15343,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Traceroute); i {
+		file_protobuf_ligolo_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportServerStateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2767,8 +15355,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRedirectorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportServerStateResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2779,8 +15367,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelRedirectorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportServerStateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2791,8 +15379,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSessionsResp); i {
+		file_protobuf_ligolo_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportServerStateResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2803,8 +15391,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameSessionReq); i {
+		file_protobuf_ligolo_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperatorsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2815,8 +15403,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartRelayReq); i {
+		file_protobuf_ligolo_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOperatorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2827,8 +15415,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRelayReq); i {
+		file_protobuf_ligolo_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOperatorResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2839,8 +15427,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillSessionReq); i {
+		file_protobuf_ligolo_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOperatorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2851,8 +15439,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddRouteReq); i {
+		file_protobuf_ligolo_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOperatorResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2863,8 +15451,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditRouteReq); i {
+		file_protobuf_ligolo_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelOperatorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2875,8 +15463,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveRouteReq); i {
+		file_protobuf_ligolo_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteOperatorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2887,8 +15475,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelRouteReq); i {
+		file_protobuf_ligolo_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DemoteOperatorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2899,8 +15487,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateAgentReq); i {
+		file_protobuf_ligolo_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOperatorRoleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2911,8 +15499,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateAgentResp); i {
+		file_protobuf_ligolo_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewOperatorCertReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2923,8 +15511,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TracerouteReq); i {
+		file_protobuf_ligolo_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewOperatorCertResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2935,8 +15523,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TracerouteResp); i {
+		file_protobuf_ligolo_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enrollment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2947,8 +15535,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCertsResp); i {
+		file_protobuf_ligolo_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnrollmentsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2959,8 +15547,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegenCertReq); i {
+		file_protobuf_ligolo_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEnrollmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2971,8 +15559,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOperatorsResp); i {
+		file_protobuf_ligolo_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEnrollmentResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2983,8 +15571,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportOperatorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelEnrollmentReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2995,8 +15583,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportOperatorResp); i {
+		file_protobuf_ligolo_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEngagementsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3007,8 +15595,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOperatorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEngagementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3019,8 +15607,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddOperatorResp); i {
+		file_protobuf_ligolo_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelEngagementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3031,8 +15619,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelOperatorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateEngagementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3043,8 +15631,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PromoteOperatorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3055,8 +15643,8 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DemoteOperatorReq); i {
+		file_protobuf_ligolo_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3067,7 +15655,43 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_ligolo_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_ligolo_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMetadataResp); i {
 			case 0:
 				return &v.state
@@ -3079,6 +15703,54 @@ func file_protobuf_ligolo_proto_init() {
 				return nil
 			}
 		}
+		file_protobuf_ligolo_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListenersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_ligolo_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetListenerEnabledReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3086,7 +15758,7 @@ func file_protobuf_ligolo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_ligolo_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   176,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,29 +19,110 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Ligolo_Join_FullMethodName            = "/ligolo.Ligolo/Join"
-	Ligolo_GetMetadata_FullMethodName     = "/ligolo.Ligolo/GetMetadata"
-	Ligolo_GetSessions_FullMethodName     = "/ligolo.Ligolo/GetSessions"
-	Ligolo_RenameSession_FullMethodName   = "/ligolo.Ligolo/RenameSession"
-	Ligolo_KillSession_FullMethodName     = "/ligolo.Ligolo/KillSession"
-	Ligolo_StartRelay_FullMethodName      = "/ligolo.Ligolo/StartRelay"
-	Ligolo_StopRelay_FullMethodName       = "/ligolo.Ligolo/StopRelay"
-	Ligolo_AddRoute_FullMethodName        = "/ligolo.Ligolo/AddRoute"
-	Ligolo_EditRoute_FullMethodName       = "/ligolo.Ligolo/EditRoute"
-	Ligolo_MoveRoute_FullMethodName       = "/ligolo.Ligolo/MoveRoute"
-	Ligolo_DelRoute_FullMethodName        = "/ligolo.Ligolo/DelRoute"
-	Ligolo_AddRedirector_FullMethodName   = "/ligolo.Ligolo/AddRedirector"
-	Ligolo_DelRedirector_FullMethodName   = "/ligolo.Ligolo/DelRedirector"
-	Ligolo_GetCerts_FullMethodName        = "/ligolo.Ligolo/GetCerts"
-	Ligolo_RegenCert_FullMethodName       = "/ligolo.Ligolo/RegenCert"
-	Ligolo_GetOperators_FullMethodName    = "/ligolo.Ligolo/GetOperators"
-	Ligolo_ExportOperator_FullMethodName  = "/ligolo.Ligolo/ExportOperator"
-	Ligolo_AddOperator_FullMethodName     = "/ligolo.Ligolo/AddOperator"
-	Ligolo_DelOperator_FullMethodName     = "/ligolo.Ligolo/DelOperator"
-	Ligolo_PromoteOperator_FullMethodName = "/ligolo.Ligolo/PromoteOperator"
-	Ligolo_DemoteOperator_FullMethodName  = "/ligolo.Ligolo/DemoteOperator"
-	Ligolo_GenerateAgent_FullMethodName   = "/ligolo.Ligolo/GenerateAgent"
-	Ligolo_Traceroute_FullMethodName      = "/ligolo.Ligolo/Traceroute"
+	Ligolo_Join_FullMethodName                 = "/ligolo.Ligolo/Join"
+	Ligolo_Subscribe_FullMethodName            = "/ligolo.Ligolo/Subscribe"
+	Ligolo_Replay_FullMethodName               = "/ligolo.Ligolo/Replay"
+	Ligolo_GetAuditLog_FullMethodName          = "/ligolo.Ligolo/GetAuditLog"
+	Ligolo_GetMetadata_FullMethodName          = "/ligolo.Ligolo/GetMetadata"
+	Ligolo_GetSessions_FullMethodName          = "/ligolo.Ligolo/GetSessions"
+	Ligolo_RenameSession_FullMethodName        = "/ligolo.Ligolo/RenameSession"
+	Ligolo_Annotate_FullMethodName             = "/ligolo.Ligolo/Annotate"
+	Ligolo_KillSession_FullMethodName          = "/ligolo.Ligolo/KillSession"
+	Ligolo_BurnAgent_FullMethodName            = "/ligolo.Ligolo/BurnAgent"
+	Ligolo_StartRelay_FullMethodName           = "/ligolo.Ligolo/StartRelay"
+	Ligolo_StopRelay_FullMethodName            = "/ligolo.Ligolo/StopRelay"
+	Ligolo_SetSpoofSource_FullMethodName       = "/ligolo.Ligolo/SetSpoofSource"
+	Ligolo_SetCompression_FullMethodName       = "/ligolo.Ligolo/SetCompression"
+	Ligolo_SetMirror_FullMethodName            = "/ligolo.Ligolo/SetMirror"
+	Ligolo_SetBeacon_FullMethodName            = "/ligolo.Ligolo/SetBeacon"
+	Ligolo_SetWorkingHours_FullMethodName      = "/ligolo.Ligolo/SetWorkingHours"
+	Ligolo_WakeSession_FullMethodName          = "/ligolo.Ligolo/WakeSession"
+	Ligolo_SetSocks_FullMethodName             = "/ligolo.Ligolo/SetSocks"
+	Ligolo_ListFiles_FullMethodName            = "/ligolo.Ligolo/ListFiles"
+	Ligolo_DownloadFile_FullMethodName         = "/ligolo.Ligolo/DownloadFile"
+	Ligolo_UploadFile_FullMethodName           = "/ligolo.Ligolo/UploadFile"
+	Ligolo_Exec_FullMethodName                 = "/ligolo.Ligolo/Exec"
+	Ligolo_Sweep_FullMethodName                = "/ligolo.Ligolo/Sweep"
+	Ligolo_UpdateAgent_FullMethodName          = "/ligolo.Ligolo/UpdateAgent"
+	Ligolo_RotateAgentCert_FullMethodName      = "/ligolo.Ligolo/RotateAgentCert"
+	Ligolo_RefreshNetwork_FullMethodName       = "/ligolo.Ligolo/RefreshNetwork"
+	Ligolo_HandoffSession_FullMethodName       = "/ligolo.Ligolo/HandoffSession"
+	Ligolo_AnswerHandoff_FullMethodName        = "/ligolo.Ligolo/AnswerHandoff"
+	Ligolo_ClaimSession_FullMethodName         = "/ligolo.Ligolo/ClaimSession"
+	Ligolo_Persist_FullMethodName              = "/ligolo.Ligolo/Persist"
+	Ligolo_SetBreakout_FullMethodName          = "/ligolo.Ligolo/SetBreakout"
+	Ligolo_SetBandwidth_FullMethodName         = "/ligolo.Ligolo/SetBandwidth"
+	Ligolo_RefreshHostInfo_FullMethodName      = "/ligolo.Ligolo/RefreshHostInfo"
+	Ligolo_Diagnose_FullMethodName             = "/ligolo.Ligolo/Diagnose"
+	Ligolo_AddRoute_FullMethodName             = "/ligolo.Ligolo/AddRoute"
+	Ligolo_EditRoute_FullMethodName            = "/ligolo.Ligolo/EditRoute"
+	Ligolo_MoveRoute_FullMethodName            = "/ligolo.Ligolo/MoveRoute"
+	Ligolo_DelRoute_FullMethodName             = "/ligolo.Ligolo/DelRoute"
+	Ligolo_GetRouteProfiles_FullMethodName     = "/ligolo.Ligolo/GetRouteProfiles"
+	Ligolo_AddRouteProfile_FullMethodName      = "/ligolo.Ligolo/AddRouteProfile"
+	Ligolo_DelRouteProfile_FullMethodName      = "/ligolo.Ligolo/DelRouteProfile"
+	Ligolo_ApplyRouteProfile_FullMethodName    = "/ligolo.Ligolo/ApplyRouteProfile"
+	Ligolo_AddRedirector_FullMethodName        = "/ligolo.Ligolo/AddRedirector"
+	Ligolo_DelRedirector_FullMethodName        = "/ligolo.Ligolo/DelRedirector"
+	Ligolo_GetAttachments_FullMethodName       = "/ligolo.Ligolo/GetAttachments"
+	Ligolo_AddAttachment_FullMethodName        = "/ligolo.Ligolo/AddAttachment"
+	Ligolo_DownloadAttachment_FullMethodName   = "/ligolo.Ligolo/DownloadAttachment"
+	Ligolo_DelAttachment_FullMethodName        = "/ligolo.Ligolo/DelAttachment"
+	Ligolo_GetCerts_FullMethodName             = "/ligolo.Ligolo/GetCerts"
+	Ligolo_RegenCert_FullMethodName            = "/ligolo.Ligolo/RegenCert"
+	Ligolo_GetRevokedCerts_FullMethodName      = "/ligolo.Ligolo/GetRevokedCerts"
+	Ligolo_RevokeCert_FullMethodName           = "/ligolo.Ligolo/RevokeCert"
+	Ligolo_UnrevokeCert_FullMethodName         = "/ligolo.Ligolo/UnrevokeCert"
+	Ligolo_GetCRL_FullMethodName               = "/ligolo.Ligolo/GetCRL"
+	Ligolo_GetCAs_FullMethodName               = "/ligolo.Ligolo/GetCAs"
+	Ligolo_AddCA_FullMethodName                = "/ligolo.Ligolo/AddCA"
+	Ligolo_RevokeCA_FullMethodName             = "/ligolo.Ligolo/RevokeCA"
+	Ligolo_ExportServerState_FullMethodName    = "/ligolo.Ligolo/ExportServerState"
+	Ligolo_ImportServerState_FullMethodName    = "/ligolo.Ligolo/ImportServerState"
+	Ligolo_ReplicateServerState_FullMethodName = "/ligolo.Ligolo/ReplicateServerState"
+	Ligolo_GetOperators_FullMethodName         = "/ligolo.Ligolo/GetOperators"
+	Ligolo_ExportOperator_FullMethodName       = "/ligolo.Ligolo/ExportOperator"
+	Ligolo_AddOperator_FullMethodName          = "/ligolo.Ligolo/AddOperator"
+	Ligolo_DelOperator_FullMethodName          = "/ligolo.Ligolo/DelOperator"
+	Ligolo_PromoteOperator_FullMethodName      = "/ligolo.Ligolo/PromoteOperator"
+	Ligolo_DemoteOperator_FullMethodName       = "/ligolo.Ligolo/DemoteOperator"
+	Ligolo_SetOperatorRole_FullMethodName      = "/ligolo.Ligolo/SetOperatorRole"
+	Ligolo_RenewOperatorCert_FullMethodName    = "/ligolo.Ligolo/RenewOperatorCert"
+	Ligolo_GetEnrollments_FullMethodName       = "/ligolo.Ligolo/GetEnrollments"
+	Ligolo_AddEnrollment_FullMethodName        = "/ligolo.Ligolo/AddEnrollment"
+	Ligolo_DelEnrollment_FullMethodName        = "/ligolo.Ligolo/DelEnrollment"
+	Ligolo_GetEngagements_FullMethodName       = "/ligolo.Ligolo/GetEngagements"
+	Ligolo_AddEngagement_FullMethodName        = "/ligolo.Ligolo/AddEngagement"
+	Ligolo_DelEngagement_FullMethodName        = "/ligolo.Ligolo/DelEngagement"
+	Ligolo_ActivateEngagement_FullMethodName   = "/ligolo.Ligolo/ActivateEngagement"
+	Ligolo_GetListeners_FullMethodName         = "/ligolo.Ligolo/GetListeners"
+	Ligolo_AddListener_FullMethodName          = "/ligolo.Ligolo/AddListener"
+	Ligolo_DelListener_FullMethodName          = "/ligolo.Ligolo/DelListener"
+	Ligolo_SetListenerEnabled_FullMethodName   = "/ligolo.Ligolo/SetListenerEnabled"
+	Ligolo_GenerateAgent_FullMethodName        = "/ligolo.Ligolo/GenerateAgent"
+	Ligolo_RenderAgent_FullMethodName          = "/ligolo.Ligolo/RenderAgent"
+	Ligolo_CancelAgentBuild_FullMethodName     = "/ligolo.Ligolo/CancelAgentBuild"
+	Ligolo_LookupAgentBuild_FullMethodName     = "/ligolo.Ligolo/LookupAgentBuild"
+	Ligolo_GetAgentBuilds_FullMethodName       = "/ligolo.Ligolo/GetAgentBuilds"
+	Ligolo_DownloadAgentBuild_FullMethodName   = "/ligolo.Ligolo/DownloadAgentBuild"
+	Ligolo_RegenerateAgent_FullMethodName      = "/ligolo.Ligolo/RegenerateAgent"
+	Ligolo_BuildRecipe_FullMethodName          = "/ligolo.Ligolo/BuildRecipe"
+	Ligolo_GetToolchain_FullMethodName         = "/ligolo.Ligolo/GetToolchain"
+	Ligolo_UploadToolchain_FullMethodName      = "/ligolo.Ligolo/UploadToolchain"
+	Ligolo_FetchToolchain_FullMethodName       = "/ligolo.Ligolo/FetchToolchain"
+	Ligolo_RollbackToolchain_FullMethodName    = "/ligolo.Ligolo/RollbackToolchain"
+	Ligolo_GetAgentTemplates_FullMethodName    = "/ligolo.Ligolo/GetAgentTemplates"
+	Ligolo_AddAgentTemplate_FullMethodName     = "/ligolo.Ligolo/AddAgentTemplate"
+	Ligolo_DelAgentTemplate_FullMethodName     = "/ligolo.Ligolo/DelAgentTemplate"
+	Ligolo_GetSigningKeys_FullMethodName       = "/ligolo.Ligolo/GetSigningKeys"
+	Ligolo_AddSigningKey_FullMethodName        = "/ligolo.Ligolo/AddSigningKey"
+	Ligolo_DelSigningKey_FullMethodName        = "/ligolo.Ligolo/DelSigningKey"
+	Ligolo_GetBuildHooks_FullMethodName        = "/ligolo.Ligolo/GetBuildHooks"
+	Ligolo_GetTransportProfiles_FullMethodName = "/ligolo.Ligolo/GetTransportProfiles"
+	Ligolo_GetAssetUsage_FullMethodName        = "/ligolo.Ligolo/GetAssetUsage"
+	Ligolo_CollectAssets_FullMethodName        = "/ligolo.Ligolo/CollectAssets"
+	Ligolo_Traceroute_FullMethodName           = "/ligolo.Ligolo/Traceroute"
+	Ligolo_Throughput_FullMethodName           = "/ligolo.Ligolo/Throughput"
 )
 
 // LigoloClient is the client API for Ligolo service.
@@ -49,28 +130,109 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LigoloClient interface {
 	Join(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Ligolo_JoinClient, error)
+	Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Ligolo_SubscribeClient, error)
+	Replay(ctx context.Context, in *ReplayReq, opts ...grpc.CallOption) (Ligolo_ReplayClient, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogReq, opts ...grpc.CallOption) (*GetAuditLogResp, error)
 	GetMetadata(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetMetadataResp, error)
 	GetSessions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetSessionsResp, error)
 	RenameSession(ctx context.Context, in *RenameSessionReq, opts ...grpc.CallOption) (*Empty, error)
+	Annotate(ctx context.Context, in *AnnotateReq, opts ...grpc.CallOption) (*Empty, error)
 	KillSession(ctx context.Context, in *KillSessionReq, opts ...grpc.CallOption) (*Empty, error)
+	BurnAgent(ctx context.Context, in *BurnAgentReq, opts ...grpc.CallOption) (*BurnAgentResp, error)
 	StartRelay(ctx context.Context, in *StartRelayReq, opts ...grpc.CallOption) (*Empty, error)
 	StopRelay(ctx context.Context, in *StopRelayReq, opts ...grpc.CallOption) (*Empty, error)
+	SetSpoofSource(ctx context.Context, in *SetSpoofSourceReq, opts ...grpc.CallOption) (*Empty, error)
+	SetCompression(ctx context.Context, in *SetCompressionReq, opts ...grpc.CallOption) (*Empty, error)
+	SetMirror(ctx context.Context, in *SetMirrorReq, opts ...grpc.CallOption) (*Empty, error)
+	SetBeacon(ctx context.Context, in *SetBeaconReq, opts ...grpc.CallOption) (*Empty, error)
+	SetWorkingHours(ctx context.Context, in *SetWorkingHoursReq, opts ...grpc.CallOption) (*Empty, error)
+	WakeSession(ctx context.Context, in *WakeSessionReq, opts ...grpc.CallOption) (*Empty, error)
+	SetSocks(ctx context.Context, in *SetSocksReq, opts ...grpc.CallOption) (*Empty, error)
+	ListFiles(ctx context.Context, in *ListFilesReq, opts ...grpc.CallOption) (*ListFilesResp, error)
+	DownloadFile(ctx context.Context, in *DownloadFileReq, opts ...grpc.CallOption) (Ligolo_DownloadFileClient, error)
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (Ligolo_UploadFileClient, error)
+	Exec(ctx context.Context, in *ExecReq, opts ...grpc.CallOption) (Ligolo_ExecClient, error)
+	Sweep(ctx context.Context, in *SweepReq, opts ...grpc.CallOption) (Ligolo_SweepClient, error)
+	UpdateAgent(ctx context.Context, in *UpdateAgentReq, opts ...grpc.CallOption) (*UpdateAgentResp, error)
+	RotateAgentCert(ctx context.Context, in *RotateAgentCertReq, opts ...grpc.CallOption) (*RotateAgentCertResp, error)
+	RefreshNetwork(ctx context.Context, in *RefreshNetworkReq, opts ...grpc.CallOption) (*RefreshNetworkResp, error)
+	HandoffSession(ctx context.Context, in *HandoffSessionReq, opts ...grpc.CallOption) (*Empty, error)
+	AnswerHandoff(ctx context.Context, in *AnswerHandoffReq, opts ...grpc.CallOption) (*Empty, error)
+	ClaimSession(ctx context.Context, in *ClaimSessionReq, opts ...grpc.CallOption) (*Empty, error)
+	Persist(ctx context.Context, in *PersistReq, opts ...grpc.CallOption) (*PersistResp, error)
+	SetBreakout(ctx context.Context, in *SetBreakoutReq, opts ...grpc.CallOption) (*Empty, error)
+	SetBandwidth(ctx context.Context, in *SetBandwidthReq, opts ...grpc.CallOption) (*Empty, error)
+	RefreshHostInfo(ctx context.Context, in *RefreshHostInfoReq, opts ...grpc.CallOption) (*Empty, error)
+	Diagnose(ctx context.Context, in *DiagnoseReq, opts ...grpc.CallOption) (*DiagnoseResp, error)
 	AddRoute(ctx context.Context, in *AddRouteReq, opts ...grpc.CallOption) (*Empty, error)
 	EditRoute(ctx context.Context, in *EditRouteReq, opts ...grpc.CallOption) (*Empty, error)
 	MoveRoute(ctx context.Context, in *MoveRouteReq, opts ...grpc.CallOption) (*Empty, error)
 	DelRoute(ctx context.Context, in *DelRouteReq, opts ...grpc.CallOption) (*Empty, error)
+	GetRouteProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetRouteProfilesResp, error)
+	AddRouteProfile(ctx context.Context, in *AddRouteProfileReq, opts ...grpc.CallOption) (*Empty, error)
+	DelRouteProfile(ctx context.Context, in *DelRouteProfileReq, opts ...grpc.CallOption) (*Empty, error)
+	ApplyRouteProfile(ctx context.Context, in *ApplyRouteProfileReq, opts ...grpc.CallOption) (*Empty, error)
 	AddRedirector(ctx context.Context, in *AddRedirectorReq, opts ...grpc.CallOption) (*Empty, error)
 	DelRedirector(ctx context.Context, in *DelRedirectorReq, opts ...grpc.CallOption) (*Empty, error)
+	GetAttachments(ctx context.Context, in *GetAttachmentsReq, opts ...grpc.CallOption) (*GetAttachmentsResp, error)
+	AddAttachment(ctx context.Context, in *AddAttachmentReq, opts ...grpc.CallOption) (*Empty, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentReq, opts ...grpc.CallOption) (*DownloadAttachmentResp, error)
+	DelAttachment(ctx context.Context, in *DelAttachmentReq, opts ...grpc.CallOption) (*Empty, error)
 	GetCerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCertsResp, error)
 	RegenCert(ctx context.Context, in *RegenCertReq, opts ...grpc.CallOption) (*Empty, error)
+	GetRevokedCerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetRevokedCertsResp, error)
+	RevokeCert(ctx context.Context, in *RevokeCertReq, opts ...grpc.CallOption) (*Empty, error)
+	UnrevokeCert(ctx context.Context, in *UnrevokeCertReq, opts ...grpc.CallOption) (*Empty, error)
+	GetCRL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCRLResp, error)
+	GetCAs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCAsResp, error)
+	AddCA(ctx context.Context, in *AddCAReq, opts ...grpc.CallOption) (*AddCAResp, error)
+	RevokeCA(ctx context.Context, in *RevokeCAReq, opts ...grpc.CallOption) (*Empty, error)
+	ExportServerState(ctx context.Context, in *ExportServerStateReq, opts ...grpc.CallOption) (Ligolo_ExportServerStateClient, error)
+	ImportServerState(ctx context.Context, opts ...grpc.CallOption) (Ligolo_ImportServerStateClient, error)
+	ReplicateServerState(ctx context.Context, in *ExportServerStateReq, opts ...grpc.CallOption) (Ligolo_ReplicateServerStateClient, error)
 	GetOperators(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetOperatorsResp, error)
 	ExportOperator(ctx context.Context, in *ExportOperatorReq, opts ...grpc.CallOption) (*ExportOperatorResp, error)
 	AddOperator(ctx context.Context, in *AddOperatorReq, opts ...grpc.CallOption) (*AddOperatorResp, error)
 	DelOperator(ctx context.Context, in *DelOperatorReq, opts ...grpc.CallOption) (*Empty, error)
 	PromoteOperator(ctx context.Context, in *PromoteOperatorReq, opts ...grpc.CallOption) (*Empty, error)
 	DemoteOperator(ctx context.Context, in *DemoteOperatorReq, opts ...grpc.CallOption) (*Empty, error)
-	GenerateAgent(ctx context.Context, in *GenerateAgentReq, opts ...grpc.CallOption) (*GenerateAgentResp, error)
+	SetOperatorRole(ctx context.Context, in *SetOperatorRoleReq, opts ...grpc.CallOption) (*Empty, error)
+	RenewOperatorCert(ctx context.Context, in *RenewOperatorCertReq, opts ...grpc.CallOption) (*RenewOperatorCertResp, error)
+	GetEnrollments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetEnrollmentsResp, error)
+	AddEnrollment(ctx context.Context, in *AddEnrollmentReq, opts ...grpc.CallOption) (*AddEnrollmentResp, error)
+	DelEnrollment(ctx context.Context, in *DelEnrollmentReq, opts ...grpc.CallOption) (*Empty, error)
+	GetEngagements(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetEngagementsResp, error)
+	AddEngagement(ctx context.Context, in *AddEngagementReq, opts ...grpc.CallOption) (*Empty, error)
+	DelEngagement(ctx context.Context, in *DelEngagementReq, opts ...grpc.CallOption) (*Empty, error)
+	ActivateEngagement(ctx context.Context, in *ActivateEngagementReq, opts ...grpc.CallOption) (*Empty, error)
+	GetListeners(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetListenersResp, error)
+	AddListener(ctx context.Context, in *AddListenerReq, opts ...grpc.CallOption) (*Empty, error)
+	DelListener(ctx context.Context, in *DelListenerReq, opts ...grpc.CallOption) (*Empty, error)
+	SetListenerEnabled(ctx context.Context, in *SetListenerEnabledReq, opts ...grpc.CallOption) (*Empty, error)
+	GenerateAgent(ctx context.Context, in *GenerateAgentReq, opts ...grpc.CallOption) (Ligolo_GenerateAgentClient, error)
+	RenderAgent(ctx context.Context, in *RenderAgentReq, opts ...grpc.CallOption) (*RenderAgentResp, error)
+	CancelAgentBuild(ctx context.Context, in *CancelAgentBuildReq, opts ...grpc.CallOption) (*Empty, error)
+	LookupAgentBuild(ctx context.Context, in *LookupAgentBuildReq, opts ...grpc.CallOption) (*LookupAgentBuildResp, error)
+	GetAgentBuilds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAgentBuildsResp, error)
+	DownloadAgentBuild(ctx context.Context, in *DownloadAgentBuildReq, opts ...grpc.CallOption) (*DownloadAgentBuildResp, error)
+	RegenerateAgent(ctx context.Context, in *RegenerateAgentReq, opts ...grpc.CallOption) (Ligolo_RegenerateAgentClient, error)
+	BuildRecipe(ctx context.Context, in *BuildRecipeReq, opts ...grpc.CallOption) (Ligolo_BuildRecipeClient, error)
+	GetToolchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Toolchain, error)
+	UploadToolchain(ctx context.Context, opts ...grpc.CallOption) (Ligolo_UploadToolchainClient, error)
+	FetchToolchain(ctx context.Context, in *FetchToolchainReq, opts ...grpc.CallOption) (*Toolchain, error)
+	RollbackToolchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Toolchain, error)
+	GetAgentTemplates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAgentTemplatesResp, error)
+	AddAgentTemplate(ctx context.Context, in *AddAgentTemplateReq, opts ...grpc.CallOption) (*Empty, error)
+	DelAgentTemplate(ctx context.Context, in *DelAgentTemplateReq, opts ...grpc.CallOption) (*Empty, error)
+	GetSigningKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetSigningKeysResp, error)
+	AddSigningKey(ctx context.Context, in *AddSigningKeyReq, opts ...grpc.CallOption) (*Empty, error)
+	DelSigningKey(ctx context.Context, in *DelSigningKeyReq, opts ...grpc.CallOption) (*Empty, error)
+	GetBuildHooks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetBuildHooksResp, error)
+	GetTransportProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetTransportProfilesResp, error)
+	GetAssetUsage(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAssetUsageResp, error)
+	CollectAssets(ctx context.Context, in *CollectAssetsReq, opts ...grpc.CallOption) (*CollectAssetsResp, error)
 	Traceroute(ctx context.Context, in *TracerouteReq, opts ...grpc.CallOption) (*TracerouteResp, error)
+	Throughput(ctx context.Context, in *ThroughputReq, opts ...grpc.CallOption) (*ThroughputResp, error)
 }
 
 type ligoloClient struct {
@@ -113,6 +275,79 @@ func (x *ligoloJoinClient) Recv() (*Event, error) {
 	return m, nil
 }
 
+func (c *ligoloClient) Subscribe(ctx context.Context, in *SubscribeReq, opts ...grpc.CallOption) (Ligolo_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[1], Ligolo_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type ligoloSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) Replay(ctx context.Context, in *ReplayReq, opts ...grpc.CallOption) (Ligolo_ReplayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[2], Ligolo_Replay_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloReplayClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_ReplayClient interface {
+	Recv() (*ReplayEvent, error)
+	grpc.ClientStream
+}
+
+type ligoloReplayClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloReplayClient) Recv() (*ReplayEvent, error) {
+	m := new(ReplayEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) GetAuditLog(ctx context.Context, in *GetAuditLogReq, opts ...grpc.CallOption) (*GetAuditLogResp, error) {
+	out := new(GetAuditLogResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ligoloClient) GetMetadata(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetMetadataResp, error) {
 	out := new(GetMetadataResp)
 	err := c.cc.Invoke(ctx, Ligolo_GetMetadata_FullMethodName, in, out, opts...)
@@ -140,6 +375,15 @@ func (c *ligoloClient) RenameSession(ctx context.Context, in *RenameSessionReq,
 	return out, nil
 }
 
+func (c *ligoloClient) Annotate(ctx context.Context, in *AnnotateReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_Annotate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ligoloClient) KillSession(ctx context.Context, in *KillSessionReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, Ligolo_KillSession_FullMethodName, in, out, opts...)
@@ -149,6 +393,15 @@ func (c *ligoloClient) KillSession(ctx context.Context, in *KillSessionReq, opts
 	return out, nil
 }
 
+func (c *ligoloClient) BurnAgent(ctx context.Context, in *BurnAgentReq, opts ...grpc.CallOption) (*BurnAgentResp, error) {
+	out := new(BurnAgentResp)
+	err := c.cc.Invoke(ctx, Ligolo_BurnAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ligoloClient) StartRelay(ctx context.Context, in *StartRelayReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, Ligolo_StartRelay_FullMethodName, in, out, opts...)
@@ -167,679 +420,3458 @@ func (c *ligoloClient) StopRelay(ctx context.Context, in *StopRelayReq, opts ...
 	return out, nil
 }
 
-func (c *ligoloClient) AddRoute(ctx context.Context, in *AddRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) SetSpoofSource(ctx context.Context, in *SetSpoofSourceReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_AddRoute_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_SetSpoofSource_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) EditRoute(ctx context.Context, in *EditRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) SetCompression(ctx context.Context, in *SetCompressionReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_EditRoute_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_SetCompression_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) MoveRoute(ctx context.Context, in *MoveRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) SetMirror(ctx context.Context, in *SetMirrorReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_MoveRoute_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_SetMirror_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) DelRoute(ctx context.Context, in *DelRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) SetBeacon(ctx context.Context, in *SetBeaconReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_DelRoute_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_SetBeacon_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) AddRedirector(ctx context.Context, in *AddRedirectorReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) SetWorkingHours(ctx context.Context, in *SetWorkingHoursReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_AddRedirector_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_SetWorkingHours_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) DelRedirector(ctx context.Context, in *DelRedirectorReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) WakeSession(ctx context.Context, in *WakeSessionReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_DelRedirector_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_WakeSession_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) GetCerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCertsResp, error) {
-	out := new(GetCertsResp)
-	err := c.cc.Invoke(ctx, Ligolo_GetCerts_FullMethodName, in, out, opts...)
+func (c *ligoloClient) SetSocks(ctx context.Context, in *SetSocksReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_SetSocks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) RegenCert(ctx context.Context, in *RegenCertReq, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_RegenCert_FullMethodName, in, out, opts...)
+func (c *ligoloClient) ListFiles(ctx context.Context, in *ListFilesReq, opts ...grpc.CallOption) (*ListFilesResp, error) {
+	out := new(ListFilesResp)
+	err := c.cc.Invoke(ctx, Ligolo_ListFiles_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) GetOperators(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetOperatorsResp, error) {
-	out := new(GetOperatorsResp)
-	err := c.cc.Invoke(ctx, Ligolo_GetOperators_FullMethodName, in, out, opts...)
+func (c *ligoloClient) DownloadFile(ctx context.Context, in *DownloadFileReq, opts ...grpc.CallOption) (Ligolo_DownloadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[3], Ligolo_DownloadFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloDownloadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_DownloadFileClient interface {
+	Recv() (*DownloadFileResp, error)
+	grpc.ClientStream
+}
+
+type ligoloDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloDownloadFileClient) Recv() (*DownloadFileResp, error) {
+	m := new(DownloadFileResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (Ligolo_UploadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[4], Ligolo_UploadFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloUploadFileClient{stream}
+	return x, nil
+}
+
+type Ligolo_UploadFileClient interface {
+	Send(*UploadFileReq) error
+	CloseAndRecv() (*UploadFileResp, error)
+	grpc.ClientStream
+}
+
+type ligoloUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloUploadFileClient) Send(m *UploadFileReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ligoloUploadFileClient) CloseAndRecv() (*UploadFileResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFileResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) Exec(ctx context.Context, in *ExecReq, opts ...grpc.CallOption) (Ligolo_ExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[5], Ligolo_Exec_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloExecClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_ExecClient interface {
+	Recv() (*ExecResp, error)
+	grpc.ClientStream
+}
+
+type ligoloExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloExecClient) Recv() (*ExecResp, error) {
+	m := new(ExecResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) Sweep(ctx context.Context, in *SweepReq, opts ...grpc.CallOption) (Ligolo_SweepClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[6], Ligolo_Sweep_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloSweepClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_SweepClient interface {
+	Recv() (*SweepResp, error)
+	grpc.ClientStream
+}
+
+type ligoloSweepClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloSweepClient) Recv() (*SweepResp, error) {
+	m := new(SweepResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) UpdateAgent(ctx context.Context, in *UpdateAgentReq, opts ...grpc.CallOption) (*UpdateAgentResp, error) {
+	out := new(UpdateAgentResp)
+	err := c.cc.Invoke(ctx, Ligolo_UpdateAgent_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) ExportOperator(ctx context.Context, in *ExportOperatorReq, opts ...grpc.CallOption) (*ExportOperatorResp, error) {
-	out := new(ExportOperatorResp)
-	err := c.cc.Invoke(ctx, Ligolo_ExportOperator_FullMethodName, in, out, opts...)
+func (c *ligoloClient) RotateAgentCert(ctx context.Context, in *RotateAgentCertReq, opts ...grpc.CallOption) (*RotateAgentCertResp, error) {
+	out := new(RotateAgentCertResp)
+	err := c.cc.Invoke(ctx, Ligolo_RotateAgentCert_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) AddOperator(ctx context.Context, in *AddOperatorReq, opts ...grpc.CallOption) (*AddOperatorResp, error) {
-	out := new(AddOperatorResp)
-	err := c.cc.Invoke(ctx, Ligolo_AddOperator_FullMethodName, in, out, opts...)
+func (c *ligoloClient) RefreshNetwork(ctx context.Context, in *RefreshNetworkReq, opts ...grpc.CallOption) (*RefreshNetworkResp, error) {
+	out := new(RefreshNetworkResp)
+	err := c.cc.Invoke(ctx, Ligolo_RefreshNetwork_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) DelOperator(ctx context.Context, in *DelOperatorReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) HandoffSession(ctx context.Context, in *HandoffSessionReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_DelOperator_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_HandoffSession_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) PromoteOperator(ctx context.Context, in *PromoteOperatorReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) AnswerHandoff(ctx context.Context, in *AnswerHandoffReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_PromoteOperator_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_AnswerHandoff_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) DemoteOperator(ctx context.Context, in *DemoteOperatorReq, opts ...grpc.CallOption) (*Empty, error) {
+func (c *ligoloClient) ClaimSession(ctx context.Context, in *ClaimSessionReq, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
-	err := c.cc.Invoke(ctx, Ligolo_DemoteOperator_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Ligolo_ClaimSession_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) GenerateAgent(ctx context.Context, in *GenerateAgentReq, opts ...grpc.CallOption) (*GenerateAgentResp, error) {
-	out := new(GenerateAgentResp)
-	err := c.cc.Invoke(ctx, Ligolo_GenerateAgent_FullMethodName, in, out, opts...)
+func (c *ligoloClient) Persist(ctx context.Context, in *PersistReq, opts ...grpc.CallOption) (*PersistResp, error) {
+	out := new(PersistResp)
+	err := c.cc.Invoke(ctx, Ligolo_Persist_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ligoloClient) Traceroute(ctx context.Context, in *TracerouteReq, opts ...grpc.CallOption) (*TracerouteResp, error) {
-	out := new(TracerouteResp)
-	err := c.cc.Invoke(ctx, Ligolo_Traceroute_FullMethodName, in, out, opts...)
+func (c *ligoloClient) SetBreakout(ctx context.Context, in *SetBreakoutReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_SetBreakout_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LigoloServer is the server API for Ligolo service.
-// All implementations must embed UnimplementedLigoloServer
-// for forward compatibility
-type LigoloServer interface {
-	Join(*Empty, Ligolo_JoinServer) error
-	GetMetadata(context.Context, *Empty) (*GetMetadataResp, error)
-	GetSessions(context.Context, *Empty) (*GetSessionsResp, error)
-	RenameSession(context.Context, *RenameSessionReq) (*Empty, error)
-	KillSession(context.Context, *KillSessionReq) (*Empty, error)
-	StartRelay(context.Context, *StartRelayReq) (*Empty, error)
-	StopRelay(context.Context, *StopRelayReq) (*Empty, error)
-	AddRoute(context.Context, *AddRouteReq) (*Empty, error)
-	EditRoute(context.Context, *EditRouteReq) (*Empty, error)
-	MoveRoute(context.Context, *MoveRouteReq) (*Empty, error)
-	DelRoute(context.Context, *DelRouteReq) (*Empty, error)
-	AddRedirector(context.Context, *AddRedirectorReq) (*Empty, error)
-	DelRedirector(context.Context, *DelRedirectorReq) (*Empty, error)
-	GetCerts(context.Context, *Empty) (*GetCertsResp, error)
-	RegenCert(context.Context, *RegenCertReq) (*Empty, error)
-	GetOperators(context.Context, *Empty) (*GetOperatorsResp, error)
-	ExportOperator(context.Context, *ExportOperatorReq) (*ExportOperatorResp, error)
-	AddOperator(context.Context, *AddOperatorReq) (*AddOperatorResp, error)
-	DelOperator(context.Context, *DelOperatorReq) (*Empty, error)
-	PromoteOperator(context.Context, *PromoteOperatorReq) (*Empty, error)
-	DemoteOperator(context.Context, *DemoteOperatorReq) (*Empty, error)
-	GenerateAgent(context.Context, *GenerateAgentReq) (*GenerateAgentResp, error)
-	Traceroute(context.Context, *TracerouteReq) (*TracerouteResp, error)
-	mustEmbedUnimplementedLigoloServer()
+func (c *ligoloClient) SetBandwidth(ctx context.Context, in *SetBandwidthReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_SetBandwidth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedLigoloServer must be embedded to have forward compatible implementations.
-type UnimplementedLigoloServer struct {
+func (c *ligoloClient) RefreshHostInfo(ctx context.Context, in *RefreshHostInfoReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_RefreshHostInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedLigoloServer) Join(*Empty, Ligolo_JoinServer) error {
-	return status.Errorf(codes.Unimplemented, "method Join not implemented")
-}
-func (UnimplementedLigoloServer) GetMetadata(context.Context, *Empty) (*GetMetadataResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
-}
-func (UnimplementedLigoloServer) GetSessions(context.Context, *Empty) (*GetSessionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
-}
-func (UnimplementedLigoloServer) RenameSession(context.Context, *RenameSessionReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenameSession not implemented")
+func (c *ligoloClient) Diagnose(ctx context.Context, in *DiagnoseReq, opts ...grpc.CallOption) (*DiagnoseResp, error) {
+	out := new(DiagnoseResp)
+	err := c.cc.Invoke(ctx, Ligolo_Diagnose_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddRoute(ctx context.Context, in *AddRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) EditRoute(ctx context.Context, in *EditRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_EditRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) MoveRoute(ctx context.Context, in *MoveRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_MoveRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelRoute(ctx context.Context, in *DelRouteReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetRouteProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetRouteProfilesResp, error) {
+	out := new(GetRouteProfilesResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetRouteProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddRouteProfile(ctx context.Context, in *AddRouteProfileReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddRouteProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelRouteProfile(ctx context.Context, in *DelRouteProfileReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelRouteProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) ApplyRouteProfile(ctx context.Context, in *ApplyRouteProfileReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_ApplyRouteProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddRedirector(ctx context.Context, in *AddRedirectorReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddRedirector_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelRedirector(ctx context.Context, in *DelRedirectorReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelRedirector_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetAttachments(ctx context.Context, in *GetAttachmentsReq, opts ...grpc.CallOption) (*GetAttachmentsResp, error) {
+	out := new(GetAttachmentsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddAttachment(ctx context.Context, in *AddAttachmentReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentReq, opts ...grpc.CallOption) (*DownloadAttachmentResp, error) {
+	out := new(DownloadAttachmentResp)
+	err := c.cc.Invoke(ctx, Ligolo_DownloadAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelAttachment(ctx context.Context, in *DelAttachmentReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetCerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCertsResp, error) {
+	out := new(GetCertsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetCerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) RegenCert(ctx context.Context, in *RegenCertReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_RegenCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetRevokedCerts(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetRevokedCertsResp, error) {
+	out := new(GetRevokedCertsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetRevokedCerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) RevokeCert(ctx context.Context, in *RevokeCertReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_RevokeCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) UnrevokeCert(ctx context.Context, in *UnrevokeCertReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_UnrevokeCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetCRL(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCRLResp, error) {
+	out := new(GetCRLResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetCRL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetCAs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetCAsResp, error) {
+	out := new(GetCAsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetCAs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddCA(ctx context.Context, in *AddCAReq, opts ...grpc.CallOption) (*AddCAResp, error) {
+	out := new(AddCAResp)
+	err := c.cc.Invoke(ctx, Ligolo_AddCA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) RevokeCA(ctx context.Context, in *RevokeCAReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_RevokeCA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) ExportServerState(ctx context.Context, in *ExportServerStateReq, opts ...grpc.CallOption) (Ligolo_ExportServerStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[7], Ligolo_ExportServerState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloExportServerStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_ExportServerStateClient interface {
+	Recv() (*ExportServerStateResp, error)
+	grpc.ClientStream
+}
+
+type ligoloExportServerStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloExportServerStateClient) Recv() (*ExportServerStateResp, error) {
+	m := new(ExportServerStateResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) ImportServerState(ctx context.Context, opts ...grpc.CallOption) (Ligolo_ImportServerStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[8], Ligolo_ImportServerState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloImportServerStateClient{stream}
+	return x, nil
+}
+
+type Ligolo_ImportServerStateClient interface {
+	Send(*ImportServerStateReq) error
+	CloseAndRecv() (*ImportServerStateResp, error)
+	grpc.ClientStream
+}
+
+type ligoloImportServerStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloImportServerStateClient) Send(m *ImportServerStateReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ligoloImportServerStateClient) CloseAndRecv() (*ImportServerStateResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportServerStateResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) ReplicateServerState(ctx context.Context, in *ExportServerStateReq, opts ...grpc.CallOption) (Ligolo_ReplicateServerStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[9], Ligolo_ReplicateServerState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloReplicateServerStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_ReplicateServerStateClient interface {
+	Recv() (*ExportServerStateResp, error)
+	grpc.ClientStream
+}
+
+type ligoloReplicateServerStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloReplicateServerStateClient) Recv() (*ExportServerStateResp, error) {
+	m := new(ExportServerStateResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) GetOperators(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetOperatorsResp, error) {
+	out := new(GetOperatorsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetOperators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) ExportOperator(ctx context.Context, in *ExportOperatorReq, opts ...grpc.CallOption) (*ExportOperatorResp, error) {
+	out := new(ExportOperatorResp)
+	err := c.cc.Invoke(ctx, Ligolo_ExportOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddOperator(ctx context.Context, in *AddOperatorReq, opts ...grpc.CallOption) (*AddOperatorResp, error) {
+	out := new(AddOperatorResp)
+	err := c.cc.Invoke(ctx, Ligolo_AddOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelOperator(ctx context.Context, in *DelOperatorReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) PromoteOperator(ctx context.Context, in *PromoteOperatorReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_PromoteOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DemoteOperator(ctx context.Context, in *DemoteOperatorReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DemoteOperator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) SetOperatorRole(ctx context.Context, in *SetOperatorRoleReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_SetOperatorRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) RenewOperatorCert(ctx context.Context, in *RenewOperatorCertReq, opts ...grpc.CallOption) (*RenewOperatorCertResp, error) {
+	out := new(RenewOperatorCertResp)
+	err := c.cc.Invoke(ctx, Ligolo_RenewOperatorCert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetEnrollments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetEnrollmentsResp, error) {
+	out := new(GetEnrollmentsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetEnrollments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddEnrollment(ctx context.Context, in *AddEnrollmentReq, opts ...grpc.CallOption) (*AddEnrollmentResp, error) {
+	out := new(AddEnrollmentResp)
+	err := c.cc.Invoke(ctx, Ligolo_AddEnrollment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelEnrollment(ctx context.Context, in *DelEnrollmentReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelEnrollment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetEngagements(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetEngagementsResp, error) {
+	out := new(GetEngagementsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetEngagements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddEngagement(ctx context.Context, in *AddEngagementReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddEngagement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelEngagement(ctx context.Context, in *DelEngagementReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelEngagement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) ActivateEngagement(ctx context.Context, in *ActivateEngagementReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_ActivateEngagement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetListeners(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetListenersResp, error) {
+	out := new(GetListenersResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetListeners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddListener(ctx context.Context, in *AddListenerReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddListener_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelListener(ctx context.Context, in *DelListenerReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelListener_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) SetListenerEnabled(ctx context.Context, in *SetListenerEnabledReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_SetListenerEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GenerateAgent(ctx context.Context, in *GenerateAgentReq, opts ...grpc.CallOption) (Ligolo_GenerateAgentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[10], Ligolo_GenerateAgent_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloGenerateAgentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_GenerateAgentClient interface {
+	Recv() (*GenerateAgentResp, error)
+	grpc.ClientStream
+}
+
+type ligoloGenerateAgentClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloGenerateAgentClient) Recv() (*GenerateAgentResp, error) {
+	m := new(GenerateAgentResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) RenderAgent(ctx context.Context, in *RenderAgentReq, opts ...grpc.CallOption) (*RenderAgentResp, error) {
+	out := new(RenderAgentResp)
+	err := c.cc.Invoke(ctx, Ligolo_RenderAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) CancelAgentBuild(ctx context.Context, in *CancelAgentBuildReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_CancelAgentBuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) LookupAgentBuild(ctx context.Context, in *LookupAgentBuildReq, opts ...grpc.CallOption) (*LookupAgentBuildResp, error) {
+	out := new(LookupAgentBuildResp)
+	err := c.cc.Invoke(ctx, Ligolo_LookupAgentBuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetAgentBuilds(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAgentBuildsResp, error) {
+	out := new(GetAgentBuildsResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetAgentBuilds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DownloadAgentBuild(ctx context.Context, in *DownloadAgentBuildReq, opts ...grpc.CallOption) (*DownloadAgentBuildResp, error) {
+	out := new(DownloadAgentBuildResp)
+	err := c.cc.Invoke(ctx, Ligolo_DownloadAgentBuild_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) RegenerateAgent(ctx context.Context, in *RegenerateAgentReq, opts ...grpc.CallOption) (Ligolo_RegenerateAgentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[11], Ligolo_RegenerateAgent_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloRegenerateAgentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_RegenerateAgentClient interface {
+	Recv() (*GenerateAgentResp, error)
+	grpc.ClientStream
+}
+
+type ligoloRegenerateAgentClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloRegenerateAgentClient) Recv() (*GenerateAgentResp, error) {
+	m := new(GenerateAgentResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) BuildRecipe(ctx context.Context, in *BuildRecipeReq, opts ...grpc.CallOption) (Ligolo_BuildRecipeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[12], Ligolo_BuildRecipe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloBuildRecipeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ligolo_BuildRecipeClient interface {
+	Recv() (*BuildRecipeResp, error)
+	grpc.ClientStream
+}
+
+type ligoloBuildRecipeClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloBuildRecipeClient) Recv() (*BuildRecipeResp, error) {
+	m := new(BuildRecipeResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) GetToolchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Toolchain, error) {
+	out := new(Toolchain)
+	err := c.cc.Invoke(ctx, Ligolo_GetToolchain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) UploadToolchain(ctx context.Context, opts ...grpc.CallOption) (Ligolo_UploadToolchainClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ligolo_ServiceDesc.Streams[13], Ligolo_UploadToolchain_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ligoloUploadToolchainClient{stream}
+	return x, nil
+}
+
+type Ligolo_UploadToolchainClient interface {
+	Send(*UploadToolchainReq) error
+	CloseAndRecv() (*Toolchain, error)
+	grpc.ClientStream
+}
+
+type ligoloUploadToolchainClient struct {
+	grpc.ClientStream
+}
+
+func (x *ligoloUploadToolchainClient) Send(m *UploadToolchainReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ligoloUploadToolchainClient) CloseAndRecv() (*Toolchain, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Toolchain)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ligoloClient) FetchToolchain(ctx context.Context, in *FetchToolchainReq, opts ...grpc.CallOption) (*Toolchain, error) {
+	out := new(Toolchain)
+	err := c.cc.Invoke(ctx, Ligolo_FetchToolchain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) RollbackToolchain(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Toolchain, error) {
+	out := new(Toolchain)
+	err := c.cc.Invoke(ctx, Ligolo_RollbackToolchain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetAgentTemplates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAgentTemplatesResp, error) {
+	out := new(GetAgentTemplatesResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetAgentTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddAgentTemplate(ctx context.Context, in *AddAgentTemplateReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddAgentTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelAgentTemplate(ctx context.Context, in *DelAgentTemplateReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelAgentTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetSigningKeys(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetSigningKeysResp, error) {
+	out := new(GetSigningKeysResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetSigningKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) AddSigningKey(ctx context.Context, in *AddSigningKeyReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_AddSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) DelSigningKey(ctx context.Context, in *DelSigningKeyReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Ligolo_DelSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetBuildHooks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetBuildHooksResp, error) {
+	out := new(GetBuildHooksResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetBuildHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetTransportProfiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetTransportProfilesResp, error) {
+	out := new(GetTransportProfilesResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetTransportProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) GetAssetUsage(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetAssetUsageResp, error) {
+	out := new(GetAssetUsageResp)
+	err := c.cc.Invoke(ctx, Ligolo_GetAssetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) CollectAssets(ctx context.Context, in *CollectAssetsReq, opts ...grpc.CallOption) (*CollectAssetsResp, error) {
+	out := new(CollectAssetsResp)
+	err := c.cc.Invoke(ctx, Ligolo_CollectAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) Traceroute(ctx context.Context, in *TracerouteReq, opts ...grpc.CallOption) (*TracerouteResp, error) {
+	out := new(TracerouteResp)
+	err := c.cc.Invoke(ctx, Ligolo_Traceroute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ligoloClient) Throughput(ctx context.Context, in *ThroughputReq, opts ...grpc.CallOption) (*ThroughputResp, error) {
+	out := new(ThroughputResp)
+	err := c.cc.Invoke(ctx, Ligolo_Throughput_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LigoloServer is the server API for Ligolo service.
+// All implementations must embed UnimplementedLigoloServer
+// for forward compatibility
+type LigoloServer interface {
+	Join(*Empty, Ligolo_JoinServer) error
+	Subscribe(*SubscribeReq, Ligolo_SubscribeServer) error
+	Replay(*ReplayReq, Ligolo_ReplayServer) error
+	GetAuditLog(context.Context, *GetAuditLogReq) (*GetAuditLogResp, error)
+	GetMetadata(context.Context, *Empty) (*GetMetadataResp, error)
+	GetSessions(context.Context, *Empty) (*GetSessionsResp, error)
+	RenameSession(context.Context, *RenameSessionReq) (*Empty, error)
+	Annotate(context.Context, *AnnotateReq) (*Empty, error)
+	KillSession(context.Context, *KillSessionReq) (*Empty, error)
+	BurnAgent(context.Context, *BurnAgentReq) (*BurnAgentResp, error)
+	StartRelay(context.Context, *StartRelayReq) (*Empty, error)
+	StopRelay(context.Context, *StopRelayReq) (*Empty, error)
+	SetSpoofSource(context.Context, *SetSpoofSourceReq) (*Empty, error)
+	SetCompression(context.Context, *SetCompressionReq) (*Empty, error)
+	SetMirror(context.Context, *SetMirrorReq) (*Empty, error)
+	SetBeacon(context.Context, *SetBeaconReq) (*Empty, error)
+	SetWorkingHours(context.Context, *SetWorkingHoursReq) (*Empty, error)
+	WakeSession(context.Context, *WakeSessionReq) (*Empty, error)
+	SetSocks(context.Context, *SetSocksReq) (*Empty, error)
+	ListFiles(context.Context, *ListFilesReq) (*ListFilesResp, error)
+	DownloadFile(*DownloadFileReq, Ligolo_DownloadFileServer) error
+	UploadFile(Ligolo_UploadFileServer) error
+	Exec(*ExecReq, Ligolo_ExecServer) error
+	Sweep(*SweepReq, Ligolo_SweepServer) error
+	UpdateAgent(context.Context, *UpdateAgentReq) (*UpdateAgentResp, error)
+	RotateAgentCert(context.Context, *RotateAgentCertReq) (*RotateAgentCertResp, error)
+	RefreshNetwork(context.Context, *RefreshNetworkReq) (*RefreshNetworkResp, error)
+	HandoffSession(context.Context, *HandoffSessionReq) (*Empty, error)
+	AnswerHandoff(context.Context, *AnswerHandoffReq) (*Empty, error)
+	ClaimSession(context.Context, *ClaimSessionReq) (*Empty, error)
+	Persist(context.Context, *PersistReq) (*PersistResp, error)
+	SetBreakout(context.Context, *SetBreakoutReq) (*Empty, error)
+	SetBandwidth(context.Context, *SetBandwidthReq) (*Empty, error)
+	RefreshHostInfo(context.Context, *RefreshHostInfoReq) (*Empty, error)
+	Diagnose(context.Context, *DiagnoseReq) (*DiagnoseResp, error)
+	AddRoute(context.Context, *AddRouteReq) (*Empty, error)
+	EditRoute(context.Context, *EditRouteReq) (*Empty, error)
+	MoveRoute(context.Context, *MoveRouteReq) (*Empty, error)
+	DelRoute(context.Context, *DelRouteReq) (*Empty, error)
+	GetRouteProfiles(context.Context, *Empty) (*GetRouteProfilesResp, error)
+	AddRouteProfile(context.Context, *AddRouteProfileReq) (*Empty, error)
+	DelRouteProfile(context.Context, *DelRouteProfileReq) (*Empty, error)
+	ApplyRouteProfile(context.Context, *ApplyRouteProfileReq) (*Empty, error)
+	AddRedirector(context.Context, *AddRedirectorReq) (*Empty, error)
+	DelRedirector(context.Context, *DelRedirectorReq) (*Empty, error)
+	GetAttachments(context.Context, *GetAttachmentsReq) (*GetAttachmentsResp, error)
+	AddAttachment(context.Context, *AddAttachmentReq) (*Empty, error)
+	DownloadAttachment(context.Context, *DownloadAttachmentReq) (*DownloadAttachmentResp, error)
+	DelAttachment(context.Context, *DelAttachmentReq) (*Empty, error)
+	GetCerts(context.Context, *Empty) (*GetCertsResp, error)
+	RegenCert(context.Context, *RegenCertReq) (*Empty, error)
+	GetRevokedCerts(context.Context, *Empty) (*GetRevokedCertsResp, error)
+	RevokeCert(context.Context, *RevokeCertReq) (*Empty, error)
+	UnrevokeCert(context.Context, *UnrevokeCertReq) (*Empty, error)
+	GetCRL(context.Context, *Empty) (*GetCRLResp, error)
+	GetCAs(context.Context, *Empty) (*GetCAsResp, error)
+	AddCA(context.Context, *AddCAReq) (*AddCAResp, error)
+	RevokeCA(context.Context, *RevokeCAReq) (*Empty, error)
+	ExportServerState(*ExportServerStateReq, Ligolo_ExportServerStateServer) error
+	ImportServerState(Ligolo_ImportServerStateServer) error
+	ReplicateServerState(*ExportServerStateReq, Ligolo_ReplicateServerStateServer) error
+	GetOperators(context.Context, *Empty) (*GetOperatorsResp, error)
+	ExportOperator(context.Context, *ExportOperatorReq) (*ExportOperatorResp, error)
+	AddOperator(context.Context, *AddOperatorReq) (*AddOperatorResp, error)
+	DelOperator(context.Context, *DelOperatorReq) (*Empty, error)
+	PromoteOperator(context.Context, *PromoteOperatorReq) (*Empty, error)
+	DemoteOperator(context.Context, *DemoteOperatorReq) (*Empty, error)
+	SetOperatorRole(context.Context, *SetOperatorRoleReq) (*Empty, error)
+	RenewOperatorCert(context.Context, *RenewOperatorCertReq) (*RenewOperatorCertResp, error)
+	GetEnrollments(context.Context, *Empty) (*GetEnrollmentsResp, error)
+	AddEnrollment(context.Context, *AddEnrollmentReq) (*AddEnrollmentResp, error)
+	DelEnrollment(context.Context, *DelEnrollmentReq) (*Empty, error)
+	GetEngagements(context.Context, *Empty) (*GetEngagementsResp, error)
+	AddEngagement(context.Context, *AddEngagementReq) (*Empty, error)
+	DelEngagement(context.Context, *DelEngagementReq) (*Empty, error)
+	ActivateEngagement(context.Context, *ActivateEngagementReq) (*Empty, error)
+	GetListeners(context.Context, *Empty) (*GetListenersResp, error)
+	AddListener(context.Context, *AddListenerReq) (*Empty, error)
+	DelListener(context.Context, *DelListenerReq) (*Empty, error)
+	SetListenerEnabled(context.Context, *SetListenerEnabledReq) (*Empty, error)
+	GenerateAgent(*GenerateAgentReq, Ligolo_GenerateAgentServer) error
+	RenderAgent(context.Context, *RenderAgentReq) (*RenderAgentResp, error)
+	CancelAgentBuild(context.Context, *CancelAgentBuildReq) (*Empty, error)
+	LookupAgentBuild(context.Context, *LookupAgentBuildReq) (*LookupAgentBuildResp, error)
+	GetAgentBuilds(context.Context, *Empty) (*GetAgentBuildsResp, error)
+	DownloadAgentBuild(context.Context, *DownloadAgentBuildReq) (*DownloadAgentBuildResp, error)
+	RegenerateAgent(*RegenerateAgentReq, Ligolo_RegenerateAgentServer) error
+	BuildRecipe(*BuildRecipeReq, Ligolo_BuildRecipeServer) error
+	GetToolchain(context.Context, *Empty) (*Toolchain, error)
+	UploadToolchain(Ligolo_UploadToolchainServer) error
+	FetchToolchain(context.Context, *FetchToolchainReq) (*Toolchain, error)
+	RollbackToolchain(context.Context, *Empty) (*Toolchain, error)
+	GetAgentTemplates(context.Context, *Empty) (*GetAgentTemplatesResp, error)
+	AddAgentTemplate(context.Context, *AddAgentTemplateReq) (*Empty, error)
+	DelAgentTemplate(context.Context, *DelAgentTemplateReq) (*Empty, error)
+	GetSigningKeys(context.Context, *Empty) (*GetSigningKeysResp, error)
+	AddSigningKey(context.Context, *AddSigningKeyReq) (*Empty, error)
+	DelSigningKey(context.Context, *DelSigningKeyReq) (*Empty, error)
+	GetBuildHooks(context.Context, *Empty) (*GetBuildHooksResp, error)
+	GetTransportProfiles(context.Context, *Empty) (*GetTransportProfilesResp, error)
+	GetAssetUsage(context.Context, *Empty) (*GetAssetUsageResp, error)
+	CollectAssets(context.Context, *CollectAssetsReq) (*CollectAssetsResp, error)
+	Traceroute(context.Context, *TracerouteReq) (*TracerouteResp, error)
+	Throughput(context.Context, *ThroughputReq) (*ThroughputResp, error)
+	mustEmbedUnimplementedLigoloServer()
+}
+
+// UnimplementedLigoloServer must be embedded to have forward compatible implementations.
+type UnimplementedLigoloServer struct {
+}
+
+func (UnimplementedLigoloServer) Join(*Empty, Ligolo_JoinServer) error {
+	return status.Errorf(codes.Unimplemented, "method Join not implemented")
+}
+func (UnimplementedLigoloServer) Subscribe(*SubscribeReq, Ligolo_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedLigoloServer) Replay(*ReplayReq, Ligolo_ReplayServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
+func (UnimplementedLigoloServer) GetAuditLog(context.Context, *GetAuditLogReq) (*GetAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedLigoloServer) GetMetadata(context.Context, *Empty) (*GetMetadataResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (UnimplementedLigoloServer) GetSessions(context.Context, *Empty) (*GetSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessions not implemented")
+}
+func (UnimplementedLigoloServer) RenameSession(context.Context, *RenameSessionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSession not implemented")
+}
+func (UnimplementedLigoloServer) Annotate(context.Context, *AnnotateReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Annotate not implemented")
 }
 func (UnimplementedLigoloServer) KillSession(context.Context, *KillSessionReq) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KillSession not implemented")
 }
-func (UnimplementedLigoloServer) StartRelay(context.Context, *StartRelayReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartRelay not implemented")
+func (UnimplementedLigoloServer) BurnAgent(context.Context, *BurnAgentReq) (*BurnAgentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnAgent not implemented")
+}
+func (UnimplementedLigoloServer) StartRelay(context.Context, *StartRelayReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRelay not implemented")
+}
+func (UnimplementedLigoloServer) StopRelay(context.Context, *StopRelayReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRelay not implemented")
+}
+func (UnimplementedLigoloServer) SetSpoofSource(context.Context, *SetSpoofSourceReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpoofSource not implemented")
+}
+func (UnimplementedLigoloServer) SetCompression(context.Context, *SetCompressionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompression not implemented")
+}
+func (UnimplementedLigoloServer) SetMirror(context.Context, *SetMirrorReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMirror not implemented")
+}
+func (UnimplementedLigoloServer) SetBeacon(context.Context, *SetBeaconReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBeacon not implemented")
+}
+func (UnimplementedLigoloServer) SetWorkingHours(context.Context, *SetWorkingHoursReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkingHours not implemented")
+}
+func (UnimplementedLigoloServer) WakeSession(context.Context, *WakeSessionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WakeSession not implemented")
+}
+func (UnimplementedLigoloServer) SetSocks(context.Context, *SetSocksReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSocks not implemented")
+}
+func (UnimplementedLigoloServer) ListFiles(context.Context, *ListFilesReq) (*ListFilesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
+}
+func (UnimplementedLigoloServer) DownloadFile(*DownloadFileReq, Ligolo_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedLigoloServer) UploadFile(Ligolo_UploadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedLigoloServer) Exec(*ExecReq, Ligolo_ExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedLigoloServer) Sweep(*SweepReq, Ligolo_SweepServer) error {
+	return status.Errorf(codes.Unimplemented, "method Sweep not implemented")
+}
+func (UnimplementedLigoloServer) UpdateAgent(context.Context, *UpdateAgentReq) (*UpdateAgentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgent not implemented")
+}
+func (UnimplementedLigoloServer) RotateAgentCert(context.Context, *RotateAgentCertReq) (*RotateAgentCertResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAgentCert not implemented")
+}
+func (UnimplementedLigoloServer) RefreshNetwork(context.Context, *RefreshNetworkReq) (*RefreshNetworkResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshNetwork not implemented")
+}
+func (UnimplementedLigoloServer) HandoffSession(context.Context, *HandoffSessionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandoffSession not implemented")
+}
+func (UnimplementedLigoloServer) AnswerHandoff(context.Context, *AnswerHandoffReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerHandoff not implemented")
+}
+func (UnimplementedLigoloServer) ClaimSession(context.Context, *ClaimSessionReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimSession not implemented")
+}
+func (UnimplementedLigoloServer) Persist(context.Context, *PersistReq) (*PersistResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedLigoloServer) SetBreakout(context.Context, *SetBreakoutReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBreakout not implemented")
+}
+func (UnimplementedLigoloServer) SetBandwidth(context.Context, *SetBandwidthReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBandwidth not implemented")
+}
+func (UnimplementedLigoloServer) RefreshHostInfo(context.Context, *RefreshHostInfoReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshHostInfo not implemented")
+}
+func (UnimplementedLigoloServer) Diagnose(context.Context, *DiagnoseReq) (*DiagnoseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diagnose not implemented")
+}
+func (UnimplementedLigoloServer) AddRoute(context.Context, *AddRouteReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoute not implemented")
+}
+func (UnimplementedLigoloServer) EditRoute(context.Context, *EditRouteReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRoute not implemented")
+}
+func (UnimplementedLigoloServer) MoveRoute(context.Context, *MoveRouteReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveRoute not implemented")
+}
+func (UnimplementedLigoloServer) DelRoute(context.Context, *DelRouteReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRoute not implemented")
+}
+func (UnimplementedLigoloServer) GetRouteProfiles(context.Context, *Empty) (*GetRouteProfilesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouteProfiles not implemented")
+}
+func (UnimplementedLigoloServer) AddRouteProfile(context.Context, *AddRouteProfileReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRouteProfile not implemented")
+}
+func (UnimplementedLigoloServer) DelRouteProfile(context.Context, *DelRouteProfileReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRouteProfile not implemented")
+}
+func (UnimplementedLigoloServer) ApplyRouteProfile(context.Context, *ApplyRouteProfileReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRouteProfile not implemented")
+}
+func (UnimplementedLigoloServer) AddRedirector(context.Context, *AddRedirectorReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRedirector not implemented")
+}
+func (UnimplementedLigoloServer) DelRedirector(context.Context, *DelRedirectorReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelRedirector not implemented")
+}
+func (UnimplementedLigoloServer) GetAttachments(context.Context, *GetAttachmentsReq) (*GetAttachmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachments not implemented")
+}
+func (UnimplementedLigoloServer) AddAttachment(context.Context, *AddAttachmentReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAttachment not implemented")
+}
+func (UnimplementedLigoloServer) DownloadAttachment(context.Context, *DownloadAttachmentReq) (*DownloadAttachmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedLigoloServer) DelAttachment(context.Context, *DelAttachmentReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelAttachment not implemented")
+}
+func (UnimplementedLigoloServer) GetCerts(context.Context, *Empty) (*GetCertsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCerts not implemented")
+}
+func (UnimplementedLigoloServer) RegenCert(context.Context, *RegenCertReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenCert not implemented")
+}
+func (UnimplementedLigoloServer) GetRevokedCerts(context.Context, *Empty) (*GetRevokedCertsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevokedCerts not implemented")
+}
+func (UnimplementedLigoloServer) RevokeCert(context.Context, *RevokeCertReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCert not implemented")
+}
+func (UnimplementedLigoloServer) UnrevokeCert(context.Context, *UnrevokeCertReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnrevokeCert not implemented")
+}
+func (UnimplementedLigoloServer) GetCRL(context.Context, *Empty) (*GetCRLResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCRL not implemented")
+}
+func (UnimplementedLigoloServer) GetCAs(context.Context, *Empty) (*GetCAsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCAs not implemented")
+}
+func (UnimplementedLigoloServer) AddCA(context.Context, *AddCAReq) (*AddCAResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCA not implemented")
+}
+func (UnimplementedLigoloServer) RevokeCA(context.Context, *RevokeCAReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCA not implemented")
+}
+func (UnimplementedLigoloServer) ExportServerState(*ExportServerStateReq, Ligolo_ExportServerStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportServerState not implemented")
+}
+func (UnimplementedLigoloServer) ImportServerState(Ligolo_ImportServerStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportServerState not implemented")
+}
+func (UnimplementedLigoloServer) ReplicateServerState(*ExportServerStateReq, Ligolo_ReplicateServerStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplicateServerState not implemented")
+}
+func (UnimplementedLigoloServer) GetOperators(context.Context, *Empty) (*GetOperatorsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperators not implemented")
+}
+func (UnimplementedLigoloServer) ExportOperator(context.Context, *ExportOperatorReq) (*ExportOperatorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOperator not implemented")
+}
+func (UnimplementedLigoloServer) AddOperator(context.Context, *AddOperatorReq) (*AddOperatorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOperator not implemented")
+}
+func (UnimplementedLigoloServer) DelOperator(context.Context, *DelOperatorReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelOperator not implemented")
+}
+func (UnimplementedLigoloServer) PromoteOperator(context.Context, *PromoteOperatorReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteOperator not implemented")
+}
+func (UnimplementedLigoloServer) DemoteOperator(context.Context, *DemoteOperatorReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemoteOperator not implemented")
+}
+func (UnimplementedLigoloServer) SetOperatorRole(context.Context, *SetOperatorRoleReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorRole not implemented")
+}
+func (UnimplementedLigoloServer) RenewOperatorCert(context.Context, *RenewOperatorCertReq) (*RenewOperatorCertResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewOperatorCert not implemented")
+}
+func (UnimplementedLigoloServer) GetEnrollments(context.Context, *Empty) (*GetEnrollmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnrollments not implemented")
+}
+func (UnimplementedLigoloServer) AddEnrollment(context.Context, *AddEnrollmentReq) (*AddEnrollmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEnrollment not implemented")
+}
+func (UnimplementedLigoloServer) DelEnrollment(context.Context, *DelEnrollmentReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelEnrollment not implemented")
+}
+func (UnimplementedLigoloServer) GetEngagements(context.Context, *Empty) (*GetEngagementsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngagements not implemented")
+}
+func (UnimplementedLigoloServer) AddEngagement(context.Context, *AddEngagementReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEngagement not implemented")
+}
+func (UnimplementedLigoloServer) DelEngagement(context.Context, *DelEngagementReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelEngagement not implemented")
+}
+func (UnimplementedLigoloServer) ActivateEngagement(context.Context, *ActivateEngagementReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateEngagement not implemented")
+}
+func (UnimplementedLigoloServer) GetListeners(context.Context, *Empty) (*GetListenersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListeners not implemented")
+}
+func (UnimplementedLigoloServer) AddListener(context.Context, *AddListenerReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListener not implemented")
+}
+func (UnimplementedLigoloServer) DelListener(context.Context, *DelListenerReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelListener not implemented")
+}
+func (UnimplementedLigoloServer) SetListenerEnabled(context.Context, *SetListenerEnabledReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetListenerEnabled not implemented")
+}
+func (UnimplementedLigoloServer) GenerateAgent(*GenerateAgentReq, Ligolo_GenerateAgentServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateAgent not implemented")
+}
+func (UnimplementedLigoloServer) RenderAgent(context.Context, *RenderAgentReq) (*RenderAgentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderAgent not implemented")
+}
+func (UnimplementedLigoloServer) CancelAgentBuild(context.Context, *CancelAgentBuildReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAgentBuild not implemented")
+}
+func (UnimplementedLigoloServer) LookupAgentBuild(context.Context, *LookupAgentBuildReq) (*LookupAgentBuildResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupAgentBuild not implemented")
+}
+func (UnimplementedLigoloServer) GetAgentBuilds(context.Context, *Empty) (*GetAgentBuildsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentBuilds not implemented")
+}
+func (UnimplementedLigoloServer) DownloadAgentBuild(context.Context, *DownloadAgentBuildReq) (*DownloadAgentBuildResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadAgentBuild not implemented")
+}
+func (UnimplementedLigoloServer) RegenerateAgent(*RegenerateAgentReq, Ligolo_RegenerateAgentServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegenerateAgent not implemented")
+}
+func (UnimplementedLigoloServer) BuildRecipe(*BuildRecipeReq, Ligolo_BuildRecipeServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildRecipe not implemented")
+}
+func (UnimplementedLigoloServer) GetToolchain(context.Context, *Empty) (*Toolchain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToolchain not implemented")
+}
+func (UnimplementedLigoloServer) UploadToolchain(Ligolo_UploadToolchainServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadToolchain not implemented")
+}
+func (UnimplementedLigoloServer) FetchToolchain(context.Context, *FetchToolchainReq) (*Toolchain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchToolchain not implemented")
+}
+func (UnimplementedLigoloServer) RollbackToolchain(context.Context, *Empty) (*Toolchain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackToolchain not implemented")
+}
+func (UnimplementedLigoloServer) GetAgentTemplates(context.Context, *Empty) (*GetAgentTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentTemplates not implemented")
+}
+func (UnimplementedLigoloServer) AddAgentTemplate(context.Context, *AddAgentTemplateReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAgentTemplate not implemented")
+}
+func (UnimplementedLigoloServer) DelAgentTemplate(context.Context, *DelAgentTemplateReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelAgentTemplate not implemented")
+}
+func (UnimplementedLigoloServer) GetSigningKeys(context.Context, *Empty) (*GetSigningKeysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSigningKeys not implemented")
+}
+func (UnimplementedLigoloServer) AddSigningKey(context.Context, *AddSigningKeyReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSigningKey not implemented")
+}
+func (UnimplementedLigoloServer) DelSigningKey(context.Context, *DelSigningKeyReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelSigningKey not implemented")
+}
+func (UnimplementedLigoloServer) GetBuildHooks(context.Context, *Empty) (*GetBuildHooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildHooks not implemented")
+}
+func (UnimplementedLigoloServer) GetTransportProfiles(context.Context, *Empty) (*GetTransportProfilesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransportProfiles not implemented")
+}
+func (UnimplementedLigoloServer) GetAssetUsage(context.Context, *Empty) (*GetAssetUsageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetUsage not implemented")
+}
+func (UnimplementedLigoloServer) CollectAssets(context.Context, *CollectAssetsReq) (*CollectAssetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectAssets not implemented")
+}
+func (UnimplementedLigoloServer) Traceroute(context.Context, *TracerouteReq) (*TracerouteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Traceroute not implemented")
+}
+func (UnimplementedLigoloServer) Throughput(context.Context, *ThroughputReq) (*ThroughputResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Throughput not implemented")
+}
+func (UnimplementedLigoloServer) mustEmbedUnimplementedLigoloServer() {}
+
+// UnsafeLigoloServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LigoloServer will
+// result in compilation errors.
+type UnsafeLigoloServer interface {
+	mustEmbedUnimplementedLigoloServer()
+}
+
+func RegisterLigoloServer(s grpc.ServiceRegistrar, srv LigoloServer) {
+	s.RegisterService(&Ligolo_ServiceDesc, srv)
+}
+
+func _Ligolo_Join_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).Join(m, &ligoloJoinServer{stream})
+}
+
+type Ligolo_JoinServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type ligoloJoinServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloJoinServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).Subscribe(m, &ligoloSubscribeServer{stream})
+}
+
+type Ligolo_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type ligoloSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_Replay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).Replay(m, &ligoloReplayServer{stream})
+}
+
+type Ligolo_ReplayServer interface {
+	Send(*ReplayEvent) error
+	grpc.ServerStream
+}
+
+type ligoloReplayServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloReplayServer) Send(m *ReplayEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetAuditLog(ctx, req.(*GetAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetMetadata(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetSessions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_RenameSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RenameSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RenameSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RenameSession(ctx, req.(*RenameSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_Annotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).Annotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_Annotate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).Annotate(ctx, req.(*AnnotateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_KillSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).KillSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_KillSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).KillSession(ctx, req.(*KillSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_BurnAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BurnAgentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).BurnAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_BurnAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).BurnAgent(ctx, req.(*BurnAgentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_StartRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRelayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).StartRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_StartRelay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).StartRelay(ctx, req.(*StartRelayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_StopRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRelayReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).StopRelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_StopRelay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).StopRelay(ctx, req.(*StopRelayReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetSpoofSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSpoofSourceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetSpoofSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetSpoofSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetSpoofSource(ctx, req.(*SetSpoofSourceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetCompression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompressionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetCompression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetCompression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetCompression(ctx, req.(*SetCompressionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetMirror_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMirrorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetMirror(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetMirror_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetMirror(ctx, req.(*SetMirrorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBeaconReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetBeacon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetBeacon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetBeacon(ctx, req.(*SetBeaconReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkingHoursReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetWorkingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetWorkingHours(ctx, req.(*SetWorkingHoursReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_WakeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WakeSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).WakeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_WakeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).WakeSession(ctx, req.(*WakeSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetSocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSocksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetSocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetSocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetSocks(ctx, req.(*SetSocksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_ListFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).ListFiles(ctx, req.(*ListFilesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).DownloadFile(m, &ligoloDownloadFileServer{stream})
+}
+
+type Ligolo_DownloadFileServer interface {
+	Send(*DownloadFileResp) error
+	grpc.ServerStream
+}
+
+type ligoloDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloDownloadFileServer) Send(m *DownloadFileResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LigoloServer).UploadFile(&ligoloUploadFileServer{stream})
+}
+
+type Ligolo_UploadFileServer interface {
+	SendAndClose(*UploadFileResp) error
+	Recv() (*UploadFileReq, error)
+	grpc.ServerStream
+}
+
+type ligoloUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloUploadFileServer) SendAndClose(m *UploadFileResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ligoloUploadFileServer) Recv() (*UploadFileReq, error) {
+	m := new(UploadFileReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Ligolo_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).Exec(m, &ligoloExecServer{stream})
+}
+
+type Ligolo_ExecServer interface {
+	Send(*ExecResp) error
+	grpc.ServerStream
+}
+
+type ligoloExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloExecServer) Send(m *ExecResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_Sweep_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SweepReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).Sweep(m, &ligoloSweepServer{stream})
+}
+
+type Ligolo_SweepServer interface {
+	Send(*SweepResp) error
+	grpc.ServerStream
+}
+
+type ligoloSweepServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloSweepServer) Send(m *SweepResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_UpdateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAgentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).UpdateAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_UpdateAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).UpdateAgent(ctx, req.(*UpdateAgentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_RotateAgentCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAgentCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RotateAgentCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RotateAgentCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RotateAgentCert(ctx, req.(*RotateAgentCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_RefreshNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshNetworkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RefreshNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RefreshNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RefreshNetwork(ctx, req.(*RefreshNetworkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_HandoffSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandoffSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).HandoffSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_HandoffSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).HandoffSession(ctx, req.(*HandoffSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_AnswerHandoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerHandoffReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AnswerHandoff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AnswerHandoff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AnswerHandoff(ctx, req.(*AnswerHandoffReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_ClaimSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).ClaimSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_ClaimSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).ClaimSession(ctx, req.(*ClaimSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_Persist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).Persist(ctx, req.(*PersistReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetBreakout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBreakoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetBreakout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetBreakout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetBreakout(ctx, req.(*SetBreakoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_SetBandwidth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBandwidthReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetBandwidth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetBandwidth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetBandwidth(ctx, req.(*SetBandwidthReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_RefreshHostInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshHostInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RefreshHostInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RefreshHostInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RefreshHostInfo(ctx, req.(*RefreshHostInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_Diagnose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnoseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).Diagnose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_Diagnose_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).Diagnose(ctx, req.(*DiagnoseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_AddRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRouteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddRoute(ctx, req.(*AddRouteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_EditRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditRouteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).EditRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_EditRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).EditRoute(ctx, req.(*EditRouteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_MoveRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRouteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).MoveRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_MoveRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).MoveRoute(ctx, req.(*MoveRouteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DelRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelRouteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DelRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelRoute(ctx, req.(*DelRouteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_GetRouteProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetRouteProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetRouteProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetRouteProfiles(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_AddRouteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRouteProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddRouteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddRouteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddRouteProfile(ctx, req.(*AddRouteProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DelRouteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelRouteProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DelRouteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelRouteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelRouteProfile(ctx, req.(*DelRouteProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_ApplyRouteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRouteProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).ApplyRouteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_ApplyRouteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).ApplyRouteProfile(ctx, req.(*ApplyRouteProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_AddRedirector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRedirectorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddRedirector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddRedirector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddRedirector(ctx, req.(*AddRedirectorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DelRedirector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelRedirectorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DelRedirector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelRedirector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelRedirector(ctx, req.(*DelRedirectorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_GetAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetAttachments(ctx, req.(*GetAttachmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_AddAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddAttachment(ctx, req.(*AddAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DownloadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DownloadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DownloadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DownloadAttachment(ctx, req.(*DownloadAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DelAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DelAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelAttachment(ctx, req.(*DelAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_GetCerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetCerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetCerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetCerts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_RegenCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RegenCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RegenCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RegenCert(ctx, req.(*RegenCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_GetRevokedCerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetRevokedCerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetRevokedCerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetRevokedCerts(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) StopRelay(context.Context, *StopRelayReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopRelay not implemented")
+
+func _Ligolo_RevokeCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RevokeCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RevokeCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RevokeCert(ctx, req.(*RevokeCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) AddRoute(context.Context, *AddRouteReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRoute not implemented")
+
+func _Ligolo_UnrevokeCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnrevokeCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).UnrevokeCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_UnrevokeCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).UnrevokeCert(ctx, req.(*UnrevokeCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) EditRoute(context.Context, *EditRouteReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditRoute not implemented")
+
+func _Ligolo_GetCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetCRL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetCRL(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) MoveRoute(context.Context, *MoveRouteReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MoveRoute not implemented")
+
+func _Ligolo_GetCAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetCAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetCAs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetCAs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) DelRoute(context.Context, *DelRouteReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelRoute not implemented")
+
+func _Ligolo_AddCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCAReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddCA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddCA(ctx, req.(*AddCAReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) AddRedirector(context.Context, *AddRedirectorReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRedirector not implemented")
+
+func _Ligolo_RevokeCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCAReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RevokeCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RevokeCA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RevokeCA(ctx, req.(*RevokeCAReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) DelRedirector(context.Context, *DelRedirectorReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelRedirector not implemented")
+
+func _Ligolo_ExportServerState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportServerStateReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).ExportServerState(m, &ligoloExportServerStateServer{stream})
 }
-func (UnimplementedLigoloServer) GetCerts(context.Context, *Empty) (*GetCertsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCerts not implemented")
+
+type Ligolo_ExportServerStateServer interface {
+	Send(*ExportServerStateResp) error
+	grpc.ServerStream
 }
-func (UnimplementedLigoloServer) RegenCert(context.Context, *RegenCertReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegenCert not implemented")
+
+type ligoloExportServerStateServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedLigoloServer) GetOperators(context.Context, *Empty) (*GetOperatorsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOperators not implemented")
+
+func (x *ligoloExportServerStateServer) Send(m *ExportServerStateResp) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedLigoloServer) ExportOperator(context.Context, *ExportOperatorReq) (*ExportOperatorResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExportOperator not implemented")
+
+func _Ligolo_ImportServerState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LigoloServer).ImportServerState(&ligoloImportServerStateServer{stream})
 }
-func (UnimplementedLigoloServer) AddOperator(context.Context, *AddOperatorReq) (*AddOperatorResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddOperator not implemented")
+
+type Ligolo_ImportServerStateServer interface {
+	SendAndClose(*ImportServerStateResp) error
+	Recv() (*ImportServerStateReq, error)
+	grpc.ServerStream
+}
+
+type ligoloImportServerStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloImportServerStateServer) SendAndClose(m *ImportServerStateResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ligoloImportServerStateServer) Recv() (*ImportServerStateReq, error) {
+	m := new(ImportServerStateReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Ligolo_ReplicateServerState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportServerStateReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).ReplicateServerState(m, &ligoloReplicateServerStateServer{stream})
+}
+
+type Ligolo_ReplicateServerStateServer interface {
+	Send(*ExportServerStateResp) error
+	grpc.ServerStream
+}
+
+type ligoloReplicateServerStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloReplicateServerStateServer) Send(m *ExportServerStateResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_GetOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetOperators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetOperators(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_ExportOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).ExportOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_ExportOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).ExportOperator(ctx, req.(*ExportOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_AddOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddOperator(ctx, req.(*AddOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DelOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DelOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelOperator(ctx, req.(*DelOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_PromoteOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).PromoteOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_PromoteOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).PromoteOperator(ctx, req.(*PromoteOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_DemoteOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DemoteOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DemoteOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DemoteOperator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DemoteOperator(ctx, req.(*DemoteOperatorReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) DelOperator(context.Context, *DelOperatorReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelOperator not implemented")
+
+func _Ligolo_SetOperatorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOperatorRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).SetOperatorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_SetOperatorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).SetOperatorRole(ctx, req.(*SetOperatorRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) PromoteOperator(context.Context, *PromoteOperatorReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PromoteOperator not implemented")
+
+func _Ligolo_RenewOperatorCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewOperatorCertReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).RenewOperatorCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_RenewOperatorCert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).RenewOperatorCert(ctx, req.(*RenewOperatorCertReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) DemoteOperator(context.Context, *DemoteOperatorReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemoteOperator not implemented")
+
+func _Ligolo_GetEnrollments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetEnrollments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetEnrollments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetEnrollments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) GenerateAgent(context.Context, *GenerateAgentReq) (*GenerateAgentResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateAgent not implemented")
+
+func _Ligolo_AddEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEnrollmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddEnrollment(ctx, req.(*AddEnrollmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) Traceroute(context.Context, *TracerouteReq) (*TracerouteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Traceroute not implemented")
+
+func _Ligolo_DelEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelEnrollmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).DelEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelEnrollment(ctx, req.(*DelEnrollmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLigoloServer) mustEmbedUnimplementedLigoloServer() {}
 
-// UnsafeLigoloServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LigoloServer will
-// result in compilation errors.
-type UnsafeLigoloServer interface {
-	mustEmbedUnimplementedLigoloServer()
+func _Ligolo_GetEngagements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetEngagements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetEngagements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetEngagements(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLigoloServer(s grpc.ServiceRegistrar, srv LigoloServer) {
-	s.RegisterService(&Ligolo_ServiceDesc, srv)
+func _Ligolo_AddEngagement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEngagementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddEngagement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddEngagement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddEngagement(ctx, req.(*AddEngagementReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_Join_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Ligolo_DelEngagement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelEngagementReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LigoloServer).Join(m, &ligoloJoinServer{stream})
+	if interceptor == nil {
+		return srv.(LigoloServer).DelEngagement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_DelEngagement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).DelEngagement(ctx, req.(*DelEngagementReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Ligolo_JoinServer interface {
-	Send(*Event) error
-	grpc.ServerStream
+func _Ligolo_ActivateEngagement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateEngagementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).ActivateEngagement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_ActivateEngagement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).ActivateEngagement(ctx, req.(*ActivateEngagementReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type ligoloJoinServer struct {
-	grpc.ServerStream
+func _Ligolo_GetListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).GetListeners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_GetListeners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).GetListeners(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *ligoloJoinServer) Send(m *Event) error {
-	return x.ServerStream.SendMsg(m)
+func _Ligolo_AddListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LigoloServer).AddListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ligolo_AddListener_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LigoloServer).AddListener(ctx, req.(*AddListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Ligolo_DelListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).GetMetadata(ctx, in)
+		return srv.(LigoloServer).DelListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_GetMetadata_FullMethodName,
+		FullMethod: Ligolo_DelListener_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).GetMetadata(ctx, req.(*Empty))
+		return srv.(LigoloServer).DelListener(ctx, req.(*DelListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Ligolo_SetListenerEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetListenerEnabledReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).GetSessions(ctx, in)
+		return srv.(LigoloServer).SetListenerEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_GetSessions_FullMethodName,
+		FullMethod: Ligolo_SetListenerEnabled_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).GetSessions(ctx, req.(*Empty))
+		return srv.(LigoloServer).SetListenerEnabled(ctx, req.(*SetListenerEnabledReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_RenameSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenameSessionReq)
+func _Ligolo_GenerateAgent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateAgentReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).GenerateAgent(m, &ligoloGenerateAgentServer{stream})
+}
+
+type Ligolo_GenerateAgentServer interface {
+	Send(*GenerateAgentResp) error
+	grpc.ServerStream
+}
+
+type ligoloGenerateAgentServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloGenerateAgentServer) Send(m *GenerateAgentResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_RenderAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderAgentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).RenameSession(ctx, in)
+		return srv.(LigoloServer).RenderAgent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_RenameSession_FullMethodName,
+		FullMethod: Ligolo_RenderAgent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).RenameSession(ctx, req.(*RenameSessionReq))
+		return srv.(LigoloServer).RenderAgent(ctx, req.(*RenderAgentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_KillSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KillSessionReq)
+func _Ligolo_CancelAgentBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAgentBuildReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).KillSession(ctx, in)
+		return srv.(LigoloServer).CancelAgentBuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_KillSession_FullMethodName,
+		FullMethod: Ligolo_CancelAgentBuild_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).KillSession(ctx, req.(*KillSessionReq))
+		return srv.(LigoloServer).CancelAgentBuild(ctx, req.(*CancelAgentBuildReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_StartRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartRelayReq)
+func _Ligolo_LookupAgentBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupAgentBuildReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).StartRelay(ctx, in)
+		return srv.(LigoloServer).LookupAgentBuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_StartRelay_FullMethodName,
+		FullMethod: Ligolo_LookupAgentBuild_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).StartRelay(ctx, req.(*StartRelayReq))
+		return srv.(LigoloServer).LookupAgentBuild(ctx, req.(*LookupAgentBuildReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_StopRelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopRelayReq)
+func _Ligolo_GetAgentBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).StopRelay(ctx, in)
+		return srv.(LigoloServer).GetAgentBuilds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_StopRelay_FullMethodName,
+		FullMethod: Ligolo_GetAgentBuilds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).StopRelay(ctx, req.(*StopRelayReq))
+		return srv.(LigoloServer).GetAgentBuilds(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_AddRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRouteReq)
+func _Ligolo_DownloadAgentBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadAgentBuildReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).AddRoute(ctx, in)
+		return srv.(LigoloServer).DownloadAgentBuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_AddRoute_FullMethodName,
+		FullMethod: Ligolo_DownloadAgentBuild_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).AddRoute(ctx, req.(*AddRouteReq))
+		return srv.(LigoloServer).DownloadAgentBuild(ctx, req.(*DownloadAgentBuildReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_EditRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditRouteReq)
+func _Ligolo_RegenerateAgent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegenerateAgentReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).RegenerateAgent(m, &ligoloRegenerateAgentServer{stream})
+}
+
+type Ligolo_RegenerateAgentServer interface {
+	Send(*GenerateAgentResp) error
+	grpc.ServerStream
+}
+
+type ligoloRegenerateAgentServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloRegenerateAgentServer) Send(m *GenerateAgentResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_BuildRecipe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BuildRecipeReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LigoloServer).BuildRecipe(m, &ligoloBuildRecipeServer{stream})
+}
+
+type Ligolo_BuildRecipeServer interface {
+	Send(*BuildRecipeResp) error
+	grpc.ServerStream
+}
+
+type ligoloBuildRecipeServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloBuildRecipeServer) Send(m *BuildRecipeResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ligolo_GetToolchain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).EditRoute(ctx, in)
+		return srv.(LigoloServer).GetToolchain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_EditRoute_FullMethodName,
+		FullMethod: Ligolo_GetToolchain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).EditRoute(ctx, req.(*EditRouteReq))
+		return srv.(LigoloServer).GetToolchain(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ligolo_UploadToolchain_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LigoloServer).UploadToolchain(&ligoloUploadToolchainServer{stream})
+}
+
+type Ligolo_UploadToolchainServer interface {
+	SendAndClose(*Toolchain) error
+	Recv() (*UploadToolchainReq, error)
+	grpc.ServerStream
+}
+
+type ligoloUploadToolchainServer struct {
+	grpc.ServerStream
+}
+
+func (x *ligoloUploadToolchainServer) SendAndClose(m *Toolchain) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ligoloUploadToolchainServer) Recv() (*UploadToolchainReq, error) {
+	m := new(UploadToolchainReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _Ligolo_MoveRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MoveRouteReq)
+func _Ligolo_FetchToolchain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchToolchainReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).MoveRoute(ctx, in)
+		return srv.(LigoloServer).FetchToolchain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_MoveRoute_FullMethodName,
+		FullMethod: Ligolo_FetchToolchain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).MoveRoute(ctx, req.(*MoveRouteReq))
+		return srv.(LigoloServer).FetchToolchain(ctx, req.(*FetchToolchainReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_DelRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelRouteReq)
+func _Ligolo_RollbackToolchain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).DelRoute(ctx, in)
+		return srv.(LigoloServer).RollbackToolchain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_DelRoute_FullMethodName,
+		FullMethod: Ligolo_RollbackToolchain_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).DelRoute(ctx, req.(*DelRouteReq))
+		return srv.(LigoloServer).RollbackToolchain(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_AddRedirector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRedirectorReq)
+func _Ligolo_GetAgentTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).AddRedirector(ctx, in)
+		return srv.(LigoloServer).GetAgentTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_AddRedirector_FullMethodName,
+		FullMethod: Ligolo_GetAgentTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).AddRedirector(ctx, req.(*AddRedirectorReq))
+		return srv.(LigoloServer).GetAgentTemplates(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_DelRedirector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelRedirectorReq)
+func _Ligolo_AddAgentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAgentTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).DelRedirector(ctx, in)
+		return srv.(LigoloServer).AddAgentTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_DelRedirector_FullMethodName,
+		FullMethod: Ligolo_AddAgentTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).DelRedirector(ctx, req.(*DelRedirectorReq))
+		return srv.(LigoloServer).AddAgentTemplate(ctx, req.(*AddAgentTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_GetCerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Ligolo_DelAgentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAgentTemplateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).GetCerts(ctx, in)
+		return srv.(LigoloServer).DelAgentTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_GetCerts_FullMethodName,
+		FullMethod: Ligolo_DelAgentTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).GetCerts(ctx, req.(*Empty))
+		return srv.(LigoloServer).DelAgentTemplate(ctx, req.(*DelAgentTemplateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_RegenCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegenCertReq)
+func _Ligolo_GetSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).RegenCert(ctx, in)
+		return srv.(LigoloServer).GetSigningKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_RegenCert_FullMethodName,
+		FullMethod: Ligolo_GetSigningKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).RegenCert(ctx, req.(*RegenCertReq))
+		return srv.(LigoloServer).GetSigningKeys(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_GetOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Ligolo_AddSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSigningKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).GetOperators(ctx, in)
+		return srv.(LigoloServer).AddSigningKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_GetOperators_FullMethodName,
+		FullMethod: Ligolo_AddSigningKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).GetOperators(ctx, req.(*Empty))
+		return srv.(LigoloServer).AddSigningKey(ctx, req.(*AddSigningKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_ExportOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportOperatorReq)
+func _Ligolo_DelSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelSigningKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).ExportOperator(ctx, in)
+		return srv.(LigoloServer).DelSigningKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_ExportOperator_FullMethodName,
+		FullMethod: Ligolo_DelSigningKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).ExportOperator(ctx, req.(*ExportOperatorReq))
+		return srv.(LigoloServer).DelSigningKey(ctx, req.(*DelSigningKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_AddOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddOperatorReq)
+func _Ligolo_GetBuildHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).AddOperator(ctx, in)
+		return srv.(LigoloServer).GetBuildHooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_AddOperator_FullMethodName,
+		FullMethod: Ligolo_GetBuildHooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).AddOperator(ctx, req.(*AddOperatorReq))
+		return srv.(LigoloServer).GetBuildHooks(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_DelOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelOperatorReq)
+func _Ligolo_GetTransportProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).DelOperator(ctx, in)
+		return srv.(LigoloServer).GetTransportProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_DelOperator_FullMethodName,
+		FullMethod: Ligolo_GetTransportProfiles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).DelOperator(ctx, req.(*DelOperatorReq))
+		return srv.(LigoloServer).GetTransportProfiles(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_PromoteOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PromoteOperatorReq)
+func _Ligolo_GetAssetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).PromoteOperator(ctx, in)
+		return srv.(LigoloServer).GetAssetUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_PromoteOperator_FullMethodName,
+		FullMethod: Ligolo_GetAssetUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).PromoteOperator(ctx, req.(*PromoteOperatorReq))
+		return srv.(LigoloServer).GetAssetUsage(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_DemoteOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DemoteOperatorReq)
+func _Ligolo_CollectAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectAssetsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).DemoteOperator(ctx, in)
+		return srv.(LigoloServer).CollectAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_DemoteOperator_FullMethodName,
+		FullMethod: Ligolo_CollectAssets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).DemoteOperator(ctx, req.(*DemoteOperatorReq))
+		return srv.(LigoloServer).CollectAssets(ctx, req.(*CollectAssetsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_GenerateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateAgentReq)
+func _Ligolo_Traceroute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TracerouteReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).GenerateAgent(ctx, in)
+		return srv.(LigoloServer).Traceroute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_GenerateAgent_FullMethodName,
+		FullMethod: Ligolo_Traceroute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).GenerateAgent(ctx, req.(*GenerateAgentReq))
+		return srv.(LigoloServer).Traceroute(ctx, req.(*TracerouteReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ligolo_Traceroute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TracerouteReq)
+func _Ligolo_Throughput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThroughputReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LigoloServer).Traceroute(ctx, in)
+		return srv.(LigoloServer).Throughput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ligolo_Traceroute_FullMethodName,
+		FullMethod: Ligolo_Throughput_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LigoloServer).Traceroute(ctx, req.(*TracerouteReq))
+		return srv.(LigoloServer).Throughput(ctx, req.(*ThroughputReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -851,6 +3883,10 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "ligolo.Ligolo",
 	HandlerType: (*LigoloServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _Ligolo_GetAuditLog_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _Ligolo_GetMetadata_Handler,
@@ -863,10 +3899,18 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameSession",
 			Handler:    _Ligolo_RenameSession_Handler,
 		},
+		{
+			MethodName: "Annotate",
+			Handler:    _Ligolo_Annotate_Handler,
+		},
 		{
 			MethodName: "KillSession",
 			Handler:    _Ligolo_KillSession_Handler,
 		},
+		{
+			MethodName: "BurnAgent",
+			Handler:    _Ligolo_BurnAgent_Handler,
+		},
 		{
 			MethodName: "StartRelay",
 			Handler:    _Ligolo_StartRelay_Handler,
@@ -875,6 +3919,82 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopRelay",
 			Handler:    _Ligolo_StopRelay_Handler,
 		},
+		{
+			MethodName: "SetSpoofSource",
+			Handler:    _Ligolo_SetSpoofSource_Handler,
+		},
+		{
+			MethodName: "SetCompression",
+			Handler:    _Ligolo_SetCompression_Handler,
+		},
+		{
+			MethodName: "SetMirror",
+			Handler:    _Ligolo_SetMirror_Handler,
+		},
+		{
+			MethodName: "SetBeacon",
+			Handler:    _Ligolo_SetBeacon_Handler,
+		},
+		{
+			MethodName: "SetWorkingHours",
+			Handler:    _Ligolo_SetWorkingHours_Handler,
+		},
+		{
+			MethodName: "WakeSession",
+			Handler:    _Ligolo_WakeSession_Handler,
+		},
+		{
+			MethodName: "SetSocks",
+			Handler:    _Ligolo_SetSocks_Handler,
+		},
+		{
+			MethodName: "ListFiles",
+			Handler:    _Ligolo_ListFiles_Handler,
+		},
+		{
+			MethodName: "UpdateAgent",
+			Handler:    _Ligolo_UpdateAgent_Handler,
+		},
+		{
+			MethodName: "RotateAgentCert",
+			Handler:    _Ligolo_RotateAgentCert_Handler,
+		},
+		{
+			MethodName: "RefreshNetwork",
+			Handler:    _Ligolo_RefreshNetwork_Handler,
+		},
+		{
+			MethodName: "HandoffSession",
+			Handler:    _Ligolo_HandoffSession_Handler,
+		},
+		{
+			MethodName: "AnswerHandoff",
+			Handler:    _Ligolo_AnswerHandoff_Handler,
+		},
+		{
+			MethodName: "ClaimSession",
+			Handler:    _Ligolo_ClaimSession_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Ligolo_Persist_Handler,
+		},
+		{
+			MethodName: "SetBreakout",
+			Handler:    _Ligolo_SetBreakout_Handler,
+		},
+		{
+			MethodName: "SetBandwidth",
+			Handler:    _Ligolo_SetBandwidth_Handler,
+		},
+		{
+			MethodName: "RefreshHostInfo",
+			Handler:    _Ligolo_RefreshHostInfo_Handler,
+		},
+		{
+			MethodName: "Diagnose",
+			Handler:    _Ligolo_Diagnose_Handler,
+		},
 		{
 			MethodName: "AddRoute",
 			Handler:    _Ligolo_AddRoute_Handler,
@@ -891,6 +4011,22 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelRoute",
 			Handler:    _Ligolo_DelRoute_Handler,
 		},
+		{
+			MethodName: "GetRouteProfiles",
+			Handler:    _Ligolo_GetRouteProfiles_Handler,
+		},
+		{
+			MethodName: "AddRouteProfile",
+			Handler:    _Ligolo_AddRouteProfile_Handler,
+		},
+		{
+			MethodName: "DelRouteProfile",
+			Handler:    _Ligolo_DelRouteProfile_Handler,
+		},
+		{
+			MethodName: "ApplyRouteProfile",
+			Handler:    _Ligolo_ApplyRouteProfile_Handler,
+		},
 		{
 			MethodName: "AddRedirector",
 			Handler:    _Ligolo_AddRedirector_Handler,
@@ -899,6 +4035,22 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelRedirector",
 			Handler:    _Ligolo_DelRedirector_Handler,
 		},
+		{
+			MethodName: "GetAttachments",
+			Handler:    _Ligolo_GetAttachments_Handler,
+		},
+		{
+			MethodName: "AddAttachment",
+			Handler:    _Ligolo_AddAttachment_Handler,
+		},
+		{
+			MethodName: "DownloadAttachment",
+			Handler:    _Ligolo_DownloadAttachment_Handler,
+		},
+		{
+			MethodName: "DelAttachment",
+			Handler:    _Ligolo_DelAttachment_Handler,
+		},
 		{
 			MethodName: "GetCerts",
 			Handler:    _Ligolo_GetCerts_Handler,
@@ -907,6 +4059,34 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegenCert",
 			Handler:    _Ligolo_RegenCert_Handler,
 		},
+		{
+			MethodName: "GetRevokedCerts",
+			Handler:    _Ligolo_GetRevokedCerts_Handler,
+		},
+		{
+			MethodName: "RevokeCert",
+			Handler:    _Ligolo_RevokeCert_Handler,
+		},
+		{
+			MethodName: "UnrevokeCert",
+			Handler:    _Ligolo_UnrevokeCert_Handler,
+		},
+		{
+			MethodName: "GetCRL",
+			Handler:    _Ligolo_GetCRL_Handler,
+		},
+		{
+			MethodName: "GetCAs",
+			Handler:    _Ligolo_GetCAs_Handler,
+		},
+		{
+			MethodName: "AddCA",
+			Handler:    _Ligolo_AddCA_Handler,
+		},
+		{
+			MethodName: "RevokeCA",
+			Handler:    _Ligolo_RevokeCA_Handler,
+		},
 		{
 			MethodName: "GetOperators",
 			Handler:    _Ligolo_GetOperators_Handler,
@@ -932,13 +4112,137 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Ligolo_DemoteOperator_Handler,
 		},
 		{
-			MethodName: "GenerateAgent",
-			Handler:    _Ligolo_GenerateAgent_Handler,
+			MethodName: "SetOperatorRole",
+			Handler:    _Ligolo_SetOperatorRole_Handler,
+		},
+		{
+			MethodName: "RenewOperatorCert",
+			Handler:    _Ligolo_RenewOperatorCert_Handler,
+		},
+		{
+			MethodName: "GetEnrollments",
+			Handler:    _Ligolo_GetEnrollments_Handler,
+		},
+		{
+			MethodName: "AddEnrollment",
+			Handler:    _Ligolo_AddEnrollment_Handler,
+		},
+		{
+			MethodName: "DelEnrollment",
+			Handler:    _Ligolo_DelEnrollment_Handler,
+		},
+		{
+			MethodName: "GetEngagements",
+			Handler:    _Ligolo_GetEngagements_Handler,
+		},
+		{
+			MethodName: "AddEngagement",
+			Handler:    _Ligolo_AddEngagement_Handler,
+		},
+		{
+			MethodName: "DelEngagement",
+			Handler:    _Ligolo_DelEngagement_Handler,
+		},
+		{
+			MethodName: "ActivateEngagement",
+			Handler:    _Ligolo_ActivateEngagement_Handler,
+		},
+		{
+			MethodName: "GetListeners",
+			Handler:    _Ligolo_GetListeners_Handler,
+		},
+		{
+			MethodName: "AddListener",
+			Handler:    _Ligolo_AddListener_Handler,
+		},
+		{
+			MethodName: "DelListener",
+			Handler:    _Ligolo_DelListener_Handler,
+		},
+		{
+			MethodName: "SetListenerEnabled",
+			Handler:    _Ligolo_SetListenerEnabled_Handler,
+		},
+		{
+			MethodName: "RenderAgent",
+			Handler:    _Ligolo_RenderAgent_Handler,
+		},
+		{
+			MethodName: "CancelAgentBuild",
+			Handler:    _Ligolo_CancelAgentBuild_Handler,
+		},
+		{
+			MethodName: "LookupAgentBuild",
+			Handler:    _Ligolo_LookupAgentBuild_Handler,
+		},
+		{
+			MethodName: "GetAgentBuilds",
+			Handler:    _Ligolo_GetAgentBuilds_Handler,
+		},
+		{
+			MethodName: "DownloadAgentBuild",
+			Handler:    _Ligolo_DownloadAgentBuild_Handler,
+		},
+		{
+			MethodName: "GetToolchain",
+			Handler:    _Ligolo_GetToolchain_Handler,
+		},
+		{
+			MethodName: "FetchToolchain",
+			Handler:    _Ligolo_FetchToolchain_Handler,
+		},
+		{
+			MethodName: "RollbackToolchain",
+			Handler:    _Ligolo_RollbackToolchain_Handler,
+		},
+		{
+			MethodName: "GetAgentTemplates",
+			Handler:    _Ligolo_GetAgentTemplates_Handler,
+		},
+		{
+			MethodName: "AddAgentTemplate",
+			Handler:    _Ligolo_AddAgentTemplate_Handler,
+		},
+		{
+			MethodName: "DelAgentTemplate",
+			Handler:    _Ligolo_DelAgentTemplate_Handler,
+		},
+		{
+			MethodName: "GetSigningKeys",
+			Handler:    _Ligolo_GetSigningKeys_Handler,
+		},
+		{
+			MethodName: "AddSigningKey",
+			Handler:    _Ligolo_AddSigningKey_Handler,
+		},
+		{
+			MethodName: "DelSigningKey",
+			Handler:    _Ligolo_DelSigningKey_Handler,
+		},
+		{
+			MethodName: "GetBuildHooks",
+			Handler:    _Ligolo_GetBuildHooks_Handler,
+		},
+		{
+			MethodName: "GetTransportProfiles",
+			Handler:    _Ligolo_GetTransportProfiles_Handler,
+		},
+		{
+			MethodName: "GetAssetUsage",
+			Handler:    _Ligolo_GetAssetUsage_Handler,
+		},
+		{
+			MethodName: "CollectAssets",
+			Handler:    _Ligolo_CollectAssets_Handler,
 		},
 		{
 			MethodName: "Traceroute",
 			Handler:    _Ligolo_Traceroute_Handler,
 		},
+		{
+			MethodName: "Throughput",
+			Handler:    _Ligolo_Throughput_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -946,6 +4250,71 @@ var Ligolo_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Ligolo_Join_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Ligolo_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Replay",
+			Handler:       _Ligolo_Replay_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _Ligolo_DownloadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFile",
+			Handler:       _Ligolo_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Exec",
+			Handler:       _Ligolo_Exec_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Sweep",
+			Handler:       _Ligolo_Sweep_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportServerState",
+			Handler:       _Ligolo_ExportServerState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportServerState",
+			Handler:       _Ligolo_ImportServerState_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReplicateServerState",
+			Handler:       _Ligolo_ReplicateServerState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GenerateAgent",
+			Handler:       _Ligolo_GenerateAgent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegenerateAgent",
+			Handler:       _Ligolo_RegenerateAgent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BuildRecipe",
+			Handler:       _Ligolo_BuildRecipe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadToolchain",
+			Handler:       _Ligolo_UploadToolchain_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "protobuf/ligolo.proto",
 }